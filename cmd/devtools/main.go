@@ -35,6 +35,13 @@ func main() {
 		log.Fatalln("logger failed:", err)
 	}
 
+	if len(os.Args) > 1 && os.Args[1] == "replay" {
+		if err := runReplay(logger, os.Args[2:]); err != nil {
+			log.Fatalln("replay failed:", err)
+		}
+		return
+	}
+
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
 		log.Fatalln("locaing home directory failed:", err)
@@ -409,3 +416,13 @@ func (h *codecHandler) HandleStreamClose(v codec.StreamClose) error {
 	h.appendMessage(ppsppv1.CapConnLog_PeerLog_Event_MESSAGE_TYPE_STREAM_CLOSE, uint64(v.Stream))
 	return nil
 }
+
+func (h *codecHandler) HandlePexRequest(v codec.PexRequest) error {
+	h.appendMessage(ppsppv1.CapConnLog_PeerLog_Event_MESSAGE_TYPE_PEX_REQUEST, 0)
+	return nil
+}
+
+func (h *codecHandler) HandlePexResponse(v codec.PexResponse) error {
+	h.appendMessage(ppsppv1.CapConnLog_PeerLog_Event_MESSAGE_TYPE_PEX_RESPONSE, 0)
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/MemeLabs/strims/pkg/ppspp"
+	"github.com/MemeLabs/strims/pkg/ppspp/ppspptest"
+	"go.uber.org/zap"
+)
+
+// runReplay replays the reads captured for one peer in a cap log against the
+// ppspp scheduler built into this binary and prints a diff of the captured
+// and replayed writes.
+func runReplay(logger *zap.Logger, args []string) error {
+	fs := flag.NewFlagSet("replay", flag.ExitOnError)
+	label := fs.String("peer", "", "label of the captured peer to replay (default first peer in the log)")
+	seed := fs.Bool("seed", false, "replay the peer with the seed scheduling method")
+	randSeed := fs.Int64("rand", 1, "seed for the scheduler's random choices")
+	tail := fs.Duration("tail", 0, "time to keep recording after the last captured read")
+	contextLines := fs.Int("context", 10, "messages to print around the first divergence")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: devtools replay [flags] <cap log>\n")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	s, err := ppspptest.LoadCapLogSession(fs.Arg(0), *label)
+	if err != nil {
+		return err
+	}
+
+	opt := ppspptest.CapLogReplayOptions{
+		Tail:     *tail,
+		RandSeed: *randSeed,
+		Logger:   logger,
+	}
+	if *seed {
+		opt.SchedulingMethod = ppspp.SeedSchedulingMethod
+	}
+	replay, err := ppspptest.ReplayCapLogSession(s, opt)
+	if err != nil {
+		return err
+	}
+
+	diff, err := ppspptest.DiffCapLogSessions(s, replay)
+	if err != nil {
+		return err
+	}
+	diff.Format(os.Stdout, *contextLines)
+	return nil
+}
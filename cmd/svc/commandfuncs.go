@@ -43,6 +43,9 @@ func runCmd(fs Flags) error {
 
 	dao.Logger = logger
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
 	var eg errgroup.Group
 	var closers []io.Closer
 
@@ -95,6 +98,21 @@ func runCmd(fs Flags) error {
 		tcpOpts.Mux = mux
 	}
 
+	udpOpts := vnic.UDPInterfaceOptions{
+		Address:     cfg.VNIC.UDP.Address.Get(""),
+		HostIP:      fs.String("host-ip"),
+		ReadTimeout: cfg.VNIC.UDP.ReadTimeout,
+	}
+	if cfg.VNIC.UDP.Enabled.Get(true) && cfg.VNIC.UDP.Address.Ok() {
+		mux, lis, err := vnic.NewUDPMux(logger, cfg.VNIC.UDP.Address.MustGet())
+		if err != nil {
+			return fmt.Errorf("creating udp mux: %w", err)
+		}
+		logger.Debug("udp mux started", zap.Stringer("address", lis.Addr()))
+		closers = append(closers, lis)
+		udpOpts.Mux = mux
+	}
+
 	webRTCOpts := &vnic.WebRTCInterfaceOptions{
 		ICEServers:    cfg.VNIC.WebRTC.ICEServers.Get(nil),
 		PortMin:       cfg.VNIC.WebRTC.PortMin,
@@ -155,6 +173,9 @@ func runCmd(fs Flags) error {
 		if cfg.VNIC.TCP.Enabled.Get(true) {
 			opts = append(opts, vnic.WithInterface(vnic.NewTCPInterface(logger, tcpOpts)))
 		}
+		if cfg.VNIC.UDP.Enabled.Get(true) {
+			opts = append(opts, vnic.WithInterface(vnic.NewUDPInterface(logger, udpOpts)))
+		}
 		if cfg.VNIC.WebSocket.Enabled.Get(true) {
 			opts = append(opts, vnic.WithInterface(vnic.NewWSInterface(logger, wsOpts)))
 		}
@@ -165,7 +186,18 @@ func runCmd(fs Flags) error {
 		if err != nil {
 			return nil, err
 		}
-		return vpn.New(logger, host)
+		vpnHost, err := vpn.New(logger, host)
+		if err != nil {
+			return nil, err
+		}
+		if cfg.VNIC.MDNS.Enabled.Get(false) {
+			go func() {
+				if err := vpn.RunLANDiscovery(ctx, logger, vpnHost); err != nil && ctx.Err() == nil {
+					logger.Warn("lan discovery stopped", zap.Error(err))
+				}
+			}()
+		}
+		return vpnHost, nil
 	}
 
 	store, err := openDB(logger, cfg.Storage)
@@ -236,6 +268,7 @@ func runCmd(fs Flags) error {
 		signal.Notify(sig, os.Interrupt)
 		err := fmt.Errorf("signal: %s", <-sig)
 
+		cancel()
 		for _, c := range closers {
 			c.Close()
 		}
@@ -182,6 +182,14 @@ type PeerConfig struct {
 			ReadTimeout     time.Duration    `yaml:"readTimeout"`
 			WriteTimeout    time.Duration    `yaml:"writeTimeout"`
 		} `yaml:"tcp"`
+		UDP struct {
+			Enabled     Optional[bool]   `yaml:"enabled"`
+			Address     Optional[string] `yaml:"address"`
+			ReadTimeout time.Duration    `yaml:"readTimeout"`
+		} `yaml:"udp"`
+		MDNS struct {
+			Enabled Optional[bool] `yaml:"enabled"`
+		} `yaml:"mdns"`
 	} `yaml:"vnic"`
 }
 
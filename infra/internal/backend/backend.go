@@ -100,6 +100,14 @@ type GCPConfig struct {
 
 func (c *GCPConfig) isDriverConfig() {}
 
+// LocalConfig ...
+type LocalConfig struct {
+	Image    string
+	Profiles []string
+}
+
+func (c *LocalConfig) isDriverConfig() {}
+
 // Config ...
 type Config struct {
 	LogLevel int
@@ -158,6 +166,8 @@ func (c *Config) DecoderConfigOptions(config *mapstructure.DecoderConfig) {
 					driverConfig = &HeficedConfig{}
 				case "GCP":
 					driverConfig = &GCPConfig{}
+				case "Local":
+					driverConfig = &LocalConfig{}
 				default:
 					return nil, fmt.Errorf("unsupported driver: %s", driverName)
 				}
@@ -247,6 +257,8 @@ func New(cfg Config) (*Backend, error) {
 				return nil, err
 			}
 			drivers[name] = driver
+		case *LocalConfig:
+			drivers[name] = node.NewLocalDriver(dc.Image, dc.Profiles)
 		}
 	}
 
@@ -0,0 +1,154 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MemeLabs/strims/infra/pkg/autoscale"
+	"github.com/MemeLabs/strims/infra/pkg/node"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapio"
+)
+
+const autoscaleNamePrefix = "autoscale-"
+
+func init() {
+	autoscaleCmd.Flags().StringP("provider", "p", "", "hosting provider to use")
+	autoscaleCmd.Flags().StringP("region", "r", "", "hosting provider region to deploy in")
+	autoscaleCmd.Flags().StringP("sku", "s", "", "hosting provider sku to provision")
+	autoscaleCmd.Flags().Bool("spot", false, "")
+	autoscaleCmd.Flags().String("prometheus", "", "prometheus server address")
+	autoscaleCmd.Flags().String("viewers-query", autoscale.DefaultViewersQuery, "promql query returning the directory viewer count")
+	autoscaleCmd.Flags().String("bandwidth-query", autoscale.DefaultBandwidthQuery, "promql query returning seed egress in bytes per second")
+	autoscaleCmd.Flags().Duration("interval", autoscale.DefaultConfig.Interval, "metrics sample interval")
+	autoscaleCmd.Flags().Int("min", autoscale.DefaultConfig.MinNodes, "minimum number of nodes")
+	autoscaleCmd.Flags().Int("max", autoscale.DefaultConfig.MaxNodes, "maximum number of nodes")
+	autoscaleCmd.Flags().Float64("viewers-per-node", autoscale.DefaultConfig.ViewersPerNode, "viewers served by each node (0 to ignore viewers)")
+	autoscaleCmd.Flags().Float64("bandwidth-per-node", autoscale.DefaultConfig.BandwidthPerNode, "bytes per second served by each node (0 to ignore bandwidth)")
+	autoscaleCmd.Flags().Duration("scale-down-delay", autoscale.DefaultConfig.ScaleDownDelay, "time the pool must be oversized before nodes are destroyed")
+	autoscaleCmd.MarkFlagRequired("provider")
+	autoscaleCmd.MarkFlagRequired("region")
+	autoscaleCmd.MarkFlagRequired("sku")
+	autoscaleCmd.MarkFlagRequired("prometheus")
+
+	rootCmd.AddCommand(autoscaleCmd)
+}
+
+var autoscaleCmd = &cobra.Command{
+	Use:   "autoscale",
+	Short: "Create and destroy worker nodes to match viewer and bandwidth demand",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		flags := cmd.Flags()
+
+		provider, _ := flags.GetString("provider")
+		driver, ok := backend.NodeDrivers[provider]
+		if !ok {
+			return fmt.Errorf("invalid node provider for %q", provider)
+		}
+		if provider == "custom" {
+			return errors.New("custom nodes cannot be autoscaled")
+		}
+
+		region, _ := flags.GetString("region")
+		regions, err := driver.Regions(cmd.Context(), &node.RegionsRequest{})
+		if err != nil {
+			return fmt.Errorf("failed to get regions for current driver: %w", err)
+		}
+		if !node.ValidRegion(region, regions) {
+			return fmt.Errorf("%s region not found for provider %q", region, provider)
+		}
+
+		sku, _ := flags.GetString("sku")
+		skus, err := driver.SKUs(cmd.Context(), &node.SKUsRequest{Region: region})
+		if err != nil {
+			return fmt.Errorf("failed to get skus for %q: %w", provider, err)
+		}
+		if !node.ValidSKU(sku, skus) {
+			return fmt.Errorf("invalid sku for %q", provider)
+		}
+
+		spot, _ := flags.GetBool("spot")
+
+		cfg := autoscale.DefaultConfig
+		cfg.Interval, _ = flags.GetDuration("interval")
+		cfg.MinNodes, _ = flags.GetInt("min")
+		cfg.MaxNodes, _ = flags.GetInt("max")
+		cfg.ViewersPerNode, _ = flags.GetFloat64("viewers-per-node")
+		cfg.BandwidthPerNode, _ = flags.GetFloat64("bandwidth-per-node")
+		cfg.ScaleDownDelay, _ = flags.GetDuration("scale-down-delay")
+
+		address, _ := flags.GetString("prometheus")
+		viewersQuery, _ := flags.GetString("viewers-query")
+		bandwidthQuery, _ := flags.GetString("bandwidth-query")
+		metrics, err := autoscale.NewPrometheusMetrics(address, viewersQuery, bandwidthQuery)
+		if err != nil {
+			return fmt.Errorf("failed to create prometheus client: %w", err)
+		}
+
+		logger, err := zap.NewDevelopment()
+		if err != nil {
+			return err
+		}
+
+		pool := &autoscalePool{
+			logger: logger,
+			driver: driver,
+			region: region,
+			sku:    sku,
+			spot:   spot,
+		}
+		a, err := autoscale.New(logger, cfg, metrics, pool)
+		if err != nil {
+			return err
+		}
+		return a.Run(cmd.Context())
+	},
+}
+
+// autoscalePool manages the active worker nodes created by the autoscaler.
+// Nodes are identified by name so manually provisioned nodes are never
+// destroyed.
+type autoscalePool struct {
+	logger *zap.Logger
+	driver node.Driver
+	region string
+	sku    string
+	spot   bool
+}
+
+func (p *autoscalePool) Nodes(ctx context.Context) ([]*node.Node, error) {
+	nodes, err := backend.ActiveNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pool []*node.Node
+	for _, n := range nodes {
+		if n.Type == node.TypeWorker && strings.HasPrefix(n.Name, autoscaleNamePrefix) {
+			pool = append(pool, n)
+		}
+	}
+	return pool, nil
+}
+
+func (p *autoscalePool) Create(ctx context.Context) error {
+	name := autoscaleNamePrefix + generateHostname(p.driver.Provider(), p.region)
+	user := p.driver.DefaultUser()
+
+	// the autoscaler runs unattended so provisioning output is logged with the
+	// node name rather than interleaved on stdout.
+	w := &zapio.Writer{Log: p.logger.With(zap.String("node", name)), Level: zap.InfoLevel}
+	defer w.Close()
+
+	return backend.CreateNode(ctx, p.driver, name, p.region, p.sku, user, "", node.Hourly, node.TypeWorker, p.spot, w)
+}
+
+func (p *autoscalePool) Destroy(ctx context.Context, n *node.Node) error {
+	return backend.DestroyNode(ctx, n.Name)
+}
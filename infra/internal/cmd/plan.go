@@ -0,0 +1,219 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/MemeLabs/strims/infra/pkg/node"
+	"github.com/MemeLabs/strims/infra/pkg/placement"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+func init() {
+	planCmd.Flags().StringArrayP("location", "l", nil, "viewer location as lat,lng[,viewers] (repeatable)")
+	planCmd.Flags().StringP("histogram", "f", "", "csv geo histogram of lat,lng,viewers rows")
+	planCmd.Flags().StringSliceP("providers", "p", nil, "providers to consider (default all)")
+	planCmd.Flags().Float64P("budget", "b", 0, "maximum monthly cost (0 for unlimited)")
+	planCmd.Flags().Float64("max-distance", 1500, "maximum distance in km between viewers and the node serving them")
+	planCmd.Flags().Float64("viewers-per-node", 500, "viewers served by each node")
+	planCmd.Flags().Float64("viewer-bitrate", 0, "per viewer bitrate in Mbps used to derive node capacity from sku network speed")
+	planCmd.Flags().Bool("provision", false, "create the recommended nodes")
+	planCmd.Flags().Bool("spot", false, "")
+
+	rootCmd.AddCommand(planCmd)
+}
+
+var planCmd = &cobra.Command{
+	Use:   "plan",
+	Short: "Recommend the cheapest set of nodes to serve viewer locations",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		flags := cmd.Flags()
+
+		demand, err := readPlanDemand(cmd)
+		if err != nil {
+			return err
+		}
+
+		providers, _ := flags.GetStringSlice("providers")
+		viewersPerNode, _ := flags.GetFloat64("viewers-per-node")
+		viewerBitrate, _ := flags.GetFloat64("viewer-bitrate")
+		candidates, err := loadPlanCandidates(cmd.Context(), providers, viewersPerNode, viewerBitrate)
+		if err != nil {
+			return err
+		}
+
+		var opt placement.Options
+		opt.Budget, _ = flags.GetFloat64("budget")
+		opt.MaxDistanceKM, _ = flags.GetFloat64("max-distance")
+		plan, err := placement.Solve(demand, candidates, opt)
+		if err != nil {
+			return err
+		}
+
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Provider", "Region", "City", "SKU", "Viewers", "Distance (km)", "Price Monthly"})
+		for _, p := range plan.Placements {
+			table.Append([]string{
+				p.Provider,
+				p.Region.Name,
+				p.Region.City,
+				p.SKU.Name,
+				strconv.FormatFloat(p.Viewers, 'f', 0, 64),
+				strconv.FormatFloat(p.MeanDistanceKM, 'f', 0, 64),
+				strconv.FormatFloat(p.MonthlyCost(), 'f', 2, 64),
+			})
+		}
+		table.SetFooter([]string{
+			"", "", "", "Total",
+			strconv.FormatFloat(plan.Served, 'f', 0, 64),
+			"",
+			strconv.FormatFloat(plan.Cost, 'f', 2, 64),
+		})
+		table.Render()
+
+		if plan.Unserved > 0 {
+			fmt.Printf("%.0f viewers could not be served within the budget and distance limits\n", plan.Unserved)
+		}
+
+		if provision, _ := flags.GetBool("provision"); !provision {
+			return nil
+		}
+
+		spot, _ := flags.GetBool("spot")
+		for _, p := range plan.Placements {
+			driver := backend.NodeDrivers[p.Provider]
+			if err := backend.CreateNode(
+				cmd.Context(),
+				driver,
+				"",
+				p.Region.Name,
+				p.SKU.Name,
+				driver.DefaultUser(),
+				"",
+				node.Hourly,
+				node.TypeWorker,
+				spot,
+				os.Stdout,
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
+
+func readPlanDemand(cmd *cobra.Command) ([]placement.Demand, error) {
+	var demand []placement.Demand
+
+	locations, _ := cmd.Flags().GetStringArray("location")
+	for _, l := range locations {
+		d, err := placement.ParseDemand(l)
+		if err != nil {
+			return nil, fmt.Errorf("invalid location %q: %w", l, err)
+		}
+		demand = append(demand, d)
+	}
+
+	if path, _ := cmd.Flags().GetString("histogram"); path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open histogram: %w", err)
+		}
+		defer f.Close()
+
+		d, err := placement.ReadDemandHistogram(f)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read histogram: %w", err)
+		}
+		demand = append(demand, d...)
+	}
+
+	if len(demand) == 0 {
+		return nil, errors.New("at least one location or a histogram is required")
+	}
+	return demand, nil
+}
+
+// loadPlanCandidates lists every region and sku for the configured drivers.
+// Providers that fail to load are skipped so a single bad credential doesn't
+// prevent planning.
+func loadPlanCandidates(ctx context.Context, providers []string, viewersPerNode, viewerBitrate float64) ([]*placement.Candidate, error) {
+	if len(providers) == 0 {
+		for name := range backend.NodeDrivers {
+			providers = append(providers, name)
+		}
+	}
+
+	var mu sync.Mutex
+	var candidates []*placement.Candidate
+	var eg errgroup.Group
+	for _, name := range providers {
+		name := name
+		driver, ok := backend.NodeDrivers[name]
+		if !ok {
+			return nil, fmt.Errorf("unsupported provider: %s", name)
+		}
+		// nodes from these providers aren't priced
+		if p := driver.Provider(); p == "custom" || p == "local" {
+			continue
+		}
+
+		eg.Go(func() error {
+			regions, err := driver.Regions(ctx, &node.RegionsRequest{})
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "skipping %s: loading regions failed: %s\n", name, err)
+				return nil
+			}
+			for _, region := range regions {
+				skus, err := driver.SKUs(ctx, &node.SKUsRequest{Region: region.Name})
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "skipping %s %s: loading SKUs failed: %s\n", name, region.Name, err)
+					continue
+				}
+
+				mu.Lock()
+				for _, sku := range skus {
+					capacity := viewersPerNode
+					if viewerBitrate > 0 && sku.NetworkSpeed > 0 {
+						capacity = float64(sku.NetworkSpeed) / viewerBitrate
+					}
+					candidates = append(candidates, &placement.Candidate{
+						Provider: name,
+						Region:   region,
+						SKU:      sku,
+						Capacity: capacity,
+					})
+				}
+				mu.Unlock()
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// driver listing order is random. sort so ties resolve the same way on
+	// every run.
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.Provider != b.Provider {
+			return a.Provider < b.Provider
+		}
+		if a.Region.Name != b.Region.Name {
+			return a.Region.Name < b.Region.Name
+		}
+		return a.SKU.Name < b.SKU.Name
+	})
+	return candidates, nil
+}
@@ -0,0 +1,194 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+// Package autoscale sizes the seed node pool to match directory viewer counts
+// and seed bandwidth so idle nodes are not kept online between events.
+package autoscale
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/MemeLabs/strims/infra/pkg/node"
+	"go.uber.org/zap"
+)
+
+// Sample is a snapshot of the load the seed pool is serving.
+type Sample struct {
+	// Viewers is the number of active directory viewer sessions.
+	Viewers float64
+	// Bandwidth is the seed egress rate in bytes per second.
+	Bandwidth float64
+}
+
+// Metrics ...
+type Metrics interface {
+	Sample(ctx context.Context) (Sample, error)
+}
+
+// Pool manages the set of nodes owned by the autoscaler.
+type Pool interface {
+	Nodes(ctx context.Context) ([]*node.Node, error)
+	Create(ctx context.Context) error
+	Destroy(ctx context.Context, n *node.Node) error
+}
+
+// Config ...
+type Config struct {
+	Interval         time.Duration
+	MinNodes         int
+	MaxNodes         int
+	ViewersPerNode   float64
+	BandwidthPerNode float64
+	// ScaleDownDelay is how long the pool must be oversized before nodes are
+	// destroyed. This prevents churn when load briefly dips mid event.
+	ScaleDownDelay time.Duration
+}
+
+// DefaultConfig ...
+var DefaultConfig = Config{
+	Interval:         time.Minute,
+	MinNodes:         0,
+	MaxNodes:         10,
+	ViewersPerNode:   500,
+	BandwidthPerNode: 50 * 1024 * 1024,
+	ScaleDownDelay:   15 * time.Minute,
+}
+
+// Validate ...
+func (c Config) Validate() error {
+	if c.Interval <= 0 {
+		return errors.New("interval must be positive")
+	}
+	if c.MinNodes < 0 || c.MaxNodes < c.MinNodes {
+		return errors.New("max nodes must be greater than or equal to min nodes")
+	}
+	if c.ViewersPerNode <= 0 && c.BandwidthPerNode <= 0 {
+		return errors.New("at least one of viewers or bandwidth per node is required")
+	}
+	return nil
+}
+
+// DesiredNodes returns the number of nodes needed to serve s.
+func (c Config) DesiredNodes(s Sample) int {
+	var n float64
+	if c.ViewersPerNode > 0 {
+		n = math.Max(n, math.Ceil(s.Viewers/c.ViewersPerNode))
+	}
+	if c.BandwidthPerNode > 0 {
+		n = math.Max(n, math.Ceil(s.Bandwidth/c.BandwidthPerNode))
+	}
+
+	if n < float64(c.MinNodes) {
+		return c.MinNodes
+	}
+	if n > float64(c.MaxNodes) {
+		return c.MaxNodes
+	}
+	return int(n)
+}
+
+// New ...
+func New(logger *zap.Logger, cfg Config, metrics Metrics, pool Pool) (*Autoscaler, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &Autoscaler{
+		logger:  logger,
+		cfg:     cfg,
+		metrics: metrics,
+		pool:    pool,
+	}, nil
+}
+
+// Autoscaler ...
+type Autoscaler struct {
+	logger        *zap.Logger
+	cfg           Config
+	metrics       Metrics
+	pool          Pool
+	oversizeSince time.Time
+}
+
+// Run ...
+func (a *Autoscaler) Run(ctx context.Context) error {
+	t := time.NewTicker(a.cfg.Interval)
+	defer t.Stop()
+
+	for {
+		if err := a.tick(ctx, time.Now()); err != nil {
+			a.logger.Error("autoscale failed", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+func (a *Autoscaler) tick(ctx context.Context, now time.Time) error {
+	s, err := a.metrics.Sample(ctx)
+	if err != nil {
+		return err
+	}
+	nodes, err := a.pool.Nodes(ctx)
+	if err != nil {
+		return err
+	}
+
+	want := a.cfg.DesiredNodes(s)
+	a.logger.Debug(
+		"autoscale sample",
+		zap.Float64("viewers", s.Viewers),
+		zap.Float64("bandwidth", s.Bandwidth),
+		zap.Int("nodes", len(nodes)),
+		zap.Int("want", want),
+	)
+
+	switch {
+	case want > len(nodes):
+		a.oversizeSince = time.Time{}
+		for i := len(nodes); i < want; i++ {
+			a.logger.Info("scaling up", zap.Int("nodes", i), zap.Int("want", want))
+			if err := a.pool.Create(ctx); err != nil {
+				return err
+			}
+		}
+	case want < len(nodes):
+		if a.oversizeSince.IsZero() {
+			a.oversizeSince = now
+		}
+		if now.Sub(a.oversizeSince) < a.cfg.ScaleDownDelay {
+			return nil
+		}
+		a.oversizeSince = time.Time{}
+
+		sortByBillingRemainder(nodes, now)
+		for _, n := range nodes[:len(nodes)-want] {
+			a.logger.Info("scaling down", zap.String("name", n.Name), zap.Int("want", want))
+			if err := a.pool.Destroy(ctx, n); err != nil {
+				return err
+			}
+		}
+	default:
+		a.oversizeSince = time.Time{}
+	}
+	return nil
+}
+
+// sortByBillingRemainder orders nodes by the time remaining in their current
+// billing hour. Destroying nodes near the end of an hour they've already paid
+// for wastes the least money.
+func sortByBillingRemainder(nodes []*node.Node, now time.Time) {
+	remainder := func(n *node.Node) time.Duration {
+		return time.Hour - now.Sub(time.Unix(0, n.StartedAt))%time.Hour
+	}
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return remainder(nodes[i]) < remainder(nodes[j])
+	})
+}
@@ -0,0 +1,105 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package autoscale
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MemeLabs/strims/infra/pkg/node"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+type mockMetrics struct {
+	sample Sample
+}
+
+func (m *mockMetrics) Sample(ctx context.Context) (Sample, error) {
+	return m.sample, nil
+}
+
+type mockPool struct {
+	nodes     []*node.Node
+	startedAt time.Time
+	destroyed []string
+}
+
+func (p *mockPool) Nodes(ctx context.Context) ([]*node.Node, error) {
+	return append([]*node.Node{}, p.nodes...), nil
+}
+
+func (p *mockPool) Create(ctx context.Context) error {
+	p.nodes = append(p.nodes, &node.Node{
+		Name:      fmt.Sprintf("node-%d", len(p.nodes)),
+		StartedAt: p.startedAt.UnixNano(),
+	})
+	return nil
+}
+
+func (p *mockPool) Destroy(ctx context.Context, n *node.Node) error {
+	for i, pn := range p.nodes {
+		if pn == n {
+			p.nodes = append(p.nodes[:i], p.nodes[i+1:]...)
+		}
+	}
+	p.destroyed = append(p.destroyed, n.Name)
+	return nil
+}
+
+func TestDesiredNodes(t *testing.T) {
+	cfg := Config{
+		Interval:         time.Minute,
+		MinNodes:         1,
+		MaxNodes:         5,
+		ViewersPerNode:   100,
+		BandwidthPerNode: 1000,
+	}
+
+	assert.Equal(t, 1, cfg.DesiredNodes(Sample{}))
+	assert.Equal(t, 2, cfg.DesiredNodes(Sample{Viewers: 101}))
+	assert.Equal(t, 3, cfg.DesiredNodes(Sample{Viewers: 101, Bandwidth: 2500}))
+	assert.Equal(t, 5, cfg.DesiredNodes(Sample{Viewers: 10000}))
+}
+
+func TestAutoscaler(t *testing.T) {
+	cfg := Config{
+		Interval:       time.Minute,
+		MaxNodes:       5,
+		ViewersPerNode: 100,
+		ScaleDownDelay: 10 * time.Minute,
+	}
+	metrics := &mockMetrics{}
+	pool := &mockPool{}
+	a, err := New(zap.NewNop(), cfg, metrics, pool)
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	now := time.Now()
+
+	pool.startedAt = now.Add(-50 * time.Minute)
+	metrics.sample.Viewers = 150
+	assert.NoError(t, a.tick(ctx, now))
+	pool.startedAt = now.Add(-10 * time.Minute)
+	metrics.sample.Viewers = 250
+	assert.NoError(t, a.tick(ctx, now))
+	assert.Len(t, pool.nodes, 3)
+
+	metrics.sample.Viewers = 50
+	assert.NoError(t, a.tick(ctx, now))
+	assert.Len(t, pool.nodes, 3, "scale down should wait for the delay")
+
+	metrics.sample.Viewers = 250
+	assert.NoError(t, a.tick(ctx, now.Add(5*time.Minute)))
+	metrics.sample.Viewers = 150
+	assert.NoError(t, a.tick(ctx, now.Add(20*time.Minute)))
+	assert.Len(t, pool.nodes, 3, "scale down delay should restart when load recovers")
+
+	assert.NoError(t, a.tick(ctx, now.Add(25*time.Minute)))
+	assert.NoError(t, a.tick(ctx, now.Add(35*time.Minute)))
+	assert.Len(t, pool.nodes, 2)
+	assert.Equal(t, []string{"node-2"}, pool.destroyed, "nodes closest to the end of their billing hour should be destroyed first")
+}
@@ -0,0 +1,79 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package autoscale
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/api"
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+)
+
+// default queries for the metrics exported by the directory and vnic packages
+const (
+	DefaultViewersQuery   = "sum(strims_directory_viewer_count)"
+	DefaultBandwidthQuery = "sum(rate(strims_vnic_link_write_bytes[5m]))"
+)
+
+// NewPrometheusMetrics ...
+func NewPrometheusMetrics(address, viewersQuery, bandwidthQuery string) (*PrometheusMetrics, error) {
+	client, err := api.NewClient(api.Config{Address: address})
+	if err != nil {
+		return nil, err
+	}
+	return &PrometheusMetrics{
+		api:            v1.NewAPI(client),
+		viewersQuery:   viewersQuery,
+		bandwidthQuery: bandwidthQuery,
+	}, nil
+}
+
+// PrometheusMetrics reads autoscaler samples from a prometheus server.
+type PrometheusMetrics struct {
+	api            v1.API
+	viewersQuery   string
+	bandwidthQuery string
+}
+
+// Sample ...
+func (m *PrometheusMetrics) Sample(ctx context.Context) (Sample, error) {
+	now := time.Now()
+
+	viewers, err := m.query(ctx, m.viewersQuery, now)
+	if err != nil {
+		return Sample{}, fmt.Errorf("failed to query viewers: %w", err)
+	}
+	bandwidth, err := m.query(ctx, m.bandwidthQuery, now)
+	if err != nil {
+		return Sample{}, fmt.Errorf("failed to query bandwidth: %w", err)
+	}
+
+	return Sample{
+		Viewers:   viewers,
+		Bandwidth: bandwidth,
+	}, nil
+}
+
+func (m *PrometheusMetrics) query(ctx context.Context, q string, ts time.Time) (float64, error) {
+	v, _, err := m.api.Query(ctx, q, ts)
+	if err != nil {
+		return 0, err
+	}
+
+	switch v := v.(type) {
+	case *model.Scalar:
+		return float64(v.Value), nil
+	case model.Vector:
+		var sum float64
+		for _, s := range v {
+			sum += float64(s.Value)
+		}
+		return sum, nil
+	default:
+		return 0, fmt.Errorf("unexpected result type: %s", v.Type())
+	}
+}
@@ -0,0 +1,301 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package node
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/geo/s2"
+)
+
+const (
+	localImage       = "ubuntu:22.04"
+	localRegion      = "local"
+	localManagedKey  = "user.strims.infra"
+	localSKUKey      = "user.strims.sku"
+	localAddrTimeout = 2 * time.Minute
+	localAddrCheck   = 2 * time.Second
+)
+
+// raw lxc config matching hack/kubernetes/setup-lxc-host.sh so kubeadm and
+// wireguard can run inside the container.
+const localRawLXC = `lxc.apparmor.profile=unconfined
+lxc.mount.auto=proc:rw sys:rw cgroup:rw
+lxc.cgroup.devices.allow=a
+lxc.cap.drop=
+lxc.apparmor.allow_incomplete=1`
+
+const localKernelModules = "ip_tables,ip6_tables,netlink_diag,nf_nat,overlay,ip_vs,ip_vs_rr,ip_vs_wrr,ip_vs_sh,nf_conntrack,wireguard"
+
+var localSKUs = []*SKU{
+	localSKU("local-small", 2, 2048, 20),
+	localSKU("local-medium", 4, 4096, 40),
+	localSKU("local-large", 8, 8192, 80),
+}
+
+func localSKU(name string, cpus, memory, disk int) *SKU {
+	return &SKU{
+		Name:         name,
+		CPUs:         cpus,
+		Memory:       memory,
+		Disk:         disk,
+		NetworkCap:   0,
+		NetworkSpeed: 0,
+		PriceMonthly: &Price{
+			Value:    0,
+			Currency: "USD",
+		},
+		PriceHourly: &Price{
+			Value:    0,
+			Currency: "USD",
+		},
+	}
+}
+
+// NewLocalDriver returns a driver that provisions nodes as privileged lxd
+// system containers on the local host using the lxc cli. If image is empty
+// ubuntu 22.04 is used.
+func NewLocalDriver(image string, profiles []string) *LocalDriver {
+	if image == "" {
+		image = localImage
+	}
+	return &LocalDriver{
+		image:     image,
+		profiles:  profiles,
+		lxc:       runLXC,
+		addrCheck: localAddrCheck,
+	}
+}
+
+// LocalDriver ...
+type LocalDriver struct {
+	image     string
+	profiles  []string
+	lxc       func(ctx context.Context, stdin io.Reader, args ...string) ([]byte, error)
+	addrCheck time.Duration
+}
+
+// Provider ...
+func (d *LocalDriver) Provider() string {
+	return "local"
+}
+
+func (d *LocalDriver) DefaultUser() string {
+	return "root"
+}
+
+// Regions ...
+func (d *LocalDriver) Regions(ctx context.Context, req *RegionsRequest) ([]*Region, error) {
+	return []*Region{localRegionInfo()}, nil
+}
+
+func localRegionInfo() *Region {
+	return &Region{
+		Name:   localRegion,
+		City:   "localhost",
+		LatLng: s2.LatLngFromDegrees(0, 0),
+	}
+}
+
+// SKUs ...
+func (d *LocalDriver) SKUs(ctx context.Context, req *SKUsRequest) ([]*SKU, error) {
+	if req.Region != "" && req.Region != localRegion {
+		return nil, nil
+	}
+	return localSKUs, nil
+}
+
+// Create ...
+func (d *LocalDriver) Create(ctx context.Context, req *CreateRequest) (*Node, error) {
+	sku := findLocalSKU(req.SKU)
+	if sku == nil {
+		return nil, fmt.Errorf("invalid sku: %s", req.SKU)
+	}
+
+	args := []string{
+		"launch", d.image, req.Name,
+		"--config", "security.privileged=true",
+		"--config", "security.nesting=true",
+		"--config", "linux.kernel_modules=" + localKernelModules,
+		"--config", "raw.lxc=" + localRawLXC,
+		"--config", "limits.cpu=" + strconv.Itoa(sku.CPUs),
+		"--config", fmt.Sprintf("limits.memory=%dMB", sku.Memory),
+		"--config", localManagedKey + "=true",
+		"--config", localSKUKey + "=" + sku.Name,
+	}
+	for _, p := range d.profiles {
+		args = append(args, "--profile", p)
+	}
+	if _, err := d.lxc(ctx, nil, args...); err != nil {
+		return nil, fmt.Errorf("failed to launch container: %w", err)
+	}
+
+	if _, err := d.lxc(ctx, nil, "config", "device", "add", req.Name, "kmsg", "unix-char", "source=/dev/kmsg", "path=/dev/kmsg"); err != nil {
+		return nil, fmt.Errorf("failed to add kmsg device: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, localAddrTimeout)
+	defer cancel()
+
+	checkTick := time.NewTicker(d.addrCheck)
+	defer checkTick.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for container address: %w", ctx.Err())
+		case <-checkTick.C:
+			n, err := d.get(ctx, req.Name)
+			if err != nil {
+				return nil, err
+			}
+			if len(n.Networks.V4) == 0 {
+				continue
+			}
+
+			// the container image ships sshd but nothing authorizes our key.
+			// cloud-init may not have finished so retry until the key is added.
+			if err := d.addSSHKey(ctx, req.Name, req.SSHKey); err != nil {
+				continue
+			}
+			return n, nil
+		}
+	}
+}
+
+func (d *LocalDriver) addSSHKey(ctx context.Context, name, key string) error {
+	_, err := d.lxc(
+		ctx,
+		strings.NewReader(key+"\n"),
+		"exec", name, "--",
+		"sh", "-c", "mkdir -p /root/.ssh && chmod 700 /root/.ssh && cat >> /root/.ssh/authorized_keys",
+	)
+	return err
+}
+
+func (d *LocalDriver) get(ctx context.Context, name string) (*Node, error) {
+	nodes, err := d.list(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range nodes {
+		if n.Name == name {
+			return n, nil
+		}
+	}
+	return nil, fmt.Errorf("container not found: %s", name)
+}
+
+// List ...
+func (d *LocalDriver) List(ctx context.Context, req *ListRequest) ([]*Node, error) {
+	return d.list(ctx)
+}
+
+func (d *LocalDriver) list(ctx context.Context, filter ...string) ([]*Node, error) {
+	out, err := d.lxc(ctx, nil, append([]string{"list", "--format", "json"}, filter...)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers: %w", err)
+	}
+
+	var containers []localContainer
+	if err := json.Unmarshal(out, &containers); err != nil {
+		return nil, fmt.Errorf("failed to parse container list: %w", err)
+	}
+
+	nodes := []*Node{}
+	for _, c := range containers {
+		if c.Config[localManagedKey] != "true" {
+			continue
+		}
+		nodes = append(nodes, localNode(c))
+	}
+	return nodes, nil
+}
+
+// Delete ...
+func (d *LocalDriver) Delete(ctx context.Context, req *DeleteRequest) error {
+	_, err := d.lxc(ctx, nil, "delete", "--force", req.ProviderID)
+	return err
+}
+
+type localContainer struct {
+	Name   string            `json:"name"`
+	Status string            `json:"status"`
+	Config map[string]string `json:"config"`
+	State  *struct {
+		Network map[string]struct {
+			Addresses []struct {
+				Family  string `json:"family"`
+				Address string `json:"address"`
+				Scope   string `json:"scope"`
+			} `json:"addresses"`
+		} `json:"network"`
+	} `json:"state"`
+}
+
+func localNode(c localContainer) *Node {
+	node := &Node{
+		Driver:     "local",
+		ProviderID: c.Name,
+		Name:       c.Name,
+		Networks:   &Networks{},
+		Status:     c.Status == "Running",
+		Region:     localRegionInfo(),
+		SKU:        findLocalSKU(c.Config[localSKUKey]),
+	}
+	if node.SKU != nil {
+		node.Memory = node.SKU.Memory
+		node.CPUs = node.SKU.CPUs
+		node.Disk = node.SKU.Disk
+	}
+
+	// wireguard and cni add interfaces once the node is initialized. only the
+	// lxd managed nic is reachable from the host.
+	if c.State != nil {
+		for _, a := range c.State.Network["eth0"].Addresses {
+			if a.Scope != "global" {
+				continue
+			}
+			switch a.Family {
+			case "inet":
+				node.Networks.V4 = append(node.Networks.V4, a.Address)
+			case "inet6":
+				node.Networks.V6 = append(node.Networks.V6, a.Address)
+			}
+		}
+	}
+
+	return node
+}
+
+func findLocalSKU(name string) *SKU {
+	for _, sku := range localSKUs {
+		if sku.Name == name {
+			return sku
+		}
+	}
+	return nil
+}
+
+func runLXC(ctx context.Context, stdin io.Reader, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "lxc", args...)
+	cmd.Stdin = stdin
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("%s: %w", msg, err)
+		}
+		return nil, err
+	}
+	return stdout.Bytes(), nil
+}
@@ -0,0 +1,221 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package node
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type lxcCall struct {
+	args  []string
+	stdin string
+}
+
+// mockLXC records lxc invocations and answers them with fn.
+type mockLXC struct {
+	mu    sync.Mutex
+	calls []lxcCall
+	fn    func(args []string) ([]byte, error)
+}
+
+func (m *mockLXC) run(ctx context.Context, stdin io.Reader, args ...string) ([]byte, error) {
+	c := lxcCall{args: args}
+	if stdin != nil {
+		b, err := io.ReadAll(stdin)
+		if err != nil {
+			return nil, err
+		}
+		c.stdin = string(b)
+	}
+
+	m.mu.Lock()
+	m.calls = append(m.calls, c)
+	m.mu.Unlock()
+
+	return m.fn(args)
+}
+
+func (m *mockLXC) find(cmd string) []lxcCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var calls []lxcCall
+	for _, c := range m.calls {
+		if c.args[0] == cmd {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+func newTestLocalDriver(fn func(args []string) ([]byte, error)) (*LocalDriver, *mockLXC) {
+	m := &mockLXC{fn: fn}
+	d := NewLocalDriver("", []string{"default", "strims"})
+	d.lxc = m.run
+	d.addrCheck = time.Millisecond
+	return d, m
+}
+
+const testLocalContainers = `[
+	{
+		"name": "local-a",
+		"status": "Running",
+		"config": {"user.strims.infra": "true", "user.strims.sku": "local-medium"},
+		"state": {"network": {
+			"eth0": {"addresses": [
+				{"family": "inet", "address": "10.0.0.2", "scope": "global"},
+				{"family": "inet6", "address": "fd00::2", "scope": "global"},
+				{"family": "inet6", "address": "fe80::2", "scope": "link"}
+			]},
+			"wg0": {"addresses": [
+				{"family": "inet", "address": "10.100.0.2", "scope": "global"}
+			]}
+		}}
+	},
+	{
+		"name": "local-b",
+		"status": "Stopped",
+		"config": {"user.strims.infra": "true", "user.strims.sku": "local-small"},
+		"state": null
+	},
+	{
+		"name": "unmanaged",
+		"status": "Running",
+		"config": {},
+		"state": null
+	}
+]`
+
+func TestLocalDriverList(t *testing.T) {
+	d, m := newTestLocalDriver(func(args []string) ([]byte, error) {
+		return []byte(testLocalContainers), nil
+	})
+
+	nodes, err := d.List(context.Background(), &ListRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"list", "--format", "json"}, m.calls[0].args)
+
+	require.Len(t, nodes, 2, "unmanaged containers should be ignored")
+
+	a := nodes[0]
+	assert.Equal(t, "local-a", a.Name)
+	assert.Equal(t, "local-a", a.ProviderID)
+	assert.True(t, a.Status)
+	assert.Equal(t, localRegion, a.Region.Name)
+	assert.Equal(t, "local-medium", a.SKU.Name)
+	assert.Equal(t, 4, a.CPUs)
+	assert.Equal(t, 4096, a.Memory)
+	assert.Equal(t, []string{"10.0.0.2"}, a.Networks.V4, "only global eth0 addresses should be used")
+	assert.Equal(t, []string{"fd00::2"}, a.Networks.V6)
+
+	b := nodes[1]
+	assert.Equal(t, "local-b", b.Name)
+	assert.False(t, b.Status)
+	assert.Empty(t, b.Networks.V4)
+}
+
+func TestLocalDriverListError(t *testing.T) {
+	d, _ := newTestLocalDriver(func(args []string) ([]byte, error) {
+		return nil, errors.New("lxd unavailable")
+	})
+
+	_, err := d.List(context.Background(), &ListRequest{})
+	assert.ErrorContains(t, err, "lxd unavailable")
+}
+
+func TestLocalDriverCreate(t *testing.T) {
+	var sshAttempts int
+	d, m := newTestLocalDriver(func(args []string) ([]byte, error) {
+		switch args[0] {
+		case "list":
+			return []byte(testLocalContainers), nil
+		case "exec":
+			sshAttempts++
+			if sshAttempts == 1 {
+				return nil, errors.New("cloud-init running")
+			}
+		}
+		return nil, nil
+	})
+
+	n, err := d.Create(context.Background(), &CreateRequest{
+		Name:   "local-a",
+		Region: localRegion,
+		SKU:    "local-medium",
+		SSHKey: "ssh-ed25519 AAAA test",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "local-a", n.Name)
+	assert.Equal(t, []string{"10.0.0.2"}, n.Networks.V4)
+
+	launch := m.find("launch")
+	require.Len(t, launch, 1)
+	args := strings.Join(launch[0].args, " ")
+	assert.True(t, strings.HasPrefix(args, "launch "+localImage+" local-a "))
+	assert.Contains(t, args, "--config security.privileged=true")
+	assert.Contains(t, args, "--config limits.cpu=4")
+	assert.Contains(t, args, "--config limits.memory=4096MB")
+	assert.Contains(t, args, "--config user.strims.infra=true")
+	assert.Contains(t, args, "--config user.strims.sku=local-medium")
+	assert.Contains(t, args, "--profile default --profile strims")
+
+	device := m.find("config")
+	require.Len(t, device, 1)
+	assert.Equal(t, []string{"config", "device", "add", "local-a", "kmsg", "unix-char", "source=/dev/kmsg", "path=/dev/kmsg"}, device[0].args)
+
+	exec := m.find("exec")
+	require.Len(t, exec, 2, "adding the ssh key should be retried until it succeeds")
+	assert.Equal(t, "ssh-ed25519 AAAA test\n", exec[1].stdin)
+	assert.Equal(t, []string{"exec", "local-a", "--"}, exec[1].args[:3])
+}
+
+func TestLocalDriverCreateInvalidSKU(t *testing.T) {
+	d, m := newTestLocalDriver(func(args []string) ([]byte, error) {
+		return nil, nil
+	})
+
+	_, err := d.Create(context.Background(), &CreateRequest{Name: "local-a", SKU: "local-huge"})
+	assert.Error(t, err)
+	assert.Empty(t, m.calls, "invalid skus should be rejected before launching")
+}
+
+func TestLocalDriverCreateLaunchError(t *testing.T) {
+	d, m := newTestLocalDriver(func(args []string) ([]byte, error) {
+		return nil, errors.New("image not found")
+	})
+
+	_, err := d.Create(context.Background(), &CreateRequest{Name: "local-a", SKU: "local-small"})
+	assert.ErrorContains(t, err, "image not found")
+	assert.Len(t, m.calls, 1)
+}
+
+func TestLocalDriverDelete(t *testing.T) {
+	d, m := newTestLocalDriver(func(args []string) ([]byte, error) {
+		return nil, nil
+	})
+
+	require.NoError(t, d.Delete(context.Background(), &DeleteRequest{ProviderID: "local-a"}))
+	assert.Equal(t, []string{"delete", "--force", "local-a"}, m.calls[0].args)
+}
+
+func TestLocalDriverSKUs(t *testing.T) {
+	d, _ := newTestLocalDriver(nil)
+
+	skus, err := d.SKUs(context.Background(), &SKUsRequest{Region: localRegion})
+	require.NoError(t, err)
+	assert.Equal(t, localSKUs, skus)
+
+	skus, err = d.SKUs(context.Background(), &SKUsRequest{Region: "nyc1"})
+	require.NoError(t, err)
+	assert.Empty(t, skus, "other regions should not have skus")
+}
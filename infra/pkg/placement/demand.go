@@ -0,0 +1,72 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package placement
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/golang/geo/s2"
+)
+
+// ParseDemand parses "lat,lng[,viewers]" where viewers defaults to 1.
+func ParseDemand(s string) (Demand, error) {
+	return parseDemandFields(strings.Split(s, ","))
+}
+
+// ReadDemandHistogram reads a csv geo histogram with one "lat,lng,viewers"
+// row per bucket. Lines starting with # are ignored.
+func ReadDemandHistogram(r io.Reader) ([]Demand, error) {
+	cr := csv.NewReader(r)
+	cr.Comment = '#'
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	var demand []Demand
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return demand, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		d, err := parseDemandFields(record)
+		if err != nil {
+			line, _ := cr.FieldPos(0)
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		demand = append(demand, d)
+	}
+}
+
+func parseDemandFields(fields []string) (Demand, error) {
+	if len(fields) != 2 && len(fields) != 3 {
+		return Demand{}, errors.New("expected lat,lng[,viewers]")
+	}
+
+	var v [3]float64
+	v[2] = 1
+	for i, f := range fields {
+		var err error
+		v[i], err = strconv.ParseFloat(strings.TrimSpace(f), 64)
+		if err != nil {
+			return Demand{}, err
+		}
+	}
+
+	ll := s2.LatLngFromDegrees(v[0], v[1])
+	if !ll.IsValid() {
+		return Demand{}, fmt.Errorf("invalid location: %s", ll)
+	}
+	if v[2] < 0 {
+		return Demand{}, errors.New("viewers must not be negative")
+	}
+	return Demand{LatLng: ll, Viewers: v[2]}, nil
+}
@@ -0,0 +1,175 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+// Package placement recommends the cheapest set of nodes that can serve a
+// geographic distribution of viewers.
+package placement
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/MemeLabs/strims/infra/pkg/node"
+	"github.com/golang/geo/s2"
+)
+
+const (
+	earthRadiusKM = 6371.0
+	hoursPerMonth = 730
+)
+
+// Demand is a group of viewers at a location.
+type Demand struct {
+	LatLng  s2.LatLng
+	Viewers float64
+}
+
+// Candidate is a provisionable node type. The same candidate may be placed
+// more than once.
+type Candidate struct {
+	Provider string
+	Region   *node.Region
+	SKU      *node.SKU
+	// Capacity is the number of viewers a single node can serve.
+	Capacity float64
+}
+
+// MonthlyCost returns the monthly price of the candidate's SKU. Prices from
+// different providers are compared as-is regardless of currency.
+func (c *Candidate) MonthlyCost() float64 {
+	if c.SKU.PriceMonthly != nil && c.SKU.PriceMonthly.Value > 0 {
+		return c.SKU.PriceMonthly.Value
+	}
+	if c.SKU.PriceHourly != nil {
+		return c.SKU.PriceHourly.Value * hoursPerMonth
+	}
+	return 0
+}
+
+// Options ...
+type Options struct {
+	// MaxDistanceKM is the furthest a viewer may be from the node serving it.
+	MaxDistanceKM float64
+	// Budget is the maximum total monthly cost. Zero means unlimited.
+	Budget float64
+}
+
+// Placement is a single node in a plan.
+type Placement struct {
+	*Candidate
+	Viewers        float64
+	MeanDistanceKM float64
+}
+
+// Plan ...
+type Plan struct {
+	Placements []*Placement
+	Cost       float64
+	Served     float64
+	Unserved   float64
+}
+
+// DistanceKM returns the great circle distance between a and b.
+func DistanceKM(a, b s2.LatLng) float64 {
+	return a.Distance(b).Radians() * earthRadiusKM
+}
+
+// Solve greedily places nodes until all demand is served or the budget runs
+// out. Each step picks the candidate serving the most viewers per unit of
+// cost, preferring closer candidates when ratios tie. Candidates without a
+// price are skipped.
+func Solve(demand []Demand, candidates []*Candidate, opt Options) (*Plan, error) {
+	if opt.MaxDistanceKM <= 0 {
+		return nil, errors.New("max distance must be positive")
+	}
+
+	remaining := make([]float64, len(demand))
+	for i, d := range demand {
+		remaining[i] = d.Viewers
+	}
+
+	// demand indices within range of each candidate ordered nearest first
+	reach := make([][]int, len(candidates))
+	dist := make([][]float64, len(candidates))
+	for i, c := range candidates {
+		dist[i] = make([]float64, len(demand))
+		for j, d := range demand {
+			dist[i][j] = DistanceKM(c.Region.LatLng, d.LatLng)
+			if dist[i][j] <= opt.MaxDistanceKM {
+				reach[i] = append(reach[i], j)
+			}
+		}
+		sort.SliceStable(reach[i], func(a, b int) bool {
+			return dist[i][reach[i][a]] < dist[i][reach[i][b]]
+		})
+	}
+
+	plan := &Plan{}
+	for {
+		best := -1
+		var bestServed, bestScore, bestDistance float64
+		for i, c := range candidates {
+			cost := c.MonthlyCost()
+			if cost <= 0 || (opt.Budget > 0 && plan.Cost+cost > opt.Budget) {
+				continue
+			}
+
+			served, distance := assign(reach[i], dist[i], remaining, c.Capacity, nil)
+			if served == 0 {
+				continue
+			}
+
+			score := served / cost
+			if best == -1 || score > bestScore || (score == bestScore && distance < bestDistance) {
+				best = i
+				bestServed = served
+				bestScore = score
+				bestDistance = distance
+			}
+		}
+		if best == -1 {
+			break
+		}
+
+		c := candidates[best]
+		assign(reach[best], dist[best], remaining, c.Capacity, remaining)
+		plan.Placements = append(plan.Placements, &Placement{
+			Candidate:      c,
+			Viewers:        bestServed,
+			MeanDistanceKM: bestDistance,
+		})
+		plan.Cost += c.MonthlyCost()
+		plan.Served += bestServed
+	}
+
+	for _, v := range remaining {
+		plan.Unserved += v
+	}
+	return plan, nil
+}
+
+// assign serves demand nearest first up to capacity and returns the number of
+// viewers served and their mean distance. If dst is not nil the served viewers
+// are subtracted from it.
+func assign(reach []int, dist, remaining []float64, capacity float64, dst []float64) (served, meanDistance float64) {
+	var weighted float64
+	for _, j := range reach {
+		if served >= capacity {
+			break
+		}
+		n := math.Min(remaining[j], capacity-served)
+		if n <= 0 {
+			continue
+		}
+		served += n
+		weighted += n * dist[j]
+		if dst != nil {
+			dst[j] -= n
+		}
+	}
+	if served == 0 {
+		return 0, 0
+	}
+	return served, weighted / served
+}
@@ -0,0 +1,80 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package placement
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MemeLabs/strims/infra/pkg/node"
+	"github.com/golang/geo/s2"
+	"github.com/stretchr/testify/assert"
+)
+
+func testCandidate(provider, region string, lat, lng, price, capacity float64) *Candidate {
+	return &Candidate{
+		Provider: provider,
+		Region:   &node.Region{Name: region, LatLng: s2.LatLngFromDegrees(lat, lng)},
+		SKU:      &node.SKU{Name: "sku", PriceMonthly: &node.Price{Value: price}},
+		Capacity: capacity,
+	}
+}
+
+func TestSolve(t *testing.T) {
+	nyc := testCandidate("a", "nyc", 40.69, -73.92, 10, 100)
+	nycCheap := testCandidate("b", "nyc", 40.69, -73.92, 5, 100)
+	ams := testCandidate("a", "ams", 52.35, 4.91, 10, 100)
+	sgp := testCandidate("a", "sgp", 1.29, 103.85, 20, 100)
+
+	demand := []Demand{
+		{LatLng: s2.LatLngFromDegrees(43.70, -79.42), Viewers: 150}, // toronto
+		{LatLng: s2.LatLngFromDegrees(50.10, 8.67), Viewers: 50},    // frankfurt
+	}
+
+	plan, err := Solve(demand, []*Candidate{nyc, nycCheap, ams, sgp}, Options{MaxDistanceKM: 1000})
+	assert.NoError(t, err)
+	assert.Equal(t, float64(200), plan.Served)
+	assert.Equal(t, float64(0), plan.Unserved)
+	assert.Equal(t, float64(20), plan.Cost)
+	if assert.Len(t, plan.Placements, 3) {
+		assert.Same(t, nycCheap, plan.Placements[0].Candidate)
+		assert.Same(t, nycCheap, plan.Placements[1].Candidate)
+		assert.Same(t, ams, plan.Placements[2].Candidate)
+	}
+
+	plan, err = Solve(demand, []*Candidate{nyc, nycCheap, ams, sgp}, Options{MaxDistanceKM: 1000, Budget: 12})
+	assert.NoError(t, err)
+	assert.Equal(t, float64(150), plan.Served)
+	assert.Equal(t, float64(50), plan.Unserved)
+	assert.Equal(t, float64(10), plan.Cost)
+}
+
+func TestSolveSkipsUnpricedCandidates(t *testing.T) {
+	free := testCandidate("local", "nyc", 40.69, -73.92, 0, 1000)
+	nyc := testCandidate("a", "nyc", 40.69, -73.92, 10, 100)
+
+	demand := []Demand{
+		{LatLng: s2.LatLngFromDegrees(43.70, -79.42), Viewers: 150}, // toronto
+	}
+
+	plan, err := Solve(demand, []*Candidate{free, nyc}, Options{MaxDistanceKM: 1000})
+	assert.NoError(t, err)
+	assert.Equal(t, float64(150), plan.Served)
+	assert.Equal(t, float64(20), plan.Cost)
+	for _, p := range plan.Placements {
+		assert.Same(t, nyc, p.Candidate)
+	}
+}
+
+func TestReadDemandHistogram(t *testing.T) {
+	demand, err := ReadDemandHistogram(strings.NewReader("# lat,lng,viewers\n40.69,-73.92,150\n52.35, 4.91\n"))
+	assert.NoError(t, err)
+	if assert.Len(t, demand, 2) {
+		assert.Equal(t, float64(150), demand[0].Viewers)
+		assert.Equal(t, float64(1), demand[1].Viewers)
+	}
+
+	_, err = ReadDemandHistogram(strings.NewReader("91,0,1\n"))
+	assert.Error(t, err)
+}
@@ -64,8 +64,8 @@ func NewControl(
 		replicationControl  = replication.NewControl(ctx, logger, vpn, store, observers, profile)
 		transferControl     = transfer.NewControl(ctx, logger, vpn, store, observers)
 		networkControl      = network.NewControl(ctx, logger, vpn, store, observers, broker, profile, transferControl, notificationControl)
-		directoryControl    = directory.NewControl(ctx, logger, vpn, store, observers, networkControl, transferControl)
-		debugControl        = debug.NewControl(ctx, logger, store, observers, transferControl, directoryControl)
+		directoryControl    = directory.NewControl(ctx, logger, vpn, store, observers, networkControl, transferControl, notificationControl)
+		debugControl        = debug.NewControl(ctx, logger, vpn, store, observers, transferControl, directoryControl)
 		chatControl         = chat.NewControl(ctx, logger, store, observers, profile, networkControl, transferControl, directoryControl)
 		bootstrapControl    = bootstrap.NewControl(ctx, logger, vpn, store, observers)
 		videocaptureControl = videocapture.NewControl(ctx, logger, transferControl, directoryControl, networkControl)
@@ -38,7 +38,7 @@ func TestVideoCapture(t *testing.T) {
 
 	go func() {
 		swarmURI := ppspp.NewURI(key.Public, options.SwarmOptions.URIOptions()).String()
-		_, r, err := ctrl[0].VideoEgress().OpenStream(context.Background(), swarmURI, [][]byte{networkKey})
+		_, r, err := ctrl[0].VideoEgress().OpenStream(context.Background(), swarmURI, [][]byte{networkKey}, 0)
 		assert.NoError(t, err)
 		assert.NotNil(t, r)
 
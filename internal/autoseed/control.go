@@ -15,6 +15,7 @@ import (
 	networkv1directory "github.com/MemeLabs/strims/pkg/apis/network/v1/directory"
 	"github.com/MemeLabs/strims/pkg/logutil"
 	"github.com/MemeLabs/strims/pkg/ppspp"
+	"github.com/MemeLabs/strims/pkg/ppspp/store"
 	"go.uber.org/zap"
 )
 
@@ -225,13 +226,18 @@ func (c *control) startSwarm(r *runner, uri *ppspp.URI) transfer.ID {
 	if !ok {
 		opt := uri.Options.SwarmOptions()
 		opt.LiveWindow = (32 * 1024 * 1024) / opt.ChunkSize
+		if c.config.DiskBuffer {
+			opt.BufferLayout = store.DiskBufferLayout
+			opt.BufferDir = c.config.BufferDir
+		}
 
 		swarm, err := ppspp.NewSwarm(uri.ID, opt)
 		if err != nil {
+			c.logger.Debug("creating swarm failed", zap.Stringer("swarm", uri.ID), zap.Error(err))
 			return transfer.NilID
 		}
 
-		transferID = c.transfer.Add(swarm, r.salt)
+		transferID = c.transfer.AddPersistent(swarm, r.salt)
 	}
 
 	c.logger.Debug(
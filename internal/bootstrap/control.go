@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/url"
 	"sync/atomic"
 	"time"
@@ -23,6 +24,7 @@ import (
 	"github.com/MemeLabs/strims/pkg/vnic"
 	"github.com/MemeLabs/strims/pkg/vpn"
 	"github.com/avast/retry-go"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 )
 
@@ -169,6 +171,12 @@ func (t *control) startClient(client *networkv1bootstrap.BootstrapClient) {
 			switch opt := client.ClientOptions.(type) {
 			case *networkv1bootstrap.BootstrapClient_WebsocketOptions:
 				peer, err = t.startWSClient(opt.WebsocketOptions)
+			case *networkv1bootstrap.BootstrapClient_TcpOptions:
+				peer, err = t.vpn.VNIC().Dial(opt.TcpOptions.Url)
+			case *networkv1bootstrap.BootstrapClient_UdpOptions:
+				peer, err = t.vpn.VNIC().Dial(opt.UdpOptions.Url)
+			case *networkv1bootstrap.BootstrapClient_DnsOptions:
+				peer, err = t.startDNSClient(opt.DnsOptions)
 			}
 			return err
 		},
@@ -204,6 +212,23 @@ func (t *control) startWSClient(opt *networkv1bootstrap.BootstrapClientWebSocket
 	return t.vpn.VNIC().Dial(u.String())
 }
 
+func (t *control) startDNSClient(opt *networkv1bootstrap.BootstrapClientDNSOptions) (*vnic.Peer, error) {
+	urls, err := resolveDNSBootstrapURLs(t.ctx, net.DefaultResolver, opt.Name, opt.InsecureSkipVerifyTls)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	for _, u := range urls {
+		peer, err := t.vpn.VNIC().Dial(u)
+		if err == nil {
+			return peer, nil
+		}
+		errs = append(errs, err)
+	}
+	return nil, multierr.Combine(errs...)
+}
+
 // PublishingEnabled ...
 func (t *control) PublishingEnabled() bool {
 	return t.enablePublishing.Load()
@@ -0,0 +1,88 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"go.uber.org/multierr"
+)
+
+const (
+	dnsBootstrapURLPrefix = "strims-bootstrap="
+	dnsBootstrapKeyPrefix = "strims-key="
+	dnsBootstrapService   = "strims"
+	dnsBootstrapProto     = "tcp"
+)
+
+var errNoDNSBootstrapURLs = errors.New("no bootstrap urls found")
+
+type dnsResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+	LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+}
+
+// resolveDNSBootstrapURLs returns the bootstrap urls published for name in
+// random order.
+func resolveDNSBootstrapURLs(ctx context.Context, r dnsResolver, name string, insecureSkipVerifyTLS bool) ([]string, error) {
+	var urls []string
+	var errs []error
+
+	txts, err := r.LookupTXT(ctx, name)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("txt lookup failed: %w", err))
+	}
+	for _, txt := range txts {
+		if strings.HasPrefix(txt, dnsBootstrapURLPrefix) {
+			u, err := url.Parse(strings.TrimPrefix(txt, dnsBootstrapURLPrefix))
+			if err != nil {
+				continue
+			}
+			if insecureSkipVerifyTLS && u.Scheme == "wss" {
+				u.Fragment = "insecure"
+			}
+			urls = append(urls, u.String())
+		}
+	}
+
+	_, srvs, err := r.LookupSRV(ctx, dnsBootstrapService, dnsBootstrapProto, name)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("srv lookup failed: %w", err))
+	}
+	for _, srv := range srvs {
+		txts, err := r.LookupTXT(ctx, srv.Target)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("srv target txt lookup failed: %w", err))
+			continue
+		}
+		for _, txt := range txts {
+			if strings.HasPrefix(txt, dnsBootstrapKeyPrefix) {
+				u := url.URL{
+					Scheme: "tcp",
+					Host:   net.JoinHostPort(strings.TrimSuffix(srv.Target, "."), strconv.Itoa(int(srv.Port))),
+					Path:   "/" + strings.TrimPrefix(txt, dnsBootstrapKeyPrefix),
+				}
+				urls = append(urls, u.String())
+				break
+			}
+		}
+	}
+
+	if len(urls) == 0 {
+		if len(errs) != 0 {
+			return nil, multierr.Combine(errs...)
+		}
+		return nil, errNoDNSBootstrapURLs
+	}
+
+	rand.Shuffle(len(urls), func(i, j int) { urls[i], urls[j] = urls[j], urls[i] })
+	return urls, nil
+}
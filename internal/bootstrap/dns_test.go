@@ -0,0 +1,54 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockDNSResolver struct {
+	txt map[string][]string
+	srv []*net.SRV
+}
+
+func (r *mockDNSResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	if txt, ok := r.txt[name]; ok {
+		return txt, nil
+	}
+	return nil, errors.New("not found")
+}
+
+func (r *mockDNSResolver) LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error) {
+	return "", r.srv, nil
+}
+
+func TestResolveDNSBootstrapURLs(t *testing.T) {
+	r := &mockDNSResolver{
+		txt: map[string][]string{
+			"seeds.example.com": {
+				"v=spf1 -all",
+				"strims-bootstrap=wss://a.example.com/abcd",
+			},
+			"b.example.com.": {
+				"strims-key=ef01",
+			},
+		},
+		srv: []*net.SRV{{Target: "b.example.com.", Port: 8083}},
+	}
+
+	urls, err := resolveDNSBootstrapURLs(context.Background(), r, "seeds.example.com", true)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{
+		"wss://a.example.com/abcd#insecure",
+		"tcp://b.example.com:8083/ef01",
+	}, urls)
+
+	_, err = resolveDNSBootstrapURLs(context.Background(), &mockDNSResolver{}, "seeds.example.com", false)
+	assert.Error(t, err)
+}
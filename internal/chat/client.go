@@ -35,49 +35,26 @@ func newChatReader(
 		return nil, err
 	}
 
-	cache, err := dao.GetSwarmCache(store, key, AssetsSwarmSalt)
-	if err == nil {
-		if err := assetSwarm.ImportCache(cache); err != nil {
-			logger.Debug("cache import failed", zap.Error(err))
-		} else {
-			logger.Debug(
-				"imported chat asset cache",
-				zap.Stringer("swarm", assetSwarm.ID()),
-				zap.Int("size", len(cache.Data)),
-			)
-		}
-	}
-
 	return &chatReader{
-		logger:          logger,
-		store:           store,
-		transfer:        transfer,
-		key:             key,
-		networkKey:      networkKey,
-		eventSwarm:      eventSwarm,
-		assetSwarm:      assetSwarm,
-		checkpointCache: make(chan struct{}, 1),
+		logger:     logger,
+		store:      store,
+		transfer:   transfer,
+		key:        key,
+		networkKey: networkKey,
+		eventSwarm: eventSwarm,
+		assetSwarm: assetSwarm,
 	}, nil
 }
 
 type chatReader struct {
-	logger          *zap.Logger
-	store           dao.Store
-	transfer        transfer.Control
-	key             []byte
-	networkKey      []byte
-	eventSwarm      *ppspp.Swarm
-	assetSwarm      *ppspp.Swarm
-	checkpointCache chan struct{}
-	stopper         servicemanager.Stopper
-}
-
-func (d *chatReader) exportCache() error {
-	cache, err := d.assetSwarm.ExportCache()
-	if err != nil {
-		return err
-	}
-	return dao.SetSwarmCache(d.store, d.assetSwarm.ID(), AssetsSwarmSalt, cache)
+	logger     *zap.Logger
+	store      dao.Store
+	transfer   transfer.Control
+	key        []byte
+	networkKey []byte
+	eventSwarm *ppspp.Swarm
+	assetSwarm *ppspp.Swarm
+	stopper    servicemanager.Stopper
 }
 
 func (d *chatReader) Run(ctx context.Context) error {
@@ -85,21 +62,11 @@ func (d *chatReader) Run(ctx context.Context) error {
 	defer done()
 
 	eventTransferID := d.transfer.Add(d.eventSwarm, EventsSwarmSalt)
-	assetTransferID := d.transfer.Add(d.assetSwarm, AssetsSwarmSalt)
+	assetTransferID := d.transfer.AddPersistent(d.assetSwarm, AssetsSwarmSalt)
 	d.transfer.Publish(eventTransferID, d.networkKey)
 	d.transfer.Publish(assetTransferID, d.networkKey)
 
-CheckpointLoop:
-	for {
-		select {
-		case <-d.checkpointCache:
-			if err := d.exportCache(); err != nil {
-				d.logger.Debug("cache export failed", zap.Error(err))
-			}
-		case <-ctx.Done():
-			break CheckpointLoop
-		}
-	}
+	<-ctx.Done()
 
 	d.transfer.Remove(eventTransferID)
 	d.transfer.Remove(assetTransferID)
@@ -126,8 +93,7 @@ func (d *chatReader) Reader(ctx context.Context) (readers, error) {
 	eventReader.Unread()
 	assetReader.Unread()
 	return readers{
-		events:          protoutil.NewChunkStreamReader(eventReader, eventChunkSize),
-		assets:          protoutil.NewChunkStreamReader(assetReader, assetChunkSize),
-		checkpointCache: d.checkpointCache,
+		events: protoutil.NewChunkStreamReader(eventReader, eventChunkSize),
+		assets: protoutil.NewChunkStreamReader(assetReader, assetChunkSize),
 	}, nil
 }
@@ -235,8 +235,6 @@ func (t *control) ReadServer(ctx context.Context, networkKey, key []byte) (<-cha
 						return fmt.Errorf("reading asset bundle: %w", err)
 					}
 
-					readers.CheckpointCache()
-
 					select {
 					case assets <- b:
 					case <-rctx.Done():
@@ -62,15 +62,7 @@ type runner struct {
 }
 
 type readers struct {
-	events, assets  *protoutil.ChunkStreamReader
-	checkpointCache chan struct{}
-}
-
-func (r readers) CheckpointCache() {
-	select {
-	case r.checkpointCache <- struct{}{}:
-	default:
-	}
+	events, assets *protoutil.ChunkStreamReader
 }
 
 type runnerAdapter struct {
@@ -120,7 +120,11 @@ func (s *whisperDeliveryService) send1(r *chatv1.WhisperRecord) error {
 	ctx, cancel := context.WithTimeout(context.Background(), whisperDeliveryTimeout)
 	defer cancel()
 
-	client, err := s.dialer.Client(ctx, r.NetworkKey, r.PeerKey, WhisperAddressSalt)
+	newClient := s.dialer.Client
+	if r.Anonymous {
+		newClient = s.dialer.AnonymousClient
+	}
+	client, err := newClient(ctx, r.NetworkKey, r.PeerKey, WhisperAddressSalt)
 	if err != nil {
 		return fmt.Errorf("opening client: %w", err)
 	}
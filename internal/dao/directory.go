@@ -23,6 +23,15 @@ const (
 	directoryUserRecordNS
 	directoryUserRecordPeerKeyNS
 	directoryUserRecordNetworkNS
+	directoryScheduledListingRecordNS
+	directoryScheduledListingRecordNetworkNS
+	directoryListingSubscriptionNS
+	directoryListingSubscriptionNetworkNS
+	directoryListingSessionRecordNS
+	directoryListingSessionRecordNetworkNS
+	directoryListingSessionRecordListingNS
+	directoryListingSessionRecordPublisherNS
+	directoryListingSessionRecordDayNS
 )
 
 var DirectoryListingRecords = NewTable[networkv1directory.ListingRecord](directoryListingRecordNS, nil)
@@ -193,3 +202,138 @@ func NewDirectoryUserRecord(s IDGenerator, networkID uint64, peerKey []byte) (*n
 		Moderation: &networkv1directory.UserModeration{},
 	}, nil
 }
+
+var DirectoryScheduledListingRecords = NewTable[networkv1directory.ScheduledListingRecord](directoryScheduledListingRecordNS, nil)
+
+var DirectoryScheduledListingRecordsByNetwork = ManyToOne(
+	directoryScheduledListingRecordNetworkNS,
+	DirectoryScheduledListingRecords,
+	Networks,
+	(*networkv1directory.ScheduledListingRecord).GetNetworkId,
+	&ManyToOneOptions[networkv1directory.ScheduledListingRecord, *networkv1directory.ScheduledListingRecord]{CascadeDelete: true},
+)
+
+func NewDirectoryScheduledListingRecord(
+	s IDGenerator,
+	networkID uint64,
+	listing *networkv1directory.Listing,
+	snippet *networkv1directory.ListingSnippet,
+	publisherKey []byte,
+	startTime int64,
+) (*networkv1directory.ScheduledListingRecord, error) {
+	id, err := s.GenerateID()
+	if err != nil {
+		return nil, err
+	}
+	return &networkv1directory.ScheduledListingRecord{
+		Id:           id,
+		NetworkId:    networkID,
+		Listing:      listing,
+		Snippet:      snippet,
+		PublisherKey: publisherKey,
+		StartTime:    startTime,
+	}, nil
+}
+
+var DirectoryListingSubscriptions = NewTable[networkv1directory.ListingSubscription](directoryListingSubscriptionNS, nil)
+
+var DirectoryListingSubscriptionsByNetwork = ManyToOne(
+	directoryListingSubscriptionNetworkNS,
+	DirectoryListingSubscriptions,
+	Networks,
+	(*networkv1directory.ListingSubscription).GetNetworkId,
+	&ManyToOneOptions[networkv1directory.ListingSubscription, *networkv1directory.ListingSubscription]{CascadeDelete: true},
+)
+
+func NewDirectoryListingSubscription(s IDGenerator, networkID uint64, listing *networkv1directory.Listing, title string) (*networkv1directory.ListingSubscription, error) {
+	id, err := s.GenerateID()
+	if err != nil {
+		return nil, err
+	}
+	return &networkv1directory.ListingSubscription{
+		Id:        id,
+		NetworkId: networkID,
+		Listing:   listing,
+		Title:     title,
+	}, nil
+}
+
+var DirectoryListingSessionRecords = NewTable[networkv1directory.ListingSessionRecord](directoryListingSessionRecordNS, nil)
+
+var DirectoryListingSessionRecordsByNetwork = ManyToOne(
+	directoryListingSessionRecordNetworkNS,
+	DirectoryListingSessionRecords,
+	Networks,
+	(*networkv1directory.ListingSessionRecord).GetNetworkId,
+	&ManyToOneOptions[networkv1directory.ListingSessionRecord, *networkv1directory.ListingSessionRecord]{CascadeDelete: true},
+)
+
+func directoryListingSessionListingKey(m *networkv1directory.ListingSessionRecord) []byte {
+	return FormatDirectoryListingRecordListingKey(m.NetworkId, m.Listing)
+}
+
+var DirectoryListingSessionRecordsByListing = NewSecondaryIndex(
+	directoryListingSessionRecordListingNS,
+	DirectoryListingSessionRecords,
+	directoryListingSessionListingKey,
+	byteIdentity,
+	nil,
+)
+
+func directoryListingSessionPublisherKey(m *networkv1directory.ListingSessionRecord) []byte {
+	return FormatDirectoryUserRecordPeerKeyKey(m.NetworkId, m.PublisherKey)
+}
+
+var DirectoryListingSessionRecordsByPublisher = NewSecondaryIndex(
+	directoryListingSessionRecordPublisherNS,
+	DirectoryListingSessionRecords,
+	directoryListingSessionPublisherKey,
+	byteIdentity,
+	nil,
+)
+
+// ListingSessionRecordDay returns the day bucket used to index session records
+// by their end time.
+func ListingSessionRecordDay(t int64) int64 {
+	return t / (24 * 60 * 60)
+}
+
+func FormatDirectoryListingSessionRecordDayKey(networkID uint64, day int64) []byte {
+	b := make([]byte, 16)
+	binary.BigEndian.PutUint64(b, networkID)
+	binary.BigEndian.PutUint64(b[8:], uint64(day))
+	return b
+}
+
+func directoryListingSessionDayKey(m *networkv1directory.ListingSessionRecord) []byte {
+	return FormatDirectoryListingSessionRecordDayKey(m.NetworkId, ListingSessionRecordDay(m.EndTime))
+}
+
+var DirectoryListingSessionRecordsByDay = NewSecondaryIndex(
+	directoryListingSessionRecordDayNS,
+	DirectoryListingSessionRecords,
+	directoryListingSessionDayKey,
+	byteIdentity,
+	nil,
+)
+
+func NewDirectoryListingSessionRecord(
+	s IDGenerator,
+	networkID uint64,
+	listing *networkv1directory.Listing,
+	publisherKey []byte,
+	startTime int64,
+) (*networkv1directory.ListingSessionRecord, error) {
+	id, err := s.GenerateID()
+	if err != nil {
+		return nil, err
+	}
+	return &networkv1directory.ListingSessionRecord{
+		Id:           id,
+		NetworkId:    networkID,
+		Listing:      listing,
+		PublisherKey: publisherKey,
+		StartTime:    startTime,
+		EndTime:      startTime,
+	}, nil
+}
@@ -6,7 +6,11 @@ package dao
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/hex"
 	"errors"
+	"net"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/MemeLabs/protobuf/pkg/rpc"
@@ -520,6 +524,12 @@ func FormatBootstrapClientClientOptionsKey(m *networkv1bootstrap.BootstrapClient
 	switch o := m.ClientOptions.(type) {
 	case *networkv1bootstrap.BootstrapClient_WebsocketOptions:
 		return []byte(o.WebsocketOptions.Url)
+	case *networkv1bootstrap.BootstrapClient_TcpOptions:
+		return []byte(o.TcpOptions.Url)
+	case *networkv1bootstrap.BootstrapClient_UdpOptions:
+		return []byte(o.UdpOptions.Url)
+	case *networkv1bootstrap.BootstrapClient_DnsOptions:
+		return []byte("dns:" + o.DnsOptions.Name)
 	default:
 		return nil
 	}
@@ -555,6 +565,115 @@ func NewWebSocketBootstrapClient(g IDGenerator, url string, insecureSkipVerifyTL
 	}, nil
 }
 
+// bootstrap url validation errors
+var (
+	ErrInvalidTCPBootstrapURL  = errors.New("invalid tcp bootstrap url")
+	ErrInvalidUDPBootstrapURL  = errors.New("invalid udp bootstrap url")
+	ErrInvalidDNSBootstrapName = errors.New("invalid dns bootstrap name")
+)
+
+// ValidateTCPBootstrapURL checks that uri has the form
+// tcp://<host>:<port>/<hex peer key>.
+func ValidateTCPBootstrapURL(uri string) error {
+	if !validPeerKeyURL(uri, "tcp") {
+		return ErrInvalidTCPBootstrapURL
+	}
+	return nil
+}
+
+// ValidateUDPBootstrapURL checks that uri has the form
+// udp://<host>:<port>/<hex peer key>.
+func ValidateUDPBootstrapURL(uri string) error {
+	if !validPeerKeyURL(uri, "udp") {
+		return ErrInvalidUDPBootstrapURL
+	}
+	return nil
+}
+
+func validPeerKeyURL(uri, scheme string) bool {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != scheme {
+		return false
+	}
+	if _, _, err := net.SplitHostPort(u.Host); err != nil {
+		return false
+	}
+	peerKey, err := hex.DecodeString(strings.TrimLeft(u.Path, "/"))
+	return err == nil && len(peerKey) == 32
+}
+
+// NewTCPBootstrapClient ...
+func NewTCPBootstrapClient(g IDGenerator, url string) (*networkv1bootstrap.BootstrapClient, error) {
+	if err := ValidateTCPBootstrapURL(url); err != nil {
+		return nil, err
+	}
+
+	id, err := g.GenerateID()
+	if err != nil {
+		return nil, err
+	}
+
+	return &networkv1bootstrap.BootstrapClient{
+		Id: id,
+		ClientOptions: &networkv1bootstrap.BootstrapClient_TcpOptions{
+			TcpOptions: &networkv1bootstrap.BootstrapClientTCPOptions{
+				Url: url,
+			},
+		},
+	}, nil
+}
+
+// NewUDPBootstrapClient ...
+func NewUDPBootstrapClient(g IDGenerator, url string) (*networkv1bootstrap.BootstrapClient, error) {
+	if err := ValidateUDPBootstrapURL(url); err != nil {
+		return nil, err
+	}
+
+	id, err := g.GenerateID()
+	if err != nil {
+		return nil, err
+	}
+
+	return &networkv1bootstrap.BootstrapClient{
+		Id: id,
+		ClientOptions: &networkv1bootstrap.BootstrapClient_UdpOptions{
+			UdpOptions: &networkv1bootstrap.BootstrapClientUDPOptions{
+				Url: url,
+			},
+		},
+	}, nil
+}
+
+// ValidateDNSBootstrapName checks that name is non-empty.
+func ValidateDNSBootstrapName(name string) error {
+	if name == "" {
+		return ErrInvalidDNSBootstrapName
+	}
+	return nil
+}
+
+// NewDNSBootstrapClient ...
+func NewDNSBootstrapClient(g IDGenerator, name string, insecureSkipVerifyTLS bool) (*networkv1bootstrap.BootstrapClient, error) {
+	if err := ValidateDNSBootstrapName(name); err != nil {
+		return nil, err
+	}
+
+	id, err := g.GenerateID()
+	if err != nil {
+		return nil, err
+	}
+
+	return &networkv1bootstrap.BootstrapClient{
+		Id: id,
+		ClientOptions: &networkv1bootstrap.BootstrapClient_DnsOptions{
+			DnsOptions: &networkv1bootstrap.BootstrapClientDNSOptions{
+				Name:                  name,
+				InsecureSkipVerifyTls: insecureSkipVerifyTLS,
+			},
+		},
+	}, nil
+}
+
 // NewBootstrapClient ...
 func NewBootstrapClient(g IDGenerator, bootstrapClient *networkv1bootstrap.BootstrapClient) (*networkv1bootstrap.BootstrapClient, error) {
 	id, err := g.GenerateID()
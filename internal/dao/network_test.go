@@ -0,0 +1,53 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTCPBootstrapURL(t *testing.T) {
+	peerKey := "0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20"
+
+	cases := []struct {
+		label string
+		url   string
+		valid bool
+	}{
+		{"valid", "tcp://127.0.0.1:8083/" + peerKey, true},
+		{"hostname", "tcp://example.com:8083/" + peerKey, true},
+		{"scheme", "udp://127.0.0.1:8083/" + peerKey, false},
+		{"missing port", "tcp://127.0.0.1/" + peerKey, false},
+		{"missing key", "tcp://127.0.0.1:8083", false},
+		{"short key", "tcp://127.0.0.1:8083/" + peerKey[:62], false},
+		{"non hex key", "tcp://127.0.0.1:8083/" + peerKey[:62] + "zz", false},
+		{"malformed", "tcp://[::1", false},
+	}
+	for _, c := range cases {
+		t.Run(c.label, func(t *testing.T) {
+			err := ValidateTCPBootstrapURL(c.url)
+			if c.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidTCPBootstrapURL)
+			}
+		})
+	}
+}
+
+func TestValidateUDPBootstrapURL(t *testing.T) {
+	peerKey := "0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20"
+
+	assert.NoError(t, ValidateUDPBootstrapURL("udp://127.0.0.1:8084/"+peerKey))
+	assert.ErrorIs(t, ValidateUDPBootstrapURL("tcp://127.0.0.1:8084/"+peerKey), ErrInvalidUDPBootstrapURL)
+	assert.ErrorIs(t, ValidateUDPBootstrapURL("udp://127.0.0.1/"+peerKey), ErrInvalidUDPBootstrapURL)
+	assert.ErrorIs(t, ValidateUDPBootstrapURL("udp://127.0.0.1:8084/"+peerKey[:62]), ErrInvalidUDPBootstrapURL)
+}
+
+func TestValidateDNSBootstrapName(t *testing.T) {
+	assert.NoError(t, ValidateDNSBootstrapName("bootstrap.example.com"))
+	assert.ErrorIs(t, ValidateDNSBootstrapName(""), ErrInvalidDNSBootstrapName)
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/MemeLabs/strims/pkg/chunkstream"
 	"github.com/MemeLabs/strims/pkg/ppspp"
 	"github.com/MemeLabs/strims/pkg/syncutil"
+	"github.com/MemeLabs/strims/pkg/vpn"
 	"github.com/golang/protobuf/proto"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -40,12 +41,14 @@ type Control interface {
 		networkKey []byte,
 	) (uint64, error)
 	StopMockStream(id uint64)
+	ReadRoutes(networkKey []byte) ([]vpn.Route, error)
 }
 
 // NewControl ...
 func NewControl(
 	ctx context.Context,
 	logger *zap.Logger,
+	vpn *vpn.Host,
 	store dao.Store,
 	observers *event.Observers,
 	transferControl transfer.Control,
@@ -54,6 +57,7 @@ func NewControl(
 	return &control{
 		ctx:       ctx,
 		logger:    logger,
+		vpn:       vpn,
 		store:     store,
 		transfer:  transferControl,
 		directory: directoryControl,
@@ -74,6 +78,7 @@ type mockStreamKey struct{ kind, id uint64 }
 type control struct {
 	ctx       context.Context
 	logger    *zap.Logger
+	vpn       *vpn.Host
 	store     dao.Store
 	transfer  transfer.Control
 	directory directory.Control
@@ -88,6 +93,13 @@ type control struct {
 func (c *control) Run() {
 	go c.loadConfig()
 
+	swarmMetrics := &swarmMetricsCollector{c.transfer}
+	if err := prometheus.Register(swarmMetrics); err != nil {
+		c.logger.Debug("registering swarm metrics failed", zap.Error(err))
+	} else {
+		defer prometheus.Unregister(swarmMetrics)
+	}
+
 	for {
 		select {
 		case e := <-c.events:
@@ -146,6 +158,15 @@ func (c *control) StopMockStream(id uint64) {
 	}
 }
 
+// ReadRoutes ...
+func (c *control) ReadRoutes(networkKey []byte) ([]vpn.Route, error) {
+	node, ok := c.vpn.Node(networkKey)
+	if !ok {
+		return nil, vpn.ErrNetworkNotFound
+	}
+	return node.Network.Routes(), nil
+}
+
 func (c *control) loadConfig() {
 	config, err := dao.DebugConfig.Get(c.store)
 	if err != nil {
@@ -0,0 +1,138 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package debug
+
+import (
+	"github.com/MemeLabs/strims/internal/transfer"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	swarmLabels     = []string{"swarm_id", "label"}
+	swarmPeerLabels = []string{"swarm_id", "label", "peer_id"}
+
+	swarmReadBytesDesc = prometheus.NewDesc(
+		"strims_transfer_swarm_read_bytes_total",
+		"The total number of data bytes received per swarm",
+		swarmLabels, nil,
+	)
+	swarmWriteBytesDesc = prometheus.NewDesc(
+		"strims_transfer_swarm_write_bytes_total",
+		"The total number of data bytes sent per swarm",
+		swarmLabels, nil,
+	)
+	swarmPeerReadBytesDesc = prometheus.NewDesc(
+		"strims_transfer_swarm_peer_read_bytes_total",
+		"The total number of data bytes received per swarm peer",
+		swarmPeerLabels, nil,
+	)
+	swarmPeerWriteBytesDesc = prometheus.NewDesc(
+		"strims_transfer_swarm_peer_write_bytes_total",
+		"The total number of data bytes sent per swarm peer",
+		swarmPeerLabels, nil,
+	)
+	swarmPeerRTTDesc = prometheus.NewDesc(
+		"strims_transfer_swarm_peer_rtt_seconds",
+		"The mean data request round trip time per swarm peer",
+		swarmPeerLabels, nil,
+	)
+	swarmPeerRTTVarDesc = prometheus.NewDesc(
+		"strims_transfer_swarm_peer_rtt_var_seconds",
+		"The data request round trip time variance per swarm peer",
+		swarmPeerLabels, nil,
+	)
+	swarmPeerCWNDDesc = prometheus.NewDesc(
+		"strims_transfer_swarm_peer_cwnd_bytes",
+		"The LEDBAT congestion window per swarm peer",
+		swarmPeerLabels, nil,
+	)
+	swarmPeerFlightSizeDesc = prometheus.NewDesc(
+		"strims_transfer_swarm_peer_flight_size_bytes",
+		"The number of requested bytes in flight per swarm peer",
+		swarmPeerLabels, nil,
+	)
+	swarmPeerRequestedChunksDesc = prometheus.NewDesc(
+		"strims_transfer_swarm_peer_requested_chunks_total",
+		"The total number of chunks requested per swarm peer",
+		swarmPeerLabels, nil,
+	)
+	swarmPeerReceivedChunksDesc = prometheus.NewDesc(
+		"strims_transfer_swarm_peer_received_chunks_total",
+		"The total number of valid chunks received per swarm peer",
+		swarmPeerLabels, nil,
+	)
+	swarmPeerInvalidChunksDesc = prometheus.NewDesc(
+		"strims_transfer_swarm_peer_invalid_chunks_total",
+		"The total number of chunks that failed integrity checks per swarm peer",
+		swarmPeerLabels, nil,
+	)
+	swarmPeerChokedDesc = prometheus.NewDesc(
+		"strims_transfer_swarm_peer_choked",
+		"Whether the peer is choking us",
+		swarmPeerLabels, nil,
+	)
+	swarmPeerPeerChokedDesc = prometheus.NewDesc(
+		"strims_transfer_swarm_peer_peer_choked",
+		"Whether we are choking the peer",
+		swarmPeerLabels, nil,
+	)
+)
+
+// swarmMetricsCollector exports transfer swarm and peer stats to prometheus.
+type swarmMetricsCollector struct {
+	transfer transfer.Control
+}
+
+// Describe implements prometheus.Collector
+func (c *swarmMetricsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- swarmReadBytesDesc
+	ch <- swarmWriteBytesDesc
+	ch <- swarmPeerReadBytesDesc
+	ch <- swarmPeerWriteBytesDesc
+	ch <- swarmPeerRTTDesc
+	ch <- swarmPeerRTTVarDesc
+	ch <- swarmPeerCWNDDesc
+	ch <- swarmPeerFlightSizeDesc
+	ch <- swarmPeerRequestedChunksDesc
+	ch <- swarmPeerReceivedChunksDesc
+	ch <- swarmPeerInvalidChunksDesc
+	ch <- swarmPeerChokedDesc
+	ch <- swarmPeerPeerChokedDesc
+}
+
+// Collect implements prometheus.Collector
+func (c *swarmMetricsCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, m := range c.transfer.SwarmMetrics(timeutil.Now()) {
+		swarmID := m.Swarm.ID().String()
+		label := m.Swarm.Options().Label
+
+		ch <- prometheus.MustNewConstMetric(swarmReadBytesDesc, prometheus.CounterValue, float64(m.Read.Count), swarmID, label)
+		ch <- prometheus.MustNewConstMetric(swarmWriteBytesDesc, prometheus.CounterValue, float64(m.Write.Count), swarmID, label)
+
+		for _, p := range m.Peers {
+			peerID := p.HostID.String()
+			s := p.Scheduler
+
+			ch <- prometheus.MustNewConstMetric(swarmPeerReadBytesDesc, prometheus.CounterValue, float64(p.Read.Count), swarmID, label, peerID)
+			ch <- prometheus.MustNewConstMetric(swarmPeerWriteBytesDesc, prometheus.CounterValue, float64(p.Write.Count), swarmID, label, peerID)
+			ch <- prometheus.MustNewConstMetric(swarmPeerRTTDesc, prometheus.GaugeValue, s.RTT.Seconds(), swarmID, label, peerID)
+			ch <- prometheus.MustNewConstMetric(swarmPeerRTTVarDesc, prometheus.GaugeValue, s.RTTVar.Seconds(), swarmID, label, peerID)
+			ch <- prometheus.MustNewConstMetric(swarmPeerCWNDDesc, prometheus.GaugeValue, float64(s.CWND), swarmID, label, peerID)
+			ch <- prometheus.MustNewConstMetric(swarmPeerFlightSizeDesc, prometheus.GaugeValue, float64(s.FlightSize), swarmID, label, peerID)
+			ch <- prometheus.MustNewConstMetric(swarmPeerRequestedChunksDesc, prometheus.CounterValue, float64(s.RequestedChunks), swarmID, label, peerID)
+			ch <- prometheus.MustNewConstMetric(swarmPeerReceivedChunksDesc, prometheus.CounterValue, float64(s.ReceivedChunks), swarmID, label, peerID)
+			ch <- prometheus.MustNewConstMetric(swarmPeerInvalidChunksDesc, prometheus.CounterValue, float64(s.InvalidChunks), swarmID, label, peerID)
+			ch <- prometheus.MustNewConstMetric(swarmPeerChokedDesc, prometheus.GaugeValue, boolToFloat(s.Choked), swarmID, label, peerID)
+			ch <- prometheus.MustNewConstMetric(swarmPeerPeerChokedDesc, prometheus.GaugeValue, boolToFloat(s.PeerChoked), swarmID, label, peerID)
+		}
+	}
+}
+
+func boolToFloat(v bool) float64 {
+	if v {
+		return 1
+	}
+	return 0
+}
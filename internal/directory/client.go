@@ -51,7 +51,7 @@ func (d *directoryReader) Run(ctx context.Context) error {
 	defer done()
 
 	eventTransferID := d.transfer.Add(d.eventSwarm, EventSwarmSalt)
-	assetTransferID := d.transfer.Add(d.assetSwarm, AssetSwarmSalt)
+	assetTransferID := d.transfer.AddPersistent(d.assetSwarm, AssetSwarmSalt)
 	d.transfer.Publish(eventTransferID, d.key)
 	d.transfer.Publish(assetTransferID, d.key)
 
@@ -16,9 +16,11 @@ import (
 	"github.com/MemeLabs/strims/internal/event"
 	"github.com/MemeLabs/strims/internal/network"
 	"github.com/MemeLabs/strims/internal/network/dialer"
+	"github.com/MemeLabs/strims/internal/notification"
 	"github.com/MemeLabs/strims/internal/transfer"
 	networkv1 "github.com/MemeLabs/strims/pkg/apis/network/v1"
 	networkv1directory "github.com/MemeLabs/strims/pkg/apis/network/v1/directory"
+	notificationv1 "github.com/MemeLabs/strims/pkg/apis/notification/v1"
 	"github.com/MemeLabs/strims/pkg/hashmap"
 	"github.com/MemeLabs/strims/pkg/logutil"
 	"github.com/MemeLabs/strims/pkg/ppspp"
@@ -27,11 +29,13 @@ import (
 	"go.uber.org/zap/zapcore"
 	"golang.org/x/exp/maps"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
 )
 
 // errors ...
 var (
-	ErrNetworkNotFound = errors.New("network not found")
+	ErrNetworkNotFound    = errors.New("network not found")
+	ErrListingNotUpcoming = errors.New("listing is not upcoming")
 )
 
 type Listing struct {
@@ -41,6 +45,8 @@ type Listing struct {
 	Moderation      *networkv1directory.ListingModeration
 	UserCount       uint32
 	RecentUserCount uint32
+	Upcoming        bool
+	Provenance      *networkv1directory.ListingProvenance
 }
 
 func (l Listing) MarshalLogObject(e zapcore.ObjectEncoder) error {
@@ -106,12 +112,18 @@ type Control interface {
 	Run()
 	PushSnippet(swarmID ppspp.SwarmID, snippet *networkv1directory.ListingSnippet)
 	DeleteSnippet(swarmID ppspp.SwarmID)
-	Publish(ctx context.Context, listing *networkv1directory.Listing, networkKey []byte) (uint64, error)
-	Unpublish(ctx context.Context, id uint64, networkKey []byte) error
+	Publish(ctx context.Context, listing *networkv1directory.Listing, networkKey []byte, opts ...PublishOption) (uint64, error)
+	Unpublish(ctx context.Context, id uint64, networkKey []byte, opts ...PublishOption) error
 	Join(ctx context.Context, query *networkv1directory.ListingQuery, networkKey []byte) (uint64, error)
 	Part(ctx context.Context, id uint64, networkKey []byte) error
+	Schedule(ctx context.Context, listing *networkv1directory.Listing, snippet *networkv1directory.ListingSnippet, startTime int64, networkKey []byte) (uint64, error)
+	Unschedule(ctx context.Context, id uint64, networkKey []byte) error
+	SubscribeListing(query *networkv1directory.ListingQuery, networkKey []byte) (*networkv1directory.ListingSubscription, error)
+	UnsubscribeListing(id uint64) error
+	GetListingSubscriptions() ([]*networkv1directory.ListingSubscription, error)
 	ModerateListing(ctx context.Context, id uint64, moderation *networkv1directory.ListingModeration, networkKey []byte) error
 	ModerateUser(ctx context.Context, peerKey []byte, moderation *networkv1directory.UserModeration, networkKey []byte) error
+	GetListingStats(ctx context.Context, req *networkv1directory.GetListingStatsRequest, networkKey []byte) (*networkv1directory.GetListingStatsResponse, error)
 	GetListingsByPeerKey(peerKey []byte) []NetworkListings
 	GetUsersByNetworkID(id uint64) []User
 	GetListingsByNetworkID(id uint64) []Listing
@@ -132,22 +144,25 @@ func NewControl(
 	observers *event.Observers,
 	network network.Control,
 	transfer transfer.Control,
+	notification notification.Control,
 ) Control {
 	snippets := &snippetMap{}
 
 	return &control{
-		ctx:       ctx,
-		logger:    logger,
-		vpn:       vpn,
-		store:     store,
-		observers: observers,
-		network:   network,
-		transfer:  transfer,
-		events:    observers.Chan(),
+		ctx:          ctx,
+		logger:       logger,
+		vpn:          vpn,
+		store:        store,
+		observers:    observers,
+		network:      network,
+		transfer:     transfer,
+		notification: notification,
+		events:       observers.Chan(),
 
 		runners:               hashmap.New[[]byte, *runner](hashmap.NewByteInterface[[]byte]()),
 		syndicateStores:       map[uint64]*syndicateStore{},
 		assetBundleEventCache: map[uint64]AssetBundleEvent{},
+		subscriptions:         hashmap.New[[]byte, *networkv1directory.ListingSubscription](hashmap.NewByteInterface[[]byte]()),
 
 		snippets: snippets,
 		snippetServer: &snippetServer{
@@ -161,19 +176,21 @@ func NewControl(
 
 // Control ...
 type control struct {
-	ctx       context.Context
-	logger    *zap.Logger
-	vpn       *vpn.Host
-	store     dao.Store
-	observers *event.Observers
-	network   network.Control
-	transfer  transfer.Control
+	ctx          context.Context
+	logger       *zap.Logger
+	vpn          *vpn.Host
+	store        dao.Store
+	observers    *event.Observers
+	network      network.Control
+	transfer     transfer.Control
+	notification notification.Control
 
 	events                chan any
 	lock                  sync.Mutex
 	runners               hashmap.Map[[]byte, *runner]
 	syndicateStores       map[uint64]*syndicateStore
 	assetBundleEventCache map[uint64]AssetBundleEvent
+	subscriptions         hashmap.Map[[]byte, *networkv1directory.ListingSubscription]
 
 	snippets      *snippetMap
 	snippetServer *snippetServer
@@ -184,6 +201,10 @@ func (t *control) Run() {
 	pingTimer := time.NewTimer(pingStartupDelay)
 	defer pingTimer.Stop()
 
+	if err := t.loadSubscriptions(); err != nil {
+		t.logger.Warn("loading listing subscriptions failed", zap.Error(err))
+	}
+
 	for {
 		select {
 		case e := <-t.events:
@@ -213,7 +234,7 @@ func (t *control) handleNetworkStart(network *networkv1.Network) {
 	t.lock.Lock()
 	defer t.lock.Unlock()
 
-	r, err := newRunner(t.ctx, logger, t.vpn, t.store, t.observers, t.network.Dialer(), t.transfer, network)
+	r, err := newRunner(t.ctx, logger, t.vpn, t.store, t.observers, t.network.Dialer(), t.transfer, t, network)
 	if err != nil {
 		logger.Error("failed to start directory runner", zap.Error(err))
 		return
@@ -257,6 +278,7 @@ func (t *control) handleNetworkStart(network *networkv1.Network) {
 						return nil
 					}
 
+					t.notifySubscribers(network, s, b)
 					s.HandleEvent(b)
 
 					t.observers.EmitLocal(event.DirectoryEvent{
@@ -308,6 +330,67 @@ func (t *control) handleNetworkStart(network *networkv1.Network) {
 	}()
 }
 
+func (t *control) loadSubscriptions() error {
+	subscriptions, err := dao.DirectoryListingSubscriptions.GetAll(t.store)
+	if err != nil {
+		return err
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for _, s := range subscriptions {
+		t.subscriptions.Set(dao.FormatDirectoryListingRecordListingKey(s.NetworkId, s.Listing), s)
+	}
+	return nil
+}
+
+// notifySubscribers dispatches a notification for each subscribed listing that
+// went from upcoming to live in b and removes the subscription. It must run
+// before b is applied to the syndicate store.
+func (t *control) notifySubscribers(network *networkv1.Network, s *syndicateStore, b *networkv1directory.EventBroadcast) {
+	for _, e := range b.Events {
+		c := e.GetListingChange()
+		if c == nil || c.Upcoming || !s.IsUpcoming(c.Id) {
+			continue
+		}
+
+		t.lock.Lock()
+		s, ok := t.subscriptions.Delete(dao.FormatDirectoryListingRecordListingKey(network.Id, c.Listing))
+		t.lock.Unlock()
+		if !ok {
+			continue
+		}
+
+		if err := dao.DirectoryListingSubscriptions.Delete(t.store, s.Id); err != nil {
+			t.logger.Warn("deleting listing subscription failed", zap.Error(err))
+		}
+
+		title := c.Snippet.GetTitle()
+		if title == "" {
+			title = s.Title
+		}
+
+		n, err := dao.NewNotification(
+			t.store,
+			notificationv1.Notification_STATUS_INFO,
+			fmt.Sprintf("%s is live", dao.CertificateRoot(network.Certificate).Subject),
+			dao.WithNotificationMessage(title),
+			dao.WithNotificationSubject(
+				notificationv1.Notification_Subject_NOTIFICATION_SUBJECT_MODEL_DIRECTORY_LISTING,
+				c.Id,
+			),
+		)
+		if err != nil {
+			t.logger.Warn("creating notification failed", zap.Error(err))
+			continue
+		}
+		if err := t.notification.Dispatch(n); err != nil {
+			t.logger.Warn("dispatching notification failed", zap.Error(err))
+		}
+	}
+}
+
 func (t *control) handleNetworkStop(network *networkv1.Network) {
 	t.lock.Lock()
 	defer t.lock.Unlock()
@@ -347,7 +430,14 @@ func (t *control) ping() {
 }
 
 func (t *control) pingDirectory(r *runner) {
-	c, dc, err := t.client(t.ctx, r.NetworkKey())
+	t.pingDirectoryWithClient(r, t.client)
+	if r.anonymousListings.Len() != 0 {
+		t.pingDirectoryWithClient(r, t.anonymousClient)
+	}
+}
+
+func (t *control) pingDirectoryWithClient(r *runner, client directoryClientFunc) {
+	c, dc, err := client(t.ctx, r.NetworkKey())
 	if err != nil {
 		r.Logger().Warn("directory ping failed", zap.Error(err))
 		return
@@ -360,6 +450,8 @@ func (t *control) pingDirectory(r *runner) {
 	}
 }
 
+type directoryClientFunc func(ctx context.Context, networkKey []byte) (*dialer.RPCClient, *networkv1directory.DirectoryClient, error)
+
 func (t *control) client(ctx context.Context, networkKey []byte) (*dialer.RPCClient, *networkv1directory.DirectoryClient, error) {
 	client, err := t.network.Dialer().Client(ctx, networkKey, networkKey, AddressSalt)
 	if err != nil {
@@ -369,6 +461,60 @@ func (t *control) client(ctx context.Context, networkKey []byte) (*dialer.RPCCli
 	return client, networkv1directory.NewDirectoryClient(client), nil
 }
 
+func (t *control) anonymousClient(ctx context.Context, networkKey []byte) (*dialer.RPCClient, *networkv1directory.DirectoryClient, error) {
+	client, err := t.network.Dialer().AnonymousClient(ctx, networkKey, networkKey, AddressSalt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return client, networkv1directory.NewDirectoryClient(client), nil
+}
+
+type publishOptions struct {
+	anonymous bool
+}
+
+// PublishOption ...
+type PublishOption func(*publishOptions)
+
+// WithAnonymousRouting sends publish requests through onion circuits so the
+// directory server does not learn the publisher's host id.
+func WithAnonymousRouting() PublishOption {
+	return func(o *publishOptions) {
+		o.anonymous = true
+	}
+}
+
+func newPublishOptions(opts []PublishOption) *publishOptions {
+	o := &publishOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+func (t *control) publishClient(ctx context.Context, networkKey []byte, o *publishOptions) (*dialer.RPCClient, *networkv1directory.DirectoryClient, error) {
+	if o.anonymous {
+		return t.anonymousClient(ctx, networkKey)
+	}
+	return t.client(ctx, networkKey)
+}
+
+// updateAnonymousListing tracks whether id was published anonymously so the
+// anonymous ping only runs while the network has anonymous listings.
+func (t *control) updateAnonymousListing(networkKey []byte, id uint64, anonymous bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if r, ok := t.runners.Get(networkKey); ok {
+		if anonymous {
+			r.anonymousListings.Insert(id)
+		} else {
+			r.anonymousListings.Delete(id)
+		}
+	}
+}
+
 // PushSnippet ...
 func (t *control) PushSnippet(swarmID ppspp.SwarmID, snippet *networkv1directory.ListingSnippet) {
 	t.snippets.Update(swarmID, snippet)
@@ -380,27 +526,37 @@ func (t *control) DeleteSnippet(swarmID ppspp.SwarmID) {
 }
 
 // Publish ...
-func (t *control) Publish(ctx context.Context, listing *networkv1directory.Listing, networkKey []byte) (uint64, error) {
-	c, dc, err := t.client(ctx, networkKey)
+func (t *control) Publish(ctx context.Context, listing *networkv1directory.Listing, networkKey []byte, opts ...PublishOption) (uint64, error) {
+	o := newPublishOptions(opts)
+	c, dc, err := t.publishClient(ctx, networkKey, o)
 	if err != nil {
 		return 0, err
 	}
 	defer c.Close()
 
 	res := &networkv1directory.PublishResponse{}
-	err = dc.Publish(ctx, &networkv1directory.PublishRequest{Listing: listing}, res)
-	return res.Id, err
+	if err := dc.Publish(ctx, &networkv1directory.PublishRequest{Listing: listing}, res); err != nil {
+		return 0, err
+	}
+	if o.anonymous {
+		t.updateAnonymousListing(networkKey, res.Id, true)
+	}
+	return res.Id, nil
 }
 
 // Unpublish ...
-func (t *control) Unpublish(ctx context.Context, id uint64, networkKey []byte) error {
-	c, dc, err := t.client(ctx, networkKey)
+func (t *control) Unpublish(ctx context.Context, id uint64, networkKey []byte, opts ...PublishOption) error {
+	c, dc, err := t.publishClient(ctx, networkKey, newPublishOptions(opts))
 	if err != nil {
 		return err
 	}
 	defer c.Close()
 
-	return dc.Unpublish(ctx, &networkv1directory.UnpublishRequest{Id: id}, &networkv1directory.UnpublishResponse{})
+	if err := dc.Unpublish(ctx, &networkv1directory.UnpublishRequest{Id: id}, &networkv1directory.UnpublishResponse{}); err != nil {
+		return err
+	}
+	t.updateAnonymousListing(networkKey, id, false)
+	return nil
 }
 
 // Join ...
@@ -430,6 +586,104 @@ func (t *control) Part(ctx context.Context, id uint64, networkKey []byte) error
 	return dc.Part(ctx, &networkv1directory.PartRequest{Id: id}, &networkv1directory.PartResponse{})
 }
 
+// Schedule ...
+func (t *control) Schedule(ctx context.Context, listing *networkv1directory.Listing, snippet *networkv1directory.ListingSnippet, startTime int64, networkKey []byte) (uint64, error) {
+	profile, err := dao.Profile.Get(t.store)
+	if err != nil {
+		return 0, err
+	}
+
+	if snippet == nil {
+		snippet = &networkv1directory.ListingSnippet{}
+	}
+	snippet = proto.Clone(snippet).(*networkv1directory.ListingSnippet)
+	snippet.StartTime = startTime
+	if err := dao.SignMessage(snippet, profile.Key); err != nil {
+		return 0, err
+	}
+
+	c, dc, err := t.client(ctx, networkKey)
+	if err != nil {
+		return 0, err
+	}
+	defer c.Close()
+
+	req := &networkv1directory.ScheduleRequest{
+		Listing:   listing,
+		Snippet:   snippet,
+		StartTime: startTime,
+	}
+	res := &networkv1directory.ScheduleResponse{}
+	err = dc.Schedule(ctx, req, res)
+	return res.Id, err
+}
+
+// Unschedule ...
+func (t *control) Unschedule(ctx context.Context, id uint64, networkKey []byte) error {
+	c, dc, err := t.client(ctx, networkKey)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	return dc.Unschedule(ctx, &networkv1directory.UnscheduleRequest{Id: id}, &networkv1directory.UnscheduleResponse{})
+}
+
+// SubscribeListing ...
+func (t *control) SubscribeListing(query *networkv1directory.ListingQuery, networkKey []byte) (*networkv1directory.ListingSubscription, error) {
+	networkID, err := dao.NetworksByKey.GetID(t.store, networkKey)
+	if err != nil {
+		return nil, err
+	}
+
+	l, ok := t.GetListingByQuery(networkID, query)
+	if !ok {
+		return nil, ErrListingNotFound
+	}
+	if !l.Upcoming {
+		return nil, ErrListingNotUpcoming
+	}
+
+	key := dao.FormatDirectoryListingRecordListingKey(networkID, l.Listing)
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if s, ok := t.subscriptions.Get(key); ok {
+		return s, nil
+	}
+
+	s, err := dao.NewDirectoryListingSubscription(t.store, networkID, l.Listing, l.Snippet.GetTitle())
+	if err != nil {
+		return nil, err
+	}
+	if err := dao.DirectoryListingSubscriptions.Insert(t.store, s); err != nil {
+		return nil, err
+	}
+	t.subscriptions.Set(key, s)
+
+	return s, nil
+}
+
+// UnsubscribeListing ...
+func (t *control) UnsubscribeListing(id uint64) error {
+	s, err := dao.DirectoryListingSubscriptions.Get(t.store, id)
+	if err != nil {
+		return err
+	}
+
+	t.lock.Lock()
+	t.subscriptions.Delete(dao.FormatDirectoryListingRecordListingKey(s.NetworkId, s.Listing))
+	t.lock.Unlock()
+
+	return dao.DirectoryListingSubscriptions.Delete(t.store, id)
+}
+
+// GetListingSubscriptions ...
+func (t *control) GetListingSubscriptions() ([]*networkv1directory.ListingSubscription, error) {
+	return dao.DirectoryListingSubscriptions.GetAll(t.store)
+}
+
 func (t *control) ModerateListing(ctx context.Context, id uint64, moderation *networkv1directory.ListingModeration, networkKey []byte) error {
 	c, dc, err := t.client(ctx, networkKey)
 	if err != nil {
@@ -444,6 +698,21 @@ func (t *control) ModerateListing(ctx context.Context, id uint64, moderation *ne
 	return dc.ModerateListing(ctx, req, &networkv1directory.ModerateListingResponse{})
 }
 
+// GetListingStats ...
+func (t *control) GetListingStats(ctx context.Context, req *networkv1directory.GetListingStatsRequest, networkKey []byte) (*networkv1directory.GetListingStatsResponse, error) {
+	c, dc, err := t.client(ctx, networkKey)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	res := &networkv1directory.GetListingStatsResponse{}
+	if err := dc.GetListingStats(ctx, req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func (t *control) ModerateUser(ctx context.Context, peerKey []byte, moderation *networkv1directory.UserModeration, networkKey []byte) error {
 	c, dc, err := t.client(ctx, networkKey)
 	if err != nil {
@@ -47,6 +47,20 @@ func newEmbedLoader(logger *zap.Logger, config *networkv1directory.ServerConfig_
 		}
 	}
 
+	if config.GetKick().GetEnable() {
+		l.loaders[networkv1directory.Listing_Embed_DIRECTORY_LISTING_EMBED_SERVICE_KICK] = &kickEmbedLoader{
+			baseURL: kickAPIBaseURL,
+		}
+	}
+
+	if config.GetVimeo().GetEnable() {
+		l.loaders[networkv1directory.Listing_Embed_DIRECTORY_LISTING_EMBED_SERVICE_VIMEO] = newVimeoEmbedLoader(config.Vimeo.AccessToken)
+	}
+
+	if config.GetOembed().GetEnable() {
+		l.loaders[networkv1directory.Listing_Embed_DIRECTORY_LISTING_EMBED_SERVICE_OEMBED] = newOEmbedEmbedLoader(config.Oembed.AllowedHosts)
+	}
+
 	return l
 }
 
@@ -0,0 +1,199 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package directory
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEmbedServer(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/kick/channels/live", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+			"slug": "live",
+			"user": {"username": "LiveUser", "profile_pic": "https://kick.test/logo.png"},
+			"offline_banner_image": {"src": "https://kick.test/banner.png"},
+			"livestream": {
+				"session_title": "live title",
+				"is_live": true,
+				"is_mature": true,
+				"viewer_count": 42,
+				"created_at": "2022-10-01 12:30:00",
+				"thumbnail": {"url": "https://kick.test/thumb.jpg"},
+				"categories": [{"name": "Just Chatting"}]
+			}
+		}`)
+	})
+	mux.HandleFunc("/kick/channels/offline", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+			"slug": "offline",
+			"user": {"username": "OfflineUser", "profile_pic": "https://kick.test/logo.png"},
+			"offline_banner_image": {"src": "https://kick.test/banner.png"},
+			"livestream": null
+		}`)
+	})
+
+	mux.HandleFunc("/vimeo/videos/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		status := "done"
+		switch r.URL.Path {
+		case "/vimeo/videos/12345":
+		case "/vimeo/videos/live":
+			status = "streaming"
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, `{
+			"name": "vimeo title",
+			"user": {"name": "vimeo author"},
+			"pictures": {"base_link": "https://vimeo.test/thumb.jpg"},
+			"live": {"status": %q},
+			"width": 1280,
+			"height": 720
+		}`, status)
+	})
+
+	mux.HandleFunc("/page/oembed", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `<html><head>
+			<link rel="alternate" type="application/json+oembed" href="/page/oembed.json">
+			<meta property="og:description" content="og description">
+		</head><body></body></html>`)
+	})
+	mux.HandleFunc("/page/oembed.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"type": "video", "title": "oembed title", "author_name": "oembed author", "thumbnail_url": "https://oembed.test/thumb.jpg"}`)
+	})
+	mux.HandleFunc("/page/opengraph", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `<html><head>
+			<meta property="og:title" content="og title">
+			<meta name="og:site_name" content="og site">
+			<meta property="og:type" content="video.other">
+			<meta property="og:image" content="https://og.test/thumb.jpg">
+		</head><body><meta property="og:title" content="ignored"></body></html>`)
+	})
+	mux.HandleFunc("/page/offsite-oembed", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `<html><head>
+			<link rel="alternate" type="application/json+oembed" href="http://example.com/oembed.json">
+		</head><body></body></html>`)
+	})
+	mux.HandleFunc("/page/redirect", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "http://example.com/page", http.StatusFound)
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestKickEmbedLoader(t *testing.T) {
+	srv := newTestEmbedServer(t)
+	loader := &kickEmbedLoader{baseURL: srv.URL + "/kick"}
+
+	res, err := loader.Load(context.Background(), []string{"live"})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, "live", res[0].id)
+	assert.True(t, res[0].snippet.Live)
+	assert.True(t, res[0].snippet.IsMature)
+	assert.Equal(t, "live title", res[0].snippet.Title)
+	assert.Equal(t, "LiveUser", res[0].snippet.ChannelName)
+	assert.Equal(t, "Just Chatting", res[0].snippet.Category)
+	assert.EqualValues(t, 42, res[0].snippet.UserCount)
+	assert.EqualValues(t, 1664627400, res[0].snippet.StartTime)
+	assert.Contains(t, res[0].snippet.Thumbnail.GetUrl(), "https://kick.test/thumb.jpg")
+
+	res, err = loader.Load(context.Background(), []string{"offline"})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.False(t, res[0].snippet.Live)
+	assert.Equal(t, "https://kick.test/banner.png", res[0].snippet.Thumbnail.GetUrl())
+
+	res, err = loader.Load(context.Background(), []string{"missing"})
+	assert.NoError(t, err)
+	assert.Empty(t, res)
+}
+
+func TestVimeoEmbedLoader(t *testing.T) {
+	srv := newTestEmbedServer(t)
+	loader := &vimeoEmbedLoader{
+		baseURL:     srv.URL + "/vimeo",
+		accessToken: "token",
+		client:      http.DefaultClient,
+	}
+
+	res, err := loader.Load(context.Background(), []string{"12345"})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, "vimeo title", res[0].snippet.Title)
+	assert.Equal(t, "vimeo author", res[0].snippet.ChannelName)
+	assert.Equal(t, "https://vimeo.test/thumb.jpg", res[0].snippet.Thumbnail.GetUrl())
+	assert.EqualValues(t, 1280, res[0].snippet.VideoWidth)
+	assert.False(t, res[0].snippet.Live)
+
+	res, err = loader.Load(context.Background(), []string{"live"})
+	require.NoError(t, err)
+	assert.True(t, res[0].snippet.Live)
+
+	res, err = loader.Load(context.Background(), []string{"missing"})
+	require.NoError(t, err)
+	assert.Empty(t, res)
+}
+
+func TestOEmbedEmbedLoader(t *testing.T) {
+	srv := newTestEmbedServer(t)
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	loader := &oEmbedEmbedLoader{AllowedHosts: []string{u.Hostname()}}
+	loader.client = loader.newHTTPClient(&net.Dialer{})
+
+	res, err := loader.Load(context.Background(), []string{srv.URL + "/page/oembed"})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, "oembed title", res[0].snippet.Title)
+	assert.Equal(t, "og description", res[0].snippet.Description)
+	assert.Equal(t, "oembed author", res[0].snippet.ChannelName)
+	assert.Equal(t, "https://oembed.test/thumb.jpg", res[0].snippet.Thumbnail.GetUrl())
+	assert.False(t, res[0].snippet.Live, "expected generic embeds not to be marked live")
+
+	res, err = loader.Load(context.Background(), []string{srv.URL + "/page/opengraph"})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, "og title", res[0].snippet.Title)
+	assert.Equal(t, "og site", res[0].snippet.ChannelName)
+	assert.Equal(t, "https://og.test/thumb.jpg", res[0].snippet.Thumbnail.GetUrl())
+	assert.False(t, res[0].snippet.Live, "expected generic embeds not to be marked live")
+
+	_, err = newOEmbedEmbedLoader([]string{"example.com"}).Load(context.Background(), []string{srv.URL + "/page/oembed"})
+	assert.ErrorIs(t, err, errOEmbedHostNotAllowed, "expected disallowed host to fail")
+
+	_, err = newOEmbedEmbedLoader(nil).Load(context.Background(), []string{srv.URL + "/page/oembed"})
+	assert.ErrorIs(t, err, errOEmbedHostNotAllowed, "expected empty allowlist to reject every host")
+
+	_, err = loader.Load(context.Background(), []string{srv.URL + "/page/offsite-oembed"})
+	assert.ErrorIs(t, err, errOEmbedHostNotAllowed, "expected discovered oembed urls to be checked")
+
+	_, err = loader.Load(context.Background(), []string{srv.URL + "/page/redirect"})
+	assert.ErrorIs(t, err, errOEmbedHostNotAllowed, "expected redirects to be checked")
+
+	_, err = newOEmbedEmbedLoader([]string{u.Hostname()}).Load(context.Background(), []string{srv.URL + "/page/oembed"})
+	assert.ErrorIs(t, err, errOEmbedAddressNotAllowed, "expected loopback addresses to be rejected")
+
+	_, err = loader.Load(context.Background(), []string{"file:///etc/passwd"})
+	assert.Error(t, err, "expected unsupported scheme to fail")
+}
@@ -0,0 +1,269 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package directory
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/MemeLabs/strims/internal/dao"
+	networkv1directory "github.com/MemeLabs/strims/pkg/apis/network/v1/directory"
+	"github.com/MemeLabs/strims/pkg/logutil"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+const federationRetryInterval = 30 * time.Second
+
+// listingEventSource provides the listings of the networks the local profile
+// is a member of. the directory control's syndicate stores implement this.
+type listingEventSource interface {
+	NotifyListingEvent(networkID uint64, ch chan ListingEvent) ([]Listing, error)
+	StopNotifyingListingEvent(networkID uint64, ch chan ListingEvent)
+}
+
+func newFederator(logger *zap.Logger, store dao.Store, listings listingEventSource) *federator {
+	return &federator{
+		logger:   logger,
+		store:    store,
+		listings: listings,
+		sources:  map[string]*federationSource{},
+	}
+}
+
+// federator imports listings from the directories of other networks into the
+// local directory service according to the server's federation config.
+type federator struct {
+	logger   *zap.Logger
+	store    dao.Store
+	listings listingEventSource
+
+	lock    sync.Mutex
+	ctx     context.Context
+	service *directoryService
+	sources map[string]*federationSource
+}
+
+type federationSource struct {
+	config *networkv1directory.ServerConfig_Federation
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func (f *federator) Start(ctx context.Context, d *directoryService, configs []*networkv1directory.ServerConfig_Federation) {
+	f.lock.Lock()
+	f.ctx = ctx
+	f.service = d
+	f.lock.Unlock()
+
+	f.Sync(configs)
+}
+
+// Sync starts, restarts or stops federation sources to match configs.
+func (f *federator) Sync(configs []*networkv1directory.ServerConfig_Federation) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.ctx == nil {
+		return
+	}
+
+	localKey := dao.NetworkKey(f.service.network.Load())
+
+	seen := map[string]struct{}{}
+	for _, c := range configs {
+		if bytes.Equal(c.NetworkKey, localKey) {
+			continue
+		}
+
+		k := string(c.NetworkKey)
+		seen[k] = struct{}{}
+
+		if s, ok := f.sources[k]; ok {
+			if proto.Equal(s.config, c) {
+				continue
+			}
+			s.Stop()
+		}
+		f.sources[k] = f.startSource(c)
+	}
+
+	for k, s := range f.sources {
+		if _, ok := seen[k]; !ok {
+			s.Stop()
+			delete(f.sources, k)
+		}
+	}
+}
+
+func (f *federator) Close() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	for k, s := range f.sources {
+		s.Stop()
+		delete(f.sources, k)
+	}
+}
+
+func (f *federator) startSource(config *networkv1directory.ServerConfig_Federation) *federationSource {
+	ctx, cancel := context.WithCancel(f.ctx)
+	s := &federationSource{
+		config: config,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	go func() {
+		defer close(s.done)
+
+		logger := f.logger.With(logutil.ByteHex("source", config.NetworkKey))
+		imported := map[uint64]*listing{}
+		defer f.service.removeImportedListings(imported)
+
+		for {
+			err := f.runSource(ctx, config, imported)
+			if ctx.Err() != nil {
+				return
+			}
+			logger.Debug("directory federation failed", zap.Error(err))
+
+			retry := timeutil.DefaultTickEmitter.Ticker(federationRetryInterval)
+			select {
+			case <-retry.C:
+				retry.Stop()
+			case <-ctx.Done():
+				retry.Stop()
+				return
+			}
+		}
+	}()
+
+	return s
+}
+
+func (f *federator) runSource(ctx context.Context, config *networkv1directory.ServerConfig_Federation, imported map[uint64]*listing) error {
+	network, err := dao.NetworksByKey.Get(f.store, config.NetworkKey)
+	if err != nil {
+		return err
+	}
+
+	ch := make(chan ListingEvent, 128)
+	ls, err := f.listings.NotifyListingEvent(network.Id, ch)
+	if err != nil {
+		return err
+	}
+	defer f.listings.StopNotifyingListingEvent(network.Id, ch)
+
+	filter := NewListingFilter(config.Filter)
+	newProvenance := func(l Listing) *networkv1directory.ListingProvenance {
+		return &networkv1directory.ListingProvenance{
+			NetworkKey:  config.NetworkKey,
+			NetworkName: dao.CertificateRoot(network.Certificate).Subject,
+			ListingId:   l.ID,
+		}
+	}
+
+	for _, l := range ls {
+		f.service.importListing(imported, newProvenance(l), l, filter)
+	}
+
+	for {
+		select {
+		case e := <-ch:
+			switch e.Type {
+			case ChangeListingEventType:
+				f.service.importListing(imported, newProvenance(e.Listing), e.Listing, filter)
+			case UnpublishListingEventType:
+				f.service.removeImportedListing(imported, e.Listing.ID)
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (s *federationSource) Stop() {
+	s.cancel()
+	<-s.done
+}
+
+// importListing adds or updates a listing from another network. listings that
+// are themselves imported are skipped to prevent cycles between federated
+// networks.
+func (d *directoryService) importListing(imported map[uint64]*listing, provenance *networkv1directory.ListingProvenance, src Listing, filter *ListingFilter) {
+	if src.Provenance != nil || src.Upcoming || !filter.Match(src) || d.validateListing(src.Listing) != nil {
+		d.removeImportedListing(imported, src.ID)
+		return
+	}
+
+	lr, err := d.getListingRecord(src.Listing)
+	if err != nil {
+		d.logger.Debug("loading federated listing record failed", zap.Error(err))
+		return
+	}
+	if lr.GetModeration().GetIsBanned().GetValue() {
+		d.removeImportedListing(imported, src.ID)
+		return
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	l, ok := imported[src.ID]
+	if !ok {
+		l, _ = newListing(src.Listing, lr)
+		if d.listings.GetByKey(l.key) != nil {
+			return
+		}
+		l.provenance = provenance
+		l = d.listings.GetOrInsert(l)
+		imported[src.ID] = l
+
+		d.logger.Debug(
+			"imported federated listing",
+			logutil.ByteHex("source", provenance.NetworkKey),
+			zap.Object("listing", l),
+		)
+	}
+
+	// the snippet is copied as is so media snippets keep the publisher's key
+	// and signature
+	l.snippet = proto.Clone(src.Snippet).(*networkv1directory.ListingSnippet)
+	l.modifiedTime = timeutil.Now()
+	d.listings.Touch(l)
+}
+
+func (d *directoryService) removeImportedListing(imported map[uint64]*listing, id uint64) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if l, ok := imported[id]; ok {
+		delete(imported, id)
+		d.evictImportedListing(l)
+	}
+}
+
+func (d *directoryService) removeImportedListings(imported map[uint64]*listing) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	for id, l := range imported {
+		delete(imported, id)
+		d.evictImportedListing(l)
+	}
+}
+
+func (d *directoryService) evictImportedListing(l *listing) {
+	// listings published locally after being imported are no longer owned by
+	// the federation source
+	if l.provenance == nil {
+		return
+	}
+	l.evicted = true
+	d.listings.Touch(l)
+}
@@ -0,0 +1,158 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package directory
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MemeLabs/strims/internal/dao"
+	"github.com/MemeLabs/strims/internal/event"
+	networkv1 "github.com/MemeLabs/strims/pkg/apis/network/v1"
+	networkv1directory "github.com/MemeLabs/strims/pkg/apis/network/v1/directory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type mockListingEventSource struct {
+	lock     sync.Mutex
+	listings map[uint64][]Listing
+	chans    map[uint64]chan ListingEvent
+	stopped  chan uint64
+}
+
+func newMockListingEventSource() *mockListingEventSource {
+	return &mockListingEventSource{
+		listings: map[uint64][]Listing{},
+		chans:    map[uint64]chan ListingEvent{},
+		stopped:  make(chan uint64, 1),
+	}
+}
+
+func (s *mockListingEventSource) NotifyListingEvent(networkID uint64, ch chan ListingEvent) ([]Listing, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.chans[networkID] = ch
+	return s.listings[networkID], nil
+}
+
+func (s *mockListingEventSource) StopNotifyingListingEvent(networkID uint64, ch chan ListingEvent) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.chans, networkID)
+	s.stopped <- networkID
+}
+
+func newTestFederatedListing(id uint64, title string) Listing {
+	return Listing{
+		ID: id,
+		Listing: &networkv1directory.Listing{
+			Content: &networkv1directory.Listing_Embed_{
+				Embed: &networkv1directory.Listing_Embed{
+					Service: networkv1directory.Listing_Embed_DIRECTORY_LISTING_EMBED_SERVICE_ANGELTHUMP,
+					Id:      title,
+				},
+			},
+		},
+		Snippet: &networkv1directory.ListingSnippet{Title: title},
+	}
+}
+
+func newTestFederationService(t *testing.T, listings listingEventSource) (*directoryService, *networkv1.Network, *networkv1.Network) {
+	store, profile := newTestStore(t)
+
+	local, err := dao.NewNetwork(store, "local", nil, profile)
+	require.NoError(t, err)
+	local.ServerConfig.Directory.Integrations.Angelthump = &networkv1directory.ServerConfig_Integrations_AngelThump{Enable: true}
+	require.NoError(t, dao.Networks.Insert(store, local))
+
+	remote, err := dao.NewNetwork(store, "remote", nil, profile)
+	require.NoError(t, err)
+	require.NoError(t, dao.Networks.Insert(store, remote))
+
+	d := newDirectoryService(zap.NewNop(), nil, store, &event.Observers{}, nil, listings, local, nil)
+	return d, local, remote
+}
+
+func countImportedListings(d *directoryService) (n int) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.listings.Each(func(l *listing) bool {
+		if l.provenance != nil && !l.evicted {
+			n++
+		}
+		return true
+	})
+	return
+}
+
+func TestImportListingProvenanceFiltering(t *testing.T) {
+	d, _, remote := newTestFederationService(t, newMockListingEventSource())
+	provenance := &networkv1directory.ListingProvenance{NetworkKey: dao.NetworkKey(remote), ListingId: 1}
+	imported := map[uint64]*listing{}
+
+	reimported := newTestFederatedListing(1, "reimported")
+	reimported.Provenance = &networkv1directory.ListingProvenance{NetworkKey: []byte("other")}
+	d.importListing(imported, provenance, reimported, NewListingFilter(nil))
+	assert.Empty(t, imported, "expected listings imported by the source to be skipped")
+
+	upcoming := newTestFederatedListing(1, "upcoming")
+	upcoming.Upcoming = true
+	d.importListing(imported, provenance, upcoming, NewListingFilter(nil))
+	assert.Empty(t, imported, "expected upcoming listings to be skipped")
+
+	filter := NewListingFilter(&networkv1directory.ListingFilter{Search: "match"})
+	d.importListing(imported, provenance, newTestFederatedListing(1, "other"), filter)
+	assert.Empty(t, imported, "expected listings not matching the filter to be skipped")
+
+	d.importListing(imported, provenance, newTestFederatedListing(1, "match"), filter)
+	require.Len(t, imported, 1)
+	assert.Equal(t, provenance, imported[1].provenance)
+	assert.Equal(t, "match", imported[1].snippet.Title)
+
+	d.importListing(imported, provenance, newTestFederatedListing(1, "renamed"), filter)
+	assert.Empty(t, imported, "expected listings that stop matching the filter to be removed")
+	assert.Equal(t, 0, countImportedListings(d))
+}
+
+func TestFederatorSkipsLocalNetwork(t *testing.T) {
+	listings := newMockListingEventSource()
+	d, local, _ := newTestFederationService(t, listings)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	d.federation.Start(ctx, d, []*networkv1directory.ServerConfig_Federation{{NetworkKey: dao.NetworkKey(local)}})
+	defer d.federation.Close()
+
+	assert.Empty(t, d.federation.sources, "expected federating with the local network to be ignored")
+}
+
+func TestFederatorStop(t *testing.T) {
+	listings := newMockListingEventSource()
+	d, _, remote := newTestFederationService(t, listings)
+	listings.listings[remote.Id] = []Listing{newTestFederatedListing(1, "a")}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	d.federation.Start(ctx, d, []*networkv1directory.ServerConfig_Federation{{NetworkKey: dao.NetworkKey(remote)}})
+	defer d.federation.Close()
+
+	assert.Eventually(t, func() bool { return countImportedListings(d) == 1 }, time.Second, 10*time.Millisecond, "expected listing to be imported")
+
+	listings.lock.Lock()
+	ch := listings.chans[remote.Id]
+	listings.lock.Unlock()
+	ch <- ListingEvent{Type: ChangeListingEventType, Listing: newTestFederatedListing(2, "b")}
+	assert.Eventually(t, func() bool { return countImportedListings(d) == 2 }, time.Second, 10*time.Millisecond, "expected listing change to be imported")
+
+	d.federation.Sync(nil)
+	assert.Equal(t, remote.Id, <-listings.stopped, "expected source to stop listening for events")
+	assert.Empty(t, d.federation.sources)
+	assert.Equal(t, 0, countImportedListings(d), "expected imported listings to be evicted")
+}
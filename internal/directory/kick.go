@@ -0,0 +1,127 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package directory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	networkv1directory "github.com/MemeLabs/strims/pkg/apis/network/v1/directory"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+)
+
+const kickAPIBaseURL = "https://kick.com/api/v2"
+
+// kick reports livestream creation times in utc without a zone offset
+const kickTimeLayout = "2006-01-02 15:04:05"
+
+type kickEmbedLoader struct {
+	baseURL string
+}
+
+func (t *kickEmbedLoader) BatchSize() int {
+	return 1
+}
+
+func (t *kickEmbedLoader) Load(ctx context.Context, ids []string) ([]*embedLoaderResult, error) {
+	if len(ids) != 1 {
+		return nil, errors.New("expected exactly one id")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/channels/%s", t.baseURL, url.PathEscape(ids[0])), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Accept", "application/json")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status: %s", res.Status)
+	}
+
+	channel := struct {
+		Slug string `json:"slug"`
+		User struct {
+			Username   string `json:"username"`
+			ProfilePic string `json:"profile_pic"`
+		} `json:"user"`
+		OfflineBannerImage struct {
+			Src string `json:"src"`
+		} `json:"offline_banner_image"`
+		Livestream *struct {
+			SessionTitle string `json:"session_title"`
+			IsLive       bool   `json:"is_live"`
+			IsMature     bool   `json:"is_mature"`
+			ViewerCount  int    `json:"viewer_count"`
+			CreatedAt    string `json:"created_at"`
+			Thumbnail    struct {
+				URL string `json:"url"`
+			} `json:"thumbnail"`
+			Categories []struct {
+				Name string `json:"name"`
+			} `json:"categories"`
+		} `json:"livestream"`
+	}{}
+
+	err = json.NewDecoder(res.Body).Decode(&channel)
+	if err != nil {
+		return nil, err
+	}
+
+	embed := &embedLoaderResult{
+		id: ids[0],
+		snippet: &networkv1directory.ListingSnippet{
+			ChannelName: channel.User.Username,
+			ChannelLogo: &networkv1directory.ListingSnippetImage{
+				SourceOneof: &networkv1directory.ListingSnippetImage_Url{
+					Url: channel.User.ProfilePic,
+				},
+			},
+			Thumbnail: &networkv1directory.ListingSnippetImage{
+				SourceOneof: &networkv1directory.ListingSnippetImage_Url{
+					Url: channel.OfflineBannerImage.Src,
+				},
+			},
+		},
+	}
+
+	if s := channel.Livestream; s != nil {
+		embed.snippet.Live = s.IsLive
+		embed.snippet.Title = s.SessionTitle
+		embed.snippet.IsMature = s.IsMature
+		embed.snippet.UserCount = uint64(s.ViewerCount)
+
+		if len(s.Categories) != 0 {
+			embed.snippet.Category = s.Categories[0].Name
+		}
+
+		if s.Thumbnail.URL != "" {
+			embed.snippet.Thumbnail = &networkv1directory.ListingSnippetImage{
+				SourceOneof: &networkv1directory.ListingSnippetImage_Url{
+					Url: fmt.Sprintf("%s?_t=%x", s.Thumbnail.URL, timeutil.Now().Unix()),
+				},
+			}
+		}
+
+		startTime, err := time.Parse(kickTimeLayout, s.CreatedAt)
+		if err == nil {
+			embed.snippet.StartTime = startTime.Unix()
+		}
+	}
+
+	return []*embedLoaderResult{embed}, nil
+}
@@ -0,0 +1,289 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package directory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	networkv1directory "github.com/MemeLabs/strims/pkg/apis/network/v1/directory"
+	"golang.org/x/net/html"
+)
+
+// limit the amount of html we'll read while looking for discovery links and
+// opengraph tags. these should all be in the document head.
+const oEmbedMaxDocumentSize = 1 << 20
+
+const (
+	oEmbedRequestTimeout = 10 * time.Second
+	oEmbedMaxRedirects   = 5
+)
+
+var (
+	errOEmbedHostNotAllowed    = errors.New("host not allowed")
+	errOEmbedAddressNotAllowed = errors.New("address not allowed")
+	errOEmbedUnsupportedScheme = errors.New("unsupported url scheme")
+	errOEmbedTooManyRedirects  = errors.New("too many redirects")
+)
+
+type oEmbedDocument struct {
+	Type         string `json:"type"`
+	Title        string `json:"title"`
+	Description  string `json:"description"`
+	AuthorName   string `json:"author_name"`
+	ProviderName string `json:"provider_name"`
+	ThumbnailURL string `json:"thumbnail_url"`
+	Width        uint32 `json:"width"`
+	Height       uint32 `json:"height"`
+}
+
+func getHTTPResource(ctx context.Context, client *http.Client, u string, accept string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Accept", accept)
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, fmt.Errorf("unexpected status: %s", res.Status)
+	}
+	return res, nil
+}
+
+func fetchOEmbedDocument(ctx context.Context, client *http.Client, u string) (*oEmbedDocument, error) {
+	res, err := getHTTPResource(ctx, client, u, "application/json")
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	doc := &oEmbedDocument{}
+	if err := json.NewDecoder(io.LimitReader(res.Body, oEmbedMaxDocumentSize)).Decode(doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+type openGraphDocument struct {
+	OEmbedURL  string
+	Properties map[string]string
+}
+
+func fetchOpenGraphDocument(ctx context.Context, client *http.Client, u string) (*openGraphDocument, error) {
+	res, err := getHTTPResource(ctx, client, u, "text/html")
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	return parseOpenGraphDocument(io.LimitReader(res.Body, oEmbedMaxDocumentSize))
+}
+
+func parseOpenGraphDocument(r io.Reader) (*openGraphDocument, error) {
+	doc := &openGraphDocument{
+		Properties: map[string]string{},
+	}
+
+	z := html.NewTokenizer(r)
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			if errors.Is(z.Err(), io.EOF) {
+				return doc, nil
+			}
+			return nil, z.Err()
+		case html.EndTagToken:
+			if name, _ := z.TagName(); string(name) == "head" {
+				return doc, nil
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := z.TagName()
+			switch string(name) {
+			case "body":
+				return doc, nil
+			case "link", "meta":
+				attrs := map[string]string{}
+				for hasAttr {
+					var k, v []byte
+					k, v, hasAttr = z.TagAttr()
+					attrs[string(k)] = string(v)
+				}
+
+				if string(name) == "link" {
+					if attrs["rel"] == "alternate" && attrs["type"] == "application/json+oembed" {
+						doc.OEmbedURL = attrs["href"]
+					}
+				} else {
+					// some publishers incorrectly use name instead of property
+					key := attrs["property"]
+					if key == "" {
+						key = attrs["name"]
+					}
+					if strings.HasPrefix(key, "og:") {
+						doc.Properties[key] = attrs["content"]
+					}
+				}
+			}
+		}
+	}
+}
+
+func newOEmbedSnippet(doc *oEmbedDocument) *networkv1directory.ListingSnippet {
+	snippet := &networkv1directory.ListingSnippet{
+		Title:       doc.Title,
+		Description: doc.Description,
+		ChannelName: doc.AuthorName,
+		VideoWidth:  doc.Width,
+		VideoHeight: doc.Height,
+	}
+	if doc.ThumbnailURL != "" {
+		snippet.Thumbnail = &networkv1directory.ListingSnippetImage{
+			SourceOneof: &networkv1directory.ListingSnippetImage_Url{
+				Url: doc.ThumbnailURL,
+			},
+		}
+	}
+	return snippet
+}
+
+// publicAddressDialControl prevents publisher supplied urls from reaching
+// loopback, private or link local addresses. It runs after name resolution so
+// it also covers allowed hosts that resolve to internal addresses.
+func publicAddressDialControl(network, address string, c syscall.RawConn) error {
+	ap, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+	addr := ap.Addr().Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return errOEmbedAddressNotAllowed
+	}
+	return nil
+}
+
+func newOEmbedEmbedLoader(allowedHosts []string) *oEmbedEmbedLoader {
+	l := &oEmbedEmbedLoader{AllowedHosts: allowedHosts}
+	l.client = l.newHTTPClient(&net.Dialer{
+		Timeout: oEmbedRequestTimeout,
+		Control: publicAddressDialControl,
+	})
+	return l
+}
+
+// oEmbedEmbedLoader resolves arbitrary page urls using oembed discovery links
+// and falls back to opengraph metadata for pages that don't publish oembed
+// documents. Only urls on AllowedHosts are fetched, including redirects and
+// discovered oembed urls. If AllowedHosts is empty every url is rejected.
+type oEmbedEmbedLoader struct {
+	AllowedHosts []string
+	client       *http.Client
+}
+
+func (t *oEmbedEmbedLoader) newHTTPClient(dialer *net.Dialer) *http.Client {
+	return &http.Client{
+		Timeout: oEmbedRequestTimeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: oEmbedRequestTimeout,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= oEmbedMaxRedirects {
+				return errOEmbedTooManyRedirects
+			}
+			return t.checkURL(req.URL)
+		},
+	}
+}
+
+func (t *oEmbedEmbedLoader) BatchSize() int {
+	return 1
+}
+
+func (t *oEmbedEmbedLoader) checkURL(u *url.URL) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errOEmbedUnsupportedScheme
+	}
+	if !t.isAllowedHost(u.Hostname()) {
+		return errOEmbedHostNotAllowed
+	}
+	return nil
+}
+
+func (t *oEmbedEmbedLoader) isAllowedHost(host string) bool {
+	for _, h := range t.AllowedHosts {
+		if host == h || strings.HasSuffix(host, "."+h) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *oEmbedEmbedLoader) Load(ctx context.Context, ids []string) ([]*embedLoaderResult, error) {
+	if len(ids) != 1 {
+		return nil, errors.New("expected exactly one id")
+	}
+
+	u, err := url.Parse(ids[0])
+	if err != nil {
+		return nil, err
+	}
+	if err := t.checkURL(u); err != nil {
+		return nil, err
+	}
+
+	og, err := fetchOpenGraphDocument(ctx, t.client, u.String())
+	if err != nil {
+		return nil, err
+	}
+
+	snippet := &networkv1directory.ListingSnippet{}
+	if og.OEmbedURL != "" {
+		ou, err := u.Parse(og.OEmbedURL)
+		if err != nil {
+			return nil, err
+		}
+		if err := t.checkURL(ou); err != nil {
+			return nil, err
+		}
+		doc, err := fetchOEmbedDocument(ctx, t.client, ou.String())
+		if err != nil {
+			return nil, err
+		}
+		snippet = newOEmbedSnippet(doc)
+	}
+
+	if snippet.Title == "" {
+		snippet.Title = og.Properties["og:title"]
+	}
+	if snippet.Description == "" {
+		snippet.Description = og.Properties["og:description"]
+	}
+	if snippet.ChannelName == "" {
+		snippet.ChannelName = og.Properties["og:site_name"]
+	}
+	if snippet.Thumbnail == nil && og.Properties["og:image"] != "" {
+		snippet.Thumbnail = &networkv1directory.ListingSnippetImage{
+			SourceOneof: &networkv1directory.ListingSnippetImage_Url{
+				Url: og.Properties["og:image"],
+			},
+		}
+	}
+
+	return []*embedLoaderResult{{id: ids[0], snippet: snippet}}, nil
+}
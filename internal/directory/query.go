@@ -0,0 +1,132 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package directory
+
+import (
+	"sort"
+	"strings"
+
+	networkv1directory "github.com/MemeLabs/strims/pkg/apis/network/v1/directory"
+	"golang.org/x/exp/slices"
+)
+
+// ListingProtoContentType ...
+func ListingProtoContentType(l *networkv1directory.Listing) networkv1directory.ListingContentType {
+	switch l.GetContent().(type) {
+	case *networkv1directory.Listing_Media_:
+		return networkv1directory.ListingContentType_LISTING_CONTENT_TYPE_MEDIA
+	case *networkv1directory.Listing_Service_:
+		return networkv1directory.ListingContentType_LISTING_CONTENT_TYPE_SERVICE
+	case *networkv1directory.Listing_Embed_:
+		return networkv1directory.ListingContentType_LISTING_CONTENT_TYPE_EMBED
+	case *networkv1directory.Listing_Chat_:
+		return networkv1directory.ListingContentType_LISTING_CONTENT_TYPE_CHAT
+	default:
+		return networkv1directory.ListingContentType_LISTING_CONTENT_TYPE_UNDEFINED
+	}
+}
+
+// NewListingFilter normalizes the search terms, tags and categories in f so
+// they can be matched case insensitively against listing snippets.
+func NewListingFilter(f *networkv1directory.ListingFilter) *ListingFilter {
+	return &ListingFilter{
+		terms:        strings.Fields(strings.ToLower(f.GetSearch())),
+		tags:         toLowerAll(f.GetTags()),
+		categories:   toLowerAll(f.GetCategories()),
+		contentTypes: f.GetContentTypes(),
+	}
+}
+
+// ListingFilter ...
+type ListingFilter struct {
+	terms        []string
+	tags         []string
+	categories   []string
+	contentTypes []networkv1directory.ListingContentType
+}
+
+// AddContentTypes ...
+func (f *ListingFilter) AddContentTypes(ts ...networkv1directory.ListingContentType) {
+	for _, t := range ts {
+		if !slices.Contains(f.contentTypes, t) {
+			f.contentTypes = append(f.contentTypes, t)
+		}
+	}
+}
+
+// Match returns true if l satisfies every condition in the filter. Search
+// terms must each appear in the snippet title, description, channel name or
+// tags. Any matching tag, category or content type satisfies the respective
+// condition.
+func (f *ListingFilter) Match(l Listing) bool {
+	if len(f.contentTypes) != 0 && !slices.Contains(f.contentTypes, ListingProtoContentType(l.Listing)) {
+		return false
+	}
+
+	if len(f.categories) != 0 && !slices.Contains(f.categories, strings.ToLower(listingCategory(l))) {
+		return false
+	}
+
+	if len(f.tags) != 0 {
+		tags := toLowerAll(l.Snippet.GetTags())
+		if slices.IndexFunc(f.tags, func(t string) bool { return slices.Contains(tags, t) }) == -1 {
+			return false
+		}
+	}
+
+	if len(f.terms) != 0 {
+		text := strings.ToLower(strings.Join([]string{
+			l.Snippet.GetTitle(),
+			l.Snippet.GetDescription(),
+			l.Snippet.GetChannelName(),
+			strings.Join(l.Snippet.GetTags(), " "),
+		}, "\n"))
+		for _, t := range f.terms {
+			if !strings.Contains(text, t) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// listingCategory returns the moderator assigned category if one exists and
+// falls back to the category from the publisher's snippet.
+func listingCategory(l Listing) string {
+	if c := l.Moderation.GetCategory(); c != nil {
+		return c.GetValue()
+	}
+	return l.Snippet.GetCategory()
+}
+
+func toLowerAll(vs []string) []string {
+	ls := make([]string, len(vs))
+	for i, v := range vs {
+		ls[i] = strings.ToLower(v)
+	}
+	return ls
+}
+
+// SortListings sorts ls in place. Listing ids are allocated sequentially by
+// the directory server so they break ties in favor of newer listings.
+func SortListings(ls []Listing, order networkv1directory.ListingSortOrder) {
+	switch order {
+	case networkv1directory.ListingSortOrder_LISTING_SORT_ORDER_VIEWERS:
+		sort.SliceStable(ls, func(i, j int) bool {
+			if ls[i].UserCount != ls[j].UserCount {
+				return ls[i].UserCount > ls[j].UserCount
+			}
+			return ls[i].ID > ls[j].ID
+		})
+	case networkv1directory.ListingSortOrder_LISTING_SORT_ORDER_RECENT:
+		sort.SliceStable(ls, func(i, j int) bool {
+			ti, tj := ls[i].Snippet.GetStartTime(), ls[j].Snippet.GetStartTime()
+			if ti != tj {
+				return ti > tj
+			}
+			return ls[i].ID > ls[j].ID
+		})
+	}
+}
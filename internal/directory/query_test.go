@@ -0,0 +1,170 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package directory
+
+import (
+	"testing"
+
+	networkv1directory "github.com/MemeLabs/strims/pkg/apis/network/v1/directory"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func getTestListings() []Listing {
+	return []Listing{
+		{
+			ID: 1,
+			Listing: &networkv1directory.Listing{
+				Content: &networkv1directory.Listing_Embed_{
+					Embed: &networkv1directory.Listing_Embed{Id: "a"},
+				},
+			},
+			Snippet: &networkv1directory.ListingSnippet{
+				Title:     "Speedrun Marathon",
+				Tags:      []string{"Gaming", "speedrun"},
+				Category:  "games",
+				StartTime: 300,
+			},
+			UserCount: 5,
+		},
+		{
+			ID: 2,
+			Listing: &networkv1directory.Listing{
+				Content: &networkv1directory.Listing_Media_{
+					Media: &networkv1directory.Listing_Media{SwarmUri: "magnet:?xt=urn:ppspp:b"},
+				},
+			},
+			Snippet: &networkv1directory.ListingSnippet{
+				Title:       "cooking stream",
+				Description: "making pasta from scratch",
+				Category:    "irl",
+				StartTime:   100,
+			},
+			Moderation: &networkv1directory.ListingModeration{
+				Category: wrapperspb.String("food"),
+			},
+			UserCount: 20,
+		},
+		{
+			ID: 3,
+			Listing: &networkv1directory.Listing{
+				Content: &networkv1directory.Listing_Chat_{
+					Chat: &networkv1directory.Listing_Chat{Name: "lobby"},
+				},
+			},
+			Snippet: &networkv1directory.ListingSnippet{
+				ChannelName: "Lobby",
+				StartTime:   300,
+			},
+			UserCount: 5,
+		},
+	}
+}
+
+func listingIDs(ls []Listing) []uint64 {
+	ids := []uint64{}
+	for _, l := range ls {
+		ids = append(ids, l.ID)
+	}
+	return ids
+}
+
+func TestListingFilter(t *testing.T) {
+	cases := []struct {
+		label  string
+		filter *networkv1directory.ListingFilter
+		ids    []uint64
+	}{
+		{
+			label: "empty",
+			ids:   []uint64{1, 2, 3},
+		},
+		{
+			label:  "search title",
+			filter: &networkv1directory.ListingFilter{Search: "marathon"},
+			ids:    []uint64{1},
+		},
+		{
+			label:  "search all terms",
+			filter: &networkv1directory.ListingFilter{Search: "PASTA stream"},
+			ids:    []uint64{2},
+		},
+		{
+			label:  "search miss",
+			filter: &networkv1directory.ListingFilter{Search: "pasta marathon"},
+			ids:    []uint64{},
+		},
+		{
+			label:  "tags",
+			filter: &networkv1directory.ListingFilter{Tags: []string{"gaming", "music"}},
+			ids:    []uint64{1},
+		},
+		{
+			label:  "moderated category",
+			filter: &networkv1directory.ListingFilter{Categories: []string{"irl"}},
+			ids:    []uint64{},
+		},
+		{
+			label:  "categories",
+			filter: &networkv1directory.ListingFilter{Categories: []string{"Food", "games"}},
+			ids:    []uint64{1, 2},
+		},
+		{
+			label: "content types",
+			filter: &networkv1directory.ListingFilter{
+				ContentTypes: []networkv1directory.ListingContentType{
+					networkv1directory.ListingContentType_LISTING_CONTENT_TYPE_MEDIA,
+					networkv1directory.ListingContentType_LISTING_CONTENT_TYPE_CHAT,
+				},
+			},
+			ids: []uint64{2, 3},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.label, func(t *testing.T) {
+			f := NewListingFilter(c.filter)
+
+			var ls []Listing
+			for _, l := range getTestListings() {
+				if f.Match(l) {
+					ls = append(ls, l)
+				}
+			}
+			assert.Equal(t, c.ids, listingIDs(ls))
+		})
+	}
+}
+
+func TestSortListings(t *testing.T) {
+	cases := []struct {
+		label string
+		order networkv1directory.ListingSortOrder
+		ids   []uint64
+	}{
+		{
+			label: "undefined",
+			order: networkv1directory.ListingSortOrder_LISTING_SORT_ORDER_UNDEFINED,
+			ids:   []uint64{1, 2, 3},
+		},
+		{
+			label: "viewers",
+			order: networkv1directory.ListingSortOrder_LISTING_SORT_ORDER_VIEWERS,
+			ids:   []uint64{2, 3, 1},
+		},
+		{
+			label: "recent",
+			order: networkv1directory.ListingSortOrder_LISTING_SORT_ORDER_RECENT,
+			ids:   []uint64{3, 1, 2},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.label, func(t *testing.T) {
+			ls := getTestListings()
+			SortListings(ls, c.order)
+			assert.Equal(t, c.ids, listingIDs(ls))
+		})
+	}
+}
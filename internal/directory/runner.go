@@ -27,6 +27,7 @@ func newRunner(
 	observers *event.Observers,
 	dialer network.Dialer,
 	transfer transfer.Control,
+	listings listingEventSource,
 	network *networkv1.Network,
 ) (*runner, error) {
 	a := &runnerAdapter{
@@ -36,6 +37,7 @@ func newRunner(
 		observers: observers,
 		dialer:    dialer,
 		transfer:  transfer,
+		listings:  listings,
 
 		network: syncutil.NewPointer(network),
 	}
@@ -53,6 +55,9 @@ func newRunner(
 
 type runner struct {
 	adapter *runnerAdapter
+	// anonymousListings holds the ids of listings published through onion
+	// circuits. their sessions are kept alive by separate anonymous pings.
+	anonymousListings syncutil.Set[uint64]
 	*servicemanager.Runner[readers, *runnerAdapter]
 }
 
@@ -79,6 +84,7 @@ type runnerAdapter struct {
 	observers *event.Observers
 	dialer    network.Dialer
 	transfer  transfer.Control
+	listings  listingEventSource
 
 	network atomic.Pointer[networkv1.Network]
 }
@@ -96,5 +102,5 @@ func (s *runnerAdapter) Client() (servicemanager.Readable[readers], error) {
 }
 
 func (s *runnerAdapter) Server() (servicemanager.Readable[readers], error) {
-	return newDirectoryServer(s.logger, s.vpn, s.store, s.observers, s.dialer, s.transfer, s.network.Load())
+	return newDirectoryServer(s.logger, s.vpn, s.store, s.observers, s.dialer, s.transfer, s.listings, s.network.Load())
 }
@@ -0,0 +1,292 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package directory
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/MemeLabs/strims/internal/dao"
+	"github.com/MemeLabs/strims/internal/event"
+	"github.com/MemeLabs/strims/internal/network/dialer"
+	networkv1 "github.com/MemeLabs/strims/pkg/apis/network/v1"
+	networkv1directory "github.com/MemeLabs/strims/pkg/apis/network/v1/directory"
+	notificationv1 "github.com/MemeLabs/strims/pkg/apis/notification/v1"
+	profilev1 "github.com/MemeLabs/strims/pkg/apis/profile/v1"
+	"github.com/MemeLabs/strims/pkg/apis/type/certificate"
+	"github.com/MemeLabs/strims/pkg/apis/type/key"
+	"github.com/MemeLabs/strims/pkg/hashmap"
+	"github.com/MemeLabs/strims/pkg/kv/kvtest"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestStore(t *testing.T) (dao.Store, *profilev1.Profile) {
+	var k [dao.KeySize]byte
+	_, err := rand.Read(k[:])
+	require.NoError(t, err)
+	skey, err := dao.NewStorageKeyFromBytes(k[:], nil)
+	require.NoError(t, err)
+
+	profileKey, err := dao.GenerateKey()
+	require.NoError(t, err)
+	profile := &profilev1.Profile{Id: 1, Name: "test", Key: profileKey}
+	ps := dao.NewProfileStore(profile.Id, skey, kvtest.NewMemStore(), nil)
+	require.NoError(t, ps.Init())
+	require.NoError(t, dao.Profile.Set(ps, profile))
+
+	store, err := dao.NewReplicatedStore(ps)
+	require.NoError(t, err)
+	return store, profile
+}
+
+func newTestDirectoryService(t *testing.T) *directoryService {
+	network := &networkv1.Network{
+		Id: 1,
+		ServerConfig: &networkv1.ServerConfig{
+			Directory: &networkv1directory.ServerConfig{
+				Integrations: &networkv1directory.ServerConfig_Integrations{
+					Angelthump: &networkv1directory.ServerConfig_Integrations_AngelThump{Enable: true},
+				},
+			},
+		},
+	}
+	store, _ := newTestStore(t)
+	return newDirectoryService(zap.NewNop(), nil, store, &event.Observers{}, nil, nil, network, nil)
+}
+
+func newTestPublisherContext(t *testing.T) (context.Context, *key.Key) {
+	publisherKey, err := dao.GenerateKey()
+	require.NoError(t, err)
+	hostKey, err := dao.GenerateKey()
+	require.NoError(t, err)
+
+	cert := &certificate.Certificate{
+		Key: hostKey.Public,
+		ParentOneof: &certificate.Certificate_Parent{
+			Parent: &certificate.Certificate{Key: publisherKey.Public},
+		},
+	}
+	return dialer.WithVPNCertificate(context.Background(), cert), publisherKey
+}
+
+func newTestScheduleRequest(t *testing.T, k *key.Key, startTime int64) *networkv1directory.ScheduleRequest {
+	snippet := &networkv1directory.ListingSnippet{
+		Title:     "upcoming",
+		StartTime: startTime,
+	}
+	require.NoError(t, dao.SignMessage(snippet, k))
+
+	return &networkv1directory.ScheduleRequest{
+		Listing: &networkv1directory.Listing{
+			Content: &networkv1directory.Listing_Embed_{
+				Embed: &networkv1directory.Listing_Embed{
+					Service: networkv1directory.Listing_Embed_DIRECTORY_LISTING_EMBED_SERVICE_ANGELTHUMP,
+					Id:      "test",
+				},
+			},
+		},
+		Snippet:   snippet,
+		StartTime: startTime,
+	}
+}
+
+func TestDirectoryServiceSchedule(t *testing.T) {
+	d := newTestDirectoryService(t)
+	ctx, publisherKey := newTestPublisherContext(t)
+	startTime := timeutil.Now().Add(time.Hour).Unix()
+
+	req := newTestScheduleRequest(t, publisherKey, startTime)
+	req.Snippet.Signature = nil
+	_, err := d.Schedule(ctx, req)
+	assert.Error(t, err, "expected unsigned snippet to be rejected")
+
+	otherKey, err := dao.GenerateKey()
+	require.NoError(t, err)
+	_, err = d.Schedule(ctx, newTestScheduleRequest(t, otherKey, startTime))
+	assert.Error(t, err, "expected snippet signed by another key to be rejected")
+
+	req = newTestScheduleRequest(t, publisherKey, startTime)
+	req.Snippet.Live = true
+	require.NoError(t, dao.SignMessage(req.Snippet, publisherKey))
+	_, err = d.Schedule(ctx, req)
+	assert.Error(t, err, "expected live snippet to be rejected")
+
+	_, err = d.Schedule(ctx, newTestScheduleRequest(t, publisherKey, timeutil.Now().Add(-time.Hour).Unix()))
+	assert.Error(t, err, "expected past start time to be rejected")
+
+	res, err := d.Schedule(ctx, newTestScheduleRequest(t, publisherKey, startTime))
+	require.NoError(t, err)
+
+	l, ok := d.schedules[res.Id]
+	require.True(t, ok, "expected scheduled listing")
+	assert.Equal(t, "upcoming", l.snippet.Title)
+	assert.Equal(t, publisherKey.Public, l.snippet.Key)
+
+	_, err = d.Schedule(ctx, newTestScheduleRequest(t, publisherKey, startTime))
+	assert.Error(t, err, "expected duplicate listing to be rejected")
+}
+
+func TestDirectoryServiceUnschedule(t *testing.T) {
+	d := newTestDirectoryService(t)
+	ctx, publisherKey := newTestPublisherContext(t)
+
+	res, err := d.Schedule(ctx, newTestScheduleRequest(t, publisherKey, timeutil.Now().Add(time.Hour).Unix()))
+	require.NoError(t, err)
+
+	otherCtx, _ := newTestPublisherContext(t)
+	_, err = d.Unschedule(otherCtx, &networkv1directory.UnscheduleRequest{Id: res.Id})
+	assert.Error(t, err, "expected other publishers to be denied")
+
+	_, err = d.Unschedule(ctx, &networkv1directory.UnscheduleRequest{Id: res.Id + 1})
+	assert.ErrorIs(t, err, ErrListingNotFound)
+
+	_, err = d.Unschedule(ctx, &networkv1directory.UnscheduleRequest{Id: res.Id})
+	require.NoError(t, err)
+	assert.True(t, d.schedules[res.Id].evicted, "expected listing to be evicted")
+}
+
+func TestDirectoryServicePublishScheduled(t *testing.T) {
+	d := newTestDirectoryService(t)
+	ctx, publisherKey := newTestPublisherContext(t)
+
+	req := newTestScheduleRequest(t, publisherKey, timeutil.Now().Add(time.Hour).Unix())
+	res, err := d.Schedule(ctx, req)
+	require.NoError(t, err)
+
+	otherCtx, _ := newTestPublisherContext(t)
+	_, err = d.Publish(otherCtx, &networkv1directory.PublishRequest{Listing: req.Listing})
+	require.NoError(t, err)
+	assert.Contains(t, d.schedules, res.Id, "expected other publishers to leave the schedule")
+
+	_, err = d.Publish(ctx, &networkv1directory.PublishRequest{Listing: req.Listing})
+	require.NoError(t, err)
+	assert.NotContains(t, d.schedules, res.Id, "expected the scheduling publisher to clear the schedule")
+}
+
+type mockNotificationControl struct {
+	notifications []*notificationv1.Notification
+}
+
+func (c *mockNotificationControl) Watch(ctx context.Context) <-chan *notificationv1.Event {
+	return nil
+}
+
+func (c *mockNotificationControl) Dispatch(n *notificationv1.Notification) error {
+	c.notifications = append(c.notifications, n)
+	return nil
+}
+
+func (c *mockNotificationControl) Dismiss(id uint64) error {
+	return nil
+}
+
+func newListingChangeBroadcast(id uint64, listing *networkv1directory.Listing, upcoming bool) *networkv1directory.EventBroadcast {
+	return &networkv1directory.EventBroadcast{
+		Events: []*networkv1directory.Event{{
+			Body: &networkv1directory.Event_ListingChange_{
+				ListingChange: &networkv1directory.Event_ListingChange{
+					Id:       id,
+					Listing:  listing,
+					Snippet:  &networkv1directory.ListingSnippet{Title: "title"},
+					Upcoming: upcoming,
+				},
+			},
+		}},
+	}
+}
+
+func TestControlListingSubscription(t *testing.T) {
+	store, profile := newTestStore(t)
+	network, err := dao.NewNetwork(store, "test", nil, profile)
+	require.NoError(t, err)
+	require.NoError(t, dao.Networks.Insert(store, network))
+
+	notifications := &mockNotificationControl{}
+	s := newSyndicateStore(zap.NewNop(), network)
+	c := &control{
+		logger:          zap.NewNop(),
+		store:           store,
+		notification:    notifications,
+		syndicateStores: map[uint64]*syndicateStore{network.Id: s},
+		subscriptions:   hashmap.New[[]byte, *networkv1directory.ListingSubscription](hashmap.NewByteInterface[[]byte]()),
+	}
+
+	upcoming := newTestEmbedListing("upcoming")
+	live := newTestEmbedListing("live")
+	for _, b := range []*networkv1directory.EventBroadcast{
+		newListingChangeBroadcast(1, upcoming, true),
+		newListingChangeBroadcast(2, live, false),
+	} {
+		c.notifySubscribers(network, s, b)
+		s.HandleEvent(b)
+	}
+
+	query := func(l *networkv1directory.Listing) *networkv1directory.ListingQuery {
+		return &networkv1directory.ListingQuery{Query: &networkv1directory.ListingQuery_Listing{Listing: l}}
+	}
+
+	_, err = c.SubscribeListing(query(live), dao.NetworkKey(network))
+	assert.ErrorIs(t, err, ErrListingNotUpcoming)
+
+	sub, err := c.SubscribeListing(query(upcoming), dao.NetworkKey(network))
+	require.NoError(t, err)
+	dup, err := c.SubscribeListing(query(upcoming), dao.NetworkKey(network))
+	require.NoError(t, err)
+	assert.Equal(t, sub.Id, dup.Id, "expected duplicate subscriptions to be merged")
+
+	subs, err := c.GetListingSubscriptions()
+	require.NoError(t, err)
+	assert.Len(t, subs, 1)
+
+	b := newListingChangeBroadcast(1, upcoming, true)
+	c.notifySubscribers(network, s, b)
+	s.HandleEvent(b)
+	assert.Empty(t, notifications.notifications, "expected upcoming refresh not to notify")
+
+	b = newListingChangeBroadcast(1, upcoming, false)
+	c.notifySubscribers(network, s, b)
+	s.HandleEvent(b)
+	assert.Len(t, notifications.notifications, 1, "expected notification when listing goes live")
+
+	c.notifySubscribers(network, s, b)
+	s.HandleEvent(b)
+	assert.Len(t, notifications.notifications, 1, "expected live refresh not to notify again")
+
+	subs, err = c.GetListingSubscriptions()
+	require.NoError(t, err)
+	assert.Empty(t, subs, "expected subscription to be removed after notifying")
+}
+
+func TestControlUnsubscribeListing(t *testing.T) {
+	store, profile := newTestStore(t)
+	network, err := dao.NewNetwork(store, "test", nil, profile)
+	require.NoError(t, err)
+	require.NoError(t, dao.Networks.Insert(store, network))
+
+	notifications := &mockNotificationControl{}
+	s := newSyndicateStore(zap.NewNop(), network)
+	c := &control{
+		logger:          zap.NewNop(),
+		store:           store,
+		notification:    notifications,
+		syndicateStores: map[uint64]*syndicateStore{network.Id: s},
+		subscriptions:   hashmap.New[[]byte, *networkv1directory.ListingSubscription](hashmap.NewByteInterface[[]byte]()),
+	}
+
+	listing := newTestEmbedListing("upcoming")
+	s.HandleEvent(newListingChangeBroadcast(1, listing, true))
+
+	sub, err := c.SubscribeListing(&networkv1directory.ListingQuery{Query: &networkv1directory.ListingQuery_Id{Id: 1}}, dao.NetworkKey(network))
+	require.NoError(t, err)
+	require.NoError(t, c.UnsubscribeListing(sub.Id))
+
+	b := newListingChangeBroadcast(1, listing, false)
+	c.notifySubscribers(network, s, b)
+	assert.Empty(t, notifications.notifications, "expected unsubscribed listing not to notify")
+}
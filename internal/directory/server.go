@@ -64,6 +64,7 @@ func newDirectoryServer(
 	observers *event.Observers,
 	dialer network.Dialer,
 	transfer transfer.Control,
+	listings listingEventSource,
 	network *networkv1.Network,
 ) (*directoryServer, error) {
 	config := network.GetServerConfig()
@@ -106,7 +107,7 @@ func newDirectoryServer(
 		eventSwarm:  eventSwarm,
 		assetSwarm:  assetSwarm,
 		assetWriter: assetWriter,
-		service:     newDirectoryService(logger, vpn, store, observers, dialer, network, eventWriter),
+		service:     newDirectoryService(logger, vpn, store, observers, dialer, listings, network, eventWriter),
 	}
 	return s, nil
 }
@@ -236,6 +237,9 @@ func (s *directoryServer) syncAssets() {
 				Twitch:     config.GetIntegrations().GetTwitch().GetEnable(),
 				Youtube:    config.GetIntegrations().GetYoutube().GetEnable(),
 				Swarm:      config.GetIntegrations().GetSwarm().GetEnable(),
+				Kick:       config.GetIntegrations().GetKick().GetEnable(),
+				Vimeo:      config.GetIntegrations().GetVimeo().GetEnable(),
+				Oembed:     config.GetIntegrations().GetOembed().GetEnable(),
 			},
 			PublishQuota:    config.GetPublishQuota(),
 			JoinQuota:       config.GetJoinQuota(),
@@ -4,6 +4,7 @@
 package directory
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -22,6 +23,7 @@ import (
 	"github.com/MemeLabs/strims/pkg/ioutil"
 	"github.com/MemeLabs/strims/pkg/kademlia"
 	"github.com/MemeLabs/strims/pkg/kv"
+	"github.com/MemeLabs/strims/pkg/logutil"
 	"github.com/MemeLabs/strims/pkg/ppspp"
 	"github.com/MemeLabs/strims/pkg/protoutil"
 	"github.com/MemeLabs/strims/pkg/sortutil"
@@ -51,6 +53,16 @@ const (
 	loadMediaEmbedTimeout = time.Second * 30
 	publishQuota          = 10
 	viewQuota             = 10
+	scheduleQuota         = 10
+
+	// scheduled listings are dropped if they haven't gone live by this long
+	// after their start time
+	scheduleTimeout = time.Hour
+	maxScheduleLead = time.Hour * 24 * 30
+
+	statsFlushInterval = time.Minute
+	statsPruneInterval = time.Hour
+	statsRetention     = time.Hour * 24 * 90
 )
 
 // errors
@@ -85,6 +97,7 @@ func newDirectoryService(
 	store dao.Store,
 	observers *event.Observers,
 	dialer network.Dialer,
+	listings listingEventSource,
 	network *networkv1.Network,
 	ew *protoutil.ChunkStreamWriter,
 ) *directoryService {
@@ -94,6 +107,7 @@ func newDirectoryService(
 		store:           store,
 		observers:       observers,
 		dialer:          dialer,
+		federation:      newFederator(logger, store, listings),
 		network:         syncutil.NewPointer(network),
 		broadcastTicker: timeutil.DefaultTickEmitter.Ticker(broadcastInterval),
 		embedLoadTicker: timeutil.DefaultTickEmitter.Ticker(embedLoadInterval),
@@ -103,6 +117,7 @@ func newDirectoryService(
 		users:           newIndexedLRU[user](),
 		listingRecords:  dao.NewDirectoryListingRecordCache(store, nil),
 		userRecords:     dao.NewDirectoryUserRecordCache(store, nil),
+		schedules:       map[uint64]*listing{},
 	}
 }
 
@@ -113,6 +128,7 @@ type directoryService struct {
 	observers *event.Observers
 	dialer    network.Dialer
 
+	federation        *federator
 	network           atomic.Pointer[networkv1.Network]
 	broadcastTicker   timeutil.Ticker
 	embedLoadTicker   timeutil.Ticker
@@ -129,6 +145,8 @@ type directoryService struct {
 	configPublisher   *vpn.HashTablePublisher
 	listingRecords    dao.DirectoryListingRecordCache
 	userRecords       dao.DirectoryUserRecordCache
+	schedules         map[uint64]*listing
+	lastStatsPruneDay int64
 }
 
 func (d *directoryService) Run(ctx context.Context) error {
@@ -137,6 +155,13 @@ func (d *directoryService) Run(ctx context.Context) error {
 	events, done := d.observers.Events()
 	defer done()
 
+	if err := d.loadSchedules(); err != nil {
+		d.logger.Warn("loading scheduled listings failed", zap.Error(err))
+	}
+
+	d.federation.Start(ctx, d, d.network.Load().GetServerConfig().GetDirectory().GetFederations())
+	defer d.federation.Close()
+
 	for {
 		select {
 		case e := <-events:
@@ -173,6 +198,8 @@ func (d *directoryService) handleNetworkChange(network *networkv1.Network) {
 	loader := newEmbedLoader(d.logger, config.GetIntegrations())
 	d.embedLoader.Swap(loader)
 
+	d.federation.Sync(config.GetFederations())
+
 	d.lock.Lock()
 	defer d.lock.Unlock()
 
@@ -194,16 +221,7 @@ func (d *directoryService) broadcast(now timeutil.Time) error {
 	if d.lastRefreshTime.Add(refreshInterval).Before(now) {
 		for it := d.listings.IterateTouchedBefore(d.lastBroadcastTime); it.Next(); {
 			l := it.Value()
-			events = append(events, &networkv1directory.Event{
-				Body: &networkv1directory.Event_ListingChange_{
-					ListingChange: &networkv1directory.Event_ListingChange{
-						Id:         l.id,
-						Listing:    l.listing,
-						Snippet:    l.snippet,
-						Moderation: l.moderation,
-					},
-				},
-			})
+			events = append(events, newListingChangeEvent(l))
 			events = append(events, &networkv1directory.Event{
 				Body: &networkv1directory.Event_UserCountChange_{
 					UserCountChange: &networkv1directory.Event_UserCountChange{
@@ -222,9 +240,27 @@ func (d *directoryService) broadcast(now timeutil.Time) error {
 		d.lastRefreshTime = now
 	}
 
+	for _, l := range d.schedules {
+		if now.After(timeutil.Unix(l.schedule.StartTime, 0).Add(scheduleTimeout)) {
+			l.evicted = true
+			d.listings.Touch(l)
+		}
+	}
+
+	d.listings.Each(func(l *listing) bool {
+		if l.session != nil {
+			l.session.Sample(now, l.viewerUsers.Len())
+			if now.Sub(l.session.lastFlushTime) >= statsFlushInterval {
+				d.flushListingSession(l.session, now)
+			}
+		}
+		return true
+	})
+	d.pruneListingSessions(now)
+
 	for it := d.listings.IterateTouchedAfter(d.lastBroadcastTime); it.Next(); {
 		l := it.Value()
-		if l.publisherSessions.Len() == 0 || l.evicted {
+		if (l.publisherSessions.Len() == 0 && l.schedule == nil && l.provenance == nil) || l.evicted {
 			d.deleteListing(l)
 			events = append(events, &networkv1directory.Event{
 				Body: &networkv1directory.Event_Unpublish_{
@@ -234,16 +270,7 @@ func (d *directoryService) broadcast(now timeutil.Time) error {
 				},
 			})
 		} else if !l.modifiedTime.Before(d.lastBroadcastTime) {
-			events = append(events, &networkv1directory.Event{
-				Body: &networkv1directory.Event_ListingChange_{
-					ListingChange: &networkv1directory.Event_ListingChange{
-						Id:         l.id,
-						Listing:    l.listing,
-						Snippet:    l.snippet,
-						Moderation: l.moderation,
-					},
-				},
-			})
+			events = append(events, newListingChangeEvent(l))
 		} else {
 			events = append(events, &networkv1directory.Event{
 				Body: &networkv1directory.Event_UserCountChange_{
@@ -294,6 +321,21 @@ func (d *directoryService) broadcast(now timeutil.Time) error {
 	return nil
 }
 
+func newListingChangeEvent(l *listing) *networkv1directory.Event {
+	return &networkv1directory.Event{
+		Body: &networkv1directory.Event_ListingChange_{
+			ListingChange: &networkv1directory.Event_ListingChange{
+				Id:         l.id,
+				Listing:    l.listing,
+				Snippet:    l.snippet,
+				Moderation: l.moderation,
+				Upcoming:   l.schedule != nil,
+				Provenance: l.provenance,
+			},
+		},
+	}
+}
+
 func (d *directoryService) appendUserEvent(events []*networkv1directory.Event, u *user) []*networkv1directory.Event {
 	return append(events, &networkv1directory.Event{
 		Body: &networkv1directory.Event_UserPresenceChange_{
@@ -316,6 +358,8 @@ func (d *directoryService) upsertUser(q *user) *user {
 
 func (d *directoryService) deleteListing(l *listing) {
 	d.listings.Delete(l)
+	d.deleteSchedule(l)
+	d.endListingSession(l)
 
 	l.EachViewer(func(s *session) {
 		s.viewedListings.Delete(l)
@@ -329,6 +373,155 @@ func (d *directoryService) deleteListing(l *listing) {
 	l.Cleanup()
 }
 
+func (d *directoryService) loadSchedules() error {
+	records, err := dao.DirectoryScheduledListingRecordsByNetwork.GetAllByRefID(d.store, d.network.Load().Id)
+	if err != nil {
+		return err
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	eol := timeutil.Now().Add(-scheduleTimeout)
+	for _, r := range records {
+		if timeutil.Unix(r.StartTime, 0).Before(eol) {
+			if err := dao.DirectoryScheduledListingRecords.Delete(d.store, r.Id); err != nil {
+				d.logger.Warn("deleting expired scheduled listing failed", zap.Error(err))
+			}
+			continue
+		}
+
+		lr, err := d.getListingRecord(r.Listing)
+		if err != nil {
+			return err
+		}
+		d.insertScheduledListing(r, lr)
+	}
+	return nil
+}
+
+func (d *directoryService) insertScheduledListing(r *networkv1directory.ScheduledListingRecord, lr *networkv1directory.ListingRecord) *listing {
+	l, _ := newListing(r.Listing, lr)
+	l.snippet = r.Snippet
+	l.schedule = r
+	l = d.listings.GetOrInsert(l)
+	d.schedules[l.id] = l
+	return l
+}
+
+func (d *directoryService) deleteSchedule(l *listing) {
+	if l.schedule == nil {
+		return
+	}
+
+	if err := dao.DirectoryScheduledListingRecords.Delete(d.store, l.schedule.Id); err != nil {
+		d.logger.Warn("deleting scheduled listing failed", zap.Error(err))
+	}
+	delete(d.schedules, l.id)
+	l.schedule = nil
+}
+
+func (d *directoryService) startListingSession(l *listing, publisherKey []byte) {
+	now := timeutil.Now()
+	r, err := dao.NewDirectoryListingSessionRecord(d.store, d.network.Load().Id, l.listing, publisherKey, now.Unix())
+	if err != nil {
+		d.logger.Warn("creating listing session failed", zap.Error(err))
+		return
+	}
+
+	l.session = newListingSession(r, now)
+	l.viewerUsers.AscendLessThan(llrb.Inf(1), func(ii llrb.Item) bool {
+		l.session.AddViewer(d.hashViewerKey(ii.(*user).certificate.Key))
+		return true
+	})
+	d.flushListingSession(l.session, now)
+}
+
+func (d *directoryService) endListingSession(l *listing) {
+	if l.session == nil {
+		return
+	}
+
+	now := timeutil.Now()
+	l.session.Sample(now, l.viewerUsers.Len())
+	d.flushListingSession(l.session, now)
+	l.session = nil
+}
+
+func (d *directoryService) flushListingSession(s *listingSession, now timeutil.Time) {
+	if err := dao.DirectoryListingSessionRecords.Upsert(d.store, s.record); err != nil {
+		d.logger.Warn("writing listing session failed", zap.Error(err))
+	}
+	s.lastFlushTime = now
+}
+
+// pruneListingSessions deletes session records that ended before the stats
+// retention window. The first call after starting scans every record for the
+// network, after that only the day buckets that left the window are deleted.
+func (d *directoryService) pruneListingSessions(now timeutil.Time) {
+	day := dao.ListingSessionRecordDay(now.Add(-statsRetention).Unix())
+	if day <= d.lastStatsPruneDay {
+		return
+	}
+
+	networkID := d.network.Load().Id
+	if d.lastStatsPruneDay == 0 {
+		records, err := dao.DirectoryListingSessionRecordsByNetwork.GetAllByRefID(d.store, networkID)
+		if err != nil {
+			d.logger.Warn("loading listing sessions failed", zap.Error(err))
+			return
+		}
+		for _, r := range records {
+			if dao.ListingSessionRecordDay(r.EndTime) < day {
+				if err := dao.DirectoryListingSessionRecords.Delete(d.store, r.Id); err != nil {
+					d.logger.Warn("deleting listing session failed", zap.Error(err))
+				}
+			}
+		}
+	} else {
+		for i := d.lastStatsPruneDay; i < day; i++ {
+			if _, err := dao.DirectoryListingSessionRecordsByDay.DeleteAll(d.store, dao.FormatDirectoryListingSessionRecordDayKey(networkID, i)); err != nil {
+				d.logger.Warn("deleting listing sessions failed", zap.Error(err))
+			}
+		}
+	}
+	d.lastStatsPruneDay = day
+}
+
+// listingSessionsSince loads the session records for the network that ended
+// after startTime using the day index.
+func (d *directoryService) listingSessionsSince(networkID uint64, startTime int64) ([]*networkv1directory.ListingSessionRecord, error) {
+	now := timeutil.Now()
+	day := dao.ListingSessionRecordDay(startTime)
+	if minDay := dao.ListingSessionRecordDay(now.Add(-statsRetention).Unix()); day < minDay {
+		day = minDay
+	}
+
+	var records []*networkv1directory.ListingSessionRecord
+	for maxDay := dao.ListingSessionRecordDay(now.Unix()); day <= maxDay; day++ {
+		rs, err := dao.DirectoryListingSessionRecordsByDay.GetAll(d.store, dao.FormatDirectoryListingSessionRecordDayKey(networkID, day))
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, rs...)
+	}
+	return records, nil
+}
+
+func (d *directoryService) hashViewerKey(peerKey []byte) []byte {
+	return hashViewerKey(d.network.Load().GetServerConfig().GetKey().GetPrivate(), peerKey)
+}
+
+func (d *directoryService) scheduledListingCount(publisherKey []byte) int {
+	var n int
+	for _, l := range d.schedules {
+		if bytes.Equal(l.schedule.PublisherKey, publisherKey) {
+			n++
+		}
+	}
+	return n
+}
+
 func (d *directoryService) deleteSession(s *session) (*user, bool) {
 	u := d.upsertUser(&user{certificate: s.certificate.GetParent()})
 	u.sessions.Delete(s)
@@ -361,6 +554,9 @@ func (d *directoryService) addListingViewer(l *listing, u *user, s *session) {
 		if !l.publisherUsers.Has(u) {
 			l.userCount++
 		}
+		if l.session != nil {
+			l.session.AddViewer(d.hashViewerKey(u.certificate.Key))
+		}
 	}
 	s.viewedListings.ReplaceOrInsert(l)
 }
@@ -543,30 +739,37 @@ func (d *directoryService) getListingByQuery(l *networkv1directory.ListingQuery)
 	}
 }
 
-func (d *directoryService) Publish(ctx context.Context, req *networkv1directory.PublishRequest) (*networkv1directory.PublishResponse, error) {
-	ur, err := d.getUserRecord(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if ur.GetModeration().GetDisablePublish().GetValue() {
-		return nil, errors.New("publishing from this account is disabled")
-	}
-
-	switch c := req.Listing.GetContent().(type) {
+func (d *directoryService) validateListing(l *networkv1directory.Listing) error {
+	switch c := l.GetContent().(type) {
 	case *networkv1directory.Listing_Embed_:
 		if !d.embedLoader.Load().IsSupported(c.Embed.Service) {
-			return nil, errors.New("unsupported embed service")
+			return errors.New("unsupported embed service")
 		}
 	case *networkv1directory.Listing_Media_:
-		if _, err := ppspp.ParseURI(req.Listing.GetMedia().SwarmUri); err != nil {
-			return nil, errors.New("invalid swarm uri")
+		if _, err := ppspp.ParseURI(c.Media.SwarmUri); err != nil {
+			return errors.New("invalid swarm uri")
 		}
 	case *networkv1directory.Listing_Chat_:
 		// TODO: validation...
 	case *networkv1directory.Listing_Service_:
 		// TODO: ingress publishing, chat, ca, etc...
 	default:
-		return nil, errors.New("unsupported content type")
+		return errors.New("unsupported content type")
+	}
+	return nil
+}
+
+func (d *directoryService) Publish(ctx context.Context, req *networkv1directory.PublishRequest) (*networkv1directory.PublishResponse, error) {
+	ur, err := d.getUserRecord(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ur.GetModeration().GetDisablePublish().GetValue() {
+		return nil, errors.New("publishing from this account is disabled")
+	}
+
+	if err := d.validateListing(req.Listing); err != nil {
+		return nil, err
 	}
 
 	lr, err := d.getListingRecord(req.Listing)
@@ -602,6 +805,18 @@ func (d *directoryService) Publish(ctx context.Context, req *networkv1directory.
 	d.removeListingViewer(l, u, s)
 	d.addListingPublisher(l, u, s)
 
+	if l.schedule != nil && bytes.Equal(l.schedule.PublisherKey, u.certificate.Key) {
+		d.deleteSchedule(l)
+		l.modifiedTime = timeutil.Now()
+	}
+	if l.session == nil {
+		d.startListingSession(l, u.certificate.Key)
+	}
+	if l.provenance != nil {
+		l.provenance = nil
+		l.modifiedTime = timeutil.Now()
+	}
+
 	u.sessions.ReplaceOrInsert(s)
 
 	// HAX
@@ -640,6 +855,160 @@ func (d *directoryService) Unpublish(ctx context.Context, req *networkv1director
 	return &networkv1directory.UnpublishResponse{}, nil
 }
 
+func (d *directoryService) Schedule(ctx context.Context, req *networkv1directory.ScheduleRequest) (*networkv1directory.ScheduleResponse, error) {
+	ur, err := d.getUserRecord(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ur.GetModeration().GetDisablePublish().GetValue() {
+		return nil, errors.New("publishing from this account is disabled")
+	}
+
+	if err := d.validateListing(req.Listing); err != nil {
+		return nil, err
+	}
+
+	now := timeutil.Now()
+	startTime := timeutil.Unix(req.StartTime, 0)
+	if startTime.Before(now) {
+		return nil, errors.New("start time must be in the future")
+	}
+	if startTime.After(now.Add(maxScheduleLead)) {
+		return nil, errors.New("start time too far in the future")
+	}
+
+	lr, err := d.getListingRecord(req.Listing)
+	if err != nil {
+		return nil, err
+	}
+	if lr.GetModeration().GetIsBanned().GetValue() {
+		return nil, errors.New("listing banned")
+	}
+
+	publisherKey := dialer.VPNCertificate(ctx).GetParent().GetKey()
+	if err := validateScheduledSnippet(req.Snippet, publisherKey, req.StartTime); err != nil {
+		return nil, err
+	}
+	snippet := proto.Clone(req.Snippet).(*networkv1directory.ListingSnippet)
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.scheduledListingCount(publisherKey) >= scheduleQuota {
+		return nil, errors.New("exceeded scheduled listing quota")
+	}
+	if d.listings.GetByKey(formatListingKey(req.Listing)) != nil {
+		return nil, errors.New("listing already published")
+	}
+
+	r, err := dao.NewDirectoryScheduledListingRecord(d.store, d.network.Load().Id, req.Listing, snippet, publisherKey, req.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	if err := dao.DirectoryScheduledListingRecords.Insert(d.store, r); err != nil {
+		return nil, err
+	}
+
+	l := d.insertScheduledListing(r, lr)
+
+	d.logger.Info(
+		"scheduled",
+		logutil.ByteHex("publisher", publisherKey),
+		zap.Object("listing", l),
+		zap.Int64("startTime", req.StartTime),
+	)
+
+	return &networkv1directory.ScheduleResponse{Id: l.id}, nil
+}
+
+// validateScheduledSnippet checks that the placeholder snippet for a scheduled
+// listing was signed by the publisher and doesn't claim to be live.
+func validateScheduledSnippet(snippet *networkv1directory.ListingSnippet, publisherKey []byte, startTime int64) error {
+	if snippet == nil {
+		return errors.New("snippet required")
+	}
+	if err := dao.VerifyMessage(snippet); err != nil {
+		return fmt.Errorf("invalid snippet: %w", err)
+	}
+	if !bytes.Equal(snippet.Key, publisherKey) {
+		return errors.New("snippet not signed by publisher")
+	}
+	if snippet.StartTime != startTime {
+		return errors.New("snippet start time mismatch")
+	}
+	if snippet.Live || snippet.UserCount != 0 {
+		return errors.New("scheduled snippet must not be live")
+	}
+	return nil
+}
+
+func (d *directoryService) Unschedule(ctx context.Context, req *networkv1directory.UnscheduleRequest) (*networkv1directory.UnscheduleResponse, error) {
+	ur, err := d.getUserRecord(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	l, ok := d.schedules[req.Id]
+	if !ok {
+		return nil, ErrListingNotFound
+	}
+
+	publisherKey := dialer.VPNCertificate(ctx).GetParent().GetKey()
+	if !bytes.Equal(l.schedule.PublisherKey, publisherKey) && !ur.GetModeration().GetIsModerator().GetValue() {
+		return nil, errors.New("permission denied")
+	}
+
+	l.evicted = true
+	d.listings.Touch(l)
+
+	return &networkv1directory.UnscheduleResponse{}, nil
+}
+
+func (d *directoryService) GetListingStats(ctx context.Context, req *networkv1directory.GetListingStatsRequest) (*networkv1directory.GetListingStatsResponse, error) {
+	ur, err := d.getUserRecord(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !ur.GetModeration().GetIsModerator().GetValue() {
+		return nil, errors.New("permission denied")
+	}
+
+	networkID := d.network.Load().Id
+
+	var records []*networkv1directory.ListingSessionRecord
+	switch {
+	case req.Listing != nil:
+		records, err = dao.DirectoryListingSessionRecordsByListing.GetAll(d.store, dao.FormatDirectoryListingRecordListingKey(networkID, req.Listing))
+	case req.PublisherKey != nil:
+		records, err = dao.DirectoryListingSessionRecordsByPublisher.GetAll(d.store, dao.FormatDirectoryUserRecordPeerKeyKey(networkID, req.PublisherKey))
+	default:
+		records, err = d.listingSessionsSince(networkID, req.StartTime)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Listing != nil && req.PublisherKey != nil {
+		n := 0
+		for _, r := range records {
+			if bytes.Equal(r.PublisherKey, req.PublisherKey) {
+				records[n] = r
+				n++
+			}
+		}
+		records = records[:n]
+	}
+
+	listings, publishers := aggregateListingStats(records, req.StartTime, req.EndTime)
+	return &networkv1directory.GetListingStatsResponse{
+		Listings:   listings,
+		Publishers: publishers,
+	}, nil
+}
+
 func (d *directoryService) Join(ctx context.Context, req *networkv1directory.JoinRequest) (*networkv1directory.JoinResponse, error) {
 	ur, err := d.getUserRecord(ctx)
 	if err != nil {
@@ -847,6 +1216,12 @@ func embedServiceName(s networkv1directory.Listing_Embed_Service) string {
 		return "twitch-vod"
 	case networkv1directory.Listing_Embed_DIRECTORY_LISTING_EMBED_SERVICE_YOUTUBE:
 		return "youtube"
+	case networkv1directory.Listing_Embed_DIRECTORY_LISTING_EMBED_SERVICE_KICK:
+		return "kick"
+	case networkv1directory.Listing_Embed_DIRECTORY_LISTING_EMBED_SERVICE_VIMEO:
+		return "vimeo"
+	case networkv1directory.Listing_Embed_DIRECTORY_LISTING_EMBED_SERVICE_OEMBED:
+		return "oembed"
 	default:
 		return "unknown"
 	}
@@ -884,6 +1259,9 @@ type listing struct {
 	publisherUsers        llrb.LLRB
 	viewerUsers           llrb.LLRB
 	evicted               bool
+	schedule              *networkv1directory.ScheduledListingRecord
+	session               *listingSession
+	provenance            *networkv1directory.ListingProvenance
 	publisherSessionCount prometheus.Gauge
 	viewerSessionCount    prometheus.Gauge
 	publisherUserCount    prometheus.Gauge
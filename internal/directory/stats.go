@@ -0,0 +1,166 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package directory
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"sort"
+
+	networkv1directory "github.com/MemeLabs/strims/pkg/apis/network/v1/directory"
+	"github.com/MemeLabs/strims/pkg/stats"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+)
+
+// viewer keys are stored as an hmac keyed with a secret only the directory
+// server holds so the history can count unique viewers without anyone being
+// able to test whether a known peer key watched a listing
+func hashViewerKey(secret, peerKey []byte) []byte {
+	h := hmac.New(sha256.New, secret)
+	h.Write(peerKey)
+	return h.Sum(nil)[:16]
+}
+
+func newListingSession(r *networkv1directory.ListingSessionRecord, now timeutil.Time) *listingSession {
+	return &listingSession{
+		record:         r,
+		viewers:        stats.HyperLogLogFromBytes(r.ViewerSketch),
+		viewerMillis:   r.ViewerSeconds * 1000,
+		lastSampleTime: now,
+		lastFlushTime:  now,
+	}
+}
+
+// listingSession tracks viewer stats for a listing between the first publish
+// and the listing being removed from the directory.
+type listingSession struct {
+	record         *networkv1directory.ListingSessionRecord
+	viewers        *stats.HyperLogLog
+	viewerMillis   uint64
+	lastSampleTime timeutil.Time
+	lastFlushTime  timeutil.Time
+}
+
+func (s *listingSession) AddViewer(hash []byte) {
+	s.viewers.Add(hash)
+	s.record.ViewerSketch = s.viewers.Bytes()
+}
+
+func (s *listingSession) Sample(now timeutil.Time, viewerCount int) {
+	if viewerCount > int(s.record.PeakViewers) {
+		s.record.PeakViewers = uint32(viewerCount)
+	}
+	if dt := now.Sub(s.lastSampleTime).Milliseconds(); dt > 0 {
+		s.viewerMillis += uint64(dt) * uint64(viewerCount)
+	}
+	s.lastSampleTime = now
+
+	s.record.ViewerSeconds = s.viewerMillis / 1000
+	s.record.EndTime = now.Unix()
+}
+
+type listingStatsAccumulator struct {
+	stats   *networkv1directory.ListingStats
+	viewers *stats.HyperLogLog
+}
+
+func (a *listingStatsAccumulator) Add(r *networkv1directory.ListingSessionRecord) {
+	if a.stats == nil {
+		a.stats = &networkv1directory.ListingStats{}
+		a.viewers = stats.NewHyperLogLog()
+	}
+
+	a.stats.SessionCount++
+	a.stats.Duration += r.EndTime - r.StartTime
+	a.stats.ViewerSeconds += r.ViewerSeconds
+	if r.PeakViewers > a.stats.PeakViewers {
+		a.stats.PeakViewers = r.PeakViewers
+	}
+	if r.ViewerSketch != nil {
+		a.viewers.Merge(stats.HyperLogLogFromBytes(r.ViewerSketch))
+	}
+}
+
+func (a *listingStatsAccumulator) Stats() *networkv1directory.ListingStats {
+	a.stats.UniqueViewers = uint32(a.viewers.Count())
+	if a.stats.Duration > 0 {
+		a.stats.AverageViewers = float64(a.stats.ViewerSeconds) / float64(a.stats.Duration)
+	}
+	return a.stats
+}
+
+// aggregateListingStats groups the sessions overlapping [startTime, endTime)
+// by listing and by publisher. A zero endTime leaves the range open.
+func aggregateListingStats(
+	records []*networkv1directory.ListingSessionRecord,
+	startTime, endTime int64,
+) ([]*networkv1directory.ListingStatsItem, []*networkv1directory.PublisherStatsItem) {
+	type listingEntry struct {
+		key     []byte
+		listing *networkv1directory.Listing
+		listingStatsAccumulator
+	}
+	listings := map[string]*listingEntry{}
+	publishers := map[string]*listingStatsAccumulator{}
+
+	for _, r := range records {
+		if r.EndTime < startTime || (endTime != 0 && r.StartTime >= endTime) {
+			continue
+		}
+
+		lk := formatListingKey(r.Listing)
+		le, ok := listings[string(lk)]
+		if !ok {
+			le = &listingEntry{key: lk, listing: r.Listing}
+			listings[string(lk)] = le
+		}
+		le.Add(r)
+
+		pa, ok := publishers[string(r.PublisherKey)]
+		if !ok {
+			pa = &listingStatsAccumulator{}
+			publishers[string(r.PublisherKey)] = pa
+		}
+		pa.Add(r)
+	}
+
+	entries := make([]*listingEntry, 0, len(listings))
+	for _, e := range listings {
+		e.Stats()
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i].stats, entries[j].stats
+		if a.ViewerSeconds != b.ViewerSeconds {
+			return a.ViewerSeconds > b.ViewerSeconds
+		}
+		return bytes.Compare(entries[i].key, entries[j].key) < 0
+	})
+
+	listingItems := make([]*networkv1directory.ListingStatsItem, len(entries))
+	for i, e := range entries {
+		listingItems[i] = &networkv1directory.ListingStatsItem{
+			Listing: e.listing,
+			Stats:   e.stats,
+		}
+	}
+
+	publisherItems := make([]*networkv1directory.PublisherStatsItem, 0, len(publishers))
+	for k, a := range publishers {
+		publisherItems = append(publisherItems, &networkv1directory.PublisherStatsItem{
+			PublisherKey: []byte(k),
+			Stats:        a.Stats(),
+		})
+	}
+	sort.Slice(publisherItems, func(i, j int) bool {
+		a, b := publisherItems[i].Stats, publisherItems[j].Stats
+		if a.ViewerSeconds != b.ViewerSeconds {
+			return a.ViewerSeconds > b.ViewerSeconds
+		}
+		return bytes.Compare(publisherItems[i].PublisherKey, publisherItems[j].PublisherKey) < 0
+	})
+
+	return listingItems, publisherItems
+}
@@ -0,0 +1,131 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package directory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MemeLabs/strims/internal/dao"
+	networkv1directory "github.com/MemeLabs/strims/pkg/apis/network/v1/directory"
+	"github.com/MemeLabs/strims/pkg/kv"
+	"github.com/MemeLabs/strims/pkg/stats"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEmbedListing(id string) *networkv1directory.Listing {
+	return &networkv1directory.Listing{
+		Content: &networkv1directory.Listing_Embed_{
+			Embed: &networkv1directory.Listing_Embed{
+				Service: networkv1directory.Listing_Embed_DIRECTORY_LISTING_EMBED_SERVICE_TWITCH_STREAM,
+				Id:      id,
+			},
+		},
+	}
+}
+
+func TestListingSessionSample(t *testing.T) {
+	start := timeutil.Unix(1000, 0)
+	s := newListingSession(&networkv1directory.ListingSessionRecord{StartTime: start.Unix()}, start)
+
+	s.Sample(start.Add(10*time.Second), 3)
+	s.Sample(start.Add(20*time.Second), 1)
+	s.AddViewer(hashViewerKey(nil, []byte("a")))
+	s.AddViewer(hashViewerKey(nil, []byte("b")))
+	s.AddViewer(hashViewerKey(nil, []byte("a")))
+
+	assert.EqualValues(t, 3, s.record.PeakViewers)
+	assert.EqualValues(t, 40, s.record.ViewerSeconds)
+	assert.EqualValues(t, 1020, s.record.EndTime)
+	assert.EqualValues(t, 2, stats.HyperLogLogFromBytes(s.record.ViewerSketch).Count())
+}
+
+func newTestViewerSketch(peerKeys ...string) []byte {
+	h := stats.NewHyperLogLog()
+	for _, k := range peerKeys {
+		h.Add(hashViewerKey(nil, []byte(k)))
+	}
+	return h.Bytes()
+}
+
+func TestAggregateListingStats(t *testing.T) {
+	a := newTestEmbedListing("a")
+	b := newTestEmbedListing("b")
+
+	records := []*networkv1directory.ListingSessionRecord{
+		{Listing: a, PublisherKey: []byte("p1"), StartTime: 100, EndTime: 200, PeakViewers: 5, ViewerSeconds: 300, ViewerSketch: newTestViewerSketch("x", "y")},
+		{Listing: a, PublisherKey: []byte("p1"), StartTime: 300, EndTime: 400, PeakViewers: 2, ViewerSeconds: 100, ViewerSketch: newTestViewerSketch("x")},
+		{Listing: b, PublisherKey: []byte("p2"), StartTime: 150, EndTime: 250, PeakViewers: 1, ViewerSeconds: 50, ViewerSketch: newTestViewerSketch("z")},
+		{Listing: b, PublisherKey: []byte("p2"), StartTime: 1000, EndTime: 1100, PeakViewers: 9, ViewerSeconds: 900},
+	}
+
+	listings, publishers := aggregateListingStats(records, 0, 500)
+	require.Len(t, listings, 2)
+	require.Len(t, publishers, 2)
+
+	assert.Equal(t, "a", listings[0].Listing.GetEmbed().Id)
+	assert.EqualValues(t, 2, listings[0].Stats.SessionCount)
+	assert.EqualValues(t, 200, listings[0].Stats.Duration)
+	assert.EqualValues(t, 5, listings[0].Stats.PeakViewers)
+	assert.EqualValues(t, 2, listings[0].Stats.UniqueViewers)
+	assert.InDelta(t, 2.0, listings[0].Stats.AverageViewers, 0.001)
+
+	assert.Equal(t, "b", listings[1].Listing.GetEmbed().Id)
+	assert.EqualValues(t, 1, listings[1].Stats.SessionCount)
+	assert.EqualValues(t, 1, listings[1].Stats.PeakViewers)
+
+	assert.Equal(t, []byte("p1"), publishers[0].PublisherKey)
+	assert.Equal(t, []byte("p2"), publishers[1].PublisherKey)
+
+	listings, _ = aggregateListingStats(records, 500, 0)
+	require.Len(t, listings, 1)
+	assert.EqualValues(t, 9, listings[0].Stats.PeakViewers)
+}
+
+func TestHashViewerKey(t *testing.T) {
+	peerKey := []byte("peer")
+	a := hashViewerKey([]byte("secret a"), peerKey)
+	assert.Len(t, a, 16)
+	assert.Equal(t, a, hashViewerKey([]byte("secret a"), peerKey))
+	assert.NotEqual(t, a, hashViewerKey([]byte("secret b"), peerKey), "expected hash to depend on the server secret")
+}
+
+func TestPruneListingSessions(t *testing.T) {
+	d := newTestDirectoryService(t)
+	now := timeutil.Now()
+
+	insert := func(endTime timeutil.Time) *networkv1directory.ListingSessionRecord {
+		r, err := dao.NewDirectoryListingSessionRecord(d.store, d.network.Load().Id, newTestEmbedListing("a"), []byte("p"), endTime.Add(-time.Hour).Unix())
+		require.NoError(t, err)
+		r.EndTime = endTime.Unix()
+		require.NoError(t, dao.DirectoryListingSessionRecords.Insert(d.store, r))
+		return r
+	}
+
+	expired := insert(now.Add(-statsRetention - 48*time.Hour))
+	recent := insert(now.Add(-time.Hour))
+
+	records, err := d.listingSessionsSince(d.network.Load().Id, 0)
+	require.NoError(t, err)
+	require.Len(t, records, 1, "expected records outside the retention window to be skipped")
+	assert.Equal(t, recent.Id, records[0].Id)
+
+	records, err = d.listingSessionsSince(d.network.Load().Id, now.Unix()+int64(48*time.Hour/time.Second))
+	require.NoError(t, err)
+	assert.Empty(t, records, "expected records ending before the start time to be skipped")
+
+	d.pruneListingSessions(now)
+	_, err = dao.DirectoryListingSessionRecords.Get(d.store, expired.Id)
+	assert.ErrorIs(t, err, kv.ErrRecordNotFound)
+
+	expired = insert(now.Add(-statsRetention + time.Hour))
+	d.pruneListingSessions(now.Add(48 * time.Hour))
+	_, err = dao.DirectoryListingSessionRecords.Get(d.store, expired.Id)
+	assert.ErrorIs(t, err, kv.ErrRecordNotFound)
+
+	_, err = dao.DirectoryListingSessionRecords.Get(d.store, recent.Id)
+	assert.NoError(t, err)
+}
@@ -116,6 +116,15 @@ func (d *syndicateStore) GetListingsByPeerKey(peerKey []byte) []Listing {
 	return ls
 }
 
+// IsUpcoming returns true if the listing with id is scheduled and not live.
+func (d *syndicateStore) IsUpcoming(id uint64) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	l, ok := d.listings[id]
+	return ok && l.Listing.Upcoming
+}
+
 func (d *syndicateStore) HandleEvent(b *networkv1directory.EventBroadcast) {
 	d.mu.Lock()
 	defer d.mu.Unlock()
@@ -175,6 +184,8 @@ func (d *syndicateStore) handleListingChange(e *networkv1directory.Event_Listing
 	l.Listing.Listing = e.Listing
 	l.Listing.Snippet = e.Snippet
 	l.Listing.Moderation = e.Moderation
+	l.Listing.Upcoming = e.Upcoming
+	l.Listing.Provenance = e.Provenance
 
 	d.listingObservers.Emit(ListingEvent{ChangeListingEventType, l.Listing})
 }
@@ -0,0 +1,116 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package directory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+
+	networkv1directory "github.com/MemeLabs/strims/pkg/apis/network/v1/directory"
+)
+
+const vimeoAPIBaseURL = "https://api.vimeo.com"
+
+// see live.status in the vimeo video docs
+// https://developer.vimeo.com/api/reference/response/video
+const vimeoLiveStatusStreaming = "streaming"
+
+func newVimeoEmbedLoader(accessToken string) *vimeoEmbedLoader {
+	dialer := &net.Dialer{
+		Timeout: oEmbedRequestTimeout,
+		Control: publicAddressDialControl,
+	}
+	return &vimeoEmbedLoader{
+		baseURL:     vimeoAPIBaseURL,
+		accessToken: accessToken,
+		client: &http.Client{
+			Timeout: oEmbedRequestTimeout,
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: oEmbedRequestTimeout,
+			},
+		},
+	}
+}
+
+type vimeoEmbedLoader struct {
+	baseURL     string
+	accessToken string
+	client      *http.Client
+}
+
+func (t *vimeoEmbedLoader) BatchSize() int {
+	return 1
+}
+
+func (t *vimeoEmbedLoader) Load(ctx context.Context, ids []string) ([]*embedLoaderResult, error) {
+	if len(ids) != 1 {
+		return nil, errors.New("expected exactly one id")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/videos/%s", t.baseURL, url.PathEscape(ids[0])), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Accept", "application/vnd.vimeo.*+json;version=3.4")
+	req.Header.Add("Authorization", "bearer "+t.accessToken)
+
+	res, err := t.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status: %s", res.Status)
+	}
+
+	video := struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Width       uint32 `json:"width"`
+		Height      uint32 `json:"height"`
+		User        struct {
+			Name string `json:"name"`
+		} `json:"user"`
+		Pictures struct {
+			BaseLink string `json:"base_link"`
+		} `json:"pictures"`
+		Live *struct {
+			Status string `json:"status"`
+		} `json:"live"`
+	}{}
+
+	err = json.NewDecoder(io.LimitReader(res.Body, oEmbedMaxDocumentSize)).Decode(&video)
+	if err != nil {
+		return nil, err
+	}
+
+	snippet := &networkv1directory.ListingSnippet{
+		Title:       video.Name,
+		Description: video.Description,
+		ChannelName: video.User.Name,
+		VideoWidth:  video.Width,
+		VideoHeight: video.Height,
+		Live:        video.Live != nil && video.Live.Status == vimeoLiveStatusStreaming,
+	}
+	if video.Pictures.BaseLink != "" {
+		snippet.Thumbnail = &networkv1directory.ListingSnippetImage{
+			SourceOneof: &networkv1directory.ListingSnippetImage_Url{
+				Url: video.Pictures.BaseLink,
+			},
+		}
+	}
+
+	return []*embedLoaderResult{{id: ids[0], snippet: snippet}}, nil
+}
@@ -6,6 +6,7 @@ package event
 import (
 	networkv1 "github.com/MemeLabs/strims/pkg/apis/network/v1"
 	networkv1directory "github.com/MemeLabs/strims/pkg/apis/network/v1/directory"
+	"github.com/MemeLabs/strims/pkg/apis/type/certificate"
 )
 
 // NetworkStart ...
@@ -37,9 +38,10 @@ type NetworkPeerBindings struct {
 
 // NetworkPeerOpen ...
 type NetworkPeerOpen struct {
-	PeerID     uint64
-	NetworkID  uint64
-	NetworkKey []byte
+	PeerID      uint64
+	NetworkID   uint64
+	NetworkKey  []byte
+	Certificate *certificate.Certificate
 }
 
 // NetworkPeerClose ...
@@ -56,6 +56,12 @@ func (s *bootstrapService) CreateClient(ctx context.Context, r *bootstrap.Create
 	switch v := r.GetClientOptions().(type) {
 	case *bootstrap.CreateBootstrapClientRequest_WebsocketOptions:
 		client, err = dao.NewWebSocketBootstrapClient(s.store, v.WebsocketOptions.Url, v.WebsocketOptions.InsecureSkipVerifyTls)
+	case *bootstrap.CreateBootstrapClientRequest_TcpOptions:
+		client, err = dao.NewTCPBootstrapClient(s.store, v.TcpOptions.Url)
+	case *bootstrap.CreateBootstrapClientRequest_UdpOptions:
+		client, err = dao.NewUDPBootstrapClient(s.store, v.UdpOptions.Url)
+	case *bootstrap.CreateBootstrapClientRequest_DnsOptions:
+		client, err = dao.NewDNSBootstrapClient(s.store, v.DnsOptions.Name, v.DnsOptions.InsecureSkipVerifyTls)
 	default:
 		return nil, errors.New("unexpected client options type")
 	}
@@ -81,6 +87,34 @@ func (s *bootstrapService) UpdateClient(ctx context.Context, r *bootstrap.Update
 					InsecureSkipVerifyTls: v.WebsocketOptions.InsecureSkipVerifyTls,
 				},
 			}
+		case *bootstrap.UpdateBootstrapClientRequest_TcpOptions:
+			if err := dao.ValidateTCPBootstrapURL(v.TcpOptions.Url); err != nil {
+				return err
+			}
+			p.ClientOptions = &bootstrap.BootstrapClient_TcpOptions{
+				TcpOptions: &bootstrap.BootstrapClientTCPOptions{
+					Url: v.TcpOptions.Url,
+				},
+			}
+		case *bootstrap.UpdateBootstrapClientRequest_UdpOptions:
+			if err := dao.ValidateUDPBootstrapURL(v.UdpOptions.Url); err != nil {
+				return err
+			}
+			p.ClientOptions = &bootstrap.BootstrapClient_UdpOptions{
+				UdpOptions: &bootstrap.BootstrapClientUDPOptions{
+					Url: v.UdpOptions.Url,
+				},
+			}
+		case *bootstrap.UpdateBootstrapClientRequest_DnsOptions:
+			if err := dao.ValidateDNSBootstrapName(v.DnsOptions.Name); err != nil {
+				return err
+			}
+			p.ClientOptions = &bootstrap.BootstrapClient_DnsOptions{
+				DnsOptions: &bootstrap.BootstrapClientDNSOptions{
+					Name:                  v.DnsOptions.Name,
+					InsecureSkipVerifyTls: v.DnsOptions.InsecureSkipVerifyTls,
+				},
+			}
 		default:
 			return errors.New("unexpected client options type")
 		}
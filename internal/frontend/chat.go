@@ -565,6 +565,7 @@ func (s *chatService) Whisper(ctx context.Context, req *chatv1.WhisperRequest) (
 	if err != nil {
 		return nil, err
 	}
+	record.Anonymous = req.Anonymous
 
 	err = s.store.Update(func(tx kv.RWTx) error {
 		thread, err := dao.ChatWhisperThreadsByPeerKey.Get(tx, peerCert.Key)
@@ -176,3 +176,26 @@ func (s *debugService) StopMockStream(ctx context.Context, r *debugv1.StopMockSt
 	s.app.Debug().StopMockStream(r.Id)
 	return &debugv1.StopMockStreamResponse{}, nil
 }
+
+// ReadRoutes ...
+func (s *debugService) ReadRoutes(ctx context.Context, r *debugv1.ReadRoutesRequest) (*debugv1.ReadRoutesResponse, error) {
+	routes, err := s.app.Debug().ReadRoutes(r.NetworkKey)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &debugv1.ReadRoutesResponse{
+		Routes: make([]*debugv1.ReadRoutesResponse_Route, len(routes)),
+	}
+	for i, route := range routes {
+		res.Routes[i] = &debugv1.ReadRoutesResponse_Route{
+			Target:   route.Target.Bytes(nil),
+			NextHop:  route.NextHop.Bytes(nil),
+			Distance: uint32(route.Distance),
+			RttMs:    route.RTT.Milliseconds(),
+			Loss:     route.Loss,
+			LastSeen: route.LastSeen.Unix(),
+		}
+	}
+	return res, nil
+}
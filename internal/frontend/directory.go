@@ -49,16 +49,23 @@ func (s *directoryService) client(ctx context.Context, networkKey []byte) (*netw
 
 // Publish ...
 func (s *directoryService) Publish(ctx context.Context, r *networkv1directory.FrontendPublishRequest) (*networkv1directory.FrontendPublishResponse, error) {
-	id, err := s.app.Directory().Publish(ctx, r.Listing, r.NetworkKey)
+	id, err := s.app.Directory().Publish(ctx, r.Listing, r.NetworkKey, publishOptions(r.Anonymous)...)
 	if err != nil {
 		return nil, err
 	}
 	return &networkv1directory.FrontendPublishResponse{Id: id}, nil
 }
 
+func publishOptions(anonymous bool) []directory.PublishOption {
+	if anonymous {
+		return []directory.PublishOption{directory.WithAnonymousRouting()}
+	}
+	return nil
+}
+
 // Unpublish ...
 func (s *directoryService) Unpublish(ctx context.Context, r *networkv1directory.FrontendUnpublishRequest) (*networkv1directory.FrontendUnpublishResponse, error) {
-	err := s.app.Directory().Unpublish(ctx, r.Id, r.NetworkKey)
+	err := s.app.Directory().Unpublish(ctx, r.Id, r.NetworkKey, publishOptions(r.Anonymous)...)
 	if err != nil {
 		return nil, err
 	}
@@ -182,6 +189,51 @@ func (s *directoryService) Test(ctx context.Context, r *networkv1directory.Front
 	return &networkv1directory.FrontendTestResponse{}, nil
 }
 
+// Schedule ...
+func (s *directoryService) Schedule(ctx context.Context, r *networkv1directory.FrontendScheduleRequest) (*networkv1directory.FrontendScheduleResponse, error) {
+	id, err := s.app.Directory().Schedule(ctx, r.Listing, r.Snippet, r.StartTime, r.NetworkKey)
+	if err != nil {
+		return nil, err
+	}
+	return &networkv1directory.FrontendScheduleResponse{Id: id}, nil
+}
+
+// Unschedule ...
+func (s *directoryService) Unschedule(ctx context.Context, r *networkv1directory.FrontendUnscheduleRequest) (*networkv1directory.FrontendUnscheduleResponse, error) {
+	err := s.app.Directory().Unschedule(ctx, r.Id, r.NetworkKey)
+	if err != nil {
+		return nil, err
+	}
+	return &networkv1directory.FrontendUnscheduleResponse{}, nil
+}
+
+// SubscribeListing ...
+func (s *directoryService) SubscribeListing(ctx context.Context, r *networkv1directory.FrontendSubscribeListingRequest) (*networkv1directory.FrontendSubscribeListingResponse, error) {
+	subscription, err := s.app.Directory().SubscribeListing(r.Query, r.NetworkKey)
+	if err != nil {
+		return nil, err
+	}
+	return &networkv1directory.FrontendSubscribeListingResponse{Subscription: subscription}, nil
+}
+
+// UnsubscribeListing ...
+func (s *directoryService) UnsubscribeListing(ctx context.Context, r *networkv1directory.FrontendUnsubscribeListingRequest) (*networkv1directory.FrontendUnsubscribeListingResponse, error) {
+	err := s.app.Directory().UnsubscribeListing(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &networkv1directory.FrontendUnsubscribeListingResponse{}, nil
+}
+
+// ListListingSubscriptions ...
+func (s *directoryService) ListListingSubscriptions(ctx context.Context, r *networkv1directory.FrontendListListingSubscriptionsRequest) (*networkv1directory.FrontendListListingSubscriptionsResponse, error) {
+	subscriptions, err := s.app.Directory().GetListingSubscriptions()
+	if err != nil {
+		return nil, err
+	}
+	return &networkv1directory.FrontendListListingSubscriptionsResponse{Subscriptions: subscriptions}, nil
+}
+
 func (s *directoryService) ModerateListing(ctx context.Context, r *networkv1directory.FrontendModerateListingRequest) (*networkv1directory.FrontendModerateListingResponse, error) {
 	err := s.app.Directory().ModerateListing(ctx, r.Id, r.Moderation, r.NetworkKey)
 	if err != nil {
@@ -203,6 +255,24 @@ func (s *directoryService) ModerateUser(ctx context.Context, r *networkv1directo
 	return &networkv1directory.FrontendModerateUserResponse{}, nil
 }
 
+// GetListingStats ...
+func (s *directoryService) GetListingStats(ctx context.Context, r *networkv1directory.FrontendGetListingStatsRequest) (*networkv1directory.FrontendGetListingStatsResponse, error) {
+	req := &networkv1directory.GetListingStatsRequest{
+		StartTime:    r.StartTime,
+		EndTime:      r.EndTime,
+		PublisherKey: r.PublisherKey,
+		Listing:      r.Listing,
+	}
+	res, err := s.app.Directory().GetListingStats(ctx, req, r.NetworkKey)
+	if err != nil {
+		return nil, err
+	}
+	return &networkv1directory.FrontendGetListingStatsResponse{
+		Listings:   res.Listings,
+		Publishers: res.Publishers,
+	}, nil
+}
+
 func (s *directoryService) GetUsers(ctx context.Context, r *networkv1directory.FrontendGetUsersRequest) (*networkv1directory.FrontendGetUsersResponse, error) {
 	res := &networkv1directory.FrontendGetUsersResponse{
 		Networks: map[uint64]*networkv1directory.Network{},
@@ -247,21 +317,6 @@ func (s *directoryService) GetUsers(ctx context.Context, r *networkv1directory.F
 	return res, nil
 }
 
-func listingProtoContentType(l *networkv1directory.Listing) networkv1directory.ListingContentType {
-	switch l.Content.(type) {
-	case *networkv1directory.Listing_Media_:
-		return networkv1directory.ListingContentType_LISTING_CONTENT_TYPE_MEDIA
-	case *networkv1directory.Listing_Service_:
-		return networkv1directory.ListingContentType_LISTING_CONTENT_TYPE_SERVICE
-	case *networkv1directory.Listing_Embed_:
-		return networkv1directory.ListingContentType_LISTING_CONTENT_TYPE_EMBED
-	case *networkv1directory.Listing_Chat_:
-		return networkv1directory.ListingContentType_LISTING_CONTENT_TYPE_CHAT
-	default:
-		return networkv1directory.ListingContentType_LISTING_CONTENT_TYPE_UNDEFINED
-	}
-}
-
 func (s *directoryService) GetListing(ctx context.Context, r *networkv1directory.FrontendGetListingRequest) (*networkv1directory.FrontendGetListingResponse, error) {
 	networkID, err := dao.NetworksByKey.GetID(s.store, r.NetworkKey)
 	if err != nil {
@@ -280,9 +335,23 @@ func (s *directoryService) GetListing(ctx context.Context, r *networkv1directory
 		Moderation:      l.Moderation,
 		UserCount:       l.UserCount,
 		RecentUserCount: l.RecentUserCount,
+		Upcoming:        l.Upcoming,
+		Provenance:      l.Provenance,
 	}, nil
 }
 
+// newListingFilter combines the legacy content types request field with the
+// listing filter. Requests can only set content types in one of them.
+func newListingFilter(contentTypes []networkv1directory.ListingContentType, f *networkv1directory.ListingFilter) (*directory.ListingFilter, error) {
+	if len(contentTypes) != 0 && len(f.GetContentTypes()) != 0 {
+		return nil, errors.New("content types must be set in either the request or the filter")
+	}
+
+	filter := directory.NewListingFilter(f)
+	filter.AddContentTypes(contentTypes...)
+	return filter, nil
+}
+
 func (s *directoryService) GetListings(ctx context.Context, r *networkv1directory.FrontendGetListingsRequest) (*networkv1directory.FrontendGetListingsResponse, error) {
 	var networks []*networkv1.Network
 	var err error
@@ -295,22 +364,35 @@ func (s *directoryService) GetListings(ctx context.Context, r *networkv1director
 		return nil, err
 	}
 
+	filter, err := newListingFilter(r.ContentTypes, r.Filter)
+	if err != nil {
+		return nil, err
+	}
+
 	res := &networkv1directory.FrontendGetListingsResponse{}
 
 	for _, n := range networks {
-		var nls []*networkv1directory.NetworkListingsItem
+		var ls []directory.Listing
 		for _, l := range s.app.Directory().GetListingsByNetworkID(n.Id) {
-			if len(r.ContentTypes) == 0 || slices.Contains(r.ContentTypes, listingProtoContentType(l.Listing)) {
-				nls = append(nls, &networkv1directory.NetworkListingsItem{
-					Id:              l.ID,
-					Listing:         l.Listing,
-					Snippet:         l.Snippet,
-					Moderation:      l.Moderation,
-					UserCount:       l.UserCount,
-					RecentUserCount: l.RecentUserCount,
-				})
+			if filter.Match(l) {
+				ls = append(ls, l)
 			}
 		}
+		directory.SortListings(ls, r.SortOrder)
+
+		var nls []*networkv1directory.NetworkListingsItem
+		for _, l := range ls {
+			nls = append(nls, &networkv1directory.NetworkListingsItem{
+				Id:              l.ID,
+				Listing:         l.Listing,
+				Snippet:         l.Snippet,
+				Moderation:      l.Moderation,
+				UserCount:       l.UserCount,
+				RecentUserCount: l.RecentUserCount,
+				Upcoming:        l.Upcoming,
+				Provenance:      l.Provenance,
+			})
+		}
 
 		res.Listings = append(res.Listings, &networkv1directory.NetworkListings{
 			Network: &networkv1directory.Network{
@@ -325,7 +407,7 @@ func (s *directoryService) GetListings(ctx context.Context, r *networkv1director
 	return res, nil
 }
 
-func (s *directoryService) watchNetworkListings(ctx context.Context, n *networkv1.Network, filterListing func(l directory.Listing) bool, events chan *networkv1directory.FrontendWatchListingsResponse_Event) error {
+func (s *directoryService) watchNetworkListings(ctx context.Context, n *networkv1.Network, filterListing func(l directory.Listing) bool, sortOrder networkv1directory.ListingSortOrder, events chan *networkv1directory.FrontendWatchListingsResponse_Event) error {
 	network := &networkv1directory.Network{
 		Id:   n.Id,
 		Name: dao.CertificateRoot(n.Certificate).Subject,
@@ -341,12 +423,21 @@ func (s *directoryService) watchNetworkListings(ctx context.Context, n *networkv
 	go func() {
 		defer s.app.Directory().StopNotifyingListingEvent(network.Id, src)
 
-		var nls []*networkv1directory.NetworkListingsItem
+		var ls []directory.Listing
 		for _, l := range listings {
-			if !filterListing(l) {
-				continue
+			if filterListing(l) {
+				ls = append(ls, l)
 			}
+		}
+		directory.SortListings(ls, sortOrder)
 
+		// listings can stop matching the filter when their snippet or moderation
+		// changes so we track which ones the client knows about to unpublish them
+		visible := map[uint64]struct{}{}
+
+		var nls []*networkv1directory.NetworkListingsItem
+		for _, l := range ls {
+			visible[l.ID] = struct{}{}
 			nls = append(nls, &networkv1directory.NetworkListingsItem{
 				Id:              l.ID,
 				Listing:         l.Listing,
@@ -354,6 +445,8 @@ func (s *directoryService) watchNetworkListings(ctx context.Context, n *networkv
 				Moderation:      l.Moderation,
 				UserCount:       l.UserCount,
 				RecentUserCount: l.RecentUserCount,
+				Upcoming:        l.Upcoming,
+				Provenance:      l.Provenance,
 			})
 		}
 		res := &networkv1directory.FrontendWatchListingsResponse_Event{
@@ -382,8 +475,20 @@ func (s *directoryService) watchNetworkListings(ctx context.Context, n *networkv
 				}
 
 				l := e.Listing
+				_, wasVisible := visible[l.ID]
 				if !filterListing(l) {
-					continue
+					if !wasVisible {
+						continue
+					}
+					e.Type = directory.UnpublishListingEventType
+				} else if !wasVisible && e.Type == directory.UserCountChangeListingEventType {
+					e.Type = directory.ChangeListingEventType
+				}
+
+				if e.Type == directory.UnpublishListingEventType {
+					delete(visible, l.ID)
+				} else {
+					visible[l.ID] = struct{}{}
 				}
 
 				res := &networkv1directory.FrontendWatchListingsResponse_Event{}
@@ -400,6 +505,8 @@ func (s *directoryService) watchNetworkListings(ctx context.Context, n *networkv
 									Moderation:      l.Moderation,
 									UserCount:       l.UserCount,
 									RecentUserCount: l.RecentUserCount,
+									Upcoming:        l.Upcoming,
+									Provenance:      l.Provenance,
 								}},
 							},
 						},
@@ -448,19 +555,21 @@ func (s *directoryService) WatchListings(ctx context.Context, r *networkv1direct
 
 	listingEvents := make(chan *networkv1directory.FrontendWatchListingsResponse_Event, 128)
 
+	filter, err := newListingFilter(r.ContentTypes, r.Filter)
+	if err != nil {
+		return nil, err
+	}
+
 	filterListing := func(l directory.Listing) bool {
 		if r.ListingId != 0 && r.ListingId != l.ID {
 			return false
 		}
-		if len(r.ContentTypes) != 0 && !slices.Contains(r.ContentTypes, listingProtoContentType(l.Listing)) {
-			return false
-		}
-		return true
+		return filter.Match(l)
 	}
 
 	var errs []error
 	for _, n := range networks {
-		if err := s.watchNetworkListings(ctx, n, filterListing, listingEvents); err != nil {
+		if err := s.watchNetworkListings(ctx, n, filterListing, r.SortOrder, listingEvents); err != nil {
 			errs = append(errs, err)
 		}
 	}
@@ -493,7 +602,7 @@ func (s *directoryService) WatchListings(ctx context.Context, r *networkv1direct
 				switch e := e.(type) {
 				case event.DirectorySyndicateStart:
 					if len(r.NetworkKeys) == 0 && slices.IndexFunc(networks, func(n *networkv1.Network) bool { return n.Id == e.Network.Id }) == -1 {
-						err := s.watchNetworkListings(ctx, e.Network, filterListing, listingEvents)
+						err := s.watchNetworkListings(ctx, e.Network, filterListing, r.SortOrder, listingEvents)
 						if err != nil {
 							s.logger.Error("failed to watch directory", zap.Error(err))
 							return
@@ -0,0 +1,107 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package frontend
+
+import (
+	"context"
+	"time"
+
+	"github.com/MemeLabs/protobuf/pkg/rpc"
+	"github.com/MemeLabs/strims/internal/app"
+	"github.com/MemeLabs/strims/internal/transfer"
+	transferv1 "github.com/MemeLabs/strims/pkg/apis/transfer/v1"
+	"github.com/MemeLabs/strims/pkg/mathutil"
+	"github.com/MemeLabs/strims/pkg/ppspp"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+)
+
+const (
+	defaultSwarmMetricsInterval = time.Second
+	minSwarmMetricsInterval     = 100 * time.Millisecond
+)
+
+func init() {
+	RegisterService(func(server *rpc.Server, params ServiceParams) {
+		transferv1.RegisterTransferFrontendService(server, &transferService{
+			app: params.App,
+		})
+	})
+}
+
+// transferService ...
+type transferService struct {
+	app app.Control
+}
+
+// WatchSwarmMetrics ...
+func (s *transferService) WatchSwarmMetrics(ctx context.Context, r *transferv1.WatchSwarmMetricsRequest) (<-chan *transferv1.WatchSwarmMetricsResponse, error) {
+	interval := defaultSwarmMetricsInterval
+	if r.IntervalMs > 0 {
+		interval = mathutil.Max(time.Duration(r.IntervalMs)*time.Millisecond, minSwarmMetricsInterval)
+	}
+
+	ch := make(chan *transferv1.WatchSwarmMetricsResponse)
+	go func() {
+		defer close(ch)
+
+		t := timeutil.DefaultTickEmitter.Ticker(interval)
+		defer t.Stop()
+
+		for {
+			select {
+			case now := <-t.C:
+				select {
+				case ch <- newWatchSwarmMetricsResponse(s.app.Transfer().SwarmMetrics(now)):
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+func newWatchSwarmMetricsResponse(ms []transfer.SwarmMetrics) *transferv1.WatchSwarmMetricsResponse {
+	res := &transferv1.WatchSwarmMetricsResponse{
+		Swarms: make([]*transferv1.SwarmMetrics, len(ms)),
+	}
+	for i, m := range ms {
+		peers := make([]*transferv1.PeerSwarmMetrics, len(m.Peers))
+		for j, p := range m.Peers {
+			peers[j] = &transferv1.PeerSwarmMetrics{
+				HostId:          p.HostID.Bytes(nil),
+				Read:            newTransferMetrics(p.Read),
+				Write:           newTransferMetrics(p.Write),
+				RttMs:           p.Scheduler.RTT.Milliseconds(),
+				RttVarMs:        p.Scheduler.RTTVar.Milliseconds(),
+				CwndBytes:       uint64(p.Scheduler.CWND),
+				FlightSizeBytes: uint64(p.Scheduler.FlightSize),
+				RequestedChunks: p.Scheduler.RequestedChunks,
+				ReceivedChunks:  p.Scheduler.ReceivedChunks,
+				InvalidChunks:   p.Scheduler.InvalidChunks,
+				Choked:          p.Scheduler.Choked,
+				PeerChoked:      p.Scheduler.PeerChoked,
+			}
+		}
+
+		res.Swarms[i] = &transferv1.SwarmMetrics{
+			TransferId: m.ID[:],
+			SwarmId:    m.Swarm.ID(),
+			Label:      m.Swarm.Options().Label,
+			Read:       newTransferMetrics(m.Read),
+			Write:      newTransferMetrics(m.Write),
+			Peers:      peers,
+		}
+	}
+	return res
+}
+
+func newTransferMetrics(m ppspp.TransferMetricsSnapshot) *transferv1.TransferMetrics {
+	return &transferv1.TransferMetrics{
+		Bytes:          m.Count,
+		BytesPerSecond: m.Rate,
+	}
+}
@@ -48,6 +48,7 @@ func (s *videoChannelService) Create(ctx context.Context, r *videov1.VideoChanne
 	channel, err := s.app.VideoChannel().CreateChannel(
 		videochannel.WithLocalOwner(s.profile.Key.Public, r.NetworkKey),
 		videochannel.WithDirectorySnippet(r.DirectoryListingSnippet),
+		videochannel.WithMembersOnly(r.MembersOnly),
 	)
 	if err != nil {
 		return nil, err
@@ -60,6 +61,7 @@ func (s *videoChannelService) Update(ctx context.Context, r *videov1.VideoChanne
 		r.Id,
 		videochannel.WithLocalOwner(s.profile.Key.Public, r.NetworkKey),
 		videochannel.WithDirectorySnippet(r.DirectoryListingSnippet),
+		videochannel.WithMembersOnly(r.MembersOnly),
 	)
 	if err != nil {
 		return nil, err
@@ -6,6 +6,7 @@ package frontend
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/MemeLabs/protobuf/pkg/rpc"
 	"github.com/MemeLabs/strims/internal/app"
@@ -42,7 +43,7 @@ func (s *videoEgressService) OpenStream(ctx context.Context, r *videov1.EgressOp
 	go func() {
 		defer close(ch)
 
-		transferID, r, err := s.app.VideoEgress().OpenStream(ctx, r.SwarmUri, r.NetworkKeys)
+		transferID, r, err := s.app.VideoEgress().OpenStream(ctx, r.SwarmUri, r.NetworkKeys, time.Duration(r.RewindMs)*time.Millisecond)
 		if err != nil {
 			logger.Error("opening stream failed", zap.Error(err))
 
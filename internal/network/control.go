@@ -89,6 +89,8 @@ type Dialer interface {
 	ClientDialer(ctx context.Context, networkKey []byte, resolver dialer.HostAddrResolver) (rpc.Dialer, error)
 	Client(ctx context.Context, networkKey, key, salt []byte) (*dialer.RPCClient, error)
 	ClientWithHostAddr(ctx context.Context, networkKey []byte, hostID kademlia.ID, port uint16) (*dialer.RPCClient, error)
+	AnonymousClientDialer(ctx context.Context, networkKey []byte, resolver dialer.HostAddrResolver) (rpc.Dialer, error)
+	AnonymousClient(ctx context.Context, networkKey, key, salt []byte) (*dialer.RPCClient, error)
 }
 
 // NewControl ...
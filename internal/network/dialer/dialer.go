@@ -51,29 +51,28 @@ type Dialer struct {
 
 // ReplaceOrInsertNetwork ...
 func (t *Dialer) ReplaceOrInsertNetwork(network *networkv1.Network) {
-	csr, err := dao.NewCertificateRequest(t.vpn.VNIC().Key(), certificate.KeyUsage_KEY_USAGE_SIGN)
+	cert, err := t.newCertificate(t.vpn.VNIC().Key(), network)
 	if err != nil {
 		return
 	}
 
-	now := timeutil.Now()
-	notAfter := timeutil.Unix(int64(network.Certificate.NotAfter), 0)
-	if now.After(notAfter) {
-		return
-	}
-
-	cert, err := dao.SignCertificateRequest(csr, notAfter.Sub(now), t.key)
-	if err != nil {
-		return
-	}
-	cert.ParentOneof = &certificate.Certificate_Parent{Parent: network.Certificate}
-
 	t.lock.Lock()
 	it := t.certs.Get(&hostCertKey{dao.NetworkKey(network)})
 	if it == nil {
-		t.certs.ReplaceOrInsert(&hostCert{syncutil.NewPointer(cert)})
+		// the anonymous key is generated once per network so the identity of
+		// anonymous clients is stable across certificate renewals
+		h, err := t.newHostCert(cert, network)
+		if err != nil {
+			t.lock.Unlock()
+			return
+		}
+		t.certs.ReplaceOrInsert(h)
 	} else {
-		it.(*hostCert).Swap(cert)
+		h := it.(*hostCert)
+		if anonymousCert, err := t.newCertificate(h.anonymousKey, network); err == nil {
+			h.anonymousCert.Store(anonymousCert)
+		}
+		h.Swap(cert)
 	}
 	t.lock.Unlock()
 
@@ -82,6 +81,44 @@ func (t *Dialer) ReplaceOrInsertNetwork(network *networkv1.Network) {
 	}
 }
 
+func (t *Dialer) newHostCert(cert *certificate.Certificate, network *networkv1.Network) (*hostCert, error) {
+	anonymousKey, err := dao.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	anonymousCert, err := t.newCertificate(anonymousKey, network)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &hostCert{
+		Pointer:      syncutil.NewPointer(cert),
+		anonymousKey: anonymousKey,
+	}
+	h.anonymousCert.Store(anonymousCert)
+	return h, nil
+}
+
+func (t *Dialer) newCertificate(k *key.Key, network *networkv1.Network) (*certificate.Certificate, error) {
+	csr, err := dao.NewCertificateRequest(k, certificate.KeyUsage_KEY_USAGE_SIGN)
+	if err != nil {
+		return nil, err
+	}
+
+	now := timeutil.Now()
+	notAfter := timeutil.Unix(int64(network.Certificate.NotAfter), 0)
+	if now.After(notAfter) {
+		return nil, errors.New("network certificate expired")
+	}
+
+	cert, err := dao.SignCertificateRequest(csr, notAfter.Sub(now), t.key)
+	if err != nil {
+		return nil, err
+	}
+	cert.ParentOneof = &certificate.Certificate_Parent{Parent: network.Certificate}
+	return cert, nil
+}
+
 // RemoveNetwork ...
 func (t *Dialer) RemoveNetwork(network *networkv1.Network) {
 	t.lock.Lock()
@@ -150,6 +187,32 @@ func (t *Dialer) ServerWithHostAddr(ctx context.Context, networkKey []byte, port
 	return rpc.NewServer(t.logger, dialer), nil
 }
 
+// StreamServerDialer returns a dialer for servers that accept calls over vpn
+// streams.
+func (t *Dialer) StreamServerDialer(ctx context.Context, networkKey []byte, port uint16, publisher HostAddrPublisher) (rpc.Dialer, error) {
+	cert, node, err := t.hostCertAndVPNNode(ctx, networkKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VPNStreamServerDialer{
+		Logger:    t.logger,
+		Node:      node,
+		Port:      port,
+		Publisher: publisher,
+		CertFunc:  cert.Load,
+	}, nil
+}
+
+// StreamServer ...
+func (t *Dialer) StreamServer(ctx context.Context, networkKey []byte, key *key.Key, salt []byte) (*rpc.Server, error) {
+	dialer, err := t.StreamServerDialer(ctx, networkKey, 0, &DHTHostAddrPublisher{key, salt})
+	if err != nil {
+		return nil, err
+	}
+	return rpc.NewServer(t.logger, dialer), nil
+}
+
 // ClientDialer ...
 func (t *Dialer) ClientDialer(ctx context.Context, networkKey []byte, resolver HostAddrResolver) (rpc.Dialer, error) {
 	cert, node, err := t.hostCertAndVPNNode(ctx, networkKey)
@@ -165,6 +228,33 @@ func (t *Dialer) ClientDialer(ctx context.Context, networkKey []byte, resolver H
 	}, nil
 }
 
+// AnonymousClientDialer returns a dialer that routes requests through onion
+// circuits using a certificate for an anonymous key in place of the host
+// certificate.
+func (t *Dialer) AnonymousClientDialer(ctx context.Context, networkKey []byte, resolver HostAddrResolver) (rpc.Dialer, error) {
+	cert, node, err := t.hostCertAndVPNNode(ctx, networkKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VPNDialer{
+		Logger:       t.logger,
+		Node:         node,
+		Resolver:     resolver,
+		CertFunc:     cert.anonymousCert.Load,
+		AnonymousKey: cert.anonymousKey,
+	}, nil
+}
+
+// AnonymousClient ...
+func (t *Dialer) AnonymousClient(ctx context.Context, networkKey, key, salt []byte) (*RPCClient, error) {
+	dialer, err := t.AnonymousClientDialer(ctx, networkKey, &DHTHostAddrResolver{key, salt})
+	if err != nil {
+		return nil, err
+	}
+	return NewRPCClient(t.logger, dialer)
+}
+
 // Client ...
 func (t *Dialer) Client(ctx context.Context, networkKey, key, salt []byte) (*RPCClient, error) {
 	dialer, err := t.ClientDialer(ctx, networkKey, &DHTHostAddrResolver{key, salt})
@@ -174,6 +264,30 @@ func (t *Dialer) Client(ctx context.Context, networkKey, key, salt []byte) (*RPC
 	return NewRPCClient(t.logger, dialer)
 }
 
+// StreamClientDialer returns a dialer for clients of StreamServer.
+func (t *Dialer) StreamClientDialer(ctx context.Context, networkKey []byte, resolver HostAddrResolver) (rpc.Dialer, error) {
+	cert, node, err := t.hostCertAndVPNNode(ctx, networkKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VPNStreamDialer{
+		Logger:   t.logger,
+		Node:     node,
+		Resolver: resolver,
+		CertFunc: cert.Load,
+	}, nil
+}
+
+// StreamClient ...
+func (t *Dialer) StreamClient(ctx context.Context, networkKey, key, salt []byte) (*RPCClient, error) {
+	dialer, err := t.StreamClientDialer(ctx, networkKey, &DHTHostAddrResolver{key, salt})
+	if err != nil {
+		return nil, err
+	}
+	return NewRPCClient(t.logger, dialer)
+}
+
 // ClientWithHostAddr ...
 func (t *Dialer) ClientWithHostAddr(ctx context.Context, networkKey []byte, hostID kademlia.ID, port uint16) (*RPCClient, error) {
 	dialer, err := t.ClientDialer(ctx, networkKey, &StaticHostAddrResolver{HostAddr{hostID, port}})
@@ -197,6 +311,8 @@ func (h *hostCertKey) Less(o llrb.Item) bool {
 
 type hostCert struct {
 	atomic.Pointer[certificate.Certificate]
+	anonymousKey  *key.Key
+	anonymousCert atomic.Pointer[certificate.Certificate]
 }
 
 func (h *hostCert) Key() []byte {
@@ -0,0 +1,304 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package dialer
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/MemeLabs/protobuf/pkg/bytereader"
+	"github.com/MemeLabs/protobuf/pkg/rpc"
+	"github.com/MemeLabs/strims/pkg/apis/type/certificate"
+	"github.com/MemeLabs/strims/pkg/vpn"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	vpnStreamHandshakeTimeout  = 10 * time.Second
+	vpnStreamMaxCertificateLen = 16 * 1024
+)
+
+var errStreamServerCall = errors.New("stream server transport does not support outgoing calls")
+
+// VPNStreamDialer dials rpc clients over reliable vpn streams. the peers
+// exchange certificates once when the stream opens instead of attaching them
+// to every call.
+type VPNStreamDialer struct {
+	Logger   *zap.Logger
+	Node     *vpn.Node
+	Resolver HostAddrResolver
+	CertFunc VPNCertFunc
+}
+
+// Dial ...
+func (d *VPNStreamDialer) Dial(ctx context.Context, dispatcher rpc.Dispatcher) (rpc.Transport, error) {
+	return &VPNStreamTransport{
+		ctx:         ctx,
+		logger:      d.Logger,
+		node:        d.Node,
+		resolver:    d.Resolver,
+		certificate: d.CertFunc,
+		dispatcher:  dispatcher,
+	}, nil
+}
+
+// VPNStreamTransport opens a stream to the resolved server on the first call
+// and reopens it if it fails.
+type VPNStreamTransport struct {
+	ctx         context.Context
+	logger      *zap.Logger
+	node        *vpn.Node
+	resolver    HostAddrResolver
+	certificate VPNCertFunc
+	dispatcher  rpc.Dispatcher
+	lock        sync.Mutex
+	conn        *vpnStreamConn
+}
+
+// Listen ...
+func (t *VPNStreamTransport) Listen() error {
+	<-t.ctx.Done()
+
+	t.lock.Lock()
+	if t.conn != nil {
+		t.conn.Close()
+	}
+	t.lock.Unlock()
+
+	return t.ctx.Err()
+}
+
+// Call ...
+func (t *VPNStreamTransport) Call(call *rpc.CallOut, fn rpc.ResponseFunc) error {
+	c, err := t.connect(call.Context())
+	if err != nil {
+		return err
+	}
+	return c.Call(call, fn)
+}
+
+func (t *VPNStreamTransport) connect(ctx context.Context) (*vpnStreamConn, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.conn != nil {
+		return t.conn, nil
+	}
+
+	addr, err := t.resolver.Resolve(ctx, t.node)
+	if err != nil {
+		return nil, err
+	}
+	s, err := vpn.DialStream(ctx, t.node.Network, addr.HostID, addr.Port)
+	if err != nil {
+		return nil, err
+	}
+	c, err := newVPNStreamConn(t.ctx, t.logger, s, t.certificate, t.dispatcher)
+	if err != nil {
+		return nil, err
+	}
+	t.conn = c
+
+	go func() {
+		if err := c.Listen(); err != nil {
+			t.logger.Debug("rpc stream closed", zap.Error(err))
+		}
+
+		t.lock.Lock()
+		if t.conn == c {
+			t.conn = nil
+		}
+		t.lock.Unlock()
+	}()
+
+	return c, nil
+}
+
+// VPNStreamServerDialer ...
+type VPNStreamServerDialer struct {
+	Logger    *zap.Logger
+	Node      *vpn.Node
+	Port      uint16
+	Publisher HostAddrPublisher
+	CertFunc  VPNCertFunc
+}
+
+// Dial ...
+func (d *VPNStreamServerDialer) Dial(ctx context.Context, dispatcher rpc.Dispatcher) (rpc.Transport, error) {
+	t := &VPNStreamServerTransport{
+		ctx:         ctx,
+		logger:      d.Logger,
+		node:        d.Node,
+		port:        d.Port,
+		certificate: d.CertFunc,
+		dispatcher:  dispatcher,
+	}
+
+	if t.port == 0 {
+		port, err := d.Node.Network.ReservePort()
+		if err != nil {
+			return nil, err
+		}
+		t.port = port
+		t.reserved = true
+	}
+
+	l, err := vpn.ListenStream(ctx, d.Node.Network, t.port)
+	if err != nil {
+		t.releasePort()
+		return nil, err
+	}
+	t.listener = l
+
+	if d.Publisher != nil {
+		addr := &HostAddr{
+			HostID: d.Node.Host.VNIC().ID(),
+			Port:   t.port,
+		}
+		if err := d.Publisher.Publish(ctx, d.Node, addr); err != nil {
+			l.Close()
+			t.releasePort()
+			return nil, err
+		}
+	}
+
+	return t, nil
+}
+
+// VPNStreamServerTransport ...
+type VPNStreamServerTransport struct {
+	ctx         context.Context
+	logger      *zap.Logger
+	node        *vpn.Node
+	port        uint16
+	reserved    bool
+	certificate VPNCertFunc
+	dispatcher  rpc.Dispatcher
+	listener    *vpn.StreamListener
+}
+
+// Listen ...
+func (t *VPNStreamServerTransport) Listen() error {
+	defer t.releasePort()
+	defer t.listener.Close()
+
+	for {
+		s, err := t.listener.Accept()
+		if err != nil {
+			if t.ctx.Err() != nil {
+				return t.ctx.Err()
+			}
+			return err
+		}
+
+		go t.serve(s.(*vpn.Stream))
+	}
+}
+
+func (t *VPNStreamServerTransport) serve(s *vpn.Stream) {
+	c, err := newVPNStreamConn(t.ctx, t.logger, s, t.certificate, t.dispatcher)
+	if err != nil {
+		t.logger.Debug("rpc stream handshake failed", zap.Error(err))
+		return
+	}
+	if err := c.Listen(); err != nil {
+		t.logger.Debug("rpc stream closed", zap.Error(err))
+	}
+}
+
+// Call ...
+func (t *VPNStreamServerTransport) Call(call *rpc.CallOut, fn rpc.ResponseFunc) error {
+	return errStreamServerCall
+}
+
+func (t *VPNStreamServerTransport) releasePort() {
+	if t.reserved {
+		t.node.Network.ReleasePort(t.port)
+	}
+}
+
+// newVPNStreamConn verifies the peer's certificate and returns an rpc
+// transport over s. the stream is closed if the handshake fails.
+func newVPNStreamConn(ctx context.Context, logger *zap.Logger, s *vpn.Stream, certFunc VPNCertFunc, dispatcher rpc.Dispatcher) (*vpnStreamConn, error) {
+	cert, err := exchangeVPNStreamCertificates(s, certFunc())
+	if err != nil {
+		s.Close()
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(WithVPNCertificate(ctx, cert))
+	transport, err := (&rpc.RWDialer{Logger: logger, ReadWriter: s}).Dial(ctx, dispatcher)
+	if err != nil {
+		cancel()
+		s.Close()
+		return nil, err
+	}
+
+	go func() {
+		<-ctx.Done()
+		s.Close()
+	}()
+
+	return &vpnStreamConn{
+		Transport: transport,
+		cancel:    cancel,
+	}, nil
+}
+
+type vpnStreamConn struct {
+	rpc.Transport
+	cancel context.CancelFunc
+}
+
+// Listen reads calls from the stream until it closes.
+func (c *vpnStreamConn) Listen() error {
+	defer c.cancel()
+	return c.Transport.Listen()
+}
+
+// Close ...
+func (c *vpnStreamConn) Close() {
+	c.cancel()
+}
+
+// exchangeVPNStreamCertificates sends the local certificate and reads and
+// verifies the peer's.
+func exchangeVPNStreamCertificates(s *vpn.Stream, local *certificate.Certificate) (*certificate.Certificate, error) {
+	s.SetDeadline(time.Now().Add(vpnStreamHandshakeTimeout))
+	defer s.SetDeadline(time.Time{})
+
+	b, err := proto.Marshal(local)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.Write(append(binary.AppendUvarint(nil, uint64(len(b))), b...)); err != nil {
+		return nil, err
+	}
+
+	n, err := binary.ReadUvarint(bytereader.New(s))
+	if err != nil {
+		return nil, err
+	}
+	if n > vpnStreamMaxCertificateLen {
+		return nil, errors.New("certificate too large")
+	}
+	b = make([]byte, n)
+	if _, err := io.ReadFull(s, b); err != nil {
+		return nil, err
+	}
+
+	cert := &certificate.Certificate{}
+	if err := proto.Unmarshal(b, cert); err != nil {
+		return nil, err
+	}
+	if err := verifyHostCertificate(s.RemoteAddr().(vpn.StreamAddr).HostID, cert, local); err != nil {
+		return nil, err
+	}
+	return cert, nil
+}
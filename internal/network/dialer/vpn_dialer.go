@@ -14,6 +14,8 @@ import (
 	"github.com/MemeLabs/protobuf/pkg/rpc"
 	"github.com/MemeLabs/strims/internal/dao"
 	"github.com/MemeLabs/strims/pkg/apis/type/certificate"
+	"github.com/MemeLabs/strims/pkg/apis/type/key"
+	"github.com/MemeLabs/strims/pkg/kademlia"
 	"github.com/MemeLabs/strims/pkg/pool"
 	"github.com/MemeLabs/strims/pkg/vpn"
 	"github.com/petar/GoLLRB/llrb"
@@ -27,6 +29,9 @@ type VPNDialer struct {
 	Node     *vpn.Node
 	Resolver HostAddrResolver
 	CertFunc VPNCertFunc
+	// AnonymousKey is the key for anonymous onion routed requests. if set
+	// CertFunc should return a certificate for this key.
+	AnonymousKey *key.Key
 }
 
 // Dial ...
@@ -37,13 +42,14 @@ func (d *VPNDialer) Dial(ctx context.Context, dispatcher rpc.Dispatcher) (rpc.Tr
 	}
 
 	return &VPNTransport{
-		ctx:         ctx,
-		logger:      d.Logger,
-		node:        d.Node,
-		resolver:    d.Resolver,
-		port:        port,
-		dispatcher:  dispatcher,
-		certificate: d.CertFunc,
+		ctx:          ctx,
+		logger:       d.Logger,
+		node:         d.Node,
+		resolver:     d.Resolver,
+		port:         port,
+		dispatcher:   dispatcher,
+		certificate:  d.CertFunc,
+		anonymousKey: d.AnonymousKey,
 	}, nil
 }
 
@@ -90,15 +96,16 @@ func (d *VPNServerDialer) Dial(ctx context.Context, dispatcher rpc.Dispatcher) (
 
 // VPNTransport ...
 type VPNTransport struct {
-	ctx         context.Context
-	logger      *zap.Logger
-	node        *vpn.Node
-	resolver    HostAddrResolver
-	certificate VPNCertFunc
-	port        uint16
-	callsIn     vpnCallMap
-	callsOut    vpnCallMap
-	dispatcher  rpc.Dispatcher
+	ctx          context.Context
+	logger       *zap.Logger
+	node         *vpn.Node
+	resolver     HostAddrResolver
+	certificate  VPNCertFunc
+	anonymousKey *key.Key
+	port         uint16
+	callsIn      vpnCallMap
+	callsOut     vpnCallMap
+	dispatcher   rpc.Dispatcher
 }
 
 // Listen ...
@@ -135,7 +142,7 @@ func (t *VPNTransport) HandleMessage(msg *vpn.Message) error {
 		Port:   msg.Header.SrcPort,
 	}
 
-	ctx := context.WithValue(t.ctx, vpnCertificateKey, cert)
+	ctx := WithVPNCertificate(t.ctx, cert)
 	parentCallAccessor := &vpnParentCallAccessor{
 		addr:     addr,
 		id:       req.ParentId,
@@ -165,6 +172,9 @@ func (t *VPNTransport) send(ctx context.Context, call *rpcapi.Call, addr *HostAd
 	}
 	call.Headers[vpnCertificateHeader] = b
 
+	if t.anonymousKey != nil {
+		return t.node.Onion.SendProto(t.anonymousKey, addr.HostID, addr.Port, t.port, call)
+	}
 	return t.node.Network.SendProto(addr.HostID, addr.Port, t.port, call)
 }
 
@@ -191,11 +201,17 @@ func (t *VPNTransport) Call(call *rpc.CallOut, fn rpc.ResponseFunc) error {
 const vpnCertificateHeader = "certificate"
 
 func (t *VPNTransport) verifyMessage(msg *vpn.Message, req *rpcapi.Call, cert *certificate.Certificate) error {
-	if !bytes.Equal(cert.GetKey(), msg.Trailer.Entries[0].HostID.Bytes(nil)) {
+	return verifyHostCertificate(msg.Trailer.Entries[0].HostID, cert, t.certificate())
+}
+
+// verifyHostCertificate checks that cert was issued to hostID by the network
+// local belongs to.
+func verifyHostCertificate(hostID kademlia.ID, cert, local *certificate.Certificate) error {
+	if !bytes.Equal(cert.GetKey(), hostID.Bytes(nil)) {
 		return errors.New("certificate host id mismatch")
 	}
 
-	if !bytes.Equal(dao.CertificateRoot(t.certificate()).Key, cert.GetParent().GetParent().GetKey()) {
+	if !bytes.Equal(dao.CertificateRoot(local).Key, cert.GetParent().GetParent().GetKey()) {
 		return errors.New("network key mismatch")
 	}
 	if err := dao.VerifyCertificate(cert); err != nil {
@@ -211,6 +227,11 @@ type vpnCertificateKeyType struct{}
 
 var vpnCertificateKey vpnCertificateKeyType
 
+// WithVPNCertificate returns a copy of ctx carrying the caller's certificate.
+func WithVPNCertificate(ctx context.Context, cert *certificate.Certificate) context.Context {
+	return context.WithValue(ctx, vpnCertificateKey, cert)
+}
+
 // VPNCertificate ...
 func VPNCertificate(ctx context.Context) *certificate.Certificate {
 	return ctx.Value(vpnCertificateKey).(*certificate.Certificate)
@@ -16,6 +16,7 @@ import (
 	"github.com/MemeLabs/strims/internal/event"
 	networkv1 "github.com/MemeLabs/strims/pkg/apis/network/v1"
 	networkv1ca "github.com/MemeLabs/strims/pkg/apis/network/v1/ca"
+	"github.com/MemeLabs/strims/pkg/apis/type/certificate"
 	"github.com/MemeLabs/strims/pkg/logutil"
 	"github.com/MemeLabs/strims/pkg/timeutil"
 	"github.com/MemeLabs/strims/pkg/vnic"
@@ -130,6 +131,7 @@ func (p *peerService) UpdateCertificate(ctx context.Context, req *networkv1.Netw
 
 	link := li.(*networkBinding)
 	link.peerCertTrusted = true
+	link.peerCertificate = req.Certificate
 
 	if err := p.openNetwork(link); err != nil {
 		return nil, err
@@ -402,6 +404,7 @@ func (p *peerService) handleNetworkBindings(networkBindings, peerNetworkBindings
 			peerPort:         uint16(peerBinding.Port),
 			localCertTrusted: isCertificateTrusted(binding.Certificate),
 			peerCertTrusted:  isCertificateTrusted(peerBinding.Certificate),
+			peerCertificate:  peerBinding.Certificate,
 		}
 		p.links.ReplaceOrInsert(link)
 
@@ -429,9 +432,10 @@ func (p *peerService) openNetwork(link *networkBinding) error {
 	node.Network.AddPeer(p.vnicPeer, link.localPort, link.peerPort)
 
 	p.observers.EmitLocal(event.NetworkPeerOpen{
-		PeerID:     p.id,
-		NetworkID:  link.networkID,
-		NetworkKey: link.networkKey,
+		PeerID:      p.id,
+		NetworkID:   link.networkID,
+		NetworkKey:  link.networkKey,
+		Certificate: link.peerCertificate,
 	})
 
 	p.logger.Info(
@@ -452,6 +456,7 @@ type networkBinding struct {
 	peerPort         uint16
 	localCertTrusted bool
 	peerCertTrusted  bool
+	peerCertificate  *certificate.Certificate
 	open             bool
 }
 
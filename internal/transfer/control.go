@@ -4,6 +4,7 @@
 package transfer
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"errors"
@@ -19,8 +20,11 @@ import (
 	"github.com/MemeLabs/strims/internal/event"
 	"github.com/MemeLabs/strims/internal/peer"
 	transferv1 "github.com/MemeLabs/strims/pkg/apis/transfer/v1"
+	"github.com/MemeLabs/strims/pkg/apis/type/certificate"
+	swarmpb "github.com/MemeLabs/strims/pkg/apis/type/swarm"
 	"github.com/MemeLabs/strims/pkg/hashmap"
 	"github.com/MemeLabs/strims/pkg/kademlia"
+	"github.com/MemeLabs/strims/pkg/kv"
 	"github.com/MemeLabs/strims/pkg/logutil"
 	"github.com/MemeLabs/strims/pkg/ppspp"
 	"github.com/MemeLabs/strims/pkg/timeutil"
@@ -33,17 +37,32 @@ import (
 const (
 	peerSearchTickRate = time.Second
 	peerSearchInterval = 30 * time.Second
+
+	peerExchangeQueueSize = 16
+
+	swarmCacheCheckpointInterval = time.Minute
+)
+
+// errors ...
+var (
+	ErrTransferNotFound          = errors.New("transfer not found")
+	ErrContentKeyNotFound        = errors.New("content key not found")
+	ErrContentKeyPolicyViolation = errors.New("peer certificate does not satisfy content key policy")
+	ErrContentKeyPolicySignature = errors.New("content key policy not signed by swarm owner")
 )
 
 type Control interface {
 	peer.PeerHandler
 	Run()
 	Add(swarm *ppspp.Swarm, salt []byte) ID
+	AddPersistent(swarm *ppspp.Swarm, salt []byte) ID
 	Find(swarm ppspp.SwarmID, salt []byte) (ID, *ppspp.Swarm, bool)
 	Remove(id ID)
 	List() []*transferv1.Transfer
 	Publish(id ID, networkKey []byte)
 	IsPublished(id ID, networkKey []byte) bool
+	SwarmMetrics(now timeutil.Time) []SwarmMetrics
+	SetContentKeyPolicy(id ID, policy *transferv1.ContentKeyPolicy) error
 }
 
 // NewControl ...
@@ -108,7 +127,7 @@ func (c *control) Run() {
 			case event.NetworkStop:
 				c.handleNetworkStop(dao.NetworkKey(e.Network))
 			case event.NetworkPeerOpen:
-				c.handleNetworkPeerOpen(e.PeerID, e.NetworkKey)
+				c.handleNetworkPeerOpen(e.PeerID, e.NetworkKey, e.Certificate)
 			case event.NetworkPeerClose:
 				c.handleNetworkPeerClose(e.PeerID, e.NetworkKey)
 			}
@@ -175,17 +194,10 @@ func (c *control) loadNetworkPeers(t *transfer, n *network) error {
 			zap.Stringer("peer", p.HostID),
 			zap.Bool("connected", c.vpn.VNIC().HasPeer(p.HostID)),
 		)
-		if c.vpn.VNIC().HasPeer(p.HostID) {
-			continue
+		if c.dialPeer(node, p.HostID) {
+			// c.candidates.addToCandidateThing(node, t, p)
+			count++
 		}
-		if _, ok := c.hackDialedPeers[p.HostID]; ok {
-			continue
-		}
-		c.hackDialedPeers[p.HostID] = struct{}{}
-
-		go node.PeerExchange.Connect(p.HostID)
-		// c.candidates.addToCandidateThing(node, t, p)
-		count++
 	}
 
 	c.logger.Debug(
@@ -198,6 +210,77 @@ func (c *control) loadNetworkPeers(t *transfer, n *network) error {
 	return nil
 }
 
+// dialPeer connects to hostID through node unless we're already connected or
+// have dialed it before.
+func (c *control) dialPeer(node *vpn.Node, hostID kademlia.ID) bool {
+	if c.vpn.VNIC().HasPeer(hostID) {
+		return false
+	}
+
+	c.lock.Lock()
+	_, ok := c.hackDialedPeers[hostID]
+	c.hackDialedPeers[hostID] = struct{}{}
+	c.lock.Unlock()
+	if ok {
+		return false
+	}
+
+	go node.PeerExchange.Connect(hostID)
+	return true
+}
+
+// runPeerExchange dials peers learned from the swarm's PEX responses through
+// each network the transfer is published to.
+func (c *control) runPeerExchange(t *transfer, ch chan []byte) {
+	defer t.swarm.StopNotifyingPeerExchange(ch)
+
+	for {
+		select {
+		case b := <-ch:
+			hostID, err := kademlia.UnmarshalID(b)
+			if err != nil {
+				c.logger.Debug("received invalid peer id from pex", zap.Error(err))
+				continue
+			}
+			if hostID.Equals(c.vpn.VNIC().ID()) {
+				continue
+			}
+
+			for _, node := range c.transferNodes(t) {
+				c.logger.Debug(
+					"found peer via pex",
+					zap.Stringer("swarm", t.swarm.ID()),
+					logutil.ByteHex("salt", t.salt),
+					zap.Stringer("peer", hostID),
+				)
+				if c.dialPeer(node, hostID) {
+					break
+				}
+			}
+		case <-t.ctx.Done():
+			return
+		}
+	}
+}
+
+// transferNodes returns the vpn nodes for the networks t is published to.
+func (c *control) transferNodes(t *transfer) []*vpn.Node {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	var nodes []*vpn.Node
+	for it := c.networks.Iterate(); it.Next(); {
+		n := it.Value()
+		if _, ok := n.transfers[t.id]; !ok {
+			continue
+		}
+		if node, ok := c.vpn.Node(n.key); ok {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
 func (c *control) handleNetworkStart(networkKey []byte) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -217,7 +300,7 @@ func (c *control) handleNetworkStop(networkKey []byte) {
 	c.searchQueue.DeleteNetwork(n)
 }
 
-func (c *control) handleNetworkPeerOpen(peerID uint64, networkKey []byte) {
+func (c *control) handleNetworkPeerOpen(peerID uint64, networkKey []byte, cert *certificate.Certificate) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
@@ -226,6 +309,8 @@ func (c *control) handleNetworkPeerOpen(peerID uint64, networkKey []byte) {
 		return
 	}
 
+	p.SetCertificate(networkKey, cert)
+
 	n := c.getOrInsertNetwork(networkKey)
 	n.peers[peerID] = p
 
@@ -238,6 +323,10 @@ func (c *control) handleNetworkPeerClose(peerID uint64, networkKey []byte) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
+	if p, ok := c.peers[peerID]; ok {
+		p.DeleteCertificate(networkKey)
+	}
+
 	n, ok := c.networks.Get(networkKey)
 	if !ok {
 		return
@@ -256,9 +345,12 @@ func (c *control) AddPeer(id uint64, vnicPeer *vnic.Peer, server *rpc.Server, cl
 	p := &peerService{
 		logger:     c.logger.With(zap.Stringer("peer", vnicPeer.HostID())),
 		ctx:        ctx,
+		hostID:     vnicPeer.HostID(),
 		runnerPeer: rp,
 		client:     transferv1.NewTransferPeerClient(client),
-		transfers:  map[ID]*peerTransfer{},
+
+		transfers:    map[ID]*peerTransfer{},
+		certificates: hashmap.New[[]byte, *certificate.Certificate](hashmap.NewByteInterface[[]byte]()),
 	}
 	transferv1.RegisterTransferPeerService(server, p)
 	vnicPeer.SetHandler(vnic.TransferPort, func(_ *vnic.Peer, f vnic.Frame) error {
@@ -290,29 +382,135 @@ func (c *control) RemovePeer(id uint64) {
 
 // Add ...
 func (c *control) Add(swarm *ppspp.Swarm, salt []byte) ID {
+	return c.add(swarm, salt, false)
+}
+
+// AddPersistent adds a swarm whose cache is restored from the store and saved
+// periodically and when the transfer is removed or the control shuts down.
+func (c *control) AddPersistent(swarm *ppspp.Swarm, salt []byte) ID {
+	return c.add(swarm, salt, true)
+}
+
+func (c *control) add(swarm *ppspp.Swarm, salt []byte, persistent bool) ID {
 	ctx, close := context.WithCancel(context.Background())
 
 	t := &transfer{
-		id:    NewID(swarm.ID(), salt),
-		salt:  salt,
-		ctx:   ctx,
-		close: close,
-		swarm: swarm,
+		id:         NewID(swarm.ID(), salt),
+		salt:       salt,
+		ctx:        ctx,
+		close:      close,
+		swarm:      swarm,
+		persistent: persistent,
+	}
+
+	if persistent {
+		c.importCache(t)
 	}
 
 	c.lock.Lock()
 	c.transfers[t.id] = t
 	c.lock.Unlock()
 
+	pex := make(chan []byte, peerExchangeQueueSize)
+	swarm.NotifyPeerExchange(pex)
+	go c.runPeerExchange(t, pex)
+
+	if persistent {
+		go c.runCacheCheckpoints(t)
+	}
+
 	c.logger.Debug(
 		"added swarm",
 		logutil.ByteHex("id", t.id[:]),
 		zap.Stringer("swarm", swarm.ID()),
+		zap.Bool("persistent", persistent),
 	)
 
 	return t.id
 }
 
+func (c *control) importCache(t *transfer) {
+	cache, err := dao.GetSwarmCache(c.store, t.swarm.ID(), t.salt)
+	if err != nil {
+		if !errors.Is(err, kv.ErrRecordNotFound) {
+			c.logger.Debug("cache read failed", zap.Stringer("swarm", t.swarm.ID()), zap.Error(err))
+		}
+		return
+	}
+
+	if err := t.swarm.ImportCache(cache); err != nil {
+		if errors.Is(err, ppspp.ErrContentKeyNotSet) {
+			// encrypted swarms get their key from peers. the import is retried by
+			// the peer service once the key arrives.
+			c.logger.Debug("deferring cache import until content key is set", zap.Stringer("swarm", t.swarm.ID()))
+			t.cacheLock.Lock()
+			t.pendingCache = cache
+			t.cacheLock.Unlock()
+			return
+		}
+		c.logger.Debug("cache import failed", zap.Stringer("swarm", t.swarm.ID()), zap.Error(err))
+		return
+	}
+
+	t.cacheLock.Lock()
+	t.cacheVersion = t.swarm.CacheVersion()
+	t.cacheLock.Unlock()
+
+	c.logger.Debug(
+		"imported swarm cache",
+		zap.Stringer("swarm", t.swarm.ID()),
+		logutil.ByteHex("salt", t.salt),
+		zap.Int("size", len(cache.Data)),
+	)
+}
+
+func (c *control) exportCache(t *transfer) {
+	t.cacheLock.Lock()
+	defer t.cacheLock.Unlock()
+
+	// don't replace the stored cache before it is imported
+	if t.pendingCache != nil {
+		return
+	}
+
+	version := t.swarm.CacheVersion()
+	if version == t.cacheVersion {
+		return
+	}
+
+	cache, err := t.swarm.ExportCache()
+	if err != nil {
+		c.logger.Debug("cache export failed", zap.Stringer("swarm", t.swarm.ID()), zap.Error(err))
+		return
+	}
+	if err := dao.SetSwarmCache(c.store, t.swarm.ID(), t.salt, cache); err != nil {
+		c.logger.Debug("cache write failed", zap.Stringer("swarm", t.swarm.ID()), zap.Error(err))
+		return
+	}
+	t.cacheVersion = version
+}
+
+// runCacheCheckpoints saves the swarm cache at intervals until the transfer
+// is removed. Checkpoints are skipped if the swarm hasn't changed since the
+// last one. The final checkpoint is written by Remove or when the control
+// shuts down.
+func (c *control) runCacheCheckpoints(t *transfer) {
+	ticker := timeutil.DefaultTickEmitter.Ticker(swarmCacheCheckpointInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.exportCache(t)
+		case <-c.ctx.Done():
+			c.exportCache(t)
+			return
+		case <-t.ctx.Done():
+			return
+		}
+	}
+}
+
 // Find ...
 func (c *control) Find(swarmID ppspp.SwarmID, salt []byte) (ID, *ppspp.Swarm, bool) {
 	id := NewID(swarmID, salt)
@@ -330,13 +528,26 @@ func (c *control) Find(swarmID ppspp.SwarmID, salt []byte) (ID, *ppspp.Swarm, bo
 // Remove ...
 func (c *control) Remove(id ID) {
 	c.lock.Lock()
-	defer c.lock.Unlock()
-
 	t, ok := c.transfers[id]
+	c.lock.Unlock()
 	if !ok {
 		return
 	}
 
+	// write the final checkpoint before removing the transfer so swarms added
+	// again with the same id restore the latest cache. exports can be slow so
+	// they run outside the control lock.
+	if t.persistent {
+		c.exportCache(t)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.transfers[id] != t {
+		return
+	}
+
 	delete(c.transfers, id)
 	for it := c.networks.Iterate(); it.Next(); {
 		delete(it.Value().transfers, id)
@@ -410,6 +621,24 @@ func (c *control) Publish(id ID, networkKey []byte) {
 	}()
 }
 
+// SetContentKeyPolicy sets the policy peers must satisfy to receive the
+// content key of the encrypted swarm in transfer id. The policy must be
+// signed by the swarm owner so peers that relay the key can prove it.
+func (c *control) SetContentKeyPolicy(id ID, policy *transferv1.ContentKeyPolicy) error {
+	c.lock.Lock()
+	t, ok := c.transfers[id]
+	c.lock.Unlock()
+
+	if !ok {
+		return ErrTransferNotFound
+	}
+	if err := verifyContentKeyPolicy(t.swarm, policy); err != nil {
+		return err
+	}
+	t.setContentKeyPolicy(policy)
+	return nil
+}
+
 func (c *control) IsPublished(id ID, networkKey []byte) bool {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -417,6 +646,44 @@ func (c *control) IsPublished(id ID, networkKey []byte) bool {
 	return ok && n.transfers[id] != nil
 }
 
+// SwarmMetrics returns the transfer stats for each swarm and the peers it is
+// running with.
+func (c *control) SwarmMetrics(now timeutil.Time) []SwarmMetrics {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	ms := make([]SwarmMetrics, 0, len(c.transfers))
+	swarms := make(map[*ppspp.Swarm]int, len(c.transfers))
+	for _, t := range c.transfers {
+		swarms[t.swarm] = len(ms)
+		ms = append(ms, SwarmMetrics{
+			ID:    t.id,
+			Swarm: t.swarm,
+		})
+	}
+
+	for _, p := range c.peers {
+		for s, snap := range p.runnerPeer.MetricsSnapshot(now).Swarms {
+			i, ok := swarms[s]
+			if !ok {
+				continue
+			}
+
+			m := &ms[i]
+			m.Read.Count += snap.Read.Count
+			m.Read.Rate += snap.Read.Rate
+			m.Write.Count += snap.Write.Count
+			m.Write.Rate += snap.Write.Rate
+			m.Peers = append(m.Peers, PeerSwarmMetrics{
+				HostID:               p.hostID,
+				SwarmMetricsSnapshot: snap,
+			})
+		}
+	}
+
+	return ms
+}
+
 func (c *control) getOrInsertNetwork(networkKey []byte) *network {
 	n, ok := c.networks.Get(networkKey)
 	if !ok {
@@ -432,11 +699,74 @@ func (c *control) getOrInsertNetwork(networkKey []byte) *network {
 
 // transfer ...
 type transfer struct {
-	id    ID
-	salt  []byte
-	ctx   context.Context
-	close context.CancelFunc
-	swarm *ppspp.Swarm
+	id         ID
+	salt       []byte
+	ctx        context.Context
+	close      context.CancelFunc
+	swarm      *ppspp.Swarm
+	persistent bool
+
+	cacheLock    sync.Mutex
+	cacheVersion uint64
+	pendingCache *swarmpb.Cache
+
+	policyLock       sync.Mutex
+	contentKeyPolicy *transferv1.ContentKeyPolicy
+}
+
+// importPendingCache imports the cache deferred by the control until the
+// swarm's content key was set.
+func (t *transfer) importPendingCache() error {
+	t.cacheLock.Lock()
+	defer t.cacheLock.Unlock()
+
+	cache := t.pendingCache
+	if cache == nil {
+		return nil
+	}
+	t.pendingCache = nil
+	if err := t.swarm.ImportCache(cache); err != nil {
+		return err
+	}
+	t.cacheVersion = t.swarm.CacheVersion()
+	return nil
+}
+
+func (t *transfer) setContentKeyPolicy(policy *transferv1.ContentKeyPolicy) {
+	t.policyLock.Lock()
+	defer t.policyLock.Unlock()
+	t.contentKeyPolicy = policy
+}
+
+func (t *transfer) getContentKeyPolicy() *transferv1.ContentKeyPolicy {
+	t.policyLock.Lock()
+	defer t.policyLock.Unlock()
+	return t.contentKeyPolicy
+}
+
+// verifyContentKeyPolicy checks that policy is signed by the owner of swarm.
+func verifyContentKeyPolicy(swarm *ppspp.Swarm, policy *transferv1.ContentKeyPolicy) error {
+	if policy == nil || !bytes.Equal(policy.Key, swarm.ID()) {
+		return ErrContentKeyPolicySignature
+	}
+	if err := dao.VerifyMessage(policy); err != nil {
+		return ErrContentKeyPolicySignature
+	}
+	return nil
+}
+
+// SwarmMetrics ...
+type SwarmMetrics struct {
+	ppspp.MetricsSnapshot
+	ID    ID
+	Swarm *ppspp.Swarm
+	Peers []PeerSwarmMetrics
+}
+
+// PeerSwarmMetrics ...
+type PeerSwarmMetrics struct {
+	ppspp.SwarmMetricsSnapshot
+	HostID kademlia.ID
 }
 
 // network ...
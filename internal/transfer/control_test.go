@@ -0,0 +1,164 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package transfer
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"testing"
+
+	"github.com/MemeLabs/strims/internal/dao"
+	profilev1 "github.com/MemeLabs/strims/pkg/apis/profile/v1"
+	"github.com/MemeLabs/strims/pkg/hashmap"
+	"github.com/MemeLabs/strims/pkg/kademlia"
+	"github.com/MemeLabs/strims/pkg/kv"
+	"github.com/MemeLabs/strims/pkg/kv/kvtest"
+	"github.com/MemeLabs/strims/pkg/ppspp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestControl(t *testing.T) *control {
+	var k [dao.KeySize]byte
+	_, err := rand.Read(k[:])
+	require.NoError(t, err)
+	skey, err := dao.NewStorageKeyFromBytes(k[:], nil)
+	require.NoError(t, err)
+
+	profileKey, err := dao.GenerateKey()
+	require.NoError(t, err)
+	profile := &profilev1.Profile{Id: 1, Name: "test", Key: profileKey}
+	ps := dao.NewProfileStore(profile.Id, skey, kvtest.NewMemStore(), nil)
+	require.NoError(t, ps.Init())
+	require.NoError(t, dao.Profile.Set(ps, profile))
+	store, err := dao.NewReplicatedStore(ps)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	return &control{
+		ctx:         ctx,
+		logger:      zap.NewNop(),
+		store:       store,
+		transfers:   map[ID]*transfer{},
+		peers:       map[uint64]*peerService{},
+		searchQueue: newSearchQueue(int(peerSearchInterval / peerSearchTickRate)),
+		networks:    hashmap.New[[]byte, *network](hashmap.NewByteInterface[[]byte]()),
+
+		hackDialedPeers: map[kademlia.ID]struct{}{},
+	}
+}
+
+func newTestWriter(t *testing.T, o ppspp.SwarmOptions) *ppspp.Writer {
+	key, err := dao.GenerateKey()
+	require.NoError(t, err)
+	w, err := ppspp.NewWriter(ppspp.WriterOptions{
+		SwarmOptions: o,
+		Key:          key,
+	})
+	require.NoError(t, err)
+
+	chunk := bytes.Repeat([]byte{0xaa}, o.ChunkSize)
+	for i := 0; i < o.ChunksPerSignature*4; i++ {
+		_, err := w.Write(chunk)
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Flush())
+	return w
+}
+
+var testSwarmOptions = ppspp.SwarmOptions{
+	LiveWindow:         1 << 10,
+	ChunkSize:          1024,
+	ChunksPerSignature: 16,
+}
+
+func TestImportCacheAfterContentKey(t *testing.T) {
+	c := newTestControl(t)
+
+	o := testSwarmOptions
+	o.ContentEncryptionMethod = ppspp.AES256CTRContentEncryptionMethod
+	w := newTestWriter(t, o)
+	contentKey, ok := w.Swarm().ContentKey()
+	require.True(t, ok)
+
+	salt := []byte("salt")
+	cache, err := w.Swarm().ExportCache()
+	require.NoError(t, err)
+	require.NoError(t, dao.SetSwarmCache(c.store, w.Swarm().ID(), salt, cache))
+
+	swarm, err := ppspp.NewSwarm(w.Swarm().ID(), w.Swarm().URI().Options.SwarmOptions())
+	require.NoError(t, err)
+	id := c.AddPersistent(swarm, salt)
+	defer c.Remove(id)
+
+	tr := c.transfers[id]
+	assert.NotNil(t, tr.pendingCache, "expected the import to wait for the content key")
+
+	c.exportCache(tr)
+	stored, err := dao.GetSwarmCache(c.store, swarm.ID(), salt)
+	require.NoError(t, err)
+	assert.Equal(t, cache.Data, stored.Data, "expected the stored cache to be kept until it is imported")
+
+	require.NoError(t, swarm.SetContentKey(contentKey))
+	require.NoError(t, tr.importPendingCache())
+	assert.Nil(t, tr.pendingCache)
+
+	imported, err := swarm.ExportCache()
+	require.NoError(t, err)
+	assert.Equal(t, cache.Data, imported.Data, "expected the cache to be imported once the key is set")
+}
+
+func TestRemoveExportsCache(t *testing.T) {
+	c := newTestControl(t)
+
+	w := newTestWriter(t, testSwarmOptions)
+	uri := w.Swarm().URI()
+	salt := []byte("salt")
+	cache, err := w.Swarm().ExportCache()
+	require.NoError(t, err)
+
+	c.Remove(c.AddPersistent(w.Swarm(), salt))
+
+	swarm, err := ppspp.NewSwarm(uri.ID, uri.Options.SwarmOptions())
+	require.NoError(t, err)
+	c.Remove(c.Add(swarm, salt))
+
+	swarm, err = ppspp.NewSwarm(uri.ID, uri.Options.SwarmOptions())
+	require.NoError(t, err)
+	id := c.AddPersistent(swarm, salt)
+	defer c.Remove(id)
+
+	imported, err := swarm.ExportCache()
+	require.NoError(t, err, "expected the cache to survive removing the transfers")
+	assert.Equal(t, cache.Data, imported.Data)
+}
+
+func TestExportCacheSkipsUnchangedSwarms(t *testing.T) {
+	c := newTestControl(t)
+
+	w := newTestWriter(t, testSwarmOptions)
+	salt := []byte("salt")
+	id := c.AddPersistent(w.Swarm(), salt)
+	defer c.Remove(id)
+	tr := c.transfers[id]
+
+	c.exportCache(tr)
+	require.NoError(t, dao.DeleteSwarmCache(c.store, w.Swarm().ID(), salt))
+
+	c.exportCache(tr)
+	_, err := dao.GetSwarmCache(c.store, w.Swarm().ID(), salt)
+	assert.ErrorIs(t, err, kv.ErrRecordNotFound, "expected unchanged swarms to be skipped")
+
+	_, err = w.Write(bytes.Repeat([]byte{0xbb}, testSwarmOptions.ChunkSize*testSwarmOptions.ChunksPerSignature))
+	require.NoError(t, err)
+	require.NoError(t, w.Flush())
+
+	c.exportCache(tr)
+	_, err = dao.GetSwarmCache(c.store, w.Swarm().ID(), salt)
+	assert.NoError(t, err, "expected changed swarms to be saved")
+}
@@ -7,7 +7,11 @@ import (
 	"context"
 	"sync"
 
+	"github.com/MemeLabs/strims/internal/dao"
 	transferv1 "github.com/MemeLabs/strims/pkg/apis/transfer/v1"
+	"github.com/MemeLabs/strims/pkg/apis/type/certificate"
+	"github.com/MemeLabs/strims/pkg/hashmap"
+	"github.com/MemeLabs/strims/pkg/kademlia"
 	"github.com/MemeLabs/strims/pkg/logutil"
 	"github.com/MemeLabs/strims/pkg/ppspp"
 	"github.com/MemeLabs/strims/pkg/ppspp/codec"
@@ -20,12 +24,14 @@ var _ transferv1.TransferPeerService = (*peerService)(nil)
 type peerService struct {
 	logger     *zap.Logger
 	ctx        context.Context
+	hostID     kademlia.ID
 	runnerPeer *ppspp.RunnerPeer
 	client     *transferv1.TransferPeerClient
 
-	lock        sync.Mutex
-	transfers   map[ID]*peerTransfer
-	nextChannel uint64
+	lock         sync.Mutex
+	transfers    map[ID]*peerTransfer
+	nextChannel  uint64
+	certificates hashmap.Map[[]byte, *certificate.Certificate]
 }
 
 func (p *peerService) Announce(ctx context.Context, req *transferv1.TransferPeerAnnounceRequest) (*transferv1.TransferPeerAnnounceResponse, error) {
@@ -55,6 +61,112 @@ func (p *peerService) Close(ctx context.Context, req *transferv1.TransferPeerClo
 	return &transferv1.TransferPeerCloseResponse{}, nil
 }
 
+func (p *peerService) RequestContentKey(ctx context.Context, req *transferv1.TransferPeerRequestContentKeyRequest) (*transferv1.TransferPeerRequestContentKeyResponse, error) {
+	id, err := ParseID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	pt, ok := p.getPeerTransfer(id)
+	if !ok {
+		return nil, ErrTransferNotFound
+	}
+
+	key, ok := pt.swarm.ContentKey()
+	policy := pt.getContentKeyPolicy()
+	if !ok || policy == nil {
+		return nil, ErrContentKeyNotFound
+	}
+	if !p.satisfiesContentKeyPolicy(policy) {
+		pt.logger.Debug("content key request denied")
+		return nil, ErrContentKeyPolicyViolation
+	}
+
+	pt.logger.Debug("sending content key")
+
+	return &transferv1.TransferPeerRequestContentKeyResponse{
+		Key:    key,
+		Policy: policy,
+	}, nil
+}
+
+// SetCertificate stores the peer's certificate for the network with
+// networkKey.
+func (p *peerService) SetCertificate(networkKey []byte, cert *certificate.Certificate) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.certificates.Set(networkKey, cert)
+}
+
+// DeleteCertificate ...
+func (p *peerService) DeleteCertificate(networkKey []byte) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.certificates.Delete(networkKey)
+}
+
+// satisfiesContentKeyPolicy checks that the peer holds a valid certificate
+// with the required key usage in one of the policy's networks.
+func (p *peerService) satisfiesContentKeyPolicy(policy *transferv1.ContentKeyPolicy) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for _, networkKey := range policy.NetworkKeys {
+		cert, ok := p.certificates.Get(networkKey)
+		if !ok || cert == nil {
+			continue
+		}
+		if uint32(cert.KeyUsage)&policy.KeyUsage != policy.KeyUsage {
+			continue
+		}
+		if dao.VerifyCertificate(cert) == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// requestContentKey fetches the content key for encrypted swarms we don't
+// have the key for yet. It returns false if the swarm still can't be read.
+func (p *peerService) requestContentKey(pt *peerTransfer) bool {
+	if !needsContentKey(pt.swarm) {
+		return true
+	}
+
+	pt.logger.Debug("requesting content key")
+
+	req := &transferv1.TransferPeerRequestContentKeyRequest{Id: pt.id[:]}
+	res := &transferv1.TransferPeerRequestContentKeyResponse{}
+	if err := p.client.RequestContentKey(p.ctx, req, res); err != nil {
+		pt.logger.Debug("content key request failed", zap.Error(err))
+		return false
+	}
+	// the peer may be relaying the key so we only trust the policy if the
+	// swarm owner signed it
+	if err := verifyContentKeyPolicy(pt.swarm, res.Policy); err != nil {
+		pt.logger.Debug("received invalid content key policy", zap.Error(err))
+		return false
+	}
+	if err := pt.swarm.SetContentKey(res.Key); err != nil {
+		pt.logger.Debug("received invalid content key", zap.Error(err))
+		return false
+	}
+	if err := pt.importPendingCache(); err != nil {
+		pt.logger.Debug("cache import failed", zap.Error(err))
+	}
+	if pt.getContentKeyPolicy() == nil {
+		pt.setContentKeyPolicy(res.Policy)
+	}
+	return true
+}
+
+func needsContentKey(s *ppspp.Swarm) bool {
+	if s.Options().ContentEncryptionMethod == ppspp.NoneContentEncryptionMethod {
+		return false
+	}
+	_, ok := s.ContentKey()
+	return !ok
+}
+
 // AssignPort starts a peer transfer when it exists in response to announce from
 // peer
 func (p *peerService) AssignPort(id ID, peerChannel uint64) (uint64, bool) {
@@ -63,6 +175,20 @@ func (p *peerService) AssignPort(id ID, peerChannel uint64) (uint64, bool) {
 		return 0, false
 	}
 
+	if needsContentKey(pt.swarm) {
+		// we can't verify chunks from encrypted swarms without the key. once we
+		// have it we open the channel with our own announce.
+		go func() {
+			if !p.requestContentKey(pt) {
+				return
+			}
+			if cur, ok := p.getPeerTransfer(id); ok && cur == pt {
+				p.SendAnnounce(pt.transfer)
+			}
+		}()
+		return 0, false
+	}
+
 	pt.logger.Debug(
 		"assigning port",
 		zap.Uint64("peerChannel", peerChannel),
@@ -78,6 +204,10 @@ func (p *peerService) SendAnnounce(t *transfer) {
 	pt.logger.Debug("announcing swarm")
 
 	go func() {
+		if !p.requestContentKey(pt) {
+			return
+		}
+
 		req := &transferv1.TransferPeerAnnounceRequest{
 			Id:      t.id[:],
 			Channel: pt.channel,
@@ -0,0 +1,131 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package transfer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MemeLabs/strims/internal/dao"
+	transferv1 "github.com/MemeLabs/strims/pkg/apis/transfer/v1"
+	"github.com/MemeLabs/strims/pkg/apis/type/certificate"
+	"github.com/MemeLabs/strims/pkg/hashmap"
+	"github.com/MemeLabs/strims/pkg/ppspp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func newTestNetworkCertificate(t *testing.T, usage certificate.KeyUsage) (networkKey []byte, cert *certificate.Certificate) {
+	networkKeyPair, err := dao.GenerateKey()
+	assert.NoError(t, err)
+	networkCert, err := dao.NewSelfSignedCertificate(networkKeyPair, certificate.KeyUsage_KEY_USAGE_SIGN, time.Hour)
+	assert.NoError(t, err)
+
+	peerKey, err := dao.GenerateKey()
+	assert.NoError(t, err)
+	csr, err := dao.NewCertificateRequest(peerKey, usage)
+	assert.NoError(t, err)
+	cert, err = dao.SignCertificateRequest(csr, time.Hour, networkKeyPair)
+	assert.NoError(t, err)
+	cert.ParentOneof = &certificate.Certificate_Parent{Parent: networkCert}
+
+	return networkKeyPair.Public, cert
+}
+
+func newTestPeerService() *peerService {
+	return &peerService{
+		logger:       zap.NewNop(),
+		ctx:          context.Background(),
+		transfers:    map[ID]*peerTransfer{},
+		certificates: hashmap.New[[]byte, *certificate.Certificate](hashmap.NewByteInterface[[]byte]()),
+	}
+}
+
+func TestRequestContentKey(t *testing.T) {
+	networkKey, cert := newTestNetworkCertificate(t, certificate.KeyUsage_KEY_USAGE_PEER)
+	otherNetworkKey, _ := newTestNetworkCertificate(t, certificate.KeyUsage_KEY_USAGE_PEER)
+
+	options := ppspp.NewDefaultSwarmOptions()
+	options.ContentEncryptionMethod = ppspp.AES256CTRContentEncryptionMethod
+	swarm, err := ppspp.NewSwarm(ppspp.SwarmID("test"), options)
+	assert.NoError(t, err)
+
+	p := newTestPeerService()
+	tr := &transfer{id: NewID(swarm.ID(), nil), swarm: swarm}
+	pt := p.getOrCreatePeerTransfer(tr)
+	req := &transferv1.TransferPeerRequestContentKeyRequest{Id: tr.id[:]}
+
+	_, err = p.RequestContentKey(context.Background(), req)
+	assert.ErrorIs(t, err, ErrContentKeyNotFound, "swarm without key")
+
+	key, err := ppspp.NewContentKey()
+	assert.NoError(t, err)
+	assert.NoError(t, swarm.SetContentKey(key))
+
+	_, err = p.RequestContentKey(context.Background(), req)
+	assert.ErrorIs(t, err, ErrContentKeyNotFound, "transfer without policy")
+
+	pt.setContentKeyPolicy(&transferv1.ContentKeyPolicy{NetworkKeys: [][]byte{otherNetworkKey}})
+	p.SetCertificate(networkKey, cert)
+
+	_, err = p.RequestContentKey(context.Background(), req)
+	assert.ErrorIs(t, err, ErrContentKeyPolicyViolation, "peer outside policy networks")
+
+	pt.setContentKeyPolicy(&transferv1.ContentKeyPolicy{
+		NetworkKeys: [][]byte{otherNetworkKey, networkKey},
+		KeyUsage:    uint32(certificate.KeyUsage_KEY_USAGE_SIGN),
+	})
+	_, err = p.RequestContentKey(context.Background(), req)
+	assert.ErrorIs(t, err, ErrContentKeyPolicyViolation, "peer without required key usage")
+
+	policy := &transferv1.ContentKeyPolicy{
+		NetworkKeys: [][]byte{otherNetworkKey, networkKey},
+		KeyUsage:    uint32(certificate.KeyUsage_KEY_USAGE_PEER),
+	}
+	pt.setContentKeyPolicy(policy)
+	res, err := p.RequestContentKey(context.Background(), req)
+	if assert.NoError(t, err) {
+		assert.Equal(t, key, res.Key)
+		assert.Equal(t, policy, res.Policy)
+	}
+
+	p.DeleteCertificate(networkKey)
+	_, err = p.RequestContentKey(context.Background(), req)
+	assert.ErrorIs(t, err, ErrContentKeyPolicyViolation, "peer that left the network")
+}
+
+func TestVerifyContentKeyPolicy(t *testing.T) {
+	ownerKey, err := dao.GenerateKey()
+	assert.NoError(t, err)
+	otherKey, err := dao.GenerateKey()
+	assert.NoError(t, err)
+
+	options := ppspp.NewDefaultSwarmOptions()
+	options.ContentEncryptionMethod = ppspp.AES256CTRContentEncryptionMethod
+	swarm, err := ppspp.NewSwarm(ppspp.NewSwarmID(ownerKey.Public), options)
+	assert.NoError(t, err)
+
+	networkKey, _ := newTestNetworkCertificate(t, certificate.KeyUsage_KEY_USAGE_PEER)
+	newPolicy := func() *transferv1.ContentKeyPolicy {
+		return &transferv1.ContentKeyPolicy{
+			NetworkKeys: [][]byte{networkKey},
+			KeyUsage:    uint32(certificate.KeyUsage_KEY_USAGE_PEER),
+		}
+	}
+
+	assert.ErrorIs(t, verifyContentKeyPolicy(swarm, nil), ErrContentKeyPolicySignature, "missing policy")
+	assert.ErrorIs(t, verifyContentKeyPolicy(swarm, newPolicy()), ErrContentKeyPolicySignature, "unsigned policy")
+
+	policy := newPolicy()
+	assert.NoError(t, dao.SignMessage(policy, otherKey))
+	assert.ErrorIs(t, verifyContentKeyPolicy(swarm, policy), ErrContentKeyPolicySignature, "policy signed by another key")
+
+	policy = newPolicy()
+	assert.NoError(t, dao.SignMessage(policy, ownerKey))
+	assert.NoError(t, verifyContentKeyPolicy(swarm, policy))
+
+	policy.KeyUsage = 0
+	assert.ErrorIs(t, verifyContentKeyPolicy(swarm, policy), ErrContentKeyPolicySignature, "modified policy")
+}
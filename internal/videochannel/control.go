@@ -92,6 +92,14 @@ func WithDirectorySnippet(snippet *networkv1directory.ListingSnippet) Option {
 	}
 }
 
+// WithMembersOnly ...
+func WithMembersOnly(membersOnly bool) Option {
+	return func(channel *videov1.VideoChannel) error {
+		channel.MembersOnly = membersOnly
+		return nil
+	}
+}
+
 // WithLocalOwner ...
 func WithLocalOwner(profileKey, networkKey []byte) Option {
 	return func(channel *videov1.VideoChannel) error {
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"time"
 
 	"github.com/MemeLabs/strims/internal/dao"
 	"github.com/MemeLabs/strims/internal/event"
@@ -21,16 +22,25 @@ import (
 	"github.com/MemeLabs/strims/pkg/chunkstream"
 	"github.com/MemeLabs/strims/pkg/hls"
 	"github.com/MemeLabs/strims/pkg/httputil"
+	"github.com/MemeLabs/strims/pkg/ioutil"
 	"github.com/MemeLabs/strims/pkg/logutil"
 	"github.com/MemeLabs/strims/pkg/ppspp"
 	"github.com/MemeLabs/strims/pkg/ppspp/store"
+	"github.com/MemeLabs/strims/pkg/timeutil"
 	"go.uber.org/zap"
 )
 
+const (
+	contentKeyTimeout      = 30 * time.Second
+	contentKeyPollInterval = 100 * time.Millisecond
+)
+
+var errContentKeyTimeout = errors.New("timed out waiting for stream content key")
+
 // Control ...
 type Control interface {
 	Run()
-	OpenStream(ctx context.Context, swarmURI string, networkKeys [][]byte) (transfer.ID, io.ReadCloser, error)
+	OpenStream(ctx context.Context, swarmURI string, networkKeys [][]byte, rewind time.Duration) (transfer.ID, io.ReadCloser, error)
 	HLSEgressEnabled() bool
 	OpenHLSStream(swarmURI string, networkKeys [][]byte) (string, error)
 	CloseHLSStream(swarmURI string) error
@@ -171,8 +181,9 @@ func (t *control) open(swarmURI string, networkKeys [][]byte) (transfer.ID, *pps
 	return transferID, swarm, true, nil
 }
 
-// OpenStream ...
-func (t *control) OpenStream(ctx context.Context, swarmURI string, networkKeys [][]byte) (transfer.ID, io.ReadCloser, error) {
+// OpenStream opens a reader for the swarm. If rewind is set playback starts
+// that far behind the live edge.
+func (t *control) OpenStream(ctx context.Context, swarmURI string, networkKeys [][]byte, rewind time.Duration) (transfer.ID, io.ReadCloser, error) {
 	transferID, swarm, created, err := t.open(swarmURI, networkKeys)
 	if err != nil {
 		return transfer.ID{}, nil, err
@@ -190,7 +201,10 @@ func (t *control) OpenStream(ctx context.Context, swarmURI string, networkKeys [
 		transferID: transferID,
 		// TODO: removeOnClose should use reference counting
 		removeOnClose: created,
+		swarm:         swarm,
+		rewind:        rewind,
 		b:             b,
+		stop:          ctx.Done(),
 	}
 	return transferID, r, nil
 }
@@ -236,7 +250,9 @@ func (t *control) OpenHLSStream(swarmURI string, networkKeys [][]byte) (string,
 		transfer:      t.transfer,
 		transferID:    transferID,
 		removeOnClose: created,
+		swarm:         swarm,
 		b:             b,
+		stop:          t.stop,
 	}
 
 	r.logger.Debug("hls stream starting", zap.String("uri", uri))
@@ -290,8 +306,11 @@ type VideoReader struct {
 	transfer      transfer.Control
 	transferID    transfer.ID
 	removeOnClose bool
+	swarm         *ppspp.Swarm
+	rewind        time.Duration
 	b             *store.BufferReader
 	r             *chunkstream.Reader
+	stop          <-chan struct{}
 }
 
 // Close ...
@@ -302,8 +321,50 @@ func (r *VideoReader) Close() error {
 	return nil
 }
 
+// waitForContentKey blocks until the transfer receives the content key of
+// encrypted swarms. peers only hand out keys to members of the networks in
+// the swarm owner's policy so non members fail here instead of stalling.
+func (r *VideoReader) waitForContentKey() error {
+	if r.swarm.Options().ContentEncryptionMethod == ppspp.NoneContentEncryptionMethod {
+		return nil
+	}
+
+	ticker := timeutil.DefaultTickEmitter.Ticker(contentKeyPollInterval)
+	defer ticker.Stop()
+
+	deadline := timeutil.Now().Add(contentKeyTimeout)
+	for {
+		if _, ok := r.swarm.ContentKey(); ok {
+			return nil
+		}
+
+		select {
+		case now := <-ticker.C:
+			if now.After(deadline) {
+				return errContentKeyTimeout
+			}
+		case <-r.stop:
+			return ioutil.ErrStopped
+		}
+	}
+}
+
 func (r *VideoReader) initReader() (err error) {
-	r.r, err = chunkstream.NewReaderSize(r.b, int64(r.b.Offset()), chunkstream.DefaultSize)
+	if err := r.waitForContentKey(); err != nil {
+		return err
+	}
+
+	off := r.b.Offset()
+	if r.rewind > 0 {
+		if o, err := r.b.SeekTime(r.swarm, timeutil.Now().Add(-r.rewind)); err != nil {
+			r.logger.Debug("rewind failed", zap.Duration("rewind", r.rewind), zap.Error(err))
+		} else {
+			off = o
+		}
+		r.rewind = 0
+	}
+
+	r.r, err = chunkstream.NewReaderSize(r.b, int64(off), chunkstream.DefaultSize)
 	if err != nil {
 		return err
 	}
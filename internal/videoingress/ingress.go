@@ -20,6 +20,7 @@ import (
 	"github.com/MemeLabs/strims/internal/network"
 	"github.com/MemeLabs/strims/internal/transfer"
 	networkv1directory "github.com/MemeLabs/strims/pkg/apis/network/v1/directory"
+	transferv1 "github.com/MemeLabs/strims/pkg/apis/transfer/v1"
 	"github.com/MemeLabs/strims/pkg/apis/type/certificate"
 	"github.com/MemeLabs/strims/pkg/apis/type/image"
 	videov1 "github.com/MemeLabs/strims/pkg/apis/video/v1"
@@ -229,6 +230,12 @@ func newIngressStream(
 	}
 
 	s.transferID = s.transfer.Add(s.swarm, []byte{})
+	if channel.MembersOnly {
+		if err := s.setContentKeyPolicy(); err != nil {
+			s.Close()
+			return nil, fmt.Errorf("setting content key policy: %w", err)
+		}
+	}
 	s.transfer.Publish(s.transferID, s.channelNetworkKey())
 
 	snippet := protoutil.Clone(channel.DirectoryListingSnippet)
@@ -294,8 +301,16 @@ func (s *ingressStream) UpdateChannel(channel *videov1.VideoChannel) {
 }
 
 func (s *ingressStream) openWriter() (*ppspp.Swarm, *ioutil.WriteFlushSampler, error) {
+	var encryptionMethod ppspp.ContentEncryptionMethod
+	if s.channel.Load().MembersOnly {
+		encryptionMethod = ppspp.AES256CTRContentEncryptionMethod
+	}
+
+	// ingress is the origin of the stream so it super-seeds to spread the
+	// upload across viewers.
 	w, err := ppspp.NewWriter(ppspp.WriterOptions{
 		SwarmOptions: ppspp.SwarmOptions{
+			SchedulingMethod:   ppspp.SuperSeedSchedulingMethod,
 			ChunkSize:          1024,
 			ChunksPerSignature: 32,
 			StreamCount:        16,
@@ -305,6 +320,7 @@ func (s *ingressStream) openWriter() (*ppspp.Swarm, *ioutil.WriteFlushSampler, e
 				MerkleHashTreeFunction: integrity.MerkleHashTreeFunctionBLAKE2B256,
 				LiveSignatureAlgorithm: integrity.LiveSignatureAlgorithmED25519,
 			},
+			ContentEncryptionMethod: encryptionMethod,
 		},
 		Key: s.channel.Load().Key,
 	})
@@ -320,6 +336,20 @@ func (s *ingressStream) openWriter() (*ppspp.Swarm, *ioutil.WriteFlushSampler, e
 	return w.Swarm(), ioutil.NewWriteFlushSampler(cw), nil
 }
 
+// setContentKeyPolicy limits the stream's content key to peers in the
+// channel's network. the policy is signed with the channel key, which owns the
+// swarm, so peers can relay the key without being trusted.
+func (s *ingressStream) setContentKeyPolicy() error {
+	policy := &transferv1.ContentKeyPolicy{
+		NetworkKeys: [][]byte{s.channelNetworkKey()},
+		KeyUsage:    uint32(certificate.KeyUsage_KEY_USAGE_PEER),
+	}
+	if err := dao.SignMessage(policy, s.channel.Load().Key); err != nil {
+		return err
+	}
+	return s.transfer.SetContentKeyPolicy(s.transferID, policy)
+}
+
 func (s *ingressStream) channelNetworkKey() []byte {
 	switch o := s.channel.Load().Owner.(type) {
 	case *videov1.VideoChannel_Local_:
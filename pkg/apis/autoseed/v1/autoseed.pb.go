@@ -29,6 +29,10 @@ type Config struct {
 	unknownFields protoimpl.UnknownFields
 
 	Enable bool `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	// disk_buffer stores autoseeded swarms in files in buffer_dir instead of
+	// memory. the system temp directory is used if buffer_dir is empty.
+	DiskBuffer bool   `protobuf:"varint,2,opt,name=disk_buffer,json=diskBuffer,proto3" json:"disk_buffer,omitempty"`
+	BufferDir  string `protobuf:"bytes,3,opt,name=buffer_dir,json=bufferDir,proto3" json:"buffer_dir,omitempty"`
 }
 
 func (x *Config) Reset() {
@@ -70,6 +74,20 @@ func (x *Config) GetEnable() bool {
 	return false
 }
 
+func (x *Config) GetDiskBuffer() bool {
+	if x != nil {
+		return x.DiskBuffer
+	}
+	return false
+}
+
+func (x *Config) GetBufferDir() string {
+	if x != nil {
+		return x.BufferDir
+	}
+	return ""
+}
+
 type Rule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -794,9 +812,13 @@ var file_autoseed_v1_autoseed_proto_rawDesc = []byte{
 	0x0a, 0x1a, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x65, 0x65, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75,
 	0x74, 0x6f, 0x73, 0x65, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x73, 0x74,
 	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x65, 0x65, 0x64, 0x2e, 0x76, 0x31,
-	0x22, 0x20, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e,
+	0x22, 0x60, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e,
 	0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x22, 0x7c, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x42, 0x75, 0x66,
+	0x66, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x64, 0x69,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x44,
+	0x69, 0x72, 0x22, 0x7c, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65,
 	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
 	0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x73,
@@ -892,6 +892,187 @@ func (*StopMockStreamResponse) Descriptor() ([]byte, []int) {
 	return file_debug_v1_debug_proto_rawDescGZIP(), []int{15}
 }
 
+type ReadRoutesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetworkKey []byte `protobuf:"bytes,1,opt,name=network_key,json=networkKey,proto3" json:"network_key,omitempty"`
+}
+
+func (x *ReadRoutesRequest) Reset() {
+	*x = ReadRoutesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_v1_debug_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadRoutesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadRoutesRequest) ProtoMessage() {}
+
+func (x *ReadRoutesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_debug_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadRoutesRequest.ProtoReflect.Descriptor instead.
+func (*ReadRoutesRequest) Descriptor() ([]byte, []int) {
+	return file_debug_v1_debug_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReadRoutesRequest) GetNetworkKey() []byte {
+	if x != nil {
+		return x.NetworkKey
+	}
+	return nil
+}
+
+type ReadRoutesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Routes []*ReadRoutesResponse_Route `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+}
+
+func (x *ReadRoutesResponse) Reset() {
+	*x = ReadRoutesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_v1_debug_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadRoutesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadRoutesResponse) ProtoMessage() {}
+
+func (x *ReadRoutesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_debug_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadRoutesResponse.ProtoReflect.Descriptor instead.
+func (*ReadRoutesResponse) Descriptor() ([]byte, []int) {
+	return file_debug_v1_debug_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReadRoutesResponse) GetRoutes() []*ReadRoutesResponse_Route {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+type ReadRoutesResponse_Route struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Target   []byte  `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	NextHop  []byte  `protobuf:"bytes,2,opt,name=next_hop,json=nextHop,proto3" json:"next_hop,omitempty"`
+	Distance uint32  `protobuf:"varint,3,opt,name=distance,proto3" json:"distance,omitempty"`
+	RttMs    int64   `protobuf:"varint,4,opt,name=rtt_ms,json=rttMs,proto3" json:"rtt_ms,omitempty"`
+	Loss     float64 `protobuf:"fixed64,5,opt,name=loss,proto3" json:"loss,omitempty"`
+	LastSeen int64   `protobuf:"varint,6,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+}
+
+func (x *ReadRoutesResponse_Route) Reset() {
+	*x = ReadRoutesResponse_Route{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_v1_debug_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadRoutesResponse_Route) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadRoutesResponse_Route) ProtoMessage() {}
+
+func (x *ReadRoutesResponse_Route) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_v1_debug_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadRoutesResponse_Route.ProtoReflect.Descriptor instead.
+func (*ReadRoutesResponse_Route) Descriptor() ([]byte, []int) {
+	return file_debug_v1_debug_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *ReadRoutesResponse_Route) GetTarget() []byte {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *ReadRoutesResponse_Route) GetNextHop() []byte {
+	if x != nil {
+		return x.NextHop
+	}
+	return nil
+}
+
+func (x *ReadRoutesResponse_Route) GetDistance() uint32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *ReadRoutesResponse_Route) GetRttMs() int64 {
+	if x != nil {
+		return x.RttMs
+	}
+	return 0
+}
+
+func (x *ReadRoutesResponse_Route) GetLoss() float64 {
+	if x != nil {
+		return x.Loss
+	}
+	return 0
+}
+
+func (x *ReadRoutesResponse_Route) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
 var File_debug_v1_debug_proto protoreflect.FileDescriptor
 
 var file_debug_v1_debug_proto_rawDesc = []byte{
@@ -967,64 +1148,88 @@ var file_debug_v1_debug_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x70, 0x4d, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
 	0x52, 0x02, 0x69, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x53, 0x74, 0x6f, 0x70, 0x4d, 0x6f, 0x63, 0x6b,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0xaa,
-	0x01, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
-	0x12, 0x17, 0x0a, 0x13, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x4d,
-	0x41, 0x54, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x4d, 0x45, 0x54,
-	0x52, 0x49, 0x43, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x54,
-	0x4f, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x4d, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x45, 0x54,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34,
+	0x0a, 0x11, 0x52, 0x65, 0x61, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x4b, 0x65, 0x79, 0x22, 0xf8, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x61, 0x64, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x61, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x9e,
+	0x01, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x68, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x48, 0x6f, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x74, 0x74, 0x5f, 0x6d,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x74, 0x74, 0x4d, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6c, 0x6f,
+	0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x2a,
+	0xaa, 0x01, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x46, 0x4f, 0x52,
+	0x4d, 0x41, 0x54, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x4d, 0x45,
+	0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x50, 0x52, 0x4f,
+	0x54, 0x4f, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x4d, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x45,
+	0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x50, 0x52, 0x4f,
+	0x54, 0x4f, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x4d, 0x45, 0x54,
 	0x52, 0x49, 0x43, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x54,
-	0x4f, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x4d, 0x45, 0x54, 0x52,
-	0x49, 0x43, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f,
-	0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x41, 0x43, 0x54, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x45,
-	0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4f, 0x50, 0x45,
-	0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x10, 0x04, 0x32, 0xf9, 0x04, 0x0a, 0x05,
-	0x44, 0x65, 0x62, 0x75, 0x67, 0x12, 0x46, 0x0a, 0x05, 0x50, 0x50, 0x72, 0x6f, 0x66, 0x12, 0x1d,
+	0x4f, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x41, 0x43, 0x54, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x4d,
+	0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4f, 0x50,
+	0x45, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x10, 0x04, 0x32, 0xd0, 0x05, 0x0a,
+	0x05, 0x44, 0x65, 0x62, 0x75, 0x67, 0x12, 0x46, 0x0a, 0x05, 0x50, 0x50, 0x72, 0x6f, 0x66, 0x12,
+	0x1d, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x50, 0x72, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
 	0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x50, 0x72, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x2e, 0x50, 0x50, 0x72, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58,
+	0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x23, 0x2e,
 	0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x50, 0x72, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a,
-	0x0b, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x23, 0x2e, 0x73,
-	0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x61, 0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0c, 0x57, 0x61, 0x74, 0x63, 0x68,
-	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73,
-	0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
-	0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e,
-	0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x52, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x62,
-	0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e,
-	0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x09, 0x53, 0x65,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73,
-	0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x74, 0x72,
-	0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64,
-	0x0a, 0x0f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x12, 0x27, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73, 0x74, 0x72,
-	0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x4d, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x70, 0x4d, 0x6f, 0x63, 0x6b,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x26, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e,
-	0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x4d, 0x6f, 0x63,
-	0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0c, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d,
+	0x73, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
 	0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x74, 0x6f, 0x70, 0x4d, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x50, 0x0a, 0x12, 0x67, 0x67, 0x2e, 0x73, 0x74,
-	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x5a, 0x34, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x65, 0x6d, 0x65, 0x4c, 0x61,
-	0x62, 0x73, 0x2f, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70,
-	0x69, 0x73, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x65, 0x62, 0x75,
-	0x67, 0x76, 0x31, 0xba, 0x02, 0x03, 0x53, 0x44, 0x47, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x52, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73,
+	0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x09, 0x53,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d,
+	0x73, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x64, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x12, 0x27, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x6f, 0x63, 0x6b, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x4d, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x70, 0x4d, 0x6f, 0x63,
+	0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x26, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73,
+	0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x4d, 0x6f,
+	0x63, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x4d, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0a, 0x52, 0x65, 0x61, 0x64,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e,
+	0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x74, 0x72,
+	0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61,
+	0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0x50, 0x0a, 0x12, 0x67, 0x67, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x2e, 0x76, 0x31, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x4d, 0x65, 0x6d, 0x65, 0x4c, 0x61, 0x62, 0x73, 0x2f, 0x73, 0x74, 0x72, 0x69, 0x6d,
+	0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67,
+	0x2f, 0x76, 0x31, 0x3b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x76, 0x31, 0xba, 0x02, 0x03, 0x53, 0x44,
+	0x47, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1040,25 +1245,28 @@ func file_debug_v1_debug_proto_rawDescGZIP() []byte {
 }
 
 var file_debug_v1_debug_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_debug_v1_debug_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_debug_v1_debug_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_debug_v1_debug_proto_goTypes = []interface{}{
-	(MetricsFormat)(0),              // 0: strims.debug.v1.MetricsFormat
-	(*PProfRequest)(nil),            // 1: strims.debug.v1.PProfRequest
-	(*PProfResponse)(nil),           // 2: strims.debug.v1.PProfResponse
-	(*ReadMetricsRequest)(nil),      // 3: strims.debug.v1.ReadMetricsRequest
-	(*ReadMetricsResponse)(nil),     // 4: strims.debug.v1.ReadMetricsResponse
-	(*WatchMetricsRequest)(nil),     // 5: strims.debug.v1.WatchMetricsRequest
-	(*WatchMetricsResponse)(nil),    // 6: strims.debug.v1.WatchMetricsResponse
-	(*GetConfigRequest)(nil),        // 7: strims.debug.v1.GetConfigRequest
-	(*GetConfigResponse)(nil),       // 8: strims.debug.v1.GetConfigResponse
-	(*SetConfigRequest)(nil),        // 9: strims.debug.v1.SetConfigRequest
-	(*SetConfigResponse)(nil),       // 10: strims.debug.v1.SetConfigResponse
-	(*Config)(nil),                  // 11: strims.debug.v1.Config
-	(*MockStreamSegment)(nil),       // 12: strims.debug.v1.MockStreamSegment
-	(*StartMockStreamRequest)(nil),  // 13: strims.debug.v1.StartMockStreamRequest
-	(*StartMockStreamResponse)(nil), // 14: strims.debug.v1.StartMockStreamResponse
-	(*StopMockStreamRequest)(nil),   // 15: strims.debug.v1.StopMockStreamRequest
-	(*StopMockStreamResponse)(nil),  // 16: strims.debug.v1.StopMockStreamResponse
+	(MetricsFormat)(0),               // 0: strims.debug.v1.MetricsFormat
+	(*PProfRequest)(nil),             // 1: strims.debug.v1.PProfRequest
+	(*PProfResponse)(nil),            // 2: strims.debug.v1.PProfResponse
+	(*ReadMetricsRequest)(nil),       // 3: strims.debug.v1.ReadMetricsRequest
+	(*ReadMetricsResponse)(nil),      // 4: strims.debug.v1.ReadMetricsResponse
+	(*WatchMetricsRequest)(nil),      // 5: strims.debug.v1.WatchMetricsRequest
+	(*WatchMetricsResponse)(nil),     // 6: strims.debug.v1.WatchMetricsResponse
+	(*GetConfigRequest)(nil),         // 7: strims.debug.v1.GetConfigRequest
+	(*GetConfigResponse)(nil),        // 8: strims.debug.v1.GetConfigResponse
+	(*SetConfigRequest)(nil),         // 9: strims.debug.v1.SetConfigRequest
+	(*SetConfigResponse)(nil),        // 10: strims.debug.v1.SetConfigResponse
+	(*Config)(nil),                   // 11: strims.debug.v1.Config
+	(*MockStreamSegment)(nil),        // 12: strims.debug.v1.MockStreamSegment
+	(*StartMockStreamRequest)(nil),   // 13: strims.debug.v1.StartMockStreamRequest
+	(*StartMockStreamResponse)(nil),  // 14: strims.debug.v1.StartMockStreamResponse
+	(*StopMockStreamRequest)(nil),    // 15: strims.debug.v1.StopMockStreamRequest
+	(*StopMockStreamResponse)(nil),   // 16: strims.debug.v1.StopMockStreamResponse
+	(*ReadRoutesRequest)(nil),        // 17: strims.debug.v1.ReadRoutesRequest
+	(*ReadRoutesResponse)(nil),       // 18: strims.debug.v1.ReadRoutesResponse
+	(*ReadRoutesResponse_Route)(nil), // 19: strims.debug.v1.ReadRoutesResponse.Route
 }
 var file_debug_v1_debug_proto_depIdxs = []int32{
 	0,  // 0: strims.debug.v1.ReadMetricsRequest.format:type_name -> strims.debug.v1.MetricsFormat
@@ -1066,25 +1274,28 @@ var file_debug_v1_debug_proto_depIdxs = []int32{
 	11, // 2: strims.debug.v1.GetConfigResponse.config:type_name -> strims.debug.v1.Config
 	11, // 3: strims.debug.v1.SetConfigRequest.config:type_name -> strims.debug.v1.Config
 	11, // 4: strims.debug.v1.SetConfigResponse.config:type_name -> strims.debug.v1.Config
-	1,  // 5: strims.debug.v1.Debug.PProf:input_type -> strims.debug.v1.PProfRequest
-	3,  // 6: strims.debug.v1.Debug.ReadMetrics:input_type -> strims.debug.v1.ReadMetricsRequest
-	5,  // 7: strims.debug.v1.Debug.WatchMetrics:input_type -> strims.debug.v1.WatchMetricsRequest
-	7,  // 8: strims.debug.v1.Debug.GetConfig:input_type -> strims.debug.v1.GetConfigRequest
-	9,  // 9: strims.debug.v1.Debug.SetConfig:input_type -> strims.debug.v1.SetConfigRequest
-	13, // 10: strims.debug.v1.Debug.StartMockStream:input_type -> strims.debug.v1.StartMockStreamRequest
-	15, // 11: strims.debug.v1.Debug.StopMockStream:input_type -> strims.debug.v1.StopMockStreamRequest
-	2,  // 12: strims.debug.v1.Debug.PProf:output_type -> strims.debug.v1.PProfResponse
-	4,  // 13: strims.debug.v1.Debug.ReadMetrics:output_type -> strims.debug.v1.ReadMetricsResponse
-	6,  // 14: strims.debug.v1.Debug.WatchMetrics:output_type -> strims.debug.v1.WatchMetricsResponse
-	8,  // 15: strims.debug.v1.Debug.GetConfig:output_type -> strims.debug.v1.GetConfigResponse
-	10, // 16: strims.debug.v1.Debug.SetConfig:output_type -> strims.debug.v1.SetConfigResponse
-	14, // 17: strims.debug.v1.Debug.StartMockStream:output_type -> strims.debug.v1.StartMockStreamResponse
-	16, // 18: strims.debug.v1.Debug.StopMockStream:output_type -> strims.debug.v1.StopMockStreamResponse
-	12, // [12:19] is the sub-list for method output_type
-	5,  // [5:12] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	19, // 5: strims.debug.v1.ReadRoutesResponse.routes:type_name -> strims.debug.v1.ReadRoutesResponse.Route
+	1,  // 6: strims.debug.v1.Debug.PProf:input_type -> strims.debug.v1.PProfRequest
+	3,  // 7: strims.debug.v1.Debug.ReadMetrics:input_type -> strims.debug.v1.ReadMetricsRequest
+	5,  // 8: strims.debug.v1.Debug.WatchMetrics:input_type -> strims.debug.v1.WatchMetricsRequest
+	7,  // 9: strims.debug.v1.Debug.GetConfig:input_type -> strims.debug.v1.GetConfigRequest
+	9,  // 10: strims.debug.v1.Debug.SetConfig:input_type -> strims.debug.v1.SetConfigRequest
+	13, // 11: strims.debug.v1.Debug.StartMockStream:input_type -> strims.debug.v1.StartMockStreamRequest
+	15, // 12: strims.debug.v1.Debug.StopMockStream:input_type -> strims.debug.v1.StopMockStreamRequest
+	17, // 13: strims.debug.v1.Debug.ReadRoutes:input_type -> strims.debug.v1.ReadRoutesRequest
+	2,  // 14: strims.debug.v1.Debug.PProf:output_type -> strims.debug.v1.PProfResponse
+	4,  // 15: strims.debug.v1.Debug.ReadMetrics:output_type -> strims.debug.v1.ReadMetricsResponse
+	6,  // 16: strims.debug.v1.Debug.WatchMetrics:output_type -> strims.debug.v1.WatchMetricsResponse
+	8,  // 17: strims.debug.v1.Debug.GetConfig:output_type -> strims.debug.v1.GetConfigResponse
+	10, // 18: strims.debug.v1.Debug.SetConfig:output_type -> strims.debug.v1.SetConfigResponse
+	14, // 19: strims.debug.v1.Debug.StartMockStream:output_type -> strims.debug.v1.StartMockStreamResponse
+	16, // 20: strims.debug.v1.Debug.StopMockStream:output_type -> strims.debug.v1.StopMockStreamResponse
+	18, // 21: strims.debug.v1.Debug.ReadRoutes:output_type -> strims.debug.v1.ReadRoutesResponse
+	14, // [14:22] is the sub-list for method output_type
+	6,  // [6:14] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_debug_v1_debug_proto_init() }
@@ -1285,6 +1496,42 @@ func file_debug_v1_debug_proto_init() {
 				return nil
 			}
 		}
+		file_debug_v1_debug_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadRoutesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_v1_debug_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadRoutesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_v1_debug_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadRoutesResponse_Route); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1292,7 +1539,7 @@ func file_debug_v1_debug_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_debug_v1_debug_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   16,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
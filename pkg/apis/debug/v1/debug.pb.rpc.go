@@ -15,6 +15,7 @@ func RegisterDebugService(host rpc.ServiceRegistry, service DebugService) {
 	host.RegisterMethod("strims.debug.v1.Debug.SetConfig", service.SetConfig)
 	host.RegisterMethod("strims.debug.v1.Debug.StartMockStream", service.StartMockStream)
 	host.RegisterMethod("strims.debug.v1.Debug.StopMockStream", service.StopMockStream)
+	host.RegisterMethod("strims.debug.v1.Debug.ReadRoutes", service.ReadRoutes)
 }
 
 // DebugService ...
@@ -47,6 +48,10 @@ type DebugService interface {
 		ctx context.Context,
 		req *StopMockStreamRequest,
 	) (*StopMockStreamResponse, error)
+	ReadRoutes(
+		ctx context.Context,
+		req *ReadRoutesRequest,
+	) (*ReadRoutesResponse, error)
 }
 
 // DebugService ...
@@ -101,6 +106,13 @@ func (s *UnimplementedDebugService) StopMockStream(
 	return nil, rpc.ErrNotImplemented
 }
 
+func (s *UnimplementedDebugService) ReadRoutes(
+	ctx context.Context,
+	req *ReadRoutesRequest,
+) (*ReadRoutesResponse, error) {
+	return nil, rpc.ErrNotImplemented
+}
+
 var _ DebugService = (*UnimplementedDebugService)(nil)
 
 // DebugClient ...
@@ -175,3 +187,12 @@ func (c *DebugClient) StopMockStream(
 ) error {
 	return c.client.CallUnary(ctx, "strims.debug.v1.Debug.StopMockStream", req, res)
 }
+
+// ReadRoutes ...
+func (c *DebugClient) ReadRoutes(
+	ctx context.Context,
+	req *ReadRoutesRequest,
+	res *ReadRoutesResponse,
+) error {
+	return c.client.CallUnary(ctx, "strims.debug.v1.Debug.ReadRoutes", req, res)
+}
@@ -104,6 +104,8 @@ const (
 	CapConnLog_PeerLog_Event_MESSAGE_TYPE_STREAM_CANCEL    CapConnLog_PeerLog_Event_MessageType = 14
 	CapConnLog_PeerLog_Event_MESSAGE_TYPE_STREAM_OPEN      CapConnLog_PeerLog_Event_MessageType = 15
 	CapConnLog_PeerLog_Event_MESSAGE_TYPE_STREAM_CLOSE     CapConnLog_PeerLog_Event_MessageType = 16
+	CapConnLog_PeerLog_Event_MESSAGE_TYPE_PEX_REQUEST      CapConnLog_PeerLog_Event_MessageType = 17
+	CapConnLog_PeerLog_Event_MESSAGE_TYPE_PEX_RESPONSE     CapConnLog_PeerLog_Event_MessageType = 18
 	CapConnLog_PeerLog_Event_MESSAGE_TYPE_END              CapConnLog_PeerLog_Event_MessageType = 255
 )
 
@@ -127,6 +129,8 @@ var (
 		14:  "MESSAGE_TYPE_STREAM_CANCEL",
 		15:  "MESSAGE_TYPE_STREAM_OPEN",
 		16:  "MESSAGE_TYPE_STREAM_CLOSE",
+		17:  "MESSAGE_TYPE_PEX_REQUEST",
+		18:  "MESSAGE_TYPE_PEX_RESPONSE",
 		255: "MESSAGE_TYPE_END",
 	}
 	CapConnLog_PeerLog_Event_MessageType_value = map[string]int32{
@@ -147,6 +151,8 @@ var (
 		"MESSAGE_TYPE_STREAM_CANCEL":    14,
 		"MESSAGE_TYPE_STREAM_OPEN":      15,
 		"MESSAGE_TYPE_STREAM_CLOSE":     16,
+		"MESSAGE_TYPE_PEX_REQUEST":      17,
+		"MESSAGE_TYPE_PEX_RESPONSE":     18,
 		"MESSAGE_TYPE_END":              255,
 	}
 )
@@ -590,20 +596,20 @@ var file_devtools_v1_ppspp_capconn_proto_rawDesc = []byte{
 	0x0a, 0x1f, 0x64, 0x65, 0x76, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x70,
 	0x73, 0x70, 0x70, 0x2f, 0x63, 0x61, 0x70, 0x63, 0x6f, 0x6e, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x18, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x74, 0x6f, 0x6f,
-	0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x70, 0x70, 0x73, 0x70, 0x70, 0x22, 0xe9, 0x08, 0x0a, 0x0a,
+	0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x70, 0x70, 0x73, 0x70, 0x70, 0x22, 0xa6, 0x09, 0x0a, 0x0a,
 	0x43, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x4c, 0x6f, 0x67, 0x12, 0x49, 0x0a, 0x09, 0x70, 0x65,
 	0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
 	0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x2e,
 	0x76, 0x31, 0x2e, 0x70, 0x70, 0x73, 0x70, 0x70, 0x2e, 0x43, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x6e,
 	0x4c, 0x6f, 0x67, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x52, 0x08, 0x70, 0x65, 0x65,
-	0x72, 0x4c, 0x6f, 0x67, 0x73, 0x1a, 0x8f, 0x08, 0x0a, 0x07, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x6f,
+	0x72, 0x4c, 0x6f, 0x67, 0x73, 0x1a, 0xcc, 0x08, 0x0a, 0x07, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x6f,
 	0x67, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x4a, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
 	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73,
 	0x2e, 0x64, 0x65, 0x76, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x70, 0x70, 0x73,
 	0x70, 0x70, 0x2e, 0x43, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x4c, 0x6f, 0x67, 0x2e, 0x50, 0x65,
 	0x65, 0x72, 0x4c, 0x6f, 0x67, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x1a, 0xa1, 0x07, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x4b, 0x0a,
+	0x6e, 0x74, 0x73, 0x1a, 0xde, 0x07, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x4b, 0x0a,
 	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x73, 0x74,
 	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31,
 	0x2e, 0x70, 0x70, 0x73, 0x70, 0x70, 0x2e, 0x43, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x4c, 0x6f,
@@ -630,7 +636,7 @@ var file_devtools_v1_ppspp_capconn_proto_rawDesc = []byte{
 	0x53, 0x48, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x56, 0x45, 0x4e,
 	0x54, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x05, 0x12, 0x17, 0x0a,
 	0x13, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44,
-	0x5f, 0x45, 0x52, 0x52, 0x10, 0x06, 0x22, 0xee, 0x03, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x5f, 0x45, 0x52, 0x52, 0x10, 0x06, 0x22, 0xab, 0x04, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61,
 	0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47,
 	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x41, 0x4e, 0x44, 0x53, 0x48, 0x41, 0x4b, 0x45,
 	0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59,
@@ -660,49 +666,52 @@ var file_devtools_v1_ppspp_capconn_proto_rawDesc = []byte{
 	0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10,
 	0x0f, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50,
 	0x45, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x10,
-	0x12, 0x15, 0x0a, 0x10, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x45, 0x4e, 0x44, 0x10, 0xff, 0x01, 0x22, 0x19, 0x0a, 0x17, 0x43, 0x61, 0x70, 0x43, 0x6f,
-	0x6e, 0x6e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x93, 0x01, 0x0a, 0x18, 0x43, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x57, 0x61,
-	0x74, 0x63, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x45, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x73, 0x74,
-	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x70, 0x70, 0x73, 0x70, 0x70, 0x2e, 0x43, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x57, 0x61,
-	0x74, 0x63, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x4f, 0x70, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x1c, 0x0a, 0x02, 0x4f, 0x70,
-	0x12, 0x0a, 0x0a, 0x06, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
-	0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x01, 0x22, 0x2b, 0x0a, 0x15, 0x43, 0x61, 0x70, 0x43,
-	0x6f, 0x6e, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x50, 0x0a, 0x16, 0x43, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x6e,
-	0x4c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x36, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73,
-	0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x70, 0x70, 0x73, 0x70, 0x70, 0x2e, 0x43, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x4c,
-	0x6f, 0x67, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x32, 0xed, 0x01, 0x0a, 0x07, 0x43, 0x61, 0x70, 0x43,
-	0x6f, 0x6e, 0x6e, 0x12, 0x74, 0x0a, 0x09, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x6f, 0x67, 0x73,
-	0x12, 0x31, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x74, 0x6f, 0x6f,
+	0x12, 0x1c, 0x0a, 0x18, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x50, 0x45, 0x58, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x11, 0x12, 0x1d,
+	0x0a, 0x19, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50,
+	0x45, 0x58, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x12, 0x12, 0x15, 0x0a,
+	0x10, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x4e,
+	0x44, 0x10, 0xff, 0x01, 0x22, 0x19, 0x0a, 0x17, 0x43, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x93, 0x01, 0x0a, 0x18, 0x43, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x02,
+	0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d,
+	0x73, 0x2e, 0x64, 0x65, 0x76, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x70, 0x70,
+	0x73, 0x70, 0x70, 0x2e, 0x43, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x70, 0x52,
+	0x02, 0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x1c, 0x0a, 0x02, 0x4f, 0x70, 0x12, 0x0a, 0x0a,
+	0x06, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x4d,
+	0x4f, 0x56, 0x45, 0x10, 0x01, 0x22, 0x2b, 0x0a, 0x15, 0x43, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x6e,
+	0x4c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x50, 0x0a, 0x16, 0x43, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x4c, 0x6f, 0x61,
+	0x64, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x03,
+	0x6c, 0x6f, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x6d, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x70,
+	0x70, 0x73, 0x70, 0x70, 0x2e, 0x43, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x4c, 0x6f, 0x67, 0x52,
+	0x03, 0x6c, 0x6f, 0x67, 0x32, 0xed, 0x01, 0x0a, 0x07, 0x43, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x6e,
+	0x12, 0x74, 0x0a, 0x09, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x31, 0x2e,
+	0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x70, 0x70, 0x73, 0x70, 0x70, 0x2e, 0x43, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x6e,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x32, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x74, 0x6f, 0x6f,
 	0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x70, 0x70, 0x73, 0x70, 0x70, 0x2e, 0x43, 0x61, 0x70, 0x43,
-	0x6f, 0x6e, 0x6e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x76,
-	0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x70, 0x70, 0x73, 0x70, 0x70, 0x2e, 0x43,
-	0x61, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x6c, 0x0a, 0x07, 0x4c, 0x6f, 0x61,
-	0x64, 0x4c, 0x6f, 0x67, 0x12, 0x2f, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65,
-	0x76, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x70, 0x70, 0x73, 0x70, 0x70, 0x2e,
-	0x43, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64,
-	0x65, 0x76, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x70, 0x70, 0x73, 0x70, 0x70,
-	0x2e, 0x43, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x60, 0x0a, 0x1b, 0x67, 0x67, 0x2e, 0x73, 0x74,
-	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x70, 0x70, 0x73, 0x70, 0x70, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x4d, 0x65, 0x6d, 0x65, 0x4c, 0x61, 0x62, 0x73, 0x2f, 0x73, 0x74, 0x72, 0x69,
-	0x6d, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x64, 0x65, 0x76, 0x74,
-	0x6f, 0x6f, 0x6c, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x70, 0x73, 0x70, 0x70, 0x3b, 0x70, 0x70,
-	0x73, 0x70, 0x70, 0xba, 0x02, 0x03, 0x53, 0x44, 0x54, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x6f, 0x6e, 0x6e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x6c, 0x0a, 0x07, 0x4c, 0x6f, 0x61, 0x64, 0x4c, 0x6f,
+	0x67, 0x12, 0x2f, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x74, 0x6f,
+	0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x70, 0x70, 0x73, 0x70, 0x70, 0x2e, 0x43, 0x61, 0x70,
+	0x43, 0x6f, 0x6e, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x30, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x74,
+	0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x70, 0x70, 0x73, 0x70, 0x70, 0x2e, 0x43, 0x61,
+	0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x4c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x60, 0x0a, 0x1b, 0x67, 0x67, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d,
+	0x73, 0x2e, 0x64, 0x65, 0x76, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x70, 0x70,
+	0x73, 0x70, 0x70, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x4d, 0x65, 0x6d, 0x65, 0x4c, 0x61, 0x62, 0x73, 0x2f, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x64, 0x65, 0x76, 0x74, 0x6f, 0x6f, 0x6c,
+	0x73, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x70, 0x73, 0x70, 0x70, 0x3b, 0x70, 0x70, 0x73, 0x70, 0x70,
+	0xba, 0x02, 0x03, 0x53, 0x44, 0x54, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
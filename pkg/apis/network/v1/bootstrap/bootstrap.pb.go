@@ -260,6 +260,9 @@ type BootstrapClient struct {
 	Version *v1.VersionVector `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
 	// Types that are assignable to ClientOptions:
 	//	*BootstrapClient_WebsocketOptions
+	//	*BootstrapClient_TcpOptions
+	//	*BootstrapClient_DnsOptions
+	//	*BootstrapClient_UdpOptions
 	ClientOptions isBootstrapClient_ClientOptions `protobuf_oneof:"client_options"`
 }
 
@@ -323,6 +326,27 @@ func (x *BootstrapClient) GetWebsocketOptions() *BootstrapClientWebSocketOptions
 	return nil
 }
 
+func (x *BootstrapClient) GetTcpOptions() *BootstrapClientTCPOptions {
+	if x, ok := x.GetClientOptions().(*BootstrapClient_TcpOptions); ok {
+		return x.TcpOptions
+	}
+	return nil
+}
+
+func (x *BootstrapClient) GetDnsOptions() *BootstrapClientDNSOptions {
+	if x, ok := x.GetClientOptions().(*BootstrapClient_DnsOptions); ok {
+		return x.DnsOptions
+	}
+	return nil
+}
+
+func (x *BootstrapClient) GetUdpOptions() *BootstrapClientUDPOptions {
+	if x, ok := x.GetClientOptions().(*BootstrapClient_UdpOptions); ok {
+		return x.UdpOptions
+	}
+	return nil
+}
+
 type isBootstrapClient_ClientOptions interface {
 	isBootstrapClient_ClientOptions()
 }
@@ -331,8 +355,26 @@ type BootstrapClient_WebsocketOptions struct {
 	WebsocketOptions *BootstrapClientWebSocketOptions `protobuf:"bytes,2,opt,name=websocket_options,json=websocketOptions,proto3,oneof"`
 }
 
+type BootstrapClient_TcpOptions struct {
+	TcpOptions *BootstrapClientTCPOptions `protobuf:"bytes,4,opt,name=tcp_options,json=tcpOptions,proto3,oneof"`
+}
+
+type BootstrapClient_DnsOptions struct {
+	DnsOptions *BootstrapClientDNSOptions `protobuf:"bytes,5,opt,name=dns_options,json=dnsOptions,proto3,oneof"`
+}
+
+type BootstrapClient_UdpOptions struct {
+	UdpOptions *BootstrapClientUDPOptions `protobuf:"bytes,6,opt,name=udp_options,json=udpOptions,proto3,oneof"`
+}
+
 func (*BootstrapClient_WebsocketOptions) isBootstrapClient_ClientOptions() {}
 
+func (*BootstrapClient_TcpOptions) isBootstrapClient_ClientOptions() {}
+
+func (*BootstrapClient_DnsOptions) isBootstrapClient_ClientOptions() {}
+
+func (*BootstrapClient_UdpOptions) isBootstrapClient_ClientOptions() {}
+
 type BootstrapClientWebSocketOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -388,6 +430,160 @@ func (x *BootstrapClientWebSocketOptions) GetInsecureSkipVerifyTls() bool {
 	return false
 }
 
+type BootstrapClientTCPOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tcp://<host>:<port>/<hex encoded peer key>
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *BootstrapClientTCPOptions) Reset() {
+	*x = BootstrapClientTCPOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BootstrapClientTCPOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootstrapClientTCPOptions) ProtoMessage() {}
+
+func (x *BootstrapClientTCPOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootstrapClientTCPOptions.ProtoReflect.Descriptor instead.
+func (*BootstrapClientTCPOptions) Descriptor() ([]byte, []int) {
+	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BootstrapClientTCPOptions) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type BootstrapClientUDPOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// udp://<host>:<port>/<hex encoded peer key>
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *BootstrapClientUDPOptions) Reset() {
+	*x = BootstrapClientUDPOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BootstrapClientUDPOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootstrapClientUDPOptions) ProtoMessage() {}
+
+func (x *BootstrapClientUDPOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootstrapClientUDPOptions.ProtoReflect.Descriptor instead.
+func (*BootstrapClientUDPOptions) Descriptor() ([]byte, []int) {
+	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BootstrapClientUDPOptions) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// BootstrapClientDNSOptions resolves bootstrap nodes from TXT records on name
+// in the form "strims-bootstrap=<url>" and from _strims._tcp SRV records whose
+// targets have a "strims-key=<hex encoded peer key>" TXT record.
+type BootstrapClientDNSOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	InsecureSkipVerifyTls bool   `protobuf:"varint,2,opt,name=insecure_skip_verify_tls,json=insecureSkipVerifyTls,proto3" json:"insecure_skip_verify_tls,omitempty"`
+}
+
+func (x *BootstrapClientDNSOptions) Reset() {
+	*x = BootstrapClientDNSOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BootstrapClientDNSOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootstrapClientDNSOptions) ProtoMessage() {}
+
+func (x *BootstrapClientDNSOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootstrapClientDNSOptions.ProtoReflect.Descriptor instead.
+func (*BootstrapClientDNSOptions) Descriptor() ([]byte, []int) {
+	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BootstrapClientDNSOptions) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BootstrapClientDNSOptions) GetInsecureSkipVerifyTls() bool {
+	if x != nil {
+		return x.InsecureSkipVerifyTls
+	}
+	return false
+}
+
 type CreateBootstrapClientRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -395,13 +591,16 @@ type CreateBootstrapClientRequest struct {
 
 	// Types that are assignable to ClientOptions:
 	//	*CreateBootstrapClientRequest_WebsocketOptions
+	//	*CreateBootstrapClientRequest_TcpOptions
+	//	*CreateBootstrapClientRequest_DnsOptions
+	//	*CreateBootstrapClientRequest_UdpOptions
 	ClientOptions isCreateBootstrapClientRequest_ClientOptions `protobuf_oneof:"client_options"`
 }
 
 func (x *CreateBootstrapClientRequest) Reset() {
 	*x = CreateBootstrapClientRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[7]
+		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -414,7 +613,7 @@ func (x *CreateBootstrapClientRequest) String() string {
 func (*CreateBootstrapClientRequest) ProtoMessage() {}
 
 func (x *CreateBootstrapClientRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[7]
+	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -427,7 +626,7 @@ func (x *CreateBootstrapClientRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBootstrapClientRequest.ProtoReflect.Descriptor instead.
 func (*CreateBootstrapClientRequest) Descriptor() ([]byte, []int) {
-	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{7}
+	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{10}
 }
 
 func (m *CreateBootstrapClientRequest) GetClientOptions() isCreateBootstrapClientRequest_ClientOptions {
@@ -444,6 +643,27 @@ func (x *CreateBootstrapClientRequest) GetWebsocketOptions() *BootstrapClientWeb
 	return nil
 }
 
+func (x *CreateBootstrapClientRequest) GetTcpOptions() *BootstrapClientTCPOptions {
+	if x, ok := x.GetClientOptions().(*CreateBootstrapClientRequest_TcpOptions); ok {
+		return x.TcpOptions
+	}
+	return nil
+}
+
+func (x *CreateBootstrapClientRequest) GetDnsOptions() *BootstrapClientDNSOptions {
+	if x, ok := x.GetClientOptions().(*CreateBootstrapClientRequest_DnsOptions); ok {
+		return x.DnsOptions
+	}
+	return nil
+}
+
+func (x *CreateBootstrapClientRequest) GetUdpOptions() *BootstrapClientUDPOptions {
+	if x, ok := x.GetClientOptions().(*CreateBootstrapClientRequest_UdpOptions); ok {
+		return x.UdpOptions
+	}
+	return nil
+}
+
 type isCreateBootstrapClientRequest_ClientOptions interface {
 	isCreateBootstrapClientRequest_ClientOptions()
 }
@@ -452,9 +672,27 @@ type CreateBootstrapClientRequest_WebsocketOptions struct {
 	WebsocketOptions *BootstrapClientWebSocketOptions `protobuf:"bytes,1,opt,name=websocket_options,json=websocketOptions,proto3,oneof"`
 }
 
+type CreateBootstrapClientRequest_TcpOptions struct {
+	TcpOptions *BootstrapClientTCPOptions `protobuf:"bytes,2,opt,name=tcp_options,json=tcpOptions,proto3,oneof"`
+}
+
+type CreateBootstrapClientRequest_DnsOptions struct {
+	DnsOptions *BootstrapClientDNSOptions `protobuf:"bytes,3,opt,name=dns_options,json=dnsOptions,proto3,oneof"`
+}
+
+type CreateBootstrapClientRequest_UdpOptions struct {
+	UdpOptions *BootstrapClientUDPOptions `protobuf:"bytes,4,opt,name=udp_options,json=udpOptions,proto3,oneof"`
+}
+
 func (*CreateBootstrapClientRequest_WebsocketOptions) isCreateBootstrapClientRequest_ClientOptions() {
 }
 
+func (*CreateBootstrapClientRequest_TcpOptions) isCreateBootstrapClientRequest_ClientOptions() {}
+
+func (*CreateBootstrapClientRequest_DnsOptions) isCreateBootstrapClientRequest_ClientOptions() {}
+
+func (*CreateBootstrapClientRequest_UdpOptions) isCreateBootstrapClientRequest_ClientOptions() {}
+
 type CreateBootstrapClientResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -466,7 +704,7 @@ type CreateBootstrapClientResponse struct {
 func (x *CreateBootstrapClientResponse) Reset() {
 	*x = CreateBootstrapClientResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[8]
+		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -479,7 +717,7 @@ func (x *CreateBootstrapClientResponse) String() string {
 func (*CreateBootstrapClientResponse) ProtoMessage() {}
 
 func (x *CreateBootstrapClientResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[8]
+	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -492,7 +730,7 @@ func (x *CreateBootstrapClientResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBootstrapClientResponse.ProtoReflect.Descriptor instead.
 func (*CreateBootstrapClientResponse) Descriptor() ([]byte, []int) {
-	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{8}
+	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CreateBootstrapClientResponse) GetBootstrapClient() *BootstrapClient {
@@ -510,13 +748,16 @@ type UpdateBootstrapClientRequest struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	// Types that are assignable to ClientOptions:
 	//	*UpdateBootstrapClientRequest_WebsocketOptions
+	//	*UpdateBootstrapClientRequest_TcpOptions
+	//	*UpdateBootstrapClientRequest_DnsOptions
+	//	*UpdateBootstrapClientRequest_UdpOptions
 	ClientOptions isUpdateBootstrapClientRequest_ClientOptions `protobuf_oneof:"client_options"`
 }
 
 func (x *UpdateBootstrapClientRequest) Reset() {
 	*x = UpdateBootstrapClientRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[9]
+		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -529,7 +770,7 @@ func (x *UpdateBootstrapClientRequest) String() string {
 func (*UpdateBootstrapClientRequest) ProtoMessage() {}
 
 func (x *UpdateBootstrapClientRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[9]
+	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -542,7 +783,7 @@ func (x *UpdateBootstrapClientRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateBootstrapClientRequest.ProtoReflect.Descriptor instead.
 func (*UpdateBootstrapClientRequest) Descriptor() ([]byte, []int) {
-	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{9}
+	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateBootstrapClientRequest) GetId() uint64 {
@@ -566,6 +807,27 @@ func (x *UpdateBootstrapClientRequest) GetWebsocketOptions() *BootstrapClientWeb
 	return nil
 }
 
+func (x *UpdateBootstrapClientRequest) GetTcpOptions() *BootstrapClientTCPOptions {
+	if x, ok := x.GetClientOptions().(*UpdateBootstrapClientRequest_TcpOptions); ok {
+		return x.TcpOptions
+	}
+	return nil
+}
+
+func (x *UpdateBootstrapClientRequest) GetDnsOptions() *BootstrapClientDNSOptions {
+	if x, ok := x.GetClientOptions().(*UpdateBootstrapClientRequest_DnsOptions); ok {
+		return x.DnsOptions
+	}
+	return nil
+}
+
+func (x *UpdateBootstrapClientRequest) GetUdpOptions() *BootstrapClientUDPOptions {
+	if x, ok := x.GetClientOptions().(*UpdateBootstrapClientRequest_UdpOptions); ok {
+		return x.UdpOptions
+	}
+	return nil
+}
+
 type isUpdateBootstrapClientRequest_ClientOptions interface {
 	isUpdateBootstrapClientRequest_ClientOptions()
 }
@@ -574,9 +836,27 @@ type UpdateBootstrapClientRequest_WebsocketOptions struct {
 	WebsocketOptions *BootstrapClientWebSocketOptions `protobuf:"bytes,2,opt,name=websocket_options,json=websocketOptions,proto3,oneof"`
 }
 
+type UpdateBootstrapClientRequest_TcpOptions struct {
+	TcpOptions *BootstrapClientTCPOptions `protobuf:"bytes,3,opt,name=tcp_options,json=tcpOptions,proto3,oneof"`
+}
+
+type UpdateBootstrapClientRequest_DnsOptions struct {
+	DnsOptions *BootstrapClientDNSOptions `protobuf:"bytes,4,opt,name=dns_options,json=dnsOptions,proto3,oneof"`
+}
+
+type UpdateBootstrapClientRequest_UdpOptions struct {
+	UdpOptions *BootstrapClientUDPOptions `protobuf:"bytes,5,opt,name=udp_options,json=udpOptions,proto3,oneof"`
+}
+
 func (*UpdateBootstrapClientRequest_WebsocketOptions) isUpdateBootstrapClientRequest_ClientOptions() {
 }
 
+func (*UpdateBootstrapClientRequest_TcpOptions) isUpdateBootstrapClientRequest_ClientOptions() {}
+
+func (*UpdateBootstrapClientRequest_DnsOptions) isUpdateBootstrapClientRequest_ClientOptions() {}
+
+func (*UpdateBootstrapClientRequest_UdpOptions) isUpdateBootstrapClientRequest_ClientOptions() {}
+
 type UpdateBootstrapClientResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -588,7 +868,7 @@ type UpdateBootstrapClientResponse struct {
 func (x *UpdateBootstrapClientResponse) Reset() {
 	*x = UpdateBootstrapClientResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[10]
+		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -601,7 +881,7 @@ func (x *UpdateBootstrapClientResponse) String() string {
 func (*UpdateBootstrapClientResponse) ProtoMessage() {}
 
 func (x *UpdateBootstrapClientResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[10]
+	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -614,7 +894,7 @@ func (x *UpdateBootstrapClientResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateBootstrapClientResponse.ProtoReflect.Descriptor instead.
 func (*UpdateBootstrapClientResponse) Descriptor() ([]byte, []int) {
-	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{10}
+	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UpdateBootstrapClientResponse) GetBootstrapClient() *BootstrapClient {
@@ -635,7 +915,7 @@ type DeleteBootstrapClientRequest struct {
 func (x *DeleteBootstrapClientRequest) Reset() {
 	*x = DeleteBootstrapClientRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[11]
+		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -648,7 +928,7 @@ func (x *DeleteBootstrapClientRequest) String() string {
 func (*DeleteBootstrapClientRequest) ProtoMessage() {}
 
 func (x *DeleteBootstrapClientRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[11]
+	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -661,7 +941,7 @@ func (x *DeleteBootstrapClientRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteBootstrapClientRequest.ProtoReflect.Descriptor instead.
 func (*DeleteBootstrapClientRequest) Descriptor() ([]byte, []int) {
-	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{11}
+	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DeleteBootstrapClientRequest) GetId() uint64 {
@@ -680,7 +960,7 @@ type DeleteBootstrapClientResponse struct {
 func (x *DeleteBootstrapClientResponse) Reset() {
 	*x = DeleteBootstrapClientResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[12]
+		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -693,7 +973,7 @@ func (x *DeleteBootstrapClientResponse) String() string {
 func (*DeleteBootstrapClientResponse) ProtoMessage() {}
 
 func (x *DeleteBootstrapClientResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[12]
+	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -706,7 +986,7 @@ func (x *DeleteBootstrapClientResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteBootstrapClientResponse.ProtoReflect.Descriptor instead.
 func (*DeleteBootstrapClientResponse) Descriptor() ([]byte, []int) {
-	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{12}
+	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{15}
 }
 
 type GetBootstrapClientRequest struct {
@@ -720,7 +1000,7 @@ type GetBootstrapClientRequest struct {
 func (x *GetBootstrapClientRequest) Reset() {
 	*x = GetBootstrapClientRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[13]
+		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -733,7 +1013,7 @@ func (x *GetBootstrapClientRequest) String() string {
 func (*GetBootstrapClientRequest) ProtoMessage() {}
 
 func (x *GetBootstrapClientRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[13]
+	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -746,7 +1026,7 @@ func (x *GetBootstrapClientRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBootstrapClientRequest.ProtoReflect.Descriptor instead.
 func (*GetBootstrapClientRequest) Descriptor() ([]byte, []int) {
-	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{13}
+	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetBootstrapClientRequest) GetId() uint64 {
@@ -767,7 +1047,7 @@ type GetBootstrapClientResponse struct {
 func (x *GetBootstrapClientResponse) Reset() {
 	*x = GetBootstrapClientResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[14]
+		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -780,7 +1060,7 @@ func (x *GetBootstrapClientResponse) String() string {
 func (*GetBootstrapClientResponse) ProtoMessage() {}
 
 func (x *GetBootstrapClientResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[14]
+	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -793,7 +1073,7 @@ func (x *GetBootstrapClientResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBootstrapClientResponse.ProtoReflect.Descriptor instead.
 func (*GetBootstrapClientResponse) Descriptor() ([]byte, []int) {
-	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{14}
+	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetBootstrapClientResponse) GetBootstrapClient() *BootstrapClient {
@@ -812,7 +1092,7 @@ type ListBootstrapClientsRequest struct {
 func (x *ListBootstrapClientsRequest) Reset() {
 	*x = ListBootstrapClientsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[15]
+		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -825,7 +1105,7 @@ func (x *ListBootstrapClientsRequest) String() string {
 func (*ListBootstrapClientsRequest) ProtoMessage() {}
 
 func (x *ListBootstrapClientsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[15]
+	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -838,7 +1118,7 @@ func (x *ListBootstrapClientsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBootstrapClientsRequest.ProtoReflect.Descriptor instead.
 func (*ListBootstrapClientsRequest) Descriptor() ([]byte, []int) {
-	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{15}
+	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{18}
 }
 
 type ListBootstrapClientsResponse struct {
@@ -852,7 +1132,7 @@ type ListBootstrapClientsResponse struct {
 func (x *ListBootstrapClientsResponse) Reset() {
 	*x = ListBootstrapClientsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[16]
+		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -865,7 +1145,7 @@ func (x *ListBootstrapClientsResponse) String() string {
 func (*ListBootstrapClientsResponse) ProtoMessage() {}
 
 func (x *ListBootstrapClientsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[16]
+	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -878,7 +1158,7 @@ func (x *ListBootstrapClientsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBootstrapClientsResponse.ProtoReflect.Descriptor instead.
 func (*ListBootstrapClientsResponse) Descriptor() ([]byte, []int) {
-	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{16}
+	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ListBootstrapClientsResponse) GetBootstrapClients() []*BootstrapClient {
@@ -897,7 +1177,7 @@ type ListBootstrapPeersRequest struct {
 func (x *ListBootstrapPeersRequest) Reset() {
 	*x = ListBootstrapPeersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[17]
+		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -910,7 +1190,7 @@ func (x *ListBootstrapPeersRequest) String() string {
 func (*ListBootstrapPeersRequest) ProtoMessage() {}
 
 func (x *ListBootstrapPeersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[17]
+	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -923,7 +1203,7 @@ func (x *ListBootstrapPeersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBootstrapPeersRequest.ProtoReflect.Descriptor instead.
 func (*ListBootstrapPeersRequest) Descriptor() ([]byte, []int) {
-	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{17}
+	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{20}
 }
 
 type ListBootstrapPeersResponse struct {
@@ -937,7 +1217,7 @@ type ListBootstrapPeersResponse struct {
 func (x *ListBootstrapPeersResponse) Reset() {
 	*x = ListBootstrapPeersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[18]
+		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -950,7 +1230,7 @@ func (x *ListBootstrapPeersResponse) String() string {
 func (*ListBootstrapPeersResponse) ProtoMessage() {}
 
 func (x *ListBootstrapPeersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[18]
+	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -963,7 +1243,7 @@ func (x *ListBootstrapPeersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBootstrapPeersResponse.ProtoReflect.Descriptor instead.
 func (*ListBootstrapPeersResponse) Descriptor() ([]byte, []int) {
-	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{18}
+	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ListBootstrapPeersResponse) GetPeers() []*BootstrapPeer {
@@ -985,7 +1265,7 @@ type BootstrapPeer struct {
 func (x *BootstrapPeer) Reset() {
 	*x = BootstrapPeer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[19]
+		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -998,7 +1278,7 @@ func (x *BootstrapPeer) String() string {
 func (*BootstrapPeer) ProtoMessage() {}
 
 func (x *BootstrapPeer) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[19]
+	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1011,7 +1291,7 @@ func (x *BootstrapPeer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BootstrapPeer.ProtoReflect.Descriptor instead.
 func (*BootstrapPeer) Descriptor() ([]byte, []int) {
-	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{19}
+	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *BootstrapPeer) GetPeerId() uint64 {
@@ -1043,7 +1323,7 @@ type BootstrapServiceMessage struct {
 func (x *BootstrapServiceMessage) Reset() {
 	*x = BootstrapServiceMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[20]
+		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1056,7 +1336,7 @@ func (x *BootstrapServiceMessage) String() string {
 func (*BootstrapServiceMessage) ProtoMessage() {}
 
 func (x *BootstrapServiceMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[20]
+	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1069,7 +1349,7 @@ func (x *BootstrapServiceMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BootstrapServiceMessage.ProtoReflect.Descriptor instead.
 func (*BootstrapServiceMessage) Descriptor() ([]byte, []int) {
-	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{20}
+	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{23}
 }
 
 func (m *BootstrapServiceMessage) GetBody() isBootstrapServiceMessage_Body {
@@ -1134,7 +1414,7 @@ type PublishNetworkToBootstrapPeerRequest struct {
 func (x *PublishNetworkToBootstrapPeerRequest) Reset() {
 	*x = PublishNetworkToBootstrapPeerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[21]
+		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1147,7 +1427,7 @@ func (x *PublishNetworkToBootstrapPeerRequest) String() string {
 func (*PublishNetworkToBootstrapPeerRequest) ProtoMessage() {}
 
 func (x *PublishNetworkToBootstrapPeerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[21]
+	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1160,7 +1440,7 @@ func (x *PublishNetworkToBootstrapPeerRequest) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use PublishNetworkToBootstrapPeerRequest.ProtoReflect.Descriptor instead.
 func (*PublishNetworkToBootstrapPeerRequest) Descriptor() ([]byte, []int) {
-	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{21}
+	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *PublishNetworkToBootstrapPeerRequest) GetPeerId() uint64 {
@@ -1186,7 +1466,7 @@ type PublishNetworkToBootstrapPeerResponse struct {
 func (x *PublishNetworkToBootstrapPeerResponse) Reset() {
 	*x = PublishNetworkToBootstrapPeerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[22]
+		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1199,7 +1479,7 @@ func (x *PublishNetworkToBootstrapPeerResponse) String() string {
 func (*PublishNetworkToBootstrapPeerResponse) ProtoMessage() {}
 
 func (x *PublishNetworkToBootstrapPeerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[22]
+	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1212,7 +1492,7 @@ func (x *PublishNetworkToBootstrapPeerResponse) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use PublishNetworkToBootstrapPeerResponse.ProtoReflect.Descriptor instead.
 func (*PublishNetworkToBootstrapPeerResponse) Descriptor() ([]byte, []int) {
-	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{22}
+	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{25}
 }
 
 type BootstrapServiceMessage_BrokerOffer struct {
@@ -1224,7 +1504,7 @@ type BootstrapServiceMessage_BrokerOffer struct {
 func (x *BootstrapServiceMessage_BrokerOffer) Reset() {
 	*x = BootstrapServiceMessage_BrokerOffer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[23]
+		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1237,7 +1517,7 @@ func (x *BootstrapServiceMessage_BrokerOffer) String() string {
 func (*BootstrapServiceMessage_BrokerOffer) ProtoMessage() {}
 
 func (x *BootstrapServiceMessage_BrokerOffer) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[23]
+	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1250,7 +1530,7 @@ func (x *BootstrapServiceMessage_BrokerOffer) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use BootstrapServiceMessage_BrokerOffer.ProtoReflect.Descriptor instead.
 func (*BootstrapServiceMessage_BrokerOffer) Descriptor() ([]byte, []int) {
-	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{20, 0}
+	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{23, 0}
 }
 
 type BootstrapServiceMessage_PublishRequest struct {
@@ -1265,7 +1545,7 @@ type BootstrapServiceMessage_PublishRequest struct {
 func (x *BootstrapServiceMessage_PublishRequest) Reset() {
 	*x = BootstrapServiceMessage_PublishRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[24]
+		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1278,7 +1558,7 @@ func (x *BootstrapServiceMessage_PublishRequest) String() string {
 func (*BootstrapServiceMessage_PublishRequest) ProtoMessage() {}
 
 func (x *BootstrapServiceMessage_PublishRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[24]
+	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1291,7 +1571,7 @@ func (x *BootstrapServiceMessage_PublishRequest) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use BootstrapServiceMessage_PublishRequest.ProtoReflect.Descriptor instead.
 func (*BootstrapServiceMessage_PublishRequest) Descriptor() ([]byte, []int) {
-	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{20, 1}
+	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{23, 1}
 }
 
 func (x *BootstrapServiceMessage_PublishRequest) GetName() string {
@@ -1321,7 +1601,7 @@ type BootstrapServiceMessage_PublishResponse struct {
 func (x *BootstrapServiceMessage_PublishResponse) Reset() {
 	*x = BootstrapServiceMessage_PublishResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[25]
+		mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1334,7 +1614,7 @@ func (x *BootstrapServiceMessage_PublishResponse) String() string {
 func (*BootstrapServiceMessage_PublishResponse) ProtoMessage() {}
 
 func (x *BootstrapServiceMessage_PublishResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[25]
+	mi := &file_network_v1_bootstrap_bootstrap_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1347,7 +1627,7 @@ func (x *BootstrapServiceMessage_PublishResponse) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use BootstrapServiceMessage_PublishResponse.ProtoReflect.Descriptor instead.
 func (*BootstrapServiceMessage_PublishResponse) Descriptor() ([]byte, []int) {
-	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{20, 2}
+	return file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP(), []int{23, 2}
 }
 
 func (m *BootstrapServiceMessage_PublishResponse) GetBody() isBootstrapServiceMessage_PublishResponse_Body {
@@ -1404,7 +1684,7 @@ var file_network_v1_bootstrap_bootstrap_proto_rawDesc = []byte{
 	0x12, 0x3b, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x23, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
 	0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xd8, 0x01,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xe9, 0x03,
 	0x0a, 0x0f, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e,
 	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
 	0x64, 0x12, 0x36, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
@@ -1417,211 +1697,275 @@ var file_network_v1_bootstrap_bootstrap_proto_rawDesc = []byte{
 	0x61, 0x70, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65,
 	0x6e, 0x74, 0x57, 0x65, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f,
 	0x6e, 0x73, 0x48, 0x00, 0x52, 0x10, 0x77, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x6c, 0x0a, 0x1f, 0x42, 0x6f, 0x6f, 0x74,
-	0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x53, 0x6f,
-	0x63, 0x6b, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x75,
-	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x37, 0x0a,
-	0x18, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x76,
-	0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x74, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x15, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72,
-	0x69, 0x66, 0x79, 0x54, 0x6c, 0x73, 0x22, 0x9d, 0x01, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x6b, 0x0a, 0x11, 0x77, 0x65, 0x62, 0x73, 0x6f,
-	0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x59, 0x0a, 0x0b, 0x74, 0x63, 0x70, 0x5f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e,
+	0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74,
+	0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x43, 0x50, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x63, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x59, 0x0a, 0x0b, 0x64, 0x6e, 0x73, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73,
+	0x74, 0x72, 0x61, 0x70, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x44, 0x4e, 0x53, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00,
+	0x52, 0x0a, 0x64, 0x6e, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x59, 0x0a, 0x0b,
+	0x75, 0x64, 0x70, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x36, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e,
+	0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55,
+	0x44, 0x50, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x75, 0x64, 0x70,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x6c, 0x0a, 0x1f, 0x42, 0x6f, 0x6f,
+	0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x53,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x37,
+	0x0a, 0x18, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x5f,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x74, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x15, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x54, 0x6c, 0x73, 0x22, 0x2d, 0x0a, 0x19, 0x42, 0x6f, 0x6f, 0x74, 0x73,
+	0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x43, 0x50, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x2d, 0x0a, 0x19, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74,
+	0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x44, 0x50, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x68, 0x0a, 0x19, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72,
+	0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44, 0x4e, 0x53, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x65, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x74,
+	0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x6c, 0x73, 0x22,
+	0xae, 0x03, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74,
+	0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x6b, 0x0a, 0x11, 0x77, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e,
+	0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74,
+	0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x53, 0x6f, 0x63, 0x6b,
+	0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x10, 0x77, 0x65, 0x62,
+	0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x59, 0x0a,
+	0x0b, 0x74, 0x63, 0x70, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x36, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77,
 	0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70,
 	0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x57, 0x65, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x48, 0x00, 0x52, 0x10, 0x77, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x78, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x62, 0x6f, 0x6f, 0x74, 0x73,
-	0x74, 0x72, 0x61, 0x70, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e,
-	0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52,
-	0x0f, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x22, 0xad, 0x01, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x74, 0x73,
-	0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x6b, 0x0a, 0x11, 0x77, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x73,
-	0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31,
-	0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73,
-	0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x53, 0x6f, 0x63,
-	0x6b, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x10, 0x77, 0x65,
-	0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x10,
+	0x54, 0x43, 0x50, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x63,
+	0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x59, 0x0a, 0x0b, 0x64, 0x6e, 0x73, 0x5f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e,
+	0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76,
+	0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x42, 0x6f, 0x6f, 0x74,
+	0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44, 0x4e, 0x53, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x6e, 0x73, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x59, 0x0a, 0x0b, 0x75, 0x64, 0x70, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d,
+	0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f,
+	0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x44, 0x50, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x48, 0x00, 0x52, 0x0a, 0x75, 0x64, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x10,
 	0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0x78, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74,
+	0x22, 0x78, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74,
 	0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x57, 0x0a, 0x10, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x5f, 0x63,
 	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x74,
 	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e,
 	0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74,
 	0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0f, 0x62, 0x6f, 0x6f, 0x74, 0x73,
-	0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22, 0x2e, 0x0a, 0x1c, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1f, 0x0a, 0x1d, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x0a, 0x19, 0x47,
-	0x65, 0x74, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x75, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x42,
-	0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74,
-	0x72, 0x61, 0x70, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x2c, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x42,
-	0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0f,
-	0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22,
-	0x1d, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x79,
-	0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59,
-	0x0a, 0x11, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x5f, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x74, 0x72, 0x69,
-	0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f,
-	0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61,
-	0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x10, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72,
-	0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x4c, 0x69, 0x73,
-	0x74, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5e, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f,
-	0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61,
-	0x70, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x50, 0x65, 0x65, 0x72, 0x52,
-	0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x22, 0x3e, 0x0a, 0x0d, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74,
-	0x72, 0x61, 0x70, 0x50, 0x65, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x8f, 0x04, 0x0a, 0x17, 0x42, 0x6f, 0x6f, 0x74, 0x73,
-	0x74, 0x72, 0x61, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x65, 0x0a, 0x0c, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x66,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d,
+	0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22, 0xbe, 0x03, 0x0a, 0x1c, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x6b, 0x0a, 0x11, 0x77,
+	0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73,
+	0x74, 0x72, 0x61, 0x70, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x10, 0x77, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65,
+	0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x59, 0x0a, 0x0b, 0x74, 0x63, 0x70, 0x5f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e,
+	0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76,
+	0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x42, 0x6f, 0x6f, 0x74,
+	0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x43, 0x50, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x63, 0x70, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x59, 0x0a, 0x0b, 0x64, 0x6e, 0x73, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d,
 	0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f,
 	0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x42,
-	0x72, 0x6f, 0x6b, 0x65, 0x72, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x72,
-	0x6f, 0x6b, 0x65, 0x72, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x6e, 0x0a, 0x0f, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x43, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70,
-	0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69,
-	0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x71, 0x0a, 0x10, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44, 0x4e, 0x53, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x48, 0x00, 0x52, 0x0a, 0x64, 0x6e, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x59,
+	0x0a, 0x0b, 0x75, 0x64, 0x70, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74,
 	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61,
-	0x70, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
-	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x0d, 0x0a, 0x0b,
-	0x42, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x1a, 0x60, 0x0a, 0x0e, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x1a, 0x31, 0x0a,
-	0x0f, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x16, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x06, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79,
-	0x42, 0x06, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x5e, 0x0a, 0x24, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x73, 0x68, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x6f, 0x42, 0x6f, 0x6f, 0x74,
-	0x73, 0x74, 0x72, 0x61, 0x70, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x22, 0x27, 0x0a, 0x25, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x73, 0x68, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x6f, 0x42, 0x6f, 0x6f, 0x74,
-	0x73, 0x74, 0x72, 0x61, 0x70, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x32, 0xa4, 0x09, 0x0a, 0x11, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x46,
-	0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x12, 0x6a, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x2d, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65,
+	0x70, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x55, 0x44, 0x50, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x75,
+	0x64, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x78, 0x0a, 0x1d, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10,
+	0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73,
+	0x74, 0x72, 0x61, 0x70, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x52, 0x0f, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22, 0x2e, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
+	0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1f, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
+	0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f,
+	0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x75, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74,
+	0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x57, 0x0a, 0x10, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x5f, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e,
+	0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74,
+	0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0f, 0x62, 0x6f, 0x6f, 0x74, 0x73,
+	0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22, 0x1d, 0x0a, 0x1b, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x79, 0x0a, 0x1c, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x11, 0x62, 0x6f, 0x6f,
+	0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65,
 	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72,
-	0x61, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74,
+	0x61, 0x70, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x52, 0x10, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x74,
+	0x73, 0x74, 0x72, 0x61, 0x70, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x5e, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72,
+	0x61, 0x70, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x40, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
+	0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x42, 0x6f, 0x6f,
+	0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x50, 0x65, 0x65, 0x72, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72,
+	0x73, 0x22, 0x3e, 0x0a, 0x0d, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x50, 0x65,
+	0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x22, 0x8f, 0x04, 0x0a, 0x17, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x65, 0x0a,
+	0x0c, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74,
 	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61,
-	0x70, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x2d, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x53,
-	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2e, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x53, 0x65,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x85, 0x01, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x12, 0x39, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x73, 0x74,
+	0x70, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x42, 0x72, 0x6f, 0x6b, 0x65, 0x72,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x4f,
+	0x66, 0x66, 0x65, 0x72, 0x12, 0x6e, 0x0a, 0x0f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e,
+	0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76,
+	0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x42, 0x6f, 0x6f, 0x74,
+	0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x71, 0x0a, 0x10, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x44,
+	0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
+	0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x42, 0x6f, 0x6f,
+	0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x0d, 0x0a, 0x0b, 0x42, 0x72, 0x6f, 0x6b, 0x65,
+	0x72, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x1a, 0x60, 0x0a, 0x0e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0b,
+	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x63, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x1a, 0x31, 0x0a, 0x0f, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x42, 0x06, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x42, 0x06, 0x0a, 0x04, 0x62,
+	0x6f, 0x64, 0x79, 0x22, 0x5e, 0x0a, 0x24, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x6f, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70,
+	0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x65,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x49, 0x64, 0x22, 0x27, 0x0a, 0x25, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x6f, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70,
+	0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xa4, 0x09, 0x0a,
+	0x11, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x64, 0x12, 0x6a, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x2d, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
+	0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
+	0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a,
+	0x0a, 0x09, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2d, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e,
+	0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x74, 0x72,
+	0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62,
+	0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x0c, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x2e, 0x73, 0x74,
 	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e,
 	0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
 	0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d,
-	0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f,
-	0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f,
-	0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73,
+	0x74, 0x72, 0x61, 0x70, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x74, 0x73,
+	0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x12, 0x39, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74,
 	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61,
 	0x70, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61,
-	0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x85, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x12, 0x39, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x73, 0x74,
+	0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a,
+	0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
+	0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x0c, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x2e, 0x73, 0x74,
 	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e,
 	0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
 	0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x12, 0x36, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72,
-	0x61, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x73,
-	0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31,
-	0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f,
-	0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x38, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74,
-	0x72, 0x61, 0x70, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61,
-	0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x39, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x09, 0x4c, 0x69,
-	0x73, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x36, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73,
+	0x74, 0x72, 0x61, 0x70, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x74, 0x73,
+	0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x7c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x36, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x47, 0x65,
+	0x74, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73,
 	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74,
-	0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74,
-	0x72, 0x61, 0x70, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x37, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x50, 0x65, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9d, 0x01, 0x0a, 0x14, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x73, 0x68, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x6f, 0x50, 0x65, 0x65,
-	0x72, 0x12, 0x41, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x6f,
-	0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x42, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72,
-	0x61, 0x70, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x54, 0x6f, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x50, 0x65, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x6a, 0x0a, 0x1e, 0x67, 0x67, 0x2e, 0x73,
+	0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72,
+	0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x82, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x38, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x73, 0x74, 0x72,
+	0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62,
+	0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f,
+	0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x65,
+	0x72, 0x73, 0x12, 0x36, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x50, 0x65,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x73, 0x74, 0x72,
+	0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62,
+	0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f,
+	0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x9d, 0x01, 0x0a, 0x14, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x6f, 0x50, 0x65, 0x65, 0x72, 0x12, 0x41, 0x2e, 0x73,
 	0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31,
-	0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x65, 0x6d, 0x65, 0x4c, 0x61, 0x62, 0x73, 0x2f,
-	0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x74, 0x73,
-	0x74, 0x72, 0x61, 0x70, 0x3b, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0xba, 0x02,
-	0x03, 0x53, 0x4e, 0x42, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x6f, 0x42, 0x6f, 0x6f, 0x74, 0x73,
+	0x74, 0x72, 0x61, 0x70, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x42, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x2e, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x6f, 0x42, 0x6f,
+	0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x6a, 0x0a, 0x1e, 0x67, 0x67, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73,
+	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x62, 0x6f, 0x6f, 0x74,
+	0x73, 0x74, 0x72, 0x61, 0x70, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x4d, 0x65, 0x6d, 0x65, 0x4c, 0x61, 0x62, 0x73, 0x2f, 0x73, 0x74, 0x72, 0x69, 0x6d,
+	0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x3b,
+	0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0xba, 0x02, 0x03, 0x53, 0x4e, 0x42, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1636,7 +1980,7 @@ func file_network_v1_bootstrap_bootstrap_proto_rawDescGZIP() []byte {
 	return file_network_v1_bootstrap_bootstrap_proto_rawDescData
 }
 
-var file_network_v1_bootstrap_bootstrap_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_network_v1_bootstrap_bootstrap_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_network_v1_bootstrap_bootstrap_proto_goTypes = []interface{}{
 	(*Config)(nil),                                  // 0: strims.network.v1.bootstrap.Config
 	(*GetConfigRequest)(nil),                        // 1: strims.network.v1.bootstrap.GetConfigRequest
@@ -1645,68 +1989,80 @@ var file_network_v1_bootstrap_bootstrap_proto_goTypes = []interface{}{
 	(*SetConfigResponse)(nil),                       // 4: strims.network.v1.bootstrap.SetConfigResponse
 	(*BootstrapClient)(nil),                         // 5: strims.network.v1.bootstrap.BootstrapClient
 	(*BootstrapClientWebSocketOptions)(nil),         // 6: strims.network.v1.bootstrap.BootstrapClientWebSocketOptions
-	(*CreateBootstrapClientRequest)(nil),            // 7: strims.network.v1.bootstrap.CreateBootstrapClientRequest
-	(*CreateBootstrapClientResponse)(nil),           // 8: strims.network.v1.bootstrap.CreateBootstrapClientResponse
-	(*UpdateBootstrapClientRequest)(nil),            // 9: strims.network.v1.bootstrap.UpdateBootstrapClientRequest
-	(*UpdateBootstrapClientResponse)(nil),           // 10: strims.network.v1.bootstrap.UpdateBootstrapClientResponse
-	(*DeleteBootstrapClientRequest)(nil),            // 11: strims.network.v1.bootstrap.DeleteBootstrapClientRequest
-	(*DeleteBootstrapClientResponse)(nil),           // 12: strims.network.v1.bootstrap.DeleteBootstrapClientResponse
-	(*GetBootstrapClientRequest)(nil),               // 13: strims.network.v1.bootstrap.GetBootstrapClientRequest
-	(*GetBootstrapClientResponse)(nil),              // 14: strims.network.v1.bootstrap.GetBootstrapClientResponse
-	(*ListBootstrapClientsRequest)(nil),             // 15: strims.network.v1.bootstrap.ListBootstrapClientsRequest
-	(*ListBootstrapClientsResponse)(nil),            // 16: strims.network.v1.bootstrap.ListBootstrapClientsResponse
-	(*ListBootstrapPeersRequest)(nil),               // 17: strims.network.v1.bootstrap.ListBootstrapPeersRequest
-	(*ListBootstrapPeersResponse)(nil),              // 18: strims.network.v1.bootstrap.ListBootstrapPeersResponse
-	(*BootstrapPeer)(nil),                           // 19: strims.network.v1.bootstrap.BootstrapPeer
-	(*BootstrapServiceMessage)(nil),                 // 20: strims.network.v1.bootstrap.BootstrapServiceMessage
-	(*PublishNetworkToBootstrapPeerRequest)(nil),    // 21: strims.network.v1.bootstrap.PublishNetworkToBootstrapPeerRequest
-	(*PublishNetworkToBootstrapPeerResponse)(nil),   // 22: strims.network.v1.bootstrap.PublishNetworkToBootstrapPeerResponse
-	(*BootstrapServiceMessage_BrokerOffer)(nil),     // 23: strims.network.v1.bootstrap.BootstrapServiceMessage.BrokerOffer
-	(*BootstrapServiceMessage_PublishRequest)(nil),  // 24: strims.network.v1.bootstrap.BootstrapServiceMessage.PublishRequest
-	(*BootstrapServiceMessage_PublishResponse)(nil), // 25: strims.network.v1.bootstrap.BootstrapServiceMessage.PublishResponse
-	(*v1.VersionVector)(nil),                        // 26: strims.dao.v1.VersionVector
-	(*certificate.Certificate)(nil),                 // 27: strims.type.Certificate
+	(*BootstrapClientTCPOptions)(nil),               // 7: strims.network.v1.bootstrap.BootstrapClientTCPOptions
+	(*BootstrapClientUDPOptions)(nil),               // 8: strims.network.v1.bootstrap.BootstrapClientUDPOptions
+	(*BootstrapClientDNSOptions)(nil),               // 9: strims.network.v1.bootstrap.BootstrapClientDNSOptions
+	(*CreateBootstrapClientRequest)(nil),            // 10: strims.network.v1.bootstrap.CreateBootstrapClientRequest
+	(*CreateBootstrapClientResponse)(nil),           // 11: strims.network.v1.bootstrap.CreateBootstrapClientResponse
+	(*UpdateBootstrapClientRequest)(nil),            // 12: strims.network.v1.bootstrap.UpdateBootstrapClientRequest
+	(*UpdateBootstrapClientResponse)(nil),           // 13: strims.network.v1.bootstrap.UpdateBootstrapClientResponse
+	(*DeleteBootstrapClientRequest)(nil),            // 14: strims.network.v1.bootstrap.DeleteBootstrapClientRequest
+	(*DeleteBootstrapClientResponse)(nil),           // 15: strims.network.v1.bootstrap.DeleteBootstrapClientResponse
+	(*GetBootstrapClientRequest)(nil),               // 16: strims.network.v1.bootstrap.GetBootstrapClientRequest
+	(*GetBootstrapClientResponse)(nil),              // 17: strims.network.v1.bootstrap.GetBootstrapClientResponse
+	(*ListBootstrapClientsRequest)(nil),             // 18: strims.network.v1.bootstrap.ListBootstrapClientsRequest
+	(*ListBootstrapClientsResponse)(nil),            // 19: strims.network.v1.bootstrap.ListBootstrapClientsResponse
+	(*ListBootstrapPeersRequest)(nil),               // 20: strims.network.v1.bootstrap.ListBootstrapPeersRequest
+	(*ListBootstrapPeersResponse)(nil),              // 21: strims.network.v1.bootstrap.ListBootstrapPeersResponse
+	(*BootstrapPeer)(nil),                           // 22: strims.network.v1.bootstrap.BootstrapPeer
+	(*BootstrapServiceMessage)(nil),                 // 23: strims.network.v1.bootstrap.BootstrapServiceMessage
+	(*PublishNetworkToBootstrapPeerRequest)(nil),    // 24: strims.network.v1.bootstrap.PublishNetworkToBootstrapPeerRequest
+	(*PublishNetworkToBootstrapPeerResponse)(nil),   // 25: strims.network.v1.bootstrap.PublishNetworkToBootstrapPeerResponse
+	(*BootstrapServiceMessage_BrokerOffer)(nil),     // 26: strims.network.v1.bootstrap.BootstrapServiceMessage.BrokerOffer
+	(*BootstrapServiceMessage_PublishRequest)(nil),  // 27: strims.network.v1.bootstrap.BootstrapServiceMessage.PublishRequest
+	(*BootstrapServiceMessage_PublishResponse)(nil), // 28: strims.network.v1.bootstrap.BootstrapServiceMessage.PublishResponse
+	(*v1.VersionVector)(nil),                        // 29: strims.dao.v1.VersionVector
+	(*certificate.Certificate)(nil),                 // 30: strims.type.Certificate
 }
 var file_network_v1_bootstrap_bootstrap_proto_depIdxs = []int32{
 	0,  // 0: strims.network.v1.bootstrap.GetConfigResponse.config:type_name -> strims.network.v1.bootstrap.Config
 	0,  // 1: strims.network.v1.bootstrap.SetConfigRequest.config:type_name -> strims.network.v1.bootstrap.Config
 	0,  // 2: strims.network.v1.bootstrap.SetConfigResponse.config:type_name -> strims.network.v1.bootstrap.Config
-	26, // 3: strims.network.v1.bootstrap.BootstrapClient.version:type_name -> strims.dao.v1.VersionVector
+	29, // 3: strims.network.v1.bootstrap.BootstrapClient.version:type_name -> strims.dao.v1.VersionVector
 	6,  // 4: strims.network.v1.bootstrap.BootstrapClient.websocket_options:type_name -> strims.network.v1.bootstrap.BootstrapClientWebSocketOptions
-	6,  // 5: strims.network.v1.bootstrap.CreateBootstrapClientRequest.websocket_options:type_name -> strims.network.v1.bootstrap.BootstrapClientWebSocketOptions
-	5,  // 6: strims.network.v1.bootstrap.CreateBootstrapClientResponse.bootstrap_client:type_name -> strims.network.v1.bootstrap.BootstrapClient
-	6,  // 7: strims.network.v1.bootstrap.UpdateBootstrapClientRequest.websocket_options:type_name -> strims.network.v1.bootstrap.BootstrapClientWebSocketOptions
-	5,  // 8: strims.network.v1.bootstrap.UpdateBootstrapClientResponse.bootstrap_client:type_name -> strims.network.v1.bootstrap.BootstrapClient
-	5,  // 9: strims.network.v1.bootstrap.GetBootstrapClientResponse.bootstrap_client:type_name -> strims.network.v1.bootstrap.BootstrapClient
-	5,  // 10: strims.network.v1.bootstrap.ListBootstrapClientsResponse.bootstrap_clients:type_name -> strims.network.v1.bootstrap.BootstrapClient
-	19, // 11: strims.network.v1.bootstrap.ListBootstrapPeersResponse.peers:type_name -> strims.network.v1.bootstrap.BootstrapPeer
-	23, // 12: strims.network.v1.bootstrap.BootstrapServiceMessage.broker_offer:type_name -> strims.network.v1.bootstrap.BootstrapServiceMessage.BrokerOffer
-	24, // 13: strims.network.v1.bootstrap.BootstrapServiceMessage.publish_request:type_name -> strims.network.v1.bootstrap.BootstrapServiceMessage.PublishRequest
-	25, // 14: strims.network.v1.bootstrap.BootstrapServiceMessage.publish_response:type_name -> strims.network.v1.bootstrap.BootstrapServiceMessage.PublishResponse
-	27, // 15: strims.network.v1.bootstrap.BootstrapServiceMessage.PublishRequest.certificate:type_name -> strims.type.Certificate
-	1,  // 16: strims.network.v1.bootstrap.BootstrapFrontend.GetConfig:input_type -> strims.network.v1.bootstrap.GetConfigRequest
-	3,  // 17: strims.network.v1.bootstrap.BootstrapFrontend.SetConfig:input_type -> strims.network.v1.bootstrap.SetConfigRequest
-	7,  // 18: strims.network.v1.bootstrap.BootstrapFrontend.CreateClient:input_type -> strims.network.v1.bootstrap.CreateBootstrapClientRequest
-	9,  // 19: strims.network.v1.bootstrap.BootstrapFrontend.UpdateClient:input_type -> strims.network.v1.bootstrap.UpdateBootstrapClientRequest
-	11, // 20: strims.network.v1.bootstrap.BootstrapFrontend.DeleteClient:input_type -> strims.network.v1.bootstrap.DeleteBootstrapClientRequest
-	13, // 21: strims.network.v1.bootstrap.BootstrapFrontend.GetClient:input_type -> strims.network.v1.bootstrap.GetBootstrapClientRequest
-	15, // 22: strims.network.v1.bootstrap.BootstrapFrontend.ListClients:input_type -> strims.network.v1.bootstrap.ListBootstrapClientsRequest
-	17, // 23: strims.network.v1.bootstrap.BootstrapFrontend.ListPeers:input_type -> strims.network.v1.bootstrap.ListBootstrapPeersRequest
-	21, // 24: strims.network.v1.bootstrap.BootstrapFrontend.PublishNetworkToPeer:input_type -> strims.network.v1.bootstrap.PublishNetworkToBootstrapPeerRequest
-	2,  // 25: strims.network.v1.bootstrap.BootstrapFrontend.GetConfig:output_type -> strims.network.v1.bootstrap.GetConfigResponse
-	4,  // 26: strims.network.v1.bootstrap.BootstrapFrontend.SetConfig:output_type -> strims.network.v1.bootstrap.SetConfigResponse
-	8,  // 27: strims.network.v1.bootstrap.BootstrapFrontend.CreateClient:output_type -> strims.network.v1.bootstrap.CreateBootstrapClientResponse
-	10, // 28: strims.network.v1.bootstrap.BootstrapFrontend.UpdateClient:output_type -> strims.network.v1.bootstrap.UpdateBootstrapClientResponse
-	12, // 29: strims.network.v1.bootstrap.BootstrapFrontend.DeleteClient:output_type -> strims.network.v1.bootstrap.DeleteBootstrapClientResponse
-	14, // 30: strims.network.v1.bootstrap.BootstrapFrontend.GetClient:output_type -> strims.network.v1.bootstrap.GetBootstrapClientResponse
-	16, // 31: strims.network.v1.bootstrap.BootstrapFrontend.ListClients:output_type -> strims.network.v1.bootstrap.ListBootstrapClientsResponse
-	18, // 32: strims.network.v1.bootstrap.BootstrapFrontend.ListPeers:output_type -> strims.network.v1.bootstrap.ListBootstrapPeersResponse
-	22, // 33: strims.network.v1.bootstrap.BootstrapFrontend.PublishNetworkToPeer:output_type -> strims.network.v1.bootstrap.PublishNetworkToBootstrapPeerResponse
-	25, // [25:34] is the sub-list for method output_type
-	16, // [16:25] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	7,  // 5: strims.network.v1.bootstrap.BootstrapClient.tcp_options:type_name -> strims.network.v1.bootstrap.BootstrapClientTCPOptions
+	9,  // 6: strims.network.v1.bootstrap.BootstrapClient.dns_options:type_name -> strims.network.v1.bootstrap.BootstrapClientDNSOptions
+	8,  // 7: strims.network.v1.bootstrap.BootstrapClient.udp_options:type_name -> strims.network.v1.bootstrap.BootstrapClientUDPOptions
+	6,  // 8: strims.network.v1.bootstrap.CreateBootstrapClientRequest.websocket_options:type_name -> strims.network.v1.bootstrap.BootstrapClientWebSocketOptions
+	7,  // 9: strims.network.v1.bootstrap.CreateBootstrapClientRequest.tcp_options:type_name -> strims.network.v1.bootstrap.BootstrapClientTCPOptions
+	9,  // 10: strims.network.v1.bootstrap.CreateBootstrapClientRequest.dns_options:type_name -> strims.network.v1.bootstrap.BootstrapClientDNSOptions
+	8,  // 11: strims.network.v1.bootstrap.CreateBootstrapClientRequest.udp_options:type_name -> strims.network.v1.bootstrap.BootstrapClientUDPOptions
+	5,  // 12: strims.network.v1.bootstrap.CreateBootstrapClientResponse.bootstrap_client:type_name -> strims.network.v1.bootstrap.BootstrapClient
+	6,  // 13: strims.network.v1.bootstrap.UpdateBootstrapClientRequest.websocket_options:type_name -> strims.network.v1.bootstrap.BootstrapClientWebSocketOptions
+	7,  // 14: strims.network.v1.bootstrap.UpdateBootstrapClientRequest.tcp_options:type_name -> strims.network.v1.bootstrap.BootstrapClientTCPOptions
+	9,  // 15: strims.network.v1.bootstrap.UpdateBootstrapClientRequest.dns_options:type_name -> strims.network.v1.bootstrap.BootstrapClientDNSOptions
+	8,  // 16: strims.network.v1.bootstrap.UpdateBootstrapClientRequest.udp_options:type_name -> strims.network.v1.bootstrap.BootstrapClientUDPOptions
+	5,  // 17: strims.network.v1.bootstrap.UpdateBootstrapClientResponse.bootstrap_client:type_name -> strims.network.v1.bootstrap.BootstrapClient
+	5,  // 18: strims.network.v1.bootstrap.GetBootstrapClientResponse.bootstrap_client:type_name -> strims.network.v1.bootstrap.BootstrapClient
+	5,  // 19: strims.network.v1.bootstrap.ListBootstrapClientsResponse.bootstrap_clients:type_name -> strims.network.v1.bootstrap.BootstrapClient
+	22, // 20: strims.network.v1.bootstrap.ListBootstrapPeersResponse.peers:type_name -> strims.network.v1.bootstrap.BootstrapPeer
+	26, // 21: strims.network.v1.bootstrap.BootstrapServiceMessage.broker_offer:type_name -> strims.network.v1.bootstrap.BootstrapServiceMessage.BrokerOffer
+	27, // 22: strims.network.v1.bootstrap.BootstrapServiceMessage.publish_request:type_name -> strims.network.v1.bootstrap.BootstrapServiceMessage.PublishRequest
+	28, // 23: strims.network.v1.bootstrap.BootstrapServiceMessage.publish_response:type_name -> strims.network.v1.bootstrap.BootstrapServiceMessage.PublishResponse
+	30, // 24: strims.network.v1.bootstrap.BootstrapServiceMessage.PublishRequest.certificate:type_name -> strims.type.Certificate
+	1,  // 25: strims.network.v1.bootstrap.BootstrapFrontend.GetConfig:input_type -> strims.network.v1.bootstrap.GetConfigRequest
+	3,  // 26: strims.network.v1.bootstrap.BootstrapFrontend.SetConfig:input_type -> strims.network.v1.bootstrap.SetConfigRequest
+	10, // 27: strims.network.v1.bootstrap.BootstrapFrontend.CreateClient:input_type -> strims.network.v1.bootstrap.CreateBootstrapClientRequest
+	12, // 28: strims.network.v1.bootstrap.BootstrapFrontend.UpdateClient:input_type -> strims.network.v1.bootstrap.UpdateBootstrapClientRequest
+	14, // 29: strims.network.v1.bootstrap.BootstrapFrontend.DeleteClient:input_type -> strims.network.v1.bootstrap.DeleteBootstrapClientRequest
+	16, // 30: strims.network.v1.bootstrap.BootstrapFrontend.GetClient:input_type -> strims.network.v1.bootstrap.GetBootstrapClientRequest
+	18, // 31: strims.network.v1.bootstrap.BootstrapFrontend.ListClients:input_type -> strims.network.v1.bootstrap.ListBootstrapClientsRequest
+	20, // 32: strims.network.v1.bootstrap.BootstrapFrontend.ListPeers:input_type -> strims.network.v1.bootstrap.ListBootstrapPeersRequest
+	24, // 33: strims.network.v1.bootstrap.BootstrapFrontend.PublishNetworkToPeer:input_type -> strims.network.v1.bootstrap.PublishNetworkToBootstrapPeerRequest
+	2,  // 34: strims.network.v1.bootstrap.BootstrapFrontend.GetConfig:output_type -> strims.network.v1.bootstrap.GetConfigResponse
+	4,  // 35: strims.network.v1.bootstrap.BootstrapFrontend.SetConfig:output_type -> strims.network.v1.bootstrap.SetConfigResponse
+	11, // 36: strims.network.v1.bootstrap.BootstrapFrontend.CreateClient:output_type -> strims.network.v1.bootstrap.CreateBootstrapClientResponse
+	13, // 37: strims.network.v1.bootstrap.BootstrapFrontend.UpdateClient:output_type -> strims.network.v1.bootstrap.UpdateBootstrapClientResponse
+	15, // 38: strims.network.v1.bootstrap.BootstrapFrontend.DeleteClient:output_type -> strims.network.v1.bootstrap.DeleteBootstrapClientResponse
+	17, // 39: strims.network.v1.bootstrap.BootstrapFrontend.GetClient:output_type -> strims.network.v1.bootstrap.GetBootstrapClientResponse
+	19, // 40: strims.network.v1.bootstrap.BootstrapFrontend.ListClients:output_type -> strims.network.v1.bootstrap.ListBootstrapClientsResponse
+	21, // 41: strims.network.v1.bootstrap.BootstrapFrontend.ListPeers:output_type -> strims.network.v1.bootstrap.ListBootstrapPeersResponse
+	25, // 42: strims.network.v1.bootstrap.BootstrapFrontend.PublishNetworkToPeer:output_type -> strims.network.v1.bootstrap.PublishNetworkToBootstrapPeerResponse
+	34, // [34:43] is the sub-list for method output_type
+	25, // [25:34] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_network_v1_bootstrap_bootstrap_proto_init() }
@@ -1800,7 +2156,7 @@ func file_network_v1_bootstrap_bootstrap_proto_init() {
 			}
 		}
 		file_network_v1_bootstrap_bootstrap_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBootstrapClientRequest); i {
+			switch v := v.(*BootstrapClientTCPOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1812,7 +2168,7 @@ func file_network_v1_bootstrap_bootstrap_proto_init() {
 			}
 		}
 		file_network_v1_bootstrap_bootstrap_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBootstrapClientResponse); i {
+			switch v := v.(*BootstrapClientUDPOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1824,7 +2180,7 @@ func file_network_v1_bootstrap_bootstrap_proto_init() {
 			}
 		}
 		file_network_v1_bootstrap_bootstrap_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateBootstrapClientRequest); i {
+			switch v := v.(*BootstrapClientDNSOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1836,7 +2192,7 @@ func file_network_v1_bootstrap_bootstrap_proto_init() {
 			}
 		}
 		file_network_v1_bootstrap_bootstrap_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateBootstrapClientResponse); i {
+			switch v := v.(*CreateBootstrapClientRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1848,7 +2204,7 @@ func file_network_v1_bootstrap_bootstrap_proto_init() {
 			}
 		}
 		file_network_v1_bootstrap_bootstrap_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteBootstrapClientRequest); i {
+			switch v := v.(*CreateBootstrapClientResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1860,7 +2216,7 @@ func file_network_v1_bootstrap_bootstrap_proto_init() {
 			}
 		}
 		file_network_v1_bootstrap_bootstrap_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteBootstrapClientResponse); i {
+			switch v := v.(*UpdateBootstrapClientRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1872,7 +2228,7 @@ func file_network_v1_bootstrap_bootstrap_proto_init() {
 			}
 		}
 		file_network_v1_bootstrap_bootstrap_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBootstrapClientRequest); i {
+			switch v := v.(*UpdateBootstrapClientResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1884,7 +2240,7 @@ func file_network_v1_bootstrap_bootstrap_proto_init() {
 			}
 		}
 		file_network_v1_bootstrap_bootstrap_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBootstrapClientResponse); i {
+			switch v := v.(*DeleteBootstrapClientRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1896,7 +2252,7 @@ func file_network_v1_bootstrap_bootstrap_proto_init() {
 			}
 		}
 		file_network_v1_bootstrap_bootstrap_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBootstrapClientsRequest); i {
+			switch v := v.(*DeleteBootstrapClientResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1908,7 +2264,7 @@ func file_network_v1_bootstrap_bootstrap_proto_init() {
 			}
 		}
 		file_network_v1_bootstrap_bootstrap_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBootstrapClientsResponse); i {
+			switch v := v.(*GetBootstrapClientRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1920,7 +2276,7 @@ func file_network_v1_bootstrap_bootstrap_proto_init() {
 			}
 		}
 		file_network_v1_bootstrap_bootstrap_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBootstrapPeersRequest); i {
+			switch v := v.(*GetBootstrapClientResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1932,7 +2288,7 @@ func file_network_v1_bootstrap_bootstrap_proto_init() {
 			}
 		}
 		file_network_v1_bootstrap_bootstrap_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBootstrapPeersResponse); i {
+			switch v := v.(*ListBootstrapClientsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1944,7 +2300,7 @@ func file_network_v1_bootstrap_bootstrap_proto_init() {
 			}
 		}
 		file_network_v1_bootstrap_bootstrap_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BootstrapPeer); i {
+			switch v := v.(*ListBootstrapClientsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1956,7 +2312,7 @@ func file_network_v1_bootstrap_bootstrap_proto_init() {
 			}
 		}
 		file_network_v1_bootstrap_bootstrap_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BootstrapServiceMessage); i {
+			switch v := v.(*ListBootstrapPeersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1968,7 +2324,7 @@ func file_network_v1_bootstrap_bootstrap_proto_init() {
 			}
 		}
 		file_network_v1_bootstrap_bootstrap_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublishNetworkToBootstrapPeerRequest); i {
+			switch v := v.(*ListBootstrapPeersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1980,7 +2336,7 @@ func file_network_v1_bootstrap_bootstrap_proto_init() {
 			}
 		}
 		file_network_v1_bootstrap_bootstrap_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublishNetworkToBootstrapPeerResponse); i {
+			switch v := v.(*BootstrapPeer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1992,7 +2348,7 @@ func file_network_v1_bootstrap_bootstrap_proto_init() {
 			}
 		}
 		file_network_v1_bootstrap_bootstrap_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BootstrapServiceMessage_BrokerOffer); i {
+			switch v := v.(*BootstrapServiceMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2004,7 +2360,7 @@ func file_network_v1_bootstrap_bootstrap_proto_init() {
 			}
 		}
 		file_network_v1_bootstrap_bootstrap_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BootstrapServiceMessage_PublishRequest); i {
+			switch v := v.(*PublishNetworkToBootstrapPeerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2016,6 +2372,42 @@ func file_network_v1_bootstrap_bootstrap_proto_init() {
 			}
 		}
 		file_network_v1_bootstrap_bootstrap_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishNetworkToBootstrapPeerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_bootstrap_bootstrap_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BootstrapServiceMessage_BrokerOffer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_bootstrap_bootstrap_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BootstrapServiceMessage_PublishRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_bootstrap_bootstrap_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BootstrapServiceMessage_PublishResponse); i {
 			case 0:
 				return &v.state
@@ -2030,19 +2422,28 @@ func file_network_v1_bootstrap_bootstrap_proto_init() {
 	}
 	file_network_v1_bootstrap_bootstrap_proto_msgTypes[5].OneofWrappers = []interface{}{
 		(*BootstrapClient_WebsocketOptions)(nil),
+		(*BootstrapClient_TcpOptions)(nil),
+		(*BootstrapClient_DnsOptions)(nil),
+		(*BootstrapClient_UdpOptions)(nil),
 	}
-	file_network_v1_bootstrap_bootstrap_proto_msgTypes[7].OneofWrappers = []interface{}{
+	file_network_v1_bootstrap_bootstrap_proto_msgTypes[10].OneofWrappers = []interface{}{
 		(*CreateBootstrapClientRequest_WebsocketOptions)(nil),
+		(*CreateBootstrapClientRequest_TcpOptions)(nil),
+		(*CreateBootstrapClientRequest_DnsOptions)(nil),
+		(*CreateBootstrapClientRequest_UdpOptions)(nil),
 	}
-	file_network_v1_bootstrap_bootstrap_proto_msgTypes[9].OneofWrappers = []interface{}{
+	file_network_v1_bootstrap_bootstrap_proto_msgTypes[12].OneofWrappers = []interface{}{
 		(*UpdateBootstrapClientRequest_WebsocketOptions)(nil),
+		(*UpdateBootstrapClientRequest_TcpOptions)(nil),
+		(*UpdateBootstrapClientRequest_DnsOptions)(nil),
+		(*UpdateBootstrapClientRequest_UdpOptions)(nil),
 	}
-	file_network_v1_bootstrap_bootstrap_proto_msgTypes[20].OneofWrappers = []interface{}{
+	file_network_v1_bootstrap_bootstrap_proto_msgTypes[23].OneofWrappers = []interface{}{
 		(*BootstrapServiceMessage_BrokerOffer_)(nil),
 		(*BootstrapServiceMessage_PublishRequest_)(nil),
 		(*BootstrapServiceMessage_PublishResponse_)(nil),
 	}
-	file_network_v1_bootstrap_bootstrap_proto_msgTypes[25].OneofWrappers = []interface{}{
+	file_network_v1_bootstrap_bootstrap_proto_msgTypes[28].OneofWrappers = []interface{}{
 		(*BootstrapServiceMessage_PublishResponse_Error)(nil),
 	}
 	type x struct{}
@@ -2051,7 +2452,7 @@ func file_network_v1_bootstrap_bootstrap_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_network_v1_bootstrap_bootstrap_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   26,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -80,6 +80,55 @@ func (ListingContentType) EnumDescriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{0}
 }
 
+type ListingSortOrder int32
+
+const (
+	ListingSortOrder_LISTING_SORT_ORDER_UNDEFINED ListingSortOrder = 0
+	ListingSortOrder_LISTING_SORT_ORDER_VIEWERS   ListingSortOrder = 1
+	ListingSortOrder_LISTING_SORT_ORDER_RECENT    ListingSortOrder = 2
+)
+
+// Enum value maps for ListingSortOrder.
+var (
+	ListingSortOrder_name = map[int32]string{
+		0: "LISTING_SORT_ORDER_UNDEFINED",
+		1: "LISTING_SORT_ORDER_VIEWERS",
+		2: "LISTING_SORT_ORDER_RECENT",
+	}
+	ListingSortOrder_value = map[string]int32{
+		"LISTING_SORT_ORDER_UNDEFINED": 0,
+		"LISTING_SORT_ORDER_VIEWERS":   1,
+		"LISTING_SORT_ORDER_RECENT":    2,
+	}
+)
+
+func (x ListingSortOrder) Enum() *ListingSortOrder {
+	p := new(ListingSortOrder)
+	*p = x
+	return p
+}
+
+func (x ListingSortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListingSortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_network_v1_directory_directory_proto_enumTypes[1].Descriptor()
+}
+
+func (ListingSortOrder) Type() protoreflect.EnumType {
+	return &file_network_v1_directory_directory_proto_enumTypes[1]
+}
+
+func (x ListingSortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListingSortOrder.Descriptor instead.
+func (ListingSortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{1}
+}
+
 type Listing_Embed_Service int32
 
 const (
@@ -88,6 +137,9 @@ const (
 	Listing_Embed_DIRECTORY_LISTING_EMBED_SERVICE_TWITCH_STREAM Listing_Embed_Service = 2
 	Listing_Embed_DIRECTORY_LISTING_EMBED_SERVICE_TWITCH_VOD    Listing_Embed_Service = 3
 	Listing_Embed_DIRECTORY_LISTING_EMBED_SERVICE_YOUTUBE       Listing_Embed_Service = 4
+	Listing_Embed_DIRECTORY_LISTING_EMBED_SERVICE_KICK          Listing_Embed_Service = 5
+	Listing_Embed_DIRECTORY_LISTING_EMBED_SERVICE_VIMEO         Listing_Embed_Service = 6
+	Listing_Embed_DIRECTORY_LISTING_EMBED_SERVICE_OEMBED        Listing_Embed_Service = 7
 )
 
 // Enum value maps for Listing_Embed_Service.
@@ -98,6 +150,9 @@ var (
 		2: "DIRECTORY_LISTING_EMBED_SERVICE_TWITCH_STREAM",
 		3: "DIRECTORY_LISTING_EMBED_SERVICE_TWITCH_VOD",
 		4: "DIRECTORY_LISTING_EMBED_SERVICE_YOUTUBE",
+		5: "DIRECTORY_LISTING_EMBED_SERVICE_KICK",
+		6: "DIRECTORY_LISTING_EMBED_SERVICE_VIMEO",
+		7: "DIRECTORY_LISTING_EMBED_SERVICE_OEMBED",
 	}
 	Listing_Embed_Service_value = map[string]int32{
 		"DIRECTORY_LISTING_EMBED_SERVICE_UNDEFINED":     0,
@@ -105,6 +160,9 @@ var (
 		"DIRECTORY_LISTING_EMBED_SERVICE_TWITCH_STREAM": 2,
 		"DIRECTORY_LISTING_EMBED_SERVICE_TWITCH_VOD":    3,
 		"DIRECTORY_LISTING_EMBED_SERVICE_YOUTUBE":       4,
+		"DIRECTORY_LISTING_EMBED_SERVICE_KICK":          5,
+		"DIRECTORY_LISTING_EMBED_SERVICE_VIMEO":         6,
+		"DIRECTORY_LISTING_EMBED_SERVICE_OEMBED":        7,
 	}
 )
 
@@ -119,11 +177,11 @@ func (x Listing_Embed_Service) String() string {
 }
 
 func (Listing_Embed_Service) Descriptor() protoreflect.EnumDescriptor {
-	return file_network_v1_directory_directory_proto_enumTypes[1].Descriptor()
+	return file_network_v1_directory_directory_proto_enumTypes[2].Descriptor()
 }
 
 func (Listing_Embed_Service) Type() protoreflect.EnumType {
-	return &file_network_v1_directory_directory_proto_enumTypes[1]
+	return &file_network_v1_directory_directory_proto_enumTypes[2]
 }
 
 func (x Listing_Embed_Service) Number() protoreflect.EnumNumber {
@@ -168,11 +226,11 @@ func (x FrontendWatchListingUsersResponse_UserEventType) String() string {
 }
 
 func (FrontendWatchListingUsersResponse_UserEventType) Descriptor() protoreflect.EnumDescriptor {
-	return file_network_v1_directory_directory_proto_enumTypes[2].Descriptor()
+	return file_network_v1_directory_directory_proto_enumTypes[3].Descriptor()
 }
 
 func (FrontendWatchListingUsersResponse_UserEventType) Type() protoreflect.EnumType {
-	return &file_network_v1_directory_directory_proto_enumTypes[2]
+	return &file_network_v1_directory_directory_proto_enumTypes[3]
 }
 
 func (x FrontendWatchListingUsersResponse_UserEventType) Number() protoreflect.EnumNumber {
@@ -181,7 +239,7 @@ func (x FrontendWatchListingUsersResponse_UserEventType) Number() protoreflect.E
 
 // Deprecated: Use FrontendWatchListingUsersResponse_UserEventType.Descriptor instead.
 func (FrontendWatchListingUsersResponse_UserEventType) EnumDescriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{57, 0}
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{83, 0}
 }
 
 type ServerConfig struct {
@@ -199,6 +257,7 @@ type ServerConfig struct {
 	MaxPingInterval       uint32                     `protobuf:"varint,8,opt,name=max_ping_interval,json=maxPingInterval,proto3" json:"max_ping_interval,omitempty"`
 	EmbedLoadInterval     uint32                     `protobuf:"varint,9,opt,name=embed_load_interval,json=embedLoadInterval,proto3" json:"embed_load_interval,omitempty"`
 	LoadMediaEmbedTimeout uint32                     `protobuf:"varint,10,opt,name=load_media_embed_timeout,json=loadMediaEmbedTimeout,proto3" json:"load_media_embed_timeout,omitempty"`
+	Federations           []*ServerConfig_Federation `protobuf:"bytes,11,rep,name=federations,proto3" json:"federations,omitempty"`
 }
 
 func (x *ServerConfig) Reset() {
@@ -303,6 +362,13 @@ func (x *ServerConfig) GetLoadMediaEmbedTimeout() uint32 {
 	return 0
 }
 
+func (x *ServerConfig) GetFederations() []*ServerConfig_Federation {
+	if x != nil {
+		return x.Federations
+	}
+	return nil
+}
+
 type ClientConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -869,6 +935,69 @@ func (x *ListingSnippet) GetSignature() []byte {
 	return nil
 }
 
+type ListingProvenance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetworkKey  []byte `protobuf:"bytes,1,opt,name=network_key,json=networkKey,proto3" json:"network_key,omitempty"`
+	NetworkName string `protobuf:"bytes,2,opt,name=network_name,json=networkName,proto3" json:"network_name,omitempty"`
+	ListingId   uint64 `protobuf:"varint,3,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+}
+
+func (x *ListingProvenance) Reset() {
+	*x = ListingProvenance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListingProvenance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListingProvenance) ProtoMessage() {}
+
+func (x *ListingProvenance) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListingProvenance.ProtoReflect.Descriptor instead.
+func (*ListingProvenance) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListingProvenance) GetNetworkKey() []byte {
+	if x != nil {
+		return x.NetworkKey
+	}
+	return nil
+}
+
+func (x *ListingProvenance) GetNetworkName() string {
+	if x != nil {
+		return x.NetworkName
+	}
+	return ""
+}
+
+func (x *ListingProvenance) GetListingId() uint64 {
+	if x != nil {
+		return x.ListingId
+	}
+	return 0
+}
+
 type ListingSnippetDelta struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -901,7 +1030,7 @@ type ListingSnippetDelta struct {
 func (x *ListingSnippetDelta) Reset() {
 	*x = ListingSnippetDelta{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_directory_directory_proto_msgTypes[8]
+		mi := &file_network_v1_directory_directory_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -914,7 +1043,7 @@ func (x *ListingSnippetDelta) String() string {
 func (*ListingSnippetDelta) ProtoMessage() {}
 
 func (x *ListingSnippetDelta) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_directory_directory_proto_msgTypes[8]
+	mi := &file_network_v1_directory_directory_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -927,7 +1056,7 @@ func (x *ListingSnippetDelta) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListingSnippetDelta.ProtoReflect.Descriptor instead.
 func (*ListingSnippetDelta) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{8}
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListingSnippetDelta) GetTitle() *wrapperspb.StringValue {
@@ -1110,7 +1239,7 @@ type Event struct {
 func (x *Event) Reset() {
 	*x = Event{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_directory_directory_proto_msgTypes[9]
+		mi := &file_network_v1_directory_directory_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1123,7 +1252,7 @@ func (x *Event) String() string {
 func (*Event) ProtoMessage() {}
 
 func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_directory_directory_proto_msgTypes[9]
+	mi := &file_network_v1_directory_directory_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1136,7 +1265,7 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Event.ProtoReflect.Descriptor instead.
 func (*Event) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{9}
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{10}
 }
 
 func (m *Event) GetBody() isEvent_Body {
@@ -1228,7 +1357,7 @@ type ListingModeration struct {
 func (x *ListingModeration) Reset() {
 	*x = ListingModeration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_directory_directory_proto_msgTypes[10]
+		mi := &file_network_v1_directory_directory_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1241,7 +1370,7 @@ func (x *ListingModeration) String() string {
 func (*ListingModeration) ProtoMessage() {}
 
 func (x *ListingModeration) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_directory_directory_proto_msgTypes[10]
+	mi := &file_network_v1_directory_directory_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1254,7 +1383,7 @@ func (x *ListingModeration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListingModeration.ProtoReflect.Descriptor instead.
 func (*ListingModeration) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{10}
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListingModeration) GetIsMature() *wrapperspb.BoolValue {
@@ -1292,7 +1421,7 @@ type ListingQuery struct {
 func (x *ListingQuery) Reset() {
 	*x = ListingQuery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_directory_directory_proto_msgTypes[11]
+		mi := &file_network_v1_directory_directory_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1305,7 +1434,7 @@ func (x *ListingQuery) String() string {
 func (*ListingQuery) ProtoMessage() {}
 
 func (x *ListingQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_directory_directory_proto_msgTypes[11]
+	mi := &file_network_v1_directory_directory_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1318,7 +1447,7 @@ func (x *ListingQuery) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListingQuery.ProtoReflect.Descriptor instead.
 func (*ListingQuery) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{11}
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{12}
 }
 
 func (m *ListingQuery) GetQuery() isListingQuery_Query {
@@ -1373,7 +1502,7 @@ type ListingRecord struct {
 func (x *ListingRecord) Reset() {
 	*x = ListingRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_directory_directory_proto_msgTypes[12]
+		mi := &file_network_v1_directory_directory_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1386,7 +1515,7 @@ func (x *ListingRecord) String() string {
 func (*ListingRecord) ProtoMessage() {}
 
 func (x *ListingRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_directory_directory_proto_msgTypes[12]
+	mi := &file_network_v1_directory_directory_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1399,7 +1528,7 @@ func (x *ListingRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListingRecord.ProtoReflect.Descriptor instead.
 func (*ListingRecord) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{12}
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListingRecord) GetId() uint64 {
@@ -1437,34 +1566,36 @@ func (x *ListingRecord) GetNotes() string {
 	return ""
 }
 
-type UserModeration struct {
+type ScheduledListingRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DisableJoin    *wrapperspb.BoolValue `protobuf:"bytes,1,opt,name=disable_join,json=disableJoin,proto3" json:"disable_join,omitempty"`
-	DisablePublish *wrapperspb.BoolValue `protobuf:"bytes,2,opt,name=disable_publish,json=disablePublish,proto3" json:"disable_publish,omitempty"`
-	IsModerator    *wrapperspb.BoolValue `protobuf:"bytes,3,opt,name=is_moderator,json=isModerator,proto3" json:"is_moderator,omitempty"`
-	IsAdmin        *wrapperspb.BoolValue `protobuf:"bytes,4,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	Id           uint64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	NetworkId    uint64          `protobuf:"varint,2,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	Listing      *Listing        `protobuf:"bytes,3,opt,name=listing,proto3" json:"listing,omitempty"`
+	Snippet      *ListingSnippet `protobuf:"bytes,4,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	PublisherKey []byte          `protobuf:"bytes,5,opt,name=publisher_key,json=publisherKey,proto3" json:"publisher_key,omitempty"`
+	StartTime    int64           `protobuf:"varint,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
 }
 
-func (x *UserModeration) Reset() {
-	*x = UserModeration{}
+func (x *ScheduledListingRecord) Reset() {
+	*x = ScheduledListingRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_directory_directory_proto_msgTypes[13]
+		mi := &file_network_v1_directory_directory_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserModeration) String() string {
+func (x *ScheduledListingRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserModeration) ProtoMessage() {}
+func (*ScheduledListingRecord) ProtoMessage() {}
 
-func (x *UserModeration) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_directory_directory_proto_msgTypes[13]
+func (x *ScheduledListingRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1475,67 +1606,81 @@ func (x *UserModeration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserModeration.ProtoReflect.Descriptor instead.
-func (*UserModeration) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ScheduledListingRecord.ProtoReflect.Descriptor instead.
+func (*ScheduledListingRecord) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *UserModeration) GetDisableJoin() *wrapperspb.BoolValue {
+func (x *ScheduledListingRecord) GetId() uint64 {
 	if x != nil {
-		return x.DisableJoin
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *UserModeration) GetDisablePublish() *wrapperspb.BoolValue {
+func (x *ScheduledListingRecord) GetNetworkId() uint64 {
 	if x != nil {
-		return x.DisablePublish
+		return x.NetworkId
+	}
+	return 0
+}
+
+func (x *ScheduledListingRecord) GetListing() *Listing {
+	if x != nil {
+		return x.Listing
 	}
 	return nil
 }
 
-func (x *UserModeration) GetIsModerator() *wrapperspb.BoolValue {
+func (x *ScheduledListingRecord) GetSnippet() *ListingSnippet {
 	if x != nil {
-		return x.IsModerator
+		return x.Snippet
 	}
 	return nil
 }
 
-func (x *UserModeration) GetIsAdmin() *wrapperspb.BoolValue {
+func (x *ScheduledListingRecord) GetPublisherKey() []byte {
 	if x != nil {
-		return x.IsAdmin
+		return x.PublisherKey
 	}
 	return nil
 }
 
-type UserRecord struct {
+func (x *ScheduledListingRecord) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+type ListingSubscription struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         uint64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	NetworkId  uint64          `protobuf:"varint,2,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
-	PeerKey    []byte          `protobuf:"bytes,3,opt,name=peer_key,json=peerKey,proto3" json:"peer_key,omitempty"`
-	Moderation *UserModeration `protobuf:"bytes,4,opt,name=moderation,proto3" json:"moderation,omitempty"`
+	Id        uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	NetworkId uint64   `protobuf:"varint,2,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	Listing   *Listing `protobuf:"bytes,3,opt,name=listing,proto3" json:"listing,omitempty"`
+	Title     string   `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
 }
 
-func (x *UserRecord) Reset() {
-	*x = UserRecord{}
+func (x *ListingSubscription) Reset() {
+	*x = ListingSubscription{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_directory_directory_proto_msgTypes[14]
+		mi := &file_network_v1_directory_directory_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserRecord) String() string {
+func (x *ListingSubscription) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserRecord) ProtoMessage() {}
+func (*ListingSubscription) ProtoMessage() {}
 
-func (x *UserRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_directory_directory_proto_msgTypes[14]
+func (x *ListingSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1546,64 +1691,72 @@ func (x *UserRecord) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserRecord.ProtoReflect.Descriptor instead.
-func (*UserRecord) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ListingSubscription.ProtoReflect.Descriptor instead.
+func (*ListingSubscription) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *UserRecord) GetId() uint64 {
+func (x *ListingSubscription) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *UserRecord) GetNetworkId() uint64 {
+func (x *ListingSubscription) GetNetworkId() uint64 {
 	if x != nil {
 		return x.NetworkId
 	}
 	return 0
 }
 
-func (x *UserRecord) GetPeerKey() []byte {
+func (x *ListingSubscription) GetListing() *Listing {
 	if x != nil {
-		return x.PeerKey
+		return x.Listing
 	}
 	return nil
 }
 
-func (x *UserRecord) GetModeration() *UserModeration {
+func (x *ListingSubscription) GetTitle() string {
 	if x != nil {
-		return x.Moderation
+		return x.Title
 	}
-	return nil
+	return ""
 }
 
-type EventBroadcast struct {
+type ListingSessionRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Events []*Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Id            uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	NetworkId     uint64   `protobuf:"varint,2,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	Listing       *Listing `protobuf:"bytes,3,opt,name=listing,proto3" json:"listing,omitempty"`
+	PublisherKey  []byte   `protobuf:"bytes,4,opt,name=publisher_key,json=publisherKey,proto3" json:"publisher_key,omitempty"`
+	StartTime     int64    `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       int64    `protobuf:"varint,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	PeakViewers   uint32   `protobuf:"varint,7,opt,name=peak_viewers,json=peakViewers,proto3" json:"peak_viewers,omitempty"`
+	ViewerSeconds uint64   `protobuf:"varint,8,opt,name=viewer_seconds,json=viewerSeconds,proto3" json:"viewer_seconds,omitempty"`
+	ViewerSketch  []byte   `protobuf:"bytes,9,opt,name=viewer_sketch,json=viewerSketch,proto3" json:"viewer_sketch,omitempty"`
 }
 
-func (x *EventBroadcast) Reset() {
-	*x = EventBroadcast{}
+func (x *ListingSessionRecord) Reset() {
+	*x = ListingSessionRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_directory_directory_proto_msgTypes[15]
+		mi := &file_network_v1_directory_directory_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventBroadcast) String() string {
+func (x *ListingSessionRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventBroadcast) ProtoMessage() {}
+func (*ListingSessionRecord) ProtoMessage() {}
 
-func (x *EventBroadcast) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_directory_directory_proto_msgTypes[15]
+func (x *ListingSessionRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1614,83 +1767,89 @@ func (x *EventBroadcast) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventBroadcast.ProtoReflect.Descriptor instead.
-func (*EventBroadcast) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ListingSessionRecord.ProtoReflect.Descriptor instead.
+func (*ListingSessionRecord) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *EventBroadcast) GetEvents() []*Event {
+func (x *ListingSessionRecord) GetId() uint64 {
 	if x != nil {
-		return x.Events
+		return x.Id
 	}
-	return nil
-}
-
-type AssetBundle struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Icon      *image.Image  `protobuf:"bytes,1,opt,name=icon,proto3" json:"icon,omitempty"`
-	Directory *ClientConfig `protobuf:"bytes,2,opt,name=directory,proto3" json:"directory,omitempty"`
+	return 0
 }
 
-func (x *AssetBundle) Reset() {
-	*x = AssetBundle{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_directory_directory_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ListingSessionRecord) GetNetworkId() uint64 {
+	if x != nil {
+		return x.NetworkId
 	}
+	return 0
 }
 
-func (x *AssetBundle) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*AssetBundle) ProtoMessage() {}
-
-func (x *AssetBundle) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_directory_directory_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ListingSessionRecord) GetListing() *Listing {
+	if x != nil {
+		return x.Listing
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use AssetBundle.ProtoReflect.Descriptor instead.
-func (*AssetBundle) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{16}
+	return nil
 }
 
-func (x *AssetBundle) GetIcon() *image.Image {
+func (x *ListingSessionRecord) GetPublisherKey() []byte {
 	if x != nil {
-		return x.Icon
+		return x.PublisherKey
 	}
 	return nil
 }
 
-func (x *AssetBundle) GetDirectory() *ClientConfig {
+func (x *ListingSessionRecord) GetStartTime() int64 {
 	if x != nil {
-		return x.Directory
+		return x.StartTime
 	}
-	return nil
+	return 0
 }
 
-type PublishRequest struct {
+func (x *ListingSessionRecord) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *ListingSessionRecord) GetPeakViewers() uint32 {
+	if x != nil {
+		return x.PeakViewers
+	}
+	return 0
+}
+
+func (x *ListingSessionRecord) GetViewerSeconds() uint64 {
+	if x != nil {
+		return x.ViewerSeconds
+	}
+	return 0
+}
+
+func (x *ListingSessionRecord) GetViewerSketch() []byte {
+	if x != nil {
+		return x.ViewerSketch
+	}
+	return nil
+}
+
+type ListingStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Listing *Listing `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing,omitempty"`
+	SessionCount   uint32  `protobuf:"varint,1,opt,name=session_count,json=sessionCount,proto3" json:"session_count,omitempty"`
+	Duration       int64   `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	PeakViewers    uint32  `protobuf:"varint,3,opt,name=peak_viewers,json=peakViewers,proto3" json:"peak_viewers,omitempty"`
+	AverageViewers float64 `protobuf:"fixed64,4,opt,name=average_viewers,json=averageViewers,proto3" json:"average_viewers,omitempty"`
+	UniqueViewers  uint32  `protobuf:"varint,5,opt,name=unique_viewers,json=uniqueViewers,proto3" json:"unique_viewers,omitempty"`
+	ViewerSeconds  uint64  `protobuf:"varint,6,opt,name=viewer_seconds,json=viewerSeconds,proto3" json:"viewer_seconds,omitempty"`
 }
 
-func (x *PublishRequest) Reset() {
-	*x = PublishRequest{}
+func (x *ListingStats) Reset() {
+	*x = ListingStats{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1698,13 +1857,13 @@ func (x *PublishRequest) Reset() {
 	}
 }
 
-func (x *PublishRequest) String() string {
+func (x *ListingStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PublishRequest) ProtoMessage() {}
+func (*ListingStats) ProtoMessage() {}
 
-func (x *PublishRequest) ProtoReflect() protoreflect.Message {
+func (x *ListingStats) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1716,28 +1875,64 @@ func (x *PublishRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PublishRequest.ProtoReflect.Descriptor instead.
-func (*PublishRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListingStats.ProtoReflect.Descriptor instead.
+func (*ListingStats) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *PublishRequest) GetListing() *Listing {
+func (x *ListingStats) GetSessionCount() uint32 {
 	if x != nil {
-		return x.Listing
+		return x.SessionCount
 	}
-	return nil
+	return 0
 }
 
-type PublishResponse struct {
+func (x *ListingStats) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *ListingStats) GetPeakViewers() uint32 {
+	if x != nil {
+		return x.PeakViewers
+	}
+	return 0
+}
+
+func (x *ListingStats) GetAverageViewers() float64 {
+	if x != nil {
+		return x.AverageViewers
+	}
+	return 0
+}
+
+func (x *ListingStats) GetUniqueViewers() uint32 {
+	if x != nil {
+		return x.UniqueViewers
+	}
+	return 0
+}
+
+func (x *ListingStats) GetViewerSeconds() uint64 {
+	if x != nil {
+		return x.ViewerSeconds
+	}
+	return 0
+}
+
+type ListingStatsItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Listing *Listing      `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing,omitempty"`
+	Stats   *ListingStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
 }
 
-func (x *PublishResponse) Reset() {
-	*x = PublishResponse{}
+func (x *ListingStatsItem) Reset() {
+	*x = ListingStatsItem{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1745,13 +1940,13 @@ func (x *PublishResponse) Reset() {
 	}
 }
 
-func (x *PublishResponse) String() string {
+func (x *ListingStatsItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PublishResponse) ProtoMessage() {}
+func (*ListingStatsItem) ProtoMessage() {}
 
-func (x *PublishResponse) ProtoReflect() protoreflect.Message {
+func (x *ListingStatsItem) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1763,28 +1958,36 @@ func (x *PublishResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PublishResponse.ProtoReflect.Descriptor instead.
-func (*PublishResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListingStatsItem.ProtoReflect.Descriptor instead.
+func (*ListingStatsItem) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *PublishResponse) GetId() uint64 {
+func (x *ListingStatsItem) GetListing() *Listing {
 	if x != nil {
-		return x.Id
+		return x.Listing
 	}
-	return 0
+	return nil
 }
 
-type UnpublishRequest struct {
+func (x *ListingStatsItem) GetStats() *ListingStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type PublisherStatsItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PublisherKey []byte        `protobuf:"bytes,1,opt,name=publisher_key,json=publisherKey,proto3" json:"publisher_key,omitempty"`
+	Stats        *ListingStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
 }
 
-func (x *UnpublishRequest) Reset() {
-	*x = UnpublishRequest{}
+func (x *PublisherStatsItem) Reset() {
+	*x = PublisherStatsItem{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1792,13 +1995,13 @@ func (x *UnpublishRequest) Reset() {
 	}
 }
 
-func (x *UnpublishRequest) String() string {
+func (x *PublisherStatsItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnpublishRequest) ProtoMessage() {}
+func (*PublisherStatsItem) ProtoMessage() {}
 
-func (x *UnpublishRequest) ProtoReflect() protoreflect.Message {
+func (x *PublisherStatsItem) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1810,26 +2013,38 @@ func (x *UnpublishRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnpublishRequest.ProtoReflect.Descriptor instead.
-func (*UnpublishRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PublisherStatsItem.ProtoReflect.Descriptor instead.
+func (*PublisherStatsItem) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *UnpublishRequest) GetId() uint64 {
+func (x *PublisherStatsItem) GetPublisherKey() []byte {
 	if x != nil {
-		return x.Id
+		return x.PublisherKey
 	}
-	return 0
+	return nil
 }
 
-type UnpublishResponse struct {
+func (x *PublisherStatsItem) GetStats() *ListingStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type UserModeration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	DisableJoin    *wrapperspb.BoolValue `protobuf:"bytes,1,opt,name=disable_join,json=disableJoin,proto3" json:"disable_join,omitempty"`
+	DisablePublish *wrapperspb.BoolValue `protobuf:"bytes,2,opt,name=disable_publish,json=disablePublish,proto3" json:"disable_publish,omitempty"`
+	IsModerator    *wrapperspb.BoolValue `protobuf:"bytes,3,opt,name=is_moderator,json=isModerator,proto3" json:"is_moderator,omitempty"`
+	IsAdmin        *wrapperspb.BoolValue `protobuf:"bytes,4,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
 }
 
-func (x *UnpublishResponse) Reset() {
-	*x = UnpublishResponse{}
+func (x *UserModeration) Reset() {
+	*x = UserModeration{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1837,13 +2052,13 @@ func (x *UnpublishResponse) Reset() {
 	}
 }
 
-func (x *UnpublishResponse) String() string {
+func (x *UserModeration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnpublishResponse) ProtoMessage() {}
+func (*UserModeration) ProtoMessage() {}
 
-func (x *UnpublishResponse) ProtoReflect() protoreflect.Message {
+func (x *UserModeration) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1855,21 +2070,52 @@ func (x *UnpublishResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnpublishResponse.ProtoReflect.Descriptor instead.
-func (*UnpublishResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserModeration.ProtoReflect.Descriptor instead.
+func (*UserModeration) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{20}
 }
 
-type JoinRequest struct {
+func (x *UserModeration) GetDisableJoin() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.DisableJoin
+	}
+	return nil
+}
+
+func (x *UserModeration) GetDisablePublish() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.DisablePublish
+	}
+	return nil
+}
+
+func (x *UserModeration) GetIsModerator() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.IsModerator
+	}
+	return nil
+}
+
+func (x *UserModeration) GetIsAdmin() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.IsAdmin
+	}
+	return nil
+}
+
+type UserRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query *ListingQuery `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Id         uint64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	NetworkId  uint64          `protobuf:"varint,2,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	PeerKey    []byte          `protobuf:"bytes,3,opt,name=peer_key,json=peerKey,proto3" json:"peer_key,omitempty"`
+	Moderation *UserModeration `protobuf:"bytes,4,opt,name=moderation,proto3" json:"moderation,omitempty"`
 }
 
-func (x *JoinRequest) Reset() {
-	*x = JoinRequest{}
+func (x *UserRecord) Reset() {
+	*x = UserRecord{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1877,13 +2123,13 @@ func (x *JoinRequest) Reset() {
 	}
 }
 
-func (x *JoinRequest) String() string {
+func (x *UserRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinRequest) ProtoMessage() {}
+func (*UserRecord) ProtoMessage() {}
 
-func (x *JoinRequest) ProtoReflect() protoreflect.Message {
+func (x *UserRecord) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1895,28 +2141,49 @@ func (x *JoinRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinRequest.ProtoReflect.Descriptor instead.
-func (*JoinRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserRecord.ProtoReflect.Descriptor instead.
+func (*UserRecord) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *JoinRequest) GetQuery() *ListingQuery {
+func (x *UserRecord) GetId() uint64 {
 	if x != nil {
-		return x.Query
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserRecord) GetNetworkId() uint64 {
+	if x != nil {
+		return x.NetworkId
+	}
+	return 0
+}
+
+func (x *UserRecord) GetPeerKey() []byte {
+	if x != nil {
+		return x.PeerKey
 	}
 	return nil
 }
 
-type JoinResponse struct {
+func (x *UserRecord) GetModeration() *UserModeration {
+	if x != nil {
+		return x.Moderation
+	}
+	return nil
+}
+
+type EventBroadcast struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Events []*Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 }
 
-func (x *JoinResponse) Reset() {
-	*x = JoinResponse{}
+func (x *EventBroadcast) Reset() {
+	*x = EventBroadcast{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1924,13 +2191,13 @@ func (x *JoinResponse) Reset() {
 	}
 }
 
-func (x *JoinResponse) String() string {
+func (x *EventBroadcast) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinResponse) ProtoMessage() {}
+func (*EventBroadcast) ProtoMessage() {}
 
-func (x *JoinResponse) ProtoReflect() protoreflect.Message {
+func (x *EventBroadcast) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1942,28 +2209,29 @@ func (x *JoinResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinResponse.ProtoReflect.Descriptor instead.
-func (*JoinResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use EventBroadcast.ProtoReflect.Descriptor instead.
+func (*EventBroadcast) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *JoinResponse) GetId() uint64 {
+func (x *EventBroadcast) GetEvents() []*Event {
 	if x != nil {
-		return x.Id
+		return x.Events
 	}
-	return 0
+	return nil
 }
 
-type PartRequest struct {
+type AssetBundle struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Icon      *image.Image  `protobuf:"bytes,1,opt,name=icon,proto3" json:"icon,omitempty"`
+	Directory *ClientConfig `protobuf:"bytes,2,opt,name=directory,proto3" json:"directory,omitempty"`
 }
 
-func (x *PartRequest) Reset() {
-	*x = PartRequest{}
+func (x *AssetBundle) Reset() {
+	*x = AssetBundle{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1971,13 +2239,13 @@ func (x *PartRequest) Reset() {
 	}
 }
 
-func (x *PartRequest) String() string {
+func (x *AssetBundle) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PartRequest) ProtoMessage() {}
+func (*AssetBundle) ProtoMessage() {}
 
-func (x *PartRequest) ProtoReflect() protoreflect.Message {
+func (x *AssetBundle) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1989,26 +2257,35 @@ func (x *PartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PartRequest.ProtoReflect.Descriptor instead.
-func (*PartRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AssetBundle.ProtoReflect.Descriptor instead.
+func (*AssetBundle) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *PartRequest) GetId() uint64 {
+func (x *AssetBundle) GetIcon() *image.Image {
 	if x != nil {
-		return x.Id
+		return x.Icon
 	}
-	return 0
+	return nil
 }
 
-type PartResponse struct {
+func (x *AssetBundle) GetDirectory() *ClientConfig {
+	if x != nil {
+		return x.Directory
+	}
+	return nil
+}
+
+type PublishRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Listing *Listing `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing,omitempty"`
 }
 
-func (x *PartResponse) Reset() {
-	*x = PartResponse{}
+func (x *PublishRequest) Reset() {
+	*x = PublishRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2016,13 +2293,13 @@ func (x *PartResponse) Reset() {
 	}
 }
 
-func (x *PartResponse) String() string {
+func (x *PublishRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PartResponse) ProtoMessage() {}
+func (*PublishRequest) ProtoMessage() {}
 
-func (x *PartResponse) ProtoReflect() protoreflect.Message {
+func (x *PublishRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2034,19 +2311,28 @@ func (x *PartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PartResponse.ProtoReflect.Descriptor instead.
-func (*PartResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PublishRequest.ProtoReflect.Descriptor instead.
+func (*PublishRequest) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{24}
 }
 
-type PingRequest struct {
+func (x *PublishRequest) GetListing() *Listing {
+	if x != nil {
+		return x.Listing
+	}
+	return nil
+}
+
+type PublishResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *PingRequest) Reset() {
-	*x = PingRequest{}
+func (x *PublishResponse) Reset() {
+	*x = PublishResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2054,13 +2340,13 @@ func (x *PingRequest) Reset() {
 	}
 }
 
-func (x *PingRequest) String() string {
+func (x *PublishResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingRequest) ProtoMessage() {}
+func (*PublishResponse) ProtoMessage() {}
 
-func (x *PingRequest) ProtoReflect() protoreflect.Message {
+func (x *PublishResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2072,19 +2358,28 @@ func (x *PingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
-func (*PingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PublishResponse.ProtoReflect.Descriptor instead.
+func (*PublishResponse) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{25}
 }
 
-type PingResponse struct {
+func (x *PublishResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UnpublishRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *PingResponse) Reset() {
-	*x = PingResponse{}
+func (x *UnpublishRequest) Reset() {
+	*x = UnpublishRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2092,13 +2387,13 @@ func (x *PingResponse) Reset() {
 	}
 }
 
-func (x *PingResponse) String() string {
+func (x *UnpublishRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingResponse) ProtoMessage() {}
+func (*UnpublishRequest) ProtoMessage() {}
 
-func (x *PingResponse) ProtoReflect() protoreflect.Message {
+func (x *UnpublishRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2110,22 +2405,26 @@ func (x *PingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
-func (*PingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnpublishRequest.ProtoReflect.Descriptor instead.
+func (*UnpublishRequest) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{26}
 }
 
-type ModerateListingRequest struct {
+func (x *UnpublishRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UnpublishResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id         uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Moderation *ListingModeration `protobuf:"bytes,2,opt,name=moderation,proto3" json:"moderation,omitempty"`
 }
 
-func (x *ModerateListingRequest) Reset() {
-	*x = ModerateListingRequest{}
+func (x *UnpublishResponse) Reset() {
+	*x = UnpublishResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2133,13 +2432,13 @@ func (x *ModerateListingRequest) Reset() {
 	}
 }
 
-func (x *ModerateListingRequest) String() string {
+func (x *UnpublishResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModerateListingRequest) ProtoMessage() {}
+func (*UnpublishResponse) ProtoMessage() {}
 
-func (x *ModerateListingRequest) ProtoReflect() protoreflect.Message {
+func (x *UnpublishResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2151,33 +2450,21 @@ func (x *ModerateListingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModerateListingRequest.ProtoReflect.Descriptor instead.
-func (*ModerateListingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnpublishResponse.ProtoReflect.Descriptor instead.
+func (*UnpublishResponse) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ModerateListingRequest) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *ModerateListingRequest) GetModeration() *ListingModeration {
-	if x != nil {
-		return x.Moderation
-	}
-	return nil
-}
-
-type ModerateListingResponse struct {
+type JoinRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Query *ListingQuery `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 }
 
-func (x *ModerateListingResponse) Reset() {
-	*x = ModerateListingResponse{}
+func (x *JoinRequest) Reset() {
+	*x = JoinRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2185,13 +2472,13 @@ func (x *ModerateListingResponse) Reset() {
 	}
 }
 
-func (x *ModerateListingResponse) String() string {
+func (x *JoinRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModerateListingResponse) ProtoMessage() {}
+func (*JoinRequest) ProtoMessage() {}
 
-func (x *ModerateListingResponse) ProtoReflect() protoreflect.Message {
+func (x *JoinRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2203,22 +2490,28 @@ func (x *ModerateListingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModerateListingResponse.ProtoReflect.Descriptor instead.
-func (*ModerateListingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use JoinRequest.ProtoReflect.Descriptor instead.
+func (*JoinRequest) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{28}
 }
 
-type ModerateUserRequest struct {
+func (x *JoinRequest) GetQuery() *ListingQuery {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+type JoinResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PeerKey    []byte          `protobuf:"bytes,1,opt,name=peer_key,json=peerKey,proto3" json:"peer_key,omitempty"`
-	Moderation *UserModeration `protobuf:"bytes,2,opt,name=moderation,proto3" json:"moderation,omitempty"`
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ModerateUserRequest) Reset() {
-	*x = ModerateUserRequest{}
+func (x *JoinResponse) Reset() {
+	*x = JoinResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2226,13 +2519,13 @@ func (x *ModerateUserRequest) Reset() {
 	}
 }
 
-func (x *ModerateUserRequest) String() string {
+func (x *JoinResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModerateUserRequest) ProtoMessage() {}
+func (*JoinResponse) ProtoMessage() {}
 
-func (x *ModerateUserRequest) ProtoReflect() protoreflect.Message {
+func (x *JoinResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2244,33 +2537,28 @@ func (x *ModerateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModerateUserRequest.ProtoReflect.Descriptor instead.
-func (*ModerateUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use JoinResponse.ProtoReflect.Descriptor instead.
+func (*JoinResponse) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ModerateUserRequest) GetPeerKey() []byte {
-	if x != nil {
-		return x.PeerKey
-	}
-	return nil
-}
-
-func (x *ModerateUserRequest) GetModeration() *UserModeration {
+func (x *JoinResponse) GetId() uint64 {
 	if x != nil {
-		return x.Moderation
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type ModerateUserResponse struct {
+type PartRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ModerateUserResponse) Reset() {
-	*x = ModerateUserResponse{}
+func (x *PartRequest) Reset() {
+	*x = PartRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2278,13 +2566,13 @@ func (x *ModerateUserResponse) Reset() {
 	}
 }
 
-func (x *ModerateUserResponse) String() string {
+func (x *PartRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModerateUserResponse) ProtoMessage() {}
+func (*PartRequest) ProtoMessage() {}
 
-func (x *ModerateUserResponse) ProtoReflect() protoreflect.Message {
+func (x *PartRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2296,23 +2584,26 @@ func (x *ModerateUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModerateUserResponse.ProtoReflect.Descriptor instead.
-func (*ModerateUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PartRequest.ProtoReflect.Descriptor instead.
+func (*PartRequest) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{30}
 }
 
-type Network struct {
+func (x *PartRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PartResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id   uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Key  []byte `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (x *Network) Reset() {
-	*x = Network{}
+func (x *PartResponse) Reset() {
+	*x = PartResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2320,13 +2611,13 @@ func (x *Network) Reset() {
 	}
 }
 
-func (x *Network) String() string {
+func (x *PartResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Network) ProtoMessage() {}
+func (*PartResponse) ProtoMessage() {}
 
-func (x *Network) ProtoReflect() protoreflect.Message {
+func (x *PartResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2338,47 +2629,23 @@ func (x *Network) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Network.ProtoReflect.Descriptor instead.
-func (*Network) Descriptor() ([]byte, []int) {
+// Deprecated: Use PartResponse.ProtoReflect.Descriptor instead.
+func (*PartResponse) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *Network) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Network) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Network) GetKey() []byte {
-	if x != nil {
-		return x.Key
-	}
-	return nil
-}
-
-type NetworkListingsItem struct {
+type ScheduleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id              uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Listing         *Listing           `protobuf:"bytes,2,opt,name=listing,proto3" json:"listing,omitempty"`
-	Snippet         *ListingSnippet    `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
-	Moderation      *ListingModeration `protobuf:"bytes,4,opt,name=moderation,proto3" json:"moderation,omitempty"`
-	UserCount       uint32             `protobuf:"varint,5,opt,name=user_count,json=userCount,proto3" json:"user_count,omitempty"`
-	RecentUserCount uint32             `protobuf:"varint,6,opt,name=recent_user_count,json=recentUserCount,proto3" json:"recent_user_count,omitempty"`
+	Listing   *Listing        `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing,omitempty"`
+	Snippet   *ListingSnippet `protobuf:"bytes,2,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	StartTime int64           `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
 }
 
-func (x *NetworkListingsItem) Reset() {
-	*x = NetworkListingsItem{}
+func (x *ScheduleRequest) Reset() {
+	*x = ScheduleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2386,13 +2653,13 @@ func (x *NetworkListingsItem) Reset() {
 	}
 }
 
-func (x *NetworkListingsItem) String() string {
+func (x *ScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetworkListingsItem) ProtoMessage() {}
+func (*ScheduleRequest) ProtoMessage() {}
 
-func (x *NetworkListingsItem) ProtoReflect() protoreflect.Message {
+func (x *ScheduleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2404,64 +2671,42 @@ func (x *NetworkListingsItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetworkListingsItem.ProtoReflect.Descriptor instead.
-func (*NetworkListingsItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScheduleRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleRequest) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *NetworkListingsItem) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *NetworkListingsItem) GetListing() *Listing {
+func (x *ScheduleRequest) GetListing() *Listing {
 	if x != nil {
 		return x.Listing
 	}
 	return nil
 }
 
-func (x *NetworkListingsItem) GetSnippet() *ListingSnippet {
+func (x *ScheduleRequest) GetSnippet() *ListingSnippet {
 	if x != nil {
 		return x.Snippet
 	}
 	return nil
 }
 
-func (x *NetworkListingsItem) GetModeration() *ListingModeration {
-	if x != nil {
-		return x.Moderation
-	}
-	return nil
-}
-
-func (x *NetworkListingsItem) GetUserCount() uint32 {
+func (x *ScheduleRequest) GetStartTime() int64 {
 	if x != nil {
-		return x.UserCount
+		return x.StartTime
 	}
 	return 0
 }
 
-func (x *NetworkListingsItem) GetRecentUserCount() uint32 {
-	if x != nil {
-		return x.RecentUserCount
-	}
-	return 0
-}
-
-type NetworkListings struct {
+type ScheduleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Network  *Network               `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
-	Listings []*NetworkListingsItem `protobuf:"bytes,2,rep,name=listings,proto3" json:"listings,omitempty"`
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *NetworkListings) Reset() {
-	*x = NetworkListings{}
+func (x *ScheduleResponse) Reset() {
+	*x = ScheduleResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2469,13 +2714,13 @@ func (x *NetworkListings) Reset() {
 	}
 }
 
-func (x *NetworkListings) String() string {
+func (x *ScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetworkListings) ProtoMessage() {}
+func (*ScheduleResponse) ProtoMessage() {}
 
-func (x *NetworkListings) ProtoReflect() protoreflect.Message {
+func (x *ScheduleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2487,36 +2732,28 @@ func (x *NetworkListings) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetworkListings.ProtoReflect.Descriptor instead.
-func (*NetworkListings) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScheduleResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleResponse) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *NetworkListings) GetNetwork() *Network {
-	if x != nil {
-		return x.Network
-	}
-	return nil
-}
-
-func (x *NetworkListings) GetListings() []*NetworkListingsItem {
+func (x *ScheduleResponse) GetId() uint64 {
 	if x != nil {
-		return x.Listings
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type FrontendPublishRequest struct {
+type UnscheduleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NetworkKey []byte   `protobuf:"bytes,1,opt,name=network_key,json=networkKey,proto3" json:"network_key,omitempty"`
-	Listing    *Listing `protobuf:"bytes,2,opt,name=listing,proto3" json:"listing,omitempty"`
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *FrontendPublishRequest) Reset() {
-	*x = FrontendPublishRequest{}
+func (x *UnscheduleRequest) Reset() {
+	*x = UnscheduleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2524,13 +2761,13 @@ func (x *FrontendPublishRequest) Reset() {
 	}
 }
 
-func (x *FrontendPublishRequest) String() string {
+func (x *UnscheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendPublishRequest) ProtoMessage() {}
+func (*UnscheduleRequest) ProtoMessage() {}
 
-func (x *FrontendPublishRequest) ProtoReflect() protoreflect.Message {
+func (x *UnscheduleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2542,35 +2779,26 @@ func (x *FrontendPublishRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendPublishRequest.ProtoReflect.Descriptor instead.
-func (*FrontendPublishRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnscheduleRequest.ProtoReflect.Descriptor instead.
+func (*UnscheduleRequest) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *FrontendPublishRequest) GetNetworkKey() []byte {
-	if x != nil {
-		return x.NetworkKey
-	}
-	return nil
-}
-
-func (x *FrontendPublishRequest) GetListing() *Listing {
+func (x *UnscheduleRequest) GetId() uint64 {
 	if x != nil {
-		return x.Listing
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type FrontendPublishResponse struct {
+type UnscheduleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *FrontendPublishResponse) Reset() {
-	*x = FrontendPublishResponse{}
+func (x *UnscheduleResponse) Reset() {
+	*x = UnscheduleResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2578,13 +2806,13 @@ func (x *FrontendPublishResponse) Reset() {
 	}
 }
 
-func (x *FrontendPublishResponse) String() string {
+func (x *UnscheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendPublishResponse) ProtoMessage() {}
+func (*UnscheduleResponse) ProtoMessage() {}
 
-func (x *FrontendPublishResponse) ProtoReflect() protoreflect.Message {
+func (x *UnscheduleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2596,29 +2824,24 @@ func (x *FrontendPublishResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendPublishResponse.ProtoReflect.Descriptor instead.
-func (*FrontendPublishResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnscheduleResponse.ProtoReflect.Descriptor instead.
+func (*UnscheduleResponse) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *FrontendPublishResponse) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-type FrontendUnpublishRequest struct {
+type GetListingStatsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NetworkKey []byte `protobuf:"bytes,1,opt,name=network_key,json=networkKey,proto3" json:"network_key,omitempty"`
-	Id         uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	StartTime    int64    `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime      int64    `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	PublisherKey []byte   `protobuf:"bytes,3,opt,name=publisher_key,json=publisherKey,proto3" json:"publisher_key,omitempty"`
+	Listing      *Listing `protobuf:"bytes,4,opt,name=listing,proto3" json:"listing,omitempty"`
 }
 
-func (x *FrontendUnpublishRequest) Reset() {
-	*x = FrontendUnpublishRequest{}
+func (x *GetListingStatsRequest) Reset() {
+	*x = GetListingStatsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2626,13 +2849,13 @@ func (x *FrontendUnpublishRequest) Reset() {
 	}
 }
 
-func (x *FrontendUnpublishRequest) String() string {
+func (x *GetListingStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendUnpublishRequest) ProtoMessage() {}
+func (*GetListingStatsRequest) ProtoMessage() {}
 
-func (x *FrontendUnpublishRequest) ProtoReflect() protoreflect.Message {
+func (x *GetListingStatsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2644,33 +2867,50 @@ func (x *FrontendUnpublishRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendUnpublishRequest.ProtoReflect.Descriptor instead.
-func (*FrontendUnpublishRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetListingStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetListingStatsRequest) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *FrontendUnpublishRequest) GetNetworkKey() []byte {
+func (x *GetListingStatsRequest) GetStartTime() int64 {
 	if x != nil {
-		return x.NetworkKey
+		return x.StartTime
 	}
-	return nil
+	return 0
 }
 
-func (x *FrontendUnpublishRequest) GetId() uint64 {
+func (x *GetListingStatsRequest) GetEndTime() int64 {
 	if x != nil {
-		return x.Id
+		return x.EndTime
 	}
 	return 0
 }
 
-type FrontendUnpublishResponse struct {
+func (x *GetListingStatsRequest) GetPublisherKey() []byte {
+	if x != nil {
+		return x.PublisherKey
+	}
+	return nil
+}
+
+func (x *GetListingStatsRequest) GetListing() *Listing {
+	if x != nil {
+		return x.Listing
+	}
+	return nil
+}
+
+type GetListingStatsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Listings   []*ListingStatsItem   `protobuf:"bytes,1,rep,name=listings,proto3" json:"listings,omitempty"`
+	Publishers []*PublisherStatsItem `protobuf:"bytes,2,rep,name=publishers,proto3" json:"publishers,omitempty"`
 }
 
-func (x *FrontendUnpublishResponse) Reset() {
-	*x = FrontendUnpublishResponse{}
+func (x *GetListingStatsResponse) Reset() {
+	*x = GetListingStatsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2678,13 +2918,13 @@ func (x *FrontendUnpublishResponse) Reset() {
 	}
 }
 
-func (x *FrontendUnpublishResponse) String() string {
+func (x *GetListingStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendUnpublishResponse) ProtoMessage() {}
+func (*GetListingStatsResponse) ProtoMessage() {}
 
-func (x *FrontendUnpublishResponse) ProtoReflect() protoreflect.Message {
+func (x *GetListingStatsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2696,22 +2936,33 @@ func (x *FrontendUnpublishResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendUnpublishResponse.ProtoReflect.Descriptor instead.
-func (*FrontendUnpublishResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetListingStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetListingStatsResponse) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{37}
 }
 
-type FrontendJoinRequest struct {
+func (x *GetListingStatsResponse) GetListings() []*ListingStatsItem {
+	if x != nil {
+		return x.Listings
+	}
+	return nil
+}
+
+func (x *GetListingStatsResponse) GetPublishers() []*PublisherStatsItem {
+	if x != nil {
+		return x.Publishers
+	}
+	return nil
+}
+
+type PingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	NetworkKey []byte        `protobuf:"bytes,1,opt,name=network_key,json=networkKey,proto3" json:"network_key,omitempty"`
-	Query      *ListingQuery `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
 }
 
-func (x *FrontendJoinRequest) Reset() {
-	*x = FrontendJoinRequest{}
+func (x *PingRequest) Reset() {
+	*x = PingRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2719,13 +2970,13 @@ func (x *FrontendJoinRequest) Reset() {
 	}
 }
 
-func (x *FrontendJoinRequest) String() string {
+func (x *PingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendJoinRequest) ProtoMessage() {}
+func (*PingRequest) ProtoMessage() {}
 
-func (x *FrontendJoinRequest) ProtoReflect() protoreflect.Message {
+func (x *PingRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2737,35 +2988,19 @@ func (x *FrontendJoinRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendJoinRequest.ProtoReflect.Descriptor instead.
-func (*FrontendJoinRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
+func (*PingRequest) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *FrontendJoinRequest) GetNetworkKey() []byte {
-	if x != nil {
-		return x.NetworkKey
-	}
-	return nil
-}
-
-func (x *FrontendJoinRequest) GetQuery() *ListingQuery {
-	if x != nil {
-		return x.Query
-	}
-	return nil
-}
-
-type FrontendJoinResponse struct {
+type PingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *FrontendJoinResponse) Reset() {
-	*x = FrontendJoinResponse{}
+func (x *PingResponse) Reset() {
+	*x = PingResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2773,13 +3008,13 @@ func (x *FrontendJoinResponse) Reset() {
 	}
 }
 
-func (x *FrontendJoinResponse) String() string {
+func (x *PingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendJoinResponse) ProtoMessage() {}
+func (*PingResponse) ProtoMessage() {}
 
-func (x *FrontendJoinResponse) ProtoReflect() protoreflect.Message {
+func (x *PingResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2791,29 +3026,22 @@ func (x *FrontendJoinResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendJoinResponse.ProtoReflect.Descriptor instead.
-func (*FrontendJoinResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
+func (*PingResponse) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *FrontendJoinResponse) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-type FrontendPartRequest struct {
+type ModerateListingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NetworkKey []byte `protobuf:"bytes,1,opt,name=network_key,json=networkKey,proto3" json:"network_key,omitempty"`
-	Id         uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Id         uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Moderation *ListingModeration `protobuf:"bytes,2,opt,name=moderation,proto3" json:"moderation,omitempty"`
 }
 
-func (x *FrontendPartRequest) Reset() {
-	*x = FrontendPartRequest{}
+func (x *ModerateListingRequest) Reset() {
+	*x = ModerateListingRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2821,13 +3049,13 @@ func (x *FrontendPartRequest) Reset() {
 	}
 }
 
-func (x *FrontendPartRequest) String() string {
+func (x *ModerateListingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendPartRequest) ProtoMessage() {}
+func (*ModerateListingRequest) ProtoMessage() {}
 
-func (x *FrontendPartRequest) ProtoReflect() protoreflect.Message {
+func (x *ModerateListingRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2839,33 +3067,33 @@ func (x *FrontendPartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendPartRequest.ProtoReflect.Descriptor instead.
-func (*FrontendPartRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModerateListingRequest.ProtoReflect.Descriptor instead.
+func (*ModerateListingRequest) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *FrontendPartRequest) GetNetworkKey() []byte {
+func (x *ModerateListingRequest) GetId() uint64 {
 	if x != nil {
-		return x.NetworkKey
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *FrontendPartRequest) GetId() uint64 {
+func (x *ModerateListingRequest) GetModeration() *ListingModeration {
 	if x != nil {
-		return x.Id
+		return x.Moderation
 	}
-	return 0
+	return nil
 }
 
-type FrontendPartResponse struct {
+type ModerateListingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *FrontendPartResponse) Reset() {
-	*x = FrontendPartResponse{}
+func (x *ModerateListingResponse) Reset() {
+	*x = ModerateListingResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2873,13 +3101,13 @@ func (x *FrontendPartResponse) Reset() {
 	}
 }
 
-func (x *FrontendPartResponse) String() string {
+func (x *ModerateListingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendPartResponse) ProtoMessage() {}
+func (*ModerateListingResponse) ProtoMessage() {}
 
-func (x *FrontendPartResponse) ProtoReflect() protoreflect.Message {
+func (x *ModerateListingResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2891,21 +3119,22 @@ func (x *FrontendPartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendPartResponse.ProtoReflect.Descriptor instead.
-func (*FrontendPartResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModerateListingResponse.ProtoReflect.Descriptor instead.
+func (*ModerateListingResponse) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{41}
 }
 
-type FrontendTestRequest struct {
+type ModerateUserRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NetworkKey []byte `protobuf:"bytes,1,opt,name=network_key,json=networkKey,proto3" json:"network_key,omitempty"`
+	PeerKey    []byte          `protobuf:"bytes,1,opt,name=peer_key,json=peerKey,proto3" json:"peer_key,omitempty"`
+	Moderation *UserModeration `protobuf:"bytes,2,opt,name=moderation,proto3" json:"moderation,omitempty"`
 }
 
-func (x *FrontendTestRequest) Reset() {
-	*x = FrontendTestRequest{}
+func (x *ModerateUserRequest) Reset() {
+	*x = ModerateUserRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2913,13 +3142,13 @@ func (x *FrontendTestRequest) Reset() {
 	}
 }
 
-func (x *FrontendTestRequest) String() string {
+func (x *ModerateUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendTestRequest) ProtoMessage() {}
+func (*ModerateUserRequest) ProtoMessage() {}
 
-func (x *FrontendTestRequest) ProtoReflect() protoreflect.Message {
+func (x *ModerateUserRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2931,26 +3160,33 @@ func (x *FrontendTestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendTestRequest.ProtoReflect.Descriptor instead.
-func (*FrontendTestRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModerateUserRequest.ProtoReflect.Descriptor instead.
+func (*ModerateUserRequest) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *FrontendTestRequest) GetNetworkKey() []byte {
+func (x *ModerateUserRequest) GetPeerKey() []byte {
 	if x != nil {
-		return x.NetworkKey
+		return x.PeerKey
 	}
 	return nil
 }
 
-type FrontendTestResponse struct {
+func (x *ModerateUserRequest) GetModeration() *UserModeration {
+	if x != nil {
+		return x.Moderation
+	}
+	return nil
+}
+
+type ModerateUserResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *FrontendTestResponse) Reset() {
-	*x = FrontendTestResponse{}
+func (x *ModerateUserResponse) Reset() {
+	*x = ModerateUserResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2958,13 +3194,13 @@ func (x *FrontendTestResponse) Reset() {
 	}
 }
 
-func (x *FrontendTestResponse) String() string {
+func (x *ModerateUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendTestResponse) ProtoMessage() {}
+func (*ModerateUserResponse) ProtoMessage() {}
 
-func (x *FrontendTestResponse) ProtoReflect() protoreflect.Message {
+func (x *ModerateUserResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2976,23 +3212,23 @@ func (x *FrontendTestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendTestResponse.ProtoReflect.Descriptor instead.
-func (*FrontendTestResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModerateUserResponse.ProtoReflect.Descriptor instead.
+func (*ModerateUserResponse) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{43}
 }
 
-type FrontendModerateListingRequest struct {
+type Network struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NetworkKey []byte             `protobuf:"bytes,1,opt,name=network_key,json=networkKey,proto3" json:"network_key,omitempty"`
-	Id         uint64             `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	Moderation *ListingModeration `protobuf:"bytes,3,opt,name=moderation,proto3" json:"moderation,omitempty"`
+	Id   uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Key  []byte `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (x *FrontendModerateListingRequest) Reset() {
-	*x = FrontendModerateListingRequest{}
+func (x *Network) Reset() {
+	*x = Network{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3000,13 +3236,13 @@ func (x *FrontendModerateListingRequest) Reset() {
 	}
 }
 
-func (x *FrontendModerateListingRequest) String() string {
+func (x *Network) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendModerateListingRequest) ProtoMessage() {}
+func (*Network) ProtoMessage() {}
 
-func (x *FrontendModerateListingRequest) ProtoReflect() protoreflect.Message {
+func (x *Network) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3018,40 +3254,49 @@ func (x *FrontendModerateListingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendModerateListingRequest.ProtoReflect.Descriptor instead.
-func (*FrontendModerateListingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Network.ProtoReflect.Descriptor instead.
+func (*Network) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *FrontendModerateListingRequest) GetNetworkKey() []byte {
+func (x *Network) GetId() uint64 {
 	if x != nil {
-		return x.NetworkKey
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *FrontendModerateListingRequest) GetId() uint64 {
+func (x *Network) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *FrontendModerateListingRequest) GetModeration() *ListingModeration {
+func (x *Network) GetKey() []byte {
 	if x != nil {
-		return x.Moderation
+		return x.Key
 	}
 	return nil
 }
 
-type FrontendModerateListingResponse struct {
+type NetworkListingsItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id              uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Listing         *Listing           `protobuf:"bytes,2,opt,name=listing,proto3" json:"listing,omitempty"`
+	Snippet         *ListingSnippet    `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	Moderation      *ListingModeration `protobuf:"bytes,4,opt,name=moderation,proto3" json:"moderation,omitempty"`
+	UserCount       uint32             `protobuf:"varint,5,opt,name=user_count,json=userCount,proto3" json:"user_count,omitempty"`
+	RecentUserCount uint32             `protobuf:"varint,6,opt,name=recent_user_count,json=recentUserCount,proto3" json:"recent_user_count,omitempty"`
+	Upcoming        bool               `protobuf:"varint,7,opt,name=upcoming,proto3" json:"upcoming,omitempty"`
+	Provenance      *ListingProvenance `protobuf:"bytes,8,opt,name=provenance,proto3" json:"provenance,omitempty"`
 }
 
-func (x *FrontendModerateListingResponse) Reset() {
-	*x = FrontendModerateListingResponse{}
+func (x *NetworkListingsItem) Reset() {
+	*x = NetworkListingsItem{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3059,13 +3304,13 @@ func (x *FrontendModerateListingResponse) Reset() {
 	}
 }
 
-func (x *FrontendModerateListingResponse) String() string {
+func (x *NetworkListingsItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendModerateListingResponse) ProtoMessage() {}
+func (*NetworkListingsItem) ProtoMessage() {}
 
-func (x *FrontendModerateListingResponse) ProtoReflect() protoreflect.Message {
+func (x *NetworkListingsItem) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3077,23 +3322,78 @@ func (x *FrontendModerateListingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendModerateListingResponse.ProtoReflect.Descriptor instead.
-func (*FrontendModerateListingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use NetworkListingsItem.ProtoReflect.Descriptor instead.
+func (*NetworkListingsItem) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{45}
 }
 
-type FrontendModerateUserRequest struct {
+func (x *NetworkListingsItem) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *NetworkListingsItem) GetListing() *Listing {
+	if x != nil {
+		return x.Listing
+	}
+	return nil
+}
+
+func (x *NetworkListingsItem) GetSnippet() *ListingSnippet {
+	if x != nil {
+		return x.Snippet
+	}
+	return nil
+}
+
+func (x *NetworkListingsItem) GetModeration() *ListingModeration {
+	if x != nil {
+		return x.Moderation
+	}
+	return nil
+}
+
+func (x *NetworkListingsItem) GetUserCount() uint32 {
+	if x != nil {
+		return x.UserCount
+	}
+	return 0
+}
+
+func (x *NetworkListingsItem) GetRecentUserCount() uint32 {
+	if x != nil {
+		return x.RecentUserCount
+	}
+	return 0
+}
+
+func (x *NetworkListingsItem) GetUpcoming() bool {
+	if x != nil {
+		return x.Upcoming
+	}
+	return false
+}
+
+func (x *NetworkListingsItem) GetProvenance() *ListingProvenance {
+	if x != nil {
+		return x.Provenance
+	}
+	return nil
+}
+
+type NetworkListings struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NetworkKey []byte          `protobuf:"bytes,1,opt,name=network_key,json=networkKey,proto3" json:"network_key,omitempty"`
-	Alias      string          `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
-	Moderation *UserModeration `protobuf:"bytes,3,opt,name=moderation,proto3" json:"moderation,omitempty"`
+	Network  *Network               `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Listings []*NetworkListingsItem `protobuf:"bytes,2,rep,name=listings,proto3" json:"listings,omitempty"`
 }
 
-func (x *FrontendModerateUserRequest) Reset() {
-	*x = FrontendModerateUserRequest{}
+func (x *NetworkListings) Reset() {
+	*x = NetworkListings{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3101,13 +3401,13 @@ func (x *FrontendModerateUserRequest) Reset() {
 	}
 }
 
-func (x *FrontendModerateUserRequest) String() string {
+func (x *NetworkListings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendModerateUserRequest) ProtoMessage() {}
+func (*NetworkListings) ProtoMessage() {}
 
-func (x *FrontendModerateUserRequest) ProtoReflect() protoreflect.Message {
+func (x *NetworkListings) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3119,40 +3419,37 @@ func (x *FrontendModerateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendModerateUserRequest.ProtoReflect.Descriptor instead.
-func (*FrontendModerateUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use NetworkListings.ProtoReflect.Descriptor instead.
+func (*NetworkListings) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *FrontendModerateUserRequest) GetNetworkKey() []byte {
+func (x *NetworkListings) GetNetwork() *Network {
 	if x != nil {
-		return x.NetworkKey
+		return x.Network
 	}
 	return nil
 }
 
-func (x *FrontendModerateUserRequest) GetAlias() string {
-	if x != nil {
-		return x.Alias
-	}
-	return ""
-}
-
-func (x *FrontendModerateUserRequest) GetModeration() *UserModeration {
+func (x *NetworkListings) GetListings() []*NetworkListingsItem {
 	if x != nil {
-		return x.Moderation
+		return x.Listings
 	}
 	return nil
 }
 
-type FrontendModerateUserResponse struct {
+type FrontendPublishRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	NetworkKey []byte   `protobuf:"bytes,1,opt,name=network_key,json=networkKey,proto3" json:"network_key,omitempty"`
+	Listing    *Listing `protobuf:"bytes,2,opt,name=listing,proto3" json:"listing,omitempty"`
+	Anonymous  bool     `protobuf:"varint,3,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
 }
 
-func (x *FrontendModerateUserResponse) Reset() {
-	*x = FrontendModerateUserResponse{}
+func (x *FrontendPublishRequest) Reset() {
+	*x = FrontendPublishRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3160,13 +3457,13 @@ func (x *FrontendModerateUserResponse) Reset() {
 	}
 }
 
-func (x *FrontendModerateUserResponse) String() string {
+func (x *FrontendPublishRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendModerateUserResponse) ProtoMessage() {}
+func (*FrontendPublishRequest) ProtoMessage() {}
 
-func (x *FrontendModerateUserResponse) ProtoReflect() protoreflect.Message {
+func (x *FrontendPublishRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3178,19 +3475,42 @@ func (x *FrontendModerateUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendModerateUserResponse.ProtoReflect.Descriptor instead.
-func (*FrontendModerateUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FrontendPublishRequest.ProtoReflect.Descriptor instead.
+func (*FrontendPublishRequest) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{47}
 }
 
-type FrontendGetUsersRequest struct {
+func (x *FrontendPublishRequest) GetNetworkKey() []byte {
+	if x != nil {
+		return x.NetworkKey
+	}
+	return nil
+}
+
+func (x *FrontendPublishRequest) GetListing() *Listing {
+	if x != nil {
+		return x.Listing
+	}
+	return nil
+}
+
+func (x *FrontendPublishRequest) GetAnonymous() bool {
+	if x != nil {
+		return x.Anonymous
+	}
+	return false
+}
+
+type FrontendPublishResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *FrontendGetUsersRequest) Reset() {
-	*x = FrontendGetUsersRequest{}
+func (x *FrontendPublishResponse) Reset() {
+	*x = FrontendPublishResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3198,13 +3518,13 @@ func (x *FrontendGetUsersRequest) Reset() {
 	}
 }
 
-func (x *FrontendGetUsersRequest) String() string {
+func (x *FrontendPublishResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendGetUsersRequest) ProtoMessage() {}
+func (*FrontendPublishResponse) ProtoMessage() {}
 
-func (x *FrontendGetUsersRequest) ProtoReflect() protoreflect.Message {
+func (x *FrontendPublishResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3216,22 +3536,30 @@ func (x *FrontendGetUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendGetUsersRequest.ProtoReflect.Descriptor instead.
-func (*FrontendGetUsersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FrontendPublishResponse.ProtoReflect.Descriptor instead.
+func (*FrontendPublishResponse) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{48}
 }
 
-type FrontendGetUsersResponse struct {
+func (x *FrontendPublishResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type FrontendUnpublishRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Users    []*FrontendGetUsersResponse_User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	Networks map[uint64]*Network              `protobuf:"bytes,2,rep,name=networks,proto3" json:"networks,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NetworkKey []byte `protobuf:"bytes,1,opt,name=network_key,json=networkKey,proto3" json:"network_key,omitempty"`
+	Id         uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Anonymous  bool   `protobuf:"varint,3,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
 }
 
-func (x *FrontendGetUsersResponse) Reset() {
-	*x = FrontendGetUsersResponse{}
+func (x *FrontendUnpublishRequest) Reset() {
+	*x = FrontendUnpublishRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3239,13 +3567,13 @@ func (x *FrontendGetUsersResponse) Reset() {
 	}
 }
 
-func (x *FrontendGetUsersResponse) String() string {
+func (x *FrontendUnpublishRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendGetUsersResponse) ProtoMessage() {}
+func (*FrontendUnpublishRequest) ProtoMessage() {}
 
-func (x *FrontendGetUsersResponse) ProtoReflect() protoreflect.Message {
+func (x *FrontendUnpublishRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3257,36 +3585,40 @@ func (x *FrontendGetUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendGetUsersResponse.ProtoReflect.Descriptor instead.
-func (*FrontendGetUsersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FrontendUnpublishRequest.ProtoReflect.Descriptor instead.
+func (*FrontendUnpublishRequest) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *FrontendGetUsersResponse) GetUsers() []*FrontendGetUsersResponse_User {
+func (x *FrontendUnpublishRequest) GetNetworkKey() []byte {
 	if x != nil {
-		return x.Users
+		return x.NetworkKey
 	}
 	return nil
 }
 
-func (x *FrontendGetUsersResponse) GetNetworks() map[uint64]*Network {
+func (x *FrontendUnpublishRequest) GetId() uint64 {
 	if x != nil {
-		return x.Networks
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type FrontendGetListingRequest struct {
-	state         protoimpl.MessageState
+func (x *FrontendUnpublishRequest) GetAnonymous() bool {
+	if x != nil {
+		return x.Anonymous
+	}
+	return false
+}
+
+type FrontendUnpublishResponse struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Query      *ListingQuery `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	NetworkKey []byte        `protobuf:"bytes,2,opt,name=network_key,json=networkKey,proto3" json:"network_key,omitempty"`
 }
 
-func (x *FrontendGetListingRequest) Reset() {
-	*x = FrontendGetListingRequest{}
+func (x *FrontendUnpublishResponse) Reset() {
+	*x = FrontendUnpublishResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3294,13 +3626,13 @@ func (x *FrontendGetListingRequest) Reset() {
 	}
 }
 
-func (x *FrontendGetListingRequest) String() string {
+func (x *FrontendUnpublishResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendGetListingRequest) ProtoMessage() {}
+func (*FrontendUnpublishResponse) ProtoMessage() {}
 
-func (x *FrontendGetListingRequest) ProtoReflect() protoreflect.Message {
+func (x *FrontendUnpublishResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3312,40 +3644,22 @@ func (x *FrontendGetListingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendGetListingRequest.ProtoReflect.Descriptor instead.
-func (*FrontendGetListingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FrontendUnpublishResponse.ProtoReflect.Descriptor instead.
+func (*FrontendUnpublishResponse) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *FrontendGetListingRequest) GetQuery() *ListingQuery {
-	if x != nil {
-		return x.Query
-	}
-	return nil
-}
-
-func (x *FrontendGetListingRequest) GetNetworkKey() []byte {
-	if x != nil {
-		return x.NetworkKey
-	}
-	return nil
-}
-
-type FrontendGetListingResponse struct {
+type FrontendJoinRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id              uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Listing         *Listing           `protobuf:"bytes,2,opt,name=listing,proto3" json:"listing,omitempty"`
-	Snippet         *ListingSnippet    `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
-	Moderation      *ListingModeration `protobuf:"bytes,4,opt,name=moderation,proto3" json:"moderation,omitempty"`
-	UserCount       uint32             `protobuf:"varint,5,opt,name=user_count,json=userCount,proto3" json:"user_count,omitempty"`
-	RecentUserCount uint32             `protobuf:"varint,6,opt,name=recent_user_count,json=recentUserCount,proto3" json:"recent_user_count,omitempty"`
+	NetworkKey []byte        `protobuf:"bytes,1,opt,name=network_key,json=networkKey,proto3" json:"network_key,omitempty"`
+	Query      *ListingQuery `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
 }
 
-func (x *FrontendGetListingResponse) Reset() {
-	*x = FrontendGetListingResponse{}
+func (x *FrontendJoinRequest) Reset() {
+	*x = FrontendJoinRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3353,13 +3667,13 @@ func (x *FrontendGetListingResponse) Reset() {
 	}
 }
 
-func (x *FrontendGetListingResponse) String() string {
+func (x *FrontendJoinRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendGetListingResponse) ProtoMessage() {}
+func (*FrontendJoinRequest) ProtoMessage() {}
 
-func (x *FrontendGetListingResponse) ProtoReflect() protoreflect.Message {
+func (x *FrontendJoinRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3371,79 +3685,98 @@ func (x *FrontendGetListingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendGetListingResponse.ProtoReflect.Descriptor instead.
-func (*FrontendGetListingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FrontendJoinRequest.ProtoReflect.Descriptor instead.
+func (*FrontendJoinRequest) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *FrontendGetListingResponse) GetId() uint64 {
+func (x *FrontendJoinRequest) GetNetworkKey() []byte {
 	if x != nil {
-		return x.Id
+		return x.NetworkKey
 	}
-	return 0
+	return nil
 }
 
-func (x *FrontendGetListingResponse) GetListing() *Listing {
+func (x *FrontendJoinRequest) GetQuery() *ListingQuery {
 	if x != nil {
-		return x.Listing
+		return x.Query
 	}
 	return nil
 }
 
-func (x *FrontendGetListingResponse) GetSnippet() *ListingSnippet {
-	if x != nil {
-		return x.Snippet
-	}
-	return nil
+type FrontendJoinResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *FrontendGetListingResponse) GetModeration() *ListingModeration {
-	if x != nil {
-		return x.Moderation
+func (x *FrontendJoinResponse) Reset() {
+	*x = FrontendJoinResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *FrontendGetListingResponse) GetUserCount() uint32 {
-	if x != nil {
-		return x.UserCount
+func (x *FrontendJoinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrontendJoinResponse) ProtoMessage() {}
+
+func (x *FrontendJoinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *FrontendGetListingResponse) GetRecentUserCount() uint32 {
+// Deprecated: Use FrontendJoinResponse.ProtoReflect.Descriptor instead.
+func (*FrontendJoinResponse) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *FrontendJoinResponse) GetId() uint64 {
 	if x != nil {
-		return x.RecentUserCount
+		return x.Id
 	}
 	return 0
 }
 
-type FrontendGetListingsRequest struct {
+type FrontendPartRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ContentTypes []ListingContentType `protobuf:"varint,1,rep,packed,name=content_types,json=contentTypes,proto3,enum=strims.network.v1.directory.ListingContentType" json:"content_types,omitempty"`
-	NetworkKeys  [][]byte             `protobuf:"bytes,2,rep,name=network_keys,json=networkKeys,proto3" json:"network_keys,omitempty"`
+	NetworkKey []byte `protobuf:"bytes,1,opt,name=network_key,json=networkKey,proto3" json:"network_key,omitempty"`
+	Id         uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *FrontendGetListingsRequest) Reset() {
-	*x = FrontendGetListingsRequest{}
+func (x *FrontendPartRequest) Reset() {
+	*x = FrontendPartRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_directory_directory_proto_msgTypes[52]
+		mi := &file_network_v1_directory_directory_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FrontendGetListingsRequest) String() string {
+func (x *FrontendPartRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendGetListingsRequest) ProtoMessage() {}
+func (*FrontendPartRequest) ProtoMessage() {}
 
-func (x *FrontendGetListingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_directory_directory_proto_msgTypes[52]
+func (x *FrontendPartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3454,50 +3787,48 @@ func (x *FrontendGetListingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendGetListingsRequest.ProtoReflect.Descriptor instead.
-func (*FrontendGetListingsRequest) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use FrontendPartRequest.ProtoReflect.Descriptor instead.
+func (*FrontendPartRequest) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *FrontendGetListingsRequest) GetContentTypes() []ListingContentType {
+func (x *FrontendPartRequest) GetNetworkKey() []byte {
 	if x != nil {
-		return x.ContentTypes
+		return x.NetworkKey
 	}
 	return nil
 }
 
-func (x *FrontendGetListingsRequest) GetNetworkKeys() [][]byte {
+func (x *FrontendPartRequest) GetId() uint64 {
 	if x != nil {
-		return x.NetworkKeys
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type FrontendGetListingsResponse struct {
+type FrontendPartResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Listings []*NetworkListings `protobuf:"bytes,1,rep,name=listings,proto3" json:"listings,omitempty"`
 }
 
-func (x *FrontendGetListingsResponse) Reset() {
-	*x = FrontendGetListingsResponse{}
+func (x *FrontendPartResponse) Reset() {
+	*x = FrontendPartResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_directory_directory_proto_msgTypes[53]
+		mi := &file_network_v1_directory_directory_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FrontendGetListingsResponse) String() string {
+func (x *FrontendPartResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendGetListingsResponse) ProtoMessage() {}
+func (*FrontendPartResponse) ProtoMessage() {}
 
-func (x *FrontendGetListingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_directory_directory_proto_msgTypes[53]
+func (x *FrontendPartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3508,45 +3839,39 @@ func (x *FrontendGetListingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendGetListingsResponse.ProtoReflect.Descriptor instead.
-func (*FrontendGetListingsResponse) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{53}
-}
-
-func (x *FrontendGetListingsResponse) GetListings() []*NetworkListings {
-	if x != nil {
-		return x.Listings
-	}
-	return nil
+// Deprecated: Use FrontendPartResponse.ProtoReflect.Descriptor instead.
+func (*FrontendPartResponse) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{54}
 }
 
-type FrontendWatchListingsRequest struct {
+type FrontendScheduleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ContentTypes []ListingContentType `protobuf:"varint,1,rep,packed,name=content_types,json=contentTypes,proto3,enum=strims.network.v1.directory.ListingContentType" json:"content_types,omitempty"`
-	NetworkKeys  [][]byte             `protobuf:"bytes,2,rep,name=network_keys,json=networkKeys,proto3" json:"network_keys,omitempty"`
-	ListingId    uint64               `protobuf:"varint,3,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	NetworkKey []byte          `protobuf:"bytes,1,opt,name=network_key,json=networkKey,proto3" json:"network_key,omitempty"`
+	Listing    *Listing        `protobuf:"bytes,2,opt,name=listing,proto3" json:"listing,omitempty"`
+	Snippet    *ListingSnippet `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	StartTime  int64           `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
 }
 
-func (x *FrontendWatchListingsRequest) Reset() {
-	*x = FrontendWatchListingsRequest{}
+func (x *FrontendScheduleRequest) Reset() {
+	*x = FrontendScheduleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_directory_directory_proto_msgTypes[54]
+		mi := &file_network_v1_directory_directory_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FrontendWatchListingsRequest) String() string {
+func (x *FrontendScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendWatchListingsRequest) ProtoMessage() {}
+func (*FrontendScheduleRequest) ProtoMessage() {}
 
-func (x *FrontendWatchListingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_directory_directory_proto_msgTypes[54]
+func (x *FrontendScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3557,57 +3882,64 @@ func (x *FrontendWatchListingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendWatchListingsRequest.ProtoReflect.Descriptor instead.
-func (*FrontendWatchListingsRequest) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use FrontendScheduleRequest.ProtoReflect.Descriptor instead.
+func (*FrontendScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *FrontendWatchListingsRequest) GetContentTypes() []ListingContentType {
+func (x *FrontendScheduleRequest) GetNetworkKey() []byte {
 	if x != nil {
-		return x.ContentTypes
+		return x.NetworkKey
 	}
 	return nil
 }
 
-func (x *FrontendWatchListingsRequest) GetNetworkKeys() [][]byte {
+func (x *FrontendScheduleRequest) GetListing() *Listing {
 	if x != nil {
-		return x.NetworkKeys
+		return x.Listing
 	}
 	return nil
 }
 
-func (x *FrontendWatchListingsRequest) GetListingId() uint64 {
+func (x *FrontendScheduleRequest) GetSnippet() *ListingSnippet {
 	if x != nil {
-		return x.ListingId
+		return x.Snippet
+	}
+	return nil
+}
+
+func (x *FrontendScheduleRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
 	}
 	return 0
 }
 
-type FrontendWatchListingsResponse struct {
+type FrontendScheduleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Events []*FrontendWatchListingsResponse_Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *FrontendWatchListingsResponse) Reset() {
-	*x = FrontendWatchListingsResponse{}
+func (x *FrontendScheduleResponse) Reset() {
+	*x = FrontendScheduleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_directory_directory_proto_msgTypes[55]
+		mi := &file_network_v1_directory_directory_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FrontendWatchListingsResponse) String() string {
+func (x *FrontendScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendWatchListingsResponse) ProtoMessage() {}
+func (*FrontendScheduleResponse) ProtoMessage() {}
 
-func (x *FrontendWatchListingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_directory_directory_proto_msgTypes[55]
+func (x *FrontendScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3618,44 +3950,44 @@ func (x *FrontendWatchListingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendWatchListingsResponse.ProtoReflect.Descriptor instead.
-func (*FrontendWatchListingsResponse) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use FrontendScheduleResponse.ProtoReflect.Descriptor instead.
+func (*FrontendScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *FrontendWatchListingsResponse) GetEvents() []*FrontendWatchListingsResponse_Event {
+func (x *FrontendScheduleResponse) GetId() uint64 {
 	if x != nil {
-		return x.Events
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type FrontendWatchListingUsersRequest struct {
+type FrontendUnscheduleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NetworkKey []byte        `protobuf:"bytes,1,opt,name=network_key,json=networkKey,proto3" json:"network_key,omitempty"`
-	Query      *ListingQuery `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	NetworkKey []byte `protobuf:"bytes,1,opt,name=network_key,json=networkKey,proto3" json:"network_key,omitempty"`
+	Id         uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *FrontendWatchListingUsersRequest) Reset() {
-	*x = FrontendWatchListingUsersRequest{}
+func (x *FrontendUnscheduleRequest) Reset() {
+	*x = FrontendUnscheduleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_directory_directory_proto_msgTypes[56]
+		mi := &file_network_v1_directory_directory_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FrontendWatchListingUsersRequest) String() string {
+func (x *FrontendUnscheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendWatchListingUsersRequest) ProtoMessage() {}
+func (*FrontendUnscheduleRequest) ProtoMessage() {}
 
-func (x *FrontendWatchListingUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_directory_directory_proto_msgTypes[56]
+func (x *FrontendUnscheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3666,88 +3998,33 @@ func (x *FrontendWatchListingUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendWatchListingUsersRequest.ProtoReflect.Descriptor instead.
-func (*FrontendWatchListingUsersRequest) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use FrontendUnscheduleRequest.ProtoReflect.Descriptor instead.
+func (*FrontendUnscheduleRequest) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *FrontendWatchListingUsersRequest) GetNetworkKey() []byte {
+func (x *FrontendUnscheduleRequest) GetNetworkKey() []byte {
 	if x != nil {
 		return x.NetworkKey
 	}
 	return nil
 }
 
-func (x *FrontendWatchListingUsersRequest) GetQuery() *ListingQuery {
-	if x != nil {
-		return x.Query
-	}
-	return nil
-}
-
-type FrontendWatchListingUsersResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Type  FrontendWatchListingUsersResponse_UserEventType `protobuf:"varint,1,opt,name=type,proto3,enum=strims.network.v1.directory.FrontendWatchListingUsersResponse_UserEventType" json:"type,omitempty"`
-	Users []*FrontendWatchListingUsersResponse_User       `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
-}
-
-func (x *FrontendWatchListingUsersResponse) Reset() {
-	*x = FrontendWatchListingUsersResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_directory_directory_proto_msgTypes[57]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *FrontendWatchListingUsersResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*FrontendWatchListingUsersResponse) ProtoMessage() {}
-
-func (x *FrontendWatchListingUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_directory_directory_proto_msgTypes[57]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use FrontendWatchListingUsersResponse.ProtoReflect.Descriptor instead.
-func (*FrontendWatchListingUsersResponse) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{57}
-}
-
-func (x *FrontendWatchListingUsersResponse) GetType() FrontendWatchListingUsersResponse_UserEventType {
-	if x != nil {
-		return x.Type
-	}
-	return FrontendWatchListingUsersResponse_USER_EVENT_TYPE_JOIN
-}
-
-func (x *FrontendWatchListingUsersResponse) GetUsers() []*FrontendWatchListingUsersResponse_User {
+func (x *FrontendUnscheduleRequest) GetId() uint64 {
 	if x != nil {
-		return x.Users
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type FrontendWatchAssetBundlesRequest struct {
+type FrontendUnscheduleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *FrontendWatchAssetBundlesRequest) Reset() {
-	*x = FrontendWatchAssetBundlesRequest{}
+func (x *FrontendUnscheduleResponse) Reset() {
+	*x = FrontendUnscheduleResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3755,13 +4032,13 @@ func (x *FrontendWatchAssetBundlesRequest) Reset() {
 	}
 }
 
-func (x *FrontendWatchAssetBundlesRequest) String() string {
+func (x *FrontendUnscheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendWatchAssetBundlesRequest) ProtoMessage() {}
+func (*FrontendUnscheduleResponse) ProtoMessage() {}
 
-func (x *FrontendWatchAssetBundlesRequest) ProtoReflect() protoreflect.Message {
+func (x *FrontendUnscheduleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3773,23 +4050,22 @@ func (x *FrontendWatchAssetBundlesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendWatchAssetBundlesRequest.ProtoReflect.Descriptor instead.
-func (*FrontendWatchAssetBundlesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FrontendUnscheduleResponse.ProtoReflect.Descriptor instead.
+func (*FrontendUnscheduleResponse) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{58}
 }
 
-type FrontendWatchAssetBundlesResponse struct {
+type FrontendSubscribeListingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NetworkId   uint64       `protobuf:"varint,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
-	NetworkKey  []byte       `protobuf:"bytes,2,opt,name=network_key,json=networkKey,proto3" json:"network_key,omitempty"`
-	AssetBundle *AssetBundle `protobuf:"bytes,3,opt,name=asset_bundle,json=assetBundle,proto3" json:"asset_bundle,omitempty"`
+	NetworkKey []byte        `protobuf:"bytes,1,opt,name=network_key,json=networkKey,proto3" json:"network_key,omitempty"`
+	Query      *ListingQuery `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
 }
 
-func (x *FrontendWatchAssetBundlesResponse) Reset() {
-	*x = FrontendWatchAssetBundlesResponse{}
+func (x *FrontendSubscribeListingRequest) Reset() {
+	*x = FrontendSubscribeListingRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3797,13 +4073,13 @@ func (x *FrontendWatchAssetBundlesResponse) Reset() {
 	}
 }
 
-func (x *FrontendWatchAssetBundlesResponse) String() string {
+func (x *FrontendSubscribeListingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendWatchAssetBundlesResponse) ProtoMessage() {}
+func (*FrontendSubscribeListingRequest) ProtoMessage() {}
 
-func (x *FrontendWatchAssetBundlesResponse) ProtoReflect() protoreflect.Message {
+func (x *FrontendSubscribeListingRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3815,42 +4091,35 @@ func (x *FrontendWatchAssetBundlesResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendWatchAssetBundlesResponse.ProtoReflect.Descriptor instead.
-func (*FrontendWatchAssetBundlesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FrontendSubscribeListingRequest.ProtoReflect.Descriptor instead.
+func (*FrontendSubscribeListingRequest) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *FrontendWatchAssetBundlesResponse) GetNetworkId() uint64 {
-	if x != nil {
-		return x.NetworkId
-	}
-	return 0
-}
-
-func (x *FrontendWatchAssetBundlesResponse) GetNetworkKey() []byte {
+func (x *FrontendSubscribeListingRequest) GetNetworkKey() []byte {
 	if x != nil {
 		return x.NetworkKey
 	}
 	return nil
 }
 
-func (x *FrontendWatchAssetBundlesResponse) GetAssetBundle() *AssetBundle {
+func (x *FrontendSubscribeListingRequest) GetQuery() *ListingQuery {
 	if x != nil {
-		return x.AssetBundle
+		return x.Query
 	}
 	return nil
 }
 
-type SnippetSubscribeRequest struct {
+type FrontendSubscribeListingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SwarmId []byte `protobuf:"bytes,1,opt,name=swarm_id,json=swarmId,proto3" json:"swarm_id,omitempty"`
+	Subscription *ListingSubscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
 }
 
-func (x *SnippetSubscribeRequest) Reset() {
-	*x = SnippetSubscribeRequest{}
+func (x *FrontendSubscribeListingResponse) Reset() {
+	*x = FrontendSubscribeListingResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3858,13 +4127,13 @@ func (x *SnippetSubscribeRequest) Reset() {
 	}
 }
 
-func (x *SnippetSubscribeRequest) String() string {
+func (x *FrontendSubscribeListingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SnippetSubscribeRequest) ProtoMessage() {}
+func (*FrontendSubscribeListingResponse) ProtoMessage() {}
 
-func (x *SnippetSubscribeRequest) ProtoReflect() protoreflect.Message {
+func (x *FrontendSubscribeListingResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3876,28 +4145,28 @@ func (x *SnippetSubscribeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SnippetSubscribeRequest.ProtoReflect.Descriptor instead.
-func (*SnippetSubscribeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FrontendSubscribeListingResponse.ProtoReflect.Descriptor instead.
+func (*FrontendSubscribeListingResponse) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *SnippetSubscribeRequest) GetSwarmId() []byte {
+func (x *FrontendSubscribeListingResponse) GetSubscription() *ListingSubscription {
 	if x != nil {
-		return x.SwarmId
+		return x.Subscription
 	}
 	return nil
 }
 
-type SnippetSubscribeResponse struct {
+type FrontendUnsubscribeListingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SnippetDelta *ListingSnippetDelta `protobuf:"bytes,1,opt,name=snippet_delta,json=snippetDelta,proto3" json:"snippet_delta,omitempty"`
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *SnippetSubscribeResponse) Reset() {
-	*x = SnippetSubscribeResponse{}
+func (x *FrontendUnsubscribeListingRequest) Reset() {
+	*x = FrontendUnsubscribeListingRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3905,13 +4174,13 @@ func (x *SnippetSubscribeResponse) Reset() {
 	}
 }
 
-func (x *SnippetSubscribeResponse) String() string {
+func (x *FrontendUnsubscribeListingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SnippetSubscribeResponse) ProtoMessage() {}
+func (*FrontendUnsubscribeListingRequest) ProtoMessage() {}
 
-func (x *SnippetSubscribeResponse) ProtoReflect() protoreflect.Message {
+func (x *FrontendUnsubscribeListingRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3923,31 +4192,26 @@ func (x *SnippetSubscribeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SnippetSubscribeResponse.ProtoReflect.Descriptor instead.
-func (*SnippetSubscribeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FrontendUnsubscribeListingRequest.ProtoReflect.Descriptor instead.
+func (*FrontendUnsubscribeListingRequest) Descriptor() ([]byte, []int) {
 	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *SnippetSubscribeResponse) GetSnippetDelta() *ListingSnippetDelta {
+func (x *FrontendUnsubscribeListingRequest) GetId() uint64 {
 	if x != nil {
-		return x.SnippetDelta
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type ServerConfig_Integrations struct {
+type FrontendUnsubscribeListingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Angelthump *ServerConfig_Integrations_AngelThump `protobuf:"bytes,1,opt,name=angelthump,proto3" json:"angelthump,omitempty"`
-	Twitch     *ServerConfig_Integrations_Twitch     `protobuf:"bytes,2,opt,name=twitch,proto3" json:"twitch,omitempty"`
-	Youtube    *ServerConfig_Integrations_YouTube    `protobuf:"bytes,3,opt,name=youtube,proto3" json:"youtube,omitempty"`
-	Swarm      *ServerConfig_Integrations_Swarm      `protobuf:"bytes,4,opt,name=swarm,proto3" json:"swarm,omitempty"`
 }
 
-func (x *ServerConfig_Integrations) Reset() {
-	*x = ServerConfig_Integrations{}
+func (x *FrontendUnsubscribeListingResponse) Reset() {
+	*x = FrontendUnsubscribeListingResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3955,13 +4219,13 @@ func (x *ServerConfig_Integrations) Reset() {
 	}
 }
 
-func (x *ServerConfig_Integrations) String() string {
+func (x *FrontendUnsubscribeListingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerConfig_Integrations) ProtoMessage() {}
+func (*FrontendUnsubscribeListingResponse) ProtoMessage() {}
 
-func (x *ServerConfig_Integrations) ProtoReflect() protoreflect.Message {
+func (x *FrontendUnsubscribeListingResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3973,49 +4237,19 @@ func (x *ServerConfig_Integrations) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerConfig_Integrations.ProtoReflect.Descriptor instead.
-func (*ServerConfig_Integrations) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{0, 0}
-}
-
-func (x *ServerConfig_Integrations) GetAngelthump() *ServerConfig_Integrations_AngelThump {
-	if x != nil {
-		return x.Angelthump
-	}
-	return nil
-}
-
-func (x *ServerConfig_Integrations) GetTwitch() *ServerConfig_Integrations_Twitch {
-	if x != nil {
-		return x.Twitch
-	}
-	return nil
-}
-
-func (x *ServerConfig_Integrations) GetYoutube() *ServerConfig_Integrations_YouTube {
-	if x != nil {
-		return x.Youtube
-	}
-	return nil
-}
-
-func (x *ServerConfig_Integrations) GetSwarm() *ServerConfig_Integrations_Swarm {
-	if x != nil {
-		return x.Swarm
-	}
-	return nil
+// Deprecated: Use FrontendUnsubscribeListingResponse.ProtoReflect.Descriptor instead.
+func (*FrontendUnsubscribeListingResponse) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{62}
 }
 
-type ServerConfig_Integrations_AngelThump struct {
+type FrontendListListingSubscriptionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Enable bool `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
 }
 
-func (x *ServerConfig_Integrations_AngelThump) Reset() {
-	*x = ServerConfig_Integrations_AngelThump{}
+func (x *FrontendListListingSubscriptionsRequest) Reset() {
+	*x = FrontendListListingSubscriptionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4023,13 +4257,13 @@ func (x *ServerConfig_Integrations_AngelThump) Reset() {
 	}
 }
 
-func (x *ServerConfig_Integrations_AngelThump) String() string {
+func (x *FrontendListListingSubscriptionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerConfig_Integrations_AngelThump) ProtoMessage() {}
+func (*FrontendListListingSubscriptionsRequest) ProtoMessage() {}
 
-func (x *ServerConfig_Integrations_AngelThump) ProtoReflect() protoreflect.Message {
+func (x *FrontendListListingSubscriptionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4041,30 +4275,21 @@ func (x *ServerConfig_Integrations_AngelThump) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerConfig_Integrations_AngelThump.ProtoReflect.Descriptor instead.
-func (*ServerConfig_Integrations_AngelThump) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{0, 0, 0}
-}
-
-func (x *ServerConfig_Integrations_AngelThump) GetEnable() bool {
-	if x != nil {
-		return x.Enable
-	}
-	return false
+// Deprecated: Use FrontendListListingSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*FrontendListListingSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{63}
 }
 
-type ServerConfig_Integrations_Twitch struct {
+type FrontendListListingSubscriptionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enable       bool   `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
-	ClientId     string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	ClientSecret string `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	Subscriptions []*ListingSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
 }
 
-func (x *ServerConfig_Integrations_Twitch) Reset() {
-	*x = ServerConfig_Integrations_Twitch{}
+func (x *FrontendListListingSubscriptionsResponse) Reset() {
+	*x = FrontendListListingSubscriptionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4072,13 +4297,13 @@ func (x *ServerConfig_Integrations_Twitch) Reset() {
 	}
 }
 
-func (x *ServerConfig_Integrations_Twitch) String() string {
+func (x *FrontendListListingSubscriptionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerConfig_Integrations_Twitch) ProtoMessage() {}
+func (*FrontendListListingSubscriptionsResponse) ProtoMessage() {}
 
-func (x *ServerConfig_Integrations_Twitch) ProtoReflect() protoreflect.Message {
+func (x *FrontendListListingSubscriptionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4090,43 +4315,32 @@ func (x *ServerConfig_Integrations_Twitch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerConfig_Integrations_Twitch.ProtoReflect.Descriptor instead.
-func (*ServerConfig_Integrations_Twitch) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{0, 0, 1}
-}
-
-func (x *ServerConfig_Integrations_Twitch) GetEnable() bool {
-	if x != nil {
-		return x.Enable
-	}
-	return false
-}
-
-func (x *ServerConfig_Integrations_Twitch) GetClientId() string {
-	if x != nil {
-		return x.ClientId
-	}
-	return ""
+// Deprecated: Use FrontendListListingSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*FrontendListListingSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *ServerConfig_Integrations_Twitch) GetClientSecret() string {
+func (x *FrontendListListingSubscriptionsResponse) GetSubscriptions() []*ListingSubscription {
 	if x != nil {
-		return x.ClientSecret
+		return x.Subscriptions
 	}
-	return ""
+	return nil
 }
 
-type ServerConfig_Integrations_YouTube struct {
+type FrontendGetListingStatsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enable       bool   `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
-	PublicApiKey string `protobuf:"bytes,2,opt,name=public_api_key,json=publicApiKey,proto3" json:"public_api_key,omitempty"`
+	NetworkKey   []byte   `protobuf:"bytes,1,opt,name=network_key,json=networkKey,proto3" json:"network_key,omitempty"`
+	StartTime    int64    `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime      int64    `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	PublisherKey []byte   `protobuf:"bytes,4,opt,name=publisher_key,json=publisherKey,proto3" json:"publisher_key,omitempty"`
+	Listing      *Listing `protobuf:"bytes,5,opt,name=listing,proto3" json:"listing,omitempty"`
 }
 
-func (x *ServerConfig_Integrations_YouTube) Reset() {
-	*x = ServerConfig_Integrations_YouTube{}
+func (x *FrontendGetListingStatsRequest) Reset() {
+	*x = FrontendGetListingStatsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4134,13 +4348,13 @@ func (x *ServerConfig_Integrations_YouTube) Reset() {
 	}
 }
 
-func (x *ServerConfig_Integrations_YouTube) String() string {
+func (x *FrontendGetListingStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerConfig_Integrations_YouTube) ProtoMessage() {}
+func (*FrontendGetListingStatsRequest) ProtoMessage() {}
 
-func (x *ServerConfig_Integrations_YouTube) ProtoReflect() protoreflect.Message {
+func (x *FrontendGetListingStatsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4152,35 +4366,57 @@ func (x *ServerConfig_Integrations_YouTube) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerConfig_Integrations_YouTube.ProtoReflect.Descriptor instead.
-func (*ServerConfig_Integrations_YouTube) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{0, 0, 2}
+// Deprecated: Use FrontendGetListingStatsRequest.ProtoReflect.Descriptor instead.
+func (*FrontendGetListingStatsRequest) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *ServerConfig_Integrations_YouTube) GetEnable() bool {
+func (x *FrontendGetListingStatsRequest) GetNetworkKey() []byte {
 	if x != nil {
-		return x.Enable
+		return x.NetworkKey
 	}
-	return false
+	return nil
 }
 
-func (x *ServerConfig_Integrations_YouTube) GetPublicApiKey() string {
+func (x *FrontendGetListingStatsRequest) GetStartTime() int64 {
 	if x != nil {
-		return x.PublicApiKey
+		return x.StartTime
 	}
-	return ""
+	return 0
 }
 
-type ServerConfig_Integrations_Swarm struct {
+func (x *FrontendGetListingStatsRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *FrontendGetListingStatsRequest) GetPublisherKey() []byte {
+	if x != nil {
+		return x.PublisherKey
+	}
+	return nil
+}
+
+func (x *FrontendGetListingStatsRequest) GetListing() *Listing {
+	if x != nil {
+		return x.Listing
+	}
+	return nil
+}
+
+type FrontendGetListingStatsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enable bool `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	Listings   []*ListingStatsItem   `protobuf:"bytes,1,rep,name=listings,proto3" json:"listings,omitempty"`
+	Publishers []*PublisherStatsItem `protobuf:"bytes,2,rep,name=publishers,proto3" json:"publishers,omitempty"`
 }
 
-func (x *ServerConfig_Integrations_Swarm) Reset() {
-	*x = ServerConfig_Integrations_Swarm{}
+func (x *FrontendGetListingStatsResponse) Reset() {
+	*x = FrontendGetListingStatsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4188,13 +4424,13 @@ func (x *ServerConfig_Integrations_Swarm) Reset() {
 	}
 }
 
-func (x *ServerConfig_Integrations_Swarm) String() string {
+func (x *FrontendGetListingStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerConfig_Integrations_Swarm) ProtoMessage() {}
+func (*FrontendGetListingStatsResponse) ProtoMessage() {}
 
-func (x *ServerConfig_Integrations_Swarm) ProtoReflect() protoreflect.Message {
+func (x *FrontendGetListingStatsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4206,31 +4442,35 @@ func (x *ServerConfig_Integrations_Swarm) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerConfig_Integrations_Swarm.ProtoReflect.Descriptor instead.
-func (*ServerConfig_Integrations_Swarm) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{0, 0, 3}
+// Deprecated: Use FrontendGetListingStatsResponse.ProtoReflect.Descriptor instead.
+func (*FrontendGetListingStatsResponse) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *ServerConfig_Integrations_Swarm) GetEnable() bool {
+func (x *FrontendGetListingStatsResponse) GetListings() []*ListingStatsItem {
 	if x != nil {
-		return x.Enable
+		return x.Listings
 	}
-	return false
+	return nil
 }
 
-type ClientConfig_Integrations struct {
+func (x *FrontendGetListingStatsResponse) GetPublishers() []*PublisherStatsItem {
+	if x != nil {
+		return x.Publishers
+	}
+	return nil
+}
+
+type FrontendTestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Angelthump bool `protobuf:"varint,1,opt,name=angelthump,proto3" json:"angelthump,omitempty"`
-	Twitch     bool `protobuf:"varint,2,opt,name=twitch,proto3" json:"twitch,omitempty"`
-	Youtube    bool `protobuf:"varint,3,opt,name=youtube,proto3" json:"youtube,omitempty"`
-	Swarm      bool `protobuf:"varint,4,opt,name=swarm,proto3" json:"swarm,omitempty"`
+	NetworkKey []byte `protobuf:"bytes,1,opt,name=network_key,json=networkKey,proto3" json:"network_key,omitempty"`
 }
 
-func (x *ClientConfig_Integrations) Reset() {
-	*x = ClientConfig_Integrations{}
+func (x *FrontendTestRequest) Reset() {
+	*x = FrontendTestRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4238,13 +4478,13 @@ func (x *ClientConfig_Integrations) Reset() {
 	}
 }
 
-func (x *ClientConfig_Integrations) String() string {
+func (x *FrontendTestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClientConfig_Integrations) ProtoMessage() {}
+func (*FrontendTestRequest) ProtoMessage() {}
 
-func (x *ClientConfig_Integrations) ProtoReflect() protoreflect.Message {
+func (x *FrontendTestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4256,50 +4496,26 @@ func (x *ClientConfig_Integrations) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClientConfig_Integrations.ProtoReflect.Descriptor instead.
-func (*ClientConfig_Integrations) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{1, 0}
-}
-
-func (x *ClientConfig_Integrations) GetAngelthump() bool {
-	if x != nil {
-		return x.Angelthump
-	}
-	return false
-}
-
-func (x *ClientConfig_Integrations) GetTwitch() bool {
-	if x != nil {
-		return x.Twitch
-	}
-	return false
-}
-
-func (x *ClientConfig_Integrations) GetYoutube() bool {
-	if x != nil {
-		return x.Youtube
-	}
-	return false
+// Deprecated: Use FrontendTestRequest.ProtoReflect.Descriptor instead.
+func (*FrontendTestRequest) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *ClientConfig_Integrations) GetSwarm() bool {
+func (x *FrontendTestRequest) GetNetworkKey() []byte {
 	if x != nil {
-		return x.Swarm
+		return x.NetworkKey
 	}
-	return false
+	return nil
 }
 
-type Listing_Media struct {
+type FrontendTestResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	MimeType string `protobuf:"bytes,1,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
-	SwarmUri string `protobuf:"bytes,2,opt,name=swarm_uri,json=swarmUri,proto3" json:"swarm_uri,omitempty"`
 }
 
-func (x *Listing_Media) Reset() {
-	*x = Listing_Media{}
+func (x *FrontendTestResponse) Reset() {
+	*x = FrontendTestResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4307,13 +4523,13 @@ func (x *Listing_Media) Reset() {
 	}
 }
 
-func (x *Listing_Media) String() string {
+func (x *FrontendTestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Listing_Media) ProtoMessage() {}
+func (*FrontendTestResponse) ProtoMessage() {}
 
-func (x *Listing_Media) ProtoReflect() protoreflect.Message {
+func (x *FrontendTestResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4325,36 +4541,23 @@ func (x *Listing_Media) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Listing_Media.ProtoReflect.Descriptor instead.
-func (*Listing_Media) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{5, 0}
-}
-
-func (x *Listing_Media) GetMimeType() string {
-	if x != nil {
-		return x.MimeType
-	}
-	return ""
-}
-
-func (x *Listing_Media) GetSwarmUri() string {
-	if x != nil {
-		return x.SwarmUri
-	}
-	return ""
+// Deprecated: Use FrontendTestResponse.ProtoReflect.Descriptor instead.
+func (*FrontendTestResponse) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{68}
 }
 
-type Listing_Service struct {
+type FrontendModerateListingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type     string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	SwarmUri string `protobuf:"bytes,2,opt,name=swarm_uri,json=swarmUri,proto3" json:"swarm_uri,omitempty"`
+	NetworkKey []byte             `protobuf:"bytes,1,opt,name=network_key,json=networkKey,proto3" json:"network_key,omitempty"`
+	Id         uint64             `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Moderation *ListingModeration `protobuf:"bytes,3,opt,name=moderation,proto3" json:"moderation,omitempty"`
 }
 
-func (x *Listing_Service) Reset() {
-	*x = Listing_Service{}
+func (x *FrontendModerateListingRequest) Reset() {
+	*x = FrontendModerateListingRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4362,13 +4565,13 @@ func (x *Listing_Service) Reset() {
 	}
 }
 
-func (x *Listing_Service) String() string {
+func (x *FrontendModerateListingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Listing_Service) ProtoMessage() {}
+func (*FrontendModerateListingRequest) ProtoMessage() {}
 
-func (x *Listing_Service) ProtoReflect() protoreflect.Message {
+func (x *FrontendModerateListingRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4380,37 +4583,40 @@ func (x *Listing_Service) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Listing_Service.ProtoReflect.Descriptor instead.
-func (*Listing_Service) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{5, 1}
+// Deprecated: Use FrontendModerateListingRequest.ProtoReflect.Descriptor instead.
+func (*FrontendModerateListingRequest) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *Listing_Service) GetType() string {
+func (x *FrontendModerateListingRequest) GetNetworkKey() []byte {
 	if x != nil {
-		return x.Type
+		return x.NetworkKey
 	}
-	return ""
+	return nil
 }
 
-func (x *Listing_Service) GetSwarmUri() string {
+func (x *FrontendModerateListingRequest) GetId() uint64 {
 	if x != nil {
-		return x.SwarmUri
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type Listing_Embed struct {
+func (x *FrontendModerateListingRequest) GetModeration() *ListingModeration {
+	if x != nil {
+		return x.Moderation
+	}
+	return nil
+}
+
+type FrontendModerateListingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Service     Listing_Embed_Service `protobuf:"varint,1,opt,name=service,proto3,enum=strims.network.v1.directory.Listing_Embed_Service" json:"service,omitempty"`
-	Id          string                `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	QueryParams map[string]string     `protobuf:"bytes,3,rep,name=query_params,json=queryParams,proto3" json:"query_params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *Listing_Embed) Reset() {
-	*x = Listing_Embed{}
+func (x *FrontendModerateListingResponse) Reset() {
+	*x = FrontendModerateListingResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4418,13 +4624,13 @@ func (x *Listing_Embed) Reset() {
 	}
 }
 
-func (x *Listing_Embed) String() string {
+func (x *FrontendModerateListingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Listing_Embed) ProtoMessage() {}
+func (*FrontendModerateListingResponse) ProtoMessage() {}
 
-func (x *Listing_Embed) ProtoReflect() protoreflect.Message {
+func (x *FrontendModerateListingResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4436,43 +4642,23 @@ func (x *Listing_Embed) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Listing_Embed.ProtoReflect.Descriptor instead.
-func (*Listing_Embed) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{5, 2}
-}
-
-func (x *Listing_Embed) GetService() Listing_Embed_Service {
-	if x != nil {
-		return x.Service
-	}
-	return Listing_Embed_DIRECTORY_LISTING_EMBED_SERVICE_UNDEFINED
-}
-
-func (x *Listing_Embed) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Listing_Embed) GetQueryParams() map[string]string {
-	if x != nil {
-		return x.QueryParams
-	}
-	return nil
+// Deprecated: Use FrontendModerateListingResponse.ProtoReflect.Descriptor instead.
+func (*FrontendModerateListingResponse) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{70}
 }
 
-type Listing_Chat struct {
+type FrontendModerateUserRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key  []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	NetworkKey []byte          `protobuf:"bytes,1,opt,name=network_key,json=networkKey,proto3" json:"network_key,omitempty"`
+	Alias      string          `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	Moderation *UserModeration `protobuf:"bytes,3,opt,name=moderation,proto3" json:"moderation,omitempty"`
 }
 
-func (x *Listing_Chat) Reset() {
-	*x = Listing_Chat{}
+func (x *FrontendModerateUserRequest) Reset() {
+	*x = FrontendModerateUserRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4480,13 +4666,13 @@ func (x *Listing_Chat) Reset() {
 	}
 }
 
-func (x *Listing_Chat) String() string {
+func (x *FrontendModerateUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Listing_Chat) ProtoMessage() {}
+func (*FrontendModerateUserRequest) ProtoMessage() {}
 
-func (x *Listing_Chat) ProtoReflect() protoreflect.Message {
+func (x *FrontendModerateUserRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4498,50 +4684,55 @@ func (x *Listing_Chat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Listing_Chat.ProtoReflect.Descriptor instead.
-func (*Listing_Chat) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{5, 3}
+// Deprecated: Use FrontendModerateUserRequest.ProtoReflect.Descriptor instead.
+func (*FrontendModerateUserRequest) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *Listing_Chat) GetKey() []byte {
+func (x *FrontendModerateUserRequest) GetNetworkKey() []byte {
 	if x != nil {
-		return x.Key
+		return x.NetworkKey
 	}
 	return nil
 }
 
-func (x *Listing_Chat) GetName() string {
+func (x *FrontendModerateUserRequest) GetAlias() string {
 	if x != nil {
-		return x.Name
+		return x.Alias
 	}
 	return ""
 }
 
-type ListingSnippetDelta_Tags struct {
+func (x *FrontendModerateUserRequest) GetModeration() *UserModeration {
+	if x != nil {
+		return x.Moderation
+	}
+	return nil
+}
+
+type FrontendModerateUserResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Tags []string `protobuf:"bytes,1001,rep,name=tags,proto3" json:"tags,omitempty"`
 }
 
-func (x *ListingSnippetDelta_Tags) Reset() {
-	*x = ListingSnippetDelta_Tags{}
+func (x *FrontendModerateUserResponse) Reset() {
+	*x = FrontendModerateUserResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_directory_directory_proto_msgTypes[73]
+		mi := &file_network_v1_directory_directory_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListingSnippetDelta_Tags) String() string {
+func (x *FrontendModerateUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListingSnippetDelta_Tags) ProtoMessage() {}
+func (*FrontendModerateUserResponse) ProtoMessage() {}
 
-func (x *ListingSnippetDelta_Tags) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_directory_directory_proto_msgTypes[73]
+func (x *FrontendModerateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4552,46 +4743,34 @@ func (x *ListingSnippetDelta_Tags) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListingSnippetDelta_Tags.ProtoReflect.Descriptor instead.
-func (*ListingSnippetDelta_Tags) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{8, 0}
-}
-
-func (x *ListingSnippetDelta_Tags) GetTags() []string {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
+// Deprecated: Use FrontendModerateUserResponse.ProtoReflect.Descriptor instead.
+func (*FrontendModerateUserResponse) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{72}
 }
 
-type Event_ListingChange struct {
+type FrontendGetUsersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id         uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Listing    *Listing           `protobuf:"bytes,2,opt,name=listing,proto3" json:"listing,omitempty"`
-	Snippet    *ListingSnippet    `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
-	Moderation *ListingModeration `protobuf:"bytes,4,opt,name=moderation,proto3" json:"moderation,omitempty"`
 }
 
-func (x *Event_ListingChange) Reset() {
-	*x = Event_ListingChange{}
+func (x *FrontendGetUsersRequest) Reset() {
+	*x = FrontendGetUsersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_directory_directory_proto_msgTypes[74]
+		mi := &file_network_v1_directory_directory_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Event_ListingChange) String() string {
+func (x *FrontendGetUsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event_ListingChange) ProtoMessage() {}
+func (*FrontendGetUsersRequest) ProtoMessage() {}
 
-func (x *Event_ListingChange) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_directory_directory_proto_msgTypes[74]
+func (x *FrontendGetUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4602,49 +4781,77 @@ func (x *Event_ListingChange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event_ListingChange.ProtoReflect.Descriptor instead.
-func (*Event_ListingChange) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{9, 0}
+// Deprecated: Use FrontendGetUsersRequest.ProtoReflect.Descriptor instead.
+func (*FrontendGetUsersRequest) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *Event_ListingChange) GetId() uint64 {
-	if x != nil {
-		return x.Id
+type FrontendGetUsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users    []*FrontendGetUsersResponse_User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Networks map[uint64]*Network              `protobuf:"bytes,2,rep,name=networks,proto3" json:"networks,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *FrontendGetUsersResponse) Reset() {
+	*x = FrontendGetUsersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Event_ListingChange) GetListing() *Listing {
-	if x != nil {
-		return x.Listing
+func (x *FrontendGetUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrontendGetUsersResponse) ProtoMessage() {}
+
+func (x *FrontendGetUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Event_ListingChange) GetSnippet() *ListingSnippet {
+// Deprecated: Use FrontendGetUsersResponse.ProtoReflect.Descriptor instead.
+func (*FrontendGetUsersResponse) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *FrontendGetUsersResponse) GetUsers() []*FrontendGetUsersResponse_User {
 	if x != nil {
-		return x.Snippet
+		return x.Users
 	}
 	return nil
 }
 
-func (x *Event_ListingChange) GetModeration() *ListingModeration {
+func (x *FrontendGetUsersResponse) GetNetworks() map[uint64]*Network {
 	if x != nil {
-		return x.Moderation
+		return x.Networks
 	}
 	return nil
 }
 
-type Event_Unpublish struct {
+type FrontendGetListingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Query      *ListingQuery `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	NetworkKey []byte        `protobuf:"bytes,2,opt,name=network_key,json=networkKey,proto3" json:"network_key,omitempty"`
 }
 
-func (x *Event_Unpublish) Reset() {
-	*x = Event_Unpublish{}
+func (x *FrontendGetListingRequest) Reset() {
+	*x = FrontendGetListingRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4652,13 +4859,13 @@ func (x *Event_Unpublish) Reset() {
 	}
 }
 
-func (x *Event_Unpublish) String() string {
+func (x *FrontendGetListingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event_Unpublish) ProtoMessage() {}
+func (*FrontendGetListingRequest) ProtoMessage() {}
 
-func (x *Event_Unpublish) ProtoReflect() protoreflect.Message {
+func (x *FrontendGetListingRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4670,30 +4877,42 @@ func (x *Event_Unpublish) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event_Unpublish.ProtoReflect.Descriptor instead.
-func (*Event_Unpublish) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{9, 1}
+// Deprecated: Use FrontendGetListingRequest.ProtoReflect.Descriptor instead.
+func (*FrontendGetListingRequest) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *Event_Unpublish) GetId() uint64 {
+func (x *FrontendGetListingRequest) GetQuery() *ListingQuery {
 	if x != nil {
-		return x.Id
+		return x.Query
 	}
-	return 0
+	return nil
 }
 
-type Event_UserCountChange struct {
+func (x *FrontendGetListingRequest) GetNetworkKey() []byte {
+	if x != nil {
+		return x.NetworkKey
+	}
+	return nil
+}
+
+type FrontendGetListingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id              uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserCount       uint32 `protobuf:"varint,2,opt,name=user_count,json=userCount,proto3" json:"user_count,omitempty"`
-	RecentUserCount uint32 `protobuf:"varint,3,opt,name=recent_user_count,json=recentUserCount,proto3" json:"recent_user_count,omitempty"`
+	Id              uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Listing         *Listing           `protobuf:"bytes,2,opt,name=listing,proto3" json:"listing,omitempty"`
+	Snippet         *ListingSnippet    `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	Moderation      *ListingModeration `protobuf:"bytes,4,opt,name=moderation,proto3" json:"moderation,omitempty"`
+	UserCount       uint32             `protobuf:"varint,5,opt,name=user_count,json=userCount,proto3" json:"user_count,omitempty"`
+	RecentUserCount uint32             `protobuf:"varint,6,opt,name=recent_user_count,json=recentUserCount,proto3" json:"recent_user_count,omitempty"`
+	Upcoming        bool               `protobuf:"varint,7,opt,name=upcoming,proto3" json:"upcoming,omitempty"`
+	Provenance      *ListingProvenance `protobuf:"bytes,8,opt,name=provenance,proto3" json:"provenance,omitempty"`
 }
 
-func (x *Event_UserCountChange) Reset() {
-	*x = Event_UserCountChange{}
+func (x *FrontendGetListingResponse) Reset() {
+	*x = FrontendGetListingResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4701,13 +4920,13 @@ func (x *Event_UserCountChange) Reset() {
 	}
 }
 
-func (x *Event_UserCountChange) String() string {
+func (x *FrontendGetListingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event_UserCountChange) ProtoMessage() {}
+func (*FrontendGetListingResponse) ProtoMessage() {}
 
-func (x *Event_UserCountChange) ProtoReflect() protoreflect.Message {
+func (x *FrontendGetListingResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4719,46 +4938,80 @@ func (x *Event_UserCountChange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event_UserCountChange.ProtoReflect.Descriptor instead.
-func (*Event_UserCountChange) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{9, 2}
+// Deprecated: Use FrontendGetListingResponse.ProtoReflect.Descriptor instead.
+func (*FrontendGetListingResponse) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *Event_UserCountChange) GetId() uint64 {
+func (x *FrontendGetListingResponse) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Event_UserCountChange) GetUserCount() uint32 {
+func (x *FrontendGetListingResponse) GetListing() *Listing {
+	if x != nil {
+		return x.Listing
+	}
+	return nil
+}
+
+func (x *FrontendGetListingResponse) GetSnippet() *ListingSnippet {
+	if x != nil {
+		return x.Snippet
+	}
+	return nil
+}
+
+func (x *FrontendGetListingResponse) GetModeration() *ListingModeration {
+	if x != nil {
+		return x.Moderation
+	}
+	return nil
+}
+
+func (x *FrontendGetListingResponse) GetUserCount() uint32 {
 	if x != nil {
 		return x.UserCount
 	}
 	return 0
 }
 
-func (x *Event_UserCountChange) GetRecentUserCount() uint32 {
+func (x *FrontendGetListingResponse) GetRecentUserCount() uint32 {
 	if x != nil {
 		return x.RecentUserCount
 	}
 	return 0
 }
 
-type Event_UserPresenceChange struct {
+func (x *FrontendGetListingResponse) GetUpcoming() bool {
+	if x != nil {
+		return x.Upcoming
+	}
+	return false
+}
+
+func (x *FrontendGetListingResponse) GetProvenance() *ListingProvenance {
+	if x != nil {
+		return x.Provenance
+	}
+	return nil
+}
+
+type ListingFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Alias      string   `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
-	PeerKey    []byte   `protobuf:"bytes,3,opt,name=peer_key,json=peerKey,proto3" json:"peer_key,omitempty"`
-	Online     bool     `protobuf:"varint,4,opt,name=online,proto3" json:"online,omitempty"`
-	ListingIds []uint64 `protobuf:"varint,5,rep,packed,name=listing_ids,json=listingIds,proto3" json:"listing_ids,omitempty"`
+	Search       string               `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	Tags         []string             `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	Categories   []string             `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"`
+	ContentTypes []ListingContentType `protobuf:"varint,4,rep,packed,name=content_types,json=contentTypes,proto3,enum=strims.network.v1.directory.ListingContentType" json:"content_types,omitempty"`
 }
 
-func (x *Event_UserPresenceChange) Reset() {
-	*x = Event_UserPresenceChange{}
+func (x *ListingFilter) Reset() {
+	*x = ListingFilter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4766,13 +5019,13 @@ func (x *Event_UserPresenceChange) Reset() {
 	}
 }
 
-func (x *Event_UserPresenceChange) String() string {
+func (x *ListingFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event_UserPresenceChange) ProtoMessage() {}
+func (*ListingFilter) ProtoMessage() {}
 
-func (x *Event_UserPresenceChange) ProtoReflect() protoreflect.Message {
+func (x *ListingFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4784,56 +5037,52 @@ func (x *Event_UserPresenceChange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event_UserPresenceChange.ProtoReflect.Descriptor instead.
-func (*Event_UserPresenceChange) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{9, 3}
-}
-
-func (x *Event_UserPresenceChange) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use ListingFilter.ProtoReflect.Descriptor instead.
+func (*ListingFilter) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *Event_UserPresenceChange) GetAlias() string {
+func (x *ListingFilter) GetSearch() string {
 	if x != nil {
-		return x.Alias
+		return x.Search
 	}
 	return ""
 }
 
-func (x *Event_UserPresenceChange) GetPeerKey() []byte {
+func (x *ListingFilter) GetTags() []string {
 	if x != nil {
-		return x.PeerKey
+		return x.Tags
 	}
 	return nil
 }
 
-func (x *Event_UserPresenceChange) GetOnline() bool {
+func (x *ListingFilter) GetCategories() []string {
 	if x != nil {
-		return x.Online
+		return x.Categories
 	}
-	return false
+	return nil
 }
 
-func (x *Event_UserPresenceChange) GetListingIds() []uint64 {
+func (x *ListingFilter) GetContentTypes() []ListingContentType {
 	if x != nil {
-		return x.ListingIds
+		return x.ContentTypes
 	}
 	return nil
 }
 
-type Event_Ping struct {
+type FrontendGetListingsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Time int64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	ContentTypes []ListingContentType `protobuf:"varint,1,rep,packed,name=content_types,json=contentTypes,proto3,enum=strims.network.v1.directory.ListingContentType" json:"content_types,omitempty"`
+	NetworkKeys  [][]byte             `protobuf:"bytes,2,rep,name=network_keys,json=networkKeys,proto3" json:"network_keys,omitempty"`
+	Filter       *ListingFilter       `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	SortOrder    ListingSortOrder     `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3,enum=strims.network.v1.directory.ListingSortOrder" json:"sort_order,omitempty"`
 }
 
-func (x *Event_Ping) Reset() {
-	*x = Event_Ping{}
+func (x *FrontendGetListingsRequest) Reset() {
+	*x = FrontendGetListingsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4841,13 +5090,13 @@ func (x *Event_Ping) Reset() {
 	}
 }
 
-func (x *Event_Ping) String() string {
+func (x *FrontendGetListingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event_Ping) ProtoMessage() {}
+func (*FrontendGetListingsRequest) ProtoMessage() {}
 
-func (x *Event_Ping) ProtoReflect() protoreflect.Message {
+func (x *FrontendGetListingsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4859,29 +5108,49 @@ func (x *Event_Ping) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event_Ping.ProtoReflect.Descriptor instead.
-func (*Event_Ping) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{9, 4}
+// Deprecated: Use FrontendGetListingsRequest.ProtoReflect.Descriptor instead.
+func (*FrontendGetListingsRequest) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *Event_Ping) GetTime() int64 {
+func (x *FrontendGetListingsRequest) GetContentTypes() []ListingContentType {
 	if x != nil {
-		return x.Time
+		return x.ContentTypes
 	}
-	return 0
+	return nil
 }
 
-type FrontendGetUsersResponse_Alias struct {
+func (x *FrontendGetListingsRequest) GetNetworkKeys() [][]byte {
+	if x != nil {
+		return x.NetworkKeys
+	}
+	return nil
+}
+
+func (x *FrontendGetListingsRequest) GetFilter() *ListingFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *FrontendGetListingsRequest) GetSortOrder() ListingSortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ListingSortOrder_LISTING_SORT_ORDER_UNDEFINED
+}
+
+type FrontendGetListingsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Alias      string   `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
-	NetworkIds []uint64 `protobuf:"varint,2,rep,packed,name=network_ids,json=networkIds,proto3" json:"network_ids,omitempty"`
+	Listings []*NetworkListings `protobuf:"bytes,1,rep,name=listings,proto3" json:"listings,omitempty"`
 }
 
-func (x *FrontendGetUsersResponse_Alias) Reset() {
-	*x = FrontendGetUsersResponse_Alias{}
+func (x *FrontendGetListingsResponse) Reset() {
+	*x = FrontendGetListingsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4889,13 +5158,13 @@ func (x *FrontendGetUsersResponse_Alias) Reset() {
 	}
 }
 
-func (x *FrontendGetUsersResponse_Alias) String() string {
+func (x *FrontendGetListingsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendGetUsersResponse_Alias) ProtoMessage() {}
+func (*FrontendGetListingsResponse) ProtoMessage() {}
 
-func (x *FrontendGetUsersResponse_Alias) ProtoReflect() protoreflect.Message {
+func (x *FrontendGetListingsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4907,36 +5176,32 @@ func (x *FrontendGetUsersResponse_Alias) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendGetUsersResponse_Alias.ProtoReflect.Descriptor instead.
-func (*FrontendGetUsersResponse_Alias) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{49, 0}
+// Deprecated: Use FrontendGetListingsResponse.ProtoReflect.Descriptor instead.
+func (*FrontendGetListingsResponse) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *FrontendGetUsersResponse_Alias) GetAlias() string {
+func (x *FrontendGetListingsResponse) GetListings() []*NetworkListings {
 	if x != nil {
-		return x.Alias
+		return x.Listings
 	}
-	return ""
+	return nil
 }
 
-func (x *FrontendGetUsersResponse_Alias) GetNetworkIds() []uint64 {
-	if x != nil {
-		return x.NetworkIds
-	}
-	return nil
-}
-
-type FrontendGetUsersResponse_User struct {
+type FrontendWatchListingsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Aliases []*FrontendGetUsersResponse_Alias `protobuf:"bytes,1,rep,name=aliases,proto3" json:"aliases,omitempty"`
-	PeerKey []byte                            `protobuf:"bytes,2,opt,name=peer_key,json=peerKey,proto3" json:"peer_key,omitempty"`
+	ContentTypes []ListingContentType `protobuf:"varint,1,rep,packed,name=content_types,json=contentTypes,proto3,enum=strims.network.v1.directory.ListingContentType" json:"content_types,omitempty"`
+	NetworkKeys  [][]byte             `protobuf:"bytes,2,rep,name=network_keys,json=networkKeys,proto3" json:"network_keys,omitempty"`
+	ListingId    uint64               `protobuf:"varint,3,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	Filter       *ListingFilter       `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	SortOrder    ListingSortOrder     `protobuf:"varint,5,opt,name=sort_order,json=sortOrder,proto3,enum=strims.network.v1.directory.ListingSortOrder" json:"sort_order,omitempty"`
 }
 
-func (x *FrontendGetUsersResponse_User) Reset() {
-	*x = FrontendGetUsersResponse_User{}
+func (x *FrontendWatchListingsRequest) Reset() {
+	*x = FrontendWatchListingsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4944,13 +5209,13 @@ func (x *FrontendGetUsersResponse_User) Reset() {
 	}
 }
 
-func (x *FrontendGetUsersResponse_User) String() string {
+func (x *FrontendWatchListingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendGetUsersResponse_User) ProtoMessage() {}
+func (*FrontendWatchListingsRequest) ProtoMessage() {}
 
-func (x *FrontendGetUsersResponse_User) ProtoReflect() protoreflect.Message {
+func (x *FrontendWatchListingsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4962,50 +5227,71 @@ func (x *FrontendGetUsersResponse_User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendGetUsersResponse_User.ProtoReflect.Descriptor instead.
-func (*FrontendGetUsersResponse_User) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{49, 1}
+// Deprecated: Use FrontendWatchListingsRequest.ProtoReflect.Descriptor instead.
+func (*FrontendWatchListingsRequest) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *FrontendGetUsersResponse_User) GetAliases() []*FrontendGetUsersResponse_Alias {
+func (x *FrontendWatchListingsRequest) GetContentTypes() []ListingContentType {
 	if x != nil {
-		return x.Aliases
+		return x.ContentTypes
 	}
 	return nil
 }
 
-func (x *FrontendGetUsersResponse_User) GetPeerKey() []byte {
+func (x *FrontendWatchListingsRequest) GetNetworkKeys() [][]byte {
 	if x != nil {
-		return x.PeerKey
+		return x.NetworkKeys
 	}
 	return nil
 }
 
-type FrontendWatchListingsResponse_Change struct {
+func (x *FrontendWatchListingsRequest) GetListingId() uint64 {
+	if x != nil {
+		return x.ListingId
+	}
+	return 0
+}
+
+func (x *FrontendWatchListingsRequest) GetFilter() *ListingFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *FrontendWatchListingsRequest) GetSortOrder() ListingSortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ListingSortOrder_LISTING_SORT_ORDER_UNDEFINED
+}
+
+type FrontendWatchListingsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Listings *NetworkListings `protobuf:"bytes,1,opt,name=listings,proto3" json:"listings,omitempty"`
+	Events []*FrontendWatchListingsResponse_Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 }
 
-func (x *FrontendWatchListingsResponse_Change) Reset() {
-	*x = FrontendWatchListingsResponse_Change{}
+func (x *FrontendWatchListingsResponse) Reset() {
+	*x = FrontendWatchListingsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_directory_directory_proto_msgTypes[82]
+		mi := &file_network_v1_directory_directory_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FrontendWatchListingsResponse_Change) String() string {
+func (x *FrontendWatchListingsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendWatchListingsResponse_Change) ProtoMessage() {}
+func (*FrontendWatchListingsResponse) ProtoMessage() {}
 
-func (x *FrontendWatchListingsResponse_Change) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_directory_directory_proto_msgTypes[82]
+func (x *FrontendWatchListingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5016,44 +5302,44 @@ func (x *FrontendWatchListingsResponse_Change) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendWatchListingsResponse_Change.ProtoReflect.Descriptor instead.
-func (*FrontendWatchListingsResponse_Change) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{55, 0}
+// Deprecated: Use FrontendWatchListingsResponse.ProtoReflect.Descriptor instead.
+func (*FrontendWatchListingsResponse) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *FrontendWatchListingsResponse_Change) GetListings() *NetworkListings {
+func (x *FrontendWatchListingsResponse) GetEvents() []*FrontendWatchListingsResponse_Event {
 	if x != nil {
-		return x.Listings
+		return x.Events
 	}
 	return nil
 }
 
-type FrontendWatchListingsResponse_Unpublish struct {
+type FrontendWatchListingUsersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NetworkId uint64 `protobuf:"varint,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
-	ListingId uint64 `protobuf:"varint,2,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	NetworkKey []byte        `protobuf:"bytes,1,opt,name=network_key,json=networkKey,proto3" json:"network_key,omitempty"`
+	Query      *ListingQuery `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
 }
 
-func (x *FrontendWatchListingsResponse_Unpublish) Reset() {
-	*x = FrontendWatchListingsResponse_Unpublish{}
+func (x *FrontendWatchListingUsersRequest) Reset() {
+	*x = FrontendWatchListingUsersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_directory_directory_proto_msgTypes[83]
+		mi := &file_network_v1_directory_directory_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FrontendWatchListingsResponse_Unpublish) String() string {
+func (x *FrontendWatchListingUsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendWatchListingsResponse_Unpublish) ProtoMessage() {}
+func (*FrontendWatchListingUsersRequest) ProtoMessage() {}
 
-func (x *FrontendWatchListingsResponse_Unpublish) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_directory_directory_proto_msgTypes[83]
+func (x *FrontendWatchListingUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5064,53 +5350,51 @@ func (x *FrontendWatchListingsResponse_Unpublish) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendWatchListingsResponse_Unpublish.ProtoReflect.Descriptor instead.
-func (*FrontendWatchListingsResponse_Unpublish) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{55, 1}
+// Deprecated: Use FrontendWatchListingUsersRequest.ProtoReflect.Descriptor instead.
+func (*FrontendWatchListingUsersRequest) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *FrontendWatchListingsResponse_Unpublish) GetNetworkId() uint64 {
+func (x *FrontendWatchListingUsersRequest) GetNetworkKey() []byte {
 	if x != nil {
-		return x.NetworkId
+		return x.NetworkKey
 	}
-	return 0
+	return nil
 }
 
-func (x *FrontendWatchListingsResponse_Unpublish) GetListingId() uint64 {
+func (x *FrontendWatchListingUsersRequest) GetQuery() *ListingQuery {
 	if x != nil {
-		return x.ListingId
+		return x.Query
 	}
-	return 0
+	return nil
 }
 
-type FrontendWatchListingsResponse_UserCountChange struct {
+type FrontendWatchListingUsersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NetworkId       uint64 `protobuf:"varint,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
-	ListingId       uint64 `protobuf:"varint,2,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
-	UserCount       uint32 `protobuf:"varint,3,opt,name=user_count,json=userCount,proto3" json:"user_count,omitempty"`
-	RecentUserCount uint32 `protobuf:"varint,4,opt,name=recent_user_count,json=recentUserCount,proto3" json:"recent_user_count,omitempty"`
+	Type  FrontendWatchListingUsersResponse_UserEventType `protobuf:"varint,1,opt,name=type,proto3,enum=strims.network.v1.directory.FrontendWatchListingUsersResponse_UserEventType" json:"type,omitempty"`
+	Users []*FrontendWatchListingUsersResponse_User       `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
 }
 
-func (x *FrontendWatchListingsResponse_UserCountChange) Reset() {
-	*x = FrontendWatchListingsResponse_UserCountChange{}
+func (x *FrontendWatchListingUsersResponse) Reset() {
+	*x = FrontendWatchListingUsersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_directory_directory_proto_msgTypes[84]
+		mi := &file_network_v1_directory_directory_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FrontendWatchListingsResponse_UserCountChange) String() string {
+func (x *FrontendWatchListingUsersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendWatchListingsResponse_UserCountChange) ProtoMessage() {}
+func (*FrontendWatchListingUsersResponse) ProtoMessage() {}
 
-func (x *FrontendWatchListingsResponse_UserCountChange) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_directory_directory_proto_msgTypes[84]
+func (x *FrontendWatchListingUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5121,53 +5405,75 @@ func (x *FrontendWatchListingsResponse_UserCountChange) ProtoReflect() protorefl
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendWatchListingsResponse_UserCountChange.ProtoReflect.Descriptor instead.
-func (*FrontendWatchListingsResponse_UserCountChange) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{55, 2}
+// Deprecated: Use FrontendWatchListingUsersResponse.ProtoReflect.Descriptor instead.
+func (*FrontendWatchListingUsersResponse) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *FrontendWatchListingsResponse_UserCountChange) GetNetworkId() uint64 {
+func (x *FrontendWatchListingUsersResponse) GetType() FrontendWatchListingUsersResponse_UserEventType {
 	if x != nil {
-		return x.NetworkId
+		return x.Type
 	}
-	return 0
+	return FrontendWatchListingUsersResponse_USER_EVENT_TYPE_JOIN
 }
 
-func (x *FrontendWatchListingsResponse_UserCountChange) GetListingId() uint64 {
+func (x *FrontendWatchListingUsersResponse) GetUsers() []*FrontendWatchListingUsersResponse_User {
 	if x != nil {
-		return x.ListingId
+		return x.Users
 	}
-	return 0
+	return nil
 }
 
-func (x *FrontendWatchListingsResponse_UserCountChange) GetUserCount() uint32 {
-	if x != nil {
-		return x.UserCount
+type FrontendWatchAssetBundlesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FrontendWatchAssetBundlesRequest) Reset() {
+	*x = FrontendWatchAssetBundlesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *FrontendWatchListingsResponse_UserCountChange) GetRecentUserCount() uint32 {
-	if x != nil {
-		return x.RecentUserCount
+func (x *FrontendWatchAssetBundlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrontendWatchAssetBundlesRequest) ProtoMessage() {}
+
+func (x *FrontendWatchAssetBundlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-type FrontendWatchListingsResponse_Event struct {
+// Deprecated: Use FrontendWatchAssetBundlesRequest.ProtoReflect.Descriptor instead.
+func (*FrontendWatchAssetBundlesRequest) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{84}
+}
+
+type FrontendWatchAssetBundlesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Event:
-	//	*FrontendWatchListingsResponse_Event_Change
-	//	*FrontendWatchListingsResponse_Event_Unpublish
-	//	*FrontendWatchListingsResponse_Event_UserCountChange
-	Event isFrontendWatchListingsResponse_Event_Event `protobuf_oneof:"event"`
+	NetworkId   uint64       `protobuf:"varint,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	NetworkKey  []byte       `protobuf:"bytes,2,opt,name=network_key,json=networkKey,proto3" json:"network_key,omitempty"`
+	AssetBundle *AssetBundle `protobuf:"bytes,3,opt,name=asset_bundle,json=assetBundle,proto3" json:"asset_bundle,omitempty"`
 }
 
-func (x *FrontendWatchListingsResponse_Event) Reset() {
-	*x = FrontendWatchListingsResponse_Event{}
+func (x *FrontendWatchAssetBundlesResponse) Reset() {
+	*x = FrontendWatchAssetBundlesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_network_v1_directory_directory_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5175,13 +5481,13 @@ func (x *FrontendWatchListingsResponse_Event) Reset() {
 	}
 }
 
-func (x *FrontendWatchListingsResponse_Event) String() string {
+func (x *FrontendWatchAssetBundlesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendWatchListingsResponse_Event) ProtoMessage() {}
+func (*FrontendWatchAssetBundlesResponse) ProtoMessage() {}
 
-func (x *FrontendWatchListingsResponse_Event) ProtoReflect() protoreflect.Message {
+func (x *FrontendWatchAssetBundlesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_network_v1_directory_directory_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5193,89 +5499,157 @@ func (x *FrontendWatchListingsResponse_Event) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendWatchListingsResponse_Event.ProtoReflect.Descriptor instead.
-func (*FrontendWatchListingsResponse_Event) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{55, 3}
+// Deprecated: Use FrontendWatchAssetBundlesResponse.ProtoReflect.Descriptor instead.
+func (*FrontendWatchAssetBundlesResponse) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{85}
 }
 
-func (m *FrontendWatchListingsResponse_Event) GetEvent() isFrontendWatchListingsResponse_Event_Event {
-	if m != nil {
-		return m.Event
+func (x *FrontendWatchAssetBundlesResponse) GetNetworkId() uint64 {
+	if x != nil {
+		return x.NetworkId
 	}
-	return nil
+	return 0
 }
 
-func (x *FrontendWatchListingsResponse_Event) GetChange() *FrontendWatchListingsResponse_Change {
-	if x, ok := x.GetEvent().(*FrontendWatchListingsResponse_Event_Change); ok {
-		return x.Change
+func (x *FrontendWatchAssetBundlesResponse) GetNetworkKey() []byte {
+	if x != nil {
+		return x.NetworkKey
 	}
 	return nil
 }
 
-func (x *FrontendWatchListingsResponse_Event) GetUnpublish() *FrontendWatchListingsResponse_Unpublish {
-	if x, ok := x.GetEvent().(*FrontendWatchListingsResponse_Event_Unpublish); ok {
-		return x.Unpublish
+func (x *FrontendWatchAssetBundlesResponse) GetAssetBundle() *AssetBundle {
+	if x != nil {
+		return x.AssetBundle
 	}
 	return nil
 }
 
-func (x *FrontendWatchListingsResponse_Event) GetUserCountChange() *FrontendWatchListingsResponse_UserCountChange {
-	if x, ok := x.GetEvent().(*FrontendWatchListingsResponse_Event_UserCountChange); ok {
-		return x.UserCountChange
-	}
-	return nil
-}
+type SnippetSubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type isFrontendWatchListingsResponse_Event_Event interface {
-	isFrontendWatchListingsResponse_Event_Event()
+	SwarmId []byte `protobuf:"bytes,1,opt,name=swarm_id,json=swarmId,proto3" json:"swarm_id,omitempty"`
 }
 
-type FrontendWatchListingsResponse_Event_Change struct {
-	Change *FrontendWatchListingsResponse_Change `protobuf:"bytes,1001,opt,name=change,proto3,oneof"`
+func (x *SnippetSubscribeRequest) Reset() {
+	*x = SnippetSubscribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type FrontendWatchListingsResponse_Event_Unpublish struct {
-	Unpublish *FrontendWatchListingsResponse_Unpublish `protobuf:"bytes,1002,opt,name=unpublish,proto3,oneof"`
+func (x *SnippetSubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type FrontendWatchListingsResponse_Event_UserCountChange struct {
-	UserCountChange *FrontendWatchListingsResponse_UserCountChange `protobuf:"bytes,1003,opt,name=user_count_change,json=userCountChange,proto3,oneof"`
+func (*SnippetSubscribeRequest) ProtoMessage() {}
+
+func (x *SnippetSubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*FrontendWatchListingsResponse_Event_Change) isFrontendWatchListingsResponse_Event_Event() {}
+// Deprecated: Use SnippetSubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SnippetSubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{86}
+}
 
-func (*FrontendWatchListingsResponse_Event_Unpublish) isFrontendWatchListingsResponse_Event_Event() {}
+func (x *SnippetSubscribeRequest) GetSwarmId() []byte {
+	if x != nil {
+		return x.SwarmId
+	}
+	return nil
+}
 
-func (*FrontendWatchListingsResponse_Event_UserCountChange) isFrontendWatchListingsResponse_Event_Event() {
+type SnippetSubscribeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SnippetDelta *ListingSnippetDelta `protobuf:"bytes,1,opt,name=snippet_delta,json=snippetDelta,proto3" json:"snippet_delta,omitempty"`
 }
 
-type FrontendWatchListingUsersResponse_User struct {
+func (x *SnippetSubscribeResponse) Reset() {
+	*x = SnippetSubscribeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnippetSubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnippetSubscribeResponse) ProtoMessage() {}
+
+func (x *SnippetSubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnippetSubscribeResponse.ProtoReflect.Descriptor instead.
+func (*SnippetSubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SnippetSubscribeResponse) GetSnippetDelta() *ListingSnippetDelta {
+	if x != nil {
+		return x.SnippetDelta
+	}
+	return nil
+}
+
+type ServerConfig_Integrations struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Alias   string `protobuf:"bytes,2,opt,name=Alias,proto3" json:"Alias,omitempty"`
-	PeerKey []byte `protobuf:"bytes,3,opt,name=peer_key,json=peerKey,proto3" json:"peer_key,omitempty"`
+	Angelthump *ServerConfig_Integrations_AngelThump `protobuf:"bytes,1,opt,name=angelthump,proto3" json:"angelthump,omitempty"`
+	Twitch     *ServerConfig_Integrations_Twitch     `protobuf:"bytes,2,opt,name=twitch,proto3" json:"twitch,omitempty"`
+	Youtube    *ServerConfig_Integrations_YouTube    `protobuf:"bytes,3,opt,name=youtube,proto3" json:"youtube,omitempty"`
+	Swarm      *ServerConfig_Integrations_Swarm      `protobuf:"bytes,4,opt,name=swarm,proto3" json:"swarm,omitempty"`
+	Kick       *ServerConfig_Integrations_Kick       `protobuf:"bytes,5,opt,name=kick,proto3" json:"kick,omitempty"`
+	Vimeo      *ServerConfig_Integrations_Vimeo      `protobuf:"bytes,6,opt,name=vimeo,proto3" json:"vimeo,omitempty"`
+	Oembed     *ServerConfig_Integrations_OEmbed     `protobuf:"bytes,7,opt,name=oembed,proto3" json:"oembed,omitempty"`
 }
 
-func (x *FrontendWatchListingUsersResponse_User) Reset() {
-	*x = FrontendWatchListingUsersResponse_User{}
+func (x *ServerConfig_Integrations) Reset() {
+	*x = ServerConfig_Integrations{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_network_v1_directory_directory_proto_msgTypes[86]
+		mi := &file_network_v1_directory_directory_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FrontendWatchListingUsersResponse_User) String() string {
+func (x *ServerConfig_Integrations) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontendWatchListingUsersResponse_User) ProtoMessage() {}
+func (*ServerConfig_Integrations) ProtoMessage() {}
 
-func (x *FrontendWatchListingUsersResponse_User) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_directory_directory_proto_msgTypes[86]
+func (x *ServerConfig_Integrations) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5286,460 +5660,2192 @@ func (x *FrontendWatchListingUsersResponse_User) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontendWatchListingUsersResponse_User.ProtoReflect.Descriptor instead.
-func (*FrontendWatchListingUsersResponse_User) Descriptor() ([]byte, []int) {
-	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{57, 0}
+// Deprecated: Use ServerConfig_Integrations.ProtoReflect.Descriptor instead.
+func (*ServerConfig_Integrations) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{0, 0}
 }
 
-func (x *FrontendWatchListingUsersResponse_User) GetId() uint64 {
+func (x *ServerConfig_Integrations) GetAngelthump() *ServerConfig_Integrations_AngelThump {
 	if x != nil {
-		return x.Id
+		return x.Angelthump
 	}
-	return 0
+	return nil
 }
 
-func (x *FrontendWatchListingUsersResponse_User) GetAlias() string {
+func (x *ServerConfig_Integrations) GetTwitch() *ServerConfig_Integrations_Twitch {
 	if x != nil {
-		return x.Alias
+		return x.Twitch
 	}
-	return ""
+	return nil
 }
 
-func (x *FrontendWatchListingUsersResponse_User) GetPeerKey() []byte {
+func (x *ServerConfig_Integrations) GetYoutube() *ServerConfig_Integrations_YouTube {
 	if x != nil {
-		return x.PeerKey
+		return x.Youtube
 	}
 	return nil
 }
 
-var File_network_v1_directory_directory_proto protoreflect.FileDescriptor
+func (x *ServerConfig_Integrations) GetSwarm() *ServerConfig_Integrations_Swarm {
+	if x != nil {
+		return x.Swarm
+	}
+	return nil
+}
 
-var file_network_v1_directory_directory_proto_rawDesc = []byte{
-	0x0a, 0x24, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x79, 0x1a, 0x10, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdf, 0x08, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x5a, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x73,
-	0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31,
-	0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x71, 0x75,
-	0x6f, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69,
-	0x73, 0x68, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x6a, 0x6f, 0x69, 0x6e, 0x5f,
-	0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6a, 0x6f, 0x69,
-	0x6e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x2d, 0x0a, 0x12, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63,
-	0x61, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x11, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
-	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
-	0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x69, 0x6e,
-	0x5f, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x50, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x69, 0x6e,
-	0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0f, 0x6d, 0x61, 0x78, 0x50, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
-	0x6c, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x5f,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11,
-	0x65, 0x6d, 0x62, 0x65, 0x64, 0x4c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
-	0x6c, 0x12, 0x37, 0x0a, 0x18, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f,
-	0x65, 0x6d, 0x62, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x15, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x45, 0x6d,
-	0x62, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x1a, 0xea, 0x04, 0x0a, 0x0c, 0x49,
-	0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x61, 0x0a, 0x0a, 0x61,
-	0x6e, 0x67, 0x65, 0x6c, 0x74, 0x68, 0x75, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x41, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x41, 0x6e, 0x67, 0x65, 0x6c, 0x54, 0x68, 0x75,
-	0x6d, 0x70, 0x52, 0x0a, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x74, 0x68, 0x75, 0x6d, 0x70, 0x12, 0x55,
-	0x0a, 0x06, 0x74, 0x77, 0x69, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d,
-	0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
-	0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x54, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x06, 0x74,
-	0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x58, 0x0a, 0x07, 0x79, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x59,
-	0x6f, 0x75, 0x54, 0x75, 0x62, 0x65, 0x52, 0x07, 0x79, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x12,
-	0x52, 0x0a, 0x05, 0x73, 0x77, 0x61, 0x72, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c,
-	0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
-	0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x77, 0x61, 0x72, 0x6d, 0x52, 0x05, 0x73, 0x77,
-	0x61, 0x72, 0x6d, 0x1a, 0x24, 0x0a, 0x0a, 0x41, 0x6e, 0x67, 0x65, 0x6c, 0x54, 0x68, 0x75, 0x6d,
-	0x70, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x1a, 0x62, 0x0a, 0x06, 0x54, 0x77, 0x69,
-	0x74, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x1a, 0x47, 0x0a,
-	0x07, 0x59, 0x6f, 0x75, 0x54, 0x75, 0x62, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x12, 0x24, 0x0a, 0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x6b,
-	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x1a, 0x1f, 0x0a, 0x05, 0x53, 0x77, 0x61, 0x72, 0x6d, 0x12,
-	0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x22, 0xfe, 0x02, 0x0a, 0x0c, 0x43, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x5a, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65,
-	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36,
-	0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
-	0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f,
-	0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x73, 0x68, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x6a, 0x6f, 0x69,
-	0x6e, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6a,
-	0x6f, 0x69, 0x6e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x5f,
-	0x70, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x50, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x76, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x69, 0x6e, 0x67,
-	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0f, 0x6d, 0x61, 0x78, 0x50, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
-	0x1a, 0x76, 0x0a, 0x0c, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x74, 0x68, 0x75, 0x6d, 0x70, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x74, 0x68, 0x75, 0x6d, 0x70,
-	0x12, 0x16, 0x0a, 0x06, 0x74, 0x77, 0x69, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x74, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x79, 0x6f, 0x75, 0x74,
-	0x75, 0x62, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x79, 0x6f, 0x75, 0x74, 0x75,
-	0x62, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x77, 0x61, 0x72, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x05, 0x73, 0x77, 0x61, 0x72, 0x6d, 0x22, 0x33, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x22, 0x35, 0x0a,
-	0x12, 0x54, 0x65, 0x73, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x4b, 0x65, 0x79, 0x22, 0x15, 0x0a, 0x13, 0x54, 0x65, 0x73, 0x74, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xdb, 0x07, 0x0a, 0x07,
-	0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x43, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61,
-	0x18, 0xe9, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73,
-	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x65,
-	0x64, 0x69, 0x61, 0x48, 0x00, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x49, 0x0a, 0x07,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0xea, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c,
-	0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
-	0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x48, 0x00, 0x52, 0x07,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x05, 0x65, 0x6d, 0x62, 0x65, 0x64,
-	0x18, 0xeb, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73,
-	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6d,
-	0x62, 0x65, 0x64, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x04,
-	0x63, 0x68, 0x61, 0x74, 0x18, 0xec, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x74,
-	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e,
-	0x67, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x48, 0x00, 0x52, 0x04, 0x63, 0x68, 0x61, 0x74, 0x1a, 0x41,
-	0x0a, 0x05, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x77, 0x61, 0x72, 0x6d, 0x5f, 0x75, 0x72,
-	0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x77, 0x61, 0x72, 0x6d, 0x55, 0x72,
-	0x69, 0x1a, 0x3a, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x77, 0x61, 0x72, 0x6d, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x77, 0x61, 0x72, 0x6d, 0x55, 0x72, 0x69, 0x1a, 0x80, 0x04,
-	0x0a, 0x05, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d,
-	0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45,
-	0x6d, 0x62, 0x65, 0x64, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x5e, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x73, 0x74,
-	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e,
-	0x67, 0x2e, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf8, 0x01, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x2d, 0x0a, 0x29, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x4c,
-	0x49, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x4d, 0x42, 0x45, 0x44, 0x5f, 0x53, 0x45, 0x52,
-	0x56, 0x49, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x2e, 0x0a, 0x2a, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x4c, 0x49,
-	0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x4d, 0x42, 0x45, 0x44, 0x5f, 0x53, 0x45, 0x52, 0x56,
-	0x49, 0x43, 0x45, 0x5f, 0x41, 0x4e, 0x47, 0x45, 0x4c, 0x54, 0x48, 0x55, 0x4d, 0x50, 0x10, 0x01,
-	0x12, 0x31, 0x0a, 0x2d, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x4c, 0x49,
-	0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x4d, 0x42, 0x45, 0x44, 0x5f, 0x53, 0x45, 0x52, 0x56,
-	0x49, 0x43, 0x45, 0x5f, 0x54, 0x57, 0x49, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41,
-	0x4d, 0x10, 0x02, 0x12, 0x2e, 0x0a, 0x2a, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x59,
-	0x5f, 0x4c, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x4d, 0x42, 0x45, 0x44, 0x5f, 0x53,
-	0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x54, 0x57, 0x49, 0x54, 0x43, 0x48, 0x5f, 0x56, 0x4f,
-	0x44, 0x10, 0x03, 0x12, 0x2b, 0x0a, 0x27, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x59,
-	0x5f, 0x4c, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x4d, 0x42, 0x45, 0x44, 0x5f, 0x53,
-	0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x59, 0x4f, 0x55, 0x54, 0x55, 0x42, 0x45, 0x10, 0x04,
-	0x1a, 0x2c, 0x0a, 0x04, 0x43, 0x68, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x09,
-	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x67, 0x0a, 0x13, 0x4c, 0x69, 0x73,
-	0x74, 0x69, 0x6e, 0x67, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x12, 0x13, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0xe9, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x2b, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0xea,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6f, 0x6e, 0x65,
-	0x6f, 0x66, 0x22, 0xc6, 0x04, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x6e,
-	0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67,
-	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x21, 0x0a,
-	0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c,
-	0x69, 0x76, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x12, 0x4e, 0x0a, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c,
-	0x12, 0x53, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6c, 0x6f, 0x67, 0x6f,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x6e, 0x69, 0x70,
-	0x70, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x4c, 0x6f, 0x67, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x76, 0x69, 0x64,
-	0x65, 0x6f, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x69, 0x64, 0x65,
-	0x6f, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x76,
-	0x69, 0x64, 0x65, 0x6f, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x68, 0x65,
-	0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x07, 0x52, 0x0a,
-	0x74, 0x68, 0x65, 0x6d, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x11, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x91, 0x4e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x09,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x92, 0x4e, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xdc, 0x08, 0x0a, 0x13,
-	0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x44, 0x65,
-	0x6c, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
-	0x79, 0x12, 0x3f, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x2e, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x12,
-	0x37, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08,
-	0x69, 0x73, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2d, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x39, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74,
-	0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33,
-	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x48, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x77, 0x69, 0x64,
-	0x74, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33,
-	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x57, 0x69, 0x64,
-	0x74, 0x68, 0x12, 0x3d, 0x0a, 0x0b, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6f,
-	0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x43, 0x6f, 0x6c, 0x6f,
-	0x72, 0x12, 0x3a, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x4c, 0x0a,
-	0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0xe9, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x73,
-	0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31,
-	0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69,
-	0x6e, 0x67, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x2e, 0x54,
-	0x61, 0x67, 0x73, 0x48, 0x00, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x51, 0x0a, 0x09, 0x74,
-	0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0xd1, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x30, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x48, 0x01, 0x52, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x12, 0x56,
-	0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6c, 0x6f, 0x67, 0x6f, 0x18, 0xb9,
-	0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x6e, 0x69, 0x70, 0x70,
-	0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x48, 0x02, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x4c, 0x6f, 0x67, 0x6f, 0x1a, 0x1b, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x12, 0x13,
-	0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0xe9, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74,
-	0x61, 0x67, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x74, 0x61, 0x67, 0x73, 0x5f, 0x6f, 0x6e, 0x65, 0x6f,
-	0x66, 0x42, 0x11, 0x0a, 0x0f, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x5f, 0x6f,
-	0x6e, 0x65, 0x6f, 0x66, 0x42, 0x14, 0x0a, 0x12, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
-	0x6c, 0x6f, 0x67, 0x6f, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x22, 0xfa, 0x07, 0x0a, 0x05, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x12, 0x5a, 0x0a, 0x0e, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0xe9, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e,
-	0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76,
-	0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48,
-	0x00, 0x52, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x12, 0x4d, 0x0a, 0x09, 0x75, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x18, 0xea, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x79, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69,
-	0x73, 0x68, 0x48, 0x00, 0x52, 0x09, 0x75, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12,
-	0x61, 0x0a, 0x11, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x18, 0xeb, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x73, 0x74,
-	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48,
-	0x00, 0x52, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x12, 0x6a, 0x0a, 0x14, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65,
-	0x6e, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0xec, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x35, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e,
-	0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x12, 0x75, 0x73, 0x65, 0x72,
-	0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3e,
-	0x0a, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x18, 0xed, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
-	0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76,
-	0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x1a, 0xf6,
-	0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x3e, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
-	0x12, 0x45, 0x0a, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x07,
-	0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x4e, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x74,
-	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e,
-	0x67, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6d, 0x6f, 0x64,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x1b, 0x0a, 0x09, 0x55, 0x6e, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x73, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x69, 0x64, 0x1a, 0x6c, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x75, 0x73, 0x65,
-	0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74,
-	0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x1a, 0x8e, 0x01, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x65, 0x73, 0x65,
-	0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69,
-	0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12,
-	0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e,
-	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69,
-	0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
-	0x49, 0x64, 0x73, 0x1a, 0x1a, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x42,
-	0x06, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0xbf, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
-	0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a,
-	0x09, 0x69, 0x73, 0x5f, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x69, 0x73,
-	0x4d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x62, 0x61, 0x6e,
-	0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x69, 0x73, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12,
-	0x38, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x6d, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
-	0x74, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x11, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0xe9, 0x07, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x41, 0x0a, 0x07,
-	0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0xea, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
-	0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
-	0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x42,
-	0x07, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0xe4, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73,
-	0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x07, 0x6c, 0x69, 0x73,
-	0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x74, 0x72,
-	0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
-	0x52, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x4e, 0x0a, 0x0a, 0x6d, 0x6f, 0x64,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
-	0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76,
-	0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6d,
-	0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74,
-	0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22,
-	0x8a, 0x02, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6a, 0x6f,
-	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4a, 0x6f, 0x69,
-	0x6e, 0x12, 0x43, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f,
-	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x3d, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x6d, 0x6f, 0x64,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42,
-	0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x69, 0x73, 0x4d, 0x6f, 0x64, 0x65,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x07, 0x69, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x22, 0xa3, 0x01, 0x0a,
-	0x0a, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65,
-	0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x65,
-	0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x4b, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x74, 0x72, 0x69,
-	0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x4c, 0x0a, 0x0e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x6f, 0x61, 0x64,
-	0x63, 0x61, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x79, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x22, 0x7e, 0x0a, 0x0b, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12,
-	0x26, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x74, 0x72,
-	0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
-	0x22, 0x50, 0x0a, 0x0e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x3e, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74,
+func (x *ServerConfig_Integrations) GetKick() *ServerConfig_Integrations_Kick {
+	if x != nil {
+		return x.Kick
+	}
+	return nil
+}
+
+func (x *ServerConfig_Integrations) GetVimeo() *ServerConfig_Integrations_Vimeo {
+	if x != nil {
+		return x.Vimeo
+	}
+	return nil
+}
+
+func (x *ServerConfig_Integrations) GetOembed() *ServerConfig_Integrations_OEmbed {
+	if x != nil {
+		return x.Oembed
+	}
+	return nil
+}
+
+type ServerConfig_Federation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetworkKey []byte         `protobuf:"bytes,1,opt,name=network_key,json=networkKey,proto3" json:"network_key,omitempty"`
+	Filter     *ListingFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *ServerConfig_Federation) Reset() {
+	*x = ServerConfig_Federation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerConfig_Federation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerConfig_Federation) ProtoMessage() {}
+
+func (x *ServerConfig_Federation) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerConfig_Federation.ProtoReflect.Descriptor instead.
+func (*ServerConfig_Federation) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *ServerConfig_Federation) GetNetworkKey() []byte {
+	if x != nil {
+		return x.NetworkKey
+	}
+	return nil
+}
+
+func (x *ServerConfig_Federation) GetFilter() *ListingFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type ServerConfig_Integrations_AngelThump struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enable bool `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+}
+
+func (x *ServerConfig_Integrations_AngelThump) Reset() {
+	*x = ServerConfig_Integrations_AngelThump{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerConfig_Integrations_AngelThump) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerConfig_Integrations_AngelThump) ProtoMessage() {}
+
+func (x *ServerConfig_Integrations_AngelThump) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerConfig_Integrations_AngelThump.ProtoReflect.Descriptor instead.
+func (*ServerConfig_Integrations_AngelThump) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *ServerConfig_Integrations_AngelThump) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+type ServerConfig_Integrations_Twitch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enable       bool   `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	ClientId     string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret string `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+}
+
+func (x *ServerConfig_Integrations_Twitch) Reset() {
+	*x = ServerConfig_Integrations_Twitch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerConfig_Integrations_Twitch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerConfig_Integrations_Twitch) ProtoMessage() {}
+
+func (x *ServerConfig_Integrations_Twitch) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerConfig_Integrations_Twitch.ProtoReflect.Descriptor instead.
+func (*ServerConfig_Integrations_Twitch) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{0, 0, 1}
+}
+
+func (x *ServerConfig_Integrations_Twitch) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *ServerConfig_Integrations_Twitch) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *ServerConfig_Integrations_Twitch) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+type ServerConfig_Integrations_YouTube struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enable       bool   `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	PublicApiKey string `protobuf:"bytes,2,opt,name=public_api_key,json=publicApiKey,proto3" json:"public_api_key,omitempty"`
+}
+
+func (x *ServerConfig_Integrations_YouTube) Reset() {
+	*x = ServerConfig_Integrations_YouTube{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerConfig_Integrations_YouTube) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerConfig_Integrations_YouTube) ProtoMessage() {}
+
+func (x *ServerConfig_Integrations_YouTube) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerConfig_Integrations_YouTube.ProtoReflect.Descriptor instead.
+func (*ServerConfig_Integrations_YouTube) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{0, 0, 2}
+}
+
+func (x *ServerConfig_Integrations_YouTube) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *ServerConfig_Integrations_YouTube) GetPublicApiKey() string {
+	if x != nil {
+		return x.PublicApiKey
+	}
+	return ""
+}
+
+type ServerConfig_Integrations_Swarm struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enable bool `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+}
+
+func (x *ServerConfig_Integrations_Swarm) Reset() {
+	*x = ServerConfig_Integrations_Swarm{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerConfig_Integrations_Swarm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerConfig_Integrations_Swarm) ProtoMessage() {}
+
+func (x *ServerConfig_Integrations_Swarm) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerConfig_Integrations_Swarm.ProtoReflect.Descriptor instead.
+func (*ServerConfig_Integrations_Swarm) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{0, 0, 3}
+}
+
+func (x *ServerConfig_Integrations_Swarm) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+type ServerConfig_Integrations_Kick struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enable bool `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+}
+
+func (x *ServerConfig_Integrations_Kick) Reset() {
+	*x = ServerConfig_Integrations_Kick{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerConfig_Integrations_Kick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerConfig_Integrations_Kick) ProtoMessage() {}
+
+func (x *ServerConfig_Integrations_Kick) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerConfig_Integrations_Kick.ProtoReflect.Descriptor instead.
+func (*ServerConfig_Integrations_Kick) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{0, 0, 4}
+}
+
+func (x *ServerConfig_Integrations_Kick) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+type ServerConfig_Integrations_Vimeo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enable      bool   `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	AccessToken string `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+}
+
+func (x *ServerConfig_Integrations_Vimeo) Reset() {
+	*x = ServerConfig_Integrations_Vimeo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerConfig_Integrations_Vimeo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerConfig_Integrations_Vimeo) ProtoMessage() {}
+
+func (x *ServerConfig_Integrations_Vimeo) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerConfig_Integrations_Vimeo.ProtoReflect.Descriptor instead.
+func (*ServerConfig_Integrations_Vimeo) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{0, 0, 5}
+}
+
+func (x *ServerConfig_Integrations_Vimeo) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *ServerConfig_Integrations_Vimeo) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+type ServerConfig_Integrations_OEmbed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enable       bool     `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	AllowedHosts []string `protobuf:"bytes,2,rep,name=allowed_hosts,json=allowedHosts,proto3" json:"allowed_hosts,omitempty"`
+}
+
+func (x *ServerConfig_Integrations_OEmbed) Reset() {
+	*x = ServerConfig_Integrations_OEmbed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerConfig_Integrations_OEmbed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerConfig_Integrations_OEmbed) ProtoMessage() {}
+
+func (x *ServerConfig_Integrations_OEmbed) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerConfig_Integrations_OEmbed.ProtoReflect.Descriptor instead.
+func (*ServerConfig_Integrations_OEmbed) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{0, 0, 6}
+}
+
+func (x *ServerConfig_Integrations_OEmbed) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *ServerConfig_Integrations_OEmbed) GetAllowedHosts() []string {
+	if x != nil {
+		return x.AllowedHosts
+	}
+	return nil
+}
+
+type ClientConfig_Integrations struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Angelthump bool `protobuf:"varint,1,opt,name=angelthump,proto3" json:"angelthump,omitempty"`
+	Twitch     bool `protobuf:"varint,2,opt,name=twitch,proto3" json:"twitch,omitempty"`
+	Youtube    bool `protobuf:"varint,3,opt,name=youtube,proto3" json:"youtube,omitempty"`
+	Swarm      bool `protobuf:"varint,4,opt,name=swarm,proto3" json:"swarm,omitempty"`
+	Kick       bool `protobuf:"varint,5,opt,name=kick,proto3" json:"kick,omitempty"`
+	Vimeo      bool `protobuf:"varint,6,opt,name=vimeo,proto3" json:"vimeo,omitempty"`
+	Oembed     bool `protobuf:"varint,7,opt,name=oembed,proto3" json:"oembed,omitempty"`
+}
+
+func (x *ClientConfig_Integrations) Reset() {
+	*x = ClientConfig_Integrations{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientConfig_Integrations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientConfig_Integrations) ProtoMessage() {}
+
+func (x *ClientConfig_Integrations) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientConfig_Integrations.ProtoReflect.Descriptor instead.
+func (*ClientConfig_Integrations) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *ClientConfig_Integrations) GetAngelthump() bool {
+	if x != nil {
+		return x.Angelthump
+	}
+	return false
+}
+
+func (x *ClientConfig_Integrations) GetTwitch() bool {
+	if x != nil {
+		return x.Twitch
+	}
+	return false
+}
+
+func (x *ClientConfig_Integrations) GetYoutube() bool {
+	if x != nil {
+		return x.Youtube
+	}
+	return false
+}
+
+func (x *ClientConfig_Integrations) GetSwarm() bool {
+	if x != nil {
+		return x.Swarm
+	}
+	return false
+}
+
+func (x *ClientConfig_Integrations) GetKick() bool {
+	if x != nil {
+		return x.Kick
+	}
+	return false
+}
+
+func (x *ClientConfig_Integrations) GetVimeo() bool {
+	if x != nil {
+		return x.Vimeo
+	}
+	return false
+}
+
+func (x *ClientConfig_Integrations) GetOembed() bool {
+	if x != nil {
+		return x.Oembed
+	}
+	return false
+}
+
+type Listing_Media struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MimeType string `protobuf:"bytes,1,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	SwarmUri string `protobuf:"bytes,2,opt,name=swarm_uri,json=swarmUri,proto3" json:"swarm_uri,omitempty"`
+}
+
+func (x *Listing_Media) Reset() {
+	*x = Listing_Media{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Listing_Media) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Listing_Media) ProtoMessage() {}
+
+func (x *Listing_Media) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Listing_Media.ProtoReflect.Descriptor instead.
+func (*Listing_Media) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *Listing_Media) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *Listing_Media) GetSwarmUri() string {
+	if x != nil {
+		return x.SwarmUri
+	}
+	return ""
+}
+
+type Listing_Service struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type     string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	SwarmUri string `protobuf:"bytes,2,opt,name=swarm_uri,json=swarmUri,proto3" json:"swarm_uri,omitempty"`
+}
+
+func (x *Listing_Service) Reset() {
+	*x = Listing_Service{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Listing_Service) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Listing_Service) ProtoMessage() {}
+
+func (x *Listing_Service) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Listing_Service.ProtoReflect.Descriptor instead.
+func (*Listing_Service) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{5, 1}
+}
+
+func (x *Listing_Service) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Listing_Service) GetSwarmUri() string {
+	if x != nil {
+		return x.SwarmUri
+	}
+	return ""
+}
+
+type Listing_Embed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Service     Listing_Embed_Service `protobuf:"varint,1,opt,name=service,proto3,enum=strims.network.v1.directory.Listing_Embed_Service" json:"service,omitempty"`
+	Id          string                `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	QueryParams map[string]string     `protobuf:"bytes,3,rep,name=query_params,json=queryParams,proto3" json:"query_params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Listing_Embed) Reset() {
+	*x = Listing_Embed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Listing_Embed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Listing_Embed) ProtoMessage() {}
+
+func (x *Listing_Embed) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Listing_Embed.ProtoReflect.Descriptor instead.
+func (*Listing_Embed) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{5, 2}
+}
+
+func (x *Listing_Embed) GetService() Listing_Embed_Service {
+	if x != nil {
+		return x.Service
+	}
+	return Listing_Embed_DIRECTORY_LISTING_EMBED_SERVICE_UNDEFINED
+}
+
+func (x *Listing_Embed) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Listing_Embed) GetQueryParams() map[string]string {
+	if x != nil {
+		return x.QueryParams
+	}
+	return nil
+}
+
+type Listing_Chat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key  []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *Listing_Chat) Reset() {
+	*x = Listing_Chat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Listing_Chat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Listing_Chat) ProtoMessage() {}
+
+func (x *Listing_Chat) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Listing_Chat.ProtoReflect.Descriptor instead.
+func (*Listing_Chat) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{5, 3}
+}
+
+func (x *Listing_Chat) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *Listing_Chat) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListingSnippetDelta_Tags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tags []string `protobuf:"bytes,1001,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *ListingSnippetDelta_Tags) Reset() {
+	*x = ListingSnippetDelta_Tags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListingSnippetDelta_Tags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListingSnippetDelta_Tags) ProtoMessage() {}
+
+func (x *ListingSnippetDelta_Tags) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListingSnippetDelta_Tags.ProtoReflect.Descriptor instead.
+func (*ListingSnippetDelta_Tags) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *ListingSnippetDelta_Tags) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type Event_ListingChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Listing    *Listing           `protobuf:"bytes,2,opt,name=listing,proto3" json:"listing,omitempty"`
+	Snippet    *ListingSnippet    `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	Moderation *ListingModeration `protobuf:"bytes,4,opt,name=moderation,proto3" json:"moderation,omitempty"`
+	Upcoming   bool               `protobuf:"varint,5,opt,name=upcoming,proto3" json:"upcoming,omitempty"`
+	Provenance *ListingProvenance `protobuf:"bytes,6,opt,name=provenance,proto3" json:"provenance,omitempty"`
+}
+
+func (x *Event_ListingChange) Reset() {
+	*x = Event_ListingChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_ListingChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_ListingChange) ProtoMessage() {}
+
+func (x *Event_ListingChange) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_ListingChange.ProtoReflect.Descriptor instead.
+func (*Event_ListingChange) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *Event_ListingChange) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Event_ListingChange) GetListing() *Listing {
+	if x != nil {
+		return x.Listing
+	}
+	return nil
+}
+
+func (x *Event_ListingChange) GetSnippet() *ListingSnippet {
+	if x != nil {
+		return x.Snippet
+	}
+	return nil
+}
+
+func (x *Event_ListingChange) GetModeration() *ListingModeration {
+	if x != nil {
+		return x.Moderation
+	}
+	return nil
+}
+
+func (x *Event_ListingChange) GetUpcoming() bool {
+	if x != nil {
+		return x.Upcoming
+	}
+	return false
+}
+
+func (x *Event_ListingChange) GetProvenance() *ListingProvenance {
+	if x != nil {
+		return x.Provenance
+	}
+	return nil
+}
+
+type Event_Unpublish struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *Event_Unpublish) Reset() {
+	*x = Event_Unpublish{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_Unpublish) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_Unpublish) ProtoMessage() {}
+
+func (x *Event_Unpublish) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_Unpublish.ProtoReflect.Descriptor instead.
+func (*Event_Unpublish) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{10, 1}
+}
+
+func (x *Event_Unpublish) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type Event_UserCountChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserCount       uint32 `protobuf:"varint,2,opt,name=user_count,json=userCount,proto3" json:"user_count,omitempty"`
+	RecentUserCount uint32 `protobuf:"varint,3,opt,name=recent_user_count,json=recentUserCount,proto3" json:"recent_user_count,omitempty"`
+}
+
+func (x *Event_UserCountChange) Reset() {
+	*x = Event_UserCountChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_UserCountChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_UserCountChange) ProtoMessage() {}
+
+func (x *Event_UserCountChange) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_UserCountChange.ProtoReflect.Descriptor instead.
+func (*Event_UserCountChange) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{10, 2}
+}
+
+func (x *Event_UserCountChange) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Event_UserCountChange) GetUserCount() uint32 {
+	if x != nil {
+		return x.UserCount
+	}
+	return 0
+}
+
+func (x *Event_UserCountChange) GetRecentUserCount() uint32 {
+	if x != nil {
+		return x.RecentUserCount
+	}
+	return 0
+}
+
+type Event_UserPresenceChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Alias      string   `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	PeerKey    []byte   `protobuf:"bytes,3,opt,name=peer_key,json=peerKey,proto3" json:"peer_key,omitempty"`
+	Online     bool     `protobuf:"varint,4,opt,name=online,proto3" json:"online,omitempty"`
+	ListingIds []uint64 `protobuf:"varint,5,rep,packed,name=listing_ids,json=listingIds,proto3" json:"listing_ids,omitempty"`
+}
+
+func (x *Event_UserPresenceChange) Reset() {
+	*x = Event_UserPresenceChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_UserPresenceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_UserPresenceChange) ProtoMessage() {}
+
+func (x *Event_UserPresenceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_UserPresenceChange.ProtoReflect.Descriptor instead.
+func (*Event_UserPresenceChange) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{10, 3}
+}
+
+func (x *Event_UserPresenceChange) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Event_UserPresenceChange) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *Event_UserPresenceChange) GetPeerKey() []byte {
+	if x != nil {
+		return x.PeerKey
+	}
+	return nil
+}
+
+func (x *Event_UserPresenceChange) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+func (x *Event_UserPresenceChange) GetListingIds() []uint64 {
+	if x != nil {
+		return x.ListingIds
+	}
+	return nil
+}
+
+type Event_Ping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time int64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *Event_Ping) Reset() {
+	*x = Event_Ping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event_Ping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_Ping) ProtoMessage() {}
+
+func (x *Event_Ping) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_Ping.ProtoReflect.Descriptor instead.
+func (*Event_Ping) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{10, 4}
+}
+
+func (x *Event_Ping) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+type FrontendGetUsersResponse_Alias struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alias      string   `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	NetworkIds []uint64 `protobuf:"varint,2,rep,packed,name=network_ids,json=networkIds,proto3" json:"network_ids,omitempty"`
+}
+
+func (x *FrontendGetUsersResponse_Alias) Reset() {
+	*x = FrontendGetUsersResponse_Alias{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrontendGetUsersResponse_Alias) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrontendGetUsersResponse_Alias) ProtoMessage() {}
+
+func (x *FrontendGetUsersResponse_Alias) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrontendGetUsersResponse_Alias.ProtoReflect.Descriptor instead.
+func (*FrontendGetUsersResponse_Alias) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{74, 0}
+}
+
+func (x *FrontendGetUsersResponse_Alias) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *FrontendGetUsersResponse_Alias) GetNetworkIds() []uint64 {
+	if x != nil {
+		return x.NetworkIds
+	}
+	return nil
+}
+
+type FrontendGetUsersResponse_User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Aliases []*FrontendGetUsersResponse_Alias `protobuf:"bytes,1,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	PeerKey []byte                            `protobuf:"bytes,2,opt,name=peer_key,json=peerKey,proto3" json:"peer_key,omitempty"`
+}
+
+func (x *FrontendGetUsersResponse_User) Reset() {
+	*x = FrontendGetUsersResponse_User{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrontendGetUsersResponse_User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrontendGetUsersResponse_User) ProtoMessage() {}
+
+func (x *FrontendGetUsersResponse_User) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrontendGetUsersResponse_User.ProtoReflect.Descriptor instead.
+func (*FrontendGetUsersResponse_User) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{74, 1}
+}
+
+func (x *FrontendGetUsersResponse_User) GetAliases() []*FrontendGetUsersResponse_Alias {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *FrontendGetUsersResponse_User) GetPeerKey() []byte {
+	if x != nil {
+		return x.PeerKey
+	}
+	return nil
+}
+
+type FrontendWatchListingsResponse_Change struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Listings *NetworkListings `protobuf:"bytes,1,opt,name=listings,proto3" json:"listings,omitempty"`
+}
+
+func (x *FrontendWatchListingsResponse_Change) Reset() {
+	*x = FrontendWatchListingsResponse_Change{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrontendWatchListingsResponse_Change) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrontendWatchListingsResponse_Change) ProtoMessage() {}
+
+func (x *FrontendWatchListingsResponse_Change) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrontendWatchListingsResponse_Change.ProtoReflect.Descriptor instead.
+func (*FrontendWatchListingsResponse_Change) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{81, 0}
+}
+
+func (x *FrontendWatchListingsResponse_Change) GetListings() *NetworkListings {
+	if x != nil {
+		return x.Listings
+	}
+	return nil
+}
+
+type FrontendWatchListingsResponse_Unpublish struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetworkId uint64 `protobuf:"varint,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	ListingId uint64 `protobuf:"varint,2,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+}
+
+func (x *FrontendWatchListingsResponse_Unpublish) Reset() {
+	*x = FrontendWatchListingsResponse_Unpublish{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrontendWatchListingsResponse_Unpublish) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrontendWatchListingsResponse_Unpublish) ProtoMessage() {}
+
+func (x *FrontendWatchListingsResponse_Unpublish) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrontendWatchListingsResponse_Unpublish.ProtoReflect.Descriptor instead.
+func (*FrontendWatchListingsResponse_Unpublish) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{81, 1}
+}
+
+func (x *FrontendWatchListingsResponse_Unpublish) GetNetworkId() uint64 {
+	if x != nil {
+		return x.NetworkId
+	}
+	return 0
+}
+
+func (x *FrontendWatchListingsResponse_Unpublish) GetListingId() uint64 {
+	if x != nil {
+		return x.ListingId
+	}
+	return 0
+}
+
+type FrontendWatchListingsResponse_UserCountChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetworkId       uint64 `protobuf:"varint,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	ListingId       uint64 `protobuf:"varint,2,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	UserCount       uint32 `protobuf:"varint,3,opt,name=user_count,json=userCount,proto3" json:"user_count,omitempty"`
+	RecentUserCount uint32 `protobuf:"varint,4,opt,name=recent_user_count,json=recentUserCount,proto3" json:"recent_user_count,omitempty"`
+}
+
+func (x *FrontendWatchListingsResponse_UserCountChange) Reset() {
+	*x = FrontendWatchListingsResponse_UserCountChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrontendWatchListingsResponse_UserCountChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrontendWatchListingsResponse_UserCountChange) ProtoMessage() {}
+
+func (x *FrontendWatchListingsResponse_UserCountChange) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrontendWatchListingsResponse_UserCountChange.ProtoReflect.Descriptor instead.
+func (*FrontendWatchListingsResponse_UserCountChange) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{81, 2}
+}
+
+func (x *FrontendWatchListingsResponse_UserCountChange) GetNetworkId() uint64 {
+	if x != nil {
+		return x.NetworkId
+	}
+	return 0
+}
+
+func (x *FrontendWatchListingsResponse_UserCountChange) GetListingId() uint64 {
+	if x != nil {
+		return x.ListingId
+	}
+	return 0
+}
+
+func (x *FrontendWatchListingsResponse_UserCountChange) GetUserCount() uint32 {
+	if x != nil {
+		return x.UserCount
+	}
+	return 0
+}
+
+func (x *FrontendWatchListingsResponse_UserCountChange) GetRecentUserCount() uint32 {
+	if x != nil {
+		return x.RecentUserCount
+	}
+	return 0
+}
+
+type FrontendWatchListingsResponse_Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*FrontendWatchListingsResponse_Event_Change
+	//	*FrontendWatchListingsResponse_Event_Unpublish
+	//	*FrontendWatchListingsResponse_Event_UserCountChange
+	Event isFrontendWatchListingsResponse_Event_Event `protobuf_oneof:"event"`
+}
+
+func (x *FrontendWatchListingsResponse_Event) Reset() {
+	*x = FrontendWatchListingsResponse_Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrontendWatchListingsResponse_Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrontendWatchListingsResponse_Event) ProtoMessage() {}
+
+func (x *FrontendWatchListingsResponse_Event) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrontendWatchListingsResponse_Event.ProtoReflect.Descriptor instead.
+func (*FrontendWatchListingsResponse_Event) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{81, 3}
+}
+
+func (m *FrontendWatchListingsResponse_Event) GetEvent() isFrontendWatchListingsResponse_Event_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *FrontendWatchListingsResponse_Event) GetChange() *FrontendWatchListingsResponse_Change {
+	if x, ok := x.GetEvent().(*FrontendWatchListingsResponse_Event_Change); ok {
+		return x.Change
+	}
+	return nil
+}
+
+func (x *FrontendWatchListingsResponse_Event) GetUnpublish() *FrontendWatchListingsResponse_Unpublish {
+	if x, ok := x.GetEvent().(*FrontendWatchListingsResponse_Event_Unpublish); ok {
+		return x.Unpublish
+	}
+	return nil
+}
+
+func (x *FrontendWatchListingsResponse_Event) GetUserCountChange() *FrontendWatchListingsResponse_UserCountChange {
+	if x, ok := x.GetEvent().(*FrontendWatchListingsResponse_Event_UserCountChange); ok {
+		return x.UserCountChange
+	}
+	return nil
+}
+
+type isFrontendWatchListingsResponse_Event_Event interface {
+	isFrontendWatchListingsResponse_Event_Event()
+}
+
+type FrontendWatchListingsResponse_Event_Change struct {
+	Change *FrontendWatchListingsResponse_Change `protobuf:"bytes,1001,opt,name=change,proto3,oneof"`
+}
+
+type FrontendWatchListingsResponse_Event_Unpublish struct {
+	Unpublish *FrontendWatchListingsResponse_Unpublish `protobuf:"bytes,1002,opt,name=unpublish,proto3,oneof"`
+}
+
+type FrontendWatchListingsResponse_Event_UserCountChange struct {
+	UserCountChange *FrontendWatchListingsResponse_UserCountChange `protobuf:"bytes,1003,opt,name=user_count_change,json=userCountChange,proto3,oneof"`
+}
+
+func (*FrontendWatchListingsResponse_Event_Change) isFrontendWatchListingsResponse_Event_Event() {}
+
+func (*FrontendWatchListingsResponse_Event_Unpublish) isFrontendWatchListingsResponse_Event_Event() {}
+
+func (*FrontendWatchListingsResponse_Event_UserCountChange) isFrontendWatchListingsResponse_Event_Event() {
+}
+
+type FrontendWatchListingUsersResponse_User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Alias   string `protobuf:"bytes,2,opt,name=Alias,proto3" json:"Alias,omitempty"`
+	PeerKey []byte `protobuf:"bytes,3,opt,name=peer_key,json=peerKey,proto3" json:"peer_key,omitempty"`
+}
+
+func (x *FrontendWatchListingUsersResponse_User) Reset() {
+	*x = FrontendWatchListingUsersResponse_User{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_network_v1_directory_directory_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrontendWatchListingUsersResponse_User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrontendWatchListingUsersResponse_User) ProtoMessage() {}
+
+func (x *FrontendWatchListingUsersResponse_User) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_directory_directory_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrontendWatchListingUsersResponse_User.ProtoReflect.Descriptor instead.
+func (*FrontendWatchListingUsersResponse_User) Descriptor() ([]byte, []int) {
+	return file_network_v1_directory_directory_proto_rawDescGZIP(), []int{83, 0}
+}
+
+func (x *FrontendWatchListingUsersResponse_User) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FrontendWatchListingUsersResponse_User) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *FrontendWatchListingUsersResponse_User) GetPeerKey() []byte {
+	if x != nil {
+		return x.PeerKey
+	}
+	return nil
+}
+
+var File_network_v1_directory_directory_proto protoreflect.FileDescriptor
+
+var file_network_v1_directory_directory_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x1a, 0x10, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd1, 0x0d, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x5a, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x73,
+	0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31,
+	0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x71, 0x75,
+	0x6f, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x6a, 0x6f, 0x69, 0x6e, 0x5f,
+	0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6a, 0x6f, 0x69,
+	0x6e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x2d, 0x0a, 0x12, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63,
+	0x61, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x11, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x69, 0x6e,
+	0x5f, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x50, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x69, 0x6e,
+	0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0f, 0x6d, 0x61, 0x78, 0x50, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11,
+	0x65, 0x6d, 0x62, 0x65, 0x64, 0x4c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x12, 0x37, 0x0a, 0x18, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f,
+	0x65, 0x6d, 0x62, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x15, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x45, 0x6d,
+	0x62, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x56, 0x0a, 0x0b, 0x66, 0x65,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x34, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x46, 0x65, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x1a, 0x91, 0x08, 0x0a, 0x0c, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x61, 0x0a, 0x0a, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x74, 0x68, 0x75, 0x6d,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73,
+	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x41, 0x6e, 0x67, 0x65, 0x6c, 0x54, 0x68, 0x75, 0x6d, 0x70, 0x52, 0x0a, 0x61, 0x6e, 0x67, 0x65,
+	0x6c, 0x74, 0x68, 0x75, 0x6d, 0x70, 0x12, 0x55, 0x0a, 0x06, 0x74, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x54,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x06, 0x74, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x58, 0x0a,
+	0x07, 0x79, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e,
+	0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
+	0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x59, 0x6f, 0x75, 0x54, 0x75, 0x62, 0x65, 0x52, 0x07,
+	0x79, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x12, 0x52, 0x0a, 0x05, 0x73, 0x77, 0x61, 0x72, 0x6d,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x53,
+	0x77, 0x61, 0x72, 0x6d, 0x52, 0x05, 0x73, 0x77, 0x61, 0x72, 0x6d, 0x12, 0x4f, 0x0a, 0x04, 0x6b,
+	0x69, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x52, 0x04, 0x6b, 0x69, 0x63, 0x6b, 0x12, 0x52, 0x0a, 0x05,
+	0x76, 0x69, 0x6d, 0x65, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x69, 0x6d, 0x65, 0x6f, 0x52, 0x05, 0x76, 0x69, 0x6d, 0x65, 0x6f,
+	0x12, 0x55, 0x0a, 0x06, 0x6f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x3d, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x49, 0x6e, 0x74, 0x65,
+	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4f, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x52,
+	0x06, 0x6f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x1a, 0x24, 0x0a, 0x0a, 0x41, 0x6e, 0x67, 0x65, 0x6c,
+	0x54, 0x68, 0x75, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x1a, 0x62, 0x0a,
+	0x06, 0x54, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x1a, 0x47, 0x0a, 0x07, 0x59, 0x6f, 0x75, 0x54, 0x75, 0x62, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x61,
+	0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x1a, 0x1f, 0x0a, 0x05, 0x53, 0x77,
+	0x61, 0x72, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x1a, 0x1e, 0x0a, 0x04, 0x4b,
+	0x69, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x1a, 0x42, 0x0a, 0x05, 0x56,
+	0x69, 0x6d, 0x65, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a,
+	0x45, 0x0a, 0x06, 0x4f, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x68, 0x6f, 0x73,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x1a, 0x71, 0x0a, 0x0a, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x4b, 0x65, 0x79, 0x12, 0x42, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xc1, 0x03, 0x0a, 0x0c, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x5a, 0x0a, 0x0c, 0x69, 0x6e,
+	0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x36, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x49, 0x6e, 0x74, 0x65,
+	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x6a,
+	0x6f, 0x69, 0x6e, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x69,
+	0x6e, 0x5f, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x50, 0x69, 0x6e, 0x67, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x69,
+	0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x50, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x1a, 0xb8, 0x01, 0x0a, 0x0c, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x74, 0x68, 0x75, 0x6d,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x74, 0x68,
+	0x75, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x77, 0x69, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x74, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x79,
+	0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x79, 0x6f,
+	0x75, 0x74, 0x75, 0x62, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x77, 0x61, 0x72, 0x6d, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x77, 0x61, 0x72, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6b,
+	0x69, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6b, 0x69, 0x63, 0x6b, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x69, 0x6d, 0x65, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x76, 0x69, 0x6d, 0x65, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x22, 0x33, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b,
+	0x65, 0x79, 0x22, 0x35, 0x0a, 0x12, 0x54, 0x65, 0x73, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x22, 0x15, 0x0a, 0x13, 0x54, 0x65, 0x73,
+	0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xdc, 0x08, 0x0a, 0x07, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x43, 0x0a, 0x05,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0xe9, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73,
+	0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31,
+	0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x48, 0x00, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x12, 0x49, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0xea, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x48, 0x00, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x05,
+	0x65, 0x6d, 0x62, 0x65, 0x64, 0x18, 0xeb, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73,
+	0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31,
+	0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x62, 0x65,
+	0x64, 0x12, 0x40, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0xec, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x48, 0x00, 0x52, 0x04, 0x63,
+	0x68, 0x61, 0x74, 0x1a, 0x41, 0x0a, 0x05, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x77, 0x61,
+	0x72, 0x6d, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x77,
+	0x61, 0x72, 0x6d, 0x55, 0x72, 0x69, 0x1a, 0x3a, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x77, 0x61, 0x72, 0x6d, 0x5f, 0x75,
+	0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x77, 0x61, 0x72, 0x6d, 0x55,
+	0x72, 0x69, 0x1a, 0x81, 0x05, 0x0a, 0x05, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x07,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e,
+	0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76,
+	0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x5e, 0x0a, 0x0c, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x3b, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf9, 0x02, 0x0a, 0x07, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2d, 0x0a, 0x29, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54,
+	0x4f, 0x52, 0x59, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x4d, 0x42, 0x45,
+	0x44, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49,
+	0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x2e, 0x0a, 0x2a, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x4f,
+	0x52, 0x59, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x4d, 0x42, 0x45, 0x44,
+	0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x41, 0x4e, 0x47, 0x45, 0x4c, 0x54, 0x48,
+	0x55, 0x4d, 0x50, 0x10, 0x01, 0x12, 0x31, 0x0a, 0x2d, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x4f,
+	0x52, 0x59, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x4d, 0x42, 0x45, 0x44,
+	0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x54, 0x57, 0x49, 0x54, 0x43, 0x48, 0x5f,
+	0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x10, 0x02, 0x12, 0x2e, 0x0a, 0x2a, 0x44, 0x49, 0x52, 0x45,
+	0x43, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x4d,
+	0x42, 0x45, 0x44, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x54, 0x57, 0x49, 0x54,
+	0x43, 0x48, 0x5f, 0x56, 0x4f, 0x44, 0x10, 0x03, 0x12, 0x2b, 0x0a, 0x27, 0x44, 0x49, 0x52, 0x45,
+	0x43, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x4d,
+	0x42, 0x45, 0x44, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x59, 0x4f, 0x55, 0x54,
+	0x55, 0x42, 0x45, 0x10, 0x04, 0x12, 0x28, 0x0a, 0x24, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x4f,
+	0x52, 0x59, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x4d, 0x42, 0x45, 0x44,
+	0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x4b, 0x49, 0x43, 0x4b, 0x10, 0x05, 0x12,
+	0x29, 0x0a, 0x25, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x4c, 0x49, 0x53,
+	0x54, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x4d, 0x42, 0x45, 0x44, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49,
+	0x43, 0x45, 0x5f, 0x56, 0x49, 0x4d, 0x45, 0x4f, 0x10, 0x06, 0x12, 0x2a, 0x0a, 0x26, 0x44, 0x49,
+	0x52, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f,
+	0x45, 0x4d, 0x42, 0x45, 0x44, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x4f, 0x45,
+	0x4d, 0x42, 0x45, 0x44, 0x10, 0x07, 0x1a, 0x2c, 0x0a, 0x04, 0x43, 0x68, 0x61, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22,
+	0x67, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65,
+	0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x13, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0xe9, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x2b, 0x0a, 0x05, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x18, 0xea, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x48,
+	0x00, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x22, 0xc6, 0x04, 0x0a, 0x0e, 0x4c, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f,
+	0x6d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73,
+	0x4d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x4e, 0x0a, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e,
+	0x61, 0x69, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53,
+	0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x09, 0x74, 0x68, 0x75,
+	0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x12, 0x53, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x5f, 0x6c, 0x6f, 0x67, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73,
+	0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31,
+	0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0b,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x6f, 0x67, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x07, 0x52, 0x0a, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x11, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x91, 0x4e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x1d, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x92, 0x4e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x22, 0x76, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x76,
+	0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0xdc, 0x08, 0x0a, 0x13, 0x4c, 0x69,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x74,
+	0x61, 0x12, 0x32, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12,
+	0x3f, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x3b, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a,
+	0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x37, 0x0a,
+	0x09, 0x69, 0x73, 0x5f, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x69, 0x73,
+	0x4d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2d, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x39, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x3f, 0x0a, 0x0c, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x57, 0x69, 0x64, 0x74, 0x68,
+	0x12, 0x3d, 0x0a, 0x0b, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0a, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12,
+	0x3a, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x4c, 0x0a, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x18, 0xe9, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x73, 0x74, 0x72,
+	0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x2e, 0x54, 0x61, 0x67,
+	0x73, 0x48, 0x00, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x51, 0x0a, 0x09, 0x74, 0x68, 0x75,
+	0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0xd1, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e,
+	0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76,
+	0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x48,
+	0x01, 0x52, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x12, 0x56, 0x0a, 0x0c,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6c, 0x6f, 0x67, 0x6f, 0x18, 0xb9, 0x17, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x48, 0x02, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x4c, 0x6f, 0x67, 0x6f, 0x1a, 0x1b, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x12, 0x13, 0x0a, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x18, 0xe9, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x74, 0x61, 0x67, 0x73, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42,
+	0x11, 0x0a, 0x0f, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x5f, 0x6f, 0x6e, 0x65,
+	0x6f, 0x66, 0x42, 0x14, 0x0a, 0x12, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6c, 0x6f,
+	0x67, 0x6f, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x22, 0xe6, 0x08, 0x0a, 0x05, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x5a, 0x0a, 0x0e, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x18, 0xe9, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52,
+	0x0d, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x4d,
+	0x0a, 0x09, 0x75, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x18, 0xea, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x48, 0x00, 0x52, 0x09, 0x75, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x61, 0x0a,
+	0x11, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x18, 0xeb, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52,
+	0x0f, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x6a, 0x0a, 0x14, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63,
+	0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0xec, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x35, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x12, 0x75, 0x73, 0x65, 0x72, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3e, 0x0a, 0x04,
+	0x70, 0x69, 0x6e, 0x67, 0x18, 0xed, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e,
+	0x50, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x1a, 0xe2, 0x02, 0x0a,
+	0x0d, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3e,
+	0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x45,
+	0x0a, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x07, 0x73, 0x6e,
+	0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x4e, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x4d,
+	0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
+	0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
+	0x67, 0x12, 0x4e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x76, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x1a, 0x1b, 0x0a, 0x09, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x1a, 0x6c,
+	0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x72, 0x65, 0x63,
+	0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x8e, 0x01, 0x0a,
+	0x12, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x65,
+	0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x65, 0x65,
+	0x72, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x73, 0x1a, 0x1a, 0x0a,
+	0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x62, 0x6f, 0x64,
+	0x79, 0x22, 0xbf, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6d, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x69, 0x73, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x37, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x08, 0x69, 0x73, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x08, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x22, 0x6d, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x12, 0x11, 0x0a, 0x02, 0x69, 0x64, 0x18, 0xe9, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x41, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x18, 0xea, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d,
+	0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x00,
+	0x52, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x07, 0x0a, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x22, 0xe4, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x4e, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73,
+	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x92, 0x02, 0x0a, 0x16, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x45, 0x0a, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65,
+	0x74, 0x52, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x9a,
+	0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6c, 0x69,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0xd3, 0x02, 0x0a, 0x14,
+	0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x65, 0x61, 0x6b, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x65,
+	0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x70, 0x65, 0x61, 0x6b, 0x56, 0x69,
+	0x65, 0x77, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x76,
+	0x69, 0x65, 0x77, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0c, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x53, 0x6b, 0x65, 0x74, 0x63,
+	0x68, 0x22, 0xe9, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x65, 0x61, 0x6b, 0x5f, 0x76, 0x69, 0x65, 0x77,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x70, 0x65, 0x61, 0x6b, 0x56,
+	0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0e, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x56,
+	0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x93, 0x01,
+	0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x3e, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x3f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x22, 0x7a, 0x0a, 0x12, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x3f,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76,
+	0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22,
+	0x8a, 0x02, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6a, 0x6f,
+	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4a, 0x6f, 0x69,
+	0x6e, 0x12, 0x43, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x3d, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x69, 0x73, 0x4d, 0x6f, 0x64, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x07, 0x69, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x22, 0xa3, 0x01, 0x0a,
+	0x0a, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65,
+	0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x65,
+	0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x4b, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x4c, 0x0a, 0x0e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0x7e, 0x0a, 0x0b, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12,
+	0x26, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x74, 0x72,
+	0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x22, 0x50, 0x0a, 0x0e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x3e, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74,
 	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
 	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69,
 	0x6e, 0x67, 0x22, 0x21, 0x0a, 0x0f, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73,
@@ -5757,169 +7863,151 @@ var file_network_v1_directory_directory_proto_rawDesc = []byte{
 	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1d,
 	0x0a, 0x0b, 0x50, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
 	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x0e, 0x0a,
-	0x0c, 0x50, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0d, 0x0a,
-	0x0b, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0e, 0x0a, 0x0c,
-	0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x78, 0x0a, 0x16,
-	0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4e, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x74, 0x72,
-	0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
-	0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x7d, 0x0a, 0x13, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72,
-	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72,
-	0x4b, 0x65, 0x79, 0x12, 0x4b, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73,
-	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x16, 0x0a, 0x14, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0xc7, 0x02, 0x0a, 0x13, 0x4e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x74, 0x65,
-	0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x3e, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x0c, 0x50, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb7, 0x01,
+	0x0a, 0x0f, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3e, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77,
 	0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
 	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e,
-	0x67, 0x12, 0x45, 0x0a, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x67, 0x12, 0x45, 0x0a, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77,
 	0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
 	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x52,
-	0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x4e, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73,
-	0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31,
-	0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69,
-	0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6d, 0x6f,
-	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x75, 0x73,
-	0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x65, 0x6e,
-	0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x22, 0x9f, 0x01, 0x0a, 0x0f, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4c,
-	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x3e, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d,
-	0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x4c, 0x0a, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x69,
-	0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x74, 0x72, 0x69,
-	0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4c,
-	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x08, 0x6c, 0x69, 0x73,
-	0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x79, 0x0a, 0x16, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79,
-	0x12, 0x3e, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x22, 0x0a, 0x10, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x23, 0x0a, 0x11, 0x55,
+	0x6e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x14, 0x0a, 0x12, 0x55, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x4b, 0x65, 0x79,
+	0x12, 0x3e, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
 	0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e,
 	0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
-	0x22, 0x29, 0x0a, 0x17, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4b, 0x0a, 0x18, 0x46,
-	0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x46, 0x72, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x64, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x77, 0x0a, 0x13, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x64, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x12, 0x3f, 0x0a,
-	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73,
-	0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31,
-	0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69,
-	0x6e, 0x67, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x26,
-	0x0a, 0x14, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x46, 0x0a, 0x13, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x64, 0x50, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x16,
-	0x0a, 0x14, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x0a, 0x13, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x64, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x22, 0x16,
-	0x0a, 0x14, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa1, 0x01, 0x0a, 0x1e, 0x46, 0x72, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4e, 0x0a, 0x0a, 0x6d, 0x6f,
-	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e,
+	0x22, 0xb5, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x08,
+	0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d,
 	0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
 	0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
-	0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x21, 0x0a, 0x1f, 0x46, 0x72,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa1, 0x01,
-	0x0a, 0x1b, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
-	0x6c, 0x69, 0x61, 0x73, 0x12, 0x4b, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d,
-	0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x72,
+	0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x08, 0x6c,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x4f, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x73, 0x22, 0x0d, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x78, 0x0a, 0x16, 0x4d, 0x6f, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x4e, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x72,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x1e, 0x0a, 0x1c, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x4d, 0x6f, 0x64,
-	0x65, 0x72, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x19, 0x0a, 0x17, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x47, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xea, 0x03, 0x0a,
-	0x18, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x05, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d,
-	0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x5f, 0x0a, 0x08, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e,
+	0x6e, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7d, 0x0a, 0x13,
+	0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x4b,
+	0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x4d,
+	0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x3f, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x22, 0xb3, 0x03, 0x0a, 0x13, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3e, 0x0a, 0x07,
+	0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
 	0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76,
-	0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x64, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x08, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x1a, 0x3e, 0x0a, 0x05,
-	0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04,
-	0x52, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x73, 0x1a, 0x78, 0x0a, 0x04,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x55, 0x0a, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x6c, 0x69,
-	0x61, 0x73, 0x52, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x70,
-	0x65, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70,
-	0x65, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x1a, 0x61, 0x0a, 0x0d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3a, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d,
-	0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x7d, 0x0a, 0x19, 0x46, 0x72, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x64, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x22, 0xce, 0x02, 0x0a, 0x1a, 0x46, 0x72, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x64, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3e, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69,
+	0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x45, 0x0a, 0x07,
+	0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76,
+	0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x07, 0x73, 0x6e, 0x69, 0x70,
+	0x70, 0x65, 0x74, 0x12, 0x4e, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73,
+	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x72,
+	0x65, 0x63, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x4e, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e,
+	0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
+	0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x9f, 0x01, 0x0a, 0x0f, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x3e,
+	0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x4c,
+	0x0a, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x30, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x97, 0x01, 0x0a,
+	0x16, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x12, 0x3e, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6e,
+	0x79, 0x6d, 0x6f, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6e, 0x6f,
+	0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x22, 0x29, 0x0a, 0x17, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x69, 0x0a, 0x18, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x55, 0x6e, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x22, 0x1b, 0x0a, 0x19,
+	0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x77, 0x0a, 0x13, 0x46, 0x72, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x64, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65,
+	0x79, 0x12, 0x3f, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x22, 0x26, 0x0a, 0x14, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x4a, 0x6f,
+	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x46, 0x0a, 0x13, 0x46, 0x72,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b,
+	0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x50, 0x61,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe0, 0x01, 0x0a, 0x17, 0x46,
+	0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x12, 0x3e, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69,
 	0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d,
 	0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72,
 	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07,
@@ -5927,331 +8015,602 @@ var file_network_v1_directory_directory_proto_rawDesc = []byte{
 	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d,
 	0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72,
 	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x6e,
-	0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x4e,
-	0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d,
-	0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a,
-	0x11, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x1a, 0x46, 0x72,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x54, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32,
-	0x2f, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2a, 0x0a,
+	0x18, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4c, 0x0a, 0x19, 0x46, 0x72, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x64, 0x55, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x46, 0x72, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x64, 0x55, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x1f, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x64, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x12, 0x3f, 0x0a, 0x05, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x78, 0x0a, 0x20, 0x46,
+	0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x54, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x33, 0x0a, 0x21, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x64, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x24, 0x0a, 0x22, 0x46, 0x72,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x29, 0x0a, 0x27, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x82, 0x01, 0x0a, 0x28,
+	0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x30, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
 	0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x21,
-	0x0a, 0x0c, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79,
-	0x73, 0x22, 0x67, 0x0a, 0x1b, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x47, 0x65, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x48, 0x0a, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0xe0, 0x01, 0x0a, 0x1e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x47, 0x65, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72,
+	0x4b, 0x65, 0x79, 0x12, 0x3e, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x22, 0xbd, 0x01, 0x0a, 0x1f, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64,
+	0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x4f, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x65, 0x72, 0x73, 0x22, 0x36, 0x0a, 0x13, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x54,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x22, 0x16, 0x0a, 0x14, 0x46,
+	0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xa1, 0x01, 0x0a, 0x1e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64,
+	0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4e, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6d, 0x6f, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x21, 0x0a, 0x1f, 0x46, 0x72, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa1, 0x01, 0x0a, 0x1b, 0x46,
+	0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61,
+	0x73, 0x12, 0x4b, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1e,
+	0x0a, 0x1c, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19,
+	0x0a, 0x17, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xea, 0x03, 0x0a, 0x18, 0x46, 0x72,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x5f, 0x0a, 0x08, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x73, 0x74, 0x72,
+	0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x64, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x08, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x1a, 0x3e, 0x0a, 0x05, 0x41, 0x6c, 0x69,
+	0x61, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x73, 0x1a, 0x78, 0x0a, 0x04, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x55, 0x0a, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77,
 	0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
-	0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73,
-	0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x1c, 0x46,
-	0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74,
-	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x54, 0x0a, 0x0d, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77,
+	0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52,
+	0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72,
+	0x4b, 0x65, 0x79, 0x1a, 0x61, 0x0a, 0x0d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x7d, 0x0a, 0x19, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x64, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77,
 	0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
-	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6b, 0x65, 0x79,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x4b, 0x65, 0x79, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f,
-	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e,
-	0x67, 0x49, 0x64, 0x22, 0x88, 0x06, 0x0a, 0x1d, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64,
-	0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x4b, 0x65, 0x79, 0x22, 0xba, 0x03, 0x0a, 0x1a, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x64, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x3e, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e,
 	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x57, 0x61, 0x74, 0x63,
-	0x68, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x1a,
-	0x52, 0x0a, 0x06, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x48, 0x0a, 0x08, 0x6c, 0x69, 0x73,
-	0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x74,
-	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x69,
-	0x6e, 0x67, 0x73, 0x1a, 0x49, 0x0a, 0x09, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
-	0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12,
-	0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x1a, 0x9a,
-	0x01, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49,
-	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x64,
-	0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x2a, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x65,
-	0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xd0, 0x02, 0x0a, 0x05,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x5c, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18,
-	0xe9, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e,
+	0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6c, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x45, 0x0a, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x6e, 0x69, 0x70, 0x70,
+	0x65, 0x74, 0x52, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x4e, 0x0a, 0x0a, 0x6d,
+	0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2e, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65,
+	0x63, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69,
+	0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69,
+	0x6e, 0x67, 0x12, 0x4e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x76,
+	0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x22, 0xb1, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x54, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73,
+	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0xa7, 0x02, 0x0a, 0x1a, 0x46, 0x72, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x64, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x54, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x73,
+	0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31,
+	0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x42,
+	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
+	0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x4c, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x6f, 0x72, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x22, 0x67, 0x0a, 0x1b, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x47, 0x65, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x48, 0x0a, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e,
+	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x08, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xc8, 0x02, 0x0a, 0x1c, 0x46, 0x72,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x54, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x2f, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b,
+	0x65, 0x79, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x49, 0x64, 0x12, 0x42, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x73, 0x74, 0x72,
+	0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x22, 0x88, 0x06, 0x0a, 0x1d, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x64, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e,
 	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63,
 	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x57, 0x61, 0x74,
 	0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x06, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x12, 0x65, 0x0a, 0x09, 0x75, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
-	0x18, 0xea, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73,
+	0x73, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x1a, 0x52, 0x0a, 0x06, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x48, 0x0a, 0x08, 0x6c, 0x69,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73,
+	0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31,
+	0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x1a, 0x49, 0x0a, 0x09, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x1a,
+	0x9a, 0x01, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x72, 0x65, 0x63,
+	0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xd0, 0x02, 0x0a,
+	0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x5c, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x18, 0xe9, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73,
 	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65,
 	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x57, 0x61,
 	0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x48, 0x00, 0x52,
-	0x09, 0x75, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x79, 0x0a, 0x11, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18,
-	0xeb, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4a, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x57, 0x61, 0x74,
-	0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x84,
-	0x01, 0x0a, 0x20, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x57, 0x61, 0x74, 0x63, 0x68,
-	0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x4b, 0x65, 0x79, 0x12, 0x3f, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74,
+	0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x06, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x65, 0x0a, 0x09, 0x75, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x18, 0xea, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d,
+	0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x48, 0x00,
+	0x52, 0x09, 0x75, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x79, 0x0a, 0x11, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x18, 0xeb, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4a, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73,
+	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22,
+	0x84, 0x01, 0x0a, 0x20, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x4b, 0x65, 0x79, 0x12, 0x3f, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x8a, 0x03, 0x0a, 0x21, 0x46, 0x72, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x64, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x4c, 0x2e, 0x73, 0x74, 0x72,
+	0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x64, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x59,
+	0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e,
+	0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76,
+	0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x64, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x1a, 0x47, 0x0a, 0x04, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x4b,
+	0x65, 0x79, 0x22, 0x5f, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x18, 0x0a,
+	0x14, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x50, 0x41, 0x52, 0x54, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x55, 0x53, 0x45, 0x52, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x4e, 0x41, 0x4d,
+	0x45, 0x10, 0x02, 0x22, 0x22, 0x0a, 0x20, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb0, 0x01, 0x0a, 0x21, 0x46, 0x72, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x64, 0x57, 0x61, 0x74, 0x63, 0x68, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x75,
+	0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x12, 0x4b, 0x0a,
+	0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74,
 	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x05,
-	0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x8a, 0x03, 0x0a, 0x21, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x64, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x55, 0x73,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x4c, 0x2e, 0x73, 0x74, 0x72, 0x69,
-	0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64,
-	0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x59, 0x0a,
-	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x73,
+	0x79, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x0b, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x22, 0x34, 0x0a, 0x17, 0x53, 0x6e,
+	0x69, 0x70, 0x70, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x77, 0x61, 0x72, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x73, 0x77, 0x61, 0x72, 0x6d, 0x49, 0x64,
+	0x22, 0x71, 0x0a, 0x18, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0d,
+	0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74,
+	0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x0c, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x44, 0x65,
+	0x6c, 0x74, 0x61, 0x2a, 0xb9, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x4c, 0x49,
+	0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e,
+	0x0a, 0x1a, 0x4c, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x10, 0x01, 0x12, 0x20,
+	0x0a, 0x1c, 0x4c, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x10, 0x02,
+	0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x54,
+	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x4d, 0x42, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x1d, 0x0a, 0x19, 0x4c, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x54,
+	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x54, 0x10, 0x04, 0x2a,
+	0x73, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x1c, 0x4c, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x53,
+	0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49,
+	0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47,
+	0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x56, 0x49, 0x45, 0x57,
+	0x45, 0x52, 0x53, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x4c, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47,
+	0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x43, 0x45,
+	0x4e, 0x54, 0x10, 0x02, 0x32, 0xbd, 0x08, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x64, 0x0a, 0x07, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x2b, 0x2e,
+	0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76,
+	0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x74, 0x72,
+	0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x09, 0x55, 0x6e, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x2d, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x2e, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x2e, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x04, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x28, 0x2e, 0x73,
 	0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31,
-	0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x64, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x55,
-	0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x1a, 0x47, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6b,
-	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x4b, 0x65,
-	0x79, 0x22, 0x5f, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14,
-	0x55, 0x53, 0x45, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x50, 0x41, 0x52, 0x54, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x4e, 0x41, 0x4d, 0x45,
-	0x10, 0x02, 0x22, 0x22, 0x0a, 0x20, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x57, 0x61,
-	0x74, 0x63, 0x68, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb0, 0x01, 0x0a, 0x21, 0x46, 0x72, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x64, 0x57, 0x61, 0x74, 0x63, 0x68, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x75, 0x6e,
-	0x64, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x12, 0x4b, 0x0a, 0x0c,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77,
+	0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5b, 0x0a, 0x04, 0x50, 0x61, 0x72, 0x74, 0x12, 0x28, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b,
+	0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x50,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x08, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x2c, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73,
+	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x0a, 0x55, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x12, 0x2e, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77,
 	0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
-	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x0b, 0x61, 0x73,
-	0x73, 0x65, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x22, 0x34, 0x0a, 0x17, 0x53, 0x6e, 0x69,
-	0x70, 0x70, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x77, 0x61, 0x72, 0x6d, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x73, 0x77, 0x61, 0x72, 0x6d, 0x49, 0x64, 0x22,
-	0x71, 0x0a, 0x18, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0d, 0x73,
-	0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77,
+	0x2e, 0x55, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77,
 	0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x44,
-	0x65, 0x6c, 0x74, 0x61, 0x52, 0x0c, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x44, 0x65, 0x6c,
-	0x74, 0x61, 0x2a, 0xb9, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x4c, 0x49, 0x53,
-	0x54, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a,
-	0x1a, 0x4c, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x10, 0x01, 0x12, 0x20, 0x0a,
-	0x1c, 0x4c, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x10, 0x02, 0x12,
-	0x1e, 0x0a, 0x1a, 0x4c, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45,
-	0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x4d, 0x42, 0x45, 0x44, 0x10, 0x03, 0x12,
-	0x1d, 0x0a, 0x19, 0x4c, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45,
-	0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x54, 0x10, 0x04, 0x32, 0xe7,
-	0x05, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x64, 0x0a, 0x07,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x2b, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73,
-	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x79, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x6a, 0x0a, 0x09, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12,
-	0x2d, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x55, 0x6e,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
-	0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
-	0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x55, 0x6e, 0x70,
-	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b,
-	0x0a, 0x04, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x28, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e,
+	0x2e, 0x55, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x0f, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x33, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e,
 	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x29, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4a,
-	0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x04, 0x50,
-	0x61, 0x72, 0x74, 0x12, 0x28, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74,
+	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x73, 0x0a, 0x0c, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x30, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e,
+	0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74,
 	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
-	0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76,
-	0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x50, 0x61, 0x72, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67,
-	0x12, 0x28, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x50,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x74, 0x72,
+	0x79, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x33, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34,
+	0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
+	0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x47, 0x65, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x32, 0xf0, 0x13, 0x0a, 0x11, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x12, 0x74, 0x0a, 0x07, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x33, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x73, 0x74, 0x72,
 	0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x0f, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x33, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d,
-	0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4c,
-	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x7a, 0x0a, 0x09, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x35, 0x2e,
 	0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76,
-	0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x4d, 0x6f, 0x64, 0x65,
-	0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x0c, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x12, 0x30, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74,
+	0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x64, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x55, 0x6e, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x04,
+	0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x30, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x4a, 0x6f, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x4a, 0x6f, 0x69,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x04, 0x50, 0x61, 0x72,
+	0x74, 0x12, 0x30, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e,
+	0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74,
 	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x79, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x95, 0x0d, 0x0a, 0x11, 0x44, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x12, 0x74,
-	0x0a, 0x07, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x33, 0x2e, 0x73, 0x74, 0x72, 0x69,
-	0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34,
+	0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x04, 0x54, 0x65, 0x73, 0x74, 0x12, 0x30,
 	0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
 	0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x09, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
-	0x68, 0x12, 0x35, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e,
-	0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d,
-	0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x55,
-	0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x6b, 0x0a, 0x04, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x30, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d,
-	0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x4a,
-	0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x73, 0x74, 0x72,
+	0x6e, 0x74, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x31, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46,
+	0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x08, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12,
+	0x34, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x0a,
+	0x55, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x36, 0x2e, 0x73, 0x74, 0x72,
 	0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64,
 	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x64, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a,
-	0x04, 0x50, 0x61, 0x72, 0x74, 0x12, 0x30, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73,
-	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x50, 0x61,
-	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x04, 0x54, 0x65,
-	0x73, 0x74, 0x12, 0x30, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77,
+	0x64, 0x55, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x37, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77,
 	0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
-	0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65,
+	0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x55, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8f, 0x01, 0x0a, 0x10,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x3c, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46,
+	0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d,
+	0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
+	0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x64, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x95, 0x01,
+	0x0a, 0x12, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x3e, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65,
 	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8c, 0x01, 0x0a, 0x0f, 0x4d, 0x6f, 0x64, 0x65,
-	0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x3b, 0x2e, 0x73, 0x74,
-	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d,
+	0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x55, 0x6e, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x3f, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x55, 0x6e, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa7, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x44, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x45, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d,
 	0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x4d,
-	0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83, 0x01, 0x0a, 0x0c, 0x4d, 0x6f, 0x64, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x38, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73,
-	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x4d, 0x6f,
-	0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x39, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e,
-	0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x08,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x34, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x8c, 0x01, 0x0a, 0x0f, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x3b, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x3c, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46,
+	0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83,
+	0x01, 0x0a, 0x0c, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x38, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64,
+	0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8c, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x3b, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d,
 	0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72,
 	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35,
-	0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
-	0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x64, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74,
-	0x69, 0x6e, 0x67, 0x12, 0x36, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73,
-	0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x73, 0x74,
+	0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x47, 0x65, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12,
+	0x34, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x0a,
+	0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x36, 0x2e, 0x73, 0x74, 0x72,
+	0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x64, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x37, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x37, 0x2e, 0x73, 0x74,
 	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e,
 	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x64, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74,
-	0x69, 0x6e, 0x67, 0x73, 0x12, 0x37, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65,
+	0x6e, 0x64, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65,
 	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
 	0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x47, 0x65, 0x74, 0x4c, 0x69,
-	0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e,
-	0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76,
-	0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x64, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x0d, 0x57, 0x61, 0x74, 0x63,
-	0x68, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x39, 0x2e, 0x73, 0x74, 0x72, 0x69,
-	0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64,
-	0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x57, 0x61, 0x74, 0x63, 0x68,
-	0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x30, 0x01, 0x12, 0x94, 0x01, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74,
-	0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x3d, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d,
-	0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x57,
-	0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73,
-	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x57, 0x61,
-	0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x88,
+	0x01, 0x0a, 0x0d, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x39, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46,
+	0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x64, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x94, 0x01, 0x0a, 0x11, 0x57, 0x61,
-	0x74, 0x63, 0x68, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12,
+	0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12,
 	0x3d, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
 	0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x57, 0x61, 0x74, 0x63, 0x68, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e,
 	0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
 	0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x64, 0x57, 0x61, 0x74, 0x63, 0x68, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42,
-	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01,
-	0x32, 0x8e, 0x01, 0x0a, 0x10, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x6e,
-	0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x7a, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x12, 0x34, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
-	0x2e, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d,
-	0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30,
-	0x01, 0x42, 0x73, 0x0a, 0x1e, 0x67, 0x67, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e,
+	0x6e, 0x74, 0x65, 0x6e, 0x64, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01,
+	0x12, 0x94, 0x01, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12, 0x3d, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e,
 	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x79, 0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x4d, 0x65, 0x6d, 0x65, 0x4c, 0x61, 0x62, 0x73, 0x2f, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2f,
-	0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x2f, 0x76, 0x31, 0x2f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x3b, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x76, 0x31, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
-	0xba, 0x02, 0x03, 0x53, 0x4e, 0x44, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x72, 0x79, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x32, 0x8e, 0x01, 0x0a, 0x10, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x7a, 0x0a, 0x09,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x34, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x35, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x76, 0x31, 0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x53, 0x6e,
+	0x69, 0x70, 0x70, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x42, 0x73, 0x0a, 0x1e, 0x67, 0x67, 0x2e, 0x73,
+	0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x76, 0x31,
+	0x2e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x65, 0x6d, 0x65, 0x4c, 0x61, 0x62, 0x73, 0x2f,
+	0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x3b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x76, 0x31, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0xba, 0x02, 0x03, 0x53, 0x4e, 0x44, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -6266,242 +8625,324 @@ func file_network_v1_directory_directory_proto_rawDescGZIP() []byte {
 	return file_network_v1_directory_directory_proto_rawDescData
 }
 
-var file_network_v1_directory_directory_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_network_v1_directory_directory_proto_msgTypes = make([]protoimpl.MessageInfo, 87)
+var file_network_v1_directory_directory_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_network_v1_directory_directory_proto_msgTypes = make([]protoimpl.MessageInfo, 117)
 var file_network_v1_directory_directory_proto_goTypes = []interface{}{
 	(ListingContentType)(0),                               // 0: strims.network.v1.directory.ListingContentType
-	(Listing_Embed_Service)(0),                            // 1: strims.network.v1.directory.Listing.Embed.Service
-	(FrontendWatchListingUsersResponse_UserEventType)(0),  // 2: strims.network.v1.directory.FrontendWatchListingUsersResponse.UserEventType
-	(*ServerConfig)(nil),                                  // 3: strims.network.v1.directory.ServerConfig
-	(*ClientConfig)(nil),                                  // 4: strims.network.v1.directory.ClientConfig
-	(*GetEventsRequest)(nil),                              // 5: strims.network.v1.directory.GetEventsRequest
-	(*TestPublishRequest)(nil),                            // 6: strims.network.v1.directory.TestPublishRequest
-	(*TestPublishResponse)(nil),                           // 7: strims.network.v1.directory.TestPublishResponse
-	(*Listing)(nil),                                       // 8: strims.network.v1.directory.Listing
-	(*ListingSnippetImage)(nil),                           // 9: strims.network.v1.directory.ListingSnippetImage
-	(*ListingSnippet)(nil),                                // 10: strims.network.v1.directory.ListingSnippet
-	(*ListingSnippetDelta)(nil),                           // 11: strims.network.v1.directory.ListingSnippetDelta
-	(*Event)(nil),                                         // 12: strims.network.v1.directory.Event
-	(*ListingModeration)(nil),                             // 13: strims.network.v1.directory.ListingModeration
-	(*ListingQuery)(nil),                                  // 14: strims.network.v1.directory.ListingQuery
-	(*ListingRecord)(nil),                                 // 15: strims.network.v1.directory.ListingRecord
-	(*UserModeration)(nil),                                // 16: strims.network.v1.directory.UserModeration
-	(*UserRecord)(nil),                                    // 17: strims.network.v1.directory.UserRecord
-	(*EventBroadcast)(nil),                                // 18: strims.network.v1.directory.EventBroadcast
-	(*AssetBundle)(nil),                                   // 19: strims.network.v1.directory.AssetBundle
-	(*PublishRequest)(nil),                                // 20: strims.network.v1.directory.PublishRequest
-	(*PublishResponse)(nil),                               // 21: strims.network.v1.directory.PublishResponse
-	(*UnpublishRequest)(nil),                              // 22: strims.network.v1.directory.UnpublishRequest
-	(*UnpublishResponse)(nil),                             // 23: strims.network.v1.directory.UnpublishResponse
-	(*JoinRequest)(nil),                                   // 24: strims.network.v1.directory.JoinRequest
-	(*JoinResponse)(nil),                                  // 25: strims.network.v1.directory.JoinResponse
-	(*PartRequest)(nil),                                   // 26: strims.network.v1.directory.PartRequest
-	(*PartResponse)(nil),                                  // 27: strims.network.v1.directory.PartResponse
-	(*PingRequest)(nil),                                   // 28: strims.network.v1.directory.PingRequest
-	(*PingResponse)(nil),                                  // 29: strims.network.v1.directory.PingResponse
-	(*ModerateListingRequest)(nil),                        // 30: strims.network.v1.directory.ModerateListingRequest
-	(*ModerateListingResponse)(nil),                       // 31: strims.network.v1.directory.ModerateListingResponse
-	(*ModerateUserRequest)(nil),                           // 32: strims.network.v1.directory.ModerateUserRequest
-	(*ModerateUserResponse)(nil),                          // 33: strims.network.v1.directory.ModerateUserResponse
-	(*Network)(nil),                                       // 34: strims.network.v1.directory.Network
-	(*NetworkListingsItem)(nil),                           // 35: strims.network.v1.directory.NetworkListingsItem
-	(*NetworkListings)(nil),                               // 36: strims.network.v1.directory.NetworkListings
-	(*FrontendPublishRequest)(nil),                        // 37: strims.network.v1.directory.FrontendPublishRequest
-	(*FrontendPublishResponse)(nil),                       // 38: strims.network.v1.directory.FrontendPublishResponse
-	(*FrontendUnpublishRequest)(nil),                      // 39: strims.network.v1.directory.FrontendUnpublishRequest
-	(*FrontendUnpublishResponse)(nil),                     // 40: strims.network.v1.directory.FrontendUnpublishResponse
-	(*FrontendJoinRequest)(nil),                           // 41: strims.network.v1.directory.FrontendJoinRequest
-	(*FrontendJoinResponse)(nil),                          // 42: strims.network.v1.directory.FrontendJoinResponse
-	(*FrontendPartRequest)(nil),                           // 43: strims.network.v1.directory.FrontendPartRequest
-	(*FrontendPartResponse)(nil),                          // 44: strims.network.v1.directory.FrontendPartResponse
-	(*FrontendTestRequest)(nil),                           // 45: strims.network.v1.directory.FrontendTestRequest
-	(*FrontendTestResponse)(nil),                          // 46: strims.network.v1.directory.FrontendTestResponse
-	(*FrontendModerateListingRequest)(nil),                // 47: strims.network.v1.directory.FrontendModerateListingRequest
-	(*FrontendModerateListingResponse)(nil),               // 48: strims.network.v1.directory.FrontendModerateListingResponse
-	(*FrontendModerateUserRequest)(nil),                   // 49: strims.network.v1.directory.FrontendModerateUserRequest
-	(*FrontendModerateUserResponse)(nil),                  // 50: strims.network.v1.directory.FrontendModerateUserResponse
-	(*FrontendGetUsersRequest)(nil),                       // 51: strims.network.v1.directory.FrontendGetUsersRequest
-	(*FrontendGetUsersResponse)(nil),                      // 52: strims.network.v1.directory.FrontendGetUsersResponse
-	(*FrontendGetListingRequest)(nil),                     // 53: strims.network.v1.directory.FrontendGetListingRequest
-	(*FrontendGetListingResponse)(nil),                    // 54: strims.network.v1.directory.FrontendGetListingResponse
-	(*FrontendGetListingsRequest)(nil),                    // 55: strims.network.v1.directory.FrontendGetListingsRequest
-	(*FrontendGetListingsResponse)(nil),                   // 56: strims.network.v1.directory.FrontendGetListingsResponse
-	(*FrontendWatchListingsRequest)(nil),                  // 57: strims.network.v1.directory.FrontendWatchListingsRequest
-	(*FrontendWatchListingsResponse)(nil),                 // 58: strims.network.v1.directory.FrontendWatchListingsResponse
-	(*FrontendWatchListingUsersRequest)(nil),              // 59: strims.network.v1.directory.FrontendWatchListingUsersRequest
-	(*FrontendWatchListingUsersResponse)(nil),             // 60: strims.network.v1.directory.FrontendWatchListingUsersResponse
-	(*FrontendWatchAssetBundlesRequest)(nil),              // 61: strims.network.v1.directory.FrontendWatchAssetBundlesRequest
-	(*FrontendWatchAssetBundlesResponse)(nil),             // 62: strims.network.v1.directory.FrontendWatchAssetBundlesResponse
-	(*SnippetSubscribeRequest)(nil),                       // 63: strims.network.v1.directory.SnippetSubscribeRequest
-	(*SnippetSubscribeResponse)(nil),                      // 64: strims.network.v1.directory.SnippetSubscribeResponse
-	(*ServerConfig_Integrations)(nil),                     // 65: strims.network.v1.directory.ServerConfig.Integrations
-	(*ServerConfig_Integrations_AngelThump)(nil),          // 66: strims.network.v1.directory.ServerConfig.Integrations.AngelThump
-	(*ServerConfig_Integrations_Twitch)(nil),              // 67: strims.network.v1.directory.ServerConfig.Integrations.Twitch
-	(*ServerConfig_Integrations_YouTube)(nil),             // 68: strims.network.v1.directory.ServerConfig.Integrations.YouTube
-	(*ServerConfig_Integrations_Swarm)(nil),               // 69: strims.network.v1.directory.ServerConfig.Integrations.Swarm
-	(*ClientConfig_Integrations)(nil),                     // 70: strims.network.v1.directory.ClientConfig.Integrations
-	(*Listing_Media)(nil),                                 // 71: strims.network.v1.directory.Listing.Media
-	(*Listing_Service)(nil),                               // 72: strims.network.v1.directory.Listing.Service
-	(*Listing_Embed)(nil),                                 // 73: strims.network.v1.directory.Listing.Embed
-	(*Listing_Chat)(nil),                                  // 74: strims.network.v1.directory.Listing.Chat
-	nil,                                                   // 75: strims.network.v1.directory.Listing.Embed.QueryParamsEntry
-	(*ListingSnippetDelta_Tags)(nil),                      // 76: strims.network.v1.directory.ListingSnippetDelta.Tags
-	(*Event_ListingChange)(nil),                           // 77: strims.network.v1.directory.Event.ListingChange
-	(*Event_Unpublish)(nil),                               // 78: strims.network.v1.directory.Event.Unpublish
-	(*Event_UserCountChange)(nil),                         // 79: strims.network.v1.directory.Event.UserCountChange
-	(*Event_UserPresenceChange)(nil),                      // 80: strims.network.v1.directory.Event.UserPresenceChange
-	(*Event_Ping)(nil),                                    // 81: strims.network.v1.directory.Event.Ping
-	(*FrontendGetUsersResponse_Alias)(nil),                // 82: strims.network.v1.directory.FrontendGetUsersResponse.Alias
-	(*FrontendGetUsersResponse_User)(nil),                 // 83: strims.network.v1.directory.FrontendGetUsersResponse.User
-	nil,                                                   // 84: strims.network.v1.directory.FrontendGetUsersResponse.NetworksEntry
-	(*FrontendWatchListingsResponse_Change)(nil),          // 85: strims.network.v1.directory.FrontendWatchListingsResponse.Change
-	(*FrontendWatchListingsResponse_Unpublish)(nil),       // 86: strims.network.v1.directory.FrontendWatchListingsResponse.Unpublish
-	(*FrontendWatchListingsResponse_UserCountChange)(nil), // 87: strims.network.v1.directory.FrontendWatchListingsResponse.UserCountChange
-	(*FrontendWatchListingsResponse_Event)(nil),           // 88: strims.network.v1.directory.FrontendWatchListingsResponse.Event
-	(*FrontendWatchListingUsersResponse_User)(nil),        // 89: strims.network.v1.directory.FrontendWatchListingUsersResponse.User
-	(*image.Image)(nil),                                   // 90: strims.type.Image
-	(*wrapperspb.StringValue)(nil),                        // 91: google.protobuf.StringValue
-	(*wrapperspb.UInt64Value)(nil),                        // 92: google.protobuf.UInt64Value
-	(*wrapperspb.BoolValue)(nil),                          // 93: google.protobuf.BoolValue
-	(*wrapperspb.BytesValue)(nil),                         // 94: google.protobuf.BytesValue
-	(*wrapperspb.UInt32Value)(nil),                        // 95: google.protobuf.UInt32Value
-	(*wrapperspb.Int64Value)(nil),                         // 96: google.protobuf.Int64Value
+	(ListingSortOrder)(0),                                 // 1: strims.network.v1.directory.ListingSortOrder
+	(Listing_Embed_Service)(0),                            // 2: strims.network.v1.directory.Listing.Embed.Service
+	(FrontendWatchListingUsersResponse_UserEventType)(0),  // 3: strims.network.v1.directory.FrontendWatchListingUsersResponse.UserEventType
+	(*ServerConfig)(nil),                                  // 4: strims.network.v1.directory.ServerConfig
+	(*ClientConfig)(nil),                                  // 5: strims.network.v1.directory.ClientConfig
+	(*GetEventsRequest)(nil),                              // 6: strims.network.v1.directory.GetEventsRequest
+	(*TestPublishRequest)(nil),                            // 7: strims.network.v1.directory.TestPublishRequest
+	(*TestPublishResponse)(nil),                           // 8: strims.network.v1.directory.TestPublishResponse
+	(*Listing)(nil),                                       // 9: strims.network.v1.directory.Listing
+	(*ListingSnippetImage)(nil),                           // 10: strims.network.v1.directory.ListingSnippetImage
+	(*ListingSnippet)(nil),                                // 11: strims.network.v1.directory.ListingSnippet
+	(*ListingProvenance)(nil),                             // 12: strims.network.v1.directory.ListingProvenance
+	(*ListingSnippetDelta)(nil),                           // 13: strims.network.v1.directory.ListingSnippetDelta
+	(*Event)(nil),                                         // 14: strims.network.v1.directory.Event
+	(*ListingModeration)(nil),                             // 15: strims.network.v1.directory.ListingModeration
+	(*ListingQuery)(nil),                                  // 16: strims.network.v1.directory.ListingQuery
+	(*ListingRecord)(nil),                                 // 17: strims.network.v1.directory.ListingRecord
+	(*ScheduledListingRecord)(nil),                        // 18: strims.network.v1.directory.ScheduledListingRecord
+	(*ListingSubscription)(nil),                           // 19: strims.network.v1.directory.ListingSubscription
+	(*ListingSessionRecord)(nil),                          // 20: strims.network.v1.directory.ListingSessionRecord
+	(*ListingStats)(nil),                                  // 21: strims.network.v1.directory.ListingStats
+	(*ListingStatsItem)(nil),                              // 22: strims.network.v1.directory.ListingStatsItem
+	(*PublisherStatsItem)(nil),                            // 23: strims.network.v1.directory.PublisherStatsItem
+	(*UserModeration)(nil),                                // 24: strims.network.v1.directory.UserModeration
+	(*UserRecord)(nil),                                    // 25: strims.network.v1.directory.UserRecord
+	(*EventBroadcast)(nil),                                // 26: strims.network.v1.directory.EventBroadcast
+	(*AssetBundle)(nil),                                   // 27: strims.network.v1.directory.AssetBundle
+	(*PublishRequest)(nil),                                // 28: strims.network.v1.directory.PublishRequest
+	(*PublishResponse)(nil),                               // 29: strims.network.v1.directory.PublishResponse
+	(*UnpublishRequest)(nil),                              // 30: strims.network.v1.directory.UnpublishRequest
+	(*UnpublishResponse)(nil),                             // 31: strims.network.v1.directory.UnpublishResponse
+	(*JoinRequest)(nil),                                   // 32: strims.network.v1.directory.JoinRequest
+	(*JoinResponse)(nil),                                  // 33: strims.network.v1.directory.JoinResponse
+	(*PartRequest)(nil),                                   // 34: strims.network.v1.directory.PartRequest
+	(*PartResponse)(nil),                                  // 35: strims.network.v1.directory.PartResponse
+	(*ScheduleRequest)(nil),                               // 36: strims.network.v1.directory.ScheduleRequest
+	(*ScheduleResponse)(nil),                              // 37: strims.network.v1.directory.ScheduleResponse
+	(*UnscheduleRequest)(nil),                             // 38: strims.network.v1.directory.UnscheduleRequest
+	(*UnscheduleResponse)(nil),                            // 39: strims.network.v1.directory.UnscheduleResponse
+	(*GetListingStatsRequest)(nil),                        // 40: strims.network.v1.directory.GetListingStatsRequest
+	(*GetListingStatsResponse)(nil),                       // 41: strims.network.v1.directory.GetListingStatsResponse
+	(*PingRequest)(nil),                                   // 42: strims.network.v1.directory.PingRequest
+	(*PingResponse)(nil),                                  // 43: strims.network.v1.directory.PingResponse
+	(*ModerateListingRequest)(nil),                        // 44: strims.network.v1.directory.ModerateListingRequest
+	(*ModerateListingResponse)(nil),                       // 45: strims.network.v1.directory.ModerateListingResponse
+	(*ModerateUserRequest)(nil),                           // 46: strims.network.v1.directory.ModerateUserRequest
+	(*ModerateUserResponse)(nil),                          // 47: strims.network.v1.directory.ModerateUserResponse
+	(*Network)(nil),                                       // 48: strims.network.v1.directory.Network
+	(*NetworkListingsItem)(nil),                           // 49: strims.network.v1.directory.NetworkListingsItem
+	(*NetworkListings)(nil),                               // 50: strims.network.v1.directory.NetworkListings
+	(*FrontendPublishRequest)(nil),                        // 51: strims.network.v1.directory.FrontendPublishRequest
+	(*FrontendPublishResponse)(nil),                       // 52: strims.network.v1.directory.FrontendPublishResponse
+	(*FrontendUnpublishRequest)(nil),                      // 53: strims.network.v1.directory.FrontendUnpublishRequest
+	(*FrontendUnpublishResponse)(nil),                     // 54: strims.network.v1.directory.FrontendUnpublishResponse
+	(*FrontendJoinRequest)(nil),                           // 55: strims.network.v1.directory.FrontendJoinRequest
+	(*FrontendJoinResponse)(nil),                          // 56: strims.network.v1.directory.FrontendJoinResponse
+	(*FrontendPartRequest)(nil),                           // 57: strims.network.v1.directory.FrontendPartRequest
+	(*FrontendPartResponse)(nil),                          // 58: strims.network.v1.directory.FrontendPartResponse
+	(*FrontendScheduleRequest)(nil),                       // 59: strims.network.v1.directory.FrontendScheduleRequest
+	(*FrontendScheduleResponse)(nil),                      // 60: strims.network.v1.directory.FrontendScheduleResponse
+	(*FrontendUnscheduleRequest)(nil),                     // 61: strims.network.v1.directory.FrontendUnscheduleRequest
+	(*FrontendUnscheduleResponse)(nil),                    // 62: strims.network.v1.directory.FrontendUnscheduleResponse
+	(*FrontendSubscribeListingRequest)(nil),               // 63: strims.network.v1.directory.FrontendSubscribeListingRequest
+	(*FrontendSubscribeListingResponse)(nil),              // 64: strims.network.v1.directory.FrontendSubscribeListingResponse
+	(*FrontendUnsubscribeListingRequest)(nil),             // 65: strims.network.v1.directory.FrontendUnsubscribeListingRequest
+	(*FrontendUnsubscribeListingResponse)(nil),            // 66: strims.network.v1.directory.FrontendUnsubscribeListingResponse
+	(*FrontendListListingSubscriptionsRequest)(nil),       // 67: strims.network.v1.directory.FrontendListListingSubscriptionsRequest
+	(*FrontendListListingSubscriptionsResponse)(nil),      // 68: strims.network.v1.directory.FrontendListListingSubscriptionsResponse
+	(*FrontendGetListingStatsRequest)(nil),                // 69: strims.network.v1.directory.FrontendGetListingStatsRequest
+	(*FrontendGetListingStatsResponse)(nil),               // 70: strims.network.v1.directory.FrontendGetListingStatsResponse
+	(*FrontendTestRequest)(nil),                           // 71: strims.network.v1.directory.FrontendTestRequest
+	(*FrontendTestResponse)(nil),                          // 72: strims.network.v1.directory.FrontendTestResponse
+	(*FrontendModerateListingRequest)(nil),                // 73: strims.network.v1.directory.FrontendModerateListingRequest
+	(*FrontendModerateListingResponse)(nil),               // 74: strims.network.v1.directory.FrontendModerateListingResponse
+	(*FrontendModerateUserRequest)(nil),                   // 75: strims.network.v1.directory.FrontendModerateUserRequest
+	(*FrontendModerateUserResponse)(nil),                  // 76: strims.network.v1.directory.FrontendModerateUserResponse
+	(*FrontendGetUsersRequest)(nil),                       // 77: strims.network.v1.directory.FrontendGetUsersRequest
+	(*FrontendGetUsersResponse)(nil),                      // 78: strims.network.v1.directory.FrontendGetUsersResponse
+	(*FrontendGetListingRequest)(nil),                     // 79: strims.network.v1.directory.FrontendGetListingRequest
+	(*FrontendGetListingResponse)(nil),                    // 80: strims.network.v1.directory.FrontendGetListingResponse
+	(*ListingFilter)(nil),                                 // 81: strims.network.v1.directory.ListingFilter
+	(*FrontendGetListingsRequest)(nil),                    // 82: strims.network.v1.directory.FrontendGetListingsRequest
+	(*FrontendGetListingsResponse)(nil),                   // 83: strims.network.v1.directory.FrontendGetListingsResponse
+	(*FrontendWatchListingsRequest)(nil),                  // 84: strims.network.v1.directory.FrontendWatchListingsRequest
+	(*FrontendWatchListingsResponse)(nil),                 // 85: strims.network.v1.directory.FrontendWatchListingsResponse
+	(*FrontendWatchListingUsersRequest)(nil),              // 86: strims.network.v1.directory.FrontendWatchListingUsersRequest
+	(*FrontendWatchListingUsersResponse)(nil),             // 87: strims.network.v1.directory.FrontendWatchListingUsersResponse
+	(*FrontendWatchAssetBundlesRequest)(nil),              // 88: strims.network.v1.directory.FrontendWatchAssetBundlesRequest
+	(*FrontendWatchAssetBundlesResponse)(nil),             // 89: strims.network.v1.directory.FrontendWatchAssetBundlesResponse
+	(*SnippetSubscribeRequest)(nil),                       // 90: strims.network.v1.directory.SnippetSubscribeRequest
+	(*SnippetSubscribeResponse)(nil),                      // 91: strims.network.v1.directory.SnippetSubscribeResponse
+	(*ServerConfig_Integrations)(nil),                     // 92: strims.network.v1.directory.ServerConfig.Integrations
+	(*ServerConfig_Federation)(nil),                       // 93: strims.network.v1.directory.ServerConfig.Federation
+	(*ServerConfig_Integrations_AngelThump)(nil),          // 94: strims.network.v1.directory.ServerConfig.Integrations.AngelThump
+	(*ServerConfig_Integrations_Twitch)(nil),              // 95: strims.network.v1.directory.ServerConfig.Integrations.Twitch
+	(*ServerConfig_Integrations_YouTube)(nil),             // 96: strims.network.v1.directory.ServerConfig.Integrations.YouTube
+	(*ServerConfig_Integrations_Swarm)(nil),               // 97: strims.network.v1.directory.ServerConfig.Integrations.Swarm
+	(*ServerConfig_Integrations_Kick)(nil),                // 98: strims.network.v1.directory.ServerConfig.Integrations.Kick
+	(*ServerConfig_Integrations_Vimeo)(nil),               // 99: strims.network.v1.directory.ServerConfig.Integrations.Vimeo
+	(*ServerConfig_Integrations_OEmbed)(nil),              // 100: strims.network.v1.directory.ServerConfig.Integrations.OEmbed
+	(*ClientConfig_Integrations)(nil),                     // 101: strims.network.v1.directory.ClientConfig.Integrations
+	(*Listing_Media)(nil),                                 // 102: strims.network.v1.directory.Listing.Media
+	(*Listing_Service)(nil),                               // 103: strims.network.v1.directory.Listing.Service
+	(*Listing_Embed)(nil),                                 // 104: strims.network.v1.directory.Listing.Embed
+	(*Listing_Chat)(nil),                                  // 105: strims.network.v1.directory.Listing.Chat
+	nil,                                                   // 106: strims.network.v1.directory.Listing.Embed.QueryParamsEntry
+	(*ListingSnippetDelta_Tags)(nil),                      // 107: strims.network.v1.directory.ListingSnippetDelta.Tags
+	(*Event_ListingChange)(nil),                           // 108: strims.network.v1.directory.Event.ListingChange
+	(*Event_Unpublish)(nil),                               // 109: strims.network.v1.directory.Event.Unpublish
+	(*Event_UserCountChange)(nil),                         // 110: strims.network.v1.directory.Event.UserCountChange
+	(*Event_UserPresenceChange)(nil),                      // 111: strims.network.v1.directory.Event.UserPresenceChange
+	(*Event_Ping)(nil),                                    // 112: strims.network.v1.directory.Event.Ping
+	(*FrontendGetUsersResponse_Alias)(nil),                // 113: strims.network.v1.directory.FrontendGetUsersResponse.Alias
+	(*FrontendGetUsersResponse_User)(nil),                 // 114: strims.network.v1.directory.FrontendGetUsersResponse.User
+	nil,                                                   // 115: strims.network.v1.directory.FrontendGetUsersResponse.NetworksEntry
+	(*FrontendWatchListingsResponse_Change)(nil),          // 116: strims.network.v1.directory.FrontendWatchListingsResponse.Change
+	(*FrontendWatchListingsResponse_Unpublish)(nil),       // 117: strims.network.v1.directory.FrontendWatchListingsResponse.Unpublish
+	(*FrontendWatchListingsResponse_UserCountChange)(nil), // 118: strims.network.v1.directory.FrontendWatchListingsResponse.UserCountChange
+	(*FrontendWatchListingsResponse_Event)(nil),           // 119: strims.network.v1.directory.FrontendWatchListingsResponse.Event
+	(*FrontendWatchListingUsersResponse_User)(nil),        // 120: strims.network.v1.directory.FrontendWatchListingUsersResponse.User
+	(*image.Image)(nil),                                   // 121: strims.type.Image
+	(*wrapperspb.StringValue)(nil),                        // 122: google.protobuf.StringValue
+	(*wrapperspb.UInt64Value)(nil),                        // 123: google.protobuf.UInt64Value
+	(*wrapperspb.BoolValue)(nil),                          // 124: google.protobuf.BoolValue
+	(*wrapperspb.BytesValue)(nil),                         // 125: google.protobuf.BytesValue
+	(*wrapperspb.UInt32Value)(nil),                        // 126: google.protobuf.UInt32Value
+	(*wrapperspb.Int64Value)(nil),                         // 127: google.protobuf.Int64Value
 }
 var file_network_v1_directory_directory_proto_depIdxs = []int32{
-	65,  // 0: strims.network.v1.directory.ServerConfig.integrations:type_name -> strims.network.v1.directory.ServerConfig.Integrations
-	70,  // 1: strims.network.v1.directory.ClientConfig.integrations:type_name -> strims.network.v1.directory.ClientConfig.Integrations
-	71,  // 2: strims.network.v1.directory.Listing.media:type_name -> strims.network.v1.directory.Listing.Media
-	72,  // 3: strims.network.v1.directory.Listing.service:type_name -> strims.network.v1.directory.Listing.Service
-	73,  // 4: strims.network.v1.directory.Listing.embed:type_name -> strims.network.v1.directory.Listing.Embed
-	74,  // 5: strims.network.v1.directory.Listing.chat:type_name -> strims.network.v1.directory.Listing.Chat
-	90,  // 6: strims.network.v1.directory.ListingSnippetImage.image:type_name -> strims.type.Image
-	9,   // 7: strims.network.v1.directory.ListingSnippet.thumbnail:type_name -> strims.network.v1.directory.ListingSnippetImage
-	9,   // 8: strims.network.v1.directory.ListingSnippet.channel_logo:type_name -> strims.network.v1.directory.ListingSnippetImage
-	91,  // 9: strims.network.v1.directory.ListingSnippetDelta.title:type_name -> google.protobuf.StringValue
-	91,  // 10: strims.network.v1.directory.ListingSnippetDelta.description:type_name -> google.protobuf.StringValue
-	91,  // 11: strims.network.v1.directory.ListingSnippetDelta.category:type_name -> google.protobuf.StringValue
-	91,  // 12: strims.network.v1.directory.ListingSnippetDelta.channel_name:type_name -> google.protobuf.StringValue
-	92,  // 13: strims.network.v1.directory.ListingSnippetDelta.user_count:type_name -> google.protobuf.UInt64Value
-	93,  // 14: strims.network.v1.directory.ListingSnippetDelta.live:type_name -> google.protobuf.BoolValue
-	93,  // 15: strims.network.v1.directory.ListingSnippetDelta.is_mature:type_name -> google.protobuf.BoolValue
-	94,  // 16: strims.network.v1.directory.ListingSnippetDelta.key:type_name -> google.protobuf.BytesValue
-	94,  // 17: strims.network.v1.directory.ListingSnippetDelta.signature:type_name -> google.protobuf.BytesValue
-	95,  // 18: strims.network.v1.directory.ListingSnippetDelta.video_height:type_name -> google.protobuf.UInt32Value
-	95,  // 19: strims.network.v1.directory.ListingSnippetDelta.video_width:type_name -> google.protobuf.UInt32Value
-	95,  // 20: strims.network.v1.directory.ListingSnippetDelta.theme_color:type_name -> google.protobuf.UInt32Value
-	96,  // 21: strims.network.v1.directory.ListingSnippetDelta.start_time:type_name -> google.protobuf.Int64Value
-	76,  // 22: strims.network.v1.directory.ListingSnippetDelta.tags:type_name -> strims.network.v1.directory.ListingSnippetDelta.Tags
-	9,   // 23: strims.network.v1.directory.ListingSnippetDelta.thumbnail:type_name -> strims.network.v1.directory.ListingSnippetImage
-	9,   // 24: strims.network.v1.directory.ListingSnippetDelta.channel_logo:type_name -> strims.network.v1.directory.ListingSnippetImage
-	77,  // 25: strims.network.v1.directory.Event.listing_change:type_name -> strims.network.v1.directory.Event.ListingChange
-	78,  // 26: strims.network.v1.directory.Event.unpublish:type_name -> strims.network.v1.directory.Event.Unpublish
-	79,  // 27: strims.network.v1.directory.Event.user_count_change:type_name -> strims.network.v1.directory.Event.UserCountChange
-	80,  // 28: strims.network.v1.directory.Event.user_presence_change:type_name -> strims.network.v1.directory.Event.UserPresenceChange
-	81,  // 29: strims.network.v1.directory.Event.ping:type_name -> strims.network.v1.directory.Event.Ping
-	93,  // 30: strims.network.v1.directory.ListingModeration.is_mature:type_name -> google.protobuf.BoolValue
-	93,  // 31: strims.network.v1.directory.ListingModeration.is_banned:type_name -> google.protobuf.BoolValue
-	91,  // 32: strims.network.v1.directory.ListingModeration.category:type_name -> google.protobuf.StringValue
-	8,   // 33: strims.network.v1.directory.ListingQuery.listing:type_name -> strims.network.v1.directory.Listing
-	8,   // 34: strims.network.v1.directory.ListingRecord.listing:type_name -> strims.network.v1.directory.Listing
-	13,  // 35: strims.network.v1.directory.ListingRecord.moderation:type_name -> strims.network.v1.directory.ListingModeration
-	93,  // 36: strims.network.v1.directory.UserModeration.disable_join:type_name -> google.protobuf.BoolValue
-	93,  // 37: strims.network.v1.directory.UserModeration.disable_publish:type_name -> google.protobuf.BoolValue
-	93,  // 38: strims.network.v1.directory.UserModeration.is_moderator:type_name -> google.protobuf.BoolValue
-	93,  // 39: strims.network.v1.directory.UserModeration.is_admin:type_name -> google.protobuf.BoolValue
-	16,  // 40: strims.network.v1.directory.UserRecord.moderation:type_name -> strims.network.v1.directory.UserModeration
-	12,  // 41: strims.network.v1.directory.EventBroadcast.events:type_name -> strims.network.v1.directory.Event
-	90,  // 42: strims.network.v1.directory.AssetBundle.icon:type_name -> strims.type.Image
-	4,   // 43: strims.network.v1.directory.AssetBundle.directory:type_name -> strims.network.v1.directory.ClientConfig
-	8,   // 44: strims.network.v1.directory.PublishRequest.listing:type_name -> strims.network.v1.directory.Listing
-	14,  // 45: strims.network.v1.directory.JoinRequest.query:type_name -> strims.network.v1.directory.ListingQuery
-	13,  // 46: strims.network.v1.directory.ModerateListingRequest.moderation:type_name -> strims.network.v1.directory.ListingModeration
-	16,  // 47: strims.network.v1.directory.ModerateUserRequest.moderation:type_name -> strims.network.v1.directory.UserModeration
-	8,   // 48: strims.network.v1.directory.NetworkListingsItem.listing:type_name -> strims.network.v1.directory.Listing
-	10,  // 49: strims.network.v1.directory.NetworkListingsItem.snippet:type_name -> strims.network.v1.directory.ListingSnippet
-	13,  // 50: strims.network.v1.directory.NetworkListingsItem.moderation:type_name -> strims.network.v1.directory.ListingModeration
-	34,  // 51: strims.network.v1.directory.NetworkListings.network:type_name -> strims.network.v1.directory.Network
-	35,  // 52: strims.network.v1.directory.NetworkListings.listings:type_name -> strims.network.v1.directory.NetworkListingsItem
-	8,   // 53: strims.network.v1.directory.FrontendPublishRequest.listing:type_name -> strims.network.v1.directory.Listing
-	14,  // 54: strims.network.v1.directory.FrontendJoinRequest.query:type_name -> strims.network.v1.directory.ListingQuery
-	13,  // 55: strims.network.v1.directory.FrontendModerateListingRequest.moderation:type_name -> strims.network.v1.directory.ListingModeration
-	16,  // 56: strims.network.v1.directory.FrontendModerateUserRequest.moderation:type_name -> strims.network.v1.directory.UserModeration
-	83,  // 57: strims.network.v1.directory.FrontendGetUsersResponse.users:type_name -> strims.network.v1.directory.FrontendGetUsersResponse.User
-	84,  // 58: strims.network.v1.directory.FrontendGetUsersResponse.networks:type_name -> strims.network.v1.directory.FrontendGetUsersResponse.NetworksEntry
-	14,  // 59: strims.network.v1.directory.FrontendGetListingRequest.query:type_name -> strims.network.v1.directory.ListingQuery
-	8,   // 60: strims.network.v1.directory.FrontendGetListingResponse.listing:type_name -> strims.network.v1.directory.Listing
-	10,  // 61: strims.network.v1.directory.FrontendGetListingResponse.snippet:type_name -> strims.network.v1.directory.ListingSnippet
-	13,  // 62: strims.network.v1.directory.FrontendGetListingResponse.moderation:type_name -> strims.network.v1.directory.ListingModeration
-	0,   // 63: strims.network.v1.directory.FrontendGetListingsRequest.content_types:type_name -> strims.network.v1.directory.ListingContentType
-	36,  // 64: strims.network.v1.directory.FrontendGetListingsResponse.listings:type_name -> strims.network.v1.directory.NetworkListings
-	0,   // 65: strims.network.v1.directory.FrontendWatchListingsRequest.content_types:type_name -> strims.network.v1.directory.ListingContentType
-	88,  // 66: strims.network.v1.directory.FrontendWatchListingsResponse.events:type_name -> strims.network.v1.directory.FrontendWatchListingsResponse.Event
-	14,  // 67: strims.network.v1.directory.FrontendWatchListingUsersRequest.query:type_name -> strims.network.v1.directory.ListingQuery
-	2,   // 68: strims.network.v1.directory.FrontendWatchListingUsersResponse.type:type_name -> strims.network.v1.directory.FrontendWatchListingUsersResponse.UserEventType
-	89,  // 69: strims.network.v1.directory.FrontendWatchListingUsersResponse.users:type_name -> strims.network.v1.directory.FrontendWatchListingUsersResponse.User
-	19,  // 70: strims.network.v1.directory.FrontendWatchAssetBundlesResponse.asset_bundle:type_name -> strims.network.v1.directory.AssetBundle
-	11,  // 71: strims.network.v1.directory.SnippetSubscribeResponse.snippet_delta:type_name -> strims.network.v1.directory.ListingSnippetDelta
-	66,  // 72: strims.network.v1.directory.ServerConfig.Integrations.angelthump:type_name -> strims.network.v1.directory.ServerConfig.Integrations.AngelThump
-	67,  // 73: strims.network.v1.directory.ServerConfig.Integrations.twitch:type_name -> strims.network.v1.directory.ServerConfig.Integrations.Twitch
-	68,  // 74: strims.network.v1.directory.ServerConfig.Integrations.youtube:type_name -> strims.network.v1.directory.ServerConfig.Integrations.YouTube
-	69,  // 75: strims.network.v1.directory.ServerConfig.Integrations.swarm:type_name -> strims.network.v1.directory.ServerConfig.Integrations.Swarm
-	1,   // 76: strims.network.v1.directory.Listing.Embed.service:type_name -> strims.network.v1.directory.Listing.Embed.Service
-	75,  // 77: strims.network.v1.directory.Listing.Embed.query_params:type_name -> strims.network.v1.directory.Listing.Embed.QueryParamsEntry
-	8,   // 78: strims.network.v1.directory.Event.ListingChange.listing:type_name -> strims.network.v1.directory.Listing
-	10,  // 79: strims.network.v1.directory.Event.ListingChange.snippet:type_name -> strims.network.v1.directory.ListingSnippet
-	13,  // 80: strims.network.v1.directory.Event.ListingChange.moderation:type_name -> strims.network.v1.directory.ListingModeration
-	82,  // 81: strims.network.v1.directory.FrontendGetUsersResponse.User.aliases:type_name -> strims.network.v1.directory.FrontendGetUsersResponse.Alias
-	34,  // 82: strims.network.v1.directory.FrontendGetUsersResponse.NetworksEntry.value:type_name -> strims.network.v1.directory.Network
-	36,  // 83: strims.network.v1.directory.FrontendWatchListingsResponse.Change.listings:type_name -> strims.network.v1.directory.NetworkListings
-	85,  // 84: strims.network.v1.directory.FrontendWatchListingsResponse.Event.change:type_name -> strims.network.v1.directory.FrontendWatchListingsResponse.Change
-	86,  // 85: strims.network.v1.directory.FrontendWatchListingsResponse.Event.unpublish:type_name -> strims.network.v1.directory.FrontendWatchListingsResponse.Unpublish
-	87,  // 86: strims.network.v1.directory.FrontendWatchListingsResponse.Event.user_count_change:type_name -> strims.network.v1.directory.FrontendWatchListingsResponse.UserCountChange
-	20,  // 87: strims.network.v1.directory.Directory.Publish:input_type -> strims.network.v1.directory.PublishRequest
-	22,  // 88: strims.network.v1.directory.Directory.Unpublish:input_type -> strims.network.v1.directory.UnpublishRequest
-	24,  // 89: strims.network.v1.directory.Directory.Join:input_type -> strims.network.v1.directory.JoinRequest
-	26,  // 90: strims.network.v1.directory.Directory.Part:input_type -> strims.network.v1.directory.PartRequest
-	28,  // 91: strims.network.v1.directory.Directory.Ping:input_type -> strims.network.v1.directory.PingRequest
-	30,  // 92: strims.network.v1.directory.Directory.ModerateListing:input_type -> strims.network.v1.directory.ModerateListingRequest
-	32,  // 93: strims.network.v1.directory.Directory.ModerateUser:input_type -> strims.network.v1.directory.ModerateUserRequest
-	37,  // 94: strims.network.v1.directory.DirectoryFrontend.Publish:input_type -> strims.network.v1.directory.FrontendPublishRequest
-	39,  // 95: strims.network.v1.directory.DirectoryFrontend.Unpublish:input_type -> strims.network.v1.directory.FrontendUnpublishRequest
-	41,  // 96: strims.network.v1.directory.DirectoryFrontend.Join:input_type -> strims.network.v1.directory.FrontendJoinRequest
-	43,  // 97: strims.network.v1.directory.DirectoryFrontend.Part:input_type -> strims.network.v1.directory.FrontendPartRequest
-	45,  // 98: strims.network.v1.directory.DirectoryFrontend.Test:input_type -> strims.network.v1.directory.FrontendTestRequest
-	47,  // 99: strims.network.v1.directory.DirectoryFrontend.ModerateListing:input_type -> strims.network.v1.directory.FrontendModerateListingRequest
-	49,  // 100: strims.network.v1.directory.DirectoryFrontend.ModerateUser:input_type -> strims.network.v1.directory.FrontendModerateUserRequest
-	51,  // 101: strims.network.v1.directory.DirectoryFrontend.GetUsers:input_type -> strims.network.v1.directory.FrontendGetUsersRequest
-	53,  // 102: strims.network.v1.directory.DirectoryFrontend.GetListing:input_type -> strims.network.v1.directory.FrontendGetListingRequest
-	55,  // 103: strims.network.v1.directory.DirectoryFrontend.GetListings:input_type -> strims.network.v1.directory.FrontendGetListingsRequest
-	57,  // 104: strims.network.v1.directory.DirectoryFrontend.WatchListings:input_type -> strims.network.v1.directory.FrontendWatchListingsRequest
-	59,  // 105: strims.network.v1.directory.DirectoryFrontend.WatchListingUsers:input_type -> strims.network.v1.directory.FrontendWatchListingUsersRequest
-	61,  // 106: strims.network.v1.directory.DirectoryFrontend.WatchAssetBundles:input_type -> strims.network.v1.directory.FrontendWatchAssetBundlesRequest
-	63,  // 107: strims.network.v1.directory.DirectorySnippet.Subscribe:input_type -> strims.network.v1.directory.SnippetSubscribeRequest
-	21,  // 108: strims.network.v1.directory.Directory.Publish:output_type -> strims.network.v1.directory.PublishResponse
-	23,  // 109: strims.network.v1.directory.Directory.Unpublish:output_type -> strims.network.v1.directory.UnpublishResponse
-	25,  // 110: strims.network.v1.directory.Directory.Join:output_type -> strims.network.v1.directory.JoinResponse
-	27,  // 111: strims.network.v1.directory.Directory.Part:output_type -> strims.network.v1.directory.PartResponse
-	29,  // 112: strims.network.v1.directory.Directory.Ping:output_type -> strims.network.v1.directory.PingResponse
-	31,  // 113: strims.network.v1.directory.Directory.ModerateListing:output_type -> strims.network.v1.directory.ModerateListingResponse
-	33,  // 114: strims.network.v1.directory.Directory.ModerateUser:output_type -> strims.network.v1.directory.ModerateUserResponse
-	38,  // 115: strims.network.v1.directory.DirectoryFrontend.Publish:output_type -> strims.network.v1.directory.FrontendPublishResponse
-	40,  // 116: strims.network.v1.directory.DirectoryFrontend.Unpublish:output_type -> strims.network.v1.directory.FrontendUnpublishResponse
-	42,  // 117: strims.network.v1.directory.DirectoryFrontend.Join:output_type -> strims.network.v1.directory.FrontendJoinResponse
-	44,  // 118: strims.network.v1.directory.DirectoryFrontend.Part:output_type -> strims.network.v1.directory.FrontendPartResponse
-	46,  // 119: strims.network.v1.directory.DirectoryFrontend.Test:output_type -> strims.network.v1.directory.FrontendTestResponse
-	48,  // 120: strims.network.v1.directory.DirectoryFrontend.ModerateListing:output_type -> strims.network.v1.directory.FrontendModerateListingResponse
-	50,  // 121: strims.network.v1.directory.DirectoryFrontend.ModerateUser:output_type -> strims.network.v1.directory.FrontendModerateUserResponse
-	52,  // 122: strims.network.v1.directory.DirectoryFrontend.GetUsers:output_type -> strims.network.v1.directory.FrontendGetUsersResponse
-	54,  // 123: strims.network.v1.directory.DirectoryFrontend.GetListing:output_type -> strims.network.v1.directory.FrontendGetListingResponse
-	56,  // 124: strims.network.v1.directory.DirectoryFrontend.GetListings:output_type -> strims.network.v1.directory.FrontendGetListingsResponse
-	58,  // 125: strims.network.v1.directory.DirectoryFrontend.WatchListings:output_type -> strims.network.v1.directory.FrontendWatchListingsResponse
-	60,  // 126: strims.network.v1.directory.DirectoryFrontend.WatchListingUsers:output_type -> strims.network.v1.directory.FrontendWatchListingUsersResponse
-	62,  // 127: strims.network.v1.directory.DirectoryFrontend.WatchAssetBundles:output_type -> strims.network.v1.directory.FrontendWatchAssetBundlesResponse
-	64,  // 128: strims.network.v1.directory.DirectorySnippet.Subscribe:output_type -> strims.network.v1.directory.SnippetSubscribeResponse
-	108, // [108:129] is the sub-list for method output_type
-	87,  // [87:108] is the sub-list for method input_type
-	87,  // [87:87] is the sub-list for extension type_name
-	87,  // [87:87] is the sub-list for extension extendee
-	0,   // [0:87] is the sub-list for field type_name
+	92,  // 0: strims.network.v1.directory.ServerConfig.integrations:type_name -> strims.network.v1.directory.ServerConfig.Integrations
+	93,  // 1: strims.network.v1.directory.ServerConfig.federations:type_name -> strims.network.v1.directory.ServerConfig.Federation
+	101, // 2: strims.network.v1.directory.ClientConfig.integrations:type_name -> strims.network.v1.directory.ClientConfig.Integrations
+	102, // 3: strims.network.v1.directory.Listing.media:type_name -> strims.network.v1.directory.Listing.Media
+	103, // 4: strims.network.v1.directory.Listing.service:type_name -> strims.network.v1.directory.Listing.Service
+	104, // 5: strims.network.v1.directory.Listing.embed:type_name -> strims.network.v1.directory.Listing.Embed
+	105, // 6: strims.network.v1.directory.Listing.chat:type_name -> strims.network.v1.directory.Listing.Chat
+	121, // 7: strims.network.v1.directory.ListingSnippetImage.image:type_name -> strims.type.Image
+	10,  // 8: strims.network.v1.directory.ListingSnippet.thumbnail:type_name -> strims.network.v1.directory.ListingSnippetImage
+	10,  // 9: strims.network.v1.directory.ListingSnippet.channel_logo:type_name -> strims.network.v1.directory.ListingSnippetImage
+	122, // 10: strims.network.v1.directory.ListingSnippetDelta.title:type_name -> google.protobuf.StringValue
+	122, // 11: strims.network.v1.directory.ListingSnippetDelta.description:type_name -> google.protobuf.StringValue
+	122, // 12: strims.network.v1.directory.ListingSnippetDelta.category:type_name -> google.protobuf.StringValue
+	122, // 13: strims.network.v1.directory.ListingSnippetDelta.channel_name:type_name -> google.protobuf.StringValue
+	123, // 14: strims.network.v1.directory.ListingSnippetDelta.user_count:type_name -> google.protobuf.UInt64Value
+	124, // 15: strims.network.v1.directory.ListingSnippetDelta.live:type_name -> google.protobuf.BoolValue
+	124, // 16: strims.network.v1.directory.ListingSnippetDelta.is_mature:type_name -> google.protobuf.BoolValue
+	125, // 17: strims.network.v1.directory.ListingSnippetDelta.key:type_name -> google.protobuf.BytesValue
+	125, // 18: strims.network.v1.directory.ListingSnippetDelta.signature:type_name -> google.protobuf.BytesValue
+	126, // 19: strims.network.v1.directory.ListingSnippetDelta.video_height:type_name -> google.protobuf.UInt32Value
+	126, // 20: strims.network.v1.directory.ListingSnippetDelta.video_width:type_name -> google.protobuf.UInt32Value
+	126, // 21: strims.network.v1.directory.ListingSnippetDelta.theme_color:type_name -> google.protobuf.UInt32Value
+	127, // 22: strims.network.v1.directory.ListingSnippetDelta.start_time:type_name -> google.protobuf.Int64Value
+	107, // 23: strims.network.v1.directory.ListingSnippetDelta.tags:type_name -> strims.network.v1.directory.ListingSnippetDelta.Tags
+	10,  // 24: strims.network.v1.directory.ListingSnippetDelta.thumbnail:type_name -> strims.network.v1.directory.ListingSnippetImage
+	10,  // 25: strims.network.v1.directory.ListingSnippetDelta.channel_logo:type_name -> strims.network.v1.directory.ListingSnippetImage
+	108, // 26: strims.network.v1.directory.Event.listing_change:type_name -> strims.network.v1.directory.Event.ListingChange
+	109, // 27: strims.network.v1.directory.Event.unpublish:type_name -> strims.network.v1.directory.Event.Unpublish
+	110, // 28: strims.network.v1.directory.Event.user_count_change:type_name -> strims.network.v1.directory.Event.UserCountChange
+	111, // 29: strims.network.v1.directory.Event.user_presence_change:type_name -> strims.network.v1.directory.Event.UserPresenceChange
+	112, // 30: strims.network.v1.directory.Event.ping:type_name -> strims.network.v1.directory.Event.Ping
+	124, // 31: strims.network.v1.directory.ListingModeration.is_mature:type_name -> google.protobuf.BoolValue
+	124, // 32: strims.network.v1.directory.ListingModeration.is_banned:type_name -> google.protobuf.BoolValue
+	122, // 33: strims.network.v1.directory.ListingModeration.category:type_name -> google.protobuf.StringValue
+	9,   // 34: strims.network.v1.directory.ListingQuery.listing:type_name -> strims.network.v1.directory.Listing
+	9,   // 35: strims.network.v1.directory.ListingRecord.listing:type_name -> strims.network.v1.directory.Listing
+	15,  // 36: strims.network.v1.directory.ListingRecord.moderation:type_name -> strims.network.v1.directory.ListingModeration
+	9,   // 37: strims.network.v1.directory.ScheduledListingRecord.listing:type_name -> strims.network.v1.directory.Listing
+	11,  // 38: strims.network.v1.directory.ScheduledListingRecord.snippet:type_name -> strims.network.v1.directory.ListingSnippet
+	9,   // 39: strims.network.v1.directory.ListingSubscription.listing:type_name -> strims.network.v1.directory.Listing
+	9,   // 40: strims.network.v1.directory.ListingSessionRecord.listing:type_name -> strims.network.v1.directory.Listing
+	9,   // 41: strims.network.v1.directory.ListingStatsItem.listing:type_name -> strims.network.v1.directory.Listing
+	21,  // 42: strims.network.v1.directory.ListingStatsItem.stats:type_name -> strims.network.v1.directory.ListingStats
+	21,  // 43: strims.network.v1.directory.PublisherStatsItem.stats:type_name -> strims.network.v1.directory.ListingStats
+	124, // 44: strims.network.v1.directory.UserModeration.disable_join:type_name -> google.protobuf.BoolValue
+	124, // 45: strims.network.v1.directory.UserModeration.disable_publish:type_name -> google.protobuf.BoolValue
+	124, // 46: strims.network.v1.directory.UserModeration.is_moderator:type_name -> google.protobuf.BoolValue
+	124, // 47: strims.network.v1.directory.UserModeration.is_admin:type_name -> google.protobuf.BoolValue
+	24,  // 48: strims.network.v1.directory.UserRecord.moderation:type_name -> strims.network.v1.directory.UserModeration
+	14,  // 49: strims.network.v1.directory.EventBroadcast.events:type_name -> strims.network.v1.directory.Event
+	121, // 50: strims.network.v1.directory.AssetBundle.icon:type_name -> strims.type.Image
+	5,   // 51: strims.network.v1.directory.AssetBundle.directory:type_name -> strims.network.v1.directory.ClientConfig
+	9,   // 52: strims.network.v1.directory.PublishRequest.listing:type_name -> strims.network.v1.directory.Listing
+	16,  // 53: strims.network.v1.directory.JoinRequest.query:type_name -> strims.network.v1.directory.ListingQuery
+	9,   // 54: strims.network.v1.directory.ScheduleRequest.listing:type_name -> strims.network.v1.directory.Listing
+	11,  // 55: strims.network.v1.directory.ScheduleRequest.snippet:type_name -> strims.network.v1.directory.ListingSnippet
+	9,   // 56: strims.network.v1.directory.GetListingStatsRequest.listing:type_name -> strims.network.v1.directory.Listing
+	22,  // 57: strims.network.v1.directory.GetListingStatsResponse.listings:type_name -> strims.network.v1.directory.ListingStatsItem
+	23,  // 58: strims.network.v1.directory.GetListingStatsResponse.publishers:type_name -> strims.network.v1.directory.PublisherStatsItem
+	15,  // 59: strims.network.v1.directory.ModerateListingRequest.moderation:type_name -> strims.network.v1.directory.ListingModeration
+	24,  // 60: strims.network.v1.directory.ModerateUserRequest.moderation:type_name -> strims.network.v1.directory.UserModeration
+	9,   // 61: strims.network.v1.directory.NetworkListingsItem.listing:type_name -> strims.network.v1.directory.Listing
+	11,  // 62: strims.network.v1.directory.NetworkListingsItem.snippet:type_name -> strims.network.v1.directory.ListingSnippet
+	15,  // 63: strims.network.v1.directory.NetworkListingsItem.moderation:type_name -> strims.network.v1.directory.ListingModeration
+	12,  // 64: strims.network.v1.directory.NetworkListingsItem.provenance:type_name -> strims.network.v1.directory.ListingProvenance
+	48,  // 65: strims.network.v1.directory.NetworkListings.network:type_name -> strims.network.v1.directory.Network
+	49,  // 66: strims.network.v1.directory.NetworkListings.listings:type_name -> strims.network.v1.directory.NetworkListingsItem
+	9,   // 67: strims.network.v1.directory.FrontendPublishRequest.listing:type_name -> strims.network.v1.directory.Listing
+	16,  // 68: strims.network.v1.directory.FrontendJoinRequest.query:type_name -> strims.network.v1.directory.ListingQuery
+	9,   // 69: strims.network.v1.directory.FrontendScheduleRequest.listing:type_name -> strims.network.v1.directory.Listing
+	11,  // 70: strims.network.v1.directory.FrontendScheduleRequest.snippet:type_name -> strims.network.v1.directory.ListingSnippet
+	16,  // 71: strims.network.v1.directory.FrontendSubscribeListingRequest.query:type_name -> strims.network.v1.directory.ListingQuery
+	19,  // 72: strims.network.v1.directory.FrontendSubscribeListingResponse.subscription:type_name -> strims.network.v1.directory.ListingSubscription
+	19,  // 73: strims.network.v1.directory.FrontendListListingSubscriptionsResponse.subscriptions:type_name -> strims.network.v1.directory.ListingSubscription
+	9,   // 74: strims.network.v1.directory.FrontendGetListingStatsRequest.listing:type_name -> strims.network.v1.directory.Listing
+	22,  // 75: strims.network.v1.directory.FrontendGetListingStatsResponse.listings:type_name -> strims.network.v1.directory.ListingStatsItem
+	23,  // 76: strims.network.v1.directory.FrontendGetListingStatsResponse.publishers:type_name -> strims.network.v1.directory.PublisherStatsItem
+	15,  // 77: strims.network.v1.directory.FrontendModerateListingRequest.moderation:type_name -> strims.network.v1.directory.ListingModeration
+	24,  // 78: strims.network.v1.directory.FrontendModerateUserRequest.moderation:type_name -> strims.network.v1.directory.UserModeration
+	114, // 79: strims.network.v1.directory.FrontendGetUsersResponse.users:type_name -> strims.network.v1.directory.FrontendGetUsersResponse.User
+	115, // 80: strims.network.v1.directory.FrontendGetUsersResponse.networks:type_name -> strims.network.v1.directory.FrontendGetUsersResponse.NetworksEntry
+	16,  // 81: strims.network.v1.directory.FrontendGetListingRequest.query:type_name -> strims.network.v1.directory.ListingQuery
+	9,   // 82: strims.network.v1.directory.FrontendGetListingResponse.listing:type_name -> strims.network.v1.directory.Listing
+	11,  // 83: strims.network.v1.directory.FrontendGetListingResponse.snippet:type_name -> strims.network.v1.directory.ListingSnippet
+	15,  // 84: strims.network.v1.directory.FrontendGetListingResponse.moderation:type_name -> strims.network.v1.directory.ListingModeration
+	12,  // 85: strims.network.v1.directory.FrontendGetListingResponse.provenance:type_name -> strims.network.v1.directory.ListingProvenance
+	0,   // 86: strims.network.v1.directory.ListingFilter.content_types:type_name -> strims.network.v1.directory.ListingContentType
+	0,   // 87: strims.network.v1.directory.FrontendGetListingsRequest.content_types:type_name -> strims.network.v1.directory.ListingContentType
+	81,  // 88: strims.network.v1.directory.FrontendGetListingsRequest.filter:type_name -> strims.network.v1.directory.ListingFilter
+	1,   // 89: strims.network.v1.directory.FrontendGetListingsRequest.sort_order:type_name -> strims.network.v1.directory.ListingSortOrder
+	50,  // 90: strims.network.v1.directory.FrontendGetListingsResponse.listings:type_name -> strims.network.v1.directory.NetworkListings
+	0,   // 91: strims.network.v1.directory.FrontendWatchListingsRequest.content_types:type_name -> strims.network.v1.directory.ListingContentType
+	81,  // 92: strims.network.v1.directory.FrontendWatchListingsRequest.filter:type_name -> strims.network.v1.directory.ListingFilter
+	1,   // 93: strims.network.v1.directory.FrontendWatchListingsRequest.sort_order:type_name -> strims.network.v1.directory.ListingSortOrder
+	119, // 94: strims.network.v1.directory.FrontendWatchListingsResponse.events:type_name -> strims.network.v1.directory.FrontendWatchListingsResponse.Event
+	16,  // 95: strims.network.v1.directory.FrontendWatchListingUsersRequest.query:type_name -> strims.network.v1.directory.ListingQuery
+	3,   // 96: strims.network.v1.directory.FrontendWatchListingUsersResponse.type:type_name -> strims.network.v1.directory.FrontendWatchListingUsersResponse.UserEventType
+	120, // 97: strims.network.v1.directory.FrontendWatchListingUsersResponse.users:type_name -> strims.network.v1.directory.FrontendWatchListingUsersResponse.User
+	27,  // 98: strims.network.v1.directory.FrontendWatchAssetBundlesResponse.asset_bundle:type_name -> strims.network.v1.directory.AssetBundle
+	13,  // 99: strims.network.v1.directory.SnippetSubscribeResponse.snippet_delta:type_name -> strims.network.v1.directory.ListingSnippetDelta
+	94,  // 100: strims.network.v1.directory.ServerConfig.Integrations.angelthump:type_name -> strims.network.v1.directory.ServerConfig.Integrations.AngelThump
+	95,  // 101: strims.network.v1.directory.ServerConfig.Integrations.twitch:type_name -> strims.network.v1.directory.ServerConfig.Integrations.Twitch
+	96,  // 102: strims.network.v1.directory.ServerConfig.Integrations.youtube:type_name -> strims.network.v1.directory.ServerConfig.Integrations.YouTube
+	97,  // 103: strims.network.v1.directory.ServerConfig.Integrations.swarm:type_name -> strims.network.v1.directory.ServerConfig.Integrations.Swarm
+	98,  // 104: strims.network.v1.directory.ServerConfig.Integrations.kick:type_name -> strims.network.v1.directory.ServerConfig.Integrations.Kick
+	99,  // 105: strims.network.v1.directory.ServerConfig.Integrations.vimeo:type_name -> strims.network.v1.directory.ServerConfig.Integrations.Vimeo
+	100, // 106: strims.network.v1.directory.ServerConfig.Integrations.oembed:type_name -> strims.network.v1.directory.ServerConfig.Integrations.OEmbed
+	81,  // 107: strims.network.v1.directory.ServerConfig.Federation.filter:type_name -> strims.network.v1.directory.ListingFilter
+	2,   // 108: strims.network.v1.directory.Listing.Embed.service:type_name -> strims.network.v1.directory.Listing.Embed.Service
+	106, // 109: strims.network.v1.directory.Listing.Embed.query_params:type_name -> strims.network.v1.directory.Listing.Embed.QueryParamsEntry
+	9,   // 110: strims.network.v1.directory.Event.ListingChange.listing:type_name -> strims.network.v1.directory.Listing
+	11,  // 111: strims.network.v1.directory.Event.ListingChange.snippet:type_name -> strims.network.v1.directory.ListingSnippet
+	15,  // 112: strims.network.v1.directory.Event.ListingChange.moderation:type_name -> strims.network.v1.directory.ListingModeration
+	12,  // 113: strims.network.v1.directory.Event.ListingChange.provenance:type_name -> strims.network.v1.directory.ListingProvenance
+	113, // 114: strims.network.v1.directory.FrontendGetUsersResponse.User.aliases:type_name -> strims.network.v1.directory.FrontendGetUsersResponse.Alias
+	48,  // 115: strims.network.v1.directory.FrontendGetUsersResponse.NetworksEntry.value:type_name -> strims.network.v1.directory.Network
+	50,  // 116: strims.network.v1.directory.FrontendWatchListingsResponse.Change.listings:type_name -> strims.network.v1.directory.NetworkListings
+	116, // 117: strims.network.v1.directory.FrontendWatchListingsResponse.Event.change:type_name -> strims.network.v1.directory.FrontendWatchListingsResponse.Change
+	117, // 118: strims.network.v1.directory.FrontendWatchListingsResponse.Event.unpublish:type_name -> strims.network.v1.directory.FrontendWatchListingsResponse.Unpublish
+	118, // 119: strims.network.v1.directory.FrontendWatchListingsResponse.Event.user_count_change:type_name -> strims.network.v1.directory.FrontendWatchListingsResponse.UserCountChange
+	28,  // 120: strims.network.v1.directory.Directory.Publish:input_type -> strims.network.v1.directory.PublishRequest
+	30,  // 121: strims.network.v1.directory.Directory.Unpublish:input_type -> strims.network.v1.directory.UnpublishRequest
+	32,  // 122: strims.network.v1.directory.Directory.Join:input_type -> strims.network.v1.directory.JoinRequest
+	34,  // 123: strims.network.v1.directory.Directory.Part:input_type -> strims.network.v1.directory.PartRequest
+	42,  // 124: strims.network.v1.directory.Directory.Ping:input_type -> strims.network.v1.directory.PingRequest
+	36,  // 125: strims.network.v1.directory.Directory.Schedule:input_type -> strims.network.v1.directory.ScheduleRequest
+	38,  // 126: strims.network.v1.directory.Directory.Unschedule:input_type -> strims.network.v1.directory.UnscheduleRequest
+	44,  // 127: strims.network.v1.directory.Directory.ModerateListing:input_type -> strims.network.v1.directory.ModerateListingRequest
+	46,  // 128: strims.network.v1.directory.Directory.ModerateUser:input_type -> strims.network.v1.directory.ModerateUserRequest
+	40,  // 129: strims.network.v1.directory.Directory.GetListingStats:input_type -> strims.network.v1.directory.GetListingStatsRequest
+	51,  // 130: strims.network.v1.directory.DirectoryFrontend.Publish:input_type -> strims.network.v1.directory.FrontendPublishRequest
+	53,  // 131: strims.network.v1.directory.DirectoryFrontend.Unpublish:input_type -> strims.network.v1.directory.FrontendUnpublishRequest
+	55,  // 132: strims.network.v1.directory.DirectoryFrontend.Join:input_type -> strims.network.v1.directory.FrontendJoinRequest
+	57,  // 133: strims.network.v1.directory.DirectoryFrontend.Part:input_type -> strims.network.v1.directory.FrontendPartRequest
+	71,  // 134: strims.network.v1.directory.DirectoryFrontend.Test:input_type -> strims.network.v1.directory.FrontendTestRequest
+	59,  // 135: strims.network.v1.directory.DirectoryFrontend.Schedule:input_type -> strims.network.v1.directory.FrontendScheduleRequest
+	61,  // 136: strims.network.v1.directory.DirectoryFrontend.Unschedule:input_type -> strims.network.v1.directory.FrontendUnscheduleRequest
+	63,  // 137: strims.network.v1.directory.DirectoryFrontend.SubscribeListing:input_type -> strims.network.v1.directory.FrontendSubscribeListingRequest
+	65,  // 138: strims.network.v1.directory.DirectoryFrontend.UnsubscribeListing:input_type -> strims.network.v1.directory.FrontendUnsubscribeListingRequest
+	67,  // 139: strims.network.v1.directory.DirectoryFrontend.ListListingSubscriptions:input_type -> strims.network.v1.directory.FrontendListListingSubscriptionsRequest
+	73,  // 140: strims.network.v1.directory.DirectoryFrontend.ModerateListing:input_type -> strims.network.v1.directory.FrontendModerateListingRequest
+	75,  // 141: strims.network.v1.directory.DirectoryFrontend.ModerateUser:input_type -> strims.network.v1.directory.FrontendModerateUserRequest
+	69,  // 142: strims.network.v1.directory.DirectoryFrontend.GetListingStats:input_type -> strims.network.v1.directory.FrontendGetListingStatsRequest
+	77,  // 143: strims.network.v1.directory.DirectoryFrontend.GetUsers:input_type -> strims.network.v1.directory.FrontendGetUsersRequest
+	79,  // 144: strims.network.v1.directory.DirectoryFrontend.GetListing:input_type -> strims.network.v1.directory.FrontendGetListingRequest
+	82,  // 145: strims.network.v1.directory.DirectoryFrontend.GetListings:input_type -> strims.network.v1.directory.FrontendGetListingsRequest
+	84,  // 146: strims.network.v1.directory.DirectoryFrontend.WatchListings:input_type -> strims.network.v1.directory.FrontendWatchListingsRequest
+	86,  // 147: strims.network.v1.directory.DirectoryFrontend.WatchListingUsers:input_type -> strims.network.v1.directory.FrontendWatchListingUsersRequest
+	88,  // 148: strims.network.v1.directory.DirectoryFrontend.WatchAssetBundles:input_type -> strims.network.v1.directory.FrontendWatchAssetBundlesRequest
+	90,  // 149: strims.network.v1.directory.DirectorySnippet.Subscribe:input_type -> strims.network.v1.directory.SnippetSubscribeRequest
+	29,  // 150: strims.network.v1.directory.Directory.Publish:output_type -> strims.network.v1.directory.PublishResponse
+	31,  // 151: strims.network.v1.directory.Directory.Unpublish:output_type -> strims.network.v1.directory.UnpublishResponse
+	33,  // 152: strims.network.v1.directory.Directory.Join:output_type -> strims.network.v1.directory.JoinResponse
+	35,  // 153: strims.network.v1.directory.Directory.Part:output_type -> strims.network.v1.directory.PartResponse
+	43,  // 154: strims.network.v1.directory.Directory.Ping:output_type -> strims.network.v1.directory.PingResponse
+	37,  // 155: strims.network.v1.directory.Directory.Schedule:output_type -> strims.network.v1.directory.ScheduleResponse
+	39,  // 156: strims.network.v1.directory.Directory.Unschedule:output_type -> strims.network.v1.directory.UnscheduleResponse
+	45,  // 157: strims.network.v1.directory.Directory.ModerateListing:output_type -> strims.network.v1.directory.ModerateListingResponse
+	47,  // 158: strims.network.v1.directory.Directory.ModerateUser:output_type -> strims.network.v1.directory.ModerateUserResponse
+	41,  // 159: strims.network.v1.directory.Directory.GetListingStats:output_type -> strims.network.v1.directory.GetListingStatsResponse
+	52,  // 160: strims.network.v1.directory.DirectoryFrontend.Publish:output_type -> strims.network.v1.directory.FrontendPublishResponse
+	54,  // 161: strims.network.v1.directory.DirectoryFrontend.Unpublish:output_type -> strims.network.v1.directory.FrontendUnpublishResponse
+	56,  // 162: strims.network.v1.directory.DirectoryFrontend.Join:output_type -> strims.network.v1.directory.FrontendJoinResponse
+	58,  // 163: strims.network.v1.directory.DirectoryFrontend.Part:output_type -> strims.network.v1.directory.FrontendPartResponse
+	72,  // 164: strims.network.v1.directory.DirectoryFrontend.Test:output_type -> strims.network.v1.directory.FrontendTestResponse
+	60,  // 165: strims.network.v1.directory.DirectoryFrontend.Schedule:output_type -> strims.network.v1.directory.FrontendScheduleResponse
+	62,  // 166: strims.network.v1.directory.DirectoryFrontend.Unschedule:output_type -> strims.network.v1.directory.FrontendUnscheduleResponse
+	64,  // 167: strims.network.v1.directory.DirectoryFrontend.SubscribeListing:output_type -> strims.network.v1.directory.FrontendSubscribeListingResponse
+	66,  // 168: strims.network.v1.directory.DirectoryFrontend.UnsubscribeListing:output_type -> strims.network.v1.directory.FrontendUnsubscribeListingResponse
+	68,  // 169: strims.network.v1.directory.DirectoryFrontend.ListListingSubscriptions:output_type -> strims.network.v1.directory.FrontendListListingSubscriptionsResponse
+	74,  // 170: strims.network.v1.directory.DirectoryFrontend.ModerateListing:output_type -> strims.network.v1.directory.FrontendModerateListingResponse
+	76,  // 171: strims.network.v1.directory.DirectoryFrontend.ModerateUser:output_type -> strims.network.v1.directory.FrontendModerateUserResponse
+	70,  // 172: strims.network.v1.directory.DirectoryFrontend.GetListingStats:output_type -> strims.network.v1.directory.FrontendGetListingStatsResponse
+	78,  // 173: strims.network.v1.directory.DirectoryFrontend.GetUsers:output_type -> strims.network.v1.directory.FrontendGetUsersResponse
+	80,  // 174: strims.network.v1.directory.DirectoryFrontend.GetListing:output_type -> strims.network.v1.directory.FrontendGetListingResponse
+	83,  // 175: strims.network.v1.directory.DirectoryFrontend.GetListings:output_type -> strims.network.v1.directory.FrontendGetListingsResponse
+	85,  // 176: strims.network.v1.directory.DirectoryFrontend.WatchListings:output_type -> strims.network.v1.directory.FrontendWatchListingsResponse
+	87,  // 177: strims.network.v1.directory.DirectoryFrontend.WatchListingUsers:output_type -> strims.network.v1.directory.FrontendWatchListingUsersResponse
+	89,  // 178: strims.network.v1.directory.DirectoryFrontend.WatchAssetBundles:output_type -> strims.network.v1.directory.FrontendWatchAssetBundlesResponse
+	91,  // 179: strims.network.v1.directory.DirectorySnippet.Subscribe:output_type -> strims.network.v1.directory.SnippetSubscribeResponse
+	150, // [150:180] is the sub-list for method output_type
+	120, // [120:150] is the sub-list for method input_type
+	120, // [120:120] is the sub-list for extension type_name
+	120, // [120:120] is the sub-list for extension extendee
+	0,   // [0:120] is the sub-list for field type_name
 }
 
 func init() { file_network_v1_directory_directory_proto_init() }
@@ -6522,8 +8963,308 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClientConfig); i {
+		file_network_v1_directory_directory_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_directory_directory_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_directory_directory_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestPublishRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_directory_directory_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestPublishResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_directory_directory_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Listing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_directory_directory_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListingSnippetImage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_directory_directory_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListingSnippet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_directory_directory_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListingProvenance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_directory_directory_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListingSnippetDelta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_directory_directory_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_directory_directory_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListingModeration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_directory_directory_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListingQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_directory_directory_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListingRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_directory_directory_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledListingRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_directory_directory_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListingSubscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_directory_directory_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListingSessionRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_directory_directory_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListingStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_directory_directory_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListingStatsItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_directory_directory_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublisherStatsItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_directory_directory_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserModeration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_directory_directory_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_directory_directory_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventBroadcast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_directory_directory_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetBundle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_directory_directory_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_directory_directory_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_directory_directory_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnpublishRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6534,8 +9275,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetEventsRequest); i {
+		file_network_v1_directory_directory_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnpublishResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6546,8 +9287,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestPublishRequest); i {
+		file_network_v1_directory_directory_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6558,8 +9299,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestPublishResponse); i {
+		file_network_v1_directory_directory_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6570,8 +9311,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Listing); i {
+		file_network_v1_directory_directory_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6582,8 +9323,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListingSnippetImage); i {
+		file_network_v1_directory_directory_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6594,8 +9335,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListingSnippet); i {
+		file_network_v1_directory_directory_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6606,8 +9347,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListingSnippetDelta); i {
+		file_network_v1_directory_directory_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6618,8 +9359,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Event); i {
+		file_network_v1_directory_directory_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnscheduleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6630,8 +9371,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListingModeration); i {
+		file_network_v1_directory_directory_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnscheduleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6642,8 +9383,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListingQuery); i {
+		file_network_v1_directory_directory_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetListingStatsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6654,8 +9395,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListingRecord); i {
+		file_network_v1_directory_directory_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetListingStatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6666,8 +9407,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserModeration); i {
+		file_network_v1_directory_directory_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6678,8 +9419,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserRecord); i {
+		file_network_v1_directory_directory_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6690,8 +9431,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventBroadcast); i {
+		file_network_v1_directory_directory_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModerateListingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6702,8 +9443,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetBundle); i {
+		file_network_v1_directory_directory_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModerateListingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6714,8 +9455,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublishRequest); i {
+		file_network_v1_directory_directory_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModerateUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6726,8 +9467,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublishResponse); i {
+		file_network_v1_directory_directory_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModerateUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6738,8 +9479,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnpublishRequest); i {
+		file_network_v1_directory_directory_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Network); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6750,8 +9491,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnpublishResponse); i {
+		file_network_v1_directory_directory_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkListingsItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6762,8 +9503,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinRequest); i {
+		file_network_v1_directory_directory_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkListings); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6774,8 +9515,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinResponse); i {
+		file_network_v1_directory_directory_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrontendPublishRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6786,8 +9527,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PartRequest); i {
+		file_network_v1_directory_directory_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrontendPublishResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6798,8 +9539,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PartResponse); i {
+		file_network_v1_directory_directory_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrontendUnpublishRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6810,8 +9551,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PingRequest); i {
+		file_network_v1_directory_directory_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrontendUnpublishResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6822,8 +9563,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PingResponse); i {
+		file_network_v1_directory_directory_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrontendJoinRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6834,8 +9575,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModerateListingRequest); i {
+		file_network_v1_directory_directory_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrontendJoinResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6846,8 +9587,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModerateListingResponse); i {
+		file_network_v1_directory_directory_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrontendPartRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6858,8 +9599,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModerateUserRequest); i {
+		file_network_v1_directory_directory_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrontendPartResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6870,8 +9611,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModerateUserResponse); i {
+		file_network_v1_directory_directory_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrontendScheduleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6882,8 +9623,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Network); i {
+		file_network_v1_directory_directory_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrontendScheduleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6894,8 +9635,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NetworkListingsItem); i {
+		file_network_v1_directory_directory_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrontendUnscheduleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6906,8 +9647,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NetworkListings); i {
+		file_network_v1_directory_directory_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrontendUnscheduleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6918,8 +9659,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FrontendPublishRequest); i {
+		file_network_v1_directory_directory_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrontendSubscribeListingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6930,8 +9671,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FrontendPublishResponse); i {
+		file_network_v1_directory_directory_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrontendSubscribeListingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6942,8 +9683,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FrontendUnpublishRequest); i {
+		file_network_v1_directory_directory_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrontendUnsubscribeListingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6954,8 +9695,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FrontendUnpublishResponse); i {
+		file_network_v1_directory_directory_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrontendUnsubscribeListingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6966,8 +9707,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FrontendJoinRequest); i {
+		file_network_v1_directory_directory_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrontendListListingSubscriptionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6978,8 +9719,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FrontendJoinResponse); i {
+		file_network_v1_directory_directory_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrontendListListingSubscriptionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6990,8 +9731,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FrontendPartRequest); i {
+		file_network_v1_directory_directory_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrontendGetListingStatsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7002,8 +9743,8 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FrontendPartResponse); i {
+		file_network_v1_directory_directory_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrontendGetListingStatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7014,7 +9755,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FrontendTestRequest); i {
 			case 0:
 				return &v.state
@@ -7026,7 +9767,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FrontendTestResponse); i {
 			case 0:
 				return &v.state
@@ -7038,7 +9779,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FrontendModerateListingRequest); i {
 			case 0:
 				return &v.state
@@ -7050,7 +9791,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FrontendModerateListingResponse); i {
 			case 0:
 				return &v.state
@@ -7062,7 +9803,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FrontendModerateUserRequest); i {
 			case 0:
 				return &v.state
@@ -7074,7 +9815,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FrontendModerateUserResponse); i {
 			case 0:
 				return &v.state
@@ -7086,7 +9827,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FrontendGetUsersRequest); i {
 			case 0:
 				return &v.state
@@ -7098,7 +9839,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FrontendGetUsersResponse); i {
 			case 0:
 				return &v.state
@@ -7110,7 +9851,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FrontendGetListingRequest); i {
 			case 0:
 				return &v.state
@@ -7122,7 +9863,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FrontendGetListingResponse); i {
 			case 0:
 				return &v.state
@@ -7134,7 +9875,19 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListingFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_directory_directory_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FrontendGetListingsRequest); i {
 			case 0:
 				return &v.state
@@ -7146,7 +9899,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FrontendGetListingsResponse); i {
 			case 0:
 				return &v.state
@@ -7158,7 +9911,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FrontendWatchListingsRequest); i {
 			case 0:
 				return &v.state
@@ -7170,7 +9923,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FrontendWatchListingsResponse); i {
 			case 0:
 				return &v.state
@@ -7182,7 +9935,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FrontendWatchListingUsersRequest); i {
 			case 0:
 				return &v.state
@@ -7194,7 +9947,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FrontendWatchListingUsersResponse); i {
 			case 0:
 				return &v.state
@@ -7206,7 +9959,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FrontendWatchAssetBundlesRequest); i {
 			case 0:
 				return &v.state
@@ -7218,7 +9971,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FrontendWatchAssetBundlesResponse); i {
 			case 0:
 				return &v.state
@@ -7230,7 +9983,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SnippetSubscribeRequest); i {
 			case 0:
 				return &v.state
@@ -7242,7 +9995,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SnippetSubscribeResponse); i {
 			case 0:
 				return &v.state
@@ -7254,7 +10007,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ServerConfig_Integrations); i {
 			case 0:
 				return &v.state
@@ -7266,7 +10019,19 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerConfig_Federation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_directory_directory_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ServerConfig_Integrations_AngelThump); i {
 			case 0:
 				return &v.state
@@ -7278,7 +10043,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ServerConfig_Integrations_Twitch); i {
 			case 0:
 				return &v.state
@@ -7290,7 +10055,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ServerConfig_Integrations_YouTube); i {
 			case 0:
 				return &v.state
@@ -7302,7 +10067,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ServerConfig_Integrations_Swarm); i {
 			case 0:
 				return &v.state
@@ -7314,7 +10079,43 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerConfig_Integrations_Kick); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_directory_directory_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerConfig_Integrations_Vimeo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_directory_directory_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerConfig_Integrations_OEmbed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_network_v1_directory_directory_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ClientConfig_Integrations); i {
 			case 0:
 				return &v.state
@@ -7326,7 +10127,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Listing_Media); i {
 			case 0:
 				return &v.state
@@ -7338,7 +10139,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Listing_Service); i {
 			case 0:
 				return &v.state
@@ -7350,7 +10151,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Listing_Embed); i {
 			case 0:
 				return &v.state
@@ -7362,7 +10163,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Listing_Chat); i {
 			case 0:
 				return &v.state
@@ -7374,7 +10175,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListingSnippetDelta_Tags); i {
 			case 0:
 				return &v.state
@@ -7386,7 +10187,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Event_ListingChange); i {
 			case 0:
 				return &v.state
@@ -7398,7 +10199,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Event_Unpublish); i {
 			case 0:
 				return &v.state
@@ -7410,7 +10211,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Event_UserCountChange); i {
 			case 0:
 				return &v.state
@@ -7422,7 +10223,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Event_UserPresenceChange); i {
 			case 0:
 				return &v.state
@@ -7434,7 +10235,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Event_Ping); i {
 			case 0:
 				return &v.state
@@ -7446,7 +10247,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FrontendGetUsersResponse_Alias); i {
 			case 0:
 				return &v.state
@@ -7458,7 +10259,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FrontendGetUsersResponse_User); i {
 			case 0:
 				return &v.state
@@ -7470,7 +10271,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FrontendWatchListingsResponse_Change); i {
 			case 0:
 				return &v.state
@@ -7482,7 +10283,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FrontendWatchListingsResponse_Unpublish); i {
 			case 0:
 				return &v.state
@@ -7494,7 +10295,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FrontendWatchListingsResponse_UserCountChange); i {
 			case 0:
 				return &v.state
@@ -7506,7 +10307,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FrontendWatchListingsResponse_Event); i {
 			case 0:
 				return &v.state
@@ -7518,7 +10319,7 @@ func file_network_v1_directory_directory_proto_init() {
 				return nil
 			}
 		}
-		file_network_v1_directory_directory_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+		file_network_v1_directory_directory_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FrontendWatchListingUsersResponse_User); i {
 			case 0:
 				return &v.state
@@ -7541,23 +10342,23 @@ func file_network_v1_directory_directory_proto_init() {
 		(*ListingSnippetImage_Url)(nil),
 		(*ListingSnippetImage_Image)(nil),
 	}
-	file_network_v1_directory_directory_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_network_v1_directory_directory_proto_msgTypes[9].OneofWrappers = []interface{}{
 		(*ListingSnippetDelta_Tags_)(nil),
 		(*ListingSnippetDelta_Thumbnail)(nil),
 		(*ListingSnippetDelta_ChannelLogo)(nil),
 	}
-	file_network_v1_directory_directory_proto_msgTypes[9].OneofWrappers = []interface{}{
+	file_network_v1_directory_directory_proto_msgTypes[10].OneofWrappers = []interface{}{
 		(*Event_ListingChange_)(nil),
 		(*Event_Unpublish_)(nil),
 		(*Event_UserCountChange_)(nil),
 		(*Event_UserPresenceChange_)(nil),
 		(*Event_Ping_)(nil),
 	}
-	file_network_v1_directory_directory_proto_msgTypes[11].OneofWrappers = []interface{}{
+	file_network_v1_directory_directory_proto_msgTypes[12].OneofWrappers = []interface{}{
 		(*ListingQuery_Id)(nil),
 		(*ListingQuery_Listing)(nil),
 	}
-	file_network_v1_directory_directory_proto_msgTypes[85].OneofWrappers = []interface{}{
+	file_network_v1_directory_directory_proto_msgTypes[115].OneofWrappers = []interface{}{
 		(*FrontendWatchListingsResponse_Event_Change)(nil),
 		(*FrontendWatchListingsResponse_Event_Unpublish)(nil),
 		(*FrontendWatchListingsResponse_Event_UserCountChange)(nil),
@@ -7567,8 +10368,8 @@ func file_network_v1_directory_directory_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_network_v1_directory_directory_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   87,
+			NumEnums:      4,
+			NumMessages:   117,
 			NumExtensions: 0,
 			NumServices:   3,
 		},
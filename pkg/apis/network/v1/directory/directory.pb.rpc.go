@@ -13,8 +13,11 @@ func RegisterDirectoryService(host rpc.ServiceRegistry, service DirectoryService
 	host.RegisterMethod("strims.network.v1.directory.Directory.Join", service.Join)
 	host.RegisterMethod("strims.network.v1.directory.Directory.Part", service.Part)
 	host.RegisterMethod("strims.network.v1.directory.Directory.Ping", service.Ping)
+	host.RegisterMethod("strims.network.v1.directory.Directory.Schedule", service.Schedule)
+	host.RegisterMethod("strims.network.v1.directory.Directory.Unschedule", service.Unschedule)
 	host.RegisterMethod("strims.network.v1.directory.Directory.ModerateListing", service.ModerateListing)
 	host.RegisterMethod("strims.network.v1.directory.Directory.ModerateUser", service.ModerateUser)
+	host.RegisterMethod("strims.network.v1.directory.Directory.GetListingStats", service.GetListingStats)
 }
 
 // DirectoryService ...
@@ -39,6 +42,14 @@ type DirectoryService interface {
 		ctx context.Context,
 		req *PingRequest,
 	) (*PingResponse, error)
+	Schedule(
+		ctx context.Context,
+		req *ScheduleRequest,
+	) (*ScheduleResponse, error)
+	Unschedule(
+		ctx context.Context,
+		req *UnscheduleRequest,
+	) (*UnscheduleResponse, error)
 	ModerateListing(
 		ctx context.Context,
 		req *ModerateListingRequest,
@@ -47,6 +58,10 @@ type DirectoryService interface {
 		ctx context.Context,
 		req *ModerateUserRequest,
 	) (*ModerateUserResponse, error)
+	GetListingStats(
+		ctx context.Context,
+		req *GetListingStatsRequest,
+	) (*GetListingStatsResponse, error)
 }
 
 // DirectoryService ...
@@ -87,6 +102,20 @@ func (s *UnimplementedDirectoryService) Ping(
 	return nil, rpc.ErrNotImplemented
 }
 
+func (s *UnimplementedDirectoryService) Schedule(
+	ctx context.Context,
+	req *ScheduleRequest,
+) (*ScheduleResponse, error) {
+	return nil, rpc.ErrNotImplemented
+}
+
+func (s *UnimplementedDirectoryService) Unschedule(
+	ctx context.Context,
+	req *UnscheduleRequest,
+) (*UnscheduleResponse, error) {
+	return nil, rpc.ErrNotImplemented
+}
+
 func (s *UnimplementedDirectoryService) ModerateListing(
 	ctx context.Context,
 	req *ModerateListingRequest,
@@ -101,6 +130,13 @@ func (s *UnimplementedDirectoryService) ModerateUser(
 	return nil, rpc.ErrNotImplemented
 }
 
+func (s *UnimplementedDirectoryService) GetListingStats(
+	ctx context.Context,
+	req *GetListingStatsRequest,
+) (*GetListingStatsResponse, error) {
+	return nil, rpc.ErrNotImplemented
+}
+
 var _ DirectoryService = (*UnimplementedDirectoryService)(nil)
 
 // DirectoryClient ...
@@ -158,6 +194,24 @@ func (c *DirectoryClient) Ping(
 	return c.client.CallUnary(ctx, "strims.network.v1.directory.Directory.Ping", req, res)
 }
 
+// Schedule ...
+func (c *DirectoryClient) Schedule(
+	ctx context.Context,
+	req *ScheduleRequest,
+	res *ScheduleResponse,
+) error {
+	return c.client.CallUnary(ctx, "strims.network.v1.directory.Directory.Schedule", req, res)
+}
+
+// Unschedule ...
+func (c *DirectoryClient) Unschedule(
+	ctx context.Context,
+	req *UnscheduleRequest,
+	res *UnscheduleResponse,
+) error {
+	return c.client.CallUnary(ctx, "strims.network.v1.directory.Directory.Unschedule", req, res)
+}
+
 // ModerateListing ...
 func (c *DirectoryClient) ModerateListing(
 	ctx context.Context,
@@ -176,6 +230,15 @@ func (c *DirectoryClient) ModerateUser(
 	return c.client.CallUnary(ctx, "strims.network.v1.directory.Directory.ModerateUser", req, res)
 }
 
+// GetListingStats ...
+func (c *DirectoryClient) GetListingStats(
+	ctx context.Context,
+	req *GetListingStatsRequest,
+	res *GetListingStatsResponse,
+) error {
+	return c.client.CallUnary(ctx, "strims.network.v1.directory.Directory.GetListingStats", req, res)
+}
+
 // RegisterDirectoryFrontendService ...
 func RegisterDirectoryFrontendService(host rpc.ServiceRegistry, service DirectoryFrontendService) {
 	host.RegisterMethod("strims.network.v1.directory.DirectoryFrontend.Publish", service.Publish)
@@ -183,8 +246,14 @@ func RegisterDirectoryFrontendService(host rpc.ServiceRegistry, service Director
 	host.RegisterMethod("strims.network.v1.directory.DirectoryFrontend.Join", service.Join)
 	host.RegisterMethod("strims.network.v1.directory.DirectoryFrontend.Part", service.Part)
 	host.RegisterMethod("strims.network.v1.directory.DirectoryFrontend.Test", service.Test)
+	host.RegisterMethod("strims.network.v1.directory.DirectoryFrontend.Schedule", service.Schedule)
+	host.RegisterMethod("strims.network.v1.directory.DirectoryFrontend.Unschedule", service.Unschedule)
+	host.RegisterMethod("strims.network.v1.directory.DirectoryFrontend.SubscribeListing", service.SubscribeListing)
+	host.RegisterMethod("strims.network.v1.directory.DirectoryFrontend.UnsubscribeListing", service.UnsubscribeListing)
+	host.RegisterMethod("strims.network.v1.directory.DirectoryFrontend.ListListingSubscriptions", service.ListListingSubscriptions)
 	host.RegisterMethod("strims.network.v1.directory.DirectoryFrontend.ModerateListing", service.ModerateListing)
 	host.RegisterMethod("strims.network.v1.directory.DirectoryFrontend.ModerateUser", service.ModerateUser)
+	host.RegisterMethod("strims.network.v1.directory.DirectoryFrontend.GetListingStats", service.GetListingStats)
 	host.RegisterMethod("strims.network.v1.directory.DirectoryFrontend.GetUsers", service.GetUsers)
 	host.RegisterMethod("strims.network.v1.directory.DirectoryFrontend.GetListing", service.GetListing)
 	host.RegisterMethod("strims.network.v1.directory.DirectoryFrontend.GetListings", service.GetListings)
@@ -215,6 +284,26 @@ type DirectoryFrontendService interface {
 		ctx context.Context,
 		req *FrontendTestRequest,
 	) (*FrontendTestResponse, error)
+	Schedule(
+		ctx context.Context,
+		req *FrontendScheduleRequest,
+	) (*FrontendScheduleResponse, error)
+	Unschedule(
+		ctx context.Context,
+		req *FrontendUnscheduleRequest,
+	) (*FrontendUnscheduleResponse, error)
+	SubscribeListing(
+		ctx context.Context,
+		req *FrontendSubscribeListingRequest,
+	) (*FrontendSubscribeListingResponse, error)
+	UnsubscribeListing(
+		ctx context.Context,
+		req *FrontendUnsubscribeListingRequest,
+	) (*FrontendUnsubscribeListingResponse, error)
+	ListListingSubscriptions(
+		ctx context.Context,
+		req *FrontendListListingSubscriptionsRequest,
+	) (*FrontendListListingSubscriptionsResponse, error)
 	ModerateListing(
 		ctx context.Context,
 		req *FrontendModerateListingRequest,
@@ -223,6 +312,10 @@ type DirectoryFrontendService interface {
 		ctx context.Context,
 		req *FrontendModerateUserRequest,
 	) (*FrontendModerateUserResponse, error)
+	GetListingStats(
+		ctx context.Context,
+		req *FrontendGetListingStatsRequest,
+	) (*FrontendGetListingStatsResponse, error)
 	GetUsers(
 		ctx context.Context,
 		req *FrontendGetUsersRequest,
@@ -287,6 +380,41 @@ func (s *UnimplementedDirectoryFrontendService) Test(
 	return nil, rpc.ErrNotImplemented
 }
 
+func (s *UnimplementedDirectoryFrontendService) Schedule(
+	ctx context.Context,
+	req *FrontendScheduleRequest,
+) (*FrontendScheduleResponse, error) {
+	return nil, rpc.ErrNotImplemented
+}
+
+func (s *UnimplementedDirectoryFrontendService) Unschedule(
+	ctx context.Context,
+	req *FrontendUnscheduleRequest,
+) (*FrontendUnscheduleResponse, error) {
+	return nil, rpc.ErrNotImplemented
+}
+
+func (s *UnimplementedDirectoryFrontendService) SubscribeListing(
+	ctx context.Context,
+	req *FrontendSubscribeListingRequest,
+) (*FrontendSubscribeListingResponse, error) {
+	return nil, rpc.ErrNotImplemented
+}
+
+func (s *UnimplementedDirectoryFrontendService) UnsubscribeListing(
+	ctx context.Context,
+	req *FrontendUnsubscribeListingRequest,
+) (*FrontendUnsubscribeListingResponse, error) {
+	return nil, rpc.ErrNotImplemented
+}
+
+func (s *UnimplementedDirectoryFrontendService) ListListingSubscriptions(
+	ctx context.Context,
+	req *FrontendListListingSubscriptionsRequest,
+) (*FrontendListListingSubscriptionsResponse, error) {
+	return nil, rpc.ErrNotImplemented
+}
+
 func (s *UnimplementedDirectoryFrontendService) ModerateListing(
 	ctx context.Context,
 	req *FrontendModerateListingRequest,
@@ -301,6 +429,13 @@ func (s *UnimplementedDirectoryFrontendService) ModerateUser(
 	return nil, rpc.ErrNotImplemented
 }
 
+func (s *UnimplementedDirectoryFrontendService) GetListingStats(
+	ctx context.Context,
+	req *FrontendGetListingStatsRequest,
+) (*FrontendGetListingStatsResponse, error) {
+	return nil, rpc.ErrNotImplemented
+}
+
 func (s *UnimplementedDirectoryFrontendService) GetUsers(
 	ctx context.Context,
 	req *FrontendGetUsersRequest,
@@ -400,6 +535,51 @@ func (c *DirectoryFrontendClient) Test(
 	return c.client.CallUnary(ctx, "strims.network.v1.directory.DirectoryFrontend.Test", req, res)
 }
 
+// Schedule ...
+func (c *DirectoryFrontendClient) Schedule(
+	ctx context.Context,
+	req *FrontendScheduleRequest,
+	res *FrontendScheduleResponse,
+) error {
+	return c.client.CallUnary(ctx, "strims.network.v1.directory.DirectoryFrontend.Schedule", req, res)
+}
+
+// Unschedule ...
+func (c *DirectoryFrontendClient) Unschedule(
+	ctx context.Context,
+	req *FrontendUnscheduleRequest,
+	res *FrontendUnscheduleResponse,
+) error {
+	return c.client.CallUnary(ctx, "strims.network.v1.directory.DirectoryFrontend.Unschedule", req, res)
+}
+
+// SubscribeListing ...
+func (c *DirectoryFrontendClient) SubscribeListing(
+	ctx context.Context,
+	req *FrontendSubscribeListingRequest,
+	res *FrontendSubscribeListingResponse,
+) error {
+	return c.client.CallUnary(ctx, "strims.network.v1.directory.DirectoryFrontend.SubscribeListing", req, res)
+}
+
+// UnsubscribeListing ...
+func (c *DirectoryFrontendClient) UnsubscribeListing(
+	ctx context.Context,
+	req *FrontendUnsubscribeListingRequest,
+	res *FrontendUnsubscribeListingResponse,
+) error {
+	return c.client.CallUnary(ctx, "strims.network.v1.directory.DirectoryFrontend.UnsubscribeListing", req, res)
+}
+
+// ListListingSubscriptions ...
+func (c *DirectoryFrontendClient) ListListingSubscriptions(
+	ctx context.Context,
+	req *FrontendListListingSubscriptionsRequest,
+	res *FrontendListListingSubscriptionsResponse,
+) error {
+	return c.client.CallUnary(ctx, "strims.network.v1.directory.DirectoryFrontend.ListListingSubscriptions", req, res)
+}
+
 // ModerateListing ...
 func (c *DirectoryFrontendClient) ModerateListing(
 	ctx context.Context,
@@ -418,6 +598,15 @@ func (c *DirectoryFrontendClient) ModerateUser(
 	return c.client.CallUnary(ctx, "strims.network.v1.directory.DirectoryFrontend.ModerateUser", req, res)
 }
 
+// GetListingStats ...
+func (c *DirectoryFrontendClient) GetListingStats(
+	ctx context.Context,
+	req *FrontendGetListingStatsRequest,
+	res *FrontendGetListingStatsResponse,
+) error {
+	return c.client.CallUnary(ctx, "strims.network.v1.directory.DirectoryFrontend.GetListingStats", req, res)
+}
+
 // GetUsers ...
 func (c *DirectoryFrontendClient) GetUsers(
 	ctx context.Context,
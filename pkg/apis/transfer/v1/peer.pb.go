@@ -229,6 +229,184 @@ func (*TransferPeerCloseResponse) Descriptor() ([]byte, []int) {
 	return file_transfer_v1_peer_proto_rawDescGZIP(), []int{3}
 }
 
+type ContentKeyPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// network_keys lists the networks whose members may receive the key.
+	NetworkKeys [][]byte `protobuf:"bytes,1,rep,name=network_keys,json=networkKeys,proto3" json:"network_keys,omitempty"`
+	// key_usage is a mask of strims.type.KeyUsage values the peer's certificate
+	// for one of the networks must include.
+	KeyUsage uint32 `protobuf:"varint,2,opt,name=key_usage,json=keyUsage,proto3" json:"key_usage,omitempty"`
+	// key and signature are set by the swarm owner. peers only accept policies
+	// signed with the swarm id's private key.
+	Key       []byte `protobuf:"bytes,10001,opt,name=key,proto3" json:"key,omitempty"`
+	Signature []byte `protobuf:"bytes,10002,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *ContentKeyPolicy) Reset() {
+	*x = ContentKeyPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transfer_v1_peer_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContentKeyPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentKeyPolicy) ProtoMessage() {}
+
+func (x *ContentKeyPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_transfer_v1_peer_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentKeyPolicy.ProtoReflect.Descriptor instead.
+func (*ContentKeyPolicy) Descriptor() ([]byte, []int) {
+	return file_transfer_v1_peer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ContentKeyPolicy) GetNetworkKeys() [][]byte {
+	if x != nil {
+		return x.NetworkKeys
+	}
+	return nil
+}
+
+func (x *ContentKeyPolicy) GetKeyUsage() uint32 {
+	if x != nil {
+		return x.KeyUsage
+	}
+	return 0
+}
+
+func (x *ContentKeyPolicy) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *ContentKeyPolicy) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type TransferPeerRequestContentKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *TransferPeerRequestContentKeyRequest) Reset() {
+	*x = TransferPeerRequestContentKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transfer_v1_peer_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferPeerRequestContentKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferPeerRequestContentKeyRequest) ProtoMessage() {}
+
+func (x *TransferPeerRequestContentKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transfer_v1_peer_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferPeerRequestContentKeyRequest.ProtoReflect.Descriptor instead.
+func (*TransferPeerRequestContentKeyRequest) Descriptor() ([]byte, []int) {
+	return file_transfer_v1_peer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TransferPeerRequestContentKeyRequest) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type TransferPeerRequestContentKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    []byte            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Policy *ContentKeyPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *TransferPeerRequestContentKeyResponse) Reset() {
+	*x = TransferPeerRequestContentKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transfer_v1_peer_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferPeerRequestContentKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferPeerRequestContentKeyResponse) ProtoMessage() {}
+
+func (x *TransferPeerRequestContentKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transfer_v1_peer_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferPeerRequestContentKeyResponse.ProtoReflect.Descriptor instead.
+func (*TransferPeerRequestContentKeyResponse) Descriptor() ([]byte, []int) {
+	return file_transfer_v1_peer_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TransferPeerRequestContentKeyResponse) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *TransferPeerRequestContentKeyResponse) GetPolicy() *ContentKeyPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
 var File_transfer_v1_peer_proto protoreflect.FileDescriptor
 
 var file_transfer_v1_peer_proto_rawDesc = []byte{
@@ -248,27 +426,55 @@ var file_transfer_v1_peer_proto_rawDesc = []byte{
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0c, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
 	0x72, 0x50, 0x65, 0x65, 0x72, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x32, 0xe3, 0x01, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50,
-	0x65, 0x65, 0x72, 0x12, 0x6d, 0x0a, 0x08, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x12,
-	0x2f, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x65, 0x65,
-	0x72, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x30, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x65,
-	0x65, 0x72, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x64, 0x0a, 0x05, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x2c, 0x2e, 0x73, 0x74,
-	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x65, 0x65, 0x72, 0x43, 0x6c, 0x6f,
-	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x73, 0x74, 0x72, 0x69,
-	0x6d, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x65, 0x65, 0x72, 0x43, 0x6c, 0x6f, 0x73, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x57, 0x0a, 0x15, 0x67, 0x67, 0x2e, 0x73,
+	0x73, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4b, 0x65,
+	0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x65,
+	0x79, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6b,
+	0x65, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x11, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x91,
+	0x4e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x92, 0x4e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x36, 0x0a, 0x24, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x77, 0x0a, 0x25, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x65, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3c, 0x0a, 0x06,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73,
 	0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x65,
-	0x6d, 0x65, 0x4c, 0x61, 0x62, 0x73, 0x2f, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2f, 0x70, 0x6b,
-	0x67, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2f,
-	0x76, 0x31, 0x3b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0xba, 0x02, 0x03, 0x53, 0x54,
-	0x58, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x32, 0xee, 0x02, 0x0a, 0x0c, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x65, 0x65, 0x72, 0x12, 0x6d, 0x0a, 0x08, 0x41,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x12, 0x2f, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73,
+	0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x65, 0x65, 0x72, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d,
+	0x73, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x65, 0x65, 0x72, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x05, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x12, 0x2c, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x50, 0x65, 0x65, 0x72, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2d, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50,
+	0x65, 0x65, 0x72, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x88, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x38, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x39, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x65,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x57, 0x0a, 0x15, 0x67,
+	0x67, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x4d, 0x65, 0x6d, 0x65, 0x4c, 0x61, 0x62, 0x73, 0x2f, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0xba, 0x02,
+	0x03, 0x53, 0x54, 0x58, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -283,23 +489,29 @@ func file_transfer_v1_peer_proto_rawDescGZIP() []byte {
 	return file_transfer_v1_peer_proto_rawDescData
 }
 
-var file_transfer_v1_peer_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_transfer_v1_peer_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_transfer_v1_peer_proto_goTypes = []interface{}{
-	(*TransferPeerAnnounceRequest)(nil),  // 0: strims.transfer.v1.TransferPeerAnnounceRequest
-	(*TransferPeerAnnounceResponse)(nil), // 1: strims.transfer.v1.TransferPeerAnnounceResponse
-	(*TransferPeerCloseRequest)(nil),     // 2: strims.transfer.v1.TransferPeerCloseRequest
-	(*TransferPeerCloseResponse)(nil),    // 3: strims.transfer.v1.TransferPeerCloseResponse
+	(*TransferPeerAnnounceRequest)(nil),           // 0: strims.transfer.v1.TransferPeerAnnounceRequest
+	(*TransferPeerAnnounceResponse)(nil),          // 1: strims.transfer.v1.TransferPeerAnnounceResponse
+	(*TransferPeerCloseRequest)(nil),              // 2: strims.transfer.v1.TransferPeerCloseRequest
+	(*TransferPeerCloseResponse)(nil),             // 3: strims.transfer.v1.TransferPeerCloseResponse
+	(*ContentKeyPolicy)(nil),                      // 4: strims.transfer.v1.ContentKeyPolicy
+	(*TransferPeerRequestContentKeyRequest)(nil),  // 5: strims.transfer.v1.TransferPeerRequestContentKeyRequest
+	(*TransferPeerRequestContentKeyResponse)(nil), // 6: strims.transfer.v1.TransferPeerRequestContentKeyResponse
 }
 var file_transfer_v1_peer_proto_depIdxs = []int32{
-	0, // 0: strims.transfer.v1.TransferPeer.Announce:input_type -> strims.transfer.v1.TransferPeerAnnounceRequest
-	2, // 1: strims.transfer.v1.TransferPeer.Close:input_type -> strims.transfer.v1.TransferPeerCloseRequest
-	1, // 2: strims.transfer.v1.TransferPeer.Announce:output_type -> strims.transfer.v1.TransferPeerAnnounceResponse
-	3, // 3: strims.transfer.v1.TransferPeer.Close:output_type -> strims.transfer.v1.TransferPeerCloseResponse
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	4, // 0: strims.transfer.v1.TransferPeerRequestContentKeyResponse.policy:type_name -> strims.transfer.v1.ContentKeyPolicy
+	0, // 1: strims.transfer.v1.TransferPeer.Announce:input_type -> strims.transfer.v1.TransferPeerAnnounceRequest
+	2, // 2: strims.transfer.v1.TransferPeer.Close:input_type -> strims.transfer.v1.TransferPeerCloseRequest
+	5, // 3: strims.transfer.v1.TransferPeer.RequestContentKey:input_type -> strims.transfer.v1.TransferPeerRequestContentKeyRequest
+	1, // 4: strims.transfer.v1.TransferPeer.Announce:output_type -> strims.transfer.v1.TransferPeerAnnounceResponse
+	3, // 5: strims.transfer.v1.TransferPeer.Close:output_type -> strims.transfer.v1.TransferPeerCloseResponse
+	6, // 6: strims.transfer.v1.TransferPeer.RequestContentKey:output_type -> strims.transfer.v1.TransferPeerRequestContentKeyResponse
+	4, // [4:7] is the sub-list for method output_type
+	1, // [1:4] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_transfer_v1_peer_proto_init() }
@@ -356,6 +568,42 @@ func file_transfer_v1_peer_proto_init() {
 				return nil
 			}
 		}
+		file_transfer_v1_peer_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContentKeyPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transfer_v1_peer_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferPeerRequestContentKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transfer_v1_peer_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferPeerRequestContentKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_transfer_v1_peer_proto_msgTypes[1].OneofWrappers = []interface{}{
 		(*TransferPeerAnnounceResponse_Channel)(nil),
@@ -366,7 +614,7 @@ func file_transfer_v1_peer_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_transfer_v1_peer_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
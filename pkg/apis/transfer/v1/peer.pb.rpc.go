@@ -10,6 +10,7 @@ import (
 func RegisterTransferPeerService(host rpc.ServiceRegistry, service TransferPeerService) {
 	host.RegisterMethod("strims.transfer.v1.TransferPeer.Announce", service.Announce)
 	host.RegisterMethod("strims.transfer.v1.TransferPeer.Close", service.Close)
+	host.RegisterMethod("strims.transfer.v1.TransferPeer.RequestContentKey", service.RequestContentKey)
 }
 
 // TransferPeerService ...
@@ -22,6 +23,10 @@ type TransferPeerService interface {
 		ctx context.Context,
 		req *TransferPeerCloseRequest,
 	) (*TransferPeerCloseResponse, error)
+	RequestContentKey(
+		ctx context.Context,
+		req *TransferPeerRequestContentKeyRequest,
+	) (*TransferPeerRequestContentKeyResponse, error)
 }
 
 // TransferPeerService ...
@@ -41,6 +46,13 @@ func (s *UnimplementedTransferPeerService) Close(
 	return nil, rpc.ErrNotImplemented
 }
 
+func (s *UnimplementedTransferPeerService) RequestContentKey(
+	ctx context.Context,
+	req *TransferPeerRequestContentKeyRequest,
+) (*TransferPeerRequestContentKeyResponse, error) {
+	return nil, rpc.ErrNotImplemented
+}
+
 var _ TransferPeerService = (*UnimplementedTransferPeerService)(nil)
 
 // TransferPeerClient ...
@@ -70,3 +82,12 @@ func (c *TransferPeerClient) Close(
 ) error {
 	return c.client.CallUnary(ctx, "strims.transfer.v1.TransferPeer.Close", req, res)
 }
+
+// RequestContentKey ...
+func (c *TransferPeerClient) RequestContentKey(
+	ctx context.Context,
+	req *TransferPeerRequestContentKeyRequest,
+	res *TransferPeerRequestContentKeyResponse,
+) error {
+	return c.client.CallUnary(ctx, "strims.transfer.v1.TransferPeer.RequestContentKey", req, res)
+}
@@ -70,6 +70,377 @@ func (x *Transfer) GetId() []byte {
 	return nil
 }
 
+type TransferMetrics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bytes          uint64 `protobuf:"varint,1,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	BytesPerSecond uint64 `protobuf:"varint,2,opt,name=bytes_per_second,json=bytesPerSecond,proto3" json:"bytes_per_second,omitempty"`
+}
+
+func (x *TransferMetrics) Reset() {
+	*x = TransferMetrics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transfer_v1_transfer_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferMetrics) ProtoMessage() {}
+
+func (x *TransferMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_transfer_v1_transfer_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferMetrics.ProtoReflect.Descriptor instead.
+func (*TransferMetrics) Descriptor() ([]byte, []int) {
+	return file_transfer_v1_transfer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TransferMetrics) GetBytes() uint64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *TransferMetrics) GetBytesPerSecond() uint64 {
+	if x != nil {
+		return x.BytesPerSecond
+	}
+	return 0
+}
+
+type PeerSwarmMetrics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HostId          []byte           `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	Read            *TransferMetrics `protobuf:"bytes,2,opt,name=read,proto3" json:"read,omitempty"`
+	Write           *TransferMetrics `protobuf:"bytes,3,opt,name=write,proto3" json:"write,omitempty"`
+	RttMs           int64            `protobuf:"varint,4,opt,name=rtt_ms,json=rttMs,proto3" json:"rtt_ms,omitempty"`
+	RttVarMs        int64            `protobuf:"varint,5,opt,name=rtt_var_ms,json=rttVarMs,proto3" json:"rtt_var_ms,omitempty"`
+	CwndBytes       uint64           `protobuf:"varint,6,opt,name=cwnd_bytes,json=cwndBytes,proto3" json:"cwnd_bytes,omitempty"`
+	FlightSizeBytes uint64           `protobuf:"varint,7,opt,name=flight_size_bytes,json=flightSizeBytes,proto3" json:"flight_size_bytes,omitempty"`
+	RequestedChunks uint64           `protobuf:"varint,8,opt,name=requested_chunks,json=requestedChunks,proto3" json:"requested_chunks,omitempty"`
+	ReceivedChunks  uint64           `protobuf:"varint,9,opt,name=received_chunks,json=receivedChunks,proto3" json:"received_chunks,omitempty"`
+	InvalidChunks   uint64           `protobuf:"varint,10,opt,name=invalid_chunks,json=invalidChunks,proto3" json:"invalid_chunks,omitempty"`
+	Choked          bool             `protobuf:"varint,11,opt,name=choked,proto3" json:"choked,omitempty"`
+	PeerChoked      bool             `protobuf:"varint,12,opt,name=peer_choked,json=peerChoked,proto3" json:"peer_choked,omitempty"`
+}
+
+func (x *PeerSwarmMetrics) Reset() {
+	*x = PeerSwarmMetrics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transfer_v1_transfer_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerSwarmMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerSwarmMetrics) ProtoMessage() {}
+
+func (x *PeerSwarmMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_transfer_v1_transfer_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerSwarmMetrics.ProtoReflect.Descriptor instead.
+func (*PeerSwarmMetrics) Descriptor() ([]byte, []int) {
+	return file_transfer_v1_transfer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PeerSwarmMetrics) GetHostId() []byte {
+	if x != nil {
+		return x.HostId
+	}
+	return nil
+}
+
+func (x *PeerSwarmMetrics) GetRead() *TransferMetrics {
+	if x != nil {
+		return x.Read
+	}
+	return nil
+}
+
+func (x *PeerSwarmMetrics) GetWrite() *TransferMetrics {
+	if x != nil {
+		return x.Write
+	}
+	return nil
+}
+
+func (x *PeerSwarmMetrics) GetRttMs() int64 {
+	if x != nil {
+		return x.RttMs
+	}
+	return 0
+}
+
+func (x *PeerSwarmMetrics) GetRttVarMs() int64 {
+	if x != nil {
+		return x.RttVarMs
+	}
+	return 0
+}
+
+func (x *PeerSwarmMetrics) GetCwndBytes() uint64 {
+	if x != nil {
+		return x.CwndBytes
+	}
+	return 0
+}
+
+func (x *PeerSwarmMetrics) GetFlightSizeBytes() uint64 {
+	if x != nil {
+		return x.FlightSizeBytes
+	}
+	return 0
+}
+
+func (x *PeerSwarmMetrics) GetRequestedChunks() uint64 {
+	if x != nil {
+		return x.RequestedChunks
+	}
+	return 0
+}
+
+func (x *PeerSwarmMetrics) GetReceivedChunks() uint64 {
+	if x != nil {
+		return x.ReceivedChunks
+	}
+	return 0
+}
+
+func (x *PeerSwarmMetrics) GetInvalidChunks() uint64 {
+	if x != nil {
+		return x.InvalidChunks
+	}
+	return 0
+}
+
+func (x *PeerSwarmMetrics) GetChoked() bool {
+	if x != nil {
+		return x.Choked
+	}
+	return false
+}
+
+func (x *PeerSwarmMetrics) GetPeerChoked() bool {
+	if x != nil {
+		return x.PeerChoked
+	}
+	return false
+}
+
+type SwarmMetrics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransferId []byte              `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	SwarmId    []byte              `protobuf:"bytes,2,opt,name=swarm_id,json=swarmId,proto3" json:"swarm_id,omitempty"`
+	Label      string              `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Read       *TransferMetrics    `protobuf:"bytes,4,opt,name=read,proto3" json:"read,omitempty"`
+	Write      *TransferMetrics    `protobuf:"bytes,5,opt,name=write,proto3" json:"write,omitempty"`
+	Peers      []*PeerSwarmMetrics `protobuf:"bytes,6,rep,name=peers,proto3" json:"peers,omitempty"`
+}
+
+func (x *SwarmMetrics) Reset() {
+	*x = SwarmMetrics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transfer_v1_transfer_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwarmMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwarmMetrics) ProtoMessage() {}
+
+func (x *SwarmMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_transfer_v1_transfer_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwarmMetrics.ProtoReflect.Descriptor instead.
+func (*SwarmMetrics) Descriptor() ([]byte, []int) {
+	return file_transfer_v1_transfer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SwarmMetrics) GetTransferId() []byte {
+	if x != nil {
+		return x.TransferId
+	}
+	return nil
+}
+
+func (x *SwarmMetrics) GetSwarmId() []byte {
+	if x != nil {
+		return x.SwarmId
+	}
+	return nil
+}
+
+func (x *SwarmMetrics) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SwarmMetrics) GetRead() *TransferMetrics {
+	if x != nil {
+		return x.Read
+	}
+	return nil
+}
+
+func (x *SwarmMetrics) GetWrite() *TransferMetrics {
+	if x != nil {
+		return x.Write
+	}
+	return nil
+}
+
+func (x *SwarmMetrics) GetPeers() []*PeerSwarmMetrics {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+type WatchSwarmMetricsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IntervalMs int32 `protobuf:"varint,1,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
+}
+
+func (x *WatchSwarmMetricsRequest) Reset() {
+	*x = WatchSwarmMetricsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transfer_v1_transfer_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchSwarmMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSwarmMetricsRequest) ProtoMessage() {}
+
+func (x *WatchSwarmMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transfer_v1_transfer_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSwarmMetricsRequest.ProtoReflect.Descriptor instead.
+func (*WatchSwarmMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_transfer_v1_transfer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WatchSwarmMetricsRequest) GetIntervalMs() int32 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+type WatchSwarmMetricsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Swarms []*SwarmMetrics `protobuf:"bytes,1,rep,name=swarms,proto3" json:"swarms,omitempty"`
+}
+
+func (x *WatchSwarmMetricsResponse) Reset() {
+	*x = WatchSwarmMetricsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transfer_v1_transfer_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchSwarmMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSwarmMetricsResponse) ProtoMessage() {}
+
+func (x *WatchSwarmMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transfer_v1_transfer_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSwarmMetricsResponse.ProtoReflect.Descriptor instead.
+func (*WatchSwarmMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_transfer_v1_transfer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WatchSwarmMetricsResponse) GetSwarms() []*SwarmMetrics {
+	if x != nil {
+		return x.Swarms
+	}
+	return nil
+}
+
 var File_transfer_v1_transfer_proto protoreflect.FileDescriptor
 
 var file_transfer_v1_transfer_proto_rawDesc = []byte{
@@ -77,13 +448,83 @@ var file_transfer_v1_transfer_proto_rawDesc = []byte{
 	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x73, 0x74,
 	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x76, 0x31,
 	0x22, 0x1a, 0x0a, 0x08, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x42, 0x57, 0x0a, 0x15,
-	0x67, 0x67, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x4d, 0x65, 0x6d, 0x65, 0x4c, 0x61, 0x62, 0x73, 0x2f, 0x73, 0x74, 0x72, 0x69, 0x6d,
-	0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0xba,
-	0x02, 0x03, 0x53, 0x54, 0x58, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x22, 0x51, 0x0a, 0x0f,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x62, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x22,
+	0xd3, 0x03, 0x0a, 0x10, 0x50, 0x65, 0x65, 0x72, 0x53, 0x77, 0x61, 0x72, 0x6d, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x37, 0x0a,
+	0x04, 0x72, 0x65, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x52, 0x04, 0x72, 0x65, 0x61, 0x64, 0x12, 0x39, 0x0a, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x05, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x74, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x72, 0x74, 0x74, 0x4d, 0x73, 0x12, 0x1c, 0x0a, 0x0a, 0x72, 0x74, 0x74, 0x5f,
+	0x76, 0x61, 0x72, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x74,
+	0x74, 0x56, 0x61, 0x72, 0x4d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x77, 0x6e, 0x64, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x77, 0x6e, 0x64,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0x27, 0x0a, 0x0f,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x68, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x68,
+	0x6f, 0x6b, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x6f,
+	0x6b, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x43,
+	0x68, 0x6f, 0x6b, 0x65, 0x64, 0x22, 0x90, 0x02, 0x0a, 0x0c, 0x53, 0x77, 0x61, 0x72, 0x6d, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x77, 0x61, 0x72, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x73, 0x77, 0x61, 0x72, 0x6d,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x37, 0x0a, 0x04, 0x72, 0x65, 0x61, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x04, 0x72, 0x65, 0x61,
+	0x64, 0x12, 0x39, 0x0a, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x05,
+	0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x74,
+	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x65, 0x65, 0x72, 0x53, 0x77, 0x61, 0x72, 0x6d, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x22, 0x3b, 0x0a, 0x18, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x77, 0x61, 0x72, 0x6d, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x4d, 0x73, 0x22, 0x55, 0x0a, 0x19, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x77,
+	0x61, 0x72, 0x6d, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x77, 0x61, 0x72, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x61, 0x72, 0x6d, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x52, 0x06, 0x73, 0x77, 0x61, 0x72, 0x6d, 0x73, 0x32, 0x86, 0x01, 0x0a,
+	0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x64, 0x12, 0x72, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x77, 0x61, 0x72, 0x6d, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x2c, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x77, 0x61, 0x72, 0x6d, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53,
+	0x77, 0x61, 0x72, 0x6d, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x30, 0x01, 0x42, 0x57, 0x0a, 0x15, 0x67, 0x67, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x6d, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x5a, 0x38,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x65, 0x6d, 0x65, 0x4c,
+	0x61, 0x62, 0x73, 0x2f, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61,
+	0x70, 0x69, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x3b,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0xba, 0x02, 0x03, 0x53, 0x54, 0x58, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -98,16 +539,29 @@ func file_transfer_v1_transfer_proto_rawDescGZIP() []byte {
 	return file_transfer_v1_transfer_proto_rawDescData
 }
 
-var file_transfer_v1_transfer_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_transfer_v1_transfer_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_transfer_v1_transfer_proto_goTypes = []interface{}{
-	(*Transfer)(nil), // 0: strims.transfer.v1.Transfer
+	(*Transfer)(nil),                  // 0: strims.transfer.v1.Transfer
+	(*TransferMetrics)(nil),           // 1: strims.transfer.v1.TransferMetrics
+	(*PeerSwarmMetrics)(nil),          // 2: strims.transfer.v1.PeerSwarmMetrics
+	(*SwarmMetrics)(nil),              // 3: strims.transfer.v1.SwarmMetrics
+	(*WatchSwarmMetricsRequest)(nil),  // 4: strims.transfer.v1.WatchSwarmMetricsRequest
+	(*WatchSwarmMetricsResponse)(nil), // 5: strims.transfer.v1.WatchSwarmMetricsResponse
 }
 var file_transfer_v1_transfer_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: strims.transfer.v1.PeerSwarmMetrics.read:type_name -> strims.transfer.v1.TransferMetrics
+	1, // 1: strims.transfer.v1.PeerSwarmMetrics.write:type_name -> strims.transfer.v1.TransferMetrics
+	1, // 2: strims.transfer.v1.SwarmMetrics.read:type_name -> strims.transfer.v1.TransferMetrics
+	1, // 3: strims.transfer.v1.SwarmMetrics.write:type_name -> strims.transfer.v1.TransferMetrics
+	2, // 4: strims.transfer.v1.SwarmMetrics.peers:type_name -> strims.transfer.v1.PeerSwarmMetrics
+	3, // 5: strims.transfer.v1.WatchSwarmMetricsResponse.swarms:type_name -> strims.transfer.v1.SwarmMetrics
+	4, // 6: strims.transfer.v1.TransferFrontend.WatchSwarmMetrics:input_type -> strims.transfer.v1.WatchSwarmMetricsRequest
+	5, // 7: strims.transfer.v1.TransferFrontend.WatchSwarmMetrics:output_type -> strims.transfer.v1.WatchSwarmMetricsResponse
+	7, // [7:8] is the sub-list for method output_type
+	6, // [6:7] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_transfer_v1_transfer_proto_init() }
@@ -128,6 +582,66 @@ func file_transfer_v1_transfer_proto_init() {
 				return nil
 			}
 		}
+		file_transfer_v1_transfer_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferMetrics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transfer_v1_transfer_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerSwarmMetrics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transfer_v1_transfer_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwarmMetrics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transfer_v1_transfer_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchSwarmMetricsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transfer_v1_transfer_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchSwarmMetricsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -135,9 +649,9 @@ func file_transfer_v1_transfer_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_transfer_v1_transfer_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   6,
 			NumExtensions: 0,
-			NumServices:   0,
+			NumServices:   1,
 		},
 		GoTypes:           file_transfer_v1_transfer_proto_goTypes,
 		DependencyIndexes: file_transfer_v1_transfer_proto_depIdxs,
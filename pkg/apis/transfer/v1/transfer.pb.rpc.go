@@ -0,0 +1,51 @@
+package transfer
+
+import (
+	"context"
+
+	"github.com/MemeLabs/protobuf/pkg/rpc"
+)
+
+// RegisterTransferFrontendService ...
+func RegisterTransferFrontendService(host rpc.ServiceRegistry, service TransferFrontendService) {
+	host.RegisterMethod("strims.transfer.v1.TransferFrontend.WatchSwarmMetrics", service.WatchSwarmMetrics)
+}
+
+// TransferFrontendService ...
+type TransferFrontendService interface {
+	WatchSwarmMetrics(
+		ctx context.Context,
+		req *WatchSwarmMetricsRequest,
+	) (<-chan *WatchSwarmMetricsResponse, error)
+}
+
+// TransferFrontendService ...
+type UnimplementedTransferFrontendService struct{}
+
+func (s *UnimplementedTransferFrontendService) WatchSwarmMetrics(
+	ctx context.Context,
+	req *WatchSwarmMetricsRequest,
+) (<-chan *WatchSwarmMetricsResponse, error) {
+	return nil, rpc.ErrNotImplemented
+}
+
+var _ TransferFrontendService = (*UnimplementedTransferFrontendService)(nil)
+
+// TransferFrontendClient ...
+type TransferFrontendClient struct {
+	client rpc.Caller
+}
+
+// NewTransferFrontendClient ...
+func NewTransferFrontendClient(client rpc.Caller) *TransferFrontendClient {
+	return &TransferFrontendClient{client}
+}
+
+// WatchSwarmMetrics ...
+func (c *TransferFrontendClient) WatchSwarmMetrics(
+	ctx context.Context,
+	req *WatchSwarmMetricsRequest,
+	res chan *WatchSwarmMetricsResponse,
+) error {
+	return c.client.CallStreaming(ctx, "strims.transfer.v1.TransferFrontend.WatchSwarmMetrics", req, res)
+}
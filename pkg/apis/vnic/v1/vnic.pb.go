@@ -134,6 +134,61 @@ func (x *TCPMuxInit) GetPeerKey() []byte {
 	return nil
 }
 
+type UDPMuxInit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolVersion uint32 `protobuf:"varint,1,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	PeerKey         []byte `protobuf:"bytes,2,opt,name=peer_key,json=peerKey,proto3" json:"peer_key,omitempty"`
+}
+
+func (x *UDPMuxInit) Reset() {
+	*x = UDPMuxInit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vnic_v1_vnic_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UDPMuxInit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UDPMuxInit) ProtoMessage() {}
+
+func (x *UDPMuxInit) ProtoReflect() protoreflect.Message {
+	mi := &file_vnic_v1_vnic_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UDPMuxInit.ProtoReflect.Descriptor instead.
+func (*UDPMuxInit) Descriptor() ([]byte, []int) {
+	return file_vnic_v1_vnic_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UDPMuxInit) GetProtocolVersion() uint32 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *UDPMuxInit) GetPeerKey() []byte {
+	if x != nil {
+		return x.PeerKey
+	}
+	return nil
+}
+
 type AESLinkInit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -147,7 +202,7 @@ type AESLinkInit struct {
 func (x *AESLinkInit) Reset() {
 	*x = AESLinkInit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vnic_v1_vnic_proto_msgTypes[2]
+		mi := &file_vnic_v1_vnic_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -160,7 +215,7 @@ func (x *AESLinkInit) String() string {
 func (*AESLinkInit) ProtoMessage() {}
 
 func (x *AESLinkInit) ProtoReflect() protoreflect.Message {
-	mi := &file_vnic_v1_vnic_proto_msgTypes[2]
+	mi := &file_vnic_v1_vnic_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -173,7 +228,7 @@ func (x *AESLinkInit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AESLinkInit.ProtoReflect.Descriptor instead.
 func (*AESLinkInit) Descriptor() ([]byte, []int) {
-	return file_vnic_v1_vnic_proto_rawDescGZIP(), []int{2}
+	return file_vnic_v1_vnic_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *AESLinkInit) GetProtocolVersion() uint32 {
@@ -206,12 +261,13 @@ type PeerInit struct {
 	Certificate     *certificate.Certificate `protobuf:"bytes,2,opt,name=certificate,proto3" json:"certificate,omitempty"`
 	NodePlatform    string                   `protobuf:"bytes,3,opt,name=node_platform,json=nodePlatform,proto3" json:"node_platform,omitempty"`
 	NodeVersion     string                   `protobuf:"bytes,4,opt,name=node_version,json=nodeVersion,proto3" json:"node_version,omitempty"`
+	IdNonce         uint64                   `protobuf:"varint,5,opt,name=id_nonce,json=idNonce,proto3" json:"id_nonce,omitempty"`
 }
 
 func (x *PeerInit) Reset() {
 	*x = PeerInit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vnic_v1_vnic_proto_msgTypes[3]
+		mi := &file_vnic_v1_vnic_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -224,7 +280,7 @@ func (x *PeerInit) String() string {
 func (*PeerInit) ProtoMessage() {}
 
 func (x *PeerInit) ProtoReflect() protoreflect.Message {
-	mi := &file_vnic_v1_vnic_proto_msgTypes[3]
+	mi := &file_vnic_v1_vnic_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -237,7 +293,7 @@ func (x *PeerInit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PeerInit.ProtoReflect.Descriptor instead.
 func (*PeerInit) Descriptor() ([]byte, []int) {
-	return file_vnic_v1_vnic_proto_rawDescGZIP(), []int{3}
+	return file_vnic_v1_vnic_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *PeerInit) GetProtocolVersion() uint32 {
@@ -268,6 +324,13 @@ func (x *PeerInit) GetNodeVersion() string {
 	return ""
 }
 
+func (x *PeerInit) GetIdNonce() uint64 {
+	if x != nil {
+		return x.IdNonce
+	}
+	return 0
+}
+
 type Config struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -280,7 +343,7 @@ type Config struct {
 func (x *Config) Reset() {
 	*x = Config{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vnic_v1_vnic_proto_msgTypes[4]
+		mi := &file_vnic_v1_vnic_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -293,7 +356,7 @@ func (x *Config) String() string {
 func (*Config) ProtoMessage() {}
 
 func (x *Config) ProtoReflect() protoreflect.Message {
-	mi := &file_vnic_v1_vnic_proto_msgTypes[4]
+	mi := &file_vnic_v1_vnic_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -306,7 +369,7 @@ func (x *Config) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Config.ProtoReflect.Descriptor instead.
 func (*Config) Descriptor() ([]byte, []int) {
-	return file_vnic_v1_vnic_proto_rawDescGZIP(), []int{4}
+	return file_vnic_v1_vnic_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Config) GetMaxUploadBytesPerSecond() uint64 {
@@ -332,7 +395,7 @@ type GetConfigRequest struct {
 func (x *GetConfigRequest) Reset() {
 	*x = GetConfigRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vnic_v1_vnic_proto_msgTypes[5]
+		mi := &file_vnic_v1_vnic_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -345,7 +408,7 @@ func (x *GetConfigRequest) String() string {
 func (*GetConfigRequest) ProtoMessage() {}
 
 func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vnic_v1_vnic_proto_msgTypes[5]
+	mi := &file_vnic_v1_vnic_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -358,7 +421,7 @@ func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConfigRequest.ProtoReflect.Descriptor instead.
 func (*GetConfigRequest) Descriptor() ([]byte, []int) {
-	return file_vnic_v1_vnic_proto_rawDescGZIP(), []int{5}
+	return file_vnic_v1_vnic_proto_rawDescGZIP(), []int{6}
 }
 
 type GetConfigResponse struct {
@@ -372,7 +435,7 @@ type GetConfigResponse struct {
 func (x *GetConfigResponse) Reset() {
 	*x = GetConfigResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vnic_v1_vnic_proto_msgTypes[6]
+		mi := &file_vnic_v1_vnic_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -385,7 +448,7 @@ func (x *GetConfigResponse) String() string {
 func (*GetConfigResponse) ProtoMessage() {}
 
 func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vnic_v1_vnic_proto_msgTypes[6]
+	mi := &file_vnic_v1_vnic_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -398,7 +461,7 @@ func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConfigResponse.ProtoReflect.Descriptor instead.
 func (*GetConfigResponse) Descriptor() ([]byte, []int) {
-	return file_vnic_v1_vnic_proto_rawDescGZIP(), []int{6}
+	return file_vnic_v1_vnic_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetConfigResponse) GetConfig() *Config {
@@ -419,7 +482,7 @@ type SetConfigRequest struct {
 func (x *SetConfigRequest) Reset() {
 	*x = SetConfigRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vnic_v1_vnic_proto_msgTypes[7]
+		mi := &file_vnic_v1_vnic_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -432,7 +495,7 @@ func (x *SetConfigRequest) String() string {
 func (*SetConfigRequest) ProtoMessage() {}
 
 func (x *SetConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vnic_v1_vnic_proto_msgTypes[7]
+	mi := &file_vnic_v1_vnic_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -445,7 +508,7 @@ func (x *SetConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetConfigRequest.ProtoReflect.Descriptor instead.
 func (*SetConfigRequest) Descriptor() ([]byte, []int) {
-	return file_vnic_v1_vnic_proto_rawDescGZIP(), []int{7}
+	return file_vnic_v1_vnic_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SetConfigRequest) GetConfig() *Config {
@@ -466,7 +529,7 @@ type SetConfigResponse struct {
 func (x *SetConfigResponse) Reset() {
 	*x = SetConfigResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vnic_v1_vnic_proto_msgTypes[8]
+		mi := &file_vnic_v1_vnic_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -479,7 +542,7 @@ func (x *SetConfigResponse) String() string {
 func (*SetConfigResponse) ProtoMessage() {}
 
 func (x *SetConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vnic_v1_vnic_proto_msgTypes[8]
+	mi := &file_vnic_v1_vnic_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -492,7 +555,7 @@ func (x *SetConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetConfigResponse.ProtoReflect.Descriptor instead.
 func (*SetConfigResponse) Descriptor() ([]byte, []int) {
-	return file_vnic_v1_vnic_proto_rawDescGZIP(), []int{8}
+	return file_vnic_v1_vnic_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SetConfigResponse) GetConfig() *Config {
@@ -519,61 +582,68 @@ var file_vnic_v1_vnic_proto_rawDesc = []byte{
 	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
 	0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72,
 	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72,
-	0x4b, 0x65, 0x79, 0x22, 0x5a, 0x0a, 0x0b, 0x41, 0x45, 0x53, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x6e,
-	0x69, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x76, 0x22,
-	0xb9, 0x01, 0x0a, 0x08, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x29, 0x0a, 0x10,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73,
-	0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x74,
-	0x66, 0x6f, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65,
-	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65,
-	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x63, 0x0a, 0x06, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3c, 0x0a, 0x1b, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x70, 0x6c,
-	0x6f, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65,
-	0x63, 0x6f, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x55,
-	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63,
-	0x6f, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x65, 0x72, 0x73,
-	0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x43, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x69,
-	0x6d, 0x73, 0x2e, 0x76, 0x6e, 0x69, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x42, 0x0a, 0x10, 0x53, 0x65, 0x74,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a,
-	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x76, 0x6e, 0x69, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x43, 0x0a,
-	0x11, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x76, 0x6e, 0x69, 0x63,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x32, 0xb2, 0x01, 0x0a, 0x0c, 0x56, 0x4e, 0x49, 0x43, 0x46, 0x72, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x64, 0x12, 0x50, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x20, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x76, 0x6e, 0x69, 0x63, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x76, 0x6e, 0x69, 0x63,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x20, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x76, 0x6e, 0x69, 0x63,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x76, 0x6e,
-	0x69, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x4d, 0x0a, 0x11, 0x67, 0x67, 0x2e, 0x73, 0x74,
-	0x72, 0x69, 0x6d, 0x73, 0x2e, 0x76, 0x6e, 0x69, 0x63, 0x2e, 0x76, 0x31, 0x5a, 0x32, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x65, 0x6d, 0x65, 0x4c, 0x61, 0x62,
-	0x73, 0x2f, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69,
-	0x73, 0x2f, 0x76, 0x6e, 0x69, 0x63, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x6e, 0x69, 0x63, 0x76, 0x31,
-	0xba, 0x02, 0x03, 0x53, 0x56, 0x4e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4b, 0x65, 0x79, 0x22, 0x52, 0x0a, 0x0a, 0x55, 0x44, 0x50, 0x4d, 0x75, 0x78, 0x49, 0x6e, 0x69,
+	0x74, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08,
+	0x70, 0x65, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x70, 0x65, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x22, 0x5a, 0x0a, 0x0b, 0x41, 0x45, 0x53, 0x4c, 0x69,
+	0x6e, 0x6b, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x02, 0x69, 0x76, 0x22, 0xd4, 0x01, 0x0a, 0x08, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x69, 0x74,
+	0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0b, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x6e, 0x6f, 0x64, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x21, 0x0a, 0x0c,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x19, 0x0a, 0x08, 0x69, 0x64, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x69, 0x64, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x63, 0x0a, 0x06, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x3c, 0x0a, 0x1b, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x65, 0x72, 0x73, 0x22,
+	0x12, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x43, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d,
+	0x73, 0x2e, 0x76, 0x6e, 0x69, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x42, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73,
+	0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x76, 0x6e, 0x69, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x43, 0x0a, 0x11,
+	0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x76, 0x6e, 0x69, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x32, 0xb2, 0x01, 0x0a, 0x0c, 0x56, 0x4e, 0x49, 0x43, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x64, 0x12, 0x50, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x20, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x76, 0x6e, 0x69, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x76, 0x6e, 0x69, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x20, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x76, 0x6e, 0x69, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x76, 0x6e, 0x69,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x4d, 0x0a, 0x11, 0x67, 0x67, 0x2e, 0x73, 0x74, 0x72,
+	0x69, 0x6d, 0x73, 0x2e, 0x76, 0x6e, 0x69, 0x63, 0x2e, 0x76, 0x31, 0x5a, 0x32, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x65, 0x6d, 0x65, 0x4c, 0x61, 0x62, 0x73,
+	0x2f, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x73,
+	0x2f, 0x76, 0x6e, 0x69, 0x63, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x6e, 0x69, 0x63, 0x76, 0x31, 0xba,
+	0x02, 0x03, 0x53, 0x56, 0x4e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -588,33 +658,34 @@ func file_vnic_v1_vnic_proto_rawDescGZIP() []byte {
 	return file_vnic_v1_vnic_proto_rawDescData
 }
 
-var file_vnic_v1_vnic_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_vnic_v1_vnic_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_vnic_v1_vnic_proto_goTypes = []interface{}{
 	(*LinkDescription)(nil),         // 0: strims.vnic.v1.LinkDescription
 	(*TCPMuxInit)(nil),              // 1: strims.vnic.v1.TCPMuxInit
-	(*AESLinkInit)(nil),             // 2: strims.vnic.v1.AESLinkInit
-	(*PeerInit)(nil),                // 3: strims.vnic.v1.PeerInit
-	(*Config)(nil),                  // 4: strims.vnic.v1.Config
-	(*GetConfigRequest)(nil),        // 5: strims.vnic.v1.GetConfigRequest
-	(*GetConfigResponse)(nil),       // 6: strims.vnic.v1.GetConfigResponse
-	(*SetConfigRequest)(nil),        // 7: strims.vnic.v1.SetConfigRequest
-	(*SetConfigResponse)(nil),       // 8: strims.vnic.v1.SetConfigResponse
-	(*certificate.Certificate)(nil), // 9: strims.type.Certificate
+	(*UDPMuxInit)(nil),              // 2: strims.vnic.v1.UDPMuxInit
+	(*AESLinkInit)(nil),             // 3: strims.vnic.v1.AESLinkInit
+	(*PeerInit)(nil),                // 4: strims.vnic.v1.PeerInit
+	(*Config)(nil),                  // 5: strims.vnic.v1.Config
+	(*GetConfigRequest)(nil),        // 6: strims.vnic.v1.GetConfigRequest
+	(*GetConfigResponse)(nil),       // 7: strims.vnic.v1.GetConfigResponse
+	(*SetConfigRequest)(nil),        // 8: strims.vnic.v1.SetConfigRequest
+	(*SetConfigResponse)(nil),       // 9: strims.vnic.v1.SetConfigResponse
+	(*certificate.Certificate)(nil), // 10: strims.type.Certificate
 }
 var file_vnic_v1_vnic_proto_depIdxs = []int32{
-	9, // 0: strims.vnic.v1.PeerInit.certificate:type_name -> strims.type.Certificate
-	4, // 1: strims.vnic.v1.GetConfigResponse.config:type_name -> strims.vnic.v1.Config
-	4, // 2: strims.vnic.v1.SetConfigRequest.config:type_name -> strims.vnic.v1.Config
-	4, // 3: strims.vnic.v1.SetConfigResponse.config:type_name -> strims.vnic.v1.Config
-	5, // 4: strims.vnic.v1.VNICFrontend.GetConfig:input_type -> strims.vnic.v1.GetConfigRequest
-	7, // 5: strims.vnic.v1.VNICFrontend.SetConfig:input_type -> strims.vnic.v1.SetConfigRequest
-	6, // 6: strims.vnic.v1.VNICFrontend.GetConfig:output_type -> strims.vnic.v1.GetConfigResponse
-	8, // 7: strims.vnic.v1.VNICFrontend.SetConfig:output_type -> strims.vnic.v1.SetConfigResponse
-	6, // [6:8] is the sub-list for method output_type
-	4, // [4:6] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	10, // 0: strims.vnic.v1.PeerInit.certificate:type_name -> strims.type.Certificate
+	5,  // 1: strims.vnic.v1.GetConfigResponse.config:type_name -> strims.vnic.v1.Config
+	5,  // 2: strims.vnic.v1.SetConfigRequest.config:type_name -> strims.vnic.v1.Config
+	5,  // 3: strims.vnic.v1.SetConfigResponse.config:type_name -> strims.vnic.v1.Config
+	6,  // 4: strims.vnic.v1.VNICFrontend.GetConfig:input_type -> strims.vnic.v1.GetConfigRequest
+	8,  // 5: strims.vnic.v1.VNICFrontend.SetConfig:input_type -> strims.vnic.v1.SetConfigRequest
+	7,  // 6: strims.vnic.v1.VNICFrontend.GetConfig:output_type -> strims.vnic.v1.GetConfigResponse
+	9,  // 7: strims.vnic.v1.VNICFrontend.SetConfig:output_type -> strims.vnic.v1.SetConfigResponse
+	6,  // [6:8] is the sub-list for method output_type
+	4,  // [4:6] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_vnic_v1_vnic_proto_init() }
@@ -648,7 +719,7 @@ func file_vnic_v1_vnic_proto_init() {
 			}
 		}
 		file_vnic_v1_vnic_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AESLinkInit); i {
+			switch v := v.(*UDPMuxInit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -660,7 +731,7 @@ func file_vnic_v1_vnic_proto_init() {
 			}
 		}
 		file_vnic_v1_vnic_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeerInit); i {
+			switch v := v.(*AESLinkInit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -672,7 +743,7 @@ func file_vnic_v1_vnic_proto_init() {
 			}
 		}
 		file_vnic_v1_vnic_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Config); i {
+			switch v := v.(*PeerInit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -684,7 +755,7 @@ func file_vnic_v1_vnic_proto_init() {
 			}
 		}
 		file_vnic_v1_vnic_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConfigRequest); i {
+			switch v := v.(*Config); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -696,7 +767,7 @@ func file_vnic_v1_vnic_proto_init() {
 			}
 		}
 		file_vnic_v1_vnic_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConfigResponse); i {
+			switch v := v.(*GetConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -708,7 +779,7 @@ func file_vnic_v1_vnic_proto_init() {
 			}
 		}
 		file_vnic_v1_vnic_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetConfigRequest); i {
+			switch v := v.(*GetConfigResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -720,6 +791,18 @@ func file_vnic_v1_vnic_proto_init() {
 			}
 		}
 		file_vnic_v1_vnic_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vnic_v1_vnic_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetConfigResponse); i {
 			case 0:
 				return &v.state
@@ -738,7 +821,7 @@ func file_vnic_v1_vnic_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_vnic_v1_vnic_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
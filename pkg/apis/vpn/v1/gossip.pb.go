@@ -0,0 +1,854 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.20.1
+// source: vpn/v1/gossip.proto
+
+package vpnv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GossipMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Body:
+	//	*GossipMessage_Subscribe_
+	//	*GossipMessage_Unsubscribe_
+	//	*GossipMessage_Publish_
+	//	*GossipMessage_Ihave
+	//	*GossipMessage_Iwant
+	//	*GossipMessage_Graft_
+	//	*GossipMessage_Prune_
+	Body isGossipMessage_Body `protobuf_oneof:"body"`
+}
+
+func (x *GossipMessage) Reset() {
+	*x = GossipMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vpn_v1_gossip_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GossipMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GossipMessage) ProtoMessage() {}
+
+func (x *GossipMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_vpn_v1_gossip_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GossipMessage.ProtoReflect.Descriptor instead.
+func (*GossipMessage) Descriptor() ([]byte, []int) {
+	return file_vpn_v1_gossip_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *GossipMessage) GetBody() isGossipMessage_Body {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+func (x *GossipMessage) GetSubscribe() *GossipMessage_Subscribe {
+	if x, ok := x.GetBody().(*GossipMessage_Subscribe_); ok {
+		return x.Subscribe
+	}
+	return nil
+}
+
+func (x *GossipMessage) GetUnsubscribe() *GossipMessage_Unsubscribe {
+	if x, ok := x.GetBody().(*GossipMessage_Unsubscribe_); ok {
+		return x.Unsubscribe
+	}
+	return nil
+}
+
+func (x *GossipMessage) GetPublish() *GossipMessage_Publish {
+	if x, ok := x.GetBody().(*GossipMessage_Publish_); ok {
+		return x.Publish
+	}
+	return nil
+}
+
+func (x *GossipMessage) GetIhave() *GossipMessage_IHave {
+	if x, ok := x.GetBody().(*GossipMessage_Ihave); ok {
+		return x.Ihave
+	}
+	return nil
+}
+
+func (x *GossipMessage) GetIwant() *GossipMessage_IWant {
+	if x, ok := x.GetBody().(*GossipMessage_Iwant); ok {
+		return x.Iwant
+	}
+	return nil
+}
+
+func (x *GossipMessage) GetGraft() *GossipMessage_Graft {
+	if x, ok := x.GetBody().(*GossipMessage_Graft_); ok {
+		return x.Graft
+	}
+	return nil
+}
+
+func (x *GossipMessage) GetPrune() *GossipMessage_Prune {
+	if x, ok := x.GetBody().(*GossipMessage_Prune_); ok {
+		return x.Prune
+	}
+	return nil
+}
+
+type isGossipMessage_Body interface {
+	isGossipMessage_Body()
+}
+
+type GossipMessage_Subscribe_ struct {
+	Subscribe *GossipMessage_Subscribe `protobuf:"bytes,1,opt,name=subscribe,proto3,oneof"`
+}
+
+type GossipMessage_Unsubscribe_ struct {
+	Unsubscribe *GossipMessage_Unsubscribe `protobuf:"bytes,2,opt,name=unsubscribe,proto3,oneof"`
+}
+
+type GossipMessage_Publish_ struct {
+	Publish *GossipMessage_Publish `protobuf:"bytes,3,opt,name=publish,proto3,oneof"`
+}
+
+type GossipMessage_Ihave struct {
+	Ihave *GossipMessage_IHave `protobuf:"bytes,4,opt,name=ihave,proto3,oneof"`
+}
+
+type GossipMessage_Iwant struct {
+	Iwant *GossipMessage_IWant `protobuf:"bytes,5,opt,name=iwant,proto3,oneof"`
+}
+
+type GossipMessage_Graft_ struct {
+	Graft *GossipMessage_Graft `protobuf:"bytes,6,opt,name=graft,proto3,oneof"`
+}
+
+type GossipMessage_Prune_ struct {
+	Prune *GossipMessage_Prune `protobuf:"bytes,7,opt,name=prune,proto3,oneof"`
+}
+
+func (*GossipMessage_Subscribe_) isGossipMessage_Body() {}
+
+func (*GossipMessage_Unsubscribe_) isGossipMessage_Body() {}
+
+func (*GossipMessage_Publish_) isGossipMessage_Body() {}
+
+func (*GossipMessage_Ihave) isGossipMessage_Body() {}
+
+func (*GossipMessage_Iwant) isGossipMessage_Body() {}
+
+func (*GossipMessage_Graft_) isGossipMessage_Body() {}
+
+func (*GossipMessage_Prune_) isGossipMessage_Body() {}
+
+type GossipMessage_Record struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topic     []byte `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Data      []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Seq       uint64 `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+	Timestamp int64  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Key       []byte `protobuf:"bytes,10001,opt,name=key,proto3" json:"key,omitempty"`
+	Signature []byte `protobuf:"bytes,10002,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *GossipMessage_Record) Reset() {
+	*x = GossipMessage_Record{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vpn_v1_gossip_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GossipMessage_Record) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GossipMessage_Record) ProtoMessage() {}
+
+func (x *GossipMessage_Record) ProtoReflect() protoreflect.Message {
+	mi := &file_vpn_v1_gossip_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GossipMessage_Record.ProtoReflect.Descriptor instead.
+func (*GossipMessage_Record) Descriptor() ([]byte, []int) {
+	return file_vpn_v1_gossip_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *GossipMessage_Record) GetTopic() []byte {
+	if x != nil {
+		return x.Topic
+	}
+	return nil
+}
+
+func (x *GossipMessage_Record) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GossipMessage_Record) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *GossipMessage_Record) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *GossipMessage_Record) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *GossipMessage_Record) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type GossipMessage_Subscribe struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topics [][]byte `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+}
+
+func (x *GossipMessage_Subscribe) Reset() {
+	*x = GossipMessage_Subscribe{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vpn_v1_gossip_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GossipMessage_Subscribe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GossipMessage_Subscribe) ProtoMessage() {}
+
+func (x *GossipMessage_Subscribe) ProtoReflect() protoreflect.Message {
+	mi := &file_vpn_v1_gossip_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GossipMessage_Subscribe.ProtoReflect.Descriptor instead.
+func (*GossipMessage_Subscribe) Descriptor() ([]byte, []int) {
+	return file_vpn_v1_gossip_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *GossipMessage_Subscribe) GetTopics() [][]byte {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+type GossipMessage_Unsubscribe struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topics [][]byte `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+}
+
+func (x *GossipMessage_Unsubscribe) Reset() {
+	*x = GossipMessage_Unsubscribe{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vpn_v1_gossip_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GossipMessage_Unsubscribe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GossipMessage_Unsubscribe) ProtoMessage() {}
+
+func (x *GossipMessage_Unsubscribe) ProtoReflect() protoreflect.Message {
+	mi := &file_vpn_v1_gossip_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GossipMessage_Unsubscribe.ProtoReflect.Descriptor instead.
+func (*GossipMessage_Unsubscribe) Descriptor() ([]byte, []int) {
+	return file_vpn_v1_gossip_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *GossipMessage_Unsubscribe) GetTopics() [][]byte {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+type GossipMessage_Publish struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Record *GossipMessage_Record `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+}
+
+func (x *GossipMessage_Publish) Reset() {
+	*x = GossipMessage_Publish{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vpn_v1_gossip_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GossipMessage_Publish) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GossipMessage_Publish) ProtoMessage() {}
+
+func (x *GossipMessage_Publish) ProtoReflect() protoreflect.Message {
+	mi := &file_vpn_v1_gossip_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GossipMessage_Publish.ProtoReflect.Descriptor instead.
+func (*GossipMessage_Publish) Descriptor() ([]byte, []int) {
+	return file_vpn_v1_gossip_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *GossipMessage_Publish) GetRecord() *GossipMessage_Record {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+type GossipMessage_IHave struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topic      []byte   `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	MessageIds [][]byte `protobuf:"bytes,2,rep,name=message_ids,json=messageIds,proto3" json:"message_ids,omitempty"`
+}
+
+func (x *GossipMessage_IHave) Reset() {
+	*x = GossipMessage_IHave{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vpn_v1_gossip_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GossipMessage_IHave) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GossipMessage_IHave) ProtoMessage() {}
+
+func (x *GossipMessage_IHave) ProtoReflect() protoreflect.Message {
+	mi := &file_vpn_v1_gossip_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GossipMessage_IHave.ProtoReflect.Descriptor instead.
+func (*GossipMessage_IHave) Descriptor() ([]byte, []int) {
+	return file_vpn_v1_gossip_proto_rawDescGZIP(), []int{0, 4}
+}
+
+func (x *GossipMessage_IHave) GetTopic() []byte {
+	if x != nil {
+		return x.Topic
+	}
+	return nil
+}
+
+func (x *GossipMessage_IHave) GetMessageIds() [][]byte {
+	if x != nil {
+		return x.MessageIds
+	}
+	return nil
+}
+
+type GossipMessage_IWant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageIds [][]byte `protobuf:"bytes,1,rep,name=message_ids,json=messageIds,proto3" json:"message_ids,omitempty"`
+}
+
+func (x *GossipMessage_IWant) Reset() {
+	*x = GossipMessage_IWant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vpn_v1_gossip_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GossipMessage_IWant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GossipMessage_IWant) ProtoMessage() {}
+
+func (x *GossipMessage_IWant) ProtoReflect() protoreflect.Message {
+	mi := &file_vpn_v1_gossip_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GossipMessage_IWant.ProtoReflect.Descriptor instead.
+func (*GossipMessage_IWant) Descriptor() ([]byte, []int) {
+	return file_vpn_v1_gossip_proto_rawDescGZIP(), []int{0, 5}
+}
+
+func (x *GossipMessage_IWant) GetMessageIds() [][]byte {
+	if x != nil {
+		return x.MessageIds
+	}
+	return nil
+}
+
+type GossipMessage_Graft struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topic []byte `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+}
+
+func (x *GossipMessage_Graft) Reset() {
+	*x = GossipMessage_Graft{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vpn_v1_gossip_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GossipMessage_Graft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GossipMessage_Graft) ProtoMessage() {}
+
+func (x *GossipMessage_Graft) ProtoReflect() protoreflect.Message {
+	mi := &file_vpn_v1_gossip_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GossipMessage_Graft.ProtoReflect.Descriptor instead.
+func (*GossipMessage_Graft) Descriptor() ([]byte, []int) {
+	return file_vpn_v1_gossip_proto_rawDescGZIP(), []int{0, 6}
+}
+
+func (x *GossipMessage_Graft) GetTopic() []byte {
+	if x != nil {
+		return x.Topic
+	}
+	return nil
+}
+
+type GossipMessage_Prune struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topic []byte `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+}
+
+func (x *GossipMessage_Prune) Reset() {
+	*x = GossipMessage_Prune{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vpn_v1_gossip_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GossipMessage_Prune) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GossipMessage_Prune) ProtoMessage() {}
+
+func (x *GossipMessage_Prune) ProtoReflect() protoreflect.Message {
+	mi := &file_vpn_v1_gossip_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GossipMessage_Prune.ProtoReflect.Descriptor instead.
+func (*GossipMessage_Prune) Descriptor() ([]byte, []int) {
+	return file_vpn_v1_gossip_proto_rawDescGZIP(), []int{0, 7}
+}
+
+func (x *GossipMessage_Prune) GetTopic() []byte {
+	if x != nil {
+		return x.Topic
+	}
+	return nil
+}
+
+var File_vpn_v1_gossip_proto protoreflect.FileDescriptor
+
+var file_vpn_v1_gossip_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x76, 0x70, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x76, 0x70,
+	0x6e, 0x2e, 0x76, 0x31, 0x22, 0xb2, 0x07, 0x0a, 0x0d, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x46, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x6d, 0x73, 0x2e, 0x76, 0x70, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x48, 0x00, 0x52, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x4c,
+	0x0a, 0x0b, 0x75, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x76, 0x70, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x48, 0x00, 0x52,
+	0x0b, 0x75, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x40, 0x0a, 0x07,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x76, 0x70, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f,
+	0x73, 0x73, 0x69, 0x70, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x48, 0x00, 0x52, 0x07, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x3a,
+	0x0a, 0x05, 0x69, 0x68, 0x61, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x76, 0x70, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f,
+	0x73, 0x73, 0x69, 0x70, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x49, 0x48, 0x61, 0x76,
+	0x65, 0x48, 0x00, 0x52, 0x05, 0x69, 0x68, 0x61, 0x76, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x69, 0x77,
+	0x61, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x6d, 0x73, 0x2e, 0x76, 0x70, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x49, 0x57, 0x61, 0x6e, 0x74, 0x48, 0x00, 0x52,
+	0x05, 0x69, 0x77, 0x61, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x66, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x76,
+	0x70, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x47, 0x72, 0x61, 0x66, 0x74, 0x48, 0x00, 0x52, 0x05, 0x67, 0x72, 0x61,
+	0x66, 0x74, 0x12, 0x3a, 0x0a, 0x05, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x76, 0x70, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
+	0x50, 0x72, 0x75, 0x6e, 0x65, 0x48, 0x00, 0x52, 0x05, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x1a, 0x94,
+	0x01, 0x0a, 0x06, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x11, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x91, 0x4e, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x92, 0x4e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x23, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x1a, 0x25, 0x0a, 0x0b, 0x55, 0x6e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x73, 0x1a, 0x46, 0x0a, 0x07, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x3b, 0x0a, 0x06,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73,
+	0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x76, 0x70, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f, 0x73,
+	0x73, 0x69, 0x70, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x1a, 0x3e, 0x0a, 0x05, 0x49, 0x48, 0x61,
+	0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x73, 0x1a, 0x28, 0x0a, 0x05, 0x49, 0x57, 0x61,
+	0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x49, 0x64, 0x73, 0x1a, 0x1d, 0x0a, 0x05, 0x47, 0x72, 0x61, 0x66, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x1a, 0x1d, 0x0a, 0x05, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x42, 0x06, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x42, 0x4a, 0x0a, 0x10, 0x67, 0x67, 0x2e,
+	0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x76, 0x70, 0x6e, 0x2e, 0x76, 0x31, 0x5a, 0x30, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x65, 0x6d, 0x65, 0x4c, 0x61,
+	0x62, 0x73, 0x2f, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70,
+	0x69, 0x73, 0x2f, 0x76, 0x70, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x70, 0x6e, 0x76, 0x31, 0xba,
+	0x02, 0x03, 0x53, 0x56, 0x4e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_vpn_v1_gossip_proto_rawDescOnce sync.Once
+	file_vpn_v1_gossip_proto_rawDescData = file_vpn_v1_gossip_proto_rawDesc
+)
+
+func file_vpn_v1_gossip_proto_rawDescGZIP() []byte {
+	file_vpn_v1_gossip_proto_rawDescOnce.Do(func() {
+		file_vpn_v1_gossip_proto_rawDescData = protoimpl.X.CompressGZIP(file_vpn_v1_gossip_proto_rawDescData)
+	})
+	return file_vpn_v1_gossip_proto_rawDescData
+}
+
+var file_vpn_v1_gossip_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_vpn_v1_gossip_proto_goTypes = []interface{}{
+	(*GossipMessage)(nil),             // 0: strims.vpn.v1.GossipMessage
+	(*GossipMessage_Record)(nil),      // 1: strims.vpn.v1.GossipMessage.Record
+	(*GossipMessage_Subscribe)(nil),   // 2: strims.vpn.v1.GossipMessage.Subscribe
+	(*GossipMessage_Unsubscribe)(nil), // 3: strims.vpn.v1.GossipMessage.Unsubscribe
+	(*GossipMessage_Publish)(nil),     // 4: strims.vpn.v1.GossipMessage.Publish
+	(*GossipMessage_IHave)(nil),       // 5: strims.vpn.v1.GossipMessage.IHave
+	(*GossipMessage_IWant)(nil),       // 6: strims.vpn.v1.GossipMessage.IWant
+	(*GossipMessage_Graft)(nil),       // 7: strims.vpn.v1.GossipMessage.Graft
+	(*GossipMessage_Prune)(nil),       // 8: strims.vpn.v1.GossipMessage.Prune
+}
+var file_vpn_v1_gossip_proto_depIdxs = []int32{
+	2, // 0: strims.vpn.v1.GossipMessage.subscribe:type_name -> strims.vpn.v1.GossipMessage.Subscribe
+	3, // 1: strims.vpn.v1.GossipMessage.unsubscribe:type_name -> strims.vpn.v1.GossipMessage.Unsubscribe
+	4, // 2: strims.vpn.v1.GossipMessage.publish:type_name -> strims.vpn.v1.GossipMessage.Publish
+	5, // 3: strims.vpn.v1.GossipMessage.ihave:type_name -> strims.vpn.v1.GossipMessage.IHave
+	6, // 4: strims.vpn.v1.GossipMessage.iwant:type_name -> strims.vpn.v1.GossipMessage.IWant
+	7, // 5: strims.vpn.v1.GossipMessage.graft:type_name -> strims.vpn.v1.GossipMessage.Graft
+	8, // 6: strims.vpn.v1.GossipMessage.prune:type_name -> strims.vpn.v1.GossipMessage.Prune
+	1, // 7: strims.vpn.v1.GossipMessage.Publish.record:type_name -> strims.vpn.v1.GossipMessage.Record
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_vpn_v1_gossip_proto_init() }
+func file_vpn_v1_gossip_proto_init() {
+	if File_vpn_v1_gossip_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_vpn_v1_gossip_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GossipMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vpn_v1_gossip_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GossipMessage_Record); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vpn_v1_gossip_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GossipMessage_Subscribe); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vpn_v1_gossip_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GossipMessage_Unsubscribe); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vpn_v1_gossip_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GossipMessage_Publish); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vpn_v1_gossip_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GossipMessage_IHave); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vpn_v1_gossip_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GossipMessage_IWant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vpn_v1_gossip_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GossipMessage_Graft); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vpn_v1_gossip_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GossipMessage_Prune); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_vpn_v1_gossip_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*GossipMessage_Subscribe_)(nil),
+		(*GossipMessage_Unsubscribe_)(nil),
+		(*GossipMessage_Publish_)(nil),
+		(*GossipMessage_Ihave)(nil),
+		(*GossipMessage_Iwant)(nil),
+		(*GossipMessage_Graft_)(nil),
+		(*GossipMessage_Prune_)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_vpn_v1_gossip_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_vpn_v1_gossip_proto_goTypes,
+		DependencyIndexes: file_vpn_v1_gossip_proto_depIdxs,
+		MessageInfos:      file_vpn_v1_gossip_proto_msgTypes,
+	}.Build()
+	File_vpn_v1_gossip_proto = out.File
+	file_vpn_v1_gossip_proto_rawDesc = nil
+	file_vpn_v1_gossip_proto_goTypes = nil
+	file_vpn_v1_gossip_proto_depIdxs = nil
+}
@@ -0,0 +1,758 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.20.1
+// source: vpn/v1/onion.proto
+
+package vpnv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type OnionMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EphemeralKey []byte `protobuf:"bytes,1,opt,name=ephemeral_key,json=ephemeralKey,proto3" json:"ephemeral_key,omitempty"`
+	Header       []byte `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
+	Payload      []byte `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *OnionMessage) Reset() {
+	*x = OnionMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vpn_v1_onion_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OnionMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnionMessage) ProtoMessage() {}
+
+func (x *OnionMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_vpn_v1_onion_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnionMessage.ProtoReflect.Descriptor instead.
+func (*OnionMessage) Descriptor() ([]byte, []int) {
+	return file_vpn_v1_onion_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OnionMessage) GetEphemeralKey() []byte {
+	if x != nil {
+		return x.EphemeralKey
+	}
+	return nil
+}
+
+func (x *OnionMessage) GetHeader() []byte {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *OnionMessage) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type OnionHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Body:
+	//	*OnionHeader_Relay_
+	//	*OnionHeader_Deliver_
+	//	*OnionHeader_Receive_
+	Body isOnionHeader_Body `protobuf_oneof:"body"`
+}
+
+func (x *OnionHeader) Reset() {
+	*x = OnionHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vpn_v1_onion_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OnionHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnionHeader) ProtoMessage() {}
+
+func (x *OnionHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_vpn_v1_onion_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnionHeader.ProtoReflect.Descriptor instead.
+func (*OnionHeader) Descriptor() ([]byte, []int) {
+	return file_vpn_v1_onion_proto_rawDescGZIP(), []int{1}
+}
+
+func (m *OnionHeader) GetBody() isOnionHeader_Body {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+func (x *OnionHeader) GetRelay() *OnionHeader_Relay {
+	if x, ok := x.GetBody().(*OnionHeader_Relay_); ok {
+		return x.Relay
+	}
+	return nil
+}
+
+func (x *OnionHeader) GetDeliver() *OnionHeader_Deliver {
+	if x, ok := x.GetBody().(*OnionHeader_Deliver_); ok {
+		return x.Deliver
+	}
+	return nil
+}
+
+func (x *OnionHeader) GetReceive() *OnionHeader_Receive {
+	if x, ok := x.GetBody().(*OnionHeader_Receive_); ok {
+		return x.Receive
+	}
+	return nil
+}
+
+type isOnionHeader_Body interface {
+	isOnionHeader_Body()
+}
+
+type OnionHeader_Relay_ struct {
+	Relay *OnionHeader_Relay `protobuf:"bytes,1,opt,name=relay,proto3,oneof"`
+}
+
+type OnionHeader_Deliver_ struct {
+	Deliver *OnionHeader_Deliver `protobuf:"bytes,2,opt,name=deliver,proto3,oneof"`
+}
+
+type OnionHeader_Receive_ struct {
+	Receive *OnionHeader_Receive `protobuf:"bytes,3,opt,name=receive,proto3,oneof"`
+}
+
+func (*OnionHeader_Relay_) isOnionHeader_Body() {}
+
+func (*OnionHeader_Deliver_) isOnionHeader_Body() {}
+
+func (*OnionHeader_Receive_) isOnionHeader_Body() {}
+
+type OnionReplyBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HostId       []byte `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	EphemeralKey []byte `protobuf:"bytes,2,opt,name=ephemeral_key,json=ephemeralKey,proto3" json:"ephemeral_key,omitempty"`
+	Header       []byte `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
+}
+
+func (x *OnionReplyBlock) Reset() {
+	*x = OnionReplyBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vpn_v1_onion_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OnionReplyBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnionReplyBlock) ProtoMessage() {}
+
+func (x *OnionReplyBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_vpn_v1_onion_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnionReplyBlock.ProtoReflect.Descriptor instead.
+func (*OnionReplyBlock) Descriptor() ([]byte, []int) {
+	return file_vpn_v1_onion_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OnionReplyBlock) GetHostId() []byte {
+	if x != nil {
+		return x.HostId
+	}
+	return nil
+}
+
+func (x *OnionReplyBlock) GetEphemeralKey() []byte {
+	if x != nil {
+		return x.EphemeralKey
+	}
+	return nil
+}
+
+func (x *OnionReplyBlock) GetHeader() []byte {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+type OnionPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port    uint32 `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	SrcPort uint32 `protobuf:"varint,2,opt,name=src_port,json=srcPort,proto3" json:"src_port,omitempty"`
+	Body    []byte `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *OnionPayload) Reset() {
+	*x = OnionPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vpn_v1_onion_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OnionPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnionPayload) ProtoMessage() {}
+
+func (x *OnionPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_vpn_v1_onion_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnionPayload.ProtoReflect.Descriptor instead.
+func (*OnionPayload) Descriptor() ([]byte, []int) {
+	return file_vpn_v1_onion_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OnionPayload) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *OnionPayload) GetSrcPort() uint32 {
+	if x != nil {
+		return x.SrcPort
+	}
+	return 0
+}
+
+func (x *OnionPayload) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type OnionHeader_Relay struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HostId       []byte `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	PayloadKey   []byte `protobuf:"bytes,2,opt,name=payload_key,json=payloadKey,proto3" json:"payload_key,omitempty"`
+	EphemeralKey []byte `protobuf:"bytes,3,opt,name=ephemeral_key,json=ephemeralKey,proto3" json:"ephemeral_key,omitempty"`
+	Header       []byte `protobuf:"bytes,4,opt,name=header,proto3" json:"header,omitempty"`
+}
+
+func (x *OnionHeader_Relay) Reset() {
+	*x = OnionHeader_Relay{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vpn_v1_onion_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OnionHeader_Relay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnionHeader_Relay) ProtoMessage() {}
+
+func (x *OnionHeader_Relay) ProtoReflect() protoreflect.Message {
+	mi := &file_vpn_v1_onion_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnionHeader_Relay.ProtoReflect.Descriptor instead.
+func (*OnionHeader_Relay) Descriptor() ([]byte, []int) {
+	return file_vpn_v1_onion_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *OnionHeader_Relay) GetHostId() []byte {
+	if x != nil {
+		return x.HostId
+	}
+	return nil
+}
+
+func (x *OnionHeader_Relay) GetPayloadKey() []byte {
+	if x != nil {
+		return x.PayloadKey
+	}
+	return nil
+}
+
+func (x *OnionHeader_Relay) GetEphemeralKey() []byte {
+	if x != nil {
+		return x.EphemeralKey
+	}
+	return nil
+}
+
+func (x *OnionHeader_Relay) GetHeader() []byte {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+type OnionHeader_Deliver struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HostId     []byte           `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	Port       uint32           `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	SrcPort    uint32           `protobuf:"varint,3,opt,name=src_port,json=srcPort,proto3" json:"src_port,omitempty"`
+	Body       []byte           `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	ReplyBlock *OnionReplyBlock `protobuf:"bytes,5,opt,name=reply_block,json=replyBlock,proto3" json:"reply_block,omitempty"`
+	ReplyKey   []byte           `protobuf:"bytes,6,opt,name=reply_key,json=replyKey,proto3" json:"reply_key,omitempty"`
+	Timestamp  int64            `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Key        []byte           `protobuf:"bytes,10001,opt,name=key,proto3" json:"key,omitempty"`
+	Signature  []byte           `protobuf:"bytes,10002,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *OnionHeader_Deliver) Reset() {
+	*x = OnionHeader_Deliver{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vpn_v1_onion_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OnionHeader_Deliver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnionHeader_Deliver) ProtoMessage() {}
+
+func (x *OnionHeader_Deliver) ProtoReflect() protoreflect.Message {
+	mi := &file_vpn_v1_onion_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnionHeader_Deliver.ProtoReflect.Descriptor instead.
+func (*OnionHeader_Deliver) Descriptor() ([]byte, []int) {
+	return file_vpn_v1_onion_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *OnionHeader_Deliver) GetHostId() []byte {
+	if x != nil {
+		return x.HostId
+	}
+	return nil
+}
+
+func (x *OnionHeader_Deliver) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *OnionHeader_Deliver) GetSrcPort() uint32 {
+	if x != nil {
+		return x.SrcPort
+	}
+	return 0
+}
+
+func (x *OnionHeader_Deliver) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *OnionHeader_Deliver) GetReplyBlock() *OnionReplyBlock {
+	if x != nil {
+		return x.ReplyBlock
+	}
+	return nil
+}
+
+func (x *OnionHeader_Deliver) GetReplyKey() []byte {
+	if x != nil {
+		return x.ReplyKey
+	}
+	return nil
+}
+
+func (x *OnionHeader_Deliver) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *OnionHeader_Deliver) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *OnionHeader_Deliver) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type OnionHeader_Receive struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReplyId []byte `protobuf:"bytes,1,opt,name=reply_id,json=replyId,proto3" json:"reply_id,omitempty"`
+}
+
+func (x *OnionHeader_Receive) Reset() {
+	*x = OnionHeader_Receive{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vpn_v1_onion_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OnionHeader_Receive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnionHeader_Receive) ProtoMessage() {}
+
+func (x *OnionHeader_Receive) ProtoReflect() protoreflect.Message {
+	mi := &file_vpn_v1_onion_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnionHeader_Receive.ProtoReflect.Descriptor instead.
+func (*OnionHeader_Receive) Descriptor() ([]byte, []int) {
+	return file_vpn_v1_onion_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *OnionHeader_Receive) GetReplyId() []byte {
+	if x != nil {
+		return x.ReplyId
+	}
+	return nil
+}
+
+var File_vpn_v1_onion_proto protoreflect.FileDescriptor
+
+var file_vpn_v1_onion_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x76, 0x70, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x76, 0x70, 0x6e,
+	0x2e, 0x76, 0x31, 0x22, 0x65, 0x0a, 0x0c, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x65, 0x70, 0x68, 0x65,
+	0x6d, 0x65, 0x72, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x8b, 0x05, 0x0a, 0x0b, 0x4f,
+	0x6e, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x05, 0x72, 0x65,
+	0x6c, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x74, 0x72, 0x69,
+	0x6d, 0x73, 0x2e, 0x76, 0x70, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x48, 0x00, 0x52, 0x05, 0x72,
+	0x65, 0x6c, 0x61, 0x79, 0x12, 0x3e, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x76,
+	0x70, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x76,
+	0x70, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x1a, 0x7e, 0x0a, 0x05, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x17, 0x0a,
+	0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
+	0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x70, 0x68, 0x65, 0x6d,
+	0x65, 0x72, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c,
+	0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x1a, 0x93, 0x02, 0x0a, 0x07, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x73, 0x72, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x07, 0x73, 0x72, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x3f, 0x0a, 0x0b,
+	0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d, 0x73, 0x2e, 0x76, 0x70, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1b, 0x0a,
+	0x09, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x11, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x91, 0x4e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x92, 0x4e, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x24, 0x0a, 0x07, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x49, 0x64,
+	0x42, 0x06, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x67, 0x0a, 0x0f, 0x4f, 0x6e, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x68,
+	0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x6f,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61,
+	0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x65, 0x70, 0x68,
+	0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x22, 0x51, 0x0a, 0x0c, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x72, 0x63, 0x50, 0x6f, 0x72, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x62, 0x6f, 0x64, 0x79, 0x42, 0x4a, 0x0a, 0x10, 0x67, 0x67, 0x2e, 0x73, 0x74, 0x72, 0x69, 0x6d,
+	0x73, 0x2e, 0x76, 0x70, 0x6e, 0x2e, 0x76, 0x31, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x65, 0x6d, 0x65, 0x4c, 0x61, 0x62, 0x73, 0x2f, 0x73, 0x74,
+	0x72, 0x69, 0x6d, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x76, 0x70,
+	0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x70, 0x6e, 0x76, 0x31, 0xba, 0x02, 0x03, 0x53, 0x56, 0x4e,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_vpn_v1_onion_proto_rawDescOnce sync.Once
+	file_vpn_v1_onion_proto_rawDescData = file_vpn_v1_onion_proto_rawDesc
+)
+
+func file_vpn_v1_onion_proto_rawDescGZIP() []byte {
+	file_vpn_v1_onion_proto_rawDescOnce.Do(func() {
+		file_vpn_v1_onion_proto_rawDescData = protoimpl.X.CompressGZIP(file_vpn_v1_onion_proto_rawDescData)
+	})
+	return file_vpn_v1_onion_proto_rawDescData
+}
+
+var file_vpn_v1_onion_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_vpn_v1_onion_proto_goTypes = []interface{}{
+	(*OnionMessage)(nil),        // 0: strims.vpn.v1.OnionMessage
+	(*OnionHeader)(nil),         // 1: strims.vpn.v1.OnionHeader
+	(*OnionReplyBlock)(nil),     // 2: strims.vpn.v1.OnionReplyBlock
+	(*OnionPayload)(nil),        // 3: strims.vpn.v1.OnionPayload
+	(*OnionHeader_Relay)(nil),   // 4: strims.vpn.v1.OnionHeader.Relay
+	(*OnionHeader_Deliver)(nil), // 5: strims.vpn.v1.OnionHeader.Deliver
+	(*OnionHeader_Receive)(nil), // 6: strims.vpn.v1.OnionHeader.Receive
+}
+var file_vpn_v1_onion_proto_depIdxs = []int32{
+	4, // 0: strims.vpn.v1.OnionHeader.relay:type_name -> strims.vpn.v1.OnionHeader.Relay
+	5, // 1: strims.vpn.v1.OnionHeader.deliver:type_name -> strims.vpn.v1.OnionHeader.Deliver
+	6, // 2: strims.vpn.v1.OnionHeader.receive:type_name -> strims.vpn.v1.OnionHeader.Receive
+	2, // 3: strims.vpn.v1.OnionHeader.Deliver.reply_block:type_name -> strims.vpn.v1.OnionReplyBlock
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_vpn_v1_onion_proto_init() }
+func file_vpn_v1_onion_proto_init() {
+	if File_vpn_v1_onion_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_vpn_v1_onion_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OnionMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vpn_v1_onion_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OnionHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vpn_v1_onion_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OnionReplyBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vpn_v1_onion_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OnionPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vpn_v1_onion_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OnionHeader_Relay); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vpn_v1_onion_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OnionHeader_Deliver); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vpn_v1_onion_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OnionHeader_Receive); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_vpn_v1_onion_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*OnionHeader_Relay_)(nil),
+		(*OnionHeader_Deliver_)(nil),
+		(*OnionHeader_Receive_)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_vpn_v1_onion_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_vpn_v1_onion_proto_goTypes,
+		DependencyIndexes: file_vpn_v1_onion_proto_depIdxs,
+		MessageInfos:      file_vpn_v1_onion_proto_msgTypes,
+	}.Build()
+	File_vpn_v1_onion_proto = out.File
+	file_vpn_v1_onion_proto_rawDesc = nil
+	file_vpn_v1_onion_proto_goTypes = nil
+	file_vpn_v1_onion_proto_depIdxs = nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package chunkstream
+
+import (
+	"io"
+
+	"github.com/MemeLabs/strims/pkg/ioutil"
+)
+
+// NewAlignedWriterSize returns a writer for streams like ppspp swarms with
+// variable chunk sizes that discard the rest of the current chunk when they
+// are flushed. Every input segment starts a new chunk.
+func NewAlignedWriterSize(w ioutil.WriteFlusher, size int) (*AlignedWriter, error) {
+	c, err := NewWriterSize(w, size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AlignedWriter{
+		Writer: *c,
+		w:      w,
+	}, nil
+}
+
+type AlignedWriter struct {
+	Writer
+	w ioutil.WriteFlusher
+}
+
+func (c *AlignedWriter) Flush() (err error) {
+	if err = c.Writer.Flush(); err != nil {
+		return err
+	}
+
+	c.off = headerLen
+	c.woff = 0
+	return c.w.Flush()
+}
+
+// NewAlignedReaderSize returns a reader for streams written by AlignedWriter.
+// r must start at the beginning of an input segment.
+func NewAlignedReaderSize(r io.Reader, size int) (*AlignedReader, error) {
+	c, err := NewReaderSize(r, 0, size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AlignedReader{
+		Reader: *c,
+	}, nil
+}
+
+type AlignedReader struct {
+	Reader
+}
+
+func (c *AlignedReader) Read(p []byte) (int, error) {
+	n, err := c.Reader.Read(p)
+	if err == io.EOF {
+		c.off = 0
+	}
+	return n, err
+}
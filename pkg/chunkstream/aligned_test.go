@@ -0,0 +1,64 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package chunkstream
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// flushRecorder records the offsets where the stream was flushed.
+type flushRecorder struct {
+	bytes.Buffer
+	flushes []int
+}
+
+func (w *flushRecorder) Flush() error {
+	w.flushes = append(w.flushes, w.Len())
+	return nil
+}
+
+func TestAlignedReader(t *testing.T) {
+	const size = 32
+	writes := []int{9, 250, 311, 100, 112}
+
+	var buf flushRecorder
+	w, err := NewAlignedWriterSize(&buf, size)
+	assert.NoError(t, err, "expected NewAlignedWriterSize to return nil error")
+
+	in := make([]byte, 1024)
+	for i := range in {
+		in[i] = byte(i%251 + 1)
+	}
+
+	for _, n := range writes {
+		_, err := w.Write(in[:n])
+		assert.NoError(t, err, "expected AlignedWriter.Write to return nil error")
+		err = w.Flush()
+		assert.NoError(t, err, "expected AlignedWriter.Flush to return nil error")
+	}
+	assert.Equal(t, len(writes), len(buf.flushes), "expected every flush to reach the underlying writer")
+
+	// readers can start at any input segment
+	for i := range writes {
+		off := 0
+		if i > 0 {
+			off = buf.flushes[i-1]
+		}
+
+		r, err := NewAlignedReaderSize(bytes.NewReader(buf.Bytes()[off:]), size)
+		assert.NoError(t, err, "expected NewAlignedReaderSize to return nil error")
+
+		out := make([]byte, 1024)
+		for _, n := range writes[i:] {
+			nn, err := io.ReadAtLeast(r, out, n)
+			assert.NoError(t, err, "expected AlignedReader.Read to return nil error")
+			assert.Equal(t, n, nn, "byte read count mismatch")
+			assert.Equal(t, in[:n], out[:nn], "read data mismatch")
+		}
+	}
+}
@@ -175,7 +175,9 @@ func (l *Map[K, V]) swap(k K, v V) (e *mapItem[K, V], p V) {
 		return
 	}
 
+	// alloc may resize the table so the index has to be recomputed
 	e = l.alloc()
+	i = l.index(k)
 	e.k = k
 	e.v = v
 	e.list = l.v[i]
@@ -26,6 +26,19 @@ func TestSetGetDelete(t *testing.T) {
 	assert.False(t, ok)
 }
 
+func TestSetResize(t *testing.T) {
+	m := New[uint64, uint64](NewUint64Interface[uint64]())
+	for i := uint64(0); i < 1000; i++ {
+		m.Set(i, i)
+	}
+
+	for i := uint64(0); i < 1000; i++ {
+		v, ok := m.Get(i)
+		assert.True(t, ok, "missing key %d", i)
+		assert.Equal(t, i, v)
+	}
+}
+
 func TestIterate(t *testing.T) {
 	n := 500
 	m := New[[]byte, int](NewByteInterface[[]byte]())
@@ -6,6 +6,7 @@ package httputil
 import (
 	"errors"
 	"io"
+	"net"
 	"sync"
 	"time"
 
@@ -73,6 +74,11 @@ func (w *WSReadWriter) MTU() int {
 	return wsMTU
 }
 
+// RemoteAddr ...
+func (w *WSReadWriter) RemoteAddr() net.Addr {
+	return w.conn.RemoteAddr()
+}
+
 // Read ...
 func (w *WSReadWriter) Read(b []byte) (n int, err error) {
 	if w.reader == nil {
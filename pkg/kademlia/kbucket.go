@@ -5,6 +5,7 @@ package kademlia
 
 import (
 	"math/bits"
+	"net/netip"
 	"sort"
 	"sync"
 )
@@ -19,18 +20,83 @@ type Evictable interface {
 	Evict()
 }
 
+// Addressable is implemented by entries with a known network address. The
+// address is used by admission policies to limit the number of entries an
+// operator can insert from a single network.
+type Addressable interface {
+	Addr() netip.Addr
+}
+
+// Verifiable is implemented by entries whose ids may not have been proven.
+// Unverified entries never displace verified entries from full buckets.
+type Verifiable interface {
+	Verified() bool
+}
+
+func isVerified(n Interface) bool {
+	v, ok := n.(Verifiable)
+	return !ok || v.Verified()
+}
+
+// IPPrefixLimit limits the number of entries from the same ip prefix in a
+// single bucket and in the whole table. Loopback and private addresses are
+// exempt.
+type IPPrefixLimit struct {
+	IPv4PrefixLen int
+	IPv6PrefixLen int
+	BucketLimit   int
+	TableLimit    int
+}
+
+// DefaultIPPrefixLimit ...
+var DefaultIPPrefixLimit = IPPrefixLimit{
+	IPv4PrefixLen: 24,
+	IPv6PrefixLen: 48,
+	BucketLimit:   2,
+	TableLimit:    10,
+}
+
+func (l *IPPrefixLimit) prefix(n Interface) (netip.Prefix, bool) {
+	a, ok := n.(Addressable)
+	if !ok {
+		return netip.Prefix{}, false
+	}
+	addr := a.Addr().Unmap()
+	if !addr.IsValid() || addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() {
+		return netip.Prefix{}, false
+	}
+
+	bits := l.IPv6PrefixLen
+	if addr.Is4() {
+		bits = l.IPv4PrefixLen
+	}
+	p, err := addr.Prefix(bits)
+	return p, err == nil
+}
+
+// KBucketOption ...
+type KBucketOption func(k *KBucket)
+
+// WithIPPrefixLimit ...
+func WithIPPrefixLimit(l IPPrefixLimit) KBucketOption {
+	return func(k *KBucket) {
+		k.prefixLimit = &l
+	}
+}
+
 type bucket []Interface
 
 // KBucket ...
 type KBucket struct {
-	k  int
-	id ID
-	b  []bucket
-	l  int
+	k           int
+	id          ID
+	b           []bucket
+	l           int
+	prefixLimit *IPPrefixLimit
 }
 
 // NewKBucket ...
-func NewKBucket(id ID, k int) *KBucket {
+func NewKBucket(id ID, k int, opts ...KBucketOption) *KBucket {
 	b := make([]bucket, idBitLength)
 	is := make([]Interface, k*idBitLength)
 	for i := 0; i < idBitLength; i++ {
@@ -42,6 +108,9 @@ func NewKBucket(id ID, k int) *KBucket {
 		id: id,
 		b:  b,
 	}
+	for _, o := range opts {
+		o(v)
+	}
 	return v
 }
 
@@ -80,18 +149,13 @@ func (k *KBucket) Insert(n Interface) bool {
 		}
 	}
 
+	if !k.admit(i, n) {
+		return false
+	}
+
 	l := len(k.b[i])
 	if l == k.k {
-		maxDistance := k.id.XOr(n.ID())
-		maxIndex := -1
-		for j, n := range k.b[i] {
-			distance := k.id.XOr(n.ID())
-			if maxDistance.Less(distance) {
-				maxDistance = distance
-				maxIndex = j
-			}
-		}
-
+		maxIndex := k.evictionIndex(i, n)
 		if maxIndex == -1 {
 			return false
 		}
@@ -108,6 +172,67 @@ func (k *KBucket) Insert(n Interface) bool {
 	return true
 }
 
+// evictionIndex returns the index of the entry n replaces in the full bucket i
+// or -1 if n should not be inserted. verified entries displace the farthest
+// unverified entry. otherwise n replaces the farthest entry farther than
+// itself and unverified entries only replace other unverified entries.
+func (k *KBucket) evictionIndex(i int, n Interface) int {
+	nv := isVerified(n)
+
+	if nv {
+		var maxDistance ID
+		maxIndex := -1
+		for j, e := range k.b[i] {
+			if distance := k.id.XOr(e.ID()); !isVerified(e) && (maxIndex == -1 || maxDistance.Less(distance)) {
+				maxDistance = distance
+				maxIndex = j
+			}
+		}
+		if maxIndex != -1 {
+			return maxIndex
+		}
+	}
+
+	maxDistance := k.id.XOr(n.ID())
+	maxIndex := -1
+	for j, e := range k.b[i] {
+		if distance := k.id.XOr(e.ID()); (nv || !isVerified(e)) && maxDistance.Less(distance) {
+			maxDistance = distance
+			maxIndex = j
+		}
+	}
+	return maxIndex
+}
+
+// Admits returns false if n is rejected by the bucket's admission policy.
+// Insert may still fail if n's bucket is full of closer entries.
+func (k *KBucket) Admits(n Interface) bool {
+	return k.admit(k.idBucket(n.ID()), n)
+}
+
+func (k *KBucket) admit(i int, n Interface) bool {
+	if k.prefixLimit == nil {
+		return true
+	}
+	p, ok := k.prefixLimit.prefix(n)
+	if !ok {
+		return true
+	}
+
+	var bucketCount, tableCount int
+	for j, b := range k.b {
+		for _, e := range b {
+			if ep, ok := k.prefixLimit.prefix(e); ok && ep == p {
+				tableCount++
+				if j == i {
+					bucketCount++
+				}
+			}
+		}
+	}
+	return bucketCount < k.prefixLimit.BucketLimit && tableCount < k.prefixLimit.TableLimit
+}
+
 // Remove ...
 func (k *KBucket) Remove(id ID) bool {
 	i := k.idBucket(id)
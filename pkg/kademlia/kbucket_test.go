@@ -4,9 +4,11 @@
 package kademlia
 
 import (
+	"net/netip"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type node ID
@@ -32,6 +34,85 @@ func TestKBucket(t *testing.T) {
 	}
 }
 
+type addrNode struct {
+	id   ID
+	addr netip.Addr
+}
+
+func (n addrNode) ID() ID {
+	return n.id
+}
+
+func (n addrNode) Addr() netip.Addr {
+	return n.addr
+}
+
+func TestKBucketIPPrefixLimit(t *testing.T) {
+	b := NewKBucket(MaxID, 20, WithIPPrefixLimit(DefaultIPPrefixLimit))
+
+	var n int
+	for i := 0; i < 100; i++ {
+		id, _ := NewID()
+		if b.Insert(addrNode{id, netip.AddrFrom4([4]byte{203, 0, 113, byte(i)})}) {
+			n++
+		}
+	}
+	assert.Equal(t, DefaultIPPrefixLimit.TableLimit, n)
+	id, _ := NewID()
+	assert.False(t, b.Admits(addrNode{id, netip.MustParseAddr("203.0.113.200")}), "full prefixes should not be admitted")
+
+	id, _ = NewID()
+	assert.True(t, b.Insert(addrNode{id, netip.MustParseAddr("198.51.100.1")}), "other prefixes should be admitted")
+	id, _ = NewID()
+	assert.True(t, b.Insert(addrNode{id, netip.MustParseAddr("127.0.0.1")}), "loopback addresses should be exempt")
+	id, _ = NewID()
+	assert.True(t, b.Insert(node(id)), "entries without addresses should be admitted")
+}
+
+func TestKBucketAdmitsFullBucket(t *testing.T) {
+	b := NewKBucket(MaxID, 1, WithIPPrefixLimit(DefaultIPPrefixLimit))
+
+	near := MaxID
+	near[3] -= 2
+	require.True(t, b.Insert(node(near)))
+
+	far := MaxID
+	far[3] -= 3
+	assert.True(t, b.Admits(node(far)), "entries should be admitted when their bucket is full")
+	assert.False(t, b.Insert(node(far)), "entries farther than a full bucket's entries should not be inserted")
+}
+
+type verifiableNode struct {
+	id       ID
+	verified bool
+}
+
+func (n verifiableNode) ID() ID {
+	return n.id
+}
+
+func (n verifiableNode) Verified() bool {
+	return n.verified
+}
+
+func TestKBucketPrefersVerified(t *testing.T) {
+	b := NewKBucket(MaxID, 1)
+
+	near := MaxID
+	near[3] -= 2
+	far := MaxID
+	far[3] -= 3
+
+	require.True(t, b.Insert(verifiableNode{far, false}))
+	assert.True(t, b.Insert(verifiableNode{near, false}), "unverified entries should displace farther unverified entries")
+
+	b = NewKBucket(MaxID, 1)
+	require.True(t, b.Insert(verifiableNode{near, false}))
+	assert.True(t, b.Insert(verifiableNode{far, true}), "verified entries should displace closer unverified entries")
+	assert.False(t, b.Insert(verifiableNode{near, false}), "unverified entries should not displace verified entries")
+	assert.Equal(t, far, b.Slice()[0].ID())
+}
+
 func BenchmarkKBucket(b *testing.B) {
 	id := ID{0xffffffffffffffff, 0xffffffffffffffff, 0xffffffffffffffff, 0xffffffffffffffff}
 	k := NewKBucket(id, 20)
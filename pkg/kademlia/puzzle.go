@@ -0,0 +1,51 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package kademlia
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/bits"
+)
+
+// SolvePuzzle returns the first nonce for which the sha256 hash of the id and
+// nonce has at least difficulty leading zero bits. Binding ids to puzzle
+// solutions makes generating large numbers of ids expensive.
+func SolvePuzzle(id ID, difficulty int) uint64 {
+	for nonce := uint64(0); ; nonce++ {
+		if VerifyPuzzle(id, nonce, difficulty) {
+			return nonce
+		}
+	}
+}
+
+// VerifyPuzzle ...
+func VerifyPuzzle(id ID, nonce uint64, difficulty int) bool {
+	var b [IDLength + 8]byte
+	id.Marshal(b[:])
+	binary.BigEndian.PutUint64(b[IDLength:], nonce)
+	return puzzleLeadingZeros(sha256.Sum256(b[:])) >= difficulty
+}
+
+// VerifyStaticPuzzle returns true if the double sha256 hash of id has at
+// least difficulty leading zero bits. Ids are derived from keys so solving the
+// puzzle requires generating keys until one passes. Unlike the nonce puzzle it
+// can be checked for ids learned from other hosts without additional proof.
+func VerifyStaticPuzzle(id ID, difficulty int) bool {
+	var b [IDLength]byte
+	id.Marshal(b[:])
+	h := sha256.Sum256(b[:])
+	return puzzleLeadingZeros(sha256.Sum256(h[:])) >= difficulty
+}
+
+func puzzleLeadingZeros(h [sha256.Size]byte) int {
+	var n int
+	for _, v := range h {
+		if v != 0 {
+			return n + bits.LeadingZeros8(v)
+		}
+		n += 8
+	}
+	return n
+}
@@ -0,0 +1,45 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package kademlia
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPuzzle(t *testing.T) {
+	id, err := NewID()
+	assert.NoError(t, err)
+
+	nonce := SolvePuzzle(id, 12)
+	assert.True(t, VerifyPuzzle(id, nonce, 12))
+
+	other, err := NewID()
+	assert.NoError(t, err)
+	assert.False(t, VerifyPuzzle(other, nonce, 32), "nonce should not transfer between ids")
+}
+
+func TestStaticPuzzle(t *testing.T) {
+	var id ID
+	var err error
+	for {
+		id, err = NewID()
+		assert.NoError(t, err)
+		if VerifyStaticPuzzle(id, 4) {
+			break
+		}
+	}
+
+	var n int
+	for i := 0; i < 64; i++ {
+		other, err := NewID()
+		assert.NoError(t, err)
+		if VerifyStaticPuzzle(other, 16) {
+			n++
+		}
+	}
+	assert.Less(t, n, 2, "random ids should rarely pass the puzzle")
+	assert.True(t, VerifyStaticPuzzle(id, 0))
+}
@@ -0,0 +1,252 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+//go:build !js
+
+// Package mdns implements a minimal multicast DNS service discovery responder
+// and browser. Instances are advertised with PTR and TXT records only. Clients
+// are expected to carry everything they need to connect in the TXT record and
+// use the source address of the response to reach the instance.
+package mdns
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/MemeLabs/strims/pkg/timeutil"
+	"go.uber.org/zap"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+const (
+	maxPacketSize = 9000
+	recordTTL     = 120
+)
+
+var groupAddr = &net.UDPAddr{IP: net.IPv4(224, 0, 0, 251), Port: 5353}
+
+// Service describes a local service instance.
+type Service struct {
+	// Type is the service type, for example "_strims._tcp".
+	Type string
+	// Instance is the instance label. It must be a valid dns label.
+	Instance string
+	// TXT returns the current TXT record strings for the instance.
+	TXT func() []string
+}
+
+// Entry is an instance discovered on the local network.
+type Entry struct {
+	Instance string
+	Addr     netip.Addr
+	TXT      []string
+}
+
+// Listen joins the mdns multicast group.
+func Listen(logger *zap.Logger) (*Conn, error) {
+	conn, err := net.ListenMulticastUDP("udp4", nil, groupAddr)
+	if err != nil {
+		return nil, err
+	}
+	return &Conn{
+		logger: logger,
+		conn:   conn,
+	}, nil
+}
+
+// Conn ...
+type Conn struct {
+	logger *zap.Logger
+	conn   *net.UDPConn
+}
+
+// Close ...
+func (c *Conn) Close() error {
+	return c.conn.Close()
+}
+
+// Serve answers queries for s and announces it every ivl. fn is called with
+// the instances of the same service type announced by other hosts. Serve
+// returns when ctx is canceled or the connection is closed.
+func (c *Conn) Serve(ctx context.Context, s Service, ivl time.Duration, fn func(Entry)) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		<-ctx.Done()
+		c.conn.Close()
+	}()
+
+	go func() {
+		t := timeutil.DefaultTickEmitter.Ticker(ivl)
+		defer t.Stop()
+
+		for {
+			c.send(appendQuery, s)
+			c.send(appendResponse, s)
+
+			select {
+			case <-t.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	b := make([]byte, maxPacketSize)
+	for {
+		n, src, err := c.conn.ReadFromUDPAddrPort(b)
+		if err != nil {
+			if ctx.Err() != nil || errors.Is(err, net.ErrClosed) {
+				return ctx.Err()
+			}
+			return err
+		}
+
+		query, entries, err := parseMessage(b[:n], s.Type)
+		if err != nil {
+			c.logger.Debug("failed to parse mdns message", zap.Error(err))
+			continue
+		}
+		if query {
+			c.send(appendResponse, s)
+		}
+		for _, e := range entries {
+			if e.Instance != s.Instance {
+				e.Addr = src.Addr().Unmap()
+				fn(e)
+			}
+		}
+	}
+}
+
+func (c *Conn) send(appendMessage func([]byte, Service) ([]byte, error), s Service) {
+	b, err := appendMessage(make([]byte, 0, 512), s)
+	if err != nil {
+		c.logger.Debug("failed to build mdns message", zap.Error(err))
+		return
+	}
+	if _, err := c.conn.WriteToUDP(b, groupAddr); err != nil {
+		c.logger.Debug("failed to write mdns message", zap.Error(err))
+	}
+}
+
+func serviceName(typ string) string {
+	return typ + ".local."
+}
+
+func instanceName(s Service) string {
+	return s.Instance + "." + serviceName(s.Type)
+}
+
+func appendQuery(b []byte, s Service) ([]byte, error) {
+	name, err := dnsmessage.NewName(serviceName(s.Type))
+	if err != nil {
+		return nil, err
+	}
+
+	m := dnsmessage.NewBuilder(b, dnsmessage.Header{})
+	if err := m.StartQuestions(); err != nil {
+		return nil, err
+	}
+	q := dnsmessage.Question{
+		Name:  name,
+		Type:  dnsmessage.TypePTR,
+		Class: dnsmessage.ClassINET,
+	}
+	if err := m.Question(q); err != nil {
+		return nil, err
+	}
+	return m.Finish()
+}
+
+func appendResponse(b []byte, s Service) ([]byte, error) {
+	name, err := dnsmessage.NewName(serviceName(s.Type))
+	if err != nil {
+		return nil, err
+	}
+	instance, err := dnsmessage.NewName(instanceName(s))
+	if err != nil {
+		return nil, err
+	}
+
+	m := dnsmessage.NewBuilder(b, dnsmessage.Header{Response: true, Authoritative: true})
+	if err := m.StartAnswers(); err != nil {
+		return nil, err
+	}
+	ptr := dnsmessage.ResourceHeader{
+		Name:  name,
+		Class: dnsmessage.ClassINET,
+		TTL:   recordTTL,
+	}
+	if err := m.PTRResource(ptr, dnsmessage.PTRResource{PTR: instance}); err != nil {
+		return nil, err
+	}
+	txt := dnsmessage.ResourceHeader{
+		Name:  instance,
+		Class: dnsmessage.ClassINET,
+		TTL:   recordTTL,
+	}
+	if err := m.TXTResource(txt, dnsmessage.TXTResource{TXT: s.TXT()}); err != nil {
+		return nil, err
+	}
+	return m.Finish()
+}
+
+// parseMessage returns whether b contains a query for typ and the TXT records
+// of any typ instances it contains.
+func parseMessage(b []byte, typ string) (query bool, entries []Entry, err error) {
+	var p dnsmessage.Parser
+	h, err := p.Start(b)
+	if err != nil {
+		return false, nil, err
+	}
+
+	name := serviceName(typ)
+	suffix := "." + name
+
+	qs, err := p.AllQuestions()
+	if err != nil {
+		return false, nil, err
+	}
+	if !h.Response {
+		for _, q := range qs {
+			if q.Type == dnsmessage.TypePTR && strings.EqualFold(q.Name.String(), name) {
+				query = true
+			}
+		}
+		return query, nil, nil
+	}
+
+	for {
+		rh, err := p.AnswerHeader()
+		if err == dnsmessage.ErrSectionDone {
+			break
+		}
+		if err != nil {
+			return false, nil, err
+		}
+
+		rn := rh.Name.String()
+		if rh.Type != dnsmessage.TypeTXT || !strings.HasSuffix(strings.ToLower(rn), suffix) {
+			if err := p.SkipAnswer(); err != nil {
+				return false, nil, err
+			}
+			continue
+		}
+
+		r, err := p.TXTResource()
+		if err != nil {
+			return false, nil, err
+		}
+		entries = append(entries, Entry{
+			Instance: rn[:len(rn)-len(suffix)],
+			TXT:      r.TXT,
+		})
+	}
+	return false, entries, nil
+}
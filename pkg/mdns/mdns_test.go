@@ -0,0 +1,43 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+//go:build !js
+
+package mdns
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessage(t *testing.T) {
+	s := Service{
+		Type:     "_test._tcp",
+		Instance: "abc123",
+		TXT: func() []string {
+			return []string{"id=abc123", "uri=tcp://0.0.0.0:8083/abc"}
+		},
+	}
+
+	b, err := appendQuery(nil, s)
+	assert.NoError(t, err)
+	query, entries, err := parseMessage(b, s.Type)
+	assert.NoError(t, err)
+	assert.True(t, query)
+	assert.Empty(t, entries)
+
+	query, _, err = parseMessage(b, "_other._tcp")
+	assert.NoError(t, err)
+	assert.False(t, query, "queries for other services should be ignored")
+
+	b, err = appendResponse(nil, s)
+	assert.NoError(t, err)
+	query, entries, err = parseMessage(b, s.Type)
+	assert.NoError(t, err)
+	assert.False(t, query)
+	if assert.Len(t, entries, 1) {
+		assert.Equal(t, s.Instance, entries[0].Instance)
+		assert.Equal(t, s.TXT(), entries[0].TXT)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"sync"
 
+	"github.com/MemeLabs/strims/pkg/binmap"
 	"github.com/MemeLabs/strims/pkg/errutil"
 	"github.com/MemeLabs/strims/pkg/ioutil"
 	"github.com/MemeLabs/strims/pkg/ppspp/codec"
@@ -45,6 +46,9 @@ var (
 	errIncompatibleContentIntegrityProtectionMethod = errors.New("incompatible ContentIntegrityProtectionMethod")
 	errIncompatibleMerkleHashTreeFunction           = errors.New("incompatible MerkleHashTreeFunction")
 	errIncompatibleLiveSignatureAlgorithm           = errors.New("incompatible LiveSignatureAlgorithm")
+	errIncompatibleChunkAddressingMethod            = errors.New("incompatible ChunkAddressingMethod")
+	errIncompatibleVariableChunkSize                = errors.New("incompatible VariableChunkSize")
+	errIncompatibleContentEncryptionMethod          = errors.New("incompatible ContentEncryptionMethod")
 )
 
 func newHandshake(swarm *Swarm) *codec.Handshake {
@@ -59,6 +63,17 @@ func newHandshake(swarm *Swarm) *codec.Handshake {
 		&codec.LiveSignatureAlgorithmProtocolOption{Value: uint8(swarm.options.Integrity.LiveSignatureAlgorithm)},
 		&codec.ChunksPerSignatureProtocolOption{Value: uint32(swarm.options.ChunksPerSignature)},
 		&codec.StreamCountProtocolOption{Value: uint16(swarm.options.StreamCount)},
+		&codec.PeerExchangeProtocolOption{},
+	}
+
+	if swarm.options.ChunkAddressingMethod != codec.BinChunkAddressingMethod {
+		o = append(o, &codec.ChunkAddressingMethodProtocolOption{Value: uint8(swarm.options.ChunkAddressingMethod)})
+	}
+	if swarm.options.VariableChunkSize {
+		o = append(o, &codec.VariableChunkSizeProtocolOption{})
+	}
+	if swarm.options.ContentEncryptionMethod != NoneContentEncryptionMethod {
+		o = append(o, &codec.ContentEncryptionMethodProtocolOption{Value: uint8(swarm.options.ContentEncryptionMethod)})
 	}
 
 	if e := codec.NewEpochProtocolOption(swarm.epoch.Value()); e != nil {
@@ -68,13 +83,16 @@ func newHandshake(swarm *Swarm) *codec.Handshake {
 	return &codec.Handshake{Options: o}
 }
 
-func newChannelWriter(metrics channelWriterMetrics, w ioutil.BufferedWriteFlusher, channel codec.Channel) *channelWriter {
+func newChannelWriter(metrics channelWriterMetrics, w ioutil.BufferedWriteFlusher, channel codec.Channel, swarm *Swarm) *channelWriter {
 	head := codec.ChannelHeader{Channel: channel}
 	bw := ioutil.NewPrefixBufferWriter(w, head.ByteLen())
+	cw := codec.NewWriter(bw)
+	cw.ChunkAddressingMethod = swarm.options.ChunkAddressingMethod
+	cw.VariableChunkSize = swarm.options.VariableChunkSize
 	return &channelWriter{
 		head:    head,
 		bw:      bw,
-		cw:      codec.NewWriter(bw),
+		cw:      cw,
 		metrics: metrics,
 	}
 }
@@ -278,6 +296,24 @@ func (c *channelWriter) WriteStreamClose(m codec.StreamClose) (int, error) {
 	return n, err
 }
 
+func (c *channelWriter) WritePexRequest(m codec.PexRequest) (int, error) {
+	n, err := c.cw.WritePexRequest(m)
+	if err == nil {
+		c.metrics.PexRequestCount++
+		c.metrics.OverheadBytesCount += n
+	}
+	return n, err
+}
+
+func (c *channelWriter) WritePexResponse(m codec.PexResponse) (int, error) {
+	n, err := c.cw.WritePexResponse(m)
+	if err == nil {
+		c.metrics.PexResponseCount++
+		c.metrics.OverheadBytesCount += n
+	}
+	return n, err
+}
+
 func newChannelReader(logger *zap.Logger) *ChannelReader {
 	return &ChannelReader{
 		logger:   logger,
@@ -288,11 +324,12 @@ func newChannelReader(logger *zap.Logger) *ChannelReader {
 
 // ChannelReader ...
 type ChannelReader struct {
-	logger   *zap.Logger
-	lock     sync.Mutex
-	v        uint64
-	touched  []*channelReaderChannel
-	channels map[codec.Channel]*channelReaderChannel
+	logger            *zap.Logger
+	lock              sync.Mutex
+	v                 uint64
+	touched           []*channelReaderChannel
+	channels          map[codec.Channel]*channelReaderChannel
+	allowMissingEpoch bool
 }
 
 type channelReaderChannel struct {
@@ -301,6 +338,15 @@ type channelReaderChannel struct {
 	r         codec.Reader
 }
 
+// AllowMissingEpoch accepts handshakes without epochs from peers that don't
+// track them (ex. rfc 7574 peers) while the swarm has no epoch for them to
+// disagree with.
+func (c *ChannelReader) AllowMissingEpoch() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.allowMissingEpoch = true
+}
+
 func (c *ChannelReader) openChannel(channel codec.Channel, metrics channelReaderMetrics, scheduler channelScheduler, swarm *Swarm) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -311,12 +357,15 @@ func (c *ChannelReader) openChannel(channel codec.Channel, metrics channelReader
 			ChunkSize:              swarm.options.ChunkSize,
 			IntegrityHashSize:      swarm.options.Integrity.MerkleHashTreeFunction.HashSize(),
 			IntegritySignatureSize: swarm.options.Integrity.LiveSignatureAlgorithm.SignatureSize(),
+			ChunkAddressingMethod:  swarm.options.ChunkAddressingMethod,
+			VariableChunkSize:      swarm.options.VariableChunkSize,
 			Handler: &channelMessageHandler{
-				logger:    c.logger.With(zap.Stringer("swarm", swarm.id)),
-				swarm:     swarm,
-				scheduler: scheduler,
-				metrics:   metrics,
-				verifier:  swarm.verifier.ChannelVerifier(),
+				logger:            c.logger.With(zap.Stringer("swarm", swarm.id)),
+				swarm:             swarm,
+				scheduler:         scheduler,
+				metrics:           metrics,
+				verifier:          swarm.channelVerifier(),
+				allowMissingEpoch: c.allowMissingEpoch,
 			},
 		},
 	}
@@ -374,11 +423,13 @@ func (c *ChannelReader) HandleMessage(b []byte) (err error) {
 }
 
 type channelMessageHandler struct {
-	logger    *zap.Logger
-	swarm     *Swarm
-	scheduler channelScheduler
-	metrics   channelReaderMetrics
-	verifier  integrity.ChannelVerifier
+	logger            *zap.Logger
+	swarm             *Swarm
+	scheduler         channelScheduler
+	metrics           channelReaderMetrics
+	verifier          integrity.ChannelVerifier
+	padBuf            []byte
+	allowMissingEpoch bool
 }
 
 func (c *channelMessageHandler) HandleHandshake(m codec.Handshake) error {
@@ -465,6 +516,26 @@ func (c *channelMessageHandler) HandleHandshake(m codec.Handshake) error {
 		return errNoLiveSignatureAlgorithm
 	}
 
+	chunkAddressingMethod := codec.BinChunkAddressingMethod
+	if method, ok := m.Options.Find(codec.ChunkAddressingMethodOption); ok {
+		chunkAddressingMethod = codec.ChunkAddressingMethod(method.(*codec.ChunkAddressingMethodProtocolOption).Value)
+	}
+	if chunkAddressingMethod != c.swarm.options.ChunkAddressingMethod {
+		return errIncompatibleChunkAddressingMethod
+	}
+
+	if _, ok := m.Options.Find(codec.VariableChunkSizeOption); ok != c.swarm.options.VariableChunkSize {
+		return errIncompatibleVariableChunkSize
+	}
+
+	contentEncryptionMethod := NoneContentEncryptionMethod
+	if method, ok := m.Options.Find(codec.ContentEncryptionMethodOption); ok {
+		contentEncryptionMethod = ContentEncryptionMethod(method.(*codec.ContentEncryptionMethodProtocolOption).Value)
+	}
+	if contentEncryptionMethod != c.swarm.options.ContentEncryptionMethod {
+		return errIncompatibleContentEncryptionMethod
+	}
+
 	if epoch, ok := m.Options.Find(codec.EpochOption); ok {
 		e := epoch.(*codec.EpochProtocolOption)
 		if ok, err := c.swarm.epoch.Sync(e.Timestamp.Time, e.Signature); ok {
@@ -477,12 +548,13 @@ func (c *channelMessageHandler) HandleHandshake(m codec.Handshake) error {
 		} else if err != nil {
 			return err
 		}
-	} else {
+	} else if t, _ := c.swarm.epoch.Value(); !c.allowMissingEpoch || !t.IsNil() {
 		return nil
 	}
 
 	liveWindow := m.Options.MustFind(codec.LiveWindowOption).(*codec.LiveWindowProtocolOption).Value
-	return c.scheduler.HandleHandshake(liveWindow)
+	_, peerExchange := m.Options.Find(codec.PeerExchangeOption)
+	return c.scheduler.HandleHandshake(liveWindow, peerExchange)
 }
 
 func (c *channelMessageHandler) HandleRestart(m codec.Restart) error {
@@ -502,6 +574,10 @@ func (c *channelMessageHandler) HandleData(m codec.Data) error {
 		return nil
 	}
 
+	if c.swarm.options.VariableChunkSize {
+		m.Data = c.padData(m.Address.Bin(), m.Data)
+	}
+
 	verified, err := c.verifier.ChunkVerifier(m.Address.Bin()).Verify(m.Address.Bin(), m.Data)
 	if !verified {
 		c.metrics.InvalidDataCount.Inc()
@@ -518,12 +594,32 @@ func (c *channelMessageHandler) HandleData(m codec.Data) error {
 	}
 
 	c.swarm.pubSub.Publish(store.Chunk{
-		Bin:  m.Address.Bin(),
-		Data: m.Data,
+		Bin:     m.Address.Bin(),
+		Data:    m.Data,
+		Lengths: m.Lengths,
 	})
 	return c.scheduler.HandleData(m.Address.Bin(), m.Timestamp.Time, true)
 }
 
+// padData restores the zero padding omitted from the end of variable size
+// chunks by the sender.
+func (c *channelMessageHandler) padData(b binmap.Bin, d codec.Buffer) codec.Buffer {
+	n := int(b.BaseLength()) * c.swarm.options.ChunkSize
+	if len(d) >= n {
+		return d
+	}
+
+	if cap(c.padBuf) < n {
+		c.padBuf = make([]byte, n)
+	}
+	buf := c.padBuf[:n]
+	m := copy(buf, d)
+	for i := m; i < n; i++ {
+		buf[i] = 0
+	}
+	return buf
+}
+
 func (c *channelMessageHandler) HandleIntegrity(m codec.Integrity) error {
 	c.metrics.IntegrityCount.Inc()
 	c.metrics.OverheadBytesCount.Add(float64(m.ByteLen()))
@@ -616,6 +712,18 @@ func (c *channelMessageHandler) HandleStreamClose(m codec.StreamClose) error {
 	return c.scheduler.HandleStreamClose(m.Stream)
 }
 
+func (c *channelMessageHandler) HandlePexRequest(m codec.PexRequest) error {
+	c.metrics.PexRequestCount.Inc()
+	c.metrics.OverheadBytesCount.Add(float64(m.ByteLen()))
+	return c.scheduler.HandlePexRequest()
+}
+
+func (c *channelMessageHandler) HandlePexResponse(m codec.PexResponse) error {
+	c.metrics.PexResponseCount.Inc()
+	c.metrics.OverheadBytesCount.Add(float64(m.ByteLen()))
+	return c.scheduler.HandlePexResponse(m.PeerID)
+}
+
 func newChannelReaderMetrics(s *Swarm, p *peer, pm *peerChannelMetrics) channelReaderMetrics {
 	peerID := hex.EncodeToString(p.id)
 	swarmID := s.id.String()
@@ -674,6 +782,8 @@ type channelWriterMetrics struct {
 	StreamCancelCount    int
 	StreamOpenCount      int
 	StreamCloseCount     int
+	PexRequestCount      int
+	PexResponseCount     int
 	DataBytesCount       int
 	OverheadBytesCount   int
 	m                    channelMetrics
@@ -698,6 +808,8 @@ func (m *channelWriterMetrics) Merge() {
 	m.m.StreamCancelCount.Add(float64(m.StreamCancelCount))
 	m.m.StreamOpenCount.Add(float64(m.StreamOpenCount))
 	m.m.StreamCloseCount.Add(float64(m.StreamCloseCount))
+	m.m.PexRequestCount.Add(float64(m.PexRequestCount))
+	m.m.PexResponseCount.Add(float64(m.PexResponseCount))
 	m.m.AddDataBytesCount(m.DataBytesCount)
 	m.m.OverheadBytesCount.Add(float64(m.OverheadBytesCount))
 }
@@ -721,6 +833,8 @@ func (m *channelWriterMetrics) Reset() {
 	m.StreamCancelCount = 0
 	m.StreamOpenCount = 0
 	m.StreamCloseCount = 0
+	m.PexRequestCount = 0
+	m.PexResponseCount = 0
 	m.DataBytesCount = 0
 	m.OverheadBytesCount = 0
 }
@@ -753,6 +867,8 @@ func newChannelMetrics(s *Swarm, p *peer, direction string, pm *peerChannelMetri
 		StreamCancelCount:    channelMessageCount.WithLabelValues(swarmID, label, peerID, direction, "stream_cancel_message"),
 		StreamOpenCount:      channelMessageCount.WithLabelValues(swarmID, label, peerID, direction, "stream_open_message"),
 		StreamCloseCount:     channelMessageCount.WithLabelValues(swarmID, label, peerID, direction, "stream_close_message"),
+		PexRequestCount:      channelMessageCount.WithLabelValues(swarmID, label, peerID, direction, "pex_request_message"),
+		PexResponseCount:     channelMessageCount.WithLabelValues(swarmID, label, peerID, direction, "pex_response_message"),
 		DataBytesCount:       channelMessageCount.WithLabelValues(swarmID, label, peerID, direction, "data_bytes"),
 		OverheadBytesCount:   channelMessageCount.WithLabelValues(swarmID, label, peerID, direction, "overhead_bytes"),
 		pm:                   pm,
@@ -778,6 +894,8 @@ type channelMetrics struct {
 	StreamCancelCount    prometheus.Counter
 	StreamOpenCount      prometheus.Counter
 	StreamCloseCount     prometheus.Counter
+	PexRequestCount      prometheus.Counter
+	PexResponseCount     prometheus.Counter
 	DataBytesCount       prometheus.Counter
 	OverheadBytesCount   prometheus.Counter
 	pm                   *peerChannelMetrics
@@ -811,6 +929,8 @@ func deleteChannelMetrics(s *Swarm, p *peer, direction string) {
 	channelMessageCount.DeleteLabelValues(swarmID, label, peerID, direction, "stream_cancel_message")
 	channelMessageCount.DeleteLabelValues(swarmID, label, peerID, direction, "stream_open_message")
 	channelMessageCount.DeleteLabelValues(swarmID, label, peerID, direction, "stream_close_message")
+	channelMessageCount.DeleteLabelValues(swarmID, label, peerID, direction, "pex_request_message")
+	channelMessageCount.DeleteLabelValues(swarmID, label, peerID, direction, "pex_response_message")
 	channelMessageCount.DeleteLabelValues(swarmID, label, peerID, direction, "data_bytes")
 	channelMessageCount.DeleteLabelValues(swarmID, label, peerID, direction, "overhead_bytes")
 }
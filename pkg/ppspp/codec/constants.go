@@ -45,6 +45,10 @@ func (m MessageType) String() string {
 		return "StreamOpen"
 	case StreamCloseMessage:
 		return "StreamClose"
+	case PexRequestMessage:
+		return "PexRequest"
+	case PexResponseMessage:
+		return "PexResponse"
 	case EndMessage:
 		return "End"
 	}
@@ -71,6 +75,8 @@ const (
 	StreamCancelMessage
 	StreamOpenMessage
 	StreamCloseMessage
+	PexRequestMessage
+	PexResponseMessage
 	EndMessage MessageType = 255
 )
 
@@ -106,6 +112,12 @@ func (m ProtocolOptionType) String() string {
 		return "StreamCount"
 	case EpochOption:
 		return "Epoch"
+	case VariableChunkSizeOption:
+		return "VariableChunkSize"
+	case ContentEncryptionMethodOption:
+		return "ContentEncryptionMethod"
+	case PeerExchangeOption:
+		return "PeerExchange"
 	case EndOption:
 		return "EndOption"
 	}
@@ -127,7 +139,30 @@ const (
 	ChunksPerSignatureOption
 	StreamCountOption
 	EpochOption
+	VariableChunkSizeOption
+	ContentEncryptionMethodOption
+	PeerExchangeOption
 	EndOption ProtocolOptionType = 255
 )
 
+// ChunkAddressingMethod ...
+type ChunkAddressingMethod uint8
+
+// String ...
+func (m ChunkAddressingMethod) String() string {
+	switch m {
+	case BinChunkAddressingMethod:
+		return "Bin"
+	case ChunkRangeChunkAddressingMethod:
+		return "ChunkRange"
+	}
+	panic("invalid chunk addressing method")
+}
+
+// chunk addressing methods
+const (
+	BinChunkAddressingMethod ChunkAddressingMethod = iota
+	ChunkRangeChunkAddressingMethod
+)
+
 const timeGranularity = int64(time.Millisecond)
@@ -6,6 +6,8 @@ package codec
 import (
 	"encoding/binary"
 	"errors"
+	"math"
+	"math/bits"
 	"time"
 
 	"github.com/MemeLabs/strims/pkg/binaryutil"
@@ -17,6 +19,7 @@ import (
 var (
 	ErrUnsupportedMessageType    = errors.New("unsupported message type")
 	ErrUnsupportedProtocolOption = errors.New("unsupported protocol option")
+	ErrMalformedMessage          = errors.New("malformed message")
 )
 
 // Decoder ...
@@ -68,6 +71,75 @@ func (v Address) Bin() binmap.Bin {
 	return binmap.Bin(v)
 }
 
+// maxChunkRangeEnd is the last chunk addressable by a bin.
+const maxChunkRangeEnd = math.MaxUint64 >> 2
+
+// ChunkRange is an inclusive range of chunks.
+type ChunkRange struct {
+	Start uint64
+	End   uint64
+}
+
+// NewChunkRange returns the chunk range covered by b.
+func NewChunkRange(b binmap.Bin) ChunkRange {
+	return ChunkRange{
+		Start: b.BaseLeft().BaseOffset(),
+		End:   b.BaseRight().BaseOffset(),
+	}
+}
+
+// Unmarshal ...
+func (v *ChunkRange) Unmarshal(b []byte) (int, error) {
+	start, n := binary.Uvarint(b)
+	if n <= 0 {
+		return 0, ErrMalformedMessage
+	}
+	l, ln := binary.Uvarint(b[n:])
+	if ln <= 0 || start > maxChunkRangeEnd || l > maxChunkRangeEnd-start {
+		return 0, ErrMalformedMessage
+	}
+	v.Start = start
+	v.End = start + l
+	return n + ln, nil
+}
+
+// Marshal ...
+func (v ChunkRange) Marshal(b []byte) int {
+	n := binary.PutUvarint(b, v.Start)
+	return n + binary.PutUvarint(b[n:], v.End-v.Start)
+}
+
+// ByteLen ...
+func (v ChunkRange) ByteLen() int {
+	return binaryutil.UvarintLen(v.Start) + binaryutil.UvarintLen(v.End-v.Start)
+}
+
+// Bins returns the smallest set of bins that cover the range in order.
+func (v ChunkRange) Bins() []binmap.Bin {
+	var bins []binmap.Bin
+	for i, end := v.Start, v.End+1; i < end; {
+		layer := uint64(bits.TrailingZeros64(i))
+		if i == 0 {
+			layer = 63
+		}
+		for i+1<<layer > end {
+			layer--
+		}
+		bins = append(bins, binmap.NewBin(layer, i>>layer))
+		i += 1 << layer
+	}
+	return bins
+}
+
+// Bin returns the bin equal to the range if one exists.
+func (v ChunkRange) Bin() (binmap.Bin, bool) {
+	bins := v.Bins()
+	if len(bins) != 1 {
+		return binmap.None, false
+	}
+	return bins[0], true
+}
+
 // Buffer ...
 type Buffer []byte
 
@@ -360,6 +432,108 @@ func (v *LiveSignatureAlgorithmProtocolOption) ByteLen() int {
 	return 1
 }
 
+// ChunkAddressingMethodProtocolOption ...
+type ChunkAddressingMethodProtocolOption struct {
+	Value uint8
+}
+
+// Unmarshal ...
+func (v *ChunkAddressingMethodProtocolOption) Unmarshal(b []byte) (int, error) {
+	v.Value = b[0]
+	return 1, nil
+}
+
+// Marshal ...
+func (v *ChunkAddressingMethodProtocolOption) Marshal(b []byte) int {
+	b[0] = v.Value
+	return 1
+}
+
+// Type ...
+func (v *ChunkAddressingMethodProtocolOption) Type() ProtocolOptionType {
+	return ChunkAddressingMethodOption
+}
+
+// ByteLen ...
+func (v *ChunkAddressingMethodProtocolOption) ByteLen() int {
+	return 1
+}
+
+// VariableChunkSizeProtocolOption is sent by peers that omit the zero padding
+// at the end of chunks. It has no value.
+type VariableChunkSizeProtocolOption struct{}
+
+// Unmarshal ...
+func (v *VariableChunkSizeProtocolOption) Unmarshal(b []byte) (int, error) {
+	return 0, nil
+}
+
+// Marshal ...
+func (v *VariableChunkSizeProtocolOption) Marshal(b []byte) int {
+	return 0
+}
+
+// Type ...
+func (v *VariableChunkSizeProtocolOption) Type() ProtocolOptionType {
+	return VariableChunkSizeOption
+}
+
+// ByteLen ...
+func (v *VariableChunkSizeProtocolOption) ByteLen() int {
+	return 0
+}
+
+// PeerExchangeProtocolOption is sent by peers that handle PEX_REQ messages.
+// It has no value.
+type PeerExchangeProtocolOption struct{}
+
+// Unmarshal ...
+func (v *PeerExchangeProtocolOption) Unmarshal(b []byte) (int, error) {
+	return 0, nil
+}
+
+// Marshal ...
+func (v *PeerExchangeProtocolOption) Marshal(b []byte) int {
+	return 0
+}
+
+// Type ...
+func (v *PeerExchangeProtocolOption) Type() ProtocolOptionType {
+	return PeerExchangeOption
+}
+
+// ByteLen ...
+func (v *PeerExchangeProtocolOption) ByteLen() int {
+	return 0
+}
+
+// ContentEncryptionMethodProtocolOption ...
+type ContentEncryptionMethodProtocolOption struct {
+	Value uint8
+}
+
+// Unmarshal ...
+func (v *ContentEncryptionMethodProtocolOption) Unmarshal(b []byte) (int, error) {
+	v.Value = b[0]
+	return 1, nil
+}
+
+// Marshal ...
+func (v *ContentEncryptionMethodProtocolOption) Marshal(b []byte) int {
+	b[0] = v.Value
+	return 1
+}
+
+// Type ...
+func (v *ContentEncryptionMethodProtocolOption) Type() ProtocolOptionType {
+	return ContentEncryptionMethodOption
+}
+
+// ByteLen ...
+func (v *ContentEncryptionMethodProtocolOption) ByteLen() int {
+	return 1
+}
+
 // NewEpochProtocolOption ...
 func NewEpochProtocolOption(t timeutil.Time, sig []byte) *EpochProtocolOption {
 	if t.IsNil() {
@@ -497,6 +671,14 @@ func (v *Handshake) Unmarshal(b []byte) (size int, err error) {
 			option = &LiveSignatureAlgorithmProtocolOption{}
 		case EpochOption:
 			option = &EpochProtocolOption{signatureSize: v.signatureSize}
+		case ChunkAddressingMethodOption:
+			option = &ChunkAddressingMethodProtocolOption{}
+		case VariableChunkSizeOption:
+			option = &VariableChunkSizeProtocolOption{}
+		case ContentEncryptionMethodOption:
+			option = &ContentEncryptionMethodProtocolOption{}
+		case PeerExchangeOption:
+			option = &PeerExchangeProtocolOption{}
 		case EndOption:
 			return
 		default:
@@ -545,10 +727,16 @@ func (v *Handshake) ByteLen() (l int) {
 
 // Data ...
 type Data struct {
-	chunkSize int
-	Address   Address
-	Timestamp Timestamp
-	Data      Buffer
+	chunkSize    int
+	variableSize bool
+	Address      Address
+	Timestamp    Timestamp
+	Data         Buffer
+	// Lengths holds the length of the data in each chunk in swarms with
+	// variable chunk sizes. The lengths are sent before the data and the
+	// padding after the last chunk with data is omitted. Chunks are full if it
+	// is nil.
+	Lengths []int
 }
 
 // NewData ...
@@ -581,7 +769,14 @@ func (v *Data) Unmarshal(b []byte) (size int, err error) {
 	size += n
 
 	n = int(v.Address.Bin().BaseLength()) * v.chunkSize
-	if size+n > len(b) {
+	if v.variableSize {
+		var ln int
+		v.Lengths, n, ln, err = unmarshalChunkLengths(b[size:], int(v.Address.Bin().BaseLength()), v.chunkSize)
+		if err != nil {
+			return 0, err
+		}
+		size += ln
+	} else if size+n > len(b) {
 		n = len(b) - size
 	}
 	v.Data = b[size : size+n]
@@ -594,6 +789,9 @@ func (v *Data) Unmarshal(b []byte) (size int, err error) {
 func (v *Data) Marshal(b []byte) (size int) {
 	size += v.Address.Marshal(b)
 	size += v.Timestamp.Marshal(b[size:])
+	if v.variableSize {
+		size += marshalChunkLengths(b[size:], v.chunkLengths())
+	}
 	size += v.Data.Marshal(b[size:])
 
 	return
@@ -601,7 +799,58 @@ func (v *Data) Marshal(b []byte) (size int) {
 
 // ByteLen ...
 func (v *Data) ByteLen() int {
-	return int(v.Address.ByteLen()) + v.Timestamp.ByteLen() + v.Data.ByteLen()
+	n := int(v.Address.ByteLen()) + v.Timestamp.ByteLen() + v.Data.ByteLen()
+	if v.variableSize {
+		n += chunkLengthsByteLen(v.chunkLengths())
+	}
+	return n
+}
+
+// chunkLengths returns Lengths or the lengths of full chunks if it is nil.
+func (v *Data) chunkLengths() []int {
+	if v.Lengths != nil {
+		return v.Lengths
+	}
+	ls := make([]int, v.Address.Bin().BaseLength())
+	for i := range ls {
+		ls[i] = v.Data.ByteLen() / len(ls)
+	}
+	return ls
+}
+
+func chunkLengthsByteLen(ls []int) (size int) {
+	for _, l := range ls {
+		size += binaryutil.UvarintLen(uint64(l))
+	}
+	return
+}
+
+func marshalChunkLengths(b []byte, ls []int) (size int) {
+	for _, l := range ls {
+		size += binary.PutUvarint(b[size:], uint64(l))
+	}
+	return
+}
+
+// unmarshalChunkLengths reads the lengths of n chunks and returns them with
+// the length of the data that follows them.
+func unmarshalChunkLengths(b []byte, n, chunkSize int) (ls []int, dataLen, size int, err error) {
+	ls = make([]int, n)
+	for i := range ls {
+		l, ln := binary.Uvarint(b[size:])
+		if ln <= 0 || l > uint64(chunkSize) {
+			return nil, 0, 0, ErrMalformedMessage
+		}
+		size += ln
+		ls[i] = int(l)
+		if l != 0 {
+			dataLen = i*chunkSize + int(l)
+		}
+	}
+	if dataLen > len(b)-size {
+		return nil, 0, 0, ErrMalformedMessage
+	}
+	return
 }
 
 // Timestamp ...
@@ -1013,6 +1262,60 @@ func (v *StreamClose) Type() MessageType {
 	return StreamCloseMessage
 }
 
+// PexRequest ...
+type PexRequest struct {
+	Empty
+}
+
+// Type ...
+func (v *PexRequest) Type() MessageType {
+	return PexRequestMessage
+}
+
+// PexResponse carries the vpn host id of one peer participating in the swarm.
+// Unlike PEX_RESv4/v6 in RFC 7574 peers are identified by host id rather than
+// transport address so the receiver can dial them over the vpn.
+type PexResponse struct {
+	PeerID []byte
+}
+
+// Unmarshal ...
+func (v *PexResponse) Unmarshal(b []byte) (size int, err error) {
+	if len(b) < 1 {
+		return 0, ErrMalformedMessage
+	}
+	idSize := int(b[0])
+	size++
+
+	if len(b) < size+idSize {
+		return 0, ErrMalformedMessage
+	}
+	v.PeerID = b[size : size+idSize]
+	size += idSize
+
+	return
+}
+
+// Marshal ...
+func (v *PexResponse) Marshal(b []byte) (size int) {
+	b[0] = byte(len(v.PeerID))
+	size++
+
+	size += copy(b[size:], v.PeerID)
+
+	return
+}
+
+// ByteLen ...
+func (v *PexResponse) ByteLen() int {
+	return 1 + len(v.PeerID)
+}
+
+// Type ...
+func (v *PexResponse) Type() MessageType {
+	return PexResponseMessage
+}
+
 // Empty ...
 type Empty struct{}
 
@@ -4,10 +4,13 @@
 package codec
 
 import (
+	"encoding/binary"
 	"fmt"
+	"math"
 	"testing"
 	"time"
 
+	"github.com/MemeLabs/strims/pkg/binmap"
 	"github.com/MemeLabs/strims/pkg/timeutil"
 	"github.com/stretchr/testify/assert"
 )
@@ -68,6 +71,22 @@ func TestMessageMarshalUnmarshal(t *testing.T) {
 			src: &LiveSignatureAlgorithmProtocolOption{Value: 1},
 			dst: &LiveSignatureAlgorithmProtocolOption{Value: 1},
 		},
+		{
+			src: &ChunkAddressingMethodProtocolOption{Value: 1},
+			dst: &ChunkAddressingMethodProtocolOption{Value: 1},
+		},
+		{
+			src: &VariableChunkSizeProtocolOption{},
+			dst: &VariableChunkSizeProtocolOption{},
+		},
+		{
+			src: &ContentEncryptionMethodProtocolOption{Value: 1},
+			dst: &ContentEncryptionMethodProtocolOption{Value: 1},
+		},
+		{
+			src: &PeerExchangeProtocolOption{},
+			dst: &PeerExchangeProtocolOption{},
+		},
 		{
 			src: &SwarmIdentifierProtocolOption{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
 			dst: &SwarmIdentifierProtocolOption{},
@@ -84,6 +103,10 @@ func TestMessageMarshalUnmarshal(t *testing.T) {
 					&ContentIntegrityProtectionMethodProtocolOption{Value: 1},
 					&MerkleHashTreeFunctionProtocolOption{Value: 1},
 					&LiveSignatureAlgorithmProtocolOption{Value: 1},
+					&ChunkAddressingMethodProtocolOption{Value: 1},
+					&VariableChunkSizeProtocolOption{},
+					&ContentEncryptionMethodProtocolOption{Value: 1},
+					&PeerExchangeProtocolOption{},
 					&SwarmIdentifierProtocolOption{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
 				},
 			},
@@ -105,6 +128,10 @@ func TestMessageMarshalUnmarshal(t *testing.T) {
 				Data:      Buffer{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
 			},
 		},
+		{
+			src: &ChunkRange{Start: 1234, End: 5678},
+			dst: &ChunkRange{},
+		},
 		{
 			src: &Timestamp{Time: timeutil.New(1234 * int64(time.Millisecond))},
 			dst: &Timestamp{},
@@ -231,6 +258,14 @@ func TestMessageMarshalUnmarshal(t *testing.T) {
 			src: &Restart{},
 			dst: &Restart{},
 		},
+		{
+			src: &PexRequest{},
+			dst: &PexRequest{},
+		},
+		{
+			src: &PexResponse{PeerID: []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+			dst: &PexResponse{},
+		},
 		{
 			src: &End{},
 			dst: &End{},
@@ -266,3 +301,57 @@ func TestMessageMarshalUnmarshal(t *testing.T) {
 		})
 	}
 }
+
+func TestChunkRangeBins(t *testing.T) {
+	cases := []struct {
+		label string
+		r     ChunkRange
+		bins  []binmap.Bin
+	}{
+		{
+			label: "single chunk",
+			r:     ChunkRange{3, 3},
+			bins:  []binmap.Bin{binmap.NewBin(0, 3)},
+		},
+		{
+			label: "aligned range",
+			r:     ChunkRange{8, 15},
+			bins:  []binmap.Bin{binmap.NewBin(3, 1)},
+		},
+		{
+			label: "unaligned range",
+			r:     ChunkRange{3, 12},
+			bins: []binmap.Bin{
+				binmap.NewBin(0, 3),
+				binmap.NewBin(2, 1),
+				binmap.NewBin(2, 2),
+				binmap.NewBin(0, 12),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.label, func(t *testing.T) {
+			assert.Equal(t, c.bins, c.r.Bins())
+		})
+	}
+
+	r := NewChunkRange(binmap.NewBin(3, 1))
+	assert.Equal(t, ChunkRange{8, 15}, r)
+	b, ok := r.Bin()
+	assert.True(t, ok)
+	assert.Equal(t, binmap.NewBin(3, 1), b)
+
+	_, ok = ChunkRange{3, 12}.Bin()
+	assert.False(t, ok)
+}
+
+func TestChunkRangeUnmarshalInvalid(t *testing.T) {
+	b := make([]byte, 2*binary.MaxVarintLen64)
+	n := binary.PutUvarint(b, math.MaxUint64)
+	n += binary.PutUvarint(b[n:], 1)
+
+	var r ChunkRange
+	_, err := r.Unmarshal(b[:n])
+	assert.ErrorIs(t, err, ErrMalformedMessage)
+}
@@ -22,6 +22,8 @@ type MessageHandler interface {
 	HandleStreamCancel(v StreamCancel) error
 	HandleStreamOpen(v StreamOpen) error
 	HandleStreamClose(v StreamClose) error
+	HandlePexRequest(v PexRequest) error
+	HandlePexResponse(v PexResponse) error
 }
 
 // Reader ...
@@ -29,7 +31,12 @@ type Reader struct {
 	ChunkSize              int
 	IntegrityHashSize      int
 	IntegritySignatureSize int
-	Handler                MessageHandler
+	ChunkAddressingMethod  ChunkAddressingMethod
+	// VariableChunkSize is set for swarms that send data without the zero
+	// padding at the end of chunks. Data messages include the length of each
+	// chunk.
+	VariableChunkSize bool
+	Handler           MessageHandler
 }
 
 func (v Reader) Read(b []byte) (n int, err error) {
@@ -77,6 +84,10 @@ func (v Reader) Read(b []byte) (n int, err error) {
 			mn, err = v.readStreamOpen(b[n:])
 		case StreamCloseMessage:
 			mn, err = v.readStreamClose(b[n:])
+		case PexRequestMessage:
+			mn, err = v.readPexRequest(b[n:])
+		case PexResponseMessage:
+			mn, err = v.readPexResponse(b[n:])
 		case EndMessage:
 			return
 		default:
@@ -111,7 +122,11 @@ func (v Reader) readRestart(b []byte) (int, error) {
 }
 
 func (v Reader) readData(b []byte) (int, error) {
-	msg := Data{chunkSize: v.ChunkSize}
+	if v.ChunkAddressingMethod == ChunkRangeChunkAddressingMethod {
+		return v.readRangeAddressed(DataMessage, b)
+	}
+
+	msg := Data{chunkSize: v.ChunkSize, variableSize: v.VariableChunkSize}
 	n, err := msg.Unmarshal(b)
 	if err != nil {
 		return 0, err
@@ -121,6 +136,10 @@ func (v Reader) readData(b []byte) (int, error) {
 }
 
 func (v Reader) readAck(b []byte) (int, error) {
+	if v.ChunkAddressingMethod == ChunkRangeChunkAddressingMethod {
+		return v.readRangeAddressed(AckMessage, b)
+	}
+
 	var msg Ack
 	n, err := msg.Unmarshal(b)
 	if err != nil {
@@ -131,6 +150,10 @@ func (v Reader) readAck(b []byte) (int, error) {
 }
 
 func (v Reader) readHave(b []byte) (int, error) {
+	if v.ChunkAddressingMethod == ChunkRangeChunkAddressingMethod {
+		return v.readRangeAddressed(HaveMessage, b)
+	}
+
 	var msg Have
 	n, err := msg.Unmarshal(b)
 	if err != nil {
@@ -141,6 +164,10 @@ func (v Reader) readHave(b []byte) (int, error) {
 }
 
 func (v Reader) readIntegrity(b []byte) (int, error) {
+	if v.ChunkAddressingMethod == ChunkRangeChunkAddressingMethod {
+		return v.readRangeAddressed(IntegrityMessage, b)
+	}
+
 	msg := Integrity{hashSize: v.IntegrityHashSize}
 	n, err := msg.Unmarshal(b)
 	if err != nil {
@@ -151,6 +178,10 @@ func (v Reader) readIntegrity(b []byte) (int, error) {
 }
 
 func (v Reader) readSignedIntegrity(b []byte) (int, error) {
+	if v.ChunkAddressingMethod == ChunkRangeChunkAddressingMethod {
+		return v.readRangeAddressed(SignedIntegrityMessage, b)
+	}
+
 	msg := SignedIntegrity{signatureSize: v.IntegritySignatureSize}
 	n, err := msg.Unmarshal(b)
 	if err != nil {
@@ -161,6 +192,10 @@ func (v Reader) readSignedIntegrity(b []byte) (int, error) {
 }
 
 func (v Reader) readRequest(b []byte) (int, error) {
+	if v.ChunkAddressingMethod == ChunkRangeChunkAddressingMethod {
+		return v.readRangeAddressed(RequestMessage, b)
+	}
+
 	var msg Request
 	n, err := msg.Unmarshal(b)
 	if err != nil {
@@ -171,6 +206,10 @@ func (v Reader) readRequest(b []byte) (int, error) {
 }
 
 func (v Reader) readCancel(b []byte) (int, error) {
+	if v.ChunkAddressingMethod == ChunkRangeChunkAddressingMethod {
+		return v.readRangeAddressed(CancelMessage, b)
+	}
+
 	var msg Cancel
 	n, err := msg.Unmarshal(b)
 	if err != nil {
@@ -259,3 +298,159 @@ func (v Reader) readStreamClose(b []byte) (int, error) {
 	err = v.Handler.HandleStreamClose(msg)
 	return n, err
 }
+
+func (v Reader) readPexRequest(b []byte) (int, error) {
+	var msg PexRequest
+	n, err := msg.Unmarshal(b)
+	if err != nil {
+		return 0, err
+	}
+	err = v.Handler.HandlePexRequest(msg)
+	return n, err
+}
+
+func (v Reader) readPexResponse(b []byte) (int, error) {
+	var msg PexResponse
+	n, err := msg.Unmarshal(b)
+	if err != nil {
+		return 0, err
+	}
+	err = v.Handler.HandlePexResponse(msg)
+	return n, err
+}
+
+// readRangeAddressed reads messages whose bin address is replaced by a chunk
+// range. Ranges are split into the smallest set of bins that cover them and
+// the handler is called once for each bin. Integrity messages must address a
+// single bin.
+func (v Reader) readRangeAddressed(mt MessageType, b []byte) (int, error) {
+	var r ChunkRange
+	n, err := r.Unmarshal(b)
+	if err != nil {
+		return 0, err
+	}
+	bins := r.Bins()
+
+	switch mt {
+	case DataMessage:
+		var ts Timestamp
+		tn, err := ts.Unmarshal(b[n:])
+		if err != nil {
+			return 0, err
+		}
+		n += tn
+
+		var ls []int
+		d := b[n:]
+		if l := int(r.End-r.Start+1) * v.ChunkSize; v.VariableChunkSize {
+			var dl, ln int
+			ls, dl, ln, err = unmarshalChunkLengths(d, int(r.End-r.Start+1), v.ChunkSize)
+			if err != nil {
+				return 0, err
+			}
+			n += ln
+			d = d[ln : ln+dl]
+		} else if len(d) > l {
+			d = d[:l]
+		}
+		n += len(d)
+
+		for _, bin := range bins {
+			l := int(bin.BaseLength()) * v.ChunkSize
+			if l > len(d) {
+				l = len(d)
+			}
+			msg := Data{
+				chunkSize:    v.ChunkSize,
+				variableSize: v.VariableChunkSize,
+				Address:      Address(bin),
+				Timestamp:    ts,
+				Data:         d[:l],
+			}
+			if ls != nil {
+				msg.Lengths = ls[:bin.BaseLength()]
+				ls = ls[bin.BaseLength():]
+			}
+			if err := v.Handler.HandleData(msg); err != nil {
+				return n, err
+			}
+			d = d[l:]
+		}
+	case AckMessage:
+		var ds DelaySample
+		dn, err := ds.Unmarshal(b[n:])
+		if err != nil {
+			return 0, err
+		}
+		n += dn
+
+		for _, bin := range bins {
+			if err := v.Handler.HandleAck(Ack{Address: Address(bin), DelaySample: ds}); err != nil {
+				return n, err
+			}
+		}
+	case HaveMessage:
+		for _, bin := range bins {
+			if err := v.Handler.HandleHave(Have{Address(bin)}); err != nil {
+				return n, err
+			}
+		}
+	case IntegrityMessage:
+		if len(bins) != 1 || len(b) < n+v.IntegrityHashSize {
+			return 0, ErrMalformedMessage
+		}
+		msg := Integrity{
+			hashSize: v.IntegrityHashSize,
+			Address:  Address(bins[0]),
+			Hash:     b[n : n+v.IntegrityHashSize],
+		}
+		n += v.IntegrityHashSize
+
+		if err := v.Handler.HandleIntegrity(msg); err != nil {
+			return n, err
+		}
+	case SignedIntegrityMessage:
+		var ts Timestamp
+		tn, err := ts.Unmarshal(b[n:])
+		if err != nil {
+			return 0, err
+		}
+		n += tn
+
+		if len(bins) != 1 || len(b) < n+v.IntegritySignatureSize {
+			return 0, ErrMalformedMessage
+		}
+		msg := SignedIntegrity{
+			signatureSize: v.IntegritySignatureSize,
+			Address:       Address(bins[0]),
+			Timestamp:     ts,
+			Signature:     b[n : n+v.IntegritySignatureSize],
+		}
+		n += v.IntegritySignatureSize
+
+		if err := v.Handler.HandleSignedIntegrity(msg); err != nil {
+			return n, err
+		}
+	case RequestMessage:
+		var ts Timestamp
+		tn, err := ts.Unmarshal(b[n:])
+		if err != nil {
+			return 0, err
+		}
+		n += tn
+
+		for _, bin := range bins {
+			if err := v.Handler.HandleRequest(Request{Address: Address(bin), Timestamp: ts}); err != nil {
+				return n, err
+			}
+		}
+	case CancelMessage:
+		for _, bin := range bins {
+			if err := v.Handler.HandleCancel(Cancel{Address(bin)}); err != nil {
+				return n, err
+			}
+		}
+	}
+
+	return n, nil
+}
@@ -26,9 +26,18 @@ func NewWriter(w ioutil.BufferedWriteFlusher) Writer {
 
 // Writer ...
 type Writer struct {
+	ChunkAddressingMethod ChunkAddressingMethod
+	// VariableChunkSize prefixes data with the length of each chunk so the
+	// zero padding at the end of chunks can be omitted.
+	VariableChunkSize bool
+
 	w   ioutil.BufferedWriteFlusher
 	off int
 	buf []byte
+
+	// end offset and range of the last have written with range addressing
+	haveEnd   int
+	haveRange ChunkRange
 }
 
 // ensureSpace ...
@@ -62,6 +71,7 @@ func (w *Writer) Len() int {
 
 func (w *Writer) Reset() {
 	w.off = 0
+	w.haveEnd = 0
 }
 
 // Flush ...
@@ -119,6 +129,15 @@ func (w *Writer) WriteRestart(m Restart) (int, error) {
 
 // WriteAck ...
 func (w *Writer) WriteAck(m Ack) (int, error) {
+	if w.ChunkAddressingMethod == ChunkRangeChunkAddressingMethod {
+		n, err := w.writeRangeHeader(m.Type(), m.Address, m.DelaySample.ByteLen())
+		if err != nil {
+			return 0, err
+		}
+		w.off += m.DelaySample.Marshal(w.buf[w.off:])
+		return n, nil
+	}
+
 	n := m.ByteLen() + MessageTypeLen
 	if err := w.ensureSpace(n); err != nil {
 		return 0, err
@@ -134,6 +153,10 @@ func (w *Writer) WriteAck(m Ack) (int, error) {
 
 // WriteHave ...
 func (w *Writer) WriteHave(m Have) (int, error) {
+	if w.ChunkAddressingMethod == ChunkRangeChunkAddressingMethod {
+		return w.writeRangeHave(m)
+	}
+
 	n := m.ByteLen() + MessageTypeLen
 	if err := w.ensureSpace(n); err != nil {
 		return 0, err
@@ -149,6 +172,25 @@ func (w *Writer) WriteHave(m Have) (int, error) {
 
 // WriteData ...
 func (w *Writer) WriteData(m Data) (int, error) {
+	m.variableSize = w.VariableChunkSize
+
+	if w.ChunkAddressingMethod == ChunkRangeChunkAddressingMethod {
+		var ln int
+		if m.variableSize {
+			ln = chunkLengthsByteLen(m.chunkLengths())
+		}
+		n, err := w.writeRangeHeader(m.Type(), m.Address, m.Timestamp.ByteLen()+ln+m.Data.ByteLen())
+		if err != nil {
+			return 0, err
+		}
+		w.off += m.Timestamp.Marshal(w.buf[w.off:])
+		if m.variableSize {
+			w.off += marshalChunkLengths(w.buf[w.off:], m.chunkLengths())
+		}
+		w.off += m.Data.Marshal(w.buf[w.off:])
+		return n, nil
+	}
+
 	n := m.ByteLen() + MessageTypeLen
 	if err := w.ensureSpace(n); err != nil {
 		return 0, err
@@ -164,6 +206,15 @@ func (w *Writer) WriteData(m Data) (int, error) {
 
 // WriteIntegrity ...
 func (w *Writer) WriteIntegrity(m Integrity) (int, error) {
+	if w.ChunkAddressingMethod == ChunkRangeChunkAddressingMethod {
+		n, err := w.writeRangeHeader(m.Type(), m.Address, m.Hash.ByteLen())
+		if err != nil {
+			return 0, err
+		}
+		w.off += m.Hash.Marshal(w.buf[w.off:])
+		return n, nil
+	}
+
 	n := m.ByteLen() + MessageTypeLen
 	if err := w.ensureSpace(n); err != nil {
 		return 0, err
@@ -179,6 +230,16 @@ func (w *Writer) WriteIntegrity(m Integrity) (int, error) {
 
 // WriteSignedIntegrity ...
 func (w *Writer) WriteSignedIntegrity(m SignedIntegrity) (int, error) {
+	if w.ChunkAddressingMethod == ChunkRangeChunkAddressingMethod {
+		n, err := w.writeRangeHeader(m.Type(), m.Address, m.Timestamp.ByteLen()+m.Signature.ByteLen())
+		if err != nil {
+			return 0, err
+		}
+		w.off += m.Timestamp.Marshal(w.buf[w.off:])
+		w.off += m.Signature.Marshal(w.buf[w.off:])
+		return n, nil
+	}
+
 	n := m.ByteLen() + MessageTypeLen
 	if err := w.ensureSpace(n); err != nil {
 		return 0, err
@@ -194,6 +255,15 @@ func (w *Writer) WriteSignedIntegrity(m SignedIntegrity) (int, error) {
 
 // WriteRequest ...
 func (w *Writer) WriteRequest(m Request) (int, error) {
+	if w.ChunkAddressingMethod == ChunkRangeChunkAddressingMethod {
+		n, err := w.writeRangeHeader(m.Type(), m.Address, m.Timestamp.ByteLen())
+		if err != nil {
+			return 0, err
+		}
+		w.off += m.Timestamp.Marshal(w.buf[w.off:])
+		return n, nil
+	}
+
 	n := m.ByteLen() + MessageTypeLen
 	if err := w.ensureSpace(n); err != nil {
 		return 0, err
@@ -239,6 +309,10 @@ func (w *Writer) WritePong(m Pong) (int, error) {
 
 // WriteCancel ...
 func (w *Writer) WriteCancel(m Cancel) (int, error) {
+	if w.ChunkAddressingMethod == ChunkRangeChunkAddressingMethod {
+		return w.writeRangeHeader(m.Type(), m.Address, 0)
+	}
+
 	n := m.ByteLen() + MessageTypeLen
 	if err := w.ensureSpace(n); err != nil {
 		return 0, err
@@ -341,3 +415,77 @@ func (w *Writer) WriteStreamClose(m StreamClose) (int, error) {
 
 	return n, nil
 }
+
+// WritePexRequest ...
+func (w *Writer) WritePexRequest(m PexRequest) (int, error) {
+	n := m.ByteLen() + MessageTypeLen
+	if err := w.ensureSpace(n); err != nil {
+		return 0, err
+	}
+
+	w.buf[w.off] = byte(m.Type())
+	w.off++
+
+	w.off += m.Marshal(w.buf[w.off:])
+
+	return n, nil
+}
+
+// WritePexResponse ...
+func (w *Writer) WritePexResponse(m PexResponse) (int, error) {
+	n := m.ByteLen() + MessageTypeLen
+	if err := w.ensureSpace(n); err != nil {
+		return 0, err
+	}
+
+	w.buf[w.off] = byte(m.Type())
+	w.off++
+
+	w.off += m.Marshal(w.buf[w.off:])
+
+	return n, nil
+}
+
+// writeRangeHeader writes the message type and the chunk range equivalent to
+// a and checks that there is space for n more bytes of message fields.
+func (w *Writer) writeRangeHeader(t MessageType, a Address, n int) (int, error) {
+	r := NewChunkRange(a.Bin())
+	n += MessageTypeLen + r.ByteLen()
+	if err := w.ensureSpace(n); err != nil {
+		return 0, err
+	}
+
+	w.buf[w.off] = byte(t)
+	w.off++
+
+	w.off += r.Marshal(w.buf[w.off:])
+
+	return n, nil
+}
+
+// writeRangeHave extends the previous message if it was a have for the chunks
+// immediately before m.
+func (w *Writer) writeRangeHave(m Have) (int, error) {
+	r := NewChunkRange(m.Bin())
+	if w.off == 0 || w.off != w.haveEnd || w.haveRange.End+1 != r.Start {
+		n, err := w.writeRangeHeader(m.Type(), m.Address, 0)
+		if err != nil {
+			return 0, err
+		}
+		w.haveEnd = w.off
+		w.haveRange = r
+		return n, nil
+	}
+
+	prev := w.haveRange.ByteLen()
+	r.Start = w.haveRange.Start
+	n := r.ByteLen() - prev
+	if err := w.ensureSpace(n); err != nil {
+		return 0, err
+	}
+
+	w.off += r.Marshal(w.buf[w.off-prev:]) - prev
+	w.haveEnd = w.off
+	w.haveRange = r
+	return n, nil
+}
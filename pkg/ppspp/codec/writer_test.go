@@ -0,0 +1,208 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package codec
+
+import (
+	"bufio"
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/MemeLabs/strims/pkg/binmap"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type messageRecorder struct {
+	messages []Message
+}
+
+func (r *messageRecorder) record(m Message) error {
+	r.messages = append(r.messages, m)
+	return nil
+}
+
+func (r *messageRecorder) HandleHandshake(v Handshake) error             { return r.record(&v) }
+func (r *messageRecorder) HandleRestart(v Restart) error                 { return r.record(&v) }
+func (r *messageRecorder) HandleData(v Data) error                       { return r.record(&v) }
+func (r *messageRecorder) HandleAck(v Ack) error                         { return r.record(&v) }
+func (r *messageRecorder) HandleHave(v Have) error                       { return r.record(&v) }
+func (r *messageRecorder) HandleIntegrity(v Integrity) error             { return r.record(&v) }
+func (r *messageRecorder) HandleSignedIntegrity(v SignedIntegrity) error { return r.record(&v) }
+func (r *messageRecorder) HandleRequest(v Request) error                 { return r.record(&v) }
+func (r *messageRecorder) HandleCancel(v Cancel) error                   { return r.record(&v) }
+func (r *messageRecorder) HandleChoke(v Choke) error                     { return r.record(&v) }
+func (r *messageRecorder) HandleUnchoke(v Unchoke) error                 { return r.record(&v) }
+func (r *messageRecorder) HandlePing(v Ping) error                       { return r.record(&v) }
+func (r *messageRecorder) HandlePong(v Pong) error                       { return r.record(&v) }
+func (r *messageRecorder) HandleStreamRequest(v StreamRequest) error     { return r.record(&v) }
+func (r *messageRecorder) HandleStreamCancel(v StreamCancel) error       { return r.record(&v) }
+func (r *messageRecorder) HandleStreamOpen(v StreamOpen) error           { return r.record(&v) }
+func (r *messageRecorder) HandleStreamClose(v StreamClose) error         { return r.record(&v) }
+func (r *messageRecorder) HandlePexRequest(v PexRequest) error           { return r.record(&v) }
+func (r *messageRecorder) HandlePexResponse(v PexResponse) error         { return r.record(&v) }
+
+func TestChunkRangeWriterReader(t *testing.T) {
+	const chunkSize = 16
+	ts := Timestamp{Time: timeutil.New(1234 * int64(time.Millisecond))}
+	hash := bytes.Repeat([]byte{0xbb}, 32)
+	sig := bytes.Repeat([]byte{0xcc}, 64)
+
+	var buf bytes.Buffer
+	w := NewWriter(bufio.NewWriterSize(&buf, 1024))
+	w.ChunkAddressingMethod = ChunkRangeChunkAddressingMethod
+
+	src := []Message{
+		&Ack{Address: Address(binmap.NewBin(0, 3)), DelaySample: DelaySample{Duration: 5 * time.Millisecond}},
+		&Have{Address: Address(binmap.NewBin(2, 1))},
+		&Integrity{Address: Address(binmap.NewBin(3, 0)), Hash: hash},
+		&SignedIntegrity{Address: Address(binmap.NewBin(6, 0)), Timestamp: ts, Signature: sig},
+		&Request{Address: Address(binmap.NewBin(0, 9)), Timestamp: ts},
+		&Cancel{Address: Address(binmap.NewBin(1, 3))},
+		&Choke{},
+		&Data{Address: Address(binmap.NewBin(2, 1)), Timestamp: ts, Data: bytes.Repeat([]byte{0xaa}, 4*chunkSize)},
+	}
+	for _, m := range src {
+		var err error
+		switch m := m.(type) {
+		case *Ack:
+			_, err = w.WriteAck(*m)
+		case *Have:
+			_, err = w.WriteHave(*m)
+		case *Integrity:
+			_, err = w.WriteIntegrity(*m)
+		case *SignedIntegrity:
+			_, err = w.WriteSignedIntegrity(*m)
+		case *Request:
+			_, err = w.WriteRequest(*m)
+		case *Cancel:
+			_, err = w.WriteCancel(*m)
+		case *Choke:
+			_, err = w.WriteChoke(*m)
+		case *Data:
+			_, err = w.WriteData(*m)
+		}
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, w.Flush())
+	assert.NoError(t, w.w.Flush())
+
+	var rec messageRecorder
+	r := Reader{
+		ChunkSize:              chunkSize,
+		IntegrityHashSize:      len(hash),
+		IntegritySignatureSize: len(sig),
+		ChunkAddressingMethod:  ChunkRangeChunkAddressingMethod,
+		Handler:                &rec,
+	}
+	_, err := r.Read(buf.Bytes())
+	assert.NoError(t, err)
+
+	if assert.Equal(t, len(src), len(rec.messages)) {
+		for i := range src {
+			assert.Equal(t, src[i].Type(), rec.messages[i].Type())
+			assert.Equal(t, marshalMessage(src[i]), marshalMessage(rec.messages[i]), "message %d", i)
+		}
+	}
+}
+
+func TestChunkRangeWriterCoalescesHaves(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(bufio.NewWriterSize(&buf, 1024))
+	w.ChunkAddressingMethod = ChunkRangeChunkAddressingMethod
+
+	for _, b := range []binmap.Bin{binmap.NewBin(0, 3), binmap.NewBin(2, 1), binmap.NewBin(0, 8), binmap.NewBin(0, 10)} {
+		_, err := w.WriteHave(Have{Address(b)})
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, w.Flush())
+	assert.NoError(t, w.w.Flush())
+
+	var rec messageRecorder
+	r := Reader{
+		ChunkAddressingMethod: ChunkRangeChunkAddressingMethod,
+		Handler:               &rec,
+	}
+	_, err := r.Read(buf.Bytes())
+	assert.NoError(t, err)
+
+	expected := []Message{
+		&Have{Address(binmap.NewBin(0, 3))},
+		&Have{Address(binmap.NewBin(2, 1))},
+		&Have{Address(binmap.NewBin(0, 8))},
+		&Have{Address(binmap.NewBin(0, 10))},
+	}
+	assert.Equal(t, expected, rec.messages)
+	assert.Equal(t, 2*(MessageTypeLen+ChunkRange{3, 8}.ByteLen()), buf.Len(), "adjacent haves should share a message")
+}
+
+func TestVariableChunkSizeDataLength(t *testing.T) {
+	const chunkSize = 16
+	ts := Timestamp{Time: timeutil.New(1234 * int64(time.Millisecond))}
+
+	for _, method := range []ChunkAddressingMethod{BinChunkAddressingMethod, ChunkRangeChunkAddressingMethod} {
+		t.Run(method.String(), func(t *testing.T) {
+			var buf bytes.Buffer
+			w := NewWriter(bufio.NewWriterSize(&buf, 1024))
+			w.ChunkAddressingMethod = method
+			w.VariableChunkSize = true
+
+			// data ending in zeros, short chunks followed by more data, and
+			// messages following data must not be confused with the payload
+			data := make([]byte, chunkSize+4)
+			data[0], data[1], data[chunkSize] = 0xaa, 0xbb, 0xcc
+			lengths := []int{2, 4}
+			_, err := w.WriteData(Data{Address: Address(binmap.NewBin(1, 0)), Timestamp: ts, Data: data, Lengths: lengths})
+			assert.NoError(t, err)
+			_, err = w.WriteChoke(Choke{})
+			assert.NoError(t, err)
+			assert.NoError(t, w.Flush())
+			assert.NoError(t, w.w.Flush())
+
+			var rec messageRecorder
+			r := Reader{
+				ChunkSize:             chunkSize,
+				ChunkAddressingMethod: method,
+				VariableChunkSize:     true,
+				Handler:               &rec,
+			}
+			_, err = r.Read(buf.Bytes())
+			assert.NoError(t, err)
+
+			if assert.Len(t, rec.messages, 2) {
+				if m, ok := rec.messages[0].(*Data); assert.True(t, ok) {
+					assert.Equal(t, Buffer(data), m.Data)
+					assert.Equal(t, lengths, m.Lengths)
+				}
+				assert.Equal(t, ChokeMessage, rec.messages[1].Type())
+			}
+		})
+	}
+}
+
+func TestVariableChunkSizeDataLengthOverflow(t *testing.T) {
+	const chunkSize = 16
+
+	var buf bytes.Buffer
+	w := NewWriter(bufio.NewWriterSize(&buf, 1024))
+	w.VariableChunkSize = true
+	_, err := w.WriteData(Data{Address: Address(binmap.NewBin(0, 3)), Data: make([]byte, chunkSize+1)})
+	assert.NoError(t, err)
+	assert.NoError(t, w.Flush())
+	assert.NoError(t, w.w.Flush())
+
+	r := Reader{
+		ChunkSize:         chunkSize,
+		VariableChunkSize: true,
+		Handler:           &messageRecorder{},
+	}
+	_, err = r.Read(buf.Bytes())
+	assert.ErrorIs(t, err, ErrMalformedMessage)
+}
+
+func marshalMessage(m Message) []byte {
+	b := make([]byte, m.ByteLen())
+	m.Marshal(b)
+	return b
+}
@@ -0,0 +1,194 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package ppspp
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"sync"
+
+	"github.com/MemeLabs/strims/pkg/binmap"
+	"github.com/MemeLabs/strims/pkg/ioutil"
+	"github.com/MemeLabs/strims/pkg/ppspp/integrity"
+)
+
+// ContentKeySize is the length of swarm content keys in bytes.
+const ContentKeySize = 32
+
+// errors ...
+var (
+	ErrContentKeyNotSet            = errors.New("content key not set")
+	ErrSwarmNotEncrypted           = errors.New("swarm content is not encrypted")
+	errInvalidContentKeySize       = errors.New("invalid content key size")
+	errUnsupportedEncryptionMethod = errors.New("unsupported content encryption method")
+)
+
+// ContentEncryptionMethod ...
+type ContentEncryptionMethod uint8
+
+// String ...
+func (m ContentEncryptionMethod) String() string {
+	switch m {
+	case NoneContentEncryptionMethod:
+		return "None"
+	case AES256CTRContentEncryptionMethod:
+		return "AES256CTR"
+	}
+	panic("invalid content encryption method")
+}
+
+// content encryption methods
+const (
+	NoneContentEncryptionMethod ContentEncryptionMethod = iota
+	AES256CTRContentEncryptionMethod
+)
+
+// NewContentKey generates a random swarm content key.
+func NewContentKey() ([]byte, error) {
+	k := make([]byte, ContentKeySize)
+	if _, err := rand.Read(k); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+func newContentCipher(m ContentEncryptionMethod, e *epoch) (*contentCipher, error) {
+	if m != AES256CTRContentEncryptionMethod {
+		return nil, errUnsupportedEncryptionMethod
+	}
+	return &contentCipher{epoch: e}, nil
+}
+
+// contentCipher encrypts swarm data with AES-CTR. The iv is the swarm epoch
+// followed by the block offset so any range of the stream can be decrypted
+// independently and key streams aren't reused after the writer resets.
+type contentCipher struct {
+	epoch *epoch
+
+	lock  sync.Mutex
+	key   []byte
+	block cipher.Block
+}
+
+// SetKey ...
+func (c *contentCipher) SetKey(k []byte) error {
+	if len(k) != ContentKeySize {
+		return errInvalidContentKeySize
+	}
+	block, err := aes.NewCipher(k)
+	if err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.key = append([]byte(nil), k...)
+	c.block = block
+	return nil
+}
+
+// Key ...
+func (c *contentCipher) Key() ([]byte, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.key, c.key != nil
+}
+
+// XORKeyStreamAt implements store.Cipher
+func (c *contentCipher) XORKeyStreamAt(dst, src []byte, off uint64) error {
+	c.lock.Lock()
+	block := c.block
+	c.lock.Unlock()
+
+	if block == nil {
+		return ErrContentKeyNotSet
+	}
+
+	t, _ := c.epoch.Value()
+	var iv [aes.BlockSize]byte
+	binary.BigEndian.PutUint64(iv[:8], uint64(t.UnixNano()))
+	binary.BigEndian.PutUint64(iv[8:], off/aes.BlockSize)
+	s := cipher.NewCTR(block, iv[:])
+
+	if n := off % aes.BlockSize; n != 0 {
+		var skip [aes.BlockSize]byte
+		s.XORKeyStream(skip[:n], skip[:n])
+	}
+	s.XORKeyStream(dst, src)
+	return nil
+}
+
+// contentEncryptWriter encrypts the signed output of the integrity writer
+// before it is split into chunks and published to the swarm store.
+type contentEncryptWriter struct {
+	c   *contentCipher
+	w   ioutil.WriteFlushResetter
+	off uint64
+	buf []byte
+}
+
+// Write ...
+func (w *contentEncryptWriter) Write(p []byte) (int, error) {
+	if cap(w.buf) < len(p) {
+		w.buf = make([]byte, len(p))
+	}
+	b := w.buf[:len(p)]
+	if err := w.c.XORKeyStreamAt(b, p, w.off); err != nil {
+		return 0, err
+	}
+
+	n, err := w.w.Write(b)
+	w.off += uint64(n)
+	return n, err
+}
+
+// Flush ...
+func (w *contentEncryptWriter) Flush() error {
+	return w.w.Flush()
+}
+
+// Reset ...
+func (w *contentEncryptWriter) Reset() {
+	w.off = 0
+	w.w.Reset()
+}
+
+// contentChannelVerifier decrypts received chunks before checking them with
+// the swarm's integrity verifier. Signatures cover the plaintext so only peers
+// holding the content key can verify and store data.
+type contentChannelVerifier struct {
+	integrity.ChannelVerifier
+	cipher        *contentCipher
+	chunkSize     uint64
+	chunkVerifier contentChunkVerifier
+	buf           []byte
+}
+
+// ChunkVerifier ...
+func (v *contentChannelVerifier) ChunkVerifier(b binmap.Bin) integrity.ChunkVerifier {
+	v.chunkVerifier.ChunkVerifier = v.ChannelVerifier.ChunkVerifier(b)
+	v.chunkVerifier.channelVerifier = v
+	return &v.chunkVerifier
+}
+
+type contentChunkVerifier struct {
+	integrity.ChunkVerifier
+	channelVerifier *contentChannelVerifier
+}
+
+// Verify ...
+func (v *contentChunkVerifier) Verify(b binmap.Bin, d []byte) (bool, error) {
+	cv := v.channelVerifier
+	if cap(cv.buf) < len(d) {
+		cv.buf = make([]byte, len(d))
+	}
+	buf := cv.buf[:len(d)]
+	if err := cv.cipher.XORKeyStreamAt(buf, d, b.BaseOffset()*cv.chunkSize); err != nil {
+		return false, err
+	}
+	return v.ChunkVerifier.Verify(b, buf)
+}
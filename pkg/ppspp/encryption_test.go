@@ -0,0 +1,66 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package ppspp
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/MemeLabs/strims/pkg/ppspp/integrity"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestContentCipher(t *testing.T, epoch timeutil.Time) *contentCipher {
+	e := newEpoch(integrity.NewED25519Verifier(make([]byte, 32)))
+	e.Timestamp = epoch
+
+	c, err := newContentCipher(AES256CTRContentEncryptionMethod, &e)
+	assert.NoError(t, err)
+	return c
+}
+
+func TestContentCipherXORKeyStreamAt(t *testing.T) {
+	c := newTestContentCipher(t, timeutil.Now())
+	assert.ErrorIs(t, c.XORKeyStreamAt(nil, nil, 0), ErrContentKeyNotSet)
+
+	k, err := NewContentKey()
+	assert.NoError(t, err)
+	assert.NoError(t, c.SetKey(k))
+
+	src := bytes.Repeat([]byte{1, 2, 3, 4, 5, 6, 7}, 100)
+	dst := make([]byte, len(src))
+	assert.NoError(t, c.XORKeyStreamAt(dst, src, 0))
+	assert.NotEqual(t, src, dst)
+
+	for _, off := range []int{0, 1, 15, 16, 17, 100, 333} {
+		b := make([]byte, len(src)-off)
+		assert.NoError(t, c.XORKeyStreamAt(b, dst[off:], uint64(off)))
+		assert.Equal(t, src[off:], b, "offset %d", off)
+	}
+}
+
+func TestContentCipherEpochKeyStream(t *testing.T) {
+	k, err := NewContentKey()
+	assert.NoError(t, err)
+
+	a := newTestContentCipher(t, timeutil.New(1))
+	b := newTestContentCipher(t, timeutil.New(2))
+	assert.NoError(t, a.SetKey(k))
+	assert.NoError(t, b.SetKey(k))
+
+	src := make([]byte, 64)
+	da := make([]byte, len(src))
+	db := make([]byte, len(src))
+	assert.NoError(t, a.XORKeyStreamAt(da, src, 0))
+	assert.NoError(t, b.XORKeyStreamAt(db, src, 0))
+	assert.NotEqual(t, da, db, "key stream should change with the epoch")
+}
+
+func TestContentCipherInvalidKey(t *testing.T) {
+	c := newTestContentCipher(t, timeutil.Now())
+	assert.ErrorIs(t, c.SetKey(make([]byte, 16)), errInvalidContentKeySize)
+	_, ok := c.Key()
+	assert.False(t, ok)
+}
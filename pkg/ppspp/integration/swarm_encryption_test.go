@@ -0,0 +1,133 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package integration
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/MemeLabs/strims/pkg/ppspp"
+	"github.com/MemeLabs/strims/pkg/ppspp/ppspptest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSwarmContentEncryption(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	key := ppspptest.Key()
+	options := ppspp.SwarmOptions{
+		LiveWindow:              1 << 12,
+		ChunkSize:               1024,
+		ChunksPerSignature:      16,
+		ContentEncryptionMethod: ppspp.AES256CTRContentEncryptionMethod,
+	}
+
+	src, err := ppspp.NewWriter(ppspp.WriterOptions{
+		SwarmOptions: options,
+		Key:          key,
+	})
+	assert.NoError(t, err, "writer constructor failed")
+
+	contentKey, ok := src.Swarm().ContentKey()
+	assert.True(t, ok, "writer should generate a content key")
+
+	dst, err := ppspp.NewSwarm(ppspp.NewSwarmID(key.Public), options)
+	assert.NoError(t, err, "swarm constructor failed")
+	assert.NoError(t, dst.SetContentKey(contentKey))
+
+	logger := ppspptest.Logger()
+	srcConn, dstConn := ppspptest.NewConnPair()
+	srcReader, srcPeer := ppspp.NewRunner(ctx, logger).RunPeer([]byte("src"), srcConn)
+	dstReader, dstPeer := ppspp.NewRunner(ctx, logger).RunPeer([]byte("dst"), dstConn)
+	assert.NoError(t, srcPeer.RunSwarm(src.Swarm(), 1, 1), "channel open failed")
+	assert.NoError(t, dstPeer.RunSwarm(dst, 1, 1), "channel open failed")
+	go ppspptest.ReadChannelConn(srcConn, srcReader)
+	go ppspptest.ReadChannelConn(dstConn, dstReader)
+
+	chunk := bytes.Repeat([]byte{0xaa}, options.ChunkSize)
+	go func() {
+		ticker := time.NewTicker(10 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				for i := 0; i < options.ChunksPerSignature; i++ {
+					src.Write(chunk)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	done := make(chan []byte, 1)
+	go func() {
+		b := make([]byte, options.ChunkSize*options.ChunksPerSignature*4)
+		_, err := io.ReadFull(dst.Reader(), b)
+		assert.NoError(t, err)
+		done <- b
+	}()
+
+	select {
+	case b := <-done:
+		assert.Equal(t, bytes.Repeat(chunk, len(b)/len(chunk)), b)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out reading from peer")
+	}
+
+	cache, err := src.Swarm().ExportCache()
+	if assert.NoError(t, err) {
+		assert.False(t, bytes.Contains(cache.Data, chunk[:32]), "stored data should be encrypted")
+
+		imported, err := ppspp.NewSwarm(ppspp.NewSwarmID(key.Public), options)
+		assert.NoError(t, err, "swarm constructor failed")
+		assert.ErrorIs(t, imported.ImportCache(cache), ppspp.ErrContentKeyNotSet)
+		assert.NoError(t, imported.SetContentKey(contentKey))
+		assert.NoError(t, imported.ImportCache(cache))
+	}
+}
+
+func TestSwarmContentEncryptionWithoutKey(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	key := ppspptest.Key()
+	options := ppspp.SwarmOptions{
+		LiveWindow:              1 << 12,
+		ChunkSize:               1024,
+		ChunksPerSignature:      16,
+		ContentEncryptionMethod: ppspp.AES256CTRContentEncryptionMethod,
+	}
+
+	src, err := ppspp.NewWriter(ppspp.WriterOptions{
+		SwarmOptions: options,
+		Key:          key,
+	})
+	assert.NoError(t, err, "writer constructor failed")
+
+	dst, err := ppspp.NewSwarm(ppspp.NewSwarmID(key.Public), options)
+	assert.NoError(t, err, "swarm constructor failed")
+
+	logger := ppspptest.Logger()
+	srcConn, dstConn := ppspptest.NewConnPair()
+	srcReader, srcPeer := ppspp.NewRunner(ctx, logger).RunPeer([]byte("src"), srcConn)
+	dstReader, dstPeer := ppspp.NewRunner(ctx, logger).RunPeer([]byte("dst"), dstConn)
+	assert.NoError(t, srcPeer.RunSwarm(src.Swarm(), 1, 1), "channel open failed")
+	assert.NoError(t, dstPeer.RunSwarm(dst, 1, 1), "channel open failed")
+	go ppspptest.ReadChannelConn(srcConn, srcReader)
+	go ppspptest.ReadChannelConn(dstConn, dstReader)
+
+	chunk := make([]byte, options.ChunkSize*options.ChunksPerSignature)
+	for i := 0; i < 4; i++ {
+		src.Write(chunk)
+	}
+
+	time.Sleep(500 * time.Millisecond)
+	_, err = dst.ExportCache()
+	assert.Error(t, err, "peer without the content key should not store data")
+}
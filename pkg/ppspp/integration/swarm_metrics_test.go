@@ -0,0 +1,85 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package integration
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/MemeLabs/strims/pkg/ppspp"
+	"github.com/MemeLabs/strims/pkg/ppspp/ppspptest"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSwarmMetricsSnapshot(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	key := ppspptest.Key()
+	options := ppspp.SwarmOptions{LiveWindow: 1 << 12}
+
+	src, err := ppspp.NewWriter(ppspp.WriterOptions{
+		SwarmOptions: options,
+		Key:          key,
+	})
+	assert.NoError(t, err, "writer constructor failed")
+
+	dst, err := ppspp.NewSwarm(ppspp.NewSwarmID(key.Public), options)
+	assert.NoError(t, err, "swarm constructor failed")
+
+	logger := ppspptest.Logger()
+	srcConn, dstConn := ppspptest.NewConnPair()
+	srcReader, srcPeer := ppspp.NewRunner(ctx, logger).RunPeer([]byte("src"), srcConn)
+	dstReader, dstPeer := ppspp.NewRunner(ctx, logger).RunPeer([]byte("dst"), dstConn)
+	assert.NoError(t, srcPeer.RunSwarm(src.Swarm(), 1, 1), "channel open failed")
+	assert.NoError(t, dstPeer.RunSwarm(dst, 1, 1), "channel open failed")
+	go ppspptest.ReadChannelConn(srcConn, srcReader)
+	go ppspptest.ReadChannelConn(dstConn, dstReader)
+
+	go func() {
+		b := make([]byte, 16*1024)
+		ticker := time.NewTicker(10 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				rand.Read(b)
+				src.Write(b)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := io.CopyN(io.Discard, dst.Reader(), 256*1024)
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out reading from peer")
+	}
+
+	snap, ok := dstPeer.MetricsSnapshot(timeutil.Now()).Swarms[dst]
+	if assert.True(t, ok, "snapshot should include the swarm") {
+		assert.Greater(t, snap.Read.Count, uint64(0))
+		assert.Greater(t, snap.Scheduler.ReceivedChunks, uint64(0))
+		assert.GreaterOrEqual(t, snap.Scheduler.RequestedChunks, snap.Scheduler.ReceivedChunks)
+		assert.Zero(t, snap.Scheduler.InvalidChunks)
+		assert.Greater(t, snap.Scheduler.CWND, 0)
+	}
+
+	snap, ok = srcPeer.MetricsSnapshot(timeutil.Now()).Swarms[src.Swarm()]
+	if assert.True(t, ok, "snapshot should include the swarm") {
+		assert.Greater(t, snap.Write.Count, uint64(0))
+	}
+}
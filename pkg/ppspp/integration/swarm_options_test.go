@@ -0,0 +1,87 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package integration
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/MemeLabs/strims/pkg/ppspp"
+	"github.com/MemeLabs/strims/pkg/ppspp/codec"
+	"github.com/MemeLabs/strims/pkg/ppspp/ppspptest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSwarmChunkRangeVariableChunkSize(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	key := ppspptest.Key()
+	options := ppspp.SwarmOptions{
+		LiveWindow:            1 << 12,
+		ChunkSize:             1024,
+		ChunksPerSignature:    16,
+		ChunkAddressingMethod: codec.ChunkRangeChunkAddressingMethod,
+		VariableChunkSize:     true,
+	}
+
+	src, err := ppspp.NewWriter(ppspp.WriterOptions{
+		SwarmOptions: options,
+		Key:          key,
+	})
+	assert.NoError(t, err, "writer constructor failed")
+
+	dst, err := ppspp.NewSwarm(ppspp.NewSwarmID(key.Public), options)
+	assert.NoError(t, err, "swarm constructor failed")
+
+	logger := ppspptest.Logger()
+	srcConn, dstConn := ppspptest.NewConnPair()
+	srcReader, srcPeer := ppspp.NewRunner(ctx, logger).RunPeer([]byte("src"), srcConn)
+	dstReader, dstPeer := ppspp.NewRunner(ctx, logger).RunPeer([]byte("dst"), dstConn)
+	assert.NoError(t, srcPeer.RunSwarm(src.Swarm(), 1, 1), "channel open failed")
+	assert.NoError(t, dstPeer.RunSwarm(dst, 1, 1), "channel open failed")
+	go ppspptest.ReadChannelConn(srcConn, srcReader)
+	go ppspptest.ReadChannelConn(dstConn, dstReader)
+
+	// each flush pads the last chunk of the record and the rest of the
+	// signature segment with zeros that aren't sent to peers or read.
+	record := make([]byte, options.ChunkSize*3/2)
+	for i := range record {
+		record[i] = byte(i%251 + 1)
+	}
+	go func() {
+		ticker := time.NewTicker(10 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				for i := 0; i < 4; i++ {
+					src.Write(record)
+					src.Flush()
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	done := make(chan []byte, 1)
+	go func() {
+		b := make([]byte, len(record)*20)
+		_, err := io.ReadFull(dst.Reader(), b)
+		assert.NoError(t, err)
+		done <- b
+	}()
+
+	select {
+	case b := <-done:
+		for i := 0; i < len(b); i += len(record) {
+			assert.Equal(t, record, b[i:i+len(record)], "record %d", i/len(record))
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out reading from peer")
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package integration
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MemeLabs/strims/pkg/ppspp"
+	"github.com/MemeLabs/strims/pkg/ppspp/ppspptest"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSwarmSuperSeed(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	key := ppspptest.Key()
+	// the live window leaves readers time to catch up when bins spread slowly
+	options := ppspp.SwarmOptions{
+		LiveWindow: 1 << 14,
+	}
+
+	src, err := ppspp.NewWriter(ppspp.WriterOptions{
+		SwarmOptions: ppspp.SwarmOptions{
+			LiveWindow:       options.LiveWindow,
+			SchedulingMethod: ppspp.SuperSeedSchedulingMethod,
+		},
+		Key: key,
+	})
+	assert.NoError(t, err, "writer constructor failed")
+
+	swarms := []*ppspp.Swarm{src.Swarm()}
+	for i := 0; i < 3; i++ {
+		swarm, err := ppspp.NewSwarm(ppspp.NewSwarmID(key.Public), options)
+		assert.NoError(t, err, "swarm constructor failed")
+		swarms = append(swarms, swarm)
+	}
+
+	logger := ppspptest.Logger()
+	ids := make([][]byte, len(swarms))
+	runners := make([]*ppspp.Runner, len(swarms))
+	for i := range swarms {
+		ids[i] = make([]byte, 64)
+		rand.Read(ids[i])
+		runners[i] = ppspp.NewRunner(ctx, logger)
+	}
+
+	var srcPeers []*ppspp.RunnerPeer
+	for i := 0; i < len(swarms); i++ {
+		for j := i + 1; j < len(swarms); j++ {
+			iConn, jConn := ppspptest.NewConnPair()
+			iReader, iPeer := runners[i].RunPeer(ids[i], iConn)
+			jReader, jPeer := runners[j].RunPeer(ids[j], jConn)
+			assert.NoError(t, iPeer.RunSwarm(swarms[i], 1, 1), "channel open failed")
+			assert.NoError(t, jPeer.RunSwarm(swarms[j], 1, 1), "channel open failed")
+			go ppspptest.ReadChannelConn(iConn, iReader)
+			go ppspptest.ReadChannelConn(jConn, jReader)
+
+			if i == 0 {
+				srcPeers = append(srcPeers, iPeer)
+			}
+		}
+	}
+
+	var produced uint64
+	writeCtx, stopWriting := context.WithCancel(ctx)
+	writeDone := make(chan struct{})
+	go func() {
+		defer close(writeDone)
+		ticker := time.NewTicker(10 * time.Millisecond)
+		defer ticker.Stop()
+		b := make([]byte, 16*1024)
+		for {
+			select {
+			case <-ticker.C:
+				src.Write(b)
+				produced += uint64(len(b))
+			case <-writeCtx.Done():
+				return
+			}
+		}
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		var wg sync.WaitGroup
+		for _, swarm := range swarms[1:] {
+			wg.Add(1)
+			go func(swarm *ppspp.Swarm) {
+				defer wg.Done()
+				_, err := io.CopyN(io.Discard, swarm.Reader(), 1<<20)
+				assert.NoError(t, err)
+			}(swarm)
+		}
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(20 * time.Second):
+		t.Fatal("timed out reading from peers")
+	}
+
+	stopWriting()
+	<-writeDone
+
+	// a seed sends every chunk to each of the 3 peers. the super-seed should
+	// leave a third or more of that to the peers even when they are slow to
+	// redistribute chunks.
+	var written uint64
+	now := timeutil.Now()
+	for _, p := range srcPeers {
+		written += p.MetricsSnapshot(now).Swarms[src.Swarm()].Write.Count
+	}
+	assert.Less(t, written, produced*2, "expected peers to redistribute the data")
+}
@@ -276,9 +276,16 @@ type SwarmVerifier interface {
 	ChannelVerifier() ChannelVerifier
 	ImportCache(c *swarmpb.Cache) error
 	ExportCache() *swarmpb.Cache_Integrity
+	SignedBins() []SignedBin
 	Reset()
 }
 
+// SignedBin is a bin covered by a signature and the signed timestamp.
+type SignedBin struct {
+	Bin       binmap.Bin
+	Timestamp timeutil.Time
+}
+
 // ChannelVerifier ...
 type ChannelVerifier interface {
 	ChunkVerifier(b binmap.Bin) ChunkVerifier
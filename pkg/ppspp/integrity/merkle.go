@@ -217,6 +217,25 @@ func (v *MerkleSwarmVerifier) ExportCache() *swarmpb.Cache_Integrity {
 	return &swarmpb.Cache_Integrity{MerkleIntegrity: c}
 }
 
+// SignedBins returns the root bins and timestamps of the retained segments in
+// ascending order.
+func (v *MerkleSwarmVerifier) SignedBins() []SignedBin {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	bins := make([]SignedBin, 0, v.head-v.tail)
+	for i := v.tail; i < v.head; i++ {
+		if s := v.segments[i&v.mask]; s != nil {
+			s.Lock()
+			if s.Tree != nil {
+				bins = append(bins, SignedBin{s.Tree.RootBin(), s.Timestamp})
+			}
+			s.Unlock()
+		}
+	}
+	return bins
+}
+
 func (v *MerkleSwarmVerifier) Reset() {
 	v.lock.Lock()
 	defer v.lock.Unlock()
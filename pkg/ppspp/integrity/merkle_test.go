@@ -126,6 +126,12 @@ func TestMerkleVerifier(t *testing.T) {
 	for i := 0; i < 3; i++ {
 		t.Run(fmt.Sprintf("gen: %d", i), func(t *testing.T) {
 			src = verify(t, src)
+
+			bins := src.SignedBins()
+			assert.Len(t, bins, n/chunkSize/chunksPerSignature)
+			for i, b := range bins {
+				assert.Equal(t, binmap.Bin(i*chunksPerSignature*2).LayerShift(5), b.Bin)
+			}
 		})
 	}
 }
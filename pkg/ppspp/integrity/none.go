@@ -71,6 +71,10 @@ func (v *NoneSwarmVerifier) ExportCache() *swarmpb.Cache_Integrity {
 	return &swarmpb.Cache_Integrity{}
 }
 
+func (v *NoneSwarmVerifier) SignedBins() []SignedBin {
+	return nil
+}
+
 func (v *NoneSwarmVerifier) Reset() {}
 
 // NoneChannelVerifier ...
@@ -152,6 +152,21 @@ func (v *SignAllSwarmVerifier) ExportCache() *swarmpb.Cache_Integrity {
 	return &swarmpb.Cache_Integrity{SignAllIntegrity: c}
 }
 
+// SignedBins returns the retained signed chunks and their timestamps in
+// ascending order.
+func (v *SignAllSwarmVerifier) SignedBins() []SignedBin {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	var bins []SignedBin
+	for b := v.tail; b < v.head; b += 2 {
+		if t := v.timestamps[uint64(b>>1)&v.mask]; t != 0 {
+			bins = append(bins, SignedBin{b, t})
+		}
+	}
+	return bins
+}
+
 func (v *SignAllSwarmVerifier) Reset() {
 	v.lock.Lock()
 	defer v.lock.Unlock()
@@ -20,6 +20,18 @@ type SwarmOptions struct {
 	SchedulingMethod   SchedulingMethod
 	DeliveryMode       DeliveryMode
 	BufferLayout       store.BufferLayout
+	// BufferDir is the directory for DiskBufferLayout buffer files.
+	BufferDir string
+	// ChunkAddressingMethod selects whether chunks are addressed by bin or by
+	// chunk range on the wire.
+	ChunkAddressingMethod codec.ChunkAddressingMethod
+	// VariableChunkSize makes ChunkSize the maximum chunk size. The zero
+	// padding at the end of chunks isn't transferred or read. It can't be
+	// combined with a ContentEncryptionMethod.
+	VariableChunkSize bool
+	// ContentEncryptionMethod encrypts chunk data with a content key that
+	// peers must obtain out of band before they can verify or read it.
+	ContentEncryptionMethod ContentEncryptionMethod
 }
 
 // IntegrityVerifierOptions ...
@@ -44,7 +56,7 @@ func (o SwarmOptions) IntegrityWriterOptions() integrity.SwarmWriterOptions {
 
 // URIOptions ...
 func (o SwarmOptions) URIOptions() URIOptions {
-	return URIOptions{
+	u := URIOptions{
 		codec.ChunkSizeOption:                        o.ChunkSize,
 		codec.ChunksPerSignatureOption:               o.ChunksPerSignature,
 		codec.StreamCountOption:                      o.StreamCount,
@@ -52,6 +64,16 @@ func (o SwarmOptions) URIOptions() URIOptions {
 		codec.MerkleHashTreeFunctionOption:           int(o.Integrity.MerkleHashTreeFunction),
 		codec.LiveSignatureAlgorithmOption:           int(o.Integrity.LiveSignatureAlgorithm),
 	}
+	if o.ChunkAddressingMethod != codec.BinChunkAddressingMethod {
+		u[codec.ChunkAddressingMethodOption] = int(o.ChunkAddressingMethod)
+	}
+	if o.VariableChunkSize {
+		u[codec.VariableChunkSizeOption] = 1
+	}
+	if o.ContentEncryptionMethod != NoneContentEncryptionMethod {
+		u[codec.ContentEncryptionMethodOption] = int(o.ContentEncryptionMethod)
+	}
+	return u
 }
 
 // NewDefaultSwarmOptions ...
@@ -31,7 +31,7 @@ const (
 	peerPriorityLow  peerPriority = 1
 )
 
-func newPeer(id []byte, w Conn, t timeutil.Ticker) *peer {
+func newPeer(id []byte, w Conn, t timeutil.Ticker, manual bool) *peer {
 	p := &peer{
 		id:     id,
 		w:      w,
@@ -42,15 +42,18 @@ func newPeer(id []byte, w Conn, t timeutil.Ticker) *peer {
 
 		m: newPeerMetrics(),
 	}
-	go p.run()
+	if !manual {
+		go p.run()
+	}
 	return p
 }
 
 type peer struct {
 	id []byte
 
-	ready  chan timeutil.Time
-	ticker timeutil.Ticker
+	ready   chan timeutil.Time
+	ticker  timeutil.Ticker
+	pending bool
 
 	lock sync.Mutex
 	w    Conn
@@ -153,6 +156,49 @@ func (p *peer) run() {
 	}
 }
 
+// step runs the writes that run would perform for the signals queued since
+// the last call. Writes waiting for the ticker only run when tick is set.
+func (p *peer) step(tick bool) error {
+	for {
+		var run bool
+	Drain:
+		for {
+			select {
+			case t, ok := <-p.ready:
+				if !ok {
+					return nil
+				}
+				if t.IsNil() {
+					p.pending = true
+				} else {
+					run = true
+				}
+			default:
+				break Drain
+			}
+		}
+
+		if tick && p.pending {
+			run = true
+			tick = false
+		}
+		if !run {
+			return nil
+		}
+		p.pending = false
+
+		for {
+			idle, err := p.write()
+			if err != nil {
+				return err
+			}
+			if idle {
+				break
+			}
+		}
+	}
+}
+
 func (p *peer) write() (bool, error) {
 	p.lock.Lock()
 	pws := p.rq.Detach()
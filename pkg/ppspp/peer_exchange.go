@@ -0,0 +1,49 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package ppspp
+
+import "sync"
+
+// peerExchange fans out the ids of peers learned from PEX responses to
+// subscribers outside the scheduler.
+type peerExchange struct {
+	lock sync.Mutex
+	chs  map[chan<- []byte]struct{}
+}
+
+func (p *peerExchange) notify(ch chan<- []byte) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.chs == nil {
+		p.chs = map[chan<- []byte]struct{}{}
+	}
+	p.chs[ch] = struct{}{}
+}
+
+func (p *peerExchange) stopNotifying(ch chan<- []byte) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	delete(p.chs, ch)
+}
+
+// emit is called while the scheduler is locked so sends never block. the ids
+// are hints and dropping them when a subscriber falls behind is harmless.
+func (p *peerExchange) emit(id []byte) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if len(p.chs) == 0 {
+		return
+	}
+
+	id = append([]byte(nil), id...)
+	for ch := range p.chs {
+		select {
+		case ch <- id:
+		default:
+		}
+	}
+}
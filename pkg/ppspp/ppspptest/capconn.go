@@ -264,10 +264,13 @@ func ReadCapLogSize(r io.Reader, size int, f func() CapLogHandler) error {
 	ws := map[uint64]io.WriteCloser{}
 	b := make([]byte, size)
 
+	// wait for the parsers to drain so handlers are complete when we return
+	var wg sync.WaitGroup
 	defer func() {
 		for _, w := range ws {
 			w.Close()
 		}
+		wg.Wait()
 	}()
 
 	for {
@@ -282,9 +285,12 @@ func ReadCapLogSize(r io.Reader, size int, f func() CapLogHandler) error {
 			r, w = io.Pipe()
 			ws[i] = w
 
+			p := &capLogParser{f()}
+			wg.Add(1)
 			go func() {
-				(&capLogParser{f()}).Parse(r)
+				p.Parse(r)
 				r.Close()
+				wg.Done()
 			}()
 		}
 
@@ -0,0 +1,722 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package ppspptest
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MemeLabs/strims/pkg/ppspp"
+	"github.com/MemeLabs/strims/pkg/ppspp/codec"
+	"github.com/MemeLabs/strims/pkg/ppspp/integrity"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+	"go.uber.org/zap"
+)
+
+const defaultCapLogReplayTail = time.Second
+
+// CapLogEvent is a single CapConn event. Time is relative to the session
+// init event.
+type CapLogEvent struct {
+	Code uint8
+	Time time.Duration
+	Data []byte
+	Err  error
+}
+
+// CapLogSession is the event log for one side of a captured conn.
+type CapLogSession struct {
+	Label  string
+	Start  time.Time
+	Events []CapLogEvent
+}
+
+// LoadCapLog reads every session from a cap log in the order they were
+// created.
+func LoadCapLog(r io.Reader) ([]*CapLogSession, error) {
+	var hs []*capLogSessionHandler
+	err := ReadCapLog(r, func() CapLogHandler {
+		h := &capLogSessionHandler{}
+		hs = append(hs, h)
+		return h
+	})
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, err
+	}
+
+	ss := make([]*CapLogSession, len(hs))
+	for i, h := range hs {
+		ss[i] = &h.CapLogSession
+	}
+	return ss, nil
+}
+
+type capLogSessionHandler struct {
+	CapLogSession
+}
+
+func (h *capLogSessionHandler) append(code uint8, t time.Time, p []byte, err error) {
+	h.Events = append(h.Events, CapLogEvent{
+		Code: code,
+		Time: t.Sub(h.Start),
+		Data: p,
+		Err:  err,
+	})
+}
+
+func (h *capLogSessionHandler) HandleInit(t time.Time, label string) {
+	h.Start = t
+	h.Label = label
+	h.append(CapConnInit, t, nil, nil)
+}
+
+func (h *capLogSessionHandler) HandleEOF() {}
+
+func (h *capLogSessionHandler) HandleWrite(t time.Time, p []byte) {
+	h.append(CapConnWrite, t, p, nil)
+}
+
+func (h *capLogSessionHandler) HandleWriteErr(t time.Time, err error) {
+	h.append(CapConnWriteErr, t, nil, err)
+}
+
+func (h *capLogSessionHandler) HandleFlush(t time.Time) {
+	h.append(CapConnFlush, t, nil, nil)
+}
+
+func (h *capLogSessionHandler) HandleFlushErr(t time.Time, err error) {
+	h.append(CapConnFlushErr, t, nil, err)
+}
+
+func (h *capLogSessionHandler) HandleRead(t time.Time, p []byte) {
+	h.append(CapConnRead, t, p, nil)
+}
+
+func (h *capLogSessionHandler) HandleReadErr(t time.Time, err error) {
+	h.append(CapConnReadErr, t, nil, err)
+}
+
+// FindCapLogSession returns the session with the given label.
+func FindCapLogSession(ss []*CapLogSession, label string) (*CapLogSession, bool) {
+	for _, s := range ss {
+		if s.Label == label {
+			return s, true
+		}
+	}
+	return nil, false
+}
+
+// Duration returns the time of the last event in the session.
+func (s *CapLogSession) Duration() time.Duration {
+	if len(s.Events) == 0 {
+		return 0
+	}
+	return s.Events[len(s.Events)-1].Time
+}
+
+// Messages decodes the messages read or written in the session. code must be
+// CapConnRead or CapConnWrite.
+func (s *CapLogSession) Messages(code uint8) ([]CapLogMessage, error) {
+	d := newCapLogDecoder()
+	for _, e := range s.Events {
+		if e.Code == code {
+			if err := d.Decode(e.Time, e.Data); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return d.messages, nil
+}
+
+// CapLogMessage is a ppspp message decoded from a cap log.
+type CapLogMessage struct {
+	Time    time.Duration
+	Channel codec.Channel
+	Type    codec.MessageType
+	Address uint64
+	// DataLen is the chunk payload size for data messages.
+	DataLen int
+	// Handshake is set for handshake messages.
+	Handshake *codec.Handshake
+	// Message is set for the handshake, integrity and data messages used to
+	// rebuild swarm state.
+	Message codec.Message
+}
+
+func (m CapLogMessage) String() string {
+	return fmt.Sprintf("%12s ch:%d %s %d", m.Time, m.Channel, m.Type, m.Address)
+}
+
+func newCapLogDecoder() *capLogDecoder {
+	d := &capLogDecoder{}
+	d.r.Handler = d
+
+	// the epoch option in the first handshake is signed so decoding it needs
+	// a signature size before the handshake's options are known.
+	o := ppspp.NewDefaultSwarmOptions()
+	d.r.ChunkSize = o.ChunkSize
+	d.r.IntegrityHashSize = o.Integrity.MerkleHashTreeFunction.HashSize()
+	d.r.IntegritySignatureSize = o.Integrity.LiveSignatureAlgorithm.SignatureSize()
+	return d
+}
+
+// capLogDecoder reassembles channel frames split across conn reads or writes
+// and decodes the messages they contain.
+type capLogDecoder struct {
+	buf      []byte
+	r        codec.Reader
+	t        time.Duration
+	channel  codec.Channel
+	messages []CapLogMessage
+}
+
+func (d *capLogDecoder) Decode(t time.Duration, p []byte) error {
+	d.buf = append(d.buf, p...)
+	d.t = t
+
+	for {
+		channel, n := binary.Uvarint(d.buf)
+		if n <= 0 || len(d.buf) < n+2 {
+			return nil
+		}
+		length := int(binary.BigEndian.Uint16(d.buf[n:]))
+		n += 2
+		if len(d.buf) < n+length {
+			return nil
+		}
+
+		d.channel = codec.Channel(channel)
+		if _, err := d.r.Read(d.buf[n : n+length]); err != nil {
+			return fmt.Errorf("decoding message at %s failed: %w", t, err)
+		}
+		d.buf = d.buf[n+length:]
+	}
+}
+
+func (d *capLogDecoder) append(t codec.MessageType, a uint64) {
+	d.messages = append(d.messages, CapLogMessage{
+		Time:    d.t,
+		Channel: d.channel,
+		Type:    t,
+		Address: a,
+	})
+}
+
+func (d *capLogDecoder) HandleHandshake(v codec.Handshake) error {
+	if opt, ok := v.Options.Find(codec.ChunkSizeOption); ok {
+		d.r.ChunkSize = int(opt.(*codec.ChunkSizeProtocolOption).Value)
+	}
+	if opt, ok := v.Options.Find(codec.MerkleHashTreeFunctionOption); ok {
+		d.r.IntegrityHashSize = integrity.MerkleHashTreeFunction(opt.(*codec.MerkleHashTreeFunctionProtocolOption).Value).HashSize()
+	}
+	if opt, ok := v.Options.Find(codec.LiveSignatureAlgorithmOption); ok {
+		d.r.IntegritySignatureSize = integrity.LiveSignatureAlgorithm(opt.(*codec.LiveSignatureAlgorithmProtocolOption).Value).SignatureSize()
+	}
+	if opt, ok := v.Options.Find(codec.ChunkAddressingMethodOption); ok {
+		d.r.ChunkAddressingMethod = codec.ChunkAddressingMethod(opt.(*codec.ChunkAddressingMethodProtocolOption).Value)
+	}
+	_, d.r.VariableChunkSize = v.Options.Find(codec.VariableChunkSizeOption)
+	d.append(codec.HandshakeMessage, 0)
+	d.messages[len(d.messages)-1].Handshake = &v
+	d.messages[len(d.messages)-1].Message = &v
+	return nil
+}
+
+func (d *capLogDecoder) HandleRestart(v codec.Restart) error {
+	d.append(codec.RestartMessage, 0)
+	return nil
+}
+
+func (d *capLogDecoder) HandleData(v codec.Data) error {
+	d.append(codec.DataMessage, uint64(v.Address))
+	d.messages[len(d.messages)-1].DataLen = v.Data.ByteLen()
+	v.Data = append(codec.Buffer(nil), v.Data...)
+	d.messages[len(d.messages)-1].Message = &v
+	return nil
+}
+
+func (d *capLogDecoder) HandleAck(v codec.Ack) error {
+	d.append(codec.AckMessage, uint64(v.Address))
+	return nil
+}
+
+func (d *capLogDecoder) HandleHave(v codec.Have) error {
+	d.append(codec.HaveMessage, uint64(v.Address))
+	return nil
+}
+
+func (d *capLogDecoder) HandleIntegrity(v codec.Integrity) error {
+	d.append(codec.IntegrityMessage, uint64(v.Address))
+	v.Hash = append(codec.Buffer(nil), v.Hash...)
+	d.messages[len(d.messages)-1].Message = &v
+	return nil
+}
+
+func (d *capLogDecoder) HandleSignedIntegrity(v codec.SignedIntegrity) error {
+	d.append(codec.SignedIntegrityMessage, uint64(v.Address))
+	v.Signature = append(codec.Buffer(nil), v.Signature...)
+	d.messages[len(d.messages)-1].Message = &v
+	return nil
+}
+
+func (d *capLogDecoder) HandleRequest(v codec.Request) error {
+	d.append(codec.RequestMessage, uint64(v.Address))
+	return nil
+}
+
+func (d *capLogDecoder) HandleCancel(v codec.Cancel) error {
+	d.append(codec.CancelMessage, uint64(v.Address))
+	return nil
+}
+
+func (d *capLogDecoder) HandleChoke(v codec.Choke) error {
+	d.append(codec.ChokeMessage, 0)
+	return nil
+}
+
+func (d *capLogDecoder) HandleUnchoke(v codec.Unchoke) error {
+	d.append(codec.UnchokeMessage, 0)
+	return nil
+}
+
+func (d *capLogDecoder) HandlePing(v codec.Ping) error {
+	d.append(codec.PingMessage, v.Nonce.Value)
+	return nil
+}
+
+func (d *capLogDecoder) HandlePong(v codec.Pong) error {
+	d.append(codec.PongMessage, v.Nonce.Value)
+	return nil
+}
+
+func (d *capLogDecoder) HandleStreamRequest(v codec.StreamRequest) error {
+	d.append(codec.StreamRequestMessage, uint64(v.Stream))
+	return nil
+}
+
+func (d *capLogDecoder) HandleStreamCancel(v codec.StreamCancel) error {
+	d.append(codec.StreamCancelMessage, uint64(v.Stream))
+	return nil
+}
+
+func (d *capLogDecoder) HandleStreamOpen(v codec.StreamOpen) error {
+	d.append(codec.StreamOpenMessage, uint64(v.Stream))
+	return nil
+}
+
+func (d *capLogDecoder) HandleStreamClose(v codec.StreamClose) error {
+	d.append(codec.StreamCloseMessage, uint64(v.Stream))
+	return nil
+}
+
+func (d *capLogDecoder) HandlePexRequest(v codec.PexRequest) error {
+	d.append(codec.PexRequestMessage, 0)
+	return nil
+}
+
+func (d *capLogDecoder) HandlePexResponse(v codec.PexResponse) error {
+	d.append(codec.PexResponseMessage, 0)
+	return nil
+}
+
+// CapLogReplayOptions ...
+type CapLogReplayOptions struct {
+	// SchedulingMethod is used by the replayed swarm. Defaults to
+	// ppspp.PeerSchedulingMethod.
+	SchedulingMethod ppspp.SchedulingMethod
+	// Tail is how long to keep recording after the last read is replayed.
+	Tail time.Duration
+	// RandSeed seeds the scheduler's random choices. Defaults to 1.
+	RandSeed int64
+	Logger   *zap.Logger
+}
+
+// ReplayCapLogSession feeds the data read in s to a peer running the ppspp
+// scheduler built into this binary and records everything it writes. The
+// runner, the peer and the replay are driven by a fake clock starting at the
+// captured session's start so replaying a session always produces the same
+// writes. The swarm is seeded with the chunks exchanged in s so captures from
+// seeding peers can be replayed. The input sequence is fixed so changes in the
+// returned writes can be attributed to the peer rather than the remote. Replay
+// is open loop: the remote does not react to the peer's writes.
+func ReplayCapLogSession(s *CapLogSession, opt CapLogReplayOptions) (*CapLogSession, error) {
+	if opt.SchedulingMethod == 0 {
+		opt.SchedulingMethod = ppspp.PeerSchedulingMethod
+	}
+	if opt.Tail <= 0 {
+		opt.Tail = defaultCapLogReplayTail
+	}
+	if opt.RandSeed == 0 {
+		opt.RandSeed = 1
+	}
+	if opt.Logger == nil {
+		opt.Logger = zap.NewNop()
+	}
+
+	start := timeutil.NewFromTime(s.Start).Truncate(timeutil.Precision)
+	clock := timeutil.InstallFakeClock(start, timeutil.DefaultTickEmitter.Interval())
+	defer clock.Uninstall()
+
+	swarm, channel, peerChannel, err := NewCapLogReplaySwarm(s, opt.SchedulingMethod)
+	if err != nil {
+		return nil, err
+	}
+	defer swarm.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	runner := ppspp.NewRunnerWithOptions(ctx, opt.Logger, ppspp.RunnerOptions{
+		TickEmitter: clock.TickEmitter(),
+		Manual:      true,
+		RandSeed:    opt.RandSeed,
+	})
+
+	c := &capLogReplayConn{
+		clock: clock,
+		start: start,
+		buf:   make([]byte, 0, connMTU),
+	}
+	cr, peer := runner.RunPeer([]byte("replay"), c)
+	defer peer.Stop()
+	if err := peer.RunSwarm(swarm, channel, peerChannel); err != nil {
+		return nil, err
+	}
+	if err := peer.Step(false); err != nil {
+		return nil, err
+	}
+
+	advance := func(d time.Duration) error {
+		for clock.AdvanceTo(start.Add(d)) {
+			if err := peer.Step(true); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var last time.Duration
+	for _, e := range s.Events {
+		if e.Code != CapConnRead {
+			continue
+		}
+
+		if err := advance(e.Time); err != nil {
+			return nil, err
+		}
+		if err := cr.HandleMessage(e.Data); err != nil {
+			return nil, fmt.Errorf("handling message at %s failed: %w", e.Time, err)
+		}
+		if err := peer.Step(false); err != nil {
+			return nil, err
+		}
+		last = e.Time
+	}
+	if err := advance(last + opt.Tail); err != nil {
+		return nil, err
+	}
+
+	return &CapLogSession{
+		Label:  s.Label,
+		Start:  s.Start,
+		Events: append([]CapLogEvent{{Code: CapConnInit}}, c.events...),
+	}, nil
+}
+
+// capLogReplayConn records the frames flushed by the replayed peer with the
+// fake clock's time.
+type capLogReplayConn struct {
+	clock  *timeutil.FakeClock
+	start  timeutil.Time
+	buf    []byte
+	events []CapLogEvent
+}
+
+func (c *capLogReplayConn) Available() int {
+	return cap(c.buf) - len(c.buf)
+}
+
+func (c *capLogReplayConn) AvailableBuffer() []byte {
+	return c.buf[len(c.buf):]
+}
+
+func (c *capLogReplayConn) Write(p []byte) (int, error) {
+	if len(p) > c.Available() {
+		return 0, io.ErrShortWrite
+	}
+	c.buf = append(c.buf, p...)
+	return len(p), nil
+}
+
+func (c *capLogReplayConn) Flush() error {
+	if len(c.buf) == 0 {
+		return nil
+	}
+	c.events = append(c.events, CapLogEvent{
+		Code: CapConnWrite,
+		Time: c.clock.Now().Sub(c.start),
+		Data: append([]byte(nil), c.buf...),
+	})
+	c.buf = c.buf[:0]
+	return nil
+}
+
+func (c *capLogReplayConn) SetQOSWeight(w uint64) {}
+
+// NewCapLogReplaySwarm creates a swarm matching the handshake written by the
+// captured peer and returns the channels it used. The swarm is seeded with
+// the epoch and the chunks the peer wrote and read.
+func NewCapLogReplaySwarm(s *CapLogSession, method ppspp.SchedulingMethod) (*ppspp.Swarm, codec.Channel, codec.Channel, error) {
+	writes, err := s.Messages(CapConnWrite)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	reads, err := s.Messages(CapConnRead)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	if len(writes) == 0 || len(reads) == 0 {
+		return nil, 0, 0, errors.New("captured peer did not exchange messages")
+	}
+
+	var hs *codec.Handshake
+	for _, m := range writes {
+		if m.Handshake != nil {
+			hs = m.Handshake
+			break
+		}
+	}
+	if hs == nil {
+		return nil, 0, 0, errors.New("captured peer did not write a handshake")
+	}
+
+	id, ok := hs.Options.Find(codec.SwarmIdentifierOption)
+	if !ok {
+		return nil, 0, 0, errors.New("handshake missing swarm id")
+	}
+
+	opt := ppspp.SwarmOptions{
+		Label:            "replay",
+		SchedulingMethod: method,
+	}
+	if o, ok := hs.Options.Find(codec.LiveWindowOption); ok {
+		opt.LiveWindow = int(o.(*codec.LiveWindowProtocolOption).Value)
+	}
+	if o, ok := hs.Options.Find(codec.ChunkSizeOption); ok {
+		opt.ChunkSize = int(o.(*codec.ChunkSizeProtocolOption).Value)
+	}
+	if o, ok := hs.Options.Find(codec.ChunksPerSignatureOption); ok {
+		opt.ChunksPerSignature = int(o.(*codec.ChunksPerSignatureProtocolOption).Value)
+	}
+	if o, ok := hs.Options.Find(codec.StreamCountOption); ok {
+		opt.StreamCount = int(o.(*codec.StreamCountProtocolOption).Value)
+	}
+	if o, ok := hs.Options.Find(codec.ContentIntegrityProtectionMethodOption); ok {
+		opt.Integrity.ProtectionMethod = integrity.ProtectionMethod(o.(*codec.ContentIntegrityProtectionMethodProtocolOption).Value)
+	}
+	if o, ok := hs.Options.Find(codec.MerkleHashTreeFunctionOption); ok {
+		opt.Integrity.MerkleHashTreeFunction = integrity.MerkleHashTreeFunction(o.(*codec.MerkleHashTreeFunctionProtocolOption).Value)
+	}
+	if o, ok := hs.Options.Find(codec.LiveSignatureAlgorithmOption); ok {
+		opt.Integrity.LiveSignatureAlgorithm = integrity.LiveSignatureAlgorithm(o.(*codec.LiveSignatureAlgorithmProtocolOption).Value)
+	}
+
+	swarm, err := ppspp.NewSwarm(ppspp.NewSwarmID(*id.(*codec.SwarmIdentifierProtocolOption)), opt)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	for _, ms := range [][]CapLogMessage{writes, reads} {
+		if err := swarm.ImportMessages(capLogMessageValues(ms)); err != nil {
+			swarm.Close()
+			return nil, 0, 0, err
+		}
+	}
+
+	return swarm, reads[0].Channel, writes[0].Channel, nil
+}
+
+func capLogMessageValues(ms []CapLogMessage) []codec.Message {
+	vs := make([]codec.Message, 0, len(ms))
+	for _, m := range ms {
+		if m.Message != nil {
+			vs = append(vs, m.Message)
+		}
+	}
+	return vs
+}
+
+// LoadCapLogSession reads the session with the given label from the cap log
+// at name. Relative names that don't exist are looked up in CapConnLogDir. If
+// label is empty the first session is returned.
+func LoadCapLogSession(name, label string) (*CapLogSession, error) {
+	f, err := os.Open(name)
+	if errors.Is(err, os.ErrNotExist) && !path.IsAbs(name) {
+		f, err = os.Open(path.Join(CapConnLogDir(), name))
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	ss, err := LoadCapLog(f)
+	if err != nil {
+		return nil, err
+	}
+	if len(ss) == 0 {
+		return nil, errors.New("cap log is empty")
+	}
+
+	if label == "" {
+		return ss[0], nil
+	}
+	s, ok := FindCapLogSession(ss, label)
+	if !ok {
+		return nil, fmt.Errorf("peer not found: %s", label)
+	}
+	return s, nil
+}
+
+// ReplayCapLogTest replays the session with the given label from the cap log
+// at name and returns the diff between the captured and replayed writes. It
+// fails t if the capture can't be loaded or replayed so captures can be used
+// as scheduler regression tests.
+func ReplayCapLogTest(t testing.TB, name, label string, opt CapLogReplayOptions) *CapLogDiff {
+	t.Helper()
+
+	s, err := LoadCapLogSession(name, label)
+	if err != nil {
+		t.Fatalf("loading cap log failed: %s", err)
+	}
+	replay, err := ReplayCapLogSession(s, opt)
+	if err != nil {
+		t.Fatalf("replaying cap log failed: %s", err)
+	}
+	diff, err := DiffCapLogSessions(s, replay)
+	if err != nil {
+		t.Fatalf("diffing cap log failed: %s", err)
+	}
+	return diff
+}
+
+// CapLogDiff compares the messages written by two sessions.
+type CapLogDiff struct {
+	A, B []CapLogMessage
+	// FirstDivergence is the index of the first message that differs in type
+	// or address or -1 if the sequences are identical.
+	FirstDivergence int
+	Counts          map[codec.MessageType][2]int
+	DataBytes       [2]int
+	Duration        [2]time.Duration
+}
+
+// DiffCapLogSessions compares the messages written in a and b.
+func DiffCapLogSessions(a, b *CapLogSession) (*CapLogDiff, error) {
+	am, err := a.Messages(CapConnWrite)
+	if err != nil {
+		return nil, err
+	}
+	bm, err := b.Messages(CapConnWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &CapLogDiff{
+		A:               am,
+		B:               bm,
+		FirstDivergence: -1,
+		Counts:          map[codec.MessageType][2]int{},
+		Duration:        [2]time.Duration{a.Duration(), b.Duration()},
+	}
+
+	for i, ms := range [][]CapLogMessage{am, bm} {
+		for _, m := range ms {
+			c := d.Counts[m.Type]
+			c[i]++
+			d.Counts[m.Type] = c
+			d.DataBytes[i] += m.DataLen
+		}
+	}
+
+	for i := 0; i < len(am) || i < len(bm); i++ {
+		if i >= len(am) || i >= len(bm) || am[i].Type != bm[i].Type || am[i].Address != bm[i].Address {
+			d.FirstDivergence = i
+			break
+		}
+	}
+
+	return d, nil
+}
+
+// Equal returns true if both sessions wrote the same message sequence.
+func (d *CapLogDiff) Equal() bool {
+	return d.FirstDivergence == -1
+}
+
+// Throughput returns the data bytes per second written by each session.
+func (d *CapLogDiff) Throughput() [2]float64 {
+	var t [2]float64
+	for i := range t {
+		if d.Duration[i] > 0 {
+			t[i] = float64(d.DataBytes[i]) / d.Duration[i].Seconds()
+		}
+	}
+	return t
+}
+
+// Format writes a summary of the diff and the messages surrounding the first
+// divergence.
+func (d *CapLogDiff) Format(w io.Writer, context int) {
+	fmt.Fprintf(w, "%-16s %12s %12s\n", "", "captured", "replayed")
+	types := make([]codec.MessageType, 0, len(d.Counts))
+	for t := range d.Counts {
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	for _, t := range types {
+		c := d.Counts[t]
+		fmt.Fprintf(w, "%-16s %12d %12d\n", t, c[0], c[1])
+	}
+	fmt.Fprintf(w, "%-16s %12d %12d\n", "data bytes", d.DataBytes[0], d.DataBytes[1])
+	tp := d.Throughput()
+	fmt.Fprintf(w, "%-16s %12.0f %12.0f\n", "bytes/s", tp[0], tp[1])
+
+	if d.Equal() {
+		fmt.Fprintln(w, "message sequences are identical")
+		return
+	}
+
+	fmt.Fprintf(w, "message sequences diverge at %d\n", d.FirstDivergence)
+	start := d.FirstDivergence - context
+	if start < 0 {
+		start = 0
+	}
+	end := d.FirstDivergence + context + 1
+	for i := start; i < end; i++ {
+		var a, b string
+		if i < len(d.A) {
+			a = d.A[i].String()
+		}
+		if i < len(d.B) {
+			b = d.B[i].String()
+		}
+		if a == "" && b == "" {
+			break
+		}
+		marker := " "
+		if i >= d.FirstDivergence {
+			marker = "!"
+		}
+		fmt.Fprintf(w, "%s %-48s %s\n", marker, strings.TrimSpace(a), strings.TrimSpace(b))
+	}
+}
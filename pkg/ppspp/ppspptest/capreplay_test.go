@@ -0,0 +1,146 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package ppspptest
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MemeLabs/strims/pkg/ppspp"
+	"github.com/MemeLabs/strims/pkg/ppspp/codec"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func testCapLogFrame(channel codec.Channel, ms ...codec.Message) []byte {
+	var body []byte
+	for _, m := range ms {
+		b := make([]byte, 1+m.ByteLen())
+		b[0] = byte(m.Type())
+		m.Marshal(b[1:])
+		body = append(body, b...)
+	}
+
+	b := binary.AppendUvarint(nil, uint64(channel))
+	b = binary.BigEndian.AppendUint16(b, uint16(len(body)))
+	return append(b, body...)
+}
+
+// captureTestSwarm runs a seeder and one client for a second and returns the
+// cap log recorded on the seeder's side of the conn.
+func captureTestSwarm(t *testing.T) *CapLogSession {
+	key := Key()
+	options := ppspp.SwarmOptions{LiveWindow: 1 << 10}
+
+	src, err := ppspp.NewWriter(ppspp.WriterOptions{
+		SwarmOptions: options,
+		Key:          key,
+	})
+	assert.NoError(t, err)
+	swarm, err := ppspp.NewSwarm(ppspp.NewSwarmID(key.Public), options)
+	assert.NoError(t, err)
+
+	capLog := &bytes.Buffer{}
+	w := NewCapLogWriter(capLog)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c0, c1 := NewConnPair()
+	cc0, err := NewCapConn(c0, w.Writer(), "seed")
+	assert.NoError(t, err)
+
+	logger := zap.NewNop()
+	cr0, p0 := ppspp.NewRunner(ctx, logger).RunPeer([]byte("seed"), cc0)
+	cr1, p1 := ppspp.NewRunner(ctx, logger).RunPeer([]byte("client"), c1)
+	assert.NoError(t, p0.RunSwarm(src.Swarm(), 1, 2))
+	assert.NoError(t, p1.RunSwarm(swarm, 2, 1))
+	go ReadChannelConn(cc0, cr0)
+	go ReadChannelConn(c1, cr1)
+
+	b := make([]byte, 16*1024)
+	for i := 0; i < 10; i++ {
+		_, err := src.Write(b)
+		assert.NoError(t, err)
+		assert.NoError(t, src.Flush())
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	p0.Stop()
+	p1.Stop()
+	c0.Close()
+	c1.Close()
+	assert.NoError(t, w.Close())
+
+	ss, err := LoadCapLog(capLog)
+	assert.NoError(t, err)
+	s, ok := FindCapLogSession(ss, "seed")
+	if !ok {
+		t.Fatal("captured session not found")
+	}
+	return s
+}
+
+func TestCapLogReplay(t *testing.T) {
+	s := captureTestSwarm(t)
+
+	reads, err := s.Messages(CapConnRead)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, reads)
+
+	opt := CapLogReplayOptions{Tail: 500 * time.Millisecond}
+	a, err := ReplayCapLogSession(s, opt)
+	assert.NoError(t, err)
+	b, err := ReplayCapLogSession(s, opt)
+	assert.NoError(t, err)
+
+	diff, err := DiffCapLogSessions(a, b)
+	assert.NoError(t, err)
+	assert.True(t, diff.Equal(), "replays diverged at %d", diff.FirstDivergence)
+	assert.Equal(t, a.Events, b.Events)
+	assert.NotZero(t, diff.Counts[codec.DataMessage][0], "replayed seeder wrote no data")
+
+	tampered := &CapLogSession{
+		Events: []CapLogEvent{
+			{Code: CapConnWrite, Data: testCapLogFrame(2, &codec.Pong{Nonce: codec.Nonce{Value: 1}})},
+			{Code: CapConnWrite, Data: testCapLogFrame(2, &codec.Choke{})},
+		},
+	}
+	original := &CapLogSession{
+		Events: []CapLogEvent{
+			{Code: CapConnWrite, Data: testCapLogFrame(2, &codec.Pong{Nonce: codec.Nonce{Value: 1}})},
+			{Code: CapConnWrite, Data: testCapLogFrame(2, &codec.PexRequest{})},
+		},
+	}
+	diff, err = DiffCapLogSessions(original, tampered)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, diff.FirstDivergence)
+
+	var out strings.Builder
+	diff.Format(&out, 1)
+	assert.Contains(t, out.String(), codec.PexRequestMessage.String())
+}
+
+func TestCapLogSessionMessagesSplitFrames(t *testing.T) {
+	frame := testCapLogFrame(1, &codec.Ping{Nonce: codec.Nonce{Value: 7}}, &codec.Choke{})
+	s := &CapLogSession{
+		Events: []CapLogEvent{
+			{Code: CapConnWrite, Data: frame[:1]},
+			{Code: CapConnWrite, Data: frame[1:5]},
+			{Code: CapConnWrite, Data: frame[5:]},
+		},
+	}
+
+	ms, err := s.Messages(CapConnWrite)
+	assert.NoError(t, err)
+	if assert.Len(t, ms, 2) {
+		assert.Equal(t, codec.PingMessage, ms[0].Type)
+		assert.Equal(t, uint64(7), ms[0].Address)
+		assert.Equal(t, codec.ChokeMessage, ms[1].Type)
+	}
+}
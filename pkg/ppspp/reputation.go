@@ -0,0 +1,112 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package ppspp
+
+import (
+	"sync"
+	"time"
+
+	"github.com/MemeLabs/strims/pkg/hashmap"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+)
+
+const (
+	// reputationTTL is how long the reputation of disconnected peers is kept
+	reputationTTL = time.Hour
+	// reputationInvalidPenalty is the weight of chunks that failed verification
+	// relative to valid chunks in the integrity ratio
+	reputationInvalidPenalty = 8
+	// reputationMinSamples is the number of chunks needed before the integrity
+	// ratio is used to distrust a peer
+	reputationMinSamples        = 16
+	reputationMinIntegrityRatio = 0.5
+)
+
+func newPeerReputations() *peerReputations {
+	return &peerReputations{
+		peers: hashmap.NewLRU[[]byte, *peerReputation](hashmap.NewByteInterface[[]byte]()),
+	}
+}
+
+// peerReputations tracks the contribution of peers across swarms so upload
+// slots can be allocated to peers that give back.
+type peerReputations struct {
+	lock  sync.Mutex
+	peers hashmap.LRU[[]byte, *peerReputation]
+}
+
+// Get returns the reputation for the peer with id creating it if necessary.
+func (r *peerReputations) Get(id []byte) *peerReputation {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if p, ok := r.peers.Get(id); ok {
+		return p
+	}
+	p, _ := r.peers.GetOrInsert(append([]byte(nil), id...), &peerReputation{})
+	return p
+}
+
+// Touch defers pruning the reputation for the peer with id.
+func (r *peerReputations) Touch(id []byte) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.peers.Touch(id)
+}
+
+// Prune removes reputations that haven't been used since eol.
+func (r *peerReputations) Prune(eol timeutil.Time) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for {
+		if _, ok := r.peers.Pop(eol); !ok {
+			return
+		}
+	}
+}
+
+type peerReputation struct {
+	lock          sync.Mutex
+	receivedBytes uint64
+	validChunks   uint64
+	invalidChunks uint64
+}
+
+// AddValidChunks records n verified chunks totalling size bytes.
+func (r *peerReputation) AddValidChunks(n, size uint64) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.validChunks += n
+	r.receivedBytes += size
+}
+
+// AddInvalidChunks records n chunks that failed verification.
+func (r *peerReputation) AddInvalidChunks(n uint64) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.invalidChunks += n
+}
+
+func (r *peerReputation) integrityRatio() float64 {
+	return float64(r.validChunks+1) / float64(r.validChunks+r.invalidChunks*reputationInvalidPenalty+1)
+}
+
+// Score weights the bytes received from the peer by its integrity ratio.
+func (r *peerReputation) Score() float64 {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return float64(r.receivedBytes) * r.integrityRatio()
+}
+
+// Trusted is false for peers that have sent enough invalid data to be ignored.
+func (r *peerReputation) Trusted() bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.validChunks+r.invalidChunks < reputationMinSamples {
+		return true
+	}
+	return r.integrityRatio() >= reputationMinIntegrityRatio
+}
@@ -0,0 +1,33 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package ppspp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MemeLabs/strims/pkg/timeutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeerReputation(t *testing.T) {
+	r := newPeerReputations()
+
+	a := r.Get([]byte("a"))
+	assert.Same(t, a, r.Get([]byte("a")), "expected reputation to be shared")
+
+	a.AddValidChunks(64, 64*1024)
+	assert.EqualValues(t, 64*1024, a.Score())
+	assert.True(t, a.Trusted())
+
+	a.AddInvalidChunks(4)
+	assert.Less(t, a.Score(), float64(64*1024), "expected invalid chunks to reduce score")
+	assert.True(t, a.Trusted())
+
+	a.AddInvalidChunks(8)
+	assert.False(t, a.Trusted(), "expected peer sending mostly invalid chunks to be distrusted")
+
+	r.Prune(timeutil.Now().Add(time.Minute))
+	assert.NotSame(t, a, r.Get([]byte("a")), "expected pruned reputation to be replaced")
+}
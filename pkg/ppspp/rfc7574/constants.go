@@ -0,0 +1,139 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package rfc7574
+
+// ProtocolVersion is the protocol version defined by RFC 7574.
+const ProtocolVersion uint8 = 1
+
+// DefaultChunkSize is the chunk size assumed when a handshake omits the chunk
+// size option.
+const DefaultChunkSize = 1024
+
+// VariableChunkSize is the chunk size option value for swarms with variable
+// sized chunks.
+const VariableChunkSize = 0xffffffff
+
+// MessageType ...
+type MessageType uint8
+
+// String ...
+func (m MessageType) String() string {
+	switch m {
+	case HandshakeMessage:
+		return "Handshake"
+	case DataMessage:
+		return "Data"
+	case AckMessage:
+		return "Ack"
+	case HaveMessage:
+		return "Have"
+	case IntegrityMessage:
+		return "Integrity"
+	case PexResV4Message:
+		return "PexResV4"
+	case PexReqMessage:
+		return "PexReq"
+	case SignedIntegrityMessage:
+		return "SignedIntegrity"
+	case RequestMessage:
+		return "Request"
+	case CancelMessage:
+		return "Cancel"
+	case ChokeMessage:
+		return "Choke"
+	case UnchokeMessage:
+		return "Unchoke"
+	case PexResV6Message:
+		return "PexResV6"
+	case PexResCertMessage:
+		return "PexResCert"
+	}
+	return "Unknown"
+}
+
+// message types
+const (
+	HandshakeMessage MessageType = iota
+	DataMessage
+	AckMessage
+	HaveMessage
+	IntegrityMessage
+	PexResV4Message
+	PexReqMessage
+	SignedIntegrityMessage
+	RequestMessage
+	CancelMessage
+	ChokeMessage
+	UnchokeMessage
+	PexResV6Message
+	PexResCertMessage
+)
+
+// supportedMessages are the message types advertised in handshakes. PEX
+// messages carry transport addresses which aren't meaningful to the vpn so
+// they're ignored.
+var supportedMessages = []MessageType{
+	HandshakeMessage,
+	DataMessage,
+	AckMessage,
+	HaveMessage,
+	IntegrityMessage,
+	SignedIntegrityMessage,
+	RequestMessage,
+	CancelMessage,
+	ChokeMessage,
+	UnchokeMessage,
+}
+
+// ProtocolOptionType ...
+type ProtocolOptionType uint8
+
+// protocol options
+const (
+	VersionOption ProtocolOptionType = iota
+	MinimumVersionOption
+	SwarmIdentifierOption
+	ContentIntegrityProtectionMethodOption
+	MerkleHashTreeFunctionOption
+	LiveSignatureAlgorithmOption
+	ChunkAddressingMethodOption
+	LiveDiscardWindowOption
+	SupportedMessagesOption
+	ChunkSizeOption
+	EndOption ProtocolOptionType = 255
+)
+
+// ChunkAddressingMethod ...
+type ChunkAddressingMethod uint8
+
+// chunk addressing methods
+const (
+	Bin32ChunkAddressingMethod ChunkAddressingMethod = iota
+	ByteRange64ChunkAddressingMethod
+	ChunkRange32ChunkAddressingMethod
+	Bin64ChunkAddressingMethod
+	ChunkRange64ChunkAddressingMethod
+)
+
+// content integrity protection methods
+const (
+	noneProtectionMethod uint8 = iota
+	merkleTreeProtectionMethod
+	signAllProtectionMethod
+	unifiedMerkleTreeProtectionMethod
+)
+
+// merkle hash tree functions
+const (
+	sha1MerkleHashTreeFunction uint8 = iota
+	sha224MerkleHashTreeFunction
+	sha256MerkleHashTreeFunction
+	sha384MerkleHashTreeFunction
+	sha512MerkleHashTreeFunction
+)
+
+// live signature algorithms use the dnssec algorithm numbers
+const (
+	ed25519LiveSignatureAlgorithm uint8 = 15
+)
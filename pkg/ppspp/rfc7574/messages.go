@@ -0,0 +1,249 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package rfc7574
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/bits"
+	"time"
+
+	"github.com/MemeLabs/strims/pkg/binmap"
+	"github.com/MemeLabs/strims/pkg/ppspp/integrity"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+)
+
+// errors ...
+var (
+	ErrUnsupportedMessageType           = errors.New("unsupported message type")
+	ErrUnsupportedProtocolOption        = errors.New("unsupported protocol option")
+	ErrUnsupportedChunkAddressingMethod = errors.New("unsupported chunk addressing method")
+	ErrMalformedMessage                 = errors.New("malformed message")
+	ErrUnaddressableBin                 = errors.New("bin exceeds 32-bit chunk range")
+	ErrUnsupportedSwarmOptions          = errors.New("swarm options have no rfc 7574 equivalent")
+)
+
+// ChunkSpec is an inclusive 32-bit chunk range.
+type ChunkSpec struct {
+	Start uint32
+	End   uint32
+}
+
+// NewChunkSpec returns the chunk range covered by b.
+func NewChunkSpec(b binmap.Bin) (ChunkSpec, error) {
+	end := b.BaseRight().BaseOffset()
+	if end > math.MaxUint32 {
+		return ChunkSpec{}, ErrUnaddressableBin
+	}
+	return ChunkSpec{
+		Start: uint32(b.BaseLeft().BaseOffset()),
+		End:   uint32(end),
+	}, nil
+}
+
+// Unmarshal ...
+func (v *ChunkSpec) Unmarshal(b []byte) (int, error) {
+	if len(b) < 8 {
+		return 0, ErrMalformedMessage
+	}
+	v.Start = binary.BigEndian.Uint32(b)
+	v.End = binary.BigEndian.Uint32(b[4:])
+	if v.End < v.Start {
+		return 0, ErrMalformedMessage
+	}
+	return 8, nil
+}
+
+// Marshal ...
+func (v ChunkSpec) Marshal(b []byte) int {
+	binary.BigEndian.PutUint32(b, v.Start)
+	binary.BigEndian.PutUint32(b[4:], v.End)
+	return 8
+}
+
+// ByteLen ...
+func (v ChunkSpec) ByteLen() int {
+	return 8
+}
+
+// Len returns the number of chunks in the range.
+func (v ChunkSpec) Len() uint64 {
+	return uint64(v.End) - uint64(v.Start) + 1
+}
+
+// Bins returns the smallest set of bins that cover the range in order.
+func (v ChunkSpec) Bins() []binmap.Bin {
+	var bins []binmap.Bin
+	for i, end := uint64(v.Start), uint64(v.End)+1; i < end; {
+		layer := uint64(bits.TrailingZeros64(i))
+		if i == 0 {
+			layer = 63
+		}
+		for i+1<<layer > end {
+			layer--
+		}
+		bins = append(bins, binmap.NewBin(layer, i>>layer))
+		i += 1 << layer
+	}
+	return bins
+}
+
+// Bin returns the bin equal to the range if one exists.
+func (v ChunkSpec) Bin() (binmap.Bin, bool) {
+	bins := v.Bins()
+	if len(bins) != 1 {
+		return binmap.None, false
+	}
+	return bins[0], true
+}
+
+// ntpEpochOffset is the number of seconds between the ntp and unix epochs.
+const ntpEpochOffset = 2208988800
+
+// Timestamp is a 64-bit ntp timestamp.
+type Timestamp struct {
+	timeutil.Time
+}
+
+// Unmarshal ...
+func (v *Timestamp) Unmarshal(b []byte) (int, error) {
+	if len(b) < 8 {
+		return 0, ErrMalformedMessage
+	}
+	sec := int64(binary.BigEndian.Uint32(b)) - ntpEpochOffset
+	frac := (uint64(binary.BigEndian.Uint32(b[4:]))*uint64(time.Second) + 1<<31) >> 32
+	ns := sec*int64(time.Second) + int64(frac)
+
+	// round to the native timestamp precision so signed timestamps survive the
+	// round trip through the ntp format.
+	v.Time = timeutil.New(ns + int64(timeutil.Precision/2)).Truncate(timeutil.Precision)
+	return 8, nil
+}
+
+// Marshal ...
+func (v Timestamp) Marshal(b []byte) int {
+	ns := v.Time.UnixNano()
+	sec := ns / int64(time.Second)
+	frac := uint64(ns%int64(time.Second)) << 32 / uint64(time.Second)
+	binary.BigEndian.PutUint32(b, uint32(sec+ntpEpochOffset))
+	binary.BigEndian.PutUint32(b[4:], uint32(frac))
+	return 8
+}
+
+// ByteLen ...
+func (v Timestamp) ByteLen() int {
+	return 8
+}
+
+// DelaySample is a one way delay sample in microseconds.
+type DelaySample struct {
+	time.Duration
+}
+
+// Unmarshal ...
+func (v *DelaySample) Unmarshal(b []byte) (int, error) {
+	if len(b) < 8 {
+		return 0, ErrMalformedMessage
+	}
+	v.Duration = time.Duration(binary.BigEndian.Uint64(b)) * time.Microsecond
+	return 8, nil
+}
+
+// Marshal ...
+func (v DelaySample) Marshal(b []byte) int {
+	binary.BigEndian.PutUint64(b, uint64(v.Duration/time.Microsecond))
+	return 8
+}
+
+// ByteLen ...
+func (v DelaySample) ByteLen() int {
+	return 8
+}
+
+// the unified merkle tree method in rfc 7574 is the live variant of the merkle
+// tree method with signed munro hashes which is what the merkle verifier
+// implements.
+func protectionMethodToRFC(m integrity.ProtectionMethod) (uint8, bool) {
+	switch m {
+	case integrity.ProtectionMethodNone:
+		return noneProtectionMethod, true
+	case integrity.ProtectionMethodMerkleTree:
+		return unifiedMerkleTreeProtectionMethod, true
+	case integrity.ProtectionMethodSignAll:
+		return signAllProtectionMethod, true
+	}
+	return 0, false
+}
+
+func protectionMethodFromRFC(v uint8) integrity.ProtectionMethod {
+	switch v {
+	case noneProtectionMethod:
+		return integrity.ProtectionMethodNone
+	case unifiedMerkleTreeProtectionMethod:
+		return integrity.ProtectionMethodMerkleTree
+	case signAllProtectionMethod:
+		return integrity.ProtectionMethodSignAll
+	}
+	return 0
+}
+
+func merkleHashTreeFunctionToRFC(f integrity.MerkleHashTreeFunction) (uint8, bool) {
+	switch f {
+	case integrity.MerkleHashTreeFunctionSHA1:
+		return sha1MerkleHashTreeFunction, true
+	case integrity.MerkleHashTreeFunctionSHA256:
+		return sha256MerkleHashTreeFunction, true
+	case integrity.MerkleHashTreeFunctionSHA512:
+		return sha512MerkleHashTreeFunction, true
+	}
+	return 0, false
+}
+
+func merkleHashTreeFunctionFromRFC(v uint8) integrity.MerkleHashTreeFunction {
+	switch v {
+	case sha1MerkleHashTreeFunction:
+		return integrity.MerkleHashTreeFunctionSHA1
+	case sha256MerkleHashTreeFunction:
+		return integrity.MerkleHashTreeFunctionSHA256
+	case sha512MerkleHashTreeFunction:
+		return integrity.MerkleHashTreeFunctionSHA512
+	}
+	return 0
+}
+
+func liveSignatureAlgorithmToRFC(a integrity.LiveSignatureAlgorithm) (uint8, bool) {
+	switch a {
+	case integrity.LiveSignatureAlgorithmED25519:
+		return ed25519LiveSignatureAlgorithm, true
+	}
+	return 0, false
+}
+
+func liveSignatureAlgorithmFromRFC(v uint8) integrity.LiveSignatureAlgorithm {
+	switch v {
+	case ed25519LiveSignatureAlgorithm:
+		return integrity.LiveSignatureAlgorithmED25519
+	}
+	return 0
+}
+
+// ValidateOptions returns ErrUnsupportedSwarmOptions if swarms using o can't
+// be described in rfc 7574 handshakes.
+func ValidateOptions(o integrity.VerifierOptions) error {
+	if _, ok := protectionMethodToRFC(o.ProtectionMethod); !ok {
+		return ErrUnsupportedSwarmOptions
+	}
+	if o.ProtectionMethod == integrity.ProtectionMethodMerkleTree {
+		if _, ok := merkleHashTreeFunctionToRFC(o.MerkleHashTreeFunction); !ok {
+			return ErrUnsupportedSwarmOptions
+		}
+	}
+	if o.LiveSignatureAlgorithm != integrity.LiveSignatureAlgorithmNone {
+		if _, ok := liveSignatureAlgorithmToRFC(o.LiveSignatureAlgorithm); !ok {
+			return ErrUnsupportedSwarmOptions
+		}
+	}
+	return nil
+}
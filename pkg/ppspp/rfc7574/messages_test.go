@@ -0,0 +1,82 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package rfc7574
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MemeLabs/strims/pkg/binmap"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunkSpecBins(t *testing.T) {
+	cases := []struct {
+		label string
+		spec  ChunkSpec
+		bins  []binmap.Bin
+	}{
+		{
+			label: "single chunk",
+			spec:  ChunkSpec{3, 3},
+			bins:  []binmap.Bin{binmap.NewBin(0, 3)},
+		},
+		{
+			label: "aligned range",
+			spec:  ChunkSpec{8, 15},
+			bins:  []binmap.Bin{binmap.NewBin(3, 1)},
+		},
+		{
+			label: "unaligned range",
+			spec:  ChunkSpec{3, 12},
+			bins: []binmap.Bin{
+				binmap.NewBin(0, 3),
+				binmap.NewBin(2, 1),
+				binmap.NewBin(2, 2),
+				binmap.NewBin(0, 12),
+			},
+		},
+		{
+			label: "from zero",
+			spec:  ChunkSpec{0, 4},
+			bins: []binmap.Bin{
+				binmap.NewBin(2, 0),
+				binmap.NewBin(0, 4),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.label, func(t *testing.T) {
+			assert.Equal(t, c.bins, c.spec.Bins())
+		})
+	}
+}
+
+func TestNewChunkSpec(t *testing.T) {
+	spec, err := NewChunkSpec(binmap.NewBin(3, 1))
+	assert.NoError(t, err)
+	assert.Equal(t, ChunkSpec{8, 15}, spec)
+
+	b, ok := spec.Bin()
+	assert.True(t, ok)
+	assert.Equal(t, binmap.NewBin(3, 1), b)
+
+	_, err = NewChunkSpec(binmap.NewBin(0, 1<<32))
+	assert.ErrorIs(t, err, ErrUnaddressableBin)
+}
+
+func TestTimestampMarshalUnmarshal(t *testing.T) {
+	src := Timestamp{timeutil.Unix(1650000000, int64(123*time.Millisecond))}
+
+	b := make([]byte, src.ByteLen())
+	assert.Equal(t, 8, src.Marshal(b))
+
+	var dst Timestamp
+	n, err := dst.Unmarshal(b)
+	assert.NoError(t, err)
+	assert.Equal(t, 8, n)
+	assert.Equal(t, src, dst)
+}
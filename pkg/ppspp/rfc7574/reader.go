@@ -0,0 +1,344 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package rfc7574
+
+import (
+	"encoding/binary"
+
+	"github.com/MemeLabs/strims/pkg/ppspp"
+	"github.com/MemeLabs/strims/pkg/ppspp/codec"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+)
+
+// MessageHandler receives the native messages translated from rfc 7574
+// messages.
+type MessageHandler func(m codec.Message) error
+
+// Reader translates rfc 7574 messages into native messages. Ranges that don't
+// align with bins are split into the smallest set of covering bins and
+// messages without a native equivalent are skipped.
+type Reader struct {
+	ChunkSize              int
+	IntegrityHashSize      int
+	IntegritySignatureSize int
+	Handler                MessageHandler
+}
+
+// Read translates the messages in b. b should not include the datagram's
+// channel id.
+func (v Reader) Read(b []byte) (n int, err error) {
+	for n < len(b) {
+		mt := MessageType(b[n])
+
+		var mn int
+		switch mt {
+		case HandshakeMessage:
+			var h codec.Handshake
+			h, mn, err = ReadHandshake(b[n:])
+			if err == nil {
+				err = v.Handler(&h)
+			}
+			n += mn
+			if err != nil {
+				return n, err
+			}
+			continue
+		case DataMessage:
+			mn, err = v.readData(b[n+1:])
+		case AckMessage:
+			mn, err = v.readAck(b[n+1:])
+		case HaveMessage:
+			mn, err = v.readHave(b[n+1:])
+		case IntegrityMessage:
+			mn, err = v.readIntegrity(b[n+1:])
+		case SignedIntegrityMessage:
+			mn, err = v.readSignedIntegrity(b[n+1:])
+		case RequestMessage:
+			mn, err = v.readRequest(b[n+1:])
+		case CancelMessage:
+			mn, err = v.readCancel(b[n+1:])
+		case ChokeMessage:
+			err = v.Handler(&codec.Choke{})
+		case UnchokeMessage:
+			err = v.Handler(&codec.Unchoke{})
+		case PexReqMessage:
+		case PexResV4Message:
+			mn, err = skip(b[n+1:], 6)
+		case PexResV6Message:
+			mn, err = skip(b[n+1:], 18)
+		case PexResCertMessage:
+			if len(b) < n+3 {
+				return n, ErrMalformedMessage
+			}
+			mn, err = skip(b[n+1:], 2+int(binary.BigEndian.Uint16(b[n+1:])))
+		default:
+			return n, ErrUnsupportedMessageType
+		}
+
+		if err != nil {
+			return n, err
+		}
+		n += 1 + mn
+	}
+	return n, nil
+}
+
+func skip(b []byte, n int) (int, error) {
+	if len(b) < n {
+		return 0, ErrMalformedMessage
+	}
+	return n, nil
+}
+
+func (v Reader) readData(b []byte) (int, error) {
+	var spec ChunkSpec
+	n, err := spec.Unmarshal(b)
+	if err != nil {
+		return 0, err
+	}
+
+	var ts Timestamp
+	tn, err := ts.Unmarshal(b[n:])
+	if err != nil {
+		return 0, err
+	}
+	n += tn
+
+	d := b[n:]
+	if size := spec.Len() * uint64(v.ChunkSize); size < uint64(len(d)) {
+		d = d[:size]
+	}
+	n += len(d)
+
+	for _, bin := range spec.Bins() {
+		if len(d) == 0 {
+			break
+		}
+		size := int(bin.BaseLength()) * v.ChunkSize
+		if size > len(d) {
+			size = len(d)
+		}
+		if err := v.Handler(codec.NewData(v.ChunkSize, bin, ts.Time, d[:size])); err != nil {
+			return 0, err
+		}
+		d = d[size:]
+	}
+	return n, nil
+}
+
+func (v Reader) readAck(b []byte) (int, error) {
+	var spec ChunkSpec
+	n, err := spec.Unmarshal(b)
+	if err != nil {
+		return 0, err
+	}
+
+	var delay DelaySample
+	dn, err := delay.Unmarshal(b[n:])
+	if err != nil {
+		return 0, err
+	}
+	n += dn
+
+	for _, bin := range spec.Bins() {
+		err := v.Handler(&codec.Ack{
+			Address:     codec.Address(bin),
+			DelaySample: codec.DelaySample{Duration: delay.Duration},
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+	return n, nil
+}
+
+func (v Reader) readHave(b []byte) (int, error) {
+	var spec ChunkSpec
+	n, err := spec.Unmarshal(b)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, bin := range spec.Bins() {
+		if err := v.Handler(&codec.Have{Address: codec.Address(bin)}); err != nil {
+			return 0, err
+		}
+	}
+	return n, nil
+}
+
+func (v Reader) readIntegrity(b []byte) (int, error) {
+	var spec ChunkSpec
+	n, err := spec.Unmarshal(b)
+	if err != nil {
+		return 0, err
+	}
+	bin, ok := spec.Bin()
+	if !ok || len(b) < n+v.IntegrityHashSize {
+		return 0, ErrMalformedMessage
+	}
+
+	err = v.Handler(&codec.Integrity{
+		Address: codec.Address(bin),
+		Hash:    b[n : n+v.IntegrityHashSize],
+	})
+	return n + v.IntegrityHashSize, err
+}
+
+func (v Reader) readSignedIntegrity(b []byte) (int, error) {
+	var spec ChunkSpec
+	n, err := spec.Unmarshal(b)
+	if err != nil {
+		return 0, err
+	}
+	bin, ok := spec.Bin()
+	if !ok {
+		return 0, ErrMalformedMessage
+	}
+
+	var ts Timestamp
+	tn, err := ts.Unmarshal(b[n:])
+	if err != nil {
+		return 0, err
+	}
+	n += tn
+
+	if len(b) < n+v.IntegritySignatureSize {
+		return 0, ErrMalformedMessage
+	}
+
+	err = v.Handler(&codec.SignedIntegrity{
+		Address:   codec.Address(bin),
+		Timestamp: codec.Timestamp{Time: ts.Time},
+		Signature: b[n : n+v.IntegritySignatureSize],
+	})
+	return n + v.IntegritySignatureSize, err
+}
+
+func (v Reader) readRequest(b []byte) (int, error) {
+	var spec ChunkSpec
+	n, err := spec.Unmarshal(b)
+	if err != nil {
+		return 0, err
+	}
+
+	now := timeutil.Now()
+	for _, bin := range spec.Bins() {
+		err := v.Handler(&codec.Request{
+			Address:   codec.Address(bin),
+			Timestamp: codec.Timestamp{Time: now},
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+	return n, nil
+}
+
+func (v Reader) readCancel(b []byte) (int, error) {
+	var spec ChunkSpec
+	n, err := spec.Unmarshal(b)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, bin := range spec.Bins() {
+		if err := v.Handler(&codec.Cancel{Address: codec.Address(bin)}); err != nil {
+			return 0, err
+		}
+	}
+	return n, nil
+}
+
+// ReadHandshake reads the handshake message at the start of b. rfc 7574
+// option values are replaced with their native equivalents. Values with no
+// native equivalent are zeroed so they fail the handshake compatibility
+// checks. The ChannelID of the returned handshake is the sender's channel.
+func ReadHandshake(b []byte) (h codec.Handshake, n int, err error) {
+	if len(b) < 5 || MessageType(b[0]) != HandshakeMessage {
+		return h, 0, ErrMalformedMessage
+	}
+	h.ChannelID = binary.BigEndian.Uint32(b[1:])
+	n = 5
+
+	chunkSize := uint32(DefaultChunkSize)
+	for {
+		if n >= len(b) {
+			// a close handshake may omit the end option
+			if h.ChannelID == 0 {
+				return h, n, nil
+			}
+			return h, n, ErrMalformedMessage
+		}
+		t := ProtocolOptionType(b[n])
+		n++
+
+		var size int
+		switch t {
+		case VersionOption, MinimumVersionOption, ContentIntegrityProtectionMethodOption, MerkleHashTreeFunctionOption, LiveSignatureAlgorithmOption, ChunkAddressingMethodOption:
+			size = 1
+		case SwarmIdentifierOption:
+			if len(b) < n+2 {
+				return h, n, ErrMalformedMessage
+			}
+			size = 2 + int(binary.BigEndian.Uint16(b[n:]))
+		case LiveDiscardWindowOption, ChunkSizeOption:
+			size = 4
+		case SupportedMessagesOption:
+			if len(b) < n+1 {
+				return h, n, ErrMalformedMessage
+			}
+			size = 1 + int(b[n])
+		case EndOption:
+			if len(h.Options) != 0 {
+				h.Options = append(h.Options, &codec.ChunkSizeProtocolOption{Value: chunkSize})
+			}
+			return h, n, nil
+		default:
+			return h, n, ErrUnsupportedProtocolOption
+		}
+		if len(b) < n+size {
+			return h, n, ErrMalformedMessage
+		}
+		v := b[n : n+size]
+		n += size
+
+		switch t {
+		case VersionOption:
+			var version uint8
+			if v[0] >= ProtocolVersion {
+				version = ppspp.ProtocolVersion
+			}
+			h.Options = append(h.Options, &codec.VersionProtocolOption{Value: version})
+		case MinimumVersionOption:
+			version := ppspp.MinimumProtocolVersion
+			if v[0] > ProtocolVersion {
+				version = ppspp.ProtocolVersion + 1
+			}
+			h.Options = append(h.Options, &codec.MinimumVersionProtocolOption{Value: version})
+		case SwarmIdentifierOption:
+			h.Options = append(h.Options, codec.NewSwarmIdentifierProtocolOption(v[2:]))
+		case ContentIntegrityProtectionMethodOption:
+			h.Options = append(h.Options, &codec.ContentIntegrityProtectionMethodProtocolOption{
+				Value: uint8(protectionMethodFromRFC(v[0])),
+			})
+		case MerkleHashTreeFunctionOption:
+			h.Options = append(h.Options, &codec.MerkleHashTreeFunctionProtocolOption{
+				Value: uint8(merkleHashTreeFunctionFromRFC(v[0])),
+			})
+		case LiveSignatureAlgorithmOption:
+			h.Options = append(h.Options, &codec.LiveSignatureAlgorithmProtocolOption{
+				Value: uint8(liveSignatureAlgorithmFromRFC(v[0])),
+			})
+		case ChunkAddressingMethodOption:
+			if ChunkAddressingMethod(v[0]) != ChunkRange32ChunkAddressingMethod {
+				return h, n, ErrUnsupportedChunkAddressingMethod
+			}
+		case LiveDiscardWindowOption:
+			h.Options = append(h.Options, &codec.LiveWindowProtocolOption{Value: binary.BigEndian.Uint32(v)})
+		case ChunkSizeOption:
+			chunkSize = binary.BigEndian.Uint32(v)
+		}
+	}
+}
@@ -0,0 +1,712 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package rfc7574
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/MemeLabs/strims/pkg/options"
+	"github.com/MemeLabs/strims/pkg/ppspp"
+	"github.com/MemeLabs/strims/pkg/ppspp/codec"
+	"github.com/MemeLabs/strims/pkg/ppspp/integrity"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+	"go.uber.org/zap"
+)
+
+// DefaultDatagramSize fits in an ethernet frame with ipv4 and udp headers.
+const DefaultDatagramSize = 1472
+
+const (
+	maxDatagramSize   = 64 * 1024
+	keepAliveInterval = time.Minute
+	peerIdleTimeout   = 3 * time.Minute
+)
+
+// errors ...
+var (
+	ErrSwarmNotFound = errors.New("swarm not found")
+	errChannelClosed = errors.New("channel closed")
+)
+
+// TransportOptions ...
+type TransportOptions struct {
+	// DatagramSize is the largest datagram sent to peers.
+	DatagramSize int
+}
+
+// NewTransport ...
+func NewTransport(logger *zap.Logger, runner *ppspp.Runner, conn net.PacketConn, opt TransportOptions) *Transport {
+	opt = options.AssignDefaults(opt, TransportOptions{
+		DatagramSize: DefaultDatagramSize,
+	})
+
+	return &Transport{
+		logger:       logger,
+		runner:       runner,
+		conn:         conn,
+		datagramSize: opt.DatagramSize,
+		swarms:       map[string]*ppspp.Swarm{},
+		peers:        map[string]*transportPeer{},
+	}
+}
+
+// Transport runs swarms with peers that speak rfc 7574 over udp. Datagrams
+// from peers are translated to native messages for the runner and the
+// runner's output is translated back so the same schedulers serve vpn and
+// udp peers.
+type Transport struct {
+	logger       *zap.Logger
+	runner       *ppspp.Runner
+	conn         net.PacketConn
+	datagramSize int
+
+	lock   sync.Mutex
+	swarms map[string]*ppspp.Swarm
+	peers  map[string]*transportPeer
+}
+
+// AddSwarm makes s available to peers.
+func (t *Transport) AddSwarm(s *ppspp.Swarm) error {
+	o := s.Options()
+	if err := ValidateOptions(o.Integrity); err != nil {
+		return err
+	}
+	// rfc 7574 handshakes have no equivalent for these options
+	if o.ChunkAddressingMethod != codec.BinChunkAddressingMethod || o.VariableChunkSize || o.ContentEncryptionMethod != ppspp.NoneContentEncryptionMethod {
+		return ErrUnsupportedSwarmOptions
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.swarms[string(s.ID())] = s
+	return nil
+}
+
+// RemoveSwarm closes the channels for s.
+func (t *Transport) RemoveSwarm(s *ppspp.Swarm) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.swarms, string(s.ID()))
+	for _, p := range t.peers {
+		if c, ok := p.swarmChannel(s); ok {
+			p.closeChannel(c, true)
+		}
+	}
+}
+
+// Dial opens a channel for s with the peer at addr.
+func (t *Transport) Dial(addr net.Addr, s *ppspp.Swarm) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if _, ok := t.swarms[string(s.ID())]; !ok {
+		return ErrSwarmNotFound
+	}
+	_, err := t.peer(addr).openChannel(s, 0)
+	return err
+}
+
+// Run reads datagrams from the transport's conn until ctx is canceled or the
+// conn fails.
+func (t *Transport) Run(ctx context.Context) error {
+	stopTicker := timeutil.DefaultTickEmitter.SubscribeCtx(ctx, keepAliveInterval, t.tick, nil)
+	defer stopTicker()
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			t.conn.SetReadDeadline(time.Unix(1, 0))
+		case <-done:
+		}
+	}()
+
+	defer t.close()
+
+	b := make([]byte, maxDatagramSize)
+	for {
+		n, addr, err := t.conn.ReadFrom(b)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+
+		if err := t.handleDatagram(addr, b[:n]); err != nil {
+			t.logger.Debug(
+				"error handling datagram",
+				zap.Stringer("addr", addr),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// peer returns the peer for addr creating it if necessary. t.lock must be
+// held.
+func (t *Transport) peer(addr net.Addr) *transportPeer {
+	if p, ok := t.peers[addr.String()]; ok {
+		return p
+	}
+
+	w := &datagramWriter{t.conn, addr}
+	p := &transportPeer{
+		logger:   t.logger.With(zap.Stringer("addr", addr)),
+		dw:       w,
+		w:        NewWriter(w, t.datagramSize),
+		buf:      make([]byte, 0, t.datagramSize),
+		lastRead: timeutil.Now(),
+		channels: map[uint32]*transportChannel{},
+	}
+	p.reader, p.runnerPeer = t.runner.RunPeer([]byte(addr.String()), p)
+	p.reader.AllowMissingEpoch()
+	t.peers[addr.String()] = p
+	return p
+}
+
+func (t *Transport) handleDatagram(addr net.Addr, b []byte) error {
+	if len(b) < channelIDLen {
+		return ErrMalformedMessage
+	}
+	id := binary.BigEndian.Uint32(b)
+	b = b[channelIDLen:]
+
+	if id == 0 {
+		return t.handleOpen(addr, b)
+	}
+
+	t.lock.Lock()
+	p, ok := t.peers[addr.String()]
+	t.lock.Unlock()
+	if !ok {
+		return nil
+	}
+	return p.handleDatagram(id, b)
+}
+
+// handleOpen handles datagrams sent to channel 0 which must start with the
+// handshake opening a channel.
+func (t *Transport) handleOpen(addr net.Addr, b []byte) error {
+	h, _, err := ReadHandshake(b)
+	if err != nil {
+		return err
+	}
+	if h.ChannelID == 0 {
+		return nil
+	}
+	id, ok := h.Options.Find(codec.SwarmIdentifierOption)
+	if !ok {
+		return ErrMalformedMessage
+	}
+
+	t.lock.Lock()
+	s, ok := t.swarms[string(*id.(*codec.SwarmIdentifierProtocolOption))]
+	if !ok {
+		t.lock.Unlock()
+		return ErrSwarmNotFound
+	}
+	p := t.peer(addr)
+	t.lock.Unlock()
+
+	c, err := p.openChannel(s, h.ChannelID)
+	if err != nil {
+		return err
+	}
+	return p.handleDatagram(c.id, b)
+}
+
+func (t *Transport) tick(now timeutil.Time) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for k, p := range t.peers {
+		if now.Sub(p.LastRead()) > peerIdleTimeout {
+			p.runnerPeer.Stop()
+			delete(t.peers, k)
+			continue
+		}
+		p.keepAlive()
+	}
+}
+
+func (t *Transport) close() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for k, p := range t.peers {
+		p.runnerPeer.Stop()
+		delete(t.peers, k)
+	}
+}
+
+type datagramWriter struct {
+	conn net.PacketConn
+	addr net.Addr
+}
+
+func (w *datagramWriter) Write(p []byte) (int, error) {
+	return w.conn.WriteTo(p, w.addr)
+}
+
+type transportChannel struct {
+	id    uint32
+	swarm *ppspp.Swarm
+
+	// guarded by transportPeer.lock
+	peerID        uint32
+	handshake     []byte
+	handshakeSent bool
+	peerHandshake *codec.Handshake
+}
+
+// nativeHandshake adds the options native handshakes require that rfc 7574
+// handshakes can't carry. they're copied from the local swarm so the checks
+// for them pass trivially.
+func (c *transportChannel) nativeHandshake(h codec.Handshake) *codec.Handshake {
+	o := c.swarm.Options()
+
+	opts := append(codec.ProtocolOptions{}, h.Options...)
+	opts = append(
+		opts,
+		&codec.ChunksPerSignatureProtocolOption{Value: uint32(o.ChunksPerSignature)},
+		&codec.StreamCountProtocolOption{Value: uint16(o.StreamCount)},
+	)
+	if _, ok := opts.Find(codec.LiveWindowOption); !ok {
+		opts = append(opts, &codec.LiveWindowProtocolOption{Value: uint32(o.LiveWindow)})
+	}
+	if _, ok := opts.Find(codec.MerkleHashTreeFunctionOption); !ok {
+		opts = append(opts, &codec.MerkleHashTreeFunctionProtocolOption{Value: uint8(o.Integrity.MerkleHashTreeFunction)})
+	}
+	if _, ok := opts.Find(codec.LiveSignatureAlgorithmOption); !ok {
+		opts = append(opts, &codec.LiveSignatureAlgorithmProtocolOption{Value: uint8(integrity.LiveSignatureAlgorithmNone)})
+	}
+	if e := codec.NewEpochProtocolOption(c.swarm.Epoch()); e != nil {
+		opts = append(opts, e)
+	}
+
+	return &codec.Handshake{
+		ChannelID: h.ChannelID,
+		Options:   opts,
+	}
+}
+
+// transportPeer implements ppspp.Conn. The runner writes native frames which
+// are translated to datagrams when they're flushed.
+type transportPeer struct {
+	logger     *zap.Logger
+	dw         *datagramWriter
+	w          *Writer
+	buf        []byte
+	frames     []byte
+	reader     *ppspp.ChannelReader
+	runnerPeer *ppspp.RunnerPeer
+
+	lock     sync.Mutex
+	lastRead timeutil.Time
+	channels map[uint32]*transportChannel
+}
+
+// Available ...
+func (p *transportPeer) Available() int {
+	return cap(p.buf) - len(p.buf)
+}
+
+// AvailableBuffer ...
+func (p *transportPeer) AvailableBuffer() []byte {
+	return p.buf[len(p.buf):]
+}
+
+// Write ...
+func (p *transportPeer) Write(b []byte) (int, error) {
+	if len(b) > p.Available() {
+		return 0, codec.ErrNotEnoughSpace
+	}
+	p.buf = append(p.buf, b...)
+	return len(b), nil
+}
+
+// SetQOSWeight ...
+func (p *transportPeer) SetQOSWeight(w uint64) {}
+
+// Flush translates the buffered native frames and sends them to the peer.
+func (p *transportPeer) Flush() error {
+	defer func() { p.buf = p.buf[:0] }()
+
+	for b := p.buf; len(b) != 0; {
+		var h codec.ChannelHeader
+		n, err := h.Unmarshal(b)
+		if err != nil {
+			return err
+		}
+		frame := b[n : n+int(h.Length)]
+		b = b[n+int(h.Length):]
+
+		p.lock.Lock()
+		c, ok := p.channels[uint32(h.Channel)]
+		var peerID uint32
+		if ok {
+			peerID = c.peerID
+		}
+		p.lock.Unlock()
+		if !ok {
+			continue
+		}
+
+		if err := p.w.SetChannel(peerID); err != nil {
+			return err
+		}
+
+		o := c.swarm.Options()
+		r := codec.Reader{
+			ChunkSize:              o.ChunkSize,
+			IntegrityHashSize:      o.Integrity.MerkleHashTreeFunction.HashSize(),
+			IntegritySignatureSize: o.Integrity.LiveSignatureAlgorithm.SignatureSize(),
+			Handler:                &channelWriter{p, c, peerID},
+		}
+		if _, err := r.Read(frame); err != nil {
+			return err
+		}
+	}
+
+	return p.w.Flush()
+}
+
+// LastRead ...
+func (p *transportPeer) LastRead() timeutil.Time {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.lastRead
+}
+
+func (p *transportPeer) keepAlive() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for _, c := range p.channels {
+		if c.peerID == 0 {
+			continue
+		}
+		w := NewWriter(p.dw, channelIDLen)
+		w.SetChannel(c.peerID)
+		if err := w.WriteKeepAlive(); err != nil {
+			p.logger.Debug("error writing keep alive", zap.Error(err))
+		}
+	}
+}
+
+func (p *transportPeer) swarmChannel(s *ppspp.Swarm) (*transportChannel, bool) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for _, c := range p.channels {
+		if c.swarm == s {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+// openChannel starts running s with the peer. peerID is the peer's channel if
+// the peer opened the channel or 0 if we're dialing.
+func (p *transportPeer) openChannel(s *ppspp.Swarm, peerID uint32) (*transportChannel, error) {
+	if c, ok := p.swarmChannel(s); ok {
+		return c, nil
+	}
+
+	p.lock.Lock()
+	c := &transportChannel{
+		id:     p.allocChannelID(),
+		swarm:  s,
+		peerID: peerID,
+	}
+	p.channels[c.id] = c
+	p.lock.Unlock()
+
+	// the runner uses our channel id for both directions. frames are addressed
+	// to the peer's channel when they're translated.
+	if err := p.runnerPeer.RunSwarm(s, codec.Channel(c.id), codec.Channel(c.id)); err != nil {
+		p.lock.Lock()
+		delete(p.channels, c.id)
+		p.lock.Unlock()
+		return nil, err
+	}
+	return c, nil
+}
+
+// allocChannelID returns an unused random channel id. p.lock must be held.
+func (p *transportPeer) allocChannelID() uint32 {
+	var b [4]byte
+	for {
+		rand.Read(b[:])
+		id := binary.BigEndian.Uint32(b[:])
+		if _, ok := p.channels[id]; id != 0 && !ok {
+			return id
+		}
+	}
+}
+
+// closeChannel stops running the channel's swarm with the peer. if notify is
+// set the peer is sent a close handshake.
+func (p *transportPeer) closeChannel(c *transportChannel, notify bool) {
+	p.lock.Lock()
+	if _, ok := p.channels[c.id]; !ok {
+		p.lock.Unlock()
+		return
+	}
+	delete(p.channels, c.id)
+	peerID := c.peerID
+	p.lock.Unlock()
+
+	p.runnerPeer.StopSwarm(c.swarm)
+
+	if notify && peerID != 0 {
+		w := NewWriter(p.dw, channelIDLen+6)
+		w.SetChannel(peerID)
+		err := w.WriteClose()
+		if err == nil {
+			err = w.Flush()
+		}
+		if err != nil {
+			p.logger.Debug("error writing close handshake", zap.Error(err))
+		}
+	}
+}
+
+// handlePeerHandshake records the peer's channel and returns true if the
+// runner should resend its handshake and have bins.
+func (p *transportPeer) handlePeerHandshake(c *transportChannel, h *codec.Handshake) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	var restart bool
+	switch {
+	case c.peerHandshake != nil:
+		// the peer repeats its handshake when ours was lost
+		c.handshakeSent = false
+		restart = true
+	case c.peerID == 0:
+		// messages for dialed channels are dropped until the peer's channel is
+		// known
+		restart = true
+	}
+
+	c.peerID = h.ChannelID
+
+	opts := make(codec.ProtocolOptions, len(h.Options))
+	for i, o := range h.Options {
+		if id, ok := o.(*codec.SwarmIdentifierProtocolOption); ok {
+			o = codec.NewSwarmIdentifierProtocolOption(append([]byte(nil), *id...))
+		}
+		opts[i] = o
+	}
+	c.peerHandshake = &codec.Handshake{ChannelID: h.ChannelID, Options: opts}
+
+	return restart
+}
+
+func (p *transportPeer) handleDatagram(id uint32, b []byte) error {
+	p.lock.Lock()
+	c, ok := p.channels[id]
+	p.lastRead = timeutil.Now()
+	p.lock.Unlock()
+	if !ok || len(b) == 0 {
+		return nil
+	}
+
+	o := c.swarm.Options()
+	p.frames = p.frames[:0]
+	r := Reader{
+		ChunkSize:              o.ChunkSize,
+		IntegrityHashSize:      o.Integrity.MerkleHashTreeFunction.HashSize(),
+		IntegritySignatureSize: o.Integrity.LiveSignatureAlgorithm.SignatureSize(),
+		Handler: func(m codec.Message) error {
+			if h, ok := m.(*codec.Handshake); ok {
+				if h.ChannelID == 0 {
+					return errChannelClosed
+				}
+				restart := p.handlePeerHandshake(c, h)
+				p.frames = appendFrame(p.frames, c.id, c.nativeHandshake(*h))
+				if restart {
+					p.frames = appendFrame(p.frames, c.id, &codec.Restart{})
+				}
+				return nil
+			}
+			p.frames = appendFrame(p.frames, c.id, m)
+			return nil
+		},
+	}
+	_, rerr := r.Read(b)
+
+	if len(p.frames) != 0 {
+		if err := p.reader.HandleMessage(p.frames); err != nil {
+			p.closeChannel(c, true)
+			return err
+		}
+	}
+
+	if rerr == errChannelClosed {
+		p.closeChannel(c, false)
+		return nil
+	}
+	return rerr
+}
+
+// appendFrame appends m to b in its own native frame. Data messages without a
+// length prefix are only read correctly when they end a frame.
+func appendFrame(b []byte, channel uint32, m codec.Message) []byte {
+	h := codec.ChannelHeader{
+		Channel: codec.Channel(channel),
+		Length:  uint16(m.ByteLen() + codec.MessageTypeLen),
+	}
+
+	off := len(b)
+	n := h.ByteLen() + int(h.Length)
+	if cap(b)-off < n {
+		b = append(b, make([]byte, n)...)
+	} else {
+		b = b[:off+n]
+	}
+
+	off += h.Marshal(b[off:])
+	b[off] = byte(m.Type())
+	m.Marshal(b[off+codec.MessageTypeLen:])
+	return b
+}
+
+// channelWriter translates the native messages in frames written by the
+// runner. Messages for dialed channels are dropped until the peer's handshake
+// arrives because they can't be addressed to the peer's channel yet.
+type channelWriter struct {
+	p      *transportPeer
+	c      *transportChannel
+	peerID uint32
+}
+
+func (w *channelWriter) HandleHandshake(m codec.Handshake) error {
+	w.p.lock.Lock()
+	defer w.p.lock.Unlock()
+
+	if w.c.handshakeSent {
+		return nil
+	}
+
+	b, err := MarshalHandshake(w.c.id, m)
+	if err != nil {
+		return err
+	}
+	w.c.handshake = b
+	w.c.handshakeSent = true
+	return w.p.w.WriteMessage(b)
+}
+
+// HandleRestart is written by the runner when it hasn't received a handshake.
+// rfc 7574 has no equivalent so dialed channels retransmit the handshake that
+// opens the channel and open channels replay the peer's last handshake.
+func (w *channelWriter) HandleRestart(m codec.Restart) error {
+	w.p.lock.Lock()
+	handshake, peerHandshake := w.c.handshake, w.c.peerHandshake
+	w.p.lock.Unlock()
+
+	if w.peerID == 0 {
+		if handshake == nil {
+			return nil
+		}
+		return w.p.w.WriteMessage(handshake)
+	}
+
+	if peerHandshake != nil {
+		// the runner may be waiting on the peer to flush so the handshake is
+		// handled asynchronously.
+		frame := appendFrame(nil, w.c.id, w.c.nativeHandshake(*peerHandshake))
+		go func() {
+			if err := w.p.reader.HandleMessage(frame); err != nil {
+				w.p.logger.Debug("error replaying handshake", zap.Error(err))
+			}
+		}()
+	}
+	return nil
+}
+
+func (w *channelWriter) HandleData(m codec.Data) error {
+	if w.peerID == 0 {
+		return nil
+	}
+	return w.p.w.WriteData(m)
+}
+
+func (w *channelWriter) HandleAck(m codec.Ack) error {
+	if w.peerID == 0 {
+		return nil
+	}
+	return w.p.w.WriteAck(m)
+}
+
+func (w *channelWriter) HandleHave(m codec.Have) error {
+	if w.peerID == 0 {
+		return nil
+	}
+	return w.p.w.WriteHave(m)
+}
+
+func (w *channelWriter) HandleIntegrity(m codec.Integrity) error {
+	if w.peerID == 0 {
+		return nil
+	}
+	return w.p.w.WriteIntegrity(m)
+}
+
+func (w *channelWriter) HandleSignedIntegrity(m codec.SignedIntegrity) error {
+	if w.peerID == 0 {
+		return nil
+	}
+	return w.p.w.WriteSignedIntegrity(m)
+}
+
+func (w *channelWriter) HandleRequest(m codec.Request) error {
+	if w.peerID == 0 {
+		return nil
+	}
+	return w.p.w.WriteRequest(m)
+}
+
+func (w *channelWriter) HandleCancel(m codec.Cancel) error {
+	if w.peerID == 0 {
+		return nil
+	}
+	return w.p.w.WriteCancel(m)
+}
+
+func (w *channelWriter) HandleChoke(m codec.Choke) error {
+	if w.peerID == 0 {
+		return nil
+	}
+	return w.p.w.WriteChoke()
+}
+
+func (w *channelWriter) HandleUnchoke(m codec.Unchoke) error {
+	if w.peerID == 0 {
+		return nil
+	}
+	return w.p.w.WriteUnchoke()
+}
+
+// messages with no rfc 7574 equivalent are dropped.
+
+func (w *channelWriter) HandlePing(m codec.Ping) error                   { return nil }
+func (w *channelWriter) HandlePong(m codec.Pong) error                   { return nil }
+func (w *channelWriter) HandleStreamRequest(m codec.StreamRequest) error { return nil }
+func (w *channelWriter) HandleStreamCancel(m codec.StreamCancel) error   { return nil }
+func (w *channelWriter) HandleStreamOpen(m codec.StreamOpen) error       { return nil }
+func (w *channelWriter) HandleStreamClose(m codec.StreamClose) error     { return nil }
+func (w *channelWriter) HandlePexRequest(m codec.PexRequest) error       { return nil }
+func (w *channelWriter) HandlePexResponse(m codec.PexResponse) error     { return nil }
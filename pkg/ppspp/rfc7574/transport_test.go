@@ -0,0 +1,108 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package rfc7574
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/MemeLabs/strims/pkg/ppspp"
+	"github.com/MemeLabs/strims/pkg/ppspp/integrity"
+	"github.com/MemeLabs/strims/pkg/ppspp/ppspptest"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestTransport(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	key := ppspptest.Key()
+	options := ppspp.SwarmOptions{
+		LiveWindow: 1 << 12,
+		ChunkSize:  DefaultChunkSize,
+		Integrity: integrity.VerifierOptions{
+			ProtectionMethod:       integrity.ProtectionMethodMerkleTree,
+			MerkleHashTreeFunction: integrity.MerkleHashTreeFunctionSHA256,
+			LiveSignatureAlgorithm: integrity.LiveSignatureAlgorithmED25519,
+		},
+	}
+
+	src, err := ppspp.NewWriter(ppspp.WriterOptions{
+		SwarmOptions: options,
+		Key:          key,
+	})
+	assert.NoError(t, err)
+
+	dst, err := ppspp.NewSwarm(ppspp.NewSwarmID(key.Public), options)
+	assert.NoError(t, err)
+
+	newTransport := func(s *ppspp.Swarm) (*Transport, net.Addr) {
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		assert.NoError(t, err)
+		t.Cleanup(func() { conn.Close() })
+
+		tr := NewTransport(zap.NewNop(), ppspp.NewRunner(ctx, zap.NewNop()), conn, TransportOptions{})
+		assert.NoError(t, tr.AddSwarm(s))
+		go tr.Run(ctx)
+		return tr, conn.LocalAddr()
+	}
+
+	_, srcAddr := newTransport(src.Swarm())
+	dstTransport, _ := newTransport(dst)
+	assert.NoError(t, dstTransport.Dial(srcAddr, dst))
+
+	go func() {
+		b := make([]byte, options.ChunkSize*16)
+		ticker := time.NewTicker(50 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				rand.Read(b)
+				src.Write(b)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := io.CopyN(io.Discard, dst.Reader(), 64*1024)
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out reading from udp peer")
+	}
+}
+
+func TestTransportUnsupportedSwarm(t *testing.T) {
+	s, err := ppspp.NewSwarm(ppspp.NewSwarmID(ppspptest.Key().Public), ppspp.NewDefaultSwarmOptions())
+	assert.NoError(t, err)
+
+	tr := NewTransport(zap.NewNop(), nil, nil, TransportOptions{})
+	assert.ErrorIs(t, tr.AddSwarm(s), ErrUnsupportedSwarmOptions)
+
+	o := s.Options()
+	o.Integrity.MerkleHashTreeFunction = integrity.MerkleHashTreeFunctionSHA256
+	o.VariableChunkSize = true
+	s, err = ppspp.NewSwarm(ppspp.NewSwarmID(ppspptest.Key().Public), o)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, tr.AddSwarm(s), ErrUnsupportedSwarmOptions)
+
+	o.VariableChunkSize = false
+	o.ContentEncryptionMethod = ppspp.AES256CTRContentEncryptionMethod
+	s, err = ppspp.NewSwarm(ppspp.NewSwarmID(ppspptest.Key().Public), o)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, tr.AddSwarm(s), ErrUnsupportedSwarmOptions)
+}
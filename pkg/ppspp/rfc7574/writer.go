@@ -0,0 +1,316 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package rfc7574
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/MemeLabs/strims/pkg/ppspp/codec"
+	"github.com/MemeLabs/strims/pkg/ppspp/integrity"
+)
+
+// ErrMessageTooLarge is returned when a message doesn't fit in an empty
+// datagram.
+var ErrMessageTooLarge = errors.New("message exceeds datagram size")
+
+const channelIDLen = 4
+
+// NewWriter returns a Writer that packs messages into datagrams of at most
+// size bytes and writes them to w.
+func NewWriter(w io.Writer, size int) *Writer {
+	return &Writer{
+		w:   w,
+		buf: make([]byte, 0, size),
+	}
+}
+
+// Writer translates native messages into rfc 7574 datagrams.
+type Writer struct {
+	w       io.Writer
+	buf     []byte
+	channel uint32
+}
+
+// SetChannel flushes buffered messages and sends subsequent messages to the
+// remote channel c.
+func (w *Writer) SetChannel(c uint32) error {
+	if c == w.channel {
+		return nil
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	w.channel = c
+	return nil
+}
+
+// Flush writes the buffered datagram.
+func (w *Writer) Flush() error {
+	if len(w.buf) <= channelIDLen {
+		w.buf = w.buf[:0]
+		return nil
+	}
+
+	_, err := w.w.Write(w.buf)
+	w.buf = w.buf[:0]
+	return err
+}
+
+// WriteKeepAlive writes a datagram with no messages.
+func (w *Writer) WriteKeepAlive() error {
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	w.buf = binary.BigEndian.AppendUint32(w.buf, w.channel)
+	_, err := w.w.Write(w.buf)
+	w.buf = w.buf[:0]
+	return err
+}
+
+func (w *Writer) alloc(n int) ([]byte, error) {
+	if len(w.buf) != 0 && len(w.buf)+n > cap(w.buf) {
+		if err := w.Flush(); err != nil {
+			return nil, err
+		}
+	}
+	if len(w.buf) == 0 {
+		w.buf = binary.BigEndian.AppendUint32(w.buf, w.channel)
+	}
+	if len(w.buf)+n > cap(w.buf) {
+		return nil, ErrMessageTooLarge
+	}
+
+	off := len(w.buf)
+	w.buf = w.buf[:off+n]
+	return w.buf[off:], nil
+}
+
+// WriteMessage writes a message encoded by one of the Marshal functions.
+func (w *Writer) WriteMessage(m []byte) error {
+	b, err := w.alloc(len(m))
+	if err != nil {
+		return err
+	}
+	copy(b, m)
+	return nil
+}
+
+// WriteHandshake writes a handshake from the local channel source.
+func (w *Writer) WriteHandshake(source uint32, m codec.Handshake) error {
+	b, err := MarshalHandshake(source, m)
+	if err != nil {
+		return err
+	}
+	return w.WriteMessage(b)
+}
+
+// WriteClose writes the handshake that closes the remote channel.
+func (w *Writer) WriteClose() error {
+	b, err := w.alloc(channelIDLen + 2)
+	if err != nil {
+		return err
+	}
+	b[0] = byte(HandshakeMessage)
+	binary.BigEndian.PutUint32(b[1:], 0)
+	b[5] = byte(EndOption)
+	return nil
+}
+
+// MarshalHandshake encodes m as an rfc 7574 handshake from the local channel
+// source. Options are written in ascending order and options with no rfc 7574
+// equivalent are dropped. A handshake with no options closes the channel.
+func MarshalHandshake(source uint32, m codec.Handshake) ([]byte, error) {
+	b := []byte{byte(HandshakeMessage)}
+	b = binary.BigEndian.AppendUint32(b, source)
+
+	opts := map[codec.ProtocolOptionType]codec.ProtocolOption{}
+	for _, o := range m.Options {
+		opts[o.Type()] = o
+	}
+
+	if _, ok := opts[codec.VersionOption]; ok {
+		b = append(b, byte(VersionOption), ProtocolVersion)
+	}
+	if _, ok := opts[codec.MinimumVersionOption]; ok {
+		b = append(b, byte(MinimumVersionOption), ProtocolVersion)
+	}
+	if o, ok := opts[codec.SwarmIdentifierOption]; ok {
+		id := *o.(*codec.SwarmIdentifierProtocolOption)
+		b = append(b, byte(SwarmIdentifierOption))
+		b = binary.BigEndian.AppendUint16(b, uint16(len(id)))
+		b = append(b, id...)
+	}
+
+	var method integrity.ProtectionMethod
+	if o, ok := opts[codec.ContentIntegrityProtectionMethodOption]; ok {
+		method = integrity.ProtectionMethod(o.(*codec.ContentIntegrityProtectionMethodProtocolOption).Value)
+		v, ok := protectionMethodToRFC(method)
+		if !ok {
+			return nil, ErrUnsupportedSwarmOptions
+		}
+		b = append(b, byte(ContentIntegrityProtectionMethodOption), v)
+	}
+	if o, ok := opts[codec.MerkleHashTreeFunctionOption]; ok && method == integrity.ProtectionMethodMerkleTree {
+		v, ok := merkleHashTreeFunctionToRFC(integrity.MerkleHashTreeFunction(o.(*codec.MerkleHashTreeFunctionProtocolOption).Value))
+		if !ok {
+			return nil, ErrUnsupportedSwarmOptions
+		}
+		b = append(b, byte(MerkleHashTreeFunctionOption), v)
+	}
+	if o, ok := opts[codec.LiveSignatureAlgorithmOption]; ok {
+		a := integrity.LiveSignatureAlgorithm(o.(*codec.LiveSignatureAlgorithmProtocolOption).Value)
+		if a != integrity.LiveSignatureAlgorithmNone {
+			v, ok := liveSignatureAlgorithmToRFC(a)
+			if !ok {
+				return nil, ErrUnsupportedSwarmOptions
+			}
+			b = append(b, byte(LiveSignatureAlgorithmOption), v)
+		}
+	}
+
+	if len(opts) != 0 {
+		b = append(b, byte(ChunkAddressingMethodOption), byte(ChunkRange32ChunkAddressingMethod))
+	}
+	if o, ok := opts[codec.LiveWindowOption]; ok {
+		b = append(b, byte(LiveDiscardWindowOption))
+		b = binary.BigEndian.AppendUint32(b, o.(*codec.LiveWindowProtocolOption).Value)
+	}
+	if len(opts) != 0 {
+		b = append(b, byte(SupportedMessagesOption))
+		b = appendSupportedMessages(b)
+	}
+	if o, ok := opts[codec.ChunkSizeOption]; ok {
+		b = append(b, byte(ChunkSizeOption))
+		b = binary.BigEndian.AppendUint32(b, o.(*codec.ChunkSizeProtocolOption).Value)
+	}
+
+	return append(b, byte(EndOption)), nil
+}
+
+// appendSupportedMessages appends the length prefixed supported messages
+// bitmap. the most significant bit of the first byte is message type 0.
+func appendSupportedMessages(b []byte) []byte {
+	var bitmap [2]byte
+	for _, t := range supportedMessages {
+		bitmap[t/8] |= 0x80 >> (t % 8)
+	}
+	b = append(b, byte(len(bitmap)))
+	return append(b, bitmap[:]...)
+}
+
+// WriteData ...
+func (w *Writer) WriteData(m codec.Data) error {
+	spec, err := NewChunkSpec(m.Address.Bin())
+	if err != nil {
+		return err
+	}
+	b, err := w.alloc(1 + spec.ByteLen() + Timestamp{}.ByteLen() + len(m.Data))
+	if err != nil {
+		return err
+	}
+	b[0] = byte(DataMessage)
+	n := 1 + spec.Marshal(b[1:])
+	n += Timestamp{m.Timestamp.Time}.Marshal(b[n:])
+	copy(b[n:], m.Data)
+	return nil
+}
+
+// WriteAck ...
+func (w *Writer) WriteAck(m codec.Ack) error {
+	spec, err := NewChunkSpec(m.Address.Bin())
+	if err != nil {
+		return err
+	}
+	b, err := w.alloc(1 + spec.ByteLen() + DelaySample{}.ByteLen())
+	if err != nil {
+		return err
+	}
+	b[0] = byte(AckMessage)
+	n := 1 + spec.Marshal(b[1:])
+	DelaySample{m.DelaySample.Duration}.Marshal(b[n:])
+	return nil
+}
+
+// WriteIntegrity ...
+func (w *Writer) WriteIntegrity(m codec.Integrity) error {
+	spec, err := NewChunkSpec(m.Address.Bin())
+	if err != nil {
+		return err
+	}
+	b, err := w.alloc(1 + spec.ByteLen() + len(m.Hash))
+	if err != nil {
+		return err
+	}
+	b[0] = byte(IntegrityMessage)
+	n := 1 + spec.Marshal(b[1:])
+	copy(b[n:], m.Hash)
+	return nil
+}
+
+// WriteSignedIntegrity ...
+func (w *Writer) WriteSignedIntegrity(m codec.SignedIntegrity) error {
+	spec, err := NewChunkSpec(m.Address.Bin())
+	if err != nil {
+		return err
+	}
+	b, err := w.alloc(1 + spec.ByteLen() + Timestamp{}.ByteLen() + len(m.Signature))
+	if err != nil {
+		return err
+	}
+	b[0] = byte(SignedIntegrityMessage)
+	n := 1 + spec.Marshal(b[1:])
+	n += Timestamp{m.Timestamp.Time}.Marshal(b[n:])
+	copy(b[n:], m.Signature)
+	return nil
+}
+
+// WriteHave ...
+func (w *Writer) WriteHave(m codec.Have) error {
+	return w.writeChunkSpec(HaveMessage, m.Address)
+}
+
+// WriteRequest ...
+func (w *Writer) WriteRequest(m codec.Request) error {
+	return w.writeChunkSpec(RequestMessage, m.Address)
+}
+
+// WriteCancel ...
+func (w *Writer) WriteCancel(m codec.Cancel) error {
+	return w.writeChunkSpec(CancelMessage, m.Address)
+}
+
+func (w *Writer) writeChunkSpec(t MessageType, a codec.Address) error {
+	spec, err := NewChunkSpec(a.Bin())
+	if err != nil {
+		return err
+	}
+	b, err := w.alloc(1 + spec.ByteLen())
+	if err != nil {
+		return err
+	}
+	b[0] = byte(t)
+	spec.Marshal(b[1:])
+	return nil
+}
+
+// WriteChoke ...
+func (w *Writer) WriteChoke() error {
+	return w.writeEmpty(ChokeMessage)
+}
+
+// WriteUnchoke ...
+func (w *Writer) WriteUnchoke() error {
+	return w.writeEmpty(UnchokeMessage)
+}
+
+func (w *Writer) writeEmpty(t MessageType) error {
+	b, err := w.alloc(1)
+	if err != nil {
+		return err
+	}
+	b[0] = byte(t)
+	return nil
+}
@@ -0,0 +1,195 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package rfc7574
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/MemeLabs/strims/pkg/binmap"
+	"github.com/MemeLabs/strims/pkg/ppspp"
+	"github.com/MemeLabs/strims/pkg/ppspp/codec"
+	"github.com/MemeLabs/strims/pkg/ppspp/integrity"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type datagramRecorder struct {
+	datagrams [][]byte
+}
+
+func (r *datagramRecorder) Write(p []byte) (int, error) {
+	r.datagrams = append(r.datagrams, append([]byte(nil), p...))
+	return len(p), nil
+}
+
+func TestHandshakeMarshalUnmarshal(t *testing.T) {
+	src := codec.Handshake{
+		Options: []codec.ProtocolOption{
+			codec.NewSwarmIdentifierProtocolOption([]byte("swarm id")),
+			&codec.VersionProtocolOption{Value: ppspp.ProtocolVersion},
+			&codec.MinimumVersionProtocolOption{Value: ppspp.MinimumProtocolVersion},
+			&codec.LiveWindowProtocolOption{Value: 1 << 16},
+			&codec.ChunkSizeProtocolOption{Value: 1024},
+			&codec.ContentIntegrityProtectionMethodProtocolOption{Value: uint8(integrity.ProtectionMethodMerkleTree)},
+			&codec.MerkleHashTreeFunctionProtocolOption{Value: uint8(integrity.MerkleHashTreeFunctionSHA256)},
+			&codec.LiveSignatureAlgorithmProtocolOption{Value: uint8(integrity.LiveSignatureAlgorithmED25519)},
+			&codec.ChunksPerSignatureProtocolOption{Value: 64},
+			&codec.StreamCountProtocolOption{Value: 16},
+		},
+	}
+
+	b, err := MarshalHandshake(1234, src)
+	assert.NoError(t, err)
+
+	dst, n, err := ReadHandshake(b)
+	assert.NoError(t, err)
+	assert.Equal(t, len(b), n)
+	assert.EqualValues(t, 1234, dst.ChannelID)
+
+	expected := codec.ProtocolOptions{
+		&codec.VersionProtocolOption{Value: ppspp.ProtocolVersion},
+		&codec.MinimumVersionProtocolOption{Value: ppspp.MinimumProtocolVersion},
+		codec.NewSwarmIdentifierProtocolOption([]byte("swarm id")),
+		&codec.ContentIntegrityProtectionMethodProtocolOption{Value: uint8(integrity.ProtectionMethodMerkleTree)},
+		&codec.MerkleHashTreeFunctionProtocolOption{Value: uint8(integrity.MerkleHashTreeFunctionSHA256)},
+		&codec.LiveSignatureAlgorithmProtocolOption{Value: uint8(integrity.LiveSignatureAlgorithmED25519)},
+		&codec.LiveWindowProtocolOption{Value: 1 << 16},
+		&codec.ChunkSizeProtocolOption{Value: 1024},
+	}
+	assert.Equal(t, expected, dst.Options)
+}
+
+func TestHandshakeUnsupportedOptions(t *testing.T) {
+	_, err := MarshalHandshake(1, codec.Handshake{
+		Options: []codec.ProtocolOption{
+			&codec.ContentIntegrityProtectionMethodProtocolOption{Value: uint8(integrity.ProtectionMethodMerkleTree)},
+			&codec.MerkleHashTreeFunctionProtocolOption{Value: uint8(integrity.MerkleHashTreeFunctionBLAKE2B256)},
+		},
+	})
+	assert.ErrorIs(t, err, ErrUnsupportedSwarmOptions)
+
+	b := []byte{byte(HandshakeMessage), 0, 0, 0, 1, byte(ChunkAddressingMethodOption), byte(Bin32ChunkAddressingMethod), byte(EndOption)}
+	_, _, err = ReadHandshake(b)
+	assert.ErrorIs(t, err, ErrUnsupportedChunkAddressingMethod)
+}
+
+func TestWriterReader(t *testing.T) {
+	const chunkSize = 16
+	ts := timeutil.Unix(1650000000, int64(250*time.Millisecond))
+
+	var rec datagramRecorder
+	w := NewWriter(&rec, 128)
+	assert.NoError(t, w.SetChannel(42))
+
+	data := bytes.Repeat([]byte{0xaa}, 4*chunkSize)
+	hash := bytes.Repeat([]byte{0xbb}, 32)
+	sig := bytes.Repeat([]byte{0xcc}, 64)
+
+	src := []codec.Message{
+		&codec.Have{Address: codec.Address(binmap.NewBin(2, 1))},
+		&codec.Request{Address: codec.Address(binmap.NewBin(0, 9))},
+		&codec.Cancel{Address: codec.Address(binmap.NewBin(1, 3))},
+		&codec.Ack{Address: codec.Address(binmap.NewBin(0, 3)), DelaySample: codec.DelaySample{Duration: 5 * time.Millisecond}},
+		&codec.Integrity{Address: codec.Address(binmap.NewBin(3, 0)), Hash: hash},
+		&codec.SignedIntegrity{Address: codec.Address(binmap.NewBin(6, 0)), Timestamp: codec.Timestamp{Time: ts}, Signature: sig},
+		&codec.Choke{},
+		&codec.Unchoke{},
+		codec.NewData(chunkSize, binmap.NewBin(2, 1), ts, data),
+	}
+	for _, m := range src {
+		var err error
+		switch m := m.(type) {
+		case *codec.Have:
+			err = w.WriteHave(*m)
+		case *codec.Request:
+			err = w.WriteRequest(*m)
+		case *codec.Cancel:
+			err = w.WriteCancel(*m)
+		case *codec.Ack:
+			err = w.WriteAck(*m)
+		case *codec.Integrity:
+			err = w.WriteIntegrity(*m)
+		case *codec.SignedIntegrity:
+			err = w.WriteSignedIntegrity(*m)
+		case *codec.Choke:
+			err = w.WriteChoke()
+		case *codec.Unchoke:
+			err = w.WriteUnchoke()
+		case *codec.Data:
+			err = w.WriteData(*m)
+		}
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, w.Flush())
+	assert.Greater(t, len(rec.datagrams), 1, "messages should be split across datagrams")
+
+	var dst []codec.Message
+	r := Reader{
+		ChunkSize:              chunkSize,
+		IntegrityHashSize:      len(hash),
+		IntegritySignatureSize: len(sig),
+		Handler: func(m codec.Message) error {
+			dst = append(dst, m)
+			return nil
+		},
+	}
+	for _, d := range rec.datagrams {
+		assert.LessOrEqual(t, len(d), 128)
+		assert.EqualValues(t, 42, binary.BigEndian.Uint32(d))
+		_, err := r.Read(d[channelIDLen:])
+		assert.NoError(t, err)
+	}
+
+	if assert.Equal(t, len(src), len(dst)) {
+		for i := range src {
+			assert.Equal(t, src[i].Type(), dst[i].Type())
+			assert.Equal(t, marshalMessage(src[i]), marshalMessage(dst[i]), "message %d", i)
+		}
+	}
+}
+
+func TestReaderSplitsRanges(t *testing.T) {
+	const chunkSize = 4
+
+	var rec datagramRecorder
+	w := NewWriter(&rec, DefaultDatagramSize)
+	b, err := w.alloc(1 + 8 + 8 + 3*chunkSize)
+	assert.NoError(t, err)
+	b[0] = byte(DataMessage)
+	n := 1 + ChunkSpec{3, 5}.Marshal(b[1:])
+	n += Timestamp{timeutil.Unix(1, 0)}.Marshal(b[n:])
+	copy(b[n:], []byte("aaaabbbbcccc"))
+	assert.NoError(t, w.Flush())
+
+	var dst []*codec.Data
+	r := Reader{
+		ChunkSize: chunkSize,
+		Handler: func(m codec.Message) error {
+			dst = append(dst, m.(*codec.Data))
+			return nil
+		},
+	}
+	_, err = r.Read(rec.datagrams[0][channelIDLen:])
+	assert.NoError(t, err)
+
+	if assert.Len(t, dst, 2) {
+		assert.Equal(t, binmap.NewBin(0, 3), dst[0].Address.Bin())
+		assert.EqualValues(t, "aaaa", dst[0].Data)
+		assert.Equal(t, binmap.NewBin(1, 2), dst[1].Address.Bin())
+		assert.EqualValues(t, "bbbbcccc", dst[1].Data)
+	}
+}
+
+func marshalMessage(m codec.Message) []byte {
+	if r, ok := m.(*codec.Request); ok {
+		// request timestamps are set by the reader
+		r.Timestamp = codec.Timestamp{}
+	}
+	b := make([]byte, m.ByteLen())
+	m.Marshal(b)
+	return b
+}
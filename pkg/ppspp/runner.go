@@ -7,12 +7,16 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"math"
+	"math/rand"
 	"sync"
 	"time"
 
+	"github.com/MemeLabs/strims/pkg/errutil"
 	"github.com/MemeLabs/strims/pkg/ioutil"
 	"github.com/MemeLabs/strims/pkg/logutil"
 	"github.com/MemeLabs/strims/pkg/ppspp/codec"
+	"github.com/MemeLabs/strims/pkg/randutil"
 	"github.com/MemeLabs/strims/pkg/stats"
 	"github.com/MemeLabs/strims/pkg/timeutil"
 	"github.com/MemeLabs/strims/pkg/vnic/qos"
@@ -34,21 +38,52 @@ type runnerSwarm struct {
 	peers      map[*peer]codec.Channel
 }
 
+// RunnerOptions ...
+type RunnerOptions struct {
+	// TickEmitter drives the schedulers and peer writes. Defaults to
+	// timeutil.DefaultTickEmitter.
+	TickEmitter *timeutil.TickEmitter
+	// Manual peers only write when RunnerPeer.Step is called.
+	Manual bool
+	// RandSeed seeds the schedulers' random choices. If zero a random seed is
+	// used.
+	RandSeed int64
+}
+
 func NewRunner(ctx context.Context, logger *zap.Logger) *Runner {
+	return NewRunnerWithOptions(ctx, logger, RunnerOptions{})
+}
+
+func NewRunnerWithOptions(ctx context.Context, logger *zap.Logger, o RunnerOptions) *Runner {
+	if o.TickEmitter == nil {
+		o.TickEmitter = timeutil.DefaultTickEmitter
+	}
+	if o.RandSeed == 0 {
+		o.RandSeed = errutil.Must(randutil.Int63n(math.MaxInt64))
+	}
+
 	r := &Runner{
-		logger: logger,
-		swarms: map[*Swarm]*runnerSwarm{},
-		peers:  map[*peer]*ChannelReader{},
+		logger:      logger,
+		te:          o.TickEmitter,
+		manual:      o.Manual,
+		rng:         rand.New(rand.NewSource(o.RandSeed)),
+		swarms:      map[*Swarm]*runnerSwarm{},
+		peers:       map[*peer]*ChannelReader{},
+		reputations: newPeerReputations(),
 	}
-	timeutil.DefaultTickEmitter.SubscribeCtx(ctx, peerQOSUpdateInterval, r.updatePeerWeights, nil)
+	r.te.SubscribeCtx(ctx, peerQOSUpdateInterval, r.updatePeerWeights, nil)
 	return r
 }
 
 type Runner struct {
-	logger *zap.Logger
-	lock   sync.Mutex
-	swarms map[*Swarm]*runnerSwarm
-	peers  map[*peer]*ChannelReader
+	logger      *zap.Logger
+	te          *timeutil.TickEmitter
+	manual      bool
+	rng         *rand.Rand
+	lock        sync.Mutex
+	swarms      map[*Swarm]*runnerSwarm
+	peers       map[*peer]*ChannelReader
+	reputations *peerReputations
 }
 
 // runSwarmPeer ...
@@ -59,10 +94,11 @@ func (r *Runner) runSwarmPeer(s *Swarm, p *peer, channel, peerChannel codec.Chan
 	rs := r.swarms[s]
 	if rs == nil {
 		logger := r.logger.With(logutil.ByteHex("swarm", bytes.NewBuffer(s.id).Next(8)))
-		ss := s.options.SchedulingMethod.swarmScheduler(logger, s)
+		rng := rand.New(rand.NewSource(r.rng.Int63()))
+		ss := s.options.SchedulingMethod.swarmScheduler(logger, s, r.reputations, rng)
 		rs = &runnerSwarm{
 			scheduler:  ss,
-			stopTicker: timeutil.DefaultTickEmitter.DefaultSubscribe(ss.Run),
+			stopTicker: r.te.DefaultSubscribe(ss.Run),
 			peers:      map[*peer]codec.Channel{},
 		}
 		s.pubSub.Subscribe(ss)
@@ -80,15 +116,17 @@ func (r *Runner) runSwarmPeer(s *Swarm, p *peer, channel, peerChannel codec.Chan
 	rs.peers[p] = channel
 
 	sm := newPeerSwarmMetrics(p.m)
-	p.sm.Set(s, sm)
 
 	cwm := newPeerChannelWriterMetrics(p.m, sm)
 	crm := newPeerChannelReaderMetrics(p.m, sm)
 
-	cw := newChannelWriter(newChannelWriterMetrics(s, p, cwm), p.w, peerChannel)
+	cw := newChannelWriter(newChannelWriterMetrics(s, p, cwm), p.w, peerChannel, s)
 	cs := rs.scheduler.ChannelScheduler(p, cw)
 	cr.openChannel(channel, newChannelReaderMetrics(s, p, crm), cs, s)
 
+	sm.scheduler = cs
+	p.sm.Set(s, sm)
+
 	return nil
 }
 
@@ -127,7 +165,7 @@ func (r *Runner) stopChannel(s *Swarm, p *peer, rs *runnerSwarm, cr *ChannelRead
 }
 
 func (r *Runner) RunPeer(id []byte, w Conn) (*ChannelReader, *RunnerPeer) {
-	p := newPeer(id, w, timeutil.DefaultTickEmitter.DefaultTicker())
+	p := newPeer(id, w, r.te.DefaultTicker(), r.manual)
 	cr := newChannelReader(r.logger.With(logutil.ByteHex("peer", id)))
 
 	r.lock.Lock()
@@ -160,6 +198,11 @@ func (r *Runner) updatePeerWeights(t timeutil.Time) {
 	r.lock.Lock()
 	defer r.lock.Unlock()
 
+	for p := range r.peers {
+		r.reputations.Touch(p.id)
+	}
+	r.reputations.Prune(t.Add(-reputationTTL))
+
 	var totalBytes uint64
 	for p := range r.peers {
 		totalBytes += p.m.ReadDataBytesRate(t)
@@ -183,7 +226,26 @@ func (r *Runner) updatePeerWeights(t timeutil.Time) {
 
 type PeerMetricsSnapshot struct {
 	MetricsSnapshot
-	Swarms map[*Swarm]MetricsSnapshot
+	Swarms map[*Swarm]SwarmMetricsSnapshot
+}
+
+type SwarmMetricsSnapshot struct {
+	MetricsSnapshot
+	Scheduler SchedulerMetricsSnapshot
+}
+
+// SchedulerMetricsSnapshot describes the state of the scheduler for one
+// swarm/peer channel.
+type SchedulerMetricsSnapshot struct {
+	RTT             time.Duration
+	RTTVar          time.Duration
+	CWND            int
+	FlightSize      int
+	RequestedChunks uint64
+	ReceivedChunks  uint64
+	InvalidChunks   uint64
+	Choked          bool // the peer is choking us
+	PeerChoked      bool // we are choking the peer
 }
 
 type MetricsSnapshot struct {
@@ -257,17 +319,23 @@ func newPeerSwarmMetrics(pm *peerMetrics) *peerSwarmMetrics {
 
 type peerSwarmMetrics struct {
 	*peerMetrics
-	reader transferMetrics
-	writer transferMetrics
+	reader    transferMetrics
+	writer    transferMetrics
+	scheduler channelScheduler
 }
 
-func (m *peerSwarmMetrics) Snapshot(t timeutil.Time) MetricsSnapshot {
+func (m *peerSwarmMetrics) Snapshot(t timeutil.Time) SwarmMetricsSnapshot {
 	m.peerMetrics.lock.Lock()
-	defer m.peerMetrics.lock.Unlock()
-	return MetricsSnapshot{
+	snap := MetricsSnapshot{
 		Read:  m.reader.Snapshot(t),
 		Write: m.writer.Snapshot(t),
 	}
+	m.peerMetrics.lock.Unlock()
+
+	return SwarmMetricsSnapshot{
+		MetricsSnapshot: snap,
+		Scheduler:       m.scheduler.MetricsSnapshot(),
+	}
 }
 
 func newPeerChannelReaderMetrics(pm *peerMetrics, sm *peerSwarmMetrics) *peerChannelMetrics {
@@ -315,13 +383,20 @@ func (p *RunnerPeer) StopSwarm(s *Swarm) {
 	p.r.stopSwarmPeer(s, p.p)
 }
 
+// Step writes any messages the peer's channels have enqueued. Messages that
+// wait for the next tick are only written when tick is set. Step is only
+// used by runners created with RunnerOptions.Manual.
+func (p *RunnerPeer) Step(tick bool) error {
+	return p.p.step(tick)
+}
+
 // Stop ...
 func (p *RunnerPeer) Stop() {
 	p.r.tryStopPeer(p.p)
 }
 
 func (p *RunnerPeer) MetricsSnapshot(t timeutil.Time) PeerMetricsSnapshot {
-	swarms := map[*Swarm]MetricsSnapshot{}
+	swarms := map[*Swarm]SwarmMetricsSnapshot{}
 	p.p.sm.Each(func(s *Swarm, m *peerSwarmMetrics) {
 		swarms[s] = m.Snapshot(t)
 	})
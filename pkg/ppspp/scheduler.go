@@ -4,6 +4,7 @@
 package ppspp
 
 import (
+	"math/rand"
 	"time"
 
 	"github.com/MemeLabs/strims/pkg/binmap"
@@ -29,7 +30,7 @@ type peerTaskRunner interface {
 type channelScheduler interface {
 	peerTaskRunner
 	ExpectData(b binmap.Bin) bool
-	HandleHandshake(liveWindow uint32) error
+	HandleHandshake(liveWindow uint32, peerExchange bool) error
 	HandleRestart() error
 	HandleAck(b binmap.Bin, delaySample time.Duration) error
 	HandleData(b binmap.Bin, t timeutil.Time, valid bool) error
@@ -44,7 +45,10 @@ type channelScheduler interface {
 	HandleStreamCancel(s codec.Stream) error
 	HandleStreamOpen(s codec.Stream, b binmap.Bin) error
 	HandleStreamClose(s codec.Stream) error
+	HandlePexRequest() error
+	HandlePexResponse(peerID []byte) error
 	HandleMessageEnd() error
+	MetricsSnapshot() SchedulerMetricsSnapshot
 }
 
 type peerTaskQueue interface {
@@ -79,12 +83,14 @@ type codecMessageWriter interface {
 	WriteStreamCancel(m codec.StreamCancel) (int, error)
 	WriteStreamOpen(m codec.StreamOpen) (int, error)
 	WriteStreamClose(m codec.StreamClose) (int, error)
+	WritePexRequest(m codec.PexRequest) (int, error)
+	WritePexResponse(m codec.PexResponse) (int, error)
 }
 
 type SchedulingMethod int
 
-func (m SchedulingMethod) swarmScheduler(logger *zap.Logger, s *Swarm) swarmScheduler {
-	return newPeerSwarmScheduler(logger, s)
+func (m SchedulingMethod) swarmScheduler(logger *zap.Logger, s *Swarm, r *peerReputations, rng *rand.Rand) swarmScheduler {
+	return newPeerSwarmScheduler(logger, s, r, rng)
 
 	// TODO: do we need this?
 	// switch m {
@@ -100,8 +106,20 @@ func (m SchedulingMethod) swarmScheduler(logger *zap.Logger, s *Swarm) swarmSche
 const (
 	SeedSchedulingMethod SchedulingMethod = iota + 1
 	PeerSchedulingMethod
+	// TitForTatSchedulingMethod is PeerSchedulingMethod with upload slots
+	// reserved for the peers we receive the most data from plus one rotating
+	// optimistic slot.
+	TitForTatSchedulingMethod
+	// SuperSeedSchedulingMethod is SeedSchedulingMethod for the original
+	// broadcaster. Each chunk is announced to as few peers as possible and only
+	// revealed to more peers if it isn't redistributed by the others.
+	SuperSeedSchedulingMethod
 )
 
+func (m SchedulingMethod) seed() bool {
+	return m == SeedSchedulingMethod || m == SuperSeedSchedulingMethod
+}
+
 type DeliveryMode int
 
 const (
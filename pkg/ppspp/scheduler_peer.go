@@ -4,6 +4,7 @@
 package ppspp
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"log"
@@ -37,6 +38,14 @@ const (
 	schedulerRateUpdateInterval  = 1 * time.Second
 	schedulerStreamCheckInterval = 5 * time.Second
 	schedulerRestartCooldown     = 1 * time.Second
+	schedulerPexRequestInterval  = 30 * time.Second
+	schedulerPexResponseCooldown = 5 * time.Second
+	schedulerPexMaxPeers         = 16
+	schedulerChokeInterval       = 10 * time.Second
+	schedulerOptimisticInterval  = 30 * time.Second
+	schedulerUnchokeSlots        = 4
+
+	schedulerSuperSeedRevealInterval = 500 * time.Millisecond
 
 	timeGranularity   = timeutil.Precision
 	minRTTVar         = 200 * time.Millisecond
@@ -158,7 +167,7 @@ func (s *peerSchedulerStream) removeSubscriber(cs *peerChannelScheduler) {
 	}
 }
 
-func newPeerSwarmScheduler(logger *zap.Logger, s *Swarm) *peerSwarmScheduler {
+func newPeerSwarmScheduler(logger *zap.Logger, s *Swarm, reputations *peerReputations, rng *rand.Rand) *peerSwarmScheduler {
 	// debugHack := atomic.AddInt32(&debugHackCounter, 1)
 	// logger.Debug("started", zap.Int32("debugHack", debugHack))
 
@@ -173,11 +182,13 @@ func newPeerSwarmScheduler(logger *zap.Logger, s *Swarm) *peerSwarmScheduler {
 	for i := range ranks {
 		ranks[i] = codec.Stream(i)
 	}
-	rand.Shuffle(len(ranks), func(i, j int) { ranks[i], ranks[j] = ranks[j], ranks[i] })
+	rng.Shuffle(len(ranks), func(i, j int) { ranks[i], ranks[j] = ranks[j], ranks[i] })
 
 	return &peerSwarmScheduler{
-		logger: logger,
-		swarm:  s,
+		logger:      logger,
+		swarm:       s,
+		reputations: reputations,
+		rng:         rng,
 
 		streamCount:    codec.Stream(s.options.StreamCount),
 		streamLayer:    uint64(bits.TrailingZeros16(uint16(s.options.StreamCount))),
@@ -197,19 +208,22 @@ func newPeerSwarmScheduler(logger *zap.Logger, s *Swarm) *peerSwarmScheduler {
 		liveWindow:        binmap.Bin(s.options.LiveWindow * 2),
 
 		// debugHack: debugHack == 2,
-		firstChunkSet: s.options.SchedulingMethod == SeedSchedulingMethod || !s.store.Empty(),
+		firstChunkSet: s.options.SchedulingMethod.seed() || !s.store.Empty(),
+		superSeed:     s.options.SchedulingMethod == SuperSeedSchedulingMethod,
 
 		// HAX
-		nextGCTime:          timeutil.Now().Add(time.Duration(rand.Intn(5000)) * time.Millisecond),
-		nextStreamCheckTime: timeutil.Now().Add(time.Duration(rand.Intn(3000)) * time.Millisecond),
+		nextGCTime:          timeutil.Now().Add(time.Duration(rng.Intn(5000)) * time.Millisecond),
+		nextStreamCheckTime: timeutil.Now().Add(time.Duration(rng.Intn(3000)) * time.Millisecond),
 
 		ranks: ranks,
 	}
 }
 
 type peerSwarmScheduler struct {
-	logger *zap.Logger
-	swarm  *Swarm
+	logger      *zap.Logger
+	swarm       *Swarm
+	reputations *peerReputations
+	rng         *rand.Rand
 
 	lock sync.Mutex
 
@@ -240,6 +254,13 @@ type peerSwarmScheduler struct {
 	nextGCTime          timeutil.Time
 	nextStreamCheckTime timeutil.Time
 
+	nextChokeTime      timeutil.Time
+	nextOptimisticTime timeutil.Time
+	optimisticUnchoke  *peerChannelScheduler
+
+	superSeed     bool
+	superSeedBins []*superSeedBin
+
 	ranks []codec.Stream
 }
 
@@ -260,6 +281,16 @@ func (s *peerSwarmScheduler) Run(t timeutil.Time) {
 	for _, cs := range s.channels {
 		cs.timeOutRequests()
 		cs.tryRestart(t)
+		cs.tryPexRequest(t)
+	}
+
+	if s.swarm.options.SchedulingMethod == TitForTatSchedulingMethod && t.After(s.nextChokeTime) {
+		s.nextChokeTime = t.Add(schedulerChokeInterval)
+		s.updateChokes(t)
+	}
+
+	if s.superSeed {
+		s.revealSuperSeedBins(t)
 	}
 
 	// when the bitrate is low worry less about who we subscribe to
@@ -297,6 +328,57 @@ func (s *peerSwarmScheduler) Run(t timeutil.Time) {
 	}
 }
 
+// updateChokes unchokes the peers we receive the most data from in this swarm
+// falling back to their reputation across swarms, and one random optimistic
+// peer so new peers get a chance to reciprocate. Peers that have sent too
+// much invalid data are always choked.
+func (s *peerSwarmScheduler) updateChokes(t timeutil.Time) {
+	type candidate struct {
+		cs         *peerChannelScheduler
+		rate       uint64
+		reputation float64
+	}
+
+	candidates := make([]candidate, 0, len(s.channels))
+	for _, cs := range s.channels {
+		if !cs.reputation.Trusted() {
+			continue
+		}
+		cs.lock.Lock()
+		rate := cs.dataChunks.RateWithTime(time.Second, t)
+		cs.lock.Unlock()
+		candidates = append(candidates, candidate{cs, rate, cs.reputation.Score()})
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].rate != candidates[j].rate {
+			return candidates[i].rate > candidates[j].rate
+		}
+		return candidates[i].reputation > candidates[j].reputation
+	})
+
+	unchoked := map[*peerChannelScheduler]struct{}{}
+	for i := 0; i < len(candidates) && i < schedulerUnchokeSlots; i++ {
+		unchoked[candidates[i].cs] = struct{}{}
+	}
+
+	if len(candidates) > schedulerUnchokeSlots {
+		if s.optimisticUnchoke == nil || t.After(s.nextOptimisticTime) {
+			s.nextOptimisticTime = t.Add(schedulerOptimisticInterval)
+			rest := candidates[schedulerUnchokeSlots:]
+			s.optimisticUnchoke = rest[s.rng.Intn(len(rest))].cs
+		}
+		if s.optimisticUnchoke.reputation.Trusted() {
+			unchoked[s.optimisticUnchoke] = struct{}{}
+		}
+	}
+
+	for _, cs := range s.channels {
+		_, ok := unchoked[cs]
+		cs.setPeerChoked(!ok)
+	}
+}
+
 func (s *peerSwarmScheduler) checkStreams(t timeutil.Time) {
 	streamRate := s.peerHaveChunkRate.RateWithTime(time.Second, t) / uint64(s.streamCount)
 	if streamRate == 0 {
@@ -576,6 +658,7 @@ func (s *peerSwarmScheduler) gc(t timeutil.Time) {
 	// TODO: base this on est 99th percentile peer have lag?
 	if s.liveWindow < s.haveBinMax {
 		s.binTimes.Prune(s.haveBinMax - s.liveWindow)
+		s.gcSuperSeedBins(s.haveBinMax - s.liveWindow)
 	}
 
 	requestTimesThreshold := s.swarm.store.Next()
@@ -611,6 +694,7 @@ func (s *peerSwarmScheduler) Reset() {
 	s.requestBins = binmap.New()
 	s.streams = newPeerSchedulerStreamSubscription(int(s.streamCount))
 	s.firstChunkSet = false
+	s.superSeedBins = nil
 
 	for _, cs := range s.channels {
 		cs.lock.Lock()
@@ -627,6 +711,7 @@ func (s *peerSwarmScheduler) Reset() {
 		cs.ledbat = ledbat.New()
 		cs.nextRestartTime = timeutil.Now().Add(schedulerRestartCooldown)
 		cs.handshakeReceived = false
+		cs.superSeedBinCount = 0
 
 		cs.p.EnqueueNow(cs)
 
@@ -661,12 +746,16 @@ func (s *peerSwarmScheduler) Consume(c store.Chunk) {
 	s.wastedChunks += wastedChunks
 
 	s.haveBins.Set(c.Bin)
-	hb := s.haveBins.Cover(c.Bin)
-	if hb.IsAll() {
-		hb = s.haveBins.RootBin()
-	}
-	for _, c := range s.channels {
-		c.appendHaveBins(hb)
+	if s.superSeed {
+		s.addSuperSeedBin(c.Bin, t)
+	} else {
+		hb := s.haveBins.Cover(c.Bin)
+		if hb.IsAll() {
+			hb = s.haveBins.RootBin()
+		}
+		for _, c := range s.channels {
+			c.appendHaveBins(hb)
+		}
 	}
 
 	if b := c.Bin.BaseRight(); b > s.haveBinMax {
@@ -683,6 +772,7 @@ func (s *peerSwarmScheduler) ChannelScheduler(p peerTaskQueue, cw codecMessageWr
 	c := &peerChannelScheduler{
 		logger:          s.logger.With(logutil.ByteHex("peer", p.ID())),
 		p:               p,
+		reputation:      s.reputations.Get(p.ID()),
 		cw:              cw,
 		s:               s,
 		streamHaveLag:   make([]stats.Welford, s.streamCount),
@@ -690,7 +780,7 @@ func (s *peerSwarmScheduler) ChannelScheduler(p peerTaskQueue, cw codecMessageWr
 		dataRTTMean:     stats.NewEMA(0.125),
 		dataRTTVar:      stats.NewEMA(0.25),
 		dataChunks:      stats.NewSMA(15, time.Second),
-		haveBins:        s.haveBins.Clone(),
+		haveBins:        s.channelHaveBins(nil),
 		cancelBins:      binmap.New(),
 		requestStreams:  make([]binmap.Bin, s.streamCount),
 		extraMessages:   []codec.Message{newHandshake(s.swarm)},
@@ -745,6 +835,9 @@ func (s *peerSwarmScheduler) CloseChannel(p peerTaskQueue) {
 	cs.clearRequests()
 
 	delete(s.channels, p)
+	if s.optimisticUnchoke == cs {
+		s.optimisticUnchoke = nil
+	}
 
 	cs.lock.Unlock()
 	s.lock.Unlock()
@@ -752,6 +845,30 @@ func (s *peerSwarmScheduler) CloseChannel(p peerTaskQueue) {
 	p.RemoveRunner(cs)
 }
 
+// pexPeers returns the ids of up to n peers with open channels other than the
+// peer for cs.
+func (s *peerSwarmScheduler) pexPeers(cs *peerChannelScheduler, n int) [][]byte {
+	ids := make([][]byte, 0, n)
+	for p := range s.channels {
+		if len(ids) == n {
+			break
+		}
+		if p != cs.p {
+			ids = append(ids, p.ID())
+		}
+	}
+	return ids
+}
+
+func (s *peerSwarmScheduler) hasPeer(id []byte) bool {
+	for p := range s.channels {
+		if bytes.Equal(p.ID(), id) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *peerSwarmScheduler) binStreamOffset(b binmap.Bin) uint64 {
 	return uint64(b) >> (s.streamLayer + 1)
 }
@@ -798,7 +915,10 @@ type peerChannelScheduler struct {
 
 	lock sync.Mutex
 
-	choked        bool
+	reputation *peerReputation
+
+	choked        bool // the peer is choking us
+	peerChoked    bool // we are choking the peer
 	streamHaveLag []stats.Welford
 	requestTimes  timeSet
 	dataRTT       stats.SMA
@@ -834,7 +954,19 @@ type peerChannelScheduler struct {
 	nextPingTime timeutil.Time
 	pingNonce    uint64
 
+	peerExchange        bool // the peer advertised PeerExchangeOption
+	nextPexRequestTime  timeutil.Time
+	nextPexResponseTime timeutil.Time
+	pexResponseBudget   int
+
 	waste uint64
+
+	superSeedBinCount int // pending super-seed bins revealed to the peer
+
+	requestedChunks atomic.Uint64
+	receivedChunks  atomic.Uint64
+	invalidChunks   atomic.Uint64
+
 	// written     *binmap.Map
 	// cancelled   *binmap.Map
 	// requested   *binmap.Map
@@ -845,6 +977,28 @@ type peerChannelScheduler struct {
 	candidateBins [][]binmap.Bin
 }
 
+// setPeerChoked sends a CHOKE or UNCHOKE if the peer's state changed. Choked
+// peers lose their stream subscriptions and their requests are ignored.
+func (c *peerChannelScheduler) setPeerChoked(v bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.peerChoked == v {
+		return
+	}
+	c.peerChoked = v
+
+	if v {
+		for i := range c.s.streams {
+			c.s.streams[i].removeSubscriber(c)
+		}
+		c.extraMessages = append(c.extraMessages, &codec.Choke{})
+	} else {
+		c.extraMessages = append(c.extraMessages, &codec.Unchoke{})
+	}
+	c.p.Enqueue(c)
+}
+
 func (c *peerChannelScheduler) tryRestart(t timeutil.Time) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -856,6 +1010,20 @@ func (c *peerChannelScheduler) tryRestart(t timeutil.Time) {
 	}
 }
 
+func (c *peerChannelScheduler) tryPexRequest(t timeutil.Time) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	// peers that don't advertise pex support would reject the request as an
+	// unsupported message and close the channel
+	if c.handshakeReceived && c.peerExchange && t.After(c.nextPexRequestTime) {
+		c.nextPexRequestTime = t.Add(schedulerPexRequestInterval)
+		c.pexResponseBudget = schedulerPexMaxPeers
+		c.extraMessages = append(c.extraMessages, &codec.PexRequest{})
+		c.p.Enqueue(c)
+	}
+}
+
 func (c *peerChannelScheduler) appendHaveBins(hb binmap.Bin) {
 	c.lock.Lock()
 	c.haveBins.Set(hb)
@@ -1019,6 +1187,14 @@ func (c *peerChannelScheduler) write0() error {
 			_, err = c.cw.WriteStreamClose(*m)
 		case *codec.Pong:
 			_, err = c.cw.WritePong(*m)
+		case *codec.PexRequest:
+			_, err = c.cw.WritePexRequest(*m)
+		case *codec.PexResponse:
+			_, err = c.cw.WritePexResponse(*m)
+		case *codec.Choke:
+			_, err = c.cw.WriteChoke(*m)
+		case *codec.Unchoke:
+			_, err = c.cw.WriteUnchoke(*m)
 		}
 
 		if err != nil {
@@ -1045,7 +1221,7 @@ func (c *peerChannelScheduler) write1() error {
 	defer c.lock.Unlock()
 	defer c.s.lock.Unlock()
 
-	if c.choked {
+	if c.choked || !c.reputation.Trusted() {
 		return nil
 	}
 
@@ -1113,6 +1289,7 @@ func (c *peerChannelScheduler) write1() error {
 				c.requestTimes.Set(b, now)
 				c.requestBins.Push(b, timeout)
 				c.ledbat.AddSent(int(b.BaseLength()) * c.s.chunkSize)
+				c.requestedChunks.Add(b.BaseLength())
 
 				n--
 				if n == 0 {
@@ -1293,19 +1470,22 @@ func (c *peerChannelScheduler) ExpectData(b binmap.Bin) bool {
 	return c.handshakeReceived
 }
 
-func (c *peerChannelScheduler) HandleHandshake(liveWindow uint32) error {
+func (c *peerChannelScheduler) HandleHandshake(liveWindow uint32, peerExchange bool) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 	c.peerLiveWindow = binmap.Bin(liveWindow * 2)
+	c.peerExchange = peerExchange
 	c.handshakeReceived = true
 	return nil
 }
 
 func (c *peerChannelScheduler) HandleRestart() error {
+	c.s.lock.Lock()
 	c.lock.Lock()
 	defer c.lock.Unlock()
+	defer c.s.lock.Unlock()
 	c.extraMessages = append(c.extraMessages, newHandshake(c.s.swarm))
-	c.haveBins = c.s.haveBins.Clone()
+	c.haveBins = c.s.channelHaveBins(c)
 	return nil
 }
 
@@ -1365,6 +1545,9 @@ func (c *peerChannelScheduler) HandleData(b binmap.Bin, t timeutil.Time, valid b
 	// c.s.lock.Unlock()
 
 	if !valid {
+		c.reputation.AddInvalidChunks(b.BaseLength())
+		c.invalidChunks.Add(b.BaseLength())
+
 		// TODO: this should probably use a binmap so we can unset cancelled bins
 		// TODO: this needs to account for chunks we receive from streams
 		// c.lock.Lock()
@@ -1390,6 +1573,7 @@ func (c *peerChannelScheduler) HandleData(b binmap.Bin, t timeutil.Time, valid b
 	now := timeutil.Now()
 
 	c.enqueueNow.Store(true)
+	c.receivedChunks.Add(b.BaseLength())
 
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -1398,6 +1582,8 @@ func (c *peerChannelScheduler) HandleData(b binmap.Bin, t timeutil.Time, valid b
 		return nil
 	}
 
+	c.reputation.AddValidChunks(b.BaseLength(), b.BaseLength()*uint64(c.s.chunkSize))
+
 	if _, ts, ok := c.requestTimes.Get(b); ok {
 		// LEDBAT rtt...
 		rtt := float64(now.Sub(ts))
@@ -1459,6 +1645,10 @@ func (c *peerChannelScheduler) HandleHave(b binmap.Bin) error {
 	// c.s.peerHaveBins.Set(b)
 	c.peerHaveBins.Set(b)
 
+	if c.s.superSeed {
+		c.s.handleSuperSeedHave(c)
+	}
+
 	br := b.BaseRight()
 	if br > c.peerMaxHaveBin {
 		c.peerMaxHaveBin = br
@@ -1493,7 +1683,7 @@ func (c *peerChannelScheduler) HandleRequest(b binmap.Bin, t timeutil.Time) erro
 	// c.lock.Unlock()
 
 	c.lock.Lock()
-	ok := c.handshakeReceived
+	ok := c.handshakeReceived && !c.peerChoked
 	c.lock.Unlock()
 	if !ok {
 		return nil
@@ -1564,7 +1754,7 @@ func (c *peerChannelScheduler) HandleStreamRequest(s codec.Stream, b binmap.Bin)
 	defer c.lock.Unlock()
 	defer c.s.lock.Unlock()
 
-	if !c.handshakeReceived {
+	if !c.handshakeReceived || c.peerChoked {
 		return nil
 	}
 
@@ -1636,6 +1826,55 @@ func (c *peerChannelScheduler) HandleStreamClose(s codec.Stream) error {
 	return nil
 }
 
+func (c *peerChannelScheduler) HandlePexRequest() error {
+	c.s.lock.Lock()
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	defer c.s.lock.Unlock()
+
+	if !c.handshakeReceived {
+		return nil
+	}
+
+	// ignore requests that arrive faster than we'd send them so peers can't
+	// use us to amplify traffic
+	t := timeutil.Now()
+	if t.Before(c.nextPexResponseTime) {
+		return nil
+	}
+	c.nextPexResponseTime = t.Add(schedulerPexResponseCooldown)
+
+	ids := c.s.pexPeers(c, schedulerPexMaxPeers)
+	for _, id := range ids {
+		c.extraMessages = append(c.extraMessages, &codec.PexResponse{PeerID: id})
+	}
+	if len(ids) != 0 {
+		c.p.Enqueue(c)
+	}
+
+	return nil
+}
+
+func (c *peerChannelScheduler) HandlePexResponse(peerID []byte) error {
+	c.s.lock.Lock()
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	defer c.s.lock.Unlock()
+
+	// drop unsolicited responses and any beyond the number we'd send
+	if !c.handshakeReceived || c.pexResponseBudget == 0 {
+		return nil
+	}
+	c.pexResponseBudget--
+
+	if c.s.hasPeer(peerID) {
+		return nil
+	}
+	c.s.swarm.pex.emit(peerID)
+
+	return nil
+}
+
 // deprecated?
 func (c *peerChannelScheduler) HandleMessageEnd() error {
 	if c.enqueueNow.CompareAndSwap(true, false) {
@@ -1651,3 +1890,22 @@ func (c *peerChannelScheduler) HandleMessageEnd() error {
 
 	return nil
 }
+
+// MetricsSnapshot returns the channel's congestion control state, chunk
+// counters and choke state.
+func (c *peerChannelScheduler) MetricsSnapshot() SchedulerMetricsSnapshot {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return SchedulerMetricsSnapshot{
+		RTT:             c.ledbat.RTTMean(),
+		RTTVar:          c.ledbat.RTTVar(),
+		CWND:            c.ledbat.CWND(),
+		FlightSize:      c.ledbat.FlightSize(),
+		RequestedChunks: c.requestedChunks.Load(),
+		ReceivedChunks:  c.receivedChunks.Load(),
+		InvalidChunks:   c.invalidChunks.Load(),
+		Choked:          c.choked,
+		PeerChoked:      c.peerChoked,
+	}
+}
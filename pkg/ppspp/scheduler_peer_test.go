@@ -4,7 +4,9 @@
 package ppspp
 
 import (
+	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/MemeLabs/strims/pkg/binmap"
 	"github.com/MemeLabs/strims/pkg/ppspp/codec"
@@ -15,11 +17,15 @@ import (
 )
 
 func newTestPeerSwarmScheduler() *peerSwarmScheduler {
+	return newTestPeerSwarmSchedulerWithOptions(SwarmOptions{StreamCount: 8})
+}
+
+func newTestPeerSwarmSchedulerWithOptions(o SwarmOptions) *peerSwarmScheduler {
 	id, _ := DecodeSwarmID("ewOeQgqCCXYwVmR-nZIcbLfDszuIgV8l0Xj0OVa5Vw4")
-	swarm, _ := NewSwarm(NewSwarmID(id), SwarmOptions{StreamCount: 8})
+	swarm, _ := NewSwarm(NewSwarmID(id), o)
 
 	logger, _ := zap.NewDevelopment()
-	return newPeerSwarmScheduler(logger, swarm)
+	return newPeerSwarmScheduler(logger, swarm, newPeerReputations(), rand.New(rand.NewSource(1)))
 }
 
 func TestPeerSwarmSchedulerStartStopChannel(t *testing.T) {
@@ -69,6 +75,143 @@ func TestPeerChannelSchedulerStreamSubImplicitRequests(t *testing.T) {
 	assert.Equal(t, []binmap.Bin{31, 64}, swarmScheduler.requestBins.IterateFilled().ToSlice(), "expected unreceived bins to be filled for stream bins after unsub")
 }
 
+func TestPeerChannelSchedulerPex(t *testing.T) {
+	swarmScheduler := newTestPeerSwarmScheduler()
+	var enqueued int
+	p := &mockPeerTaskQueue{
+		id:          []byte("a"),
+		enqueueFunc: func(w peerTaskRunner) { enqueued++ },
+	}
+	a := swarmScheduler.ChannelScheduler(p, &mockCodecMessageWriter{}).(*peerChannelScheduler)
+	swarmScheduler.ChannelScheduler(&mockPeerTaskQueue{id: []byte("b")}, &mockCodecMessageWriter{})
+	a.HandleHandshake(0, true)
+	a.pruneExtraMessages(len(a.extraMessages))
+
+	enqueued = 0
+	assert.NoError(t, a.HandlePexRequest())
+	assert.Equal(t, []codec.Message{&codec.PexResponse{PeerID: []byte("b")}}, a.extraMessages, "expected response to list other peers")
+	assert.Equal(t, 1, enqueued, "expected the response to be enqueued")
+
+	a.pruneExtraMessages(len(a.extraMessages))
+	assert.NoError(t, a.HandlePexRequest())
+	assert.Empty(t, a.extraMessages, "expected requests within the cooldown to be ignored")
+
+	pex := make(chan []byte, 4)
+	swarmScheduler.swarm.NotifyPeerExchange(pex)
+
+	assert.NoError(t, a.HandlePexResponse([]byte("c")))
+	assert.Empty(t, pex, "expected unsolicited responses to be ignored")
+
+	a.tryPexRequest(timeutil.Now())
+	assert.Equal(t, []codec.Message{&codec.PexRequest{}}, a.extraMessages)
+
+	assert.NoError(t, a.HandlePexResponse([]byte("b")))
+	assert.NoError(t, a.HandlePexResponse([]byte("c")))
+	if assert.Len(t, pex, 1, "expected only unknown peers to be emitted") {
+		assert.Equal(t, []byte("c"), <-pex)
+	}
+}
+
+func TestPeerChannelSchedulerPexRequiresPeerSupport(t *testing.T) {
+	swarmScheduler := newTestPeerSwarmScheduler()
+	a := swarmScheduler.ChannelScheduler(&mockPeerTaskQueue{id: []byte("a")}, &mockCodecMessageWriter{}).(*peerChannelScheduler)
+	a.HandleHandshake(0, false)
+	a.pruneExtraMessages(len(a.extraMessages))
+
+	a.tryPexRequest(timeutil.Now())
+	assert.Empty(t, a.extraMessages, "expected no requests to peers that don't advertise pex support")
+}
+
+func TestPeerSwarmSchedulerUpdateChokes(t *testing.T) {
+	swarmScheduler := newTestPeerSwarmScheduler()
+
+	ids := []string{"a", "b", "c", "d", "e", "f", "g"}
+	cs := map[string]*peerChannelScheduler{}
+	for _, id := range ids {
+		cs[id] = swarmScheduler.ChannelScheduler(&mockPeerTaskQueue{id: []byte(id)}, &mockCodecMessageWriter{}).(*peerChannelScheduler)
+	}
+
+	now := timeutil.Now().Add(2 * time.Second)
+	for i, id := range ids[:schedulerUnchokeSlots] {
+		cs[id].dataChunks.AddWithTime(uint64(100*(i+1)), now)
+	}
+	cs["g"].reputation.AddInvalidChunks(reputationMinSamples)
+
+	swarmScheduler.updateChokes(now)
+
+	for _, id := range []string{"a", "b", "c", "d"} {
+		assert.False(t, cs[id].peerChoked, "expected contributing peer %s to be unchoked", id)
+	}
+	assert.NotEqual(t, cs["e"].peerChoked, cs["f"].peerChoked, "expected one optimistic unchoke")
+	assert.True(t, cs["g"].peerChoked, "expected untrusted peer to be choked")
+	assert.Contains(t, cs["g"].extraMessages, &codec.Choke{})
+
+	assert.NoError(t, cs["g"].HandleHandshake(0, true))
+	var pushed bool
+	cs["g"].p.(*mockPeerTaskQueue).pushDataFunc = func(w peerTaskRunner, b binmap.Bin, t timeutil.Time, pri peerPriority) {
+		pushed = true
+	}
+	assert.NoError(t, cs["g"].HandleRequest(0, now))
+	assert.False(t, pushed, "expected requests from choked peer to be ignored")
+}
+
+func TestPeerSwarmSchedulerSuperSeed(t *testing.T) {
+	swarmScheduler := newTestPeerSwarmSchedulerWithOptions(SwarmOptions{
+		StreamCount:      8,
+		SchedulingMethod: SuperSeedSchedulingMethod,
+	})
+
+	ids := []string{"a", "b", "c", "e"}
+	cs := map[string]*peerChannelScheduler{}
+	for _, id := range ids {
+		cs[id] = swarmScheduler.ChannelScheduler(&mockPeerTaskQueue{id: []byte(id)}, &mockCodecMessageWriter{}).(*peerChannelScheduler)
+		assert.NoError(t, cs[id].HandleHandshake(0, true))
+	}
+
+	revealedTo := func(b binmap.Bin) (ids []string) {
+		for id, c := range cs {
+			if c.haveBins.FilledAt(b) {
+				ids = append(ids, id)
+			}
+		}
+		return ids
+	}
+
+	swarmScheduler.Consume(store.Chunk{Bin: 0})
+	swarmScheduler.Consume(store.Chunk{Bin: 2})
+	first := revealedTo(0)
+	second := revealedTo(2)
+	assert.Len(t, first, 1, "expected new bins to be revealed to one peer")
+	assert.Len(t, second, 1, "expected new bins to be revealed to one peer")
+	assert.NotEqual(t, first, second, "expected bins to be revealed to different peers")
+
+	swarmScheduler.revealSuperSeedBins(timeutil.Now().Add(schedulerSuperSeedRevealInterval * 2))
+	assert.Len(t, revealedTo(0), 2, "expected bins to be revealed to another peer after the deadline")
+	assert.Len(t, revealedTo(2), 2, "expected bins to be revealed to another peer after the deadline")
+
+	var announcer string
+	for _, id := range ids {
+		if !cs[id].haveBins.FilledAt(2) {
+			announcer = id
+			assert.NoError(t, cs[id].HandleHave(2))
+			break
+		}
+	}
+	assert.Len(t, swarmScheduler.superSeedBins, 1, "expected bins announced by other peers to be released")
+	assert.Equal(t, binmap.Bin(0), swarmScheduler.superSeedBins[0].bin)
+	assert.False(t, cs[announcer].haveBins.FilledAt(2), "expected released bins not to be announced to the peer that has them")
+	assert.Len(t, revealedTo(2), 3, "expected released bins to be announced to the remaining peers")
+
+	d := swarmScheduler.ChannelScheduler(&mockPeerTaskQueue{id: []byte("d")}, &mockCodecMessageWriter{}).(*peerChannelScheduler)
+	assert.False(t, d.haveBins.FilledAt(0), "expected pending bins to be withheld from new peers")
+	assert.True(t, d.haveBins.FilledAt(2), "expected released bins to be announced to new peers")
+
+	swarmScheduler.streams[swarmScheduler.binStream(4)].addSubscriber(cs["a"], 0)
+	swarmScheduler.streams[swarmScheduler.binStream(4)].addSubscriber(cs["b"], 0)
+	swarmScheduler.Consume(store.Chunk{Bin: 4})
+	assert.ElementsMatch(t, []string{"a", "b"}, revealedTo(4), "expected new bins to be revealed to stream subscribers")
+}
+
 func TestPeerChannelSchedulerFoo(t *testing.T) {
 	swarmScheduler := newTestPeerSwarmScheduler()
 
@@ -92,7 +235,7 @@ func TestPeerChannelSchedulerFoo(t *testing.T) {
 		},
 	}
 	channelScheduler := swarmScheduler.ChannelScheduler(p, w).(*peerChannelScheduler)
-	channelScheduler.HandleHandshake(liveWindow)
+	channelScheduler.HandleHandshake(liveWindow, true)
 
 	haveBin := binmap.NewBin(6, 0)
 
@@ -499,7 +499,7 @@ func (c *seedChannelScheduler) ExpectData(b binmap.Bin) bool {
 	return false
 }
 
-func (c *seedChannelScheduler) HandleHandshake(liveWindow uint32) error {
+func (c *seedChannelScheduler) HandleHandshake(liveWindow uint32, peerExchange bool) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 	c.liveWindow = liveWindow
@@ -623,6 +623,14 @@ func (c *seedChannelScheduler) HandleStreamClose(s codec.Stream) error {
 	return nil
 }
 
+func (c *seedChannelScheduler) HandlePexRequest() error {
+	return nil
+}
+
+func (c *seedChannelScheduler) HandlePexResponse(peerID []byte) error {
+	return nil
+}
+
 // deprecated?
 func (c *seedChannelScheduler) HandleMessageEnd() error {
 	/*
@@ -643,3 +651,11 @@ func (c *seedChannelScheduler) HandleMessageEnd() error {
 
 	return nil
 }
+
+// MetricsSnapshot reports whether the seed has choked the peer. Seeds don't
+// request chunks so the other counters are always zero.
+func (c *seedChannelScheduler) MetricsSnapshot() SchedulerMetricsSnapshot {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return SchedulerMetricsSnapshot{Choked: c.choked}
+}
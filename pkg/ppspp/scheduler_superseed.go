@@ -0,0 +1,178 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package ppspp
+
+import (
+	"github.com/MemeLabs/strims/pkg/binmap"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+)
+
+// superSeedBin is a range of chunks the super-seed has announced to a subset
+// of its peers.
+type superSeedBin struct {
+	bin        binmap.Bin
+	channels   []*peerChannelScheduler
+	revealTime timeutil.Time
+}
+
+func (e *superSeedBin) revealedTo(cs *peerChannelScheduler) bool {
+	for _, c := range e.channels {
+		if c == cs {
+			return true
+		}
+	}
+	return false
+}
+
+// addSuperSeedBin withholds HAVEs for b from all but one peer. The bin is
+// revealed to more peers one at a time until some peer we didn't reveal it
+// to announces it, which shows it is being redistributed without us.
+func (s *peerSwarmScheduler) addSuperSeedBin(b binmap.Bin, t timeutil.Time) {
+	e := &superSeedBin{bin: b}
+	s.superSeedBins = append(s.superSeedBins, e)
+	if !s.revealSuperSeedBinToSubscribers(e, t) {
+		s.revealSuperSeedBin(e, t)
+	}
+}
+
+// revealSuperSeedBinToSubscribers announces e to the peers subscribed to its
+// stream. They are sent the data whether or not they know we have it so
+// withholding it only delays their peers. It returns false if the stream has
+// no subscribers.
+func (s *peerSwarmScheduler) revealSuperSeedBinToSubscribers(e *superSeedBin, t timeutil.Time) bool {
+	if !e.bin.IsBase() {
+		return false
+	}
+
+	var ok bool
+	for _, sub := range s.streams[s.binStream(e.bin)].subscribers {
+		if sub.startBin <= e.bin {
+			e.channels = append(e.channels, sub.channel)
+			sub.channel.superSeedBinCount++
+			sub.channel.appendHaveBins(e.bin)
+			ok = true
+		}
+	}
+	if ok {
+		e.revealTime = t.Add(schedulerSuperSeedRevealInterval)
+	}
+	return ok
+}
+
+// revealSuperSeedBin announces e to the eligible peer with the fewest pending
+// super-seed bins. It returns false if every peer has already seen e.
+func (s *peerSwarmScheduler) revealSuperSeedBin(e *superSeedBin, t timeutil.Time) bool {
+	e.revealTime = t.Add(schedulerSuperSeedRevealInterval)
+
+	var next *peerChannelScheduler
+	var pending bool
+	for _, cs := range s.channels {
+		if e.revealedTo(cs) {
+			continue
+		}
+
+		cs.lock.Lock()
+		ok := cs.handshakeReceived && !cs.peerChoked && !cs.peerHaveBins.FilledAt(e.bin)
+		cs.lock.Unlock()
+		if !ok {
+			pending = pending || !cs.handshakeReceived
+			continue
+		}
+
+		if next == nil || cs.superSeedBinCount < next.superSeedBinCount {
+			next = cs
+		}
+	}
+
+	if next == nil {
+		// keep waiting for peers that haven't finished their handshake or until
+		// the first peer connects.
+		return pending || len(s.channels) == 0
+	}
+
+	e.channels = append(e.channels, next)
+	next.superSeedBinCount++
+	next.appendHaveBins(e.bin)
+	return true
+}
+
+// revealSuperSeedBins reveals bins that haven't spread to another peer by
+// their deadline.
+func (s *peerSwarmScheduler) revealSuperSeedBins(t timeutil.Time) {
+	n := 0
+	for _, e := range s.superSeedBins {
+		if t.After(e.revealTime) && !s.revealSuperSeedBin(e, t) {
+			s.releaseSuperSeedBin(e, nil)
+			continue
+		}
+		s.superSeedBins[n] = e
+		n++
+	}
+	s.pruneSuperSeedBins(n)
+}
+
+// handleSuperSeedHave stops revealing bins once a peer that didn't get them
+// from us announces them. The caller must hold the lock for cs.
+func (s *peerSwarmScheduler) handleSuperSeedHave(cs *peerChannelScheduler) {
+	n := 0
+	for _, e := range s.superSeedBins {
+		if !e.revealedTo(cs) && cs.peerHaveBins.FilledAt(e.bin) {
+			s.releaseSuperSeedBin(e, cs)
+			continue
+		}
+		s.superSeedBins[n] = e
+		n++
+	}
+	s.pruneSuperSeedBins(n)
+}
+
+// gcSuperSeedBins drops bins that are no longer in the live window.
+func (s *peerSwarmScheduler) gcSuperSeedBins(min binmap.Bin) {
+	n := 0
+	for _, e := range s.superSeedBins {
+		if e.bin.BaseRight() < min {
+			s.dropSuperSeedBin(e)
+			continue
+		}
+		s.superSeedBins[n] = e
+		n++
+	}
+	s.pruneSuperSeedBins(n)
+}
+
+// releaseSuperSeedBin stops withholding e and announces it to the peers it
+// wasn't revealed to other than src, which announced it to us.
+func (s *peerSwarmScheduler) releaseSuperSeedBin(e *superSeedBin, src *peerChannelScheduler) {
+	s.dropSuperSeedBin(e)
+	for _, cs := range s.channels {
+		if cs != src && !e.revealedTo(cs) {
+			cs.appendHaveBins(e.bin)
+		}
+	}
+}
+
+func (s *peerSwarmScheduler) dropSuperSeedBin(e *superSeedBin) {
+	for _, cs := range e.channels {
+		cs.superSeedBinCount--
+	}
+}
+
+func (s *peerSwarmScheduler) pruneSuperSeedBins(n int) {
+	for i := n; i < len(s.superSeedBins); i++ {
+		s.superSeedBins[i] = nil
+	}
+	s.superSeedBins = s.superSeedBins[:n]
+}
+
+// channelHaveBins returns the bins cs may be told we have. Super-seed bins
+// that haven't been revealed to cs are withheld.
+func (s *peerSwarmScheduler) channelHaveBins(cs *peerChannelScheduler) *binmap.Map {
+	m := s.haveBins.Clone()
+	for _, e := range s.superSeedBins {
+		if cs == nil || !e.revealedTo(cs) {
+			m.Reset(e.bin)
+		}
+	}
+	return m
+}
@@ -83,6 +83,8 @@ type mockCodecMessageWriter struct {
 	WriteStreamCancelFunc    func(m codec.StreamCancel) error
 	WriteStreamOpenFunc      func(m codec.StreamOpen) error
 	WriteStreamCloseFunc     func(m codec.StreamClose) error
+	WritePexRequestFunc      func(m codec.PexRequest) error
+	WritePexResponseFunc     func(m codec.PexResponse) error
 }
 
 func (w *mockCodecMessageWriter) Len() int {
@@ -244,3 +246,19 @@ func (w *mockCodecMessageWriter) WriteStreamClose(m codec.StreamClose) (int, err
 	w.size -= m.ByteLen()
 	return m.ByteLen(), err
 }
+func (w *mockCodecMessageWriter) WritePexRequest(m codec.PexRequest) (int, error) {
+	var err error
+	if w.WritePexRequestFunc != nil {
+		err = w.WritePexRequestFunc(m)
+	}
+	w.size -= m.ByteLen()
+	return m.ByteLen(), err
+}
+func (w *mockCodecMessageWriter) WritePexResponse(m codec.PexResponse) (int, error) {
+	var err error
+	if w.WritePexResponseFunc != nil {
+		err = w.WritePexResponseFunc(m)
+	}
+	w.size -= m.ByteLen()
+	return m.ByteLen(), err
+}
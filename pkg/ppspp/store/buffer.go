@@ -23,14 +23,23 @@ var (
 	ErrBinDataNotSet      = errors.New("bin data not set")
 	ErrClosed             = errors.New("cannot read from closed buffer")
 	ErrReadOffsetNotFound = errors.New("viable read offset not found")
+
+	errVariableChunkSizeCache = errors.New("cache does not support variable chunk sizes")
 )
 
 type BufferLayout byte
 
+// Cipher decrypts data read from the buffer. off is the position of src in
+// the stream.
+type Cipher interface {
+	XORKeyStreamAt(dst, src []byte, off uint64) error
+}
+
 const (
 	_ BufferLayout = iota
 	CircularBufferLayout
 	ElasticBufferLayout
+	DiskBufferLayout
 )
 
 // NewBuffer ...
@@ -39,6 +48,16 @@ func NewBuffer(size, chunkSize int) (*Buffer, error) {
 }
 
 func NewBufferWithLayout(size, chunkSize int, layout BufferLayout) (*Buffer, error) {
+	return newBuffer(size, chunkSize, layout, "")
+}
+
+// NewDiskBuffer creates a buffer backed by a temporary file in dir. If dir is
+// empty the default directory for temporary files is used.
+func NewDiskBuffer(size, chunkSize int, dir string) (*Buffer, error) {
+	return newBuffer(size, chunkSize, DiskBufferLayout, dir)
+}
+
+func newBuffer(size, chunkSize int, layout BufferLayout, dir string) (*Buffer, error) {
 	if size&(size-1) != 0 {
 		return nil, errors.New("buffer size must be power of 2")
 	}
@@ -59,6 +78,12 @@ func NewBufferWithLayout(size, chunkSize int, layout BufferLayout) (*Buffer, err
 		b.buf = make([]byte, size*chunkSize)
 	case ElasticBufferLayout:
 		b.buf = make([]byte, mathutil.Max(chunkSize, 1024))
+	case DiskBufferLayout:
+		disk, err := newDiskRing(dir, size*chunkSize, chunkSize)
+		if err != nil {
+			return nil, err
+		}
+		b.disk = disk
 	default:
 		return nil, errors.New("unsupported buffer layout")
 	}
@@ -75,11 +100,15 @@ type Buffer struct {
 	head      binmap.Bin
 	bins      *binmap.Map
 	buf       []byte
+	disk      *diskRing
+	cipher    Cipher
+	lens      []uint32
 	layout    BufferLayout
 	isReady   bool
 	ready     chan struct{}
 	next      binmap.Bin
 	sem       uint64
+	version   uint64
 	err       error
 	readers   []chan error
 }
@@ -97,6 +126,7 @@ func (s *Buffer) Reset() {
 	s.bins = binmap.New()
 	s.next = 0
 	s.sem++
+	s.version++
 
 	s.isReady = false
 	s.ready = make(chan struct{})
@@ -105,17 +135,40 @@ func (s *Buffer) Reset() {
 	s.pushReadable(nil)
 }
 
+// SetCipher sets the cipher used by readers to decrypt the buffer contents.
+func (s *Buffer) SetCipher(c Cipher) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.cipher = c
+}
+
+// SetVariableChunkSize makes the buffer track the length of chunks published
+// with less data than the chunk size. The rest of the chunk is zero padded in
+// the buffer but skipped by readers and not written to peers.
+func (s *Buffer) SetVariableChunkSize() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.lens = make([]uint32, s.mask+1)
+}
+
 // Consume ...
 func (s *Buffer) Consume(c Chunk) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
-	s.set(c.Bin, c.Data)
+	s.set(c.Bin, c.Data, c.Lengths)
 }
 
 // Close ...
 func (s *Buffer) Close() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
 	s.swapReadable(ErrClosed)
 	s.setReady()
+
+	if s.disk != nil {
+		s.disk.Close()
+	}
 }
 
 func (s *Buffer) setReady() {
@@ -142,7 +195,7 @@ func (s *Buffer) pushReadable(err error) {
 	}
 }
 
-func (s *Buffer) set(b binmap.Bin, d []byte) {
+func (s *Buffer) set(b binmap.Bin, d []byte, lengths []int) {
 	l, r := b.Base()
 	if l < s.head-s.size {
 		return
@@ -161,8 +214,20 @@ func (s *Buffer) set(b binmap.Bin, d []byte) {
 		s.head = h
 	}
 
-	copy(s.buf[s.index(b):], d)
+	if s.lens != nil {
+		s.setLengths(b, lengths)
+	}
+
+	if s.disk != nil {
+		if err := s.disk.WriteAt(d, s.index(b)); err != nil {
+			s.swapReadable(err)
+			return
+		}
+	} else {
+		copy(s.buf[s.index(b):], d)
+	}
 	s.bins.Set(b)
+	s.version++
 
 	if s.next < s.tail() {
 		s.swapReadable(ErrBufferUnderrun)
@@ -180,6 +245,29 @@ func (s *Buffer) set(b binmap.Bin, d []byte) {
 	}
 }
 
+// setLengths records the length of the data in the chunks in b. chunks are
+// full if lengths is nil.
+func (s *Buffer) setLengths(b binmap.Bin, lengths []int) {
+	for i, c := 0, b.BaseLeft(); c <= b.BaseRight(); i, c = i+1, c+2 {
+		n := int(s.chunkSize)
+		if lengths != nil {
+			n = lengths[i]
+		}
+		s.lens[s.chunkIndex(c)] = uint32(n)
+	}
+}
+
+func (s *Buffer) chunkIndex(b binmap.Bin) uint64 {
+	return uint64(b.BaseOffset()) & s.mask
+}
+
+// chunkEnd returns the stream offset of the end of the data in the chunk
+// containing the byte at off.
+func (s *Buffer) chunkEnd(off uint64) uint64 {
+	start := off - off%s.chunkSize
+	return start + uint64(s.lens[s.chunkIndex(byteBin(start, s.chunkSize))])
+}
+
 type DataWriter interface {
 	WriteData(m codec.Data) (int, error)
 }
@@ -191,15 +279,47 @@ func (s *Buffer) WriteData(b binmap.Bin, t timeutil.Time, w DataWriter) (int, er
 
 	if s.contains(b) {
 		i := s.index(b)
+		n := int(b.BaseLength() * s.chunkSize)
+
+		var d []byte
+		if s.disk != nil {
+			var err error
+			if d, err = s.disk.Bytes(i, n); err != nil {
+				return 0, err
+			}
+		} else {
+			d = s.buf[i : i+n]
+		}
+
+		var lengths []int
+		if s.lens != nil {
+			lengths, d = s.trimData(b, d)
+		}
+
 		return w.WriteData(codec.Data{
 			Address:   codec.Address(b),
 			Timestamp: codec.Timestamp{Time: t},
-			Data:      s.buf[i : i+int(b.BaseLength()*s.chunkSize)],
+			Data:      d,
+			Lengths:   lengths,
 		})
 	}
 	return 0, ErrBinDataNotSet
 }
 
+// trimData returns the length of the chunks in b and d without the padding
+// after the last chunk with data.
+func (s *Buffer) trimData(b binmap.Bin, d []byte) ([]int, []byte) {
+	lengths := make([]int, b.BaseLength())
+	var n int
+	for i := range lengths {
+		lengths[i] = int(s.lens[s.chunkIndex(b.BaseLeft()+binmap.Bin(i*2))])
+		if lengths[i] != 0 {
+			n = i*int(s.chunkSize) + lengths[i]
+		}
+	}
+	return lengths, d[:n]
+}
+
 // SetOffset sets the read offset to the first contiguous filled bin <= b and
 // the next expected bin to the next empty bin >= b.
 func (s *Buffer) SetOffset(b binmap.Bin) {
@@ -295,23 +415,49 @@ func (s *Buffer) ImportCache(b []byte) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	if s.layout == ElasticBufferLayout {
-		s.buf = make([]byte, len(b))
+	if s.lens != nil {
+		return errVariableChunkSizeCache
 	}
 
-	copy(s.buf, b)
+	switch s.layout {
+	case ElasticBufferLayout:
+		s.buf = make([]byte, len(b))
+		copy(s.buf, b)
+	case DiskBufferLayout:
+		if len(b) > s.disk.size {
+			b = b[:s.disk.size]
+		}
+		if err := s.disk.WriteAt(b, 0); err != nil {
+			return err
+		}
+	default:
+		copy(s.buf, b)
+	}
 
 	s.next = byteBin(uint64(len(b)), s.chunkSize)
 
 	s.bins.FillBefore(s.next)
+	s.version++
 	s.setReady()
 	return nil
 }
 
+// Version returns a counter that is incremented whenever the buffer contents
+// change.
+func (s *Buffer) Version() uint64 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.version
+}
+
 func (s *Buffer) ExportCache() ([]byte, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
+	if s.lens != nil {
+		return nil, errVariableChunkSizeCache
+	}
+
 	if s.tail() != 0 {
 		return nil, errors.New("cannot cache truncated swarm buffer")
 	}
@@ -320,6 +466,12 @@ func (s *Buffer) ExportCache() ([]byte, error) {
 	}
 
 	b := make([]byte, binByte(s.next, s.chunkSize))
+	if s.disk != nil {
+		if _, err := s.disk.ReadAt(b, 0); err != nil {
+			return nil, err
+		}
+		return b, nil
+	}
 	copy(b, s.buf)
 	return b, nil
 }
@@ -351,6 +503,7 @@ type BufferReader struct {
 	sem      uint64
 	prev     binmap.Bin
 	off      uint64
+	aligned  bool
 	err      error
 	readable chan error
 	stopper  ioutil.Stopper
@@ -359,8 +512,7 @@ type BufferReader struct {
 func (r *BufferReader) sync() {
 	if r.sem != r.buf.sem {
 		r.sem = r.buf.sem
-		r.prev = r.buf.tail()
-		r.off = binByte(r.buf.tail(), r.buf.chunkSize)
+		r.setOffset(r.buf.tail())
 		r.err = r.buf.err
 	}
 }
@@ -373,8 +525,7 @@ func (r *BufferReader) Unread() {
 		return
 	}
 
-	r.prev = r.buf.tail()
-	r.off = binByte(r.buf.tail(), r.buf.chunkSize)
+	r.setOffset(r.buf.tail())
 
 	swapChanValue(r.readable, nil)
 }
@@ -399,7 +550,7 @@ func (r *BufferReader) Recover() (uint64, error) {
 		return 0, r.err
 	}
 
-	if r.sem == r.buf.sem {
+	if r.sem == r.buf.sem && r.buf.err == ErrBufferUnderrun {
 		if err := r.buf.recover(); err != nil {
 			return 0, err
 		}
@@ -407,9 +558,96 @@ func (r *BufferReader) Recover() (uint64, error) {
 
 	off := r.off
 	r.sync()
+
+	// the reader fell behind the tail while the buffer kept up
+	if r.err == ErrBufferUnderrun {
+		r.setOffset(r.buf.tail())
+		r.err = r.buf.err
+	}
 	return r.off - off, nil
 }
 
+// TimeIndex maps times to the first bin of the signed segment that covers
+// them.
+type TimeIndex interface {
+	TimeBin(t timeutil.Time) (binmap.Bin, error)
+}
+
+// SeekTime moves the reader to the bin idx maps t to and returns the new
+// offset. Only this reader is moved. Bins before the tail are no longer
+// buffered so the offset is clamped to the oldest readable bin.
+func (r *BufferReader) SeekTime(idx TimeIndex, t timeutil.Time) (uint64, error) {
+	b, err := idx.TimeBin(t)
+	if err != nil {
+		return 0, err
+	}
+	return r.Seek(b)
+}
+
+// Seek moves the reader to b and returns the new offset. b is clamped to the
+// readable bins between the tail and the next empty bin.
+func (r *BufferReader) Seek(b binmap.Bin) (uint64, error) {
+	<-r.buf.ready
+
+	r.buf.lock.Lock()
+	defer r.buf.lock.Unlock()
+
+	r.sync()
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	tail := r.buf.tail()
+	if tail.IsNone() {
+		return 0, ErrReadOffsetNotFound
+	}
+
+	b = b.BaseLeft()
+	if b < tail {
+		b = tail
+	} else if b > r.buf.next {
+		b = r.buf.next
+	}
+	r.setOffset(b)
+
+	swapChanValue(r.readable, nil)
+	return r.off, nil
+}
+
+func (r *BufferReader) setOffset(b binmap.Bin) {
+	r.prev = b
+	r.off = binByte(b, r.buf.chunkSize)
+	r.aligned = false
+}
+
+// skipPadding moves the reader past the padding after short chunks in
+// buffers with variable chunk sizes. Writers end their chunk when a record
+// ends so readers that were moved skip to the chunk after the next short
+// chunk to start at a record boundary. It returns false if the reader reaches
+// the next empty bin.
+func (r *BufferReader) skipPadding() bool {
+	cs := r.buf.chunkSize
+	for r.prev < r.buf.next {
+		start := r.off - r.off%cs
+		if !r.aligned {
+			prev := byteBin(start, cs) - 2
+			if start != 0 && (prev < r.buf.tail() || r.buf.chunkEnd(start-cs) == start) {
+				r.prev = byteBin(start+cs, cs)
+				r.off = start + cs
+				continue
+			}
+			r.aligned = true
+		}
+
+		if r.off < r.buf.chunkEnd(start) {
+			return true
+		}
+		r.prev = byteBin(start+cs, cs)
+		r.off = start + cs
+	}
+	return false
+}
+
 // SetReadStopper ...
 func (r *BufferReader) SetReadStopper(ch ioutil.Stopper) {
 	r.stopper = ch
@@ -423,30 +661,64 @@ func (r *BufferReader) Read(p []byte) (int, error) {
 		return 0, err
 	}
 
-	for r.buf.next == r.prev || len(r.readable) != 0 {
-		r.buf.lock.Unlock()
-
-		select {
-		case err := <-r.readable:
-			if err != nil {
-				r.buf.lock.Lock()
-				r.err = err
-				r.buf.lock.Unlock()
-				return 0, err
+	for {
+		for r.buf.next == r.prev || len(r.readable) != 0 {
+			r.buf.lock.Unlock()
+
+			select {
+			case err := <-r.readable:
+				if err != nil {
+					r.buf.lock.Lock()
+					r.err = err
+					r.buf.lock.Unlock()
+					return 0, err
+				}
+			case <-r.stopper:
+				return 0, ioutil.ErrStopped
 			}
-		case <-r.stopper:
-			return 0, ioutil.ErrStopped
+
+			r.buf.lock.Lock()
+		}
+		r.sync()
+
+		// readers that seek behind the live edge can be overtaken by the tail
+		if r.prev < r.buf.tail() {
+			r.err = ErrBufferUnderrun
+			r.buf.lock.Unlock()
+			return 0, r.err
 		}
 
-		r.buf.lock.Lock()
+		if r.buf.lens == nil || r.skipPadding() {
+			break
+		}
 	}
-	r.sync()
 
 	l := int(r.off - binByte(r.buf.tail(), r.buf.chunkSize))
 	h := int(binByte(r.buf.next-r.buf.tail(), r.buf.chunkSize))
 	i := r.buf.index(r.buf.tail())
+	if r.buf.lens != nil {
+		h = mathutil.Min(h, int(r.buf.chunkEnd(r.off)-binByte(r.buf.tail(), r.buf.chunkSize)))
+	}
 
-	n := rope.New(p).Copy(rope.New(r.buf.buf[i:], r.buf.buf[:i]).Slice(l, h)...)
+	var n int
+	if r.buf.disk != nil {
+		var err error
+		n, err = r.buf.disk.ReadAt(p[:mathutil.Min(len(p), h-l)], i+l)
+		if err != nil {
+			r.err = err
+			r.buf.lock.Unlock()
+			return 0, err
+		}
+	} else {
+		n = rope.New(p).Copy(rope.New(r.buf.buf[i:], r.buf.buf[:i]).Slice(l, h)...)
+	}
+
+	if r.buf.cipher != nil {
+		if err := r.buf.cipher.XORKeyStreamAt(p[:n], p[:n], r.off); err != nil {
+			r.buf.lock.Unlock()
+			return 0, err
+		}
+	}
 
 	r.off += uint64(n)
 	r.prev = byteBin(r.off, r.buf.chunkSize)
@@ -4,6 +4,7 @@
 package store
 
 import (
+	"bytes"
 	"context"
 	"io"
 	"testing"
@@ -11,6 +12,9 @@ import (
 
 	"github.com/MemeLabs/strims/pkg/binmap"
 	"github.com/MemeLabs/strims/pkg/ioutil"
+	"github.com/MemeLabs/strims/pkg/mathutil"
+	"github.com/MemeLabs/strims/pkg/ppspp/codec"
+	"github.com/MemeLabs/strims/pkg/timeutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -92,46 +96,57 @@ func TestBufferWriteRead(t *testing.T) {
 		},
 	}
 
-	for _, c := range cases {
-		c := c
-		t.Run(c.label, func(t *testing.T) {
-			b, err := NewBuffer(c.chunkCount, c.chunkSize)
-			assert.NoError(t, err, "buffer constructor failed")
-
-			binByteLen := int(c.inputBin.BaseLength()) * c.chunkSize
-			binData := make([]byte, binByteLen)
-			readData := make([]byte, c.readSize)
-
-			for i := 0; i < binByteLen; i++ {
-				binData[i] = byte(i / c.readSize)
-			}
-
-			go func() {
-				b.SetOffset(c.inputBin)
-
-				off := binByte(c.inputBin.BaseLeft(), uint64(c.chunkSize))
-				for _, bin := range c.writeOrder {
-					l := binByte(bin.BaseLeft(), uint64(c.chunkSize)) - off
-					h := binByte(bin.BaseRight()+2, uint64(c.chunkSize)) - off
-					b.Consume(Chunk{bin, binData[l:h]})
+	layouts := []struct {
+		label string
+		new   func(size, chunkSize int) (*Buffer, error)
+	}{
+		{"circular", NewBuffer},
+		{"disk", func(size, chunkSize int) (*Buffer, error) { return NewDiskBuffer(size, chunkSize, t.TempDir()) }},
+	}
+
+	for _, l := range layouts {
+		for _, c := range cases {
+			l, c := l, c
+			t.Run(l.label+" "+c.label, func(t *testing.T) {
+				b, err := l.new(c.chunkCount, c.chunkSize)
+				assert.NoError(t, err, "buffer constructor failed")
+				defer b.Close()
+
+				binByteLen := int(c.inputBin.BaseLength()) * c.chunkSize
+				binData := make([]byte, binByteLen)
+				readData := make([]byte, c.readSize)
+
+				for i := 0; i < binByteLen; i++ {
+					binData[i] = byte(i / c.readSize)
 				}
-			}()
 
-			r := NewBufferReader(b)
+				go func() {
+					b.SetOffset(c.inputBin)
+
+					off := binByte(c.inputBin.BaseLeft(), uint64(c.chunkSize))
+					for _, bin := range c.writeOrder {
+						l := binByte(bin.BaseLeft(), uint64(c.chunkSize)) - off
+						h := binByte(bin.BaseRight()+2, uint64(c.chunkSize)) - off
+						b.Consume(Chunk{Bin: bin, Data: binData[l:h]})
+					}
+				}()
 
-			byteOffset := binByte(c.inputBin.BaseLeft(), uint64(c.chunkSize))
-			assert.Equal(t, byteOffset, r.Offset(), "read offset mismatch")
+				r := NewBufferReader(b)
 
-			for i := 0; i < binByteLen/c.readSize; i++ {
-				n, err := r.Read(readData)
-				assert.NoError(t, err, "read failed")
+				byteOffset := binByte(c.inputBin.BaseLeft(), uint64(c.chunkSize))
+				assert.Equal(t, byteOffset, r.Offset(), "read offset mismatch")
 
-				l := i * c.readSize
-				h := l + c.readSize
-				assert.Equal(t, c.readSize, n, "incomplete read from %d - %d", l, h)
-				assert.EqualValues(t, binData[l:h], readData, "misaligned read from %d - %d", l, h)
-			}
-		})
+				for i := 0; i < binByteLen/c.readSize; i++ {
+					n, err := r.Read(readData)
+					assert.NoError(t, err, "read failed")
+
+					l := i * c.readSize
+					h := l + c.readSize
+					assert.Equal(t, c.readSize, n, "incomplete read from %d - %d", l, h)
+					assert.EqualValues(t, binData[l:h], readData, "misaligned read from %d - %d", l, h)
+				}
+			})
+		}
 	}
 }
 
@@ -147,7 +162,7 @@ func TestMultipleReaders(t *testing.T) {
 
 	src := make([]byte, chunkSize)
 	for i := 0; i < chunkCount; i++ {
-		b.Consume(Chunk{binmap.NewBin(0, uint64(i)), src})
+		b.Consume(Chunk{Bin: binmap.NewBin(0, uint64(i)), Data: src})
 	}
 
 	for i := 0; i < 3; i++ {
@@ -168,7 +183,7 @@ func TestBufferRecover(t *testing.T) {
 	dst := make([]byte, 128*16)
 
 	for i := binmap.Bin(0); i < 256; i = i.LayerRight() {
-		b.Consume(Chunk{i, src})
+		b.Consume(Chunk{Bin: i, Data: src})
 	}
 
 	r := NewBufferReader(b)
@@ -177,7 +192,7 @@ func TestBufferRecover(t *testing.T) {
 	assert.EqualValues(t, 128*16, n)
 	assert.NoError(t, err)
 
-	b.Consume(Chunk{4096, src})
+	b.Consume(Chunk{Bin: 4096, Data: src})
 
 	_, err = r.Read(dst)
 	assert.Equal(t, ErrBufferUnderrun, err)
@@ -191,6 +206,94 @@ func TestBufferRecover(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+type testTimeIndex map[timeutil.Time]binmap.Bin
+
+func (idx testTimeIndex) TimeBin(t timeutil.Time) (binmap.Bin, error) {
+	return idx[t], nil
+}
+
+func TestBufferReaderSeek(t *testing.T) {
+	const chunkSize = 16
+
+	b, err := NewBuffer(1024, chunkSize)
+	assert.NoError(t, err, "buffer construction failed")
+
+	b.SetOffset(0)
+
+	chunk := func(i int) Chunk {
+		return Chunk{Bin: binmap.NewBin(0, uint64(i)), Data: bytes.Repeat([]byte{byte(i)}, chunkSize)}
+	}
+	for i := 0; i < 2048; i++ {
+		b.Consume(chunk(i))
+	}
+	bins := b.Bins()
+
+	r0 := NewBufferReader(b)
+	r1 := NewBufferReader(b)
+	_, err = r0.Seek(binmap.NewBin(0, 2048))
+	assert.NoError(t, err)
+	_, err = r1.Seek(binmap.NewBin(0, 2048))
+	assert.NoError(t, err)
+
+	ts := timeutil.Now()
+	off, err := r0.SeekTime(testTimeIndex{ts: binmap.NewBin(0, 1536)}, ts)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1536*chunkSize, off)
+	assert.EqualValues(t, 2048*chunkSize, r1.Offset(), "other readers should not move")
+	assert.Equal(t, binmap.NewBin(0, 1024), b.Tail(), "the buffer should not move")
+	assert.Equal(t, binmap.NewBin(0, 2048), b.Next())
+	assert.Equal(t, bins.IterateFilled().ToSlice(), b.Bins().IterateFilled().ToSlice(), "buffered chunks should be retained")
+
+	dst := make([]byte, 512*chunkSize)
+	_, err = io.ReadFull(r0, dst)
+	assert.NoError(t, err)
+	for i := 0; i < 512; i++ {
+		if !assert.Equal(t, byte(1536+i), dst[i*chunkSize], "chunk %d mismatch", 1536+i) {
+			break
+		}
+	}
+
+	off, err = r0.Seek(binmap.NewBin(0, 256))
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1024*chunkSize, off, "seeks before the tail should be clamped")
+
+	off, err = r0.Seek(binmap.NewBin(0, 4096))
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2048*chunkSize, off, "seeks past the next bin should be clamped")
+}
+
+func TestBufferReaderSeekOvertakenByTail(t *testing.T) {
+	const chunkSize = 16
+
+	b, err := NewBuffer(1024, chunkSize)
+	assert.NoError(t, err, "buffer construction failed")
+
+	b.SetOffset(0)
+
+	src := make([]byte, chunkSize)
+	for i := 0; i < 1024; i++ {
+		b.Consume(Chunk{Bin: binmap.NewBin(0, uint64(i)), Data: src})
+	}
+
+	r := NewBufferReader(b)
+	_, err = r.Seek(binmap.NewBin(0, 256))
+	assert.NoError(t, err)
+
+	for i := 1024; i < 1536; i++ {
+		b.Consume(Chunk{Bin: binmap.NewBin(0, uint64(i)), Data: src})
+	}
+
+	_, err = r.Read(make([]byte, chunkSize))
+	assert.Equal(t, ErrBufferUnderrun, err, "expected an underrun once the tail passes the reader")
+
+	n, err := r.Recover()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 256*chunkSize, n, "expected the reader to skip to the tail")
+
+	_, err = r.Read(make([]byte, chunkSize))
+	assert.NoError(t, err)
+}
+
 func TestBufferReadStop(t *testing.T) {
 	b, _ := NewBuffer(1024, 16)
 
@@ -215,7 +318,7 @@ func TestReaderClose(t *testing.T) {
 
 	src := make([]byte, chunkSize)
 	for i := 0; i < chunkCount; i++ {
-		b.Consume(Chunk{binmap.NewBin(0, uint64(i)), src})
+		b.Consume(Chunk{Bin: binmap.NewBin(0, uint64(i)), Data: src})
 	}
 
 	r := NewBufferReader(b)
@@ -244,3 +347,115 @@ func TestReaderClose(t *testing.T) {
 
 	<-done
 }
+
+func TestDiskBufferCache(t *testing.T) {
+	chunkCount := 8192
+	chunkSize := 1024
+
+	b, err := NewDiskBuffer(chunkCount, chunkSize, t.TempDir())
+	assert.NoError(t, err, "buffer constructor failed")
+	defer b.Close()
+
+	b.SetOffset(0)
+
+	// write enough chunks to evict pages from the cache
+	src := make([]byte, chunkSize)
+	for i := 0; i < chunkCount; i++ {
+		src[0] = byte(i)
+		b.Consume(Chunk{Bin: binmap.NewBin(0, uint64(i)), Data: src})
+	}
+
+	var dst bytes.Buffer
+	_, err = b.WriteData(binmap.NewBin(0, 3), timeutil.Now(), dataWriterFunc(func(m codec.Data) (int, error) {
+		return dst.Write(m.Data)
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, byte(3), dst.Bytes()[0], "expected evicted chunk to be read back from disk")
+
+	c, err := b.ExportCache()
+	assert.NoError(t, err)
+	assert.Len(t, c, chunkCount*chunkSize)
+
+	b2, err := NewDiskBuffer(chunkCount, chunkSize, t.TempDir())
+	assert.NoError(t, err, "buffer constructor failed")
+	defer b2.Close()
+
+	assert.NoError(t, b2.ImportCache(c))
+	assert.Equal(t, b.Bins().IterateFilled().ToSlice(), b2.Bins().IterateFilled().ToSlice())
+
+	r := NewBufferReader(b2)
+	dst.Reset()
+	n, err := io.Copy(&dst, io.LimitReader(r, int64(len(c))))
+	assert.EqualValues(t, len(c), n)
+	assert.NoError(t, err)
+	assert.Equal(t, c, dst.Bytes())
+}
+
+type dataWriterFunc func(m codec.Data) (int, error)
+
+func (f dataWriterFunc) WriteData(m codec.Data) (int, error) {
+	return f(m)
+}
+
+func TestBufferVariableChunkSize(t *testing.T) {
+	const chunkSize = 16
+
+	b, err := NewBuffer(64, chunkSize)
+	assert.NoError(t, err, "buffer construction failed")
+	b.SetVariableChunkSize()
+	b.SetOffset(0)
+
+	// records padded to the end of 4 chunk segments
+	records := [][]byte{
+		bytes.Repeat([]byte{1}, 20),
+		bytes.Repeat([]byte{2}, 16),
+		bytes.Repeat([]byte{3}, 10),
+	}
+	var i int
+	for _, d := range records {
+		for j := 0; j < 4; j, i = j+1, i+1 {
+			off := mathutil.Min(j*chunkSize, len(d))
+			n := mathutil.Min(len(d)-off, chunkSize)
+			c := make([]byte, chunkSize)
+			copy(c, d[off:off+n])
+			b.Consume(Chunk{Bin: binmap.NewBin(0, uint64(i)), Data: c, Lengths: []int{n}})
+		}
+	}
+
+	read := func(r *BufferReader, n int) []byte {
+		d := make([]byte, n)
+		_, err := io.ReadFull(r, d)
+		assert.NoError(t, err)
+		return d
+	}
+
+	r := NewBufferReader(b)
+	for _, d := range records {
+		assert.Equal(t, d, read(r, len(d)), "readers should skip padding")
+	}
+
+	// readers moved into the middle of a record skip to the next record
+	cases := []struct {
+		chunk  uint64
+		record int
+	}{
+		{1, 1},
+		{2, 1},
+		{4, 1},
+		{5, 2},
+	}
+	for _, c := range cases {
+		r := NewBufferReader(b)
+		_, err = r.Seek(binmap.NewBin(0, c.chunk))
+		assert.NoError(t, err)
+		d := records[c.record]
+		assert.Equal(t, d, read(r, len(d)), "reader at chunk %d should skip to record %d", c.chunk, c.record)
+	}
+
+	_, err = b.WriteData(binmap.NewBin(2, 0), timeutil.Now(), dataWriterFunc(func(m codec.Data) (int, error) {
+		assert.Equal(t, []int{16, 4, 0, 0}, m.Lengths)
+		assert.EqualValues(t, records[0], m.Data, "padding after the last chunk with data should be omitted")
+		return 0, nil
+	}))
+	assert.NoError(t, err)
+}
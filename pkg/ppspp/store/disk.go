@@ -0,0 +1,198 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package store
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/MemeLabs/strims/pkg/hashmap"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+)
+
+const (
+	diskRingMinPageSize    = 64 * 1024
+	diskRingCachePageCount = 64
+)
+
+// newDiskRing creates a file backed ring of size bytes in dir. Writes land in
+// an lru cache of pages that are flushed to the file when they're evicted so
+// recently written chunks are served from memory and older ones are read back
+// from disk.
+func newDiskRing(dir string, size, chunkSize int) (*diskRing, error) {
+	f, err := os.CreateTemp(dir, "ppspp-*.buf")
+	if err != nil {
+		return nil, fmt.Errorf("creating buffer file failed: %w", err)
+	}
+	if err := f.Truncate(int64(size)); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return nil, fmt.Errorf("allocating buffer file failed: %w", err)
+	}
+
+	// pages hold a power of 2 number of chunks so bins no larger than a page
+	// never straddle a page boundary.
+	pageSize := chunkSize
+	for pageSize < diskRingMinPageSize && pageSize < size {
+		pageSize *= 2
+	}
+
+	return &diskRing{
+		file:     f,
+		size:     size,
+		pageSize: pageSize,
+		pages:    hashmap.NewLRU[uint64, *diskRingPage](hashmap.NewUint64Interface[uint64]()),
+	}, nil
+}
+
+type diskRing struct {
+	file     *os.File
+	size     int
+	pageSize int
+	pages    hashmap.LRU[uint64, *diskRingPage]
+	free     []*diskRingPage
+	scratch  []byte
+	closed   bool
+}
+
+type diskRingPage struct {
+	data  []byte
+	dirty bool
+}
+
+func (d *diskRing) page(i uint64) (*diskRingPage, error) {
+	if d.closed {
+		return nil, ErrClosed
+	}
+	if p, ok := d.pages.Get(i); ok {
+		return p, nil
+	}
+
+	p, err := d.allocPage()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := d.file.ReadAt(p.data, int64(i)*int64(d.pageSize)); err != nil && !errors.Is(err, io.EOF) {
+		d.free = append(d.free, p)
+		return nil, fmt.Errorf("reading buffer page failed: %w", err)
+	}
+	d.pages.GetOrInsert(i, p)
+	return p, nil
+}
+
+func (d *diskRing) allocPage() (*diskRingPage, error) {
+	if d.pages.Len() >= diskRingCachePageCount {
+		it := d.pages.IterateTouchedBefore(timeutil.MaxTime)
+		if it.Next() {
+			i, p := it.Key(), it.Value()
+			if err := d.flushPage(i, p); err != nil {
+				return nil, err
+			}
+			d.pages.Delete(i)
+			d.free = append(d.free, p)
+		}
+	}
+
+	if n := len(d.free); n != 0 {
+		p := d.free[n-1]
+		d.free = d.free[:n-1]
+		return p, nil
+	}
+	return &diskRingPage{data: make([]byte, d.pageSize)}, nil
+}
+
+func (d *diskRing) flushPage(i uint64, p *diskRingPage) error {
+	if !p.dirty {
+		return nil
+	}
+	if _, err := d.file.WriteAt(p.data, int64(i)*int64(d.pageSize)); err != nil {
+		return fmt.Errorf("writing buffer page failed: %w", err)
+	}
+	p.dirty = false
+	return nil
+}
+
+// Flush writes every dirty cached page to the file.
+func (d *diskRing) Flush() error {
+	for it := d.pages.Iterate(); it.Next(); {
+		if err := d.flushPage(it.Key(), it.Value()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteAt copies p into the ring starting at off wrapping at the end of the
+// ring.
+func (d *diskRing) WriteAt(p []byte, off int) error {
+	for len(p) != 0 {
+		off %= d.size
+		pg, err := d.page(uint64(off / d.pageSize))
+		if err != nil {
+			return err
+		}
+		n := copy(pg.data[off%d.pageSize:], p)
+		pg.dirty = true
+		p = p[n:]
+		off += n
+	}
+	return nil
+}
+
+// ReadAt copies len(p) bytes starting at off into p wrapping at the end of the
+// ring.
+func (d *diskRing) ReadAt(p []byte, off int) (int, error) {
+	var n int
+	for n < len(p) {
+		off %= d.size
+		pg, err := d.page(uint64(off / d.pageSize))
+		if err != nil {
+			return n, err
+		}
+		nn := copy(p[n:], pg.data[off%d.pageSize:])
+		n += nn
+		off += nn
+	}
+	return n, nil
+}
+
+// Bytes returns n bytes starting at off. The slice is only valid until the
+// next call to the ring.
+func (d *diskRing) Bytes(off, n int) ([]byte, error) {
+	if off%d.pageSize+n <= d.pageSize {
+		pg, err := d.page(uint64(off / d.pageSize))
+		if err != nil {
+			return nil, err
+		}
+		i := off % d.pageSize
+		return pg.data[i : i+n], nil
+	}
+
+	if cap(d.scratch) < n {
+		d.scratch = make([]byte, n)
+	}
+	b := d.scratch[:n]
+	if _, err := d.ReadAt(b, off); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// Close closes and deletes the buffer file.
+func (d *diskRing) Close() error {
+	if d.closed {
+		return nil
+	}
+	d.closed = true
+	d.pages = hashmap.NewLRU[uint64, *diskRingPage](hashmap.NewUint64Interface[uint64]())
+	d.free = nil
+
+	err := d.file.Close()
+	if rerr := os.Remove(d.file.Name()); err == nil {
+		err = rerr
+	}
+	return err
+}
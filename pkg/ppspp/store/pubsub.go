@@ -72,4 +72,7 @@ type Subscriber interface {
 type Chunk struct {
 	Bin  binmap.Bin
 	Data []byte
+	// Lengths holds the length of the data in each chunk in swarms with
+	// variable chunk sizes. Chunks are full if it is nil.
+	Lengths []int
 }
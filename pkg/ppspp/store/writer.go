@@ -28,7 +28,7 @@ type writer struct {
 
 // Write ...
 func (w *writer) Write(p []byte) (n int, err error) {
-	w.pub.Publish(Chunk{w.bin, p})
+	w.pub.Publish(Chunk{Bin: w.bin, Data: p})
 	w.bin += 2
 	return len(p), nil
 }
@@ -9,8 +9,10 @@ import (
 
 	swarmpb "github.com/MemeLabs/strims/pkg/apis/type/swarm"
 	"github.com/MemeLabs/strims/pkg/options"
+	"github.com/MemeLabs/strims/pkg/ppspp/codec"
 	"github.com/MemeLabs/strims/pkg/ppspp/integrity"
 	"github.com/MemeLabs/strims/pkg/ppspp/store"
+	"github.com/MemeLabs/strims/pkg/timeutil"
 )
 
 // NewDefaultSwarm ...
@@ -23,7 +25,17 @@ func NewDefaultSwarm(id SwarmID) (s *Swarm) {
 func NewSwarm(id SwarmID, o SwarmOptions) (*Swarm, error) {
 	o = options.AssignDefaults(o, NewDefaultSwarmOptions())
 
-	buf, err := store.NewBufferWithLayout(o.LiveWindow, o.ChunkSize, o.BufferLayout)
+	if o.VariableChunkSize && o.ContentEncryptionMethod != NoneContentEncryptionMethod {
+		return nil, errors.New("variable chunk sizes are not supported in encrypted swarms")
+	}
+
+	var buf *store.Buffer
+	var err error
+	if o.BufferLayout == store.DiskBufferLayout {
+		buf, err = store.NewDiskBuffer(o.LiveWindow, o.ChunkSize, o.BufferDir)
+	} else {
+		buf, err = store.NewBufferWithLayout(o.LiveWindow, o.ChunkSize, o.BufferLayout)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -38,14 +50,28 @@ func NewSwarm(id SwarmID, o SwarmOptions) (*Swarm, error) {
 		return nil, err
 	}
 
-	return &Swarm{
+	s := &Swarm{
 		id:       id,
 		options:  o,
 		store:    buf,
 		pubSub:   store.NewPubSub(buf),
 		verifier: v,
 		epoch:    newEpoch(sv),
-	}, nil
+	}
+
+	if o.ContentEncryptionMethod != NoneContentEncryptionMethod {
+		s.cipher, err = newContentCipher(o.ContentEncryptionMethod, &s.epoch)
+		if err != nil {
+			return nil, err
+		}
+		buf.SetCipher(s.cipher)
+	}
+
+	if o.VariableChunkSize {
+		buf.SetVariableChunkSize()
+	}
+
+	return s, nil
 }
 
 // Swarm ...
@@ -57,6 +83,8 @@ type Swarm struct {
 	pubSub   *store.PubSub
 	verifier integrity.SwarmVerifier
 	epoch    epoch
+	pex      peerExchange
+	cipher   *contentCipher
 }
 
 // ID ...
@@ -64,6 +92,46 @@ func (s *Swarm) ID() SwarmID {
 	return s.id
 }
 
+// Options ...
+func (s *Swarm) Options() SwarmOptions {
+	return s.options
+}
+
+// Epoch returns the signed time the swarm's writer started. The time is nil
+// until the epoch is received from a peer.
+func (s *Swarm) Epoch() (timeutil.Time, []byte) {
+	return s.epoch.Value()
+}
+
+// SetContentKey sets the key used to decrypt the content of encrypted swarms.
+func (s *Swarm) SetContentKey(k []byte) error {
+	if s.cipher == nil {
+		return ErrSwarmNotEncrypted
+	}
+	return s.cipher.SetKey(k)
+}
+
+// ContentKey returns the content key of encrypted swarms. The key is missing
+// until it is set by the writer or received from a peer.
+func (s *Swarm) ContentKey() ([]byte, bool) {
+	if s.cipher == nil {
+		return nil, false
+	}
+	return s.cipher.Key()
+}
+
+func (s *Swarm) channelVerifier() integrity.ChannelVerifier {
+	v := s.verifier.ChannelVerifier()
+	if s.cipher == nil {
+		return v
+	}
+	return &contentChannelVerifier{
+		ChannelVerifier: v,
+		cipher:          s.cipher,
+		chunkSize:       uint64(s.options.ChunkSize),
+	}
+}
+
 // URI ...
 func (s *Swarm) URI() *URI {
 	return &URI{
@@ -72,6 +140,17 @@ func (s *Swarm) URI() *URI {
 	}
 }
 
+// NotifyPeerExchange registers ch to receive the ids of peers learned from
+// PEX responses. Sends are non-blocking so ids are dropped when ch is full.
+func (s *Swarm) NotifyPeerExchange(ch chan<- []byte) {
+	s.pex.notify(ch)
+}
+
+// StopNotifyingPeerExchange ...
+func (s *Swarm) StopNotifyingPeerExchange(ch chan<- []byte) {
+	s.pex.stopNotifying(ch)
+}
+
 // Reader ...
 func (s *Swarm) Reader() *store.BufferReader {
 	return store.NewBufferReader(s.store)
@@ -81,10 +160,25 @@ func (s *Swarm) ImportCache(c *swarmpb.Cache) error {
 	if c.Uri != s.URI().String() {
 		return errors.New("cache import failed: incompatible swarm options")
 	}
+	if _, ok := s.ContentKey(); s.cipher != nil && !ok {
+		return fmt.Errorf("cache import failed: %w", ErrContentKeyNotSet)
+	}
 	if err := s.epoch.ImportCache(c.Epoch); err != nil {
 		return fmt.Errorf("epoch import failed: %w", err)
 	}
-	if err := s.verifier.ImportCache(c); err != nil {
+	vc := c
+	if s.cipher != nil {
+		// the cache data is encrypted and the integrity signatures cover the
+		// plaintext.
+		vc = &swarmpb.Cache{
+			Integrity: c.Integrity,
+			Data:      make([]byte, len(c.Data)),
+		}
+		if err := s.cipher.XORKeyStreamAt(vc.Data, c.Data, 0); err != nil {
+			return fmt.Errorf("cache import failed: %w", err)
+		}
+	}
+	if err := s.verifier.ImportCache(vc); err != nil {
 		return fmt.Errorf("cache import failed: %w", err)
 	}
 	if err := s.store.ImportCache(c.Data); err != nil {
@@ -93,6 +187,59 @@ func (s *Swarm) ImportCache(c *swarmpb.Cache) error {
 	return nil
 }
 
+// ImportMessages seeds the swarm with the epoch and chunks carried by
+// messages exchanged with a peer. Integrity messages are applied in order so
+// they verify the data that follows them, as they would when read from the
+// peer. It is used to rebuild a peer's state from captured traffic.
+func (s *Swarm) ImportMessages(ms []codec.Message) error {
+	v := s.channelVerifier()
+	offsetSet := !s.store.Empty()
+
+	for _, m := range ms {
+		switch m := m.(type) {
+		case *codec.Handshake:
+			if o, ok := m.Options.Find(codec.EpochOption); ok {
+				e := o.(*codec.EpochProtocolOption)
+				if _, err := s.epoch.Sync(e.Timestamp.Time, e.Signature); err != nil {
+					return fmt.Errorf("epoch import failed: %w", err)
+				}
+			}
+		case *codec.Integrity:
+			if cv := v.ChunkVerifier(m.Address.Bin()); cv != nil {
+				cv.SetIntegrity(m.Address.Bin(), m.Hash)
+			}
+		case *codec.SignedIntegrity:
+			if cv := v.ChunkVerifier(m.Address.Bin()); cv != nil {
+				cv.SetSignedIntegrity(m.Address.Bin(), m.Timestamp.Time, m.Signature)
+			}
+		case *codec.Data:
+			b := m.Address.Bin()
+			d := []byte(m.Data)
+			if n := int(b.BaseLength()) * s.options.ChunkSize; s.options.VariableChunkSize && len(d) < n {
+				d = append(make([]byte, 0, n), d...)
+				d = d[:n]
+			}
+
+			if ok, err := v.ChunkVerifier(b).Verify(b, d); !ok {
+				return fmt.Errorf("chunk %d import failed: %w", b, err)
+			}
+
+			if !offsetSet {
+				s.store.SetOffset(b)
+				offsetSet = true
+			}
+			s.pubSub.Publish(store.Chunk{Bin: b, Data: d, Lengths: m.Lengths})
+		}
+	}
+	return nil
+}
+
+// CacheVersion returns a counter that changes whenever the data returned by
+// ExportCache changes.
+func (s *Swarm) CacheVersion() uint64 {
+	return s.store.Version()
+}
+
 func (s *Swarm) ExportCache() (*swarmpb.Cache, error) {
 	data, err := s.store.ExportCache()
 	if err != nil {
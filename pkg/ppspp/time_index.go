@@ -0,0 +1,71 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package ppspp
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/MemeLabs/strims/pkg/binmap"
+	"github.com/MemeLabs/strims/pkg/ppspp/integrity"
+	"github.com/MemeLabs/strims/pkg/ppspp/store"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+)
+
+var _ store.TimeIndex = (*Swarm)(nil)
+
+// ErrTimeIndexEmpty is returned when no signed chunks are available to map
+// times to bins.
+var ErrTimeIndexEmpty = errors.New("time index empty")
+
+// TimeBin returns the first bin of the signed segment that covers t. Times
+// older than the retained signatures are estimated by interpolating between
+// the epoch, which is the time bin 0 was written, and the oldest signature.
+// The result is clamped to the live window behind the newest signature.
+func (s *Swarm) TimeBin(t timeutil.Time) (binmap.Bin, error) {
+	bins := s.verifier.SignedBins()
+	if len(bins) == 0 {
+		return 0, ErrTimeIndexEmpty
+	}
+
+	epoch, _ := s.epoch.Value()
+	return timeBin(bins, epoch, t, uint64(s.options.ChunksPerSignature), uint64(s.options.LiveWindow)), nil
+}
+
+func timeBin(bins []integrity.SignedBin, epoch, t timeutil.Time, chunksPerSignature, liveWindow uint64) binmap.Bin {
+	var min uint64
+	if end := bins[len(bins)-1].Bin.BaseRight().BaseOffset() + 1; end > liveWindow {
+		min = alignUp(end-liveWindow, chunksPerSignature)
+	}
+
+	var off uint64
+	i := sort.Search(len(bins), func(i int) bool { return !bins[i].Timestamp.Before(t) })
+	switch {
+	case i == len(bins):
+		off = bins[i-1].Bin.BaseLeft().BaseOffset()
+	case i > 0 || epoch.IsNil():
+		off = bins[i].Bin.BaseLeft().BaseOffset()
+	case !epoch.Before(t):
+		off = 0
+	default:
+		first := bins[0]
+		span := first.Timestamp.Sub(epoch)
+		if span > 0 {
+			off = uint64(float64(first.Bin.BaseLeft().BaseOffset()) * float64(t.Sub(epoch)) / float64(span))
+			off -= off % chunksPerSignature
+		}
+	}
+
+	if off < min {
+		off = min
+	}
+	return binmap.Bin(off * 2)
+}
+
+func alignUp(n, m uint64) uint64 {
+	if r := n % m; r != 0 {
+		n += m - r
+	}
+	return n
+}
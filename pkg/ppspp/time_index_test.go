@@ -0,0 +1,93 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package ppspp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MemeLabs/strims/pkg/binmap"
+	"github.com/MemeLabs/strims/pkg/ppspp/integrity"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeBin(t *testing.T) {
+	const chunksPerSignature = 64
+
+	epoch := timeutil.Unix(1000, 0)
+
+	// signatures every second for chunks 1024 through 2047
+	var bins []integrity.SignedBin
+	for i := 16; i < 32; i++ {
+		bins = append(bins, integrity.SignedBin{
+			Bin:       binmap.NewBin(6, uint64(i)),
+			Timestamp: epoch.Add(time.Duration(i+1) * time.Second),
+		})
+	}
+
+	cases := []struct {
+		label      string
+		epoch      timeutil.Time
+		t          timeutil.Time
+		liveWindow uint64
+		bin        binmap.Bin
+	}{
+		{
+			label:      "exact signature time",
+			epoch:      epoch,
+			t:          epoch.Add(20 * time.Second),
+			liveWindow: 1 << 16,
+			bin:        binmap.NewBin(0, 19*chunksPerSignature),
+		},
+		{
+			label:      "between signatures",
+			epoch:      epoch,
+			t:          epoch.Add(20*time.Second + 500*time.Millisecond),
+			liveWindow: 1 << 16,
+			bin:        binmap.NewBin(0, 20*chunksPerSignature),
+		},
+		{
+			label:      "after newest signature",
+			epoch:      epoch,
+			t:          epoch.Add(time.Minute),
+			liveWindow: 1 << 16,
+			bin:        binmap.NewBin(0, 31*chunksPerSignature),
+		},
+		{
+			label:      "interpolated from epoch",
+			epoch:      epoch,
+			t:          epoch.Add(8*time.Second + 500*time.Millisecond),
+			liveWindow: 1 << 16,
+			bin:        binmap.NewBin(0, 8*chunksPerSignature),
+		},
+		{
+			label:      "before epoch",
+			epoch:      epoch,
+			t:          epoch.Add(-time.Minute),
+			liveWindow: 1 << 16,
+			bin:        0,
+		},
+		{
+			label:      "missing epoch",
+			epoch:      timeutil.NilTime,
+			t:          epoch.Add(time.Second),
+			liveWindow: 1 << 16,
+			bin:        binmap.NewBin(0, 16*chunksPerSignature),
+		},
+		{
+			label:      "clamped to live window",
+			epoch:      epoch,
+			t:          epoch,
+			liveWindow: 1000,
+			bin:        binmap.NewBin(0, 17*chunksPerSignature),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.label, func(t *testing.T) {
+			assert.Equal(t, c.bin, timeBin(bins, c.epoch, c.t, chunksPerSignature, c.liveWindow))
+		})
+	}
+}
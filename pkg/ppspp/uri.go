@@ -46,6 +46,18 @@ var protocolOptions = []struct {
 		codec.StreamCountOption,
 		"x.sc",
 	},
+	{
+		codec.ChunkAddressingMethodOption,
+		"x.ca",
+	},
+	{
+		codec.VariableChunkSizeOption,
+		"x.vc",
+	},
+	{
+		codec.ContentEncryptionMethodOption,
+		"x.ce",
+	},
 }
 
 var uriScheme = "magnet"
@@ -65,6 +77,9 @@ func (o URIOptions) SwarmOptions() SwarmOptions {
 			MerkleHashTreeFunction: integrity.MerkleHashTreeFunction(o[codec.MerkleHashTreeFunctionOption]),
 			LiveSignatureAlgorithm: integrity.LiveSignatureAlgorithm(o[codec.LiveSignatureAlgorithmOption]),
 		},
+		ChunkAddressingMethod:   codec.ChunkAddressingMethod(o[codec.ChunkAddressingMethodOption]),
+		VariableChunkSize:       o[codec.VariableChunkSizeOption] != 0,
+		ContentEncryptionMethod: ContentEncryptionMethod(o[codec.ContentEncryptionMethodOption]),
 	}
 }
 
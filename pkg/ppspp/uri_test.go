@@ -33,3 +33,22 @@ func TestURI(t *testing.T) {
 
 	assert.Equal(t, uri, uri2)
 }
+
+func TestURISwarmOptions(t *testing.T) {
+	o := NewDefaultSwarmOptions()
+	assert.NotContains(t, o.URIOptions(), codec.ChunkAddressingMethodOption, "default addressing method should be omitted")
+	assert.NotContains(t, o.URIOptions(), codec.VariableChunkSizeOption, "fixed chunk size should be omitted")
+	assert.NotContains(t, o.URIOptions(), codec.ContentEncryptionMethodOption, "unencrypted content should be omitted")
+
+	o.ChunkAddressingMethod = codec.ChunkRangeChunkAddressingMethod
+	o.VariableChunkSize = true
+	o.ContentEncryptionMethod = AES256CTRContentEncryptionMethod
+
+	u, err := ParseURI(NewURI(SwarmID{}, o.URIOptions()).String())
+	assert.NoError(t, err)
+
+	so := u.Options.SwarmOptions()
+	assert.Equal(t, codec.ChunkRangeChunkAddressingMethod, so.ChunkAddressingMethod)
+	assert.True(t, so.VariableChunkSize)
+	assert.Equal(t, AES256CTRContentEncryptionMethod, so.ContentEncryptionMethod)
+}
@@ -5,7 +5,9 @@ package ppspp
 
 import (
 	"github.com/MemeLabs/strims/pkg/apis/type/key"
+	"github.com/MemeLabs/strims/pkg/binmap"
 	"github.com/MemeLabs/strims/pkg/ioutil"
+	"github.com/MemeLabs/strims/pkg/mathutil"
 	"github.com/MemeLabs/strims/pkg/options"
 	"github.com/MemeLabs/strims/pkg/ppspp/integrity"
 	"github.com/MemeLabs/strims/pkg/ppspp/store"
@@ -16,6 +18,9 @@ import (
 type WriterOptions struct {
 	SwarmOptions SwarmOptions
 	Key          *key.Key
+	// ContentKey is used to encrypt swarms with a ContentEncryptionMethod. A
+	// random key is generated if it is nil.
+	ContentKey []byte
 }
 
 // NewWriter ...
@@ -30,7 +35,27 @@ func NewWriter(o WriterOptions) (*Writer, error) {
 
 	s.store.SetOffset(0)
 
-	sw := store.NewWriter(s.pubSub, s.options.ChunkSize)
+	var pub store.Publisher = s.pubSub
+	var lp *chunkLenPublisher
+	if s.options.VariableChunkSize {
+		lp = &chunkLenPublisher{Publisher: s.pubSub, lens: map[binmap.Bin]int{}}
+		pub = lp
+	}
+
+	sw := store.NewWriter(pub, s.options.ChunkSize)
+	if s.cipher != nil {
+		k := o.ContentKey
+		if k == nil {
+			k, err = NewContentKey()
+			if err != nil {
+				return nil, err
+			}
+		}
+		if err := s.SetContentKey(k); err != nil {
+			return nil, err
+		}
+		sw = &contentEncryptWriter{c: s.cipher, w: sw}
+	}
 
 	iwo := s.options.IntegrityWriterOptions()
 	ss, err := iwo.LiveSignatureAlgorithm.Signer(o.Key.Private)
@@ -42,10 +67,17 @@ func NewWriter(o WriterOptions) (*Writer, error) {
 		return nil, err
 	}
 
+	segmentSize := s.options.ChunkSize
+	if s.options.Integrity.ProtectionMethod == integrity.ProtectionMethodMerkleTree {
+		segmentSize *= s.options.ChunksPerSignature
+	}
+
 	w := &Writer{
-		w:  iw,
-		s:  s,
-		ss: ss,
+		w:           iw,
+		s:           s,
+		ss:          ss,
+		lp:          lp,
+		segmentSize: segmentSize,
 	}
 	w.initEpoch()
 	return w, nil
@@ -56,6 +88,11 @@ type Writer struct {
 	w  ioutil.WriteFlushResetter
 	s  *Swarm
 	ss integrity.SignatureSigner
+	lp *chunkLenPublisher
+	// the integrity writer signs segments of this size
+	segmentSize int
+	// bytes written since the last reset
+	n int
 }
 
 func (w *Writer) initEpoch() {
@@ -72,11 +109,27 @@ func (w *Writer) Swarm() *Swarm {
 
 // Write ...
 func (w *Writer) Write(p []byte) (int, error) {
-	return w.w.Write(p)
+	n, err := w.w.Write(p)
+	w.n += n
+	return n, err
 }
 
-// Flush ...
+// Flush publishes the buffered data. In swarms with VariableChunkSize the
+// current signature segment is padded with zeros so the data written before
+// the flush can be signed. The length of the data in the padded chunks is
+// published with them.
 func (w *Writer) Flush() error {
+	if w.lp != nil && w.n%w.segmentSize != 0 {
+		cs := w.s.options.ChunkSize
+		end := (w.n/w.segmentSize + 1) * w.segmentSize
+		for off := w.n - w.n%cs; off < end; off += cs {
+			w.lp.SetLen(binmap.Bin(off/cs*2), mathutil.Max(w.n-off, 0))
+		}
+
+		if _, err := ioutil.WriteZerosN(w, int64(w.segmentSize-w.n%w.segmentSize)); err != nil {
+			return err
+		}
+	}
 	return w.w.Flush()
 }
 
@@ -84,6 +137,7 @@ func (w *Writer) Flush() error {
 func (w *Writer) Reset() {
 	w.initEpoch()
 	w.w.Reset()
+	w.n = 0
 	w.s.store.SetOffset(0)
 }
 
@@ -91,3 +145,27 @@ func (w *Writer) Reset() {
 func (w *Writer) Close() (err error) {
 	return w.s.Close()
 }
+
+// chunkLenPublisher adds the length of the data in chunks padded to fill
+// signature segments to the published chunks.
+type chunkLenPublisher struct {
+	store.Publisher
+	lens map[binmap.Bin]int
+}
+
+func (p *chunkLenPublisher) SetLen(b binmap.Bin, n int) {
+	p.lens[b] = n
+}
+
+func (p *chunkLenPublisher) Publish(c store.Chunk) {
+	if n, ok := p.lens[c.Bin]; ok {
+		c.Lengths = []int{n}
+		delete(p.lens, c.Bin)
+	}
+	p.Publisher.Publish(c)
+}
+
+func (p *chunkLenPublisher) Reset() {
+	p.lens = map[binmap.Bin]int{}
+	p.Publisher.Reset()
+}
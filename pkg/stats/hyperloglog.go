@@ -0,0 +1,79 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package stats
+
+import (
+	"encoding/binary"
+	"math"
+	"math/bits"
+)
+
+const (
+	hyperLogLogPrecision = 10
+	hyperLogLogSize      = 1 << hyperLogLogPrecision
+)
+
+// NewHyperLogLog ...
+func NewHyperLogLog() *HyperLogLog {
+	return &HyperLogLog{make([]byte, hyperLogLogSize)}
+}
+
+// HyperLogLogFromBytes restores a sketch created with Bytes. Malformed input
+// is replaced with an empty sketch.
+func HyperLogLogFromBytes(b []byte) *HyperLogLog {
+	h := NewHyperLogLog()
+	if len(b) == hyperLogLogSize {
+		copy(h.registers, b)
+	}
+	return h
+}
+
+// HyperLogLog estimates the number of distinct items added in a fixed 1KB
+// sketch with ~3% standard error.
+type HyperLogLog struct {
+	registers []byte
+}
+
+// Add adds an item. hash must be uniformly distributed and at least 8 bytes.
+func (h *HyperLogLog) Add(hash []byte) {
+	x := binary.BigEndian.Uint64(hash)
+	i := x >> (64 - hyperLogLogPrecision)
+	r := byte(bits.LeadingZeros64(x<<hyperLogLogPrecision|1<<(hyperLogLogPrecision-1)) + 1)
+	if r > h.registers[i] {
+		h.registers[i] = r
+	}
+}
+
+// Merge adds the items from o.
+func (h *HyperLogLog) Merge(o *HyperLogLog) {
+	for i, r := range o.registers {
+		if r > h.registers[i] {
+			h.registers[i] = r
+		}
+	}
+}
+
+// Count returns the estimated number of distinct items.
+func (h *HyperLogLog) Count() uint64 {
+	var sum float64
+	var zeros int
+	for _, r := range h.registers {
+		sum += 1 / float64(uint64(1)<<r)
+		if r == 0 {
+			zeros++
+		}
+	}
+
+	m := float64(hyperLogLogSize)
+	e := 0.7213 / (1 + 1.079/m) * m * m / sum
+	if e <= 2.5*m && zeros != 0 {
+		e = m * math.Log(m/float64(zeros))
+	}
+	return uint64(math.Round(e))
+}
+
+// Bytes returns the sketch registers.
+func (h *HyperLogLog) Bytes() []byte {
+	return h.registers
+}
@@ -0,0 +1,46 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package stats
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func hyperLogLogTestHash(i int) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(i))
+	h := sha256.Sum256(b[:])
+	return h[:]
+}
+
+func TestHyperLogLog(t *testing.T) {
+	cases := []int{0, 1, 10, 100, 1000, 10000, 100000}
+	for _, n := range cases {
+		h := NewHyperLogLog()
+		for i := 0; i < n; i++ {
+			h.Add(hyperLogLogTestHash(i))
+			h.Add(hyperLogLogTestHash(i))
+		}
+		assert.InDelta(t, n, h.Count(), float64(n)*0.1+1, "count %d should be within margin of error", n)
+	}
+}
+
+func TestHyperLogLogMerge(t *testing.T) {
+	a := NewHyperLogLog()
+	b := NewHyperLogLog()
+	for i := 0; i < 1000; i++ {
+		a.Add(hyperLogLogTestHash(i))
+		b.Add(hyperLogLogTestHash(i + 500))
+	}
+
+	c := HyperLogLogFromBytes(a.Bytes())
+	assert.Equal(t, a.Count(), c.Count())
+
+	c.Merge(b)
+	assert.InDelta(t, 1500, c.Count(), 150)
+}
@@ -7,6 +7,10 @@ type Set[V comparable] struct {
 	m Map[V, struct{}]
 }
 
+func (s *Set[V]) Len() int {
+	return s.m.Len()
+}
+
 func (s *Set[V]) Has(v V) bool {
 	_, ok := s.m.Get(v)
 	return ok
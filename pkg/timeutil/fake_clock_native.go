@@ -0,0 +1,82 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+//go:build !js
+
+package timeutil
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var fakeClock atomic.Pointer[FakeClock]
+
+// InstallFakeClock replaces the time returned by Now with a clock that only
+// moves when AdvanceTo is called. The clock's TickEmitter ticks every ivl.
+func InstallFakeClock(t Time, ivl time.Duration) *FakeClock {
+	c := &FakeClock{
+		now:  t,
+		next: t.Add(ivl),
+		ivl:  ivl,
+		te:   NewManualTickEmitter(ivl),
+	}
+	fakeClock.Store(c)
+	return c
+}
+
+// FakeClock is a manually advanced clock used to make tests and replays
+// deterministic.
+type FakeClock struct {
+	lock sync.Mutex
+	now  Time
+	next Time
+	ivl  time.Duration
+	te   *TickEmitter
+}
+
+// Uninstall restores the system clock.
+func (c *FakeClock) Uninstall() {
+	fakeClock.CompareAndSwap(c, nil)
+}
+
+// Now returns the current fake time.
+func (c *FakeClock) Now() Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.now
+}
+
+// TickEmitter returns the manual emitter driven by the clock.
+func (c *FakeClock) TickEmitter() *TickEmitter {
+	return c.te
+}
+
+// NextTick returns the time of the next tick.
+func (c *FakeClock) NextTick() Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.next
+}
+
+// AdvanceTo moves the clock to t or to the next tick if it comes first. If
+// the clock reaches a tick the emitter ticks and AdvanceTo returns true.
+func (c *FakeClock) AdvanceTo(t Time) bool {
+	c.lock.Lock()
+	if t.Before(c.next) {
+		if t.After(c.now) {
+			c.now = t
+		}
+		c.lock.Unlock()
+		return false
+	}
+
+	c.now = c.next
+	c.next = c.next.Add(c.ivl)
+	now := c.now
+	c.lock.Unlock()
+
+	c.te.Tick(now)
+	return true
+}
@@ -25,16 +25,29 @@ func NewTickEmitter(ivl time.Duration) *TickEmitter {
 	return t
 }
 
+// NewManualTickEmitter creates a TickEmitter that only ticks when Tick is
+// called. Funcs passed to Subscribe are called by Tick before it returns so
+// code driven by the emitter runs in a fixed order.
+func NewManualTickEmitter(ivl time.Duration) *TickEmitter {
+	return &TickEmitter{ivl: ivl}
+}
+
 type TickEmitter struct {
 	lock    sync.Mutex
 	chans   []chan Time
 	ivls    []int64
+	funcs   []*tickFunc
 	i       int64
 	ivl     time.Duration
 	t       *funcTicker
 	logOnce sync.Once
 }
 
+type tickFunc struct {
+	fn  func(t Time)
+	ivl int64
+}
+
 type StopFunc func()
 
 type Ticker struct {
@@ -47,7 +60,23 @@ func (t *Ticker) Stop() {
 }
 
 func (r *TickEmitter) Stop() {
-	r.t.Stop()
+	if r.t != nil {
+		r.t.Stop()
+	}
+}
+
+func (r *TickEmitter) manual() bool {
+	return r.t == nil
+}
+
+// Interval returns the time between ticks.
+func (r *TickEmitter) Interval() time.Duration {
+	return r.ivl
+}
+
+// Tick emits t to subscribers. It is used to drive manual emitters.
+func (r *TickEmitter) Tick(t Time) {
+	r.run(t)
 }
 
 func (r *TickEmitter) DefaultSubscribe(fn func(t Time)) StopFunc {
@@ -55,6 +84,10 @@ func (r *TickEmitter) DefaultSubscribe(fn func(t Time)) StopFunc {
 }
 
 func (r *TickEmitter) Subscribe(ivl time.Duration, fn func(t Time), done func()) StopFunc {
+	if r.manual() {
+		return r.subscribeFunc(ivl, fn, done)
+	}
+
 	ch, stop := r.Chan(ivl)
 	go func() {
 		for t := range ch {
@@ -69,6 +102,26 @@ func (r *TickEmitter) Subscribe(ivl time.Duration, fn func(t Time), done func())
 }
 
 func (r *TickEmitter) SubscribeCtx(ctx context.Context, ivl time.Duration, fn func(t Time), done func()) StopFunc {
+	if r.manual() {
+		stop := r.subscribeFunc(ivl, fn, done)
+		stopped := make(chan struct{})
+		go func() {
+			select {
+			case <-ctx.Done():
+				stop()
+			case <-stopped:
+			}
+		}()
+
+		var stopOnce sync.Once
+		return func() {
+			stopOnce.Do(func() {
+				close(stopped)
+				stop()
+			})
+		}
+	}
+
 	ch, stop := r.Chan(ivl)
 	go func() {
 	TickLoop:
@@ -92,6 +145,39 @@ func (r *TickEmitter) SubscribeCtx(ctx context.Context, ivl time.Duration, fn fu
 	return stop
 }
 
+func (r *TickEmitter) subscribeFunc(ivl time.Duration, fn func(t Time), done func()) StopFunc {
+	f := &tickFunc{fn, r.interval(ivl)}
+
+	r.lock.Lock()
+	r.funcs = append(r.funcs, f)
+	r.lock.Unlock()
+
+	var stopOnce sync.Once
+	return func() {
+		stopOnce.Do(func() {
+			r.lock.Lock()
+			for i := range r.funcs {
+				if r.funcs[i] == f {
+					r.funcs = append(r.funcs[:i], r.funcs[i+1:]...)
+					break
+				}
+			}
+			r.lock.Unlock()
+
+			if done != nil {
+				done()
+			}
+		})
+	}
+}
+
+func (r *TickEmitter) interval(ivl time.Duration) int64 {
+	if ivl > r.ivl {
+		return int64(ivl / r.ivl)
+	}
+	return 1
+}
+
 func (r *TickEmitter) DefaultTicker() Ticker {
 	return r.Ticker(r.ivl)
 }
@@ -116,17 +202,12 @@ func (r *TickEmitter) Chan(ivl time.Duration) (<-chan Time, StopFunc) {
 		})
 	}
 
-	n := int64(1)
-	if ivl > r.ivl {
-		n = int64(ivl / r.ivl)
-	}
-
 	r.lock.Lock()
 	defer r.lock.Unlock()
 	r.chans = append(r.chans, ch)
-	r.ivls = append(r.ivls, n)
+	r.ivls = append(r.ivls, r.interval(ivl))
 
-	if len(r.chans) == 1 {
+	if len(r.chans) == 1 && !r.manual() {
 		r.t.Start()
 	}
 
@@ -213,14 +294,13 @@ func (r *TickEmitter) unsubscribe(ch chan Time) {
 		}
 	}
 
-	if len(r.chans) == 0 {
+	if len(r.chans) == 0 && !r.manual() {
 		r.t.Stop()
 	}
 }
 
 func (r *TickEmitter) run(t Time) {
 	r.lock.Lock()
-	defer r.lock.Unlock()
 
 	r.i++
 	for i, ivl := range r.ivls {
@@ -231,4 +311,17 @@ func (r *TickEmitter) run(t Time) {
 			}
 		}
 	}
+
+	var fns []func(t Time)
+	for _, f := range r.funcs {
+		if r.i%f.ivl == 0 {
+			fns = append(fns, f.fn)
+		}
+	}
+
+	r.lock.Unlock()
+
+	for _, fn := range fns {
+		fn(t)
+	}
 }
@@ -11,5 +11,8 @@ import (
 
 // Now ...
 func Now() Time {
+	if c := fakeClock.Load(); c != nil {
+		return c.Now()
+	}
 	return NewFromTime(time.Now()).Truncate(Precision)
 }
@@ -32,6 +32,15 @@ import (
 const reservedPortCount uint16 = 1000
 const hostCertValidDuration = time.Hour
 
+// host ids are bound to S/Kademlia style crypto puzzles. the static puzzle is
+// solved by generating host keys until the id's hash has enough leading zero
+// bits and can be verified for ids learned indirectly. the dynamic puzzle is
+// solved for each id and proven with a nonce when links are opened.
+const (
+	hostIDStaticPuzzleDifficulty = 10
+	hostIDPuzzleDifficulty       = 20
+)
+
 // default network service ports
 const (
 	HashTablePort uint16 = iota + 10
@@ -39,6 +48,9 @@ const (
 	PeerExchangePort
 	TransferPort
 	SnippetPort
+	GossipPort
+	OnionPort
+	RouteProbePort
 )
 
 // peer link ports
@@ -95,18 +107,25 @@ func WithRateLimit(limit uint64) HostOption {
 	}
 }
 
-// New ...
-func New(logger *zap.Logger, profileKey *key.Key, options ...HostOption) (*Host, error) {
-	hostKey, err := dao.GenerateKey()
-	if err != nil {
-		return nil, err
+// WithIDPuzzleDifficulty sets the number of leading zero bits required by the
+// static and dynamic host id puzzles. hosts only accept peers that solved
+// puzzles at least as difficult as their own.
+func WithIDPuzzleDifficulty(static, dynamic int) HostOption {
+	return func(h *Host) error {
+		h.idStaticDifficulty = static
+		h.idDifficulty = dynamic
+		return nil
 	}
+}
 
+// New ...
+func New(logger *zap.Logger, profileKey *key.Key, options ...HostOption) (*Host, error) {
 	h := &Host{
-		logger:     logger,
-		profileKey: profileKey,
-		key:        hostKey,
-		qos:        qos.New(),
+		logger:             logger,
+		profileKey:         profileKey,
+		idStaticDifficulty: hostIDStaticPuzzleDifficulty,
+		idDifficulty:       hostIDPuzzleDifficulty,
+		qos:                qos.New(),
 	}
 
 	for _, o := range options {
@@ -115,6 +134,13 @@ func New(logger *zap.Logger, profileKey *key.Key, options ...HostOption) (*Host,
 		}
 	}
 
+	hostKey, hostID, err := generateHostKey(h.idStaticDifficulty)
+	if err != nil {
+		return nil, err
+	}
+	h.key = hostKey
+	h.idNonce = kademlia.SolvePuzzle(hostID, h.idDifficulty)
+
 	for _, iface := range h.interfaces {
 		if listener, ok := iface.(Listener); ok {
 			go func() {
@@ -128,18 +154,38 @@ func New(logger *zap.Logger, profileKey *key.Key, options ...HostOption) (*Host,
 	return h, nil
 }
 
+// generateHostKey generates keys until one solves the static host id puzzle.
+func generateHostKey(difficulty int) (*key.Key, kademlia.ID, error) {
+	for {
+		k, err := dao.GenerateKey()
+		if err != nil {
+			return nil, kademlia.ID{}, err
+		}
+		id, err := kademlia.UnmarshalID(k.Public)
+		if err != nil {
+			return nil, kademlia.ID{}, err
+		}
+		if kademlia.VerifyStaticPuzzle(id, difficulty) {
+			return k, id, nil
+		}
+	}
+}
+
 // Host ...
 type Host struct {
-	logger           *zap.Logger
-	profileKey       *key.Key
-	key              *key.Key
-	label            string
-	interfaces       []Interface
-	peerHandlersLock sync.Mutex
-	peerHandlers     []PeerHandler
-	peers            syncutil.Map[kademlia.ID, *Peer]
-	maxPeers         atomic.Int64
-	qos              *qos.Control
+	logger             *zap.Logger
+	profileKey         *key.Key
+	key                *key.Key
+	idNonce            uint64
+	idStaticDifficulty int
+	idDifficulty       int
+	label              string
+	interfaces         []Interface
+	peerHandlersLock   sync.Mutex
+	peerHandlers       []PeerHandler
+	peers              syncutil.Map[kademlia.ID, *Peer]
+	maxPeers           atomic.Int64
+	qos                *qos.Control
 }
 
 // Close ...
@@ -178,6 +224,25 @@ func (h *Host) ID() kademlia.ID {
 	return errutil.Must(kademlia.UnmarshalID(h.key.Public))
 }
 
+// VerifyHostID returns true if id solves the static host id puzzle. ids of
+// hosts that are not linked directly can only be checked this way.
+func (h *Host) VerifyHostID(id kademlia.ID) bool {
+	return kademlia.VerifyStaticPuzzle(id, h.idStaticDifficulty)
+}
+
+// LANURIs returns the uris of the host's local network listeners.
+func (h *Host) LANURIs() []string {
+	var uris []string
+	for _, i := range h.interfaces {
+		if l, ok := i.(LANListener); ok {
+			if uri, ok := l.LANURI(); ok {
+				uris = append(uris, uri)
+			}
+		}
+	}
+	return uris
+}
+
 // Key ...
 func (h *Host) Key() *key.Key {
 	return h.key
@@ -223,7 +288,7 @@ func (h *Host) addLink(logger *zap.Logger, c Link) (*Peer, error) {
 		return nil, &PeerInitError{err}
 	}
 
-	p, err := newPeer(logger, c, h.profileKey, cert)
+	p, err := newPeer(logger, c, h, cert)
 	if err != nil {
 		return nil, &PeerInitError{err}
 	}
@@ -372,6 +437,13 @@ type Interface interface {
 	CreateLinkCandidate(ctx context.Context, h *Host) (LinkCandidate, error)
 }
 
+// LANListener is implemented by interfaces that accept connections from hosts
+// on the local network. The host in the returned uri may be unspecified if the
+// listener is bound to all addresses.
+type LANListener interface {
+	LANURI() (string, bool)
+}
+
 type LinkDialer interface {
 	ValidScheme(scheme string) bool
 	Dial(addr string) (Link, error)
@@ -8,12 +8,13 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"net"
+	"net/netip"
 	"sync"
 	"sync/atomic"
 
 	"github.com/MemeLabs/strims/internal/dao"
 	"github.com/MemeLabs/strims/pkg/apis/type/certificate"
-	"github.com/MemeLabs/strims/pkg/apis/type/key"
 	vnicv1 "github.com/MemeLabs/strims/pkg/apis/vnic/v1"
 	"github.com/MemeLabs/strims/pkg/kademlia"
 	"github.com/MemeLabs/strims/pkg/logutil"
@@ -45,12 +46,20 @@ var (
 	})
 )
 
-func newPeer(logger *zap.Logger, link Link, hostKey *key.Key, hostCert *certificate.Certificate) (*Peer, error) {
+// peer init handshake versions. peers older than peerIDPuzzleProtocolVersion
+// don't prove their host ids with puzzle solutions.
+const (
+	peerProtocolVersion         = 2
+	peerIDPuzzleProtocolVersion = 2
+)
+
+func newPeer(logger *zap.Logger, link Link, host *Host, hostCert *certificate.Certificate) (*Peer, error) {
 	err := protoutil.WriteStream(link, &vnicv1.PeerInit{
-		ProtocolVersion: 1,
+		ProtocolVersion: peerProtocolVersion,
 		Certificate:     hostCert,
 		NodePlatform:    version.Platform,
 		NodeVersion:     version.Version,
+		IdNonce:         host.idNonce,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("writing peer init: %w", err)
@@ -75,6 +84,15 @@ func newPeer(logger *zap.Logger, link Link, hostKey *key.Key, hostCert *certific
 		return nil, fmt.Errorf("peer host id malformed: %w", err)
 	}
 
+	// legacy peers are accepted in a degraded mode. they can't displace
+	// verified peers in routing tables and their ids aren't propagated.
+	idVerified := init.ProtocolVersion >= peerIDPuzzleProtocolVersion
+	if idVerified {
+		if !host.VerifyHostID(hostID) || !kademlia.VerifyPuzzle(hostID, init.IdNonce, host.idDifficulty) {
+			return nil, errors.New("peer host id puzzle verification failed")
+		}
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 
 	p := &Peer{
@@ -88,6 +106,8 @@ func newPeer(logger *zap.Logger, link Link, hostKey *key.Key, hostCert *certific
 		NodePlatform:    init.NodePlatform,
 		NodeVersion:     init.NodeVersion,
 		hostID:          hostID,
+		idVerified:      idVerified,
+		remoteAddr:      linkRemoteAddr(link),
 		handlers:        map[uint16]FrameHandler{},
 		reservations:    map[uint16]struct{}{},
 		channels:        map[uint16]*FrameReadWriter{},
@@ -97,6 +117,24 @@ func newPeer(logger *zap.Logger, link Link, hostKey *key.Key, hostCert *certific
 	return p, nil
 }
 
+func linkRemoteAddr(l Link) netip.Addr {
+	for {
+		switch v := l.(type) {
+		case *aesLink:
+			l = v.Link
+		case *instrumentedLink:
+			l = v.Link
+		case interface{ RemoteAddr() net.Addr }:
+			if ap, err := netip.ParseAddrPort(v.RemoteAddr().String()); err == nil {
+				return ap.Addr()
+			}
+			return netip.Addr{}
+		default:
+			return netip.Addr{}
+		}
+	}
+}
+
 // Peer ...
 type Peer struct {
 	logger           *zap.Logger
@@ -106,6 +144,8 @@ type Peer struct {
 	NodePlatform     string
 	NodeVersion      string
 	hostID           kademlia.ID
+	idVerified       bool
+	remoteAddr       netip.Addr
 	handlersLock     sync.Mutex
 	handlers         map[uint16]FrameHandler
 	reservationsLock sync.Mutex
@@ -187,6 +227,18 @@ func (p *Peer) HostID() kademlia.ID {
 	return p.hostID
 }
 
+// IDVerified returns false for legacy peers that did not prove their host id
+// with puzzle solutions.
+func (p *Peer) IDVerified() bool {
+	return p.idVerified
+}
+
+// RemoteAddr returns the ip address of the remote end of the link if it is
+// known.
+func (p *Peer) RemoteAddr() netip.Addr {
+	return p.remoteAddr
+}
+
 // SetHandler ...
 func (p *Peer) SetHandler(port uint16, h FrameHandler) {
 	p.handlersLock.Lock()
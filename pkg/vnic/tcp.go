@@ -32,6 +32,7 @@ func init() { RegisterLinkInterface("tcp", (*tcpLinkCandidate)(nil)) }
 var _ Interface = (*tcpInterface)(nil)
 var _ LinkDialer = (*tcpInterface)(nil)
 var _ LinkCandidate = (*tcpLinkCandidate)(nil)
+var _ LANListener = (*tcpInterface)(nil)
 
 type TCPInterfaceOptions struct {
 	Address         string
@@ -132,6 +133,25 @@ func (f *tcpInterface) formatURI() (string, error) {
 	return u.String(), nil
 }
 
+// LANURI ...
+func (f *tcpInterface) LANURI() (string, bool) {
+	if f.options.Mux == nil {
+		return "", false
+	}
+
+	host, port, err := net.SplitHostPort(f.options.Address)
+	if err != nil {
+		return "", false
+	}
+
+	u := url.URL{
+		Scheme: "tcp",
+		Host:   net.JoinHostPort(host, port),
+		Path:   fmt.Sprintf("/%x", f.peerKey),
+	}
+	return u.String(), true
+}
+
 func (f *tcpInterface) Close() error {
 	if f.options.Mux != nil {
 		f.options.Mux.StopHandling(f.peerKey)
@@ -0,0 +1,418 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+//go:build !js
+
+package vnic
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/MemeLabs/strims/pkg/apis/type/key"
+	vnicv1 "github.com/MemeLabs/strims/pkg/apis/vnic/v1"
+	"github.com/MemeLabs/strims/pkg/ed25519util"
+	"github.com/MemeLabs/strims/pkg/options"
+	"github.com/MemeLabs/strims/pkg/protoutil"
+	"github.com/MemeLabs/strims/pkg/syncutil"
+	"github.com/pion/sctp"
+	"github.com/pion/udp"
+	"go.uber.org/zap"
+)
+
+func init() { RegisterLinkInterface("udp", (*udpLinkCandidate)(nil)) }
+
+var _ Interface = (*udpInterface)(nil)
+var _ LinkDialer = (*udpInterface)(nil)
+var _ LinkCandidate = (*udpLinkCandidate)(nil)
+
+const (
+	udpMTU              = 64 * 1024
+	udpHandshakeTimeout = 10 * time.Second
+	udpCloseTimeout     = time.Second
+)
+
+var errUDPHandshakeTimeout = errors.New("udp handshake timeout")
+
+type UDPInterfaceOptions struct {
+	Address     string
+	HostIP      string
+	Mux         *UDPMux
+	ReadTimeout time.Duration
+}
+
+var DefaultUDPInterfaceOptions = UDPInterfaceOptions{
+	ReadTimeout: 25 * time.Second,
+}
+
+// NewUDPInterface creates an interface for links carried by sctp over udp.
+// sctp provides the reliable ordered delivery and congestion control that
+// tcp links get from the kernel.
+func NewUDPInterface(logger *zap.Logger, o UDPInterfaceOptions) Interface {
+	o = options.AssignDefaults(o, DefaultUDPInterfaceOptions)
+
+	return &udpInterface{
+		logger:  logger,
+		options: o,
+		ready:   make(chan struct{}),
+	}
+}
+
+type udpInterface struct {
+	logger  *zap.Logger
+	options UDPInterfaceOptions
+	ready   chan struct{}
+	key     *key.Key
+	peerKey []byte
+	uri     string
+}
+
+func (f *udpInterface) ValidScheme(scheme string) bool {
+	return scheme == "udp"
+}
+
+// Listen is called by the host in a separate goroutine. Dial and
+// LocalDescription wait for ready to read the fields it sets.
+func (f *udpInterface) Listen(h *Host) error {
+	defer close(f.ready)
+
+	f.key = ed25519util.KeyToCurve25519(h.profileKey)
+
+	if f.options.Mux == nil {
+		return nil
+	}
+
+	f.peerKey = h.profileKey.Public
+	if u, err := f.formatURI(); err != nil {
+		f.logger.Debug("failed to format udp uri", zap.Error(err))
+	} else {
+		f.uri = u
+	}
+
+	f.logger.Debug("udp vnic listener starting", zap.String("uri", f.uri))
+	f.options.Mux.Handle(f.peerKey, UDPConnHandlerFunc(func(c *UDPConn) error {
+		c.ReadTimeout = f.options.ReadTimeout
+
+		l, err := handshakeAESLink(c, f.key, nil)
+		if err != nil {
+			return err
+		}
+
+		h.AddLink(l)
+		return nil
+	}))
+	return nil
+}
+
+func (f *udpInterface) formatURI() (string, error) {
+	ap, err := netip.ParseAddrPort(f.options.Address)
+	if err != nil {
+		return "", err
+	}
+
+	if f.options.HostIP != "" {
+		a, err := netip.ParseAddr(f.options.HostIP)
+		if err != nil {
+			return "", err
+		}
+		ap = netip.AddrPortFrom(a, ap.Port())
+	}
+
+	if ap.Addr().IsUnspecified() || !ap.Addr().IsValid() {
+		return "", fmt.Errorf("invalid ip: %s", ap.Addr())
+	}
+
+	u := url.URL{
+		Scheme: "udp",
+		Host:   ap.String(),
+		Path:   fmt.Sprintf("/%x", f.peerKey),
+	}
+	return u.String(), nil
+}
+
+func (f *udpInterface) Close() error {
+	<-f.ready
+	if f.options.Mux != nil {
+		f.options.Mux.StopHandling(f.peerKey)
+	}
+	return nil
+}
+
+func (f *udpInterface) Dial(uri string) (Link, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	peerKey, err := hex.DecodeString(strings.TrimLeft(u.Path, "/"))
+	if err != nil {
+		return nil, err
+	}
+	if len(peerKey) != 32 {
+		return nil, errors.New("invalid peer key size")
+	}
+
+	a, err := net.ResolveUDPAddr("udp", u.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := DialUDPMux(f.logger, a, peerKey)
+	if err != nil {
+		return nil, err
+	}
+	c.ReadTimeout = f.options.ReadTimeout
+
+	<-f.ready
+
+	var peerCurve25519Key [32]byte
+	ed25519util.PublicKeyToCurve25519(&peerCurve25519Key, (*[32]byte)(peerKey))
+	return handshakeAESLink(c, f.key, peerCurve25519Key[:])
+}
+
+func (f *udpInterface) CreateLinkCandidate(ctx context.Context, h *Host) (LinkCandidate, error) {
+	return &udpLinkCandidate{f, h}, nil
+}
+
+type udpLinkCandidate struct {
+	iface *udpInterface
+	host  *Host
+}
+
+func (f *udpLinkCandidate) LocalDescription() (*vnicv1.LinkDescription, error) {
+	<-f.iface.ready
+	if f.iface.uri == "" {
+		return nil, nil
+	}
+
+	d := &vnicv1.LinkDescription{
+		Interface:   "udp",
+		Description: f.iface.uri,
+	}
+	return d, nil
+}
+
+func (f *udpLinkCandidate) SetRemoteDescription(d *vnicv1.LinkDescription) (bool, error) {
+	_, err := f.host.Dial(d.Description)
+	return err == nil, err
+}
+
+func NewUDPMux(logger *zap.Logger, addr string) (*UDPMux, net.Listener, error) {
+	m := &UDPMux{logger: logger}
+	l, err := m.Listen(addr)
+	if err != nil {
+		return nil, nil, err
+	}
+	return m, l, nil
+}
+
+// UDPMux accepts sctp associations on a shared udp socket and dispatches them
+// to handlers by the peer key the remote sends in its UDPMuxInit.
+type UDPMux struct {
+	logger   *zap.Logger
+	handlers syncutil.Map[[32]byte, UDPConnHandler]
+}
+
+func (m *UDPMux) Handle(k []byte, h UDPConnHandler) {
+	m.handlers.Set(*(*[32]byte)(k), h)
+}
+
+func (m *UDPMux) StopHandling(k []byte) {
+	m.handlers.Delete(*(*[32]byte)(k))
+}
+
+func (m *UDPMux) Listen(addr string) (net.Listener, error) {
+	a, err := net.ResolveUDPAddr("udp", addr)
+	if err != nil {
+		return nil, err
+	}
+	l, err := udp.Listen("udp", a)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				m.logger.Debug("udp listener closed with error", zap.Error(err))
+				return
+			}
+
+			go func() {
+				if err := m.handleConn(udpMuxConn{c}); err != nil {
+					m.logger.Debug("mux connection handler failed", zap.Error(err))
+				}
+			}()
+		}
+	}()
+
+	return l, nil
+}
+
+func (m *UDPMux) handleConn(nc net.Conn) error {
+	var init vnicv1.UDPMuxInit
+	c, err := newUDPConn(m.logger, nc, false, func(c *UDPConn) error {
+		if err := protoutil.ReadStream(c.r, &init); err != nil {
+			return fmt.Errorf("reading peer init failed: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(init.PeerKey) != 32 {
+		c.Close()
+		return errors.New("invalid peer key size")
+	}
+	h, ok := m.handlers.Get(*(*[32]byte)(init.PeerKey))
+	if !ok {
+		c.Close()
+		return errors.New("peer key not found")
+	}
+
+	if err := h.HandleConn(c); err != nil {
+		c.Close()
+		return err
+	}
+	return nil
+}
+
+func DialUDPMux(logger *zap.Logger, a *net.UDPAddr, peerKey []byte) (*UDPConn, error) {
+	nc, err := net.DialUDP("udp", nil, a)
+	if err != nil {
+		return nil, err
+	}
+
+	return newUDPConn(logger, nc, true, func(c *UDPConn) error {
+		err := protoutil.WriteStream(c.s, &vnicv1.UDPMuxInit{
+			ProtocolVersion: 1,
+			PeerKey:         peerKey,
+		})
+		if err != nil {
+			return fmt.Errorf("writing udp mux init failed: %w", err)
+		}
+		return nil
+	})
+}
+
+// newUDPConn establishes an sctp association over nc and opens or accepts its
+// stream. init runs before the handshake deadline expires. nc is closed if
+// any step fails.
+func newUDPConn(logger *zap.Logger, nc net.Conn, client bool, init func(c *UDPConn) error) (*UDPConn, error) {
+	timer := time.AfterFunc(udpHandshakeTimeout, func() { nc.Close() })
+
+	c := &UDPConn{}
+	err := func() (err error) {
+		cfg := sctp.Config{
+			NetConn:       nc,
+			LoggerFactory: &pionLoggerFactory{logger},
+		}
+		if client {
+			if c.a, err = sctp.Client(cfg); err != nil {
+				return err
+			}
+			c.s, err = c.a.OpenStream(0, sctp.PayloadTypeWebRTCBinary)
+		} else {
+			if c.a, err = sctp.Server(cfg); err != nil {
+				return err
+			}
+			c.s, err = c.a.AcceptStream()
+		}
+		if err != nil {
+			return err
+		}
+		c.r = bufio.NewReaderSize(c.s, udpMTU)
+
+		return init(c)
+	}()
+
+	if !timer.Stop() && err == nil {
+		err = errUDPHandshakeTimeout
+	}
+	if err != nil {
+		if c.a != nil {
+			c.a.Close()
+		} else {
+			nc.Close()
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+type UDPConnHandler interface {
+	HandleConn(c *UDPConn) error
+}
+
+type UDPConnHandlerFunc func(*UDPConn) error
+
+func (f UDPConnHandlerFunc) HandleConn(c *UDPConn) error {
+	return f(c)
+}
+
+// UDPConn is a Link backed by a single sctp stream.
+type UDPConn struct {
+	ReadTimeout time.Duration
+	a           *sctp.Association
+	s           *sctp.Stream
+	r           *bufio.Reader
+}
+
+func (c *UDPConn) Read(b []byte) (int, error) {
+	if c.ReadTimeout != 0 {
+		if err := c.s.SetReadDeadline(time.Now().Add(c.ReadTimeout)); err != nil {
+			return 0, err
+		}
+	}
+
+	return c.r.Read(b)
+}
+
+func (c *UDPConn) Write(b []byte) (int, error) {
+	return c.s.Write(b)
+}
+
+// Close aborts the association so the peer learns the link is closed without
+// waiting for its read deadline. if the abort cannot be sent the association
+// is closed locally.
+func (c *UDPConn) Close() error {
+	done := make(chan struct{})
+	go func() {
+		c.a.Abort("link closed")
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-time.After(udpCloseTimeout):
+		return c.a.Close()
+	}
+}
+
+func (c *UDPConn) MTU() int {
+	return udpMTU
+}
+
+// udpMuxConn unblocks pending reads when closed. conns accepted from pion/udp
+// listeners only release their buffers when the listener closes which would
+// leave sctp read loops running after their associations close.
+type udpMuxConn struct {
+	net.Conn
+}
+
+func (c udpMuxConn) Close() error {
+	err := c.Conn.Close()
+	c.Conn.SetReadDeadline(time.Unix(0, 1))
+	return err
+}
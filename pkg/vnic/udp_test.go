@@ -0,0 +1,76 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+//go:build !js
+
+package vnic
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MemeLabs/strims/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestUDPInterfaceDial(t *testing.T) {
+	logger := zap.NewNop()
+
+	mux, lis, err := NewUDPMux(logger, "127.0.0.1:0")
+	require.NoError(t, err)
+	defer lis.Close()
+
+	newHost := func(o UDPInterfaceOptions) *Host {
+		key, err := dao.GenerateKey()
+		require.NoError(t, err)
+		h, err := New(logger, key, WithIDPuzzleDifficulty(2, 2), WithInterface(NewUDPInterface(logger, o)))
+		require.NoError(t, err)
+		t.Cleanup(h.Close)
+		return h
+	}
+
+	server := newHost(UDPInterfaceOptions{
+		Address: lis.Addr().String(),
+		Mux:     mux,
+	})
+	client := newHost(UDPInterfaceOptions{})
+
+	serverPeers := make(chan *Peer, 1)
+	server.AddPeerHandler(PeerHandlerFunc(func(p *Peer) { serverPeers <- p }))
+
+	assert.Eventually(t, func() bool {
+		_, ok := mux.handlers.Get(*(*[32]byte)(server.profileKey.Public))
+		return ok
+	}, time.Second, 10*time.Millisecond, "expected the server to register its mux handler")
+
+	peer, err := client.Dial(fmt.Sprintf("udp://%s/%x", lis.Addr(), server.profileKey.Public))
+	require.NoError(t, err)
+	assert.Equal(t, server.ID(), peer.HostID())
+
+	select {
+	case p := <-serverPeers:
+		assert.Equal(t, client.ID(), p.HostID())
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for the server peer")
+	}
+}
+
+func TestUDPMuxRejectsUnknownPeerKey(t *testing.T) {
+	logger := zap.NewNop()
+
+	_, lis, err := NewUDPMux(logger, "127.0.0.1:0")
+	require.NoError(t, err)
+	defer lis.Close()
+
+	key, err := dao.GenerateKey()
+	require.NoError(t, err)
+	h, err := New(logger, key, WithIDPuzzleDifficulty(2, 2), WithInterface(NewUDPInterface(logger, UDPInterfaceOptions{})))
+	require.NoError(t, err)
+	defer h.Close()
+
+	_, err = h.Dial(fmt.Sprintf("udp://%s/%x", lis.Addr(), key.Public))
+	assert.Error(t, err)
+}
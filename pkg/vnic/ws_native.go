@@ -26,6 +26,7 @@ func init() { RegisterLinkInterface("ws", (*wsLinkCandidate)(nil)) }
 var _ Interface = (*wsInterface)(nil)
 var _ LinkDialer = (*wsInterface)(nil)
 var _ LinkCandidate = (*wsLinkCandidate)(nil)
+var _ LANListener = (*wsInterface)(nil)
 
 type WSInterfaceOptions struct {
 	ServeMux       *httputil.MapServeMux
@@ -105,6 +106,31 @@ func (f *wsInterface) formatURI() (string, error) {
 	return u.String(), nil
 }
 
+// LANURI ...
+func (f *wsInterface) LANURI() (string, bool) {
+	if f.options.ServeMux == nil {
+		return "", false
+	}
+
+	host, port, err := net.SplitHostPort(f.options.Address)
+	if err != nil {
+		return "", false
+	}
+
+	u := &url.URL{
+		Scheme: "ws",
+		Host:   net.JoinHostPort(host, port),
+		Path:   f.path,
+	}
+	if f.options.Secure {
+		// lan peers are dialed by ip so the certificate will not match. links
+		// are authenticated during peer init.
+		u.Scheme = "wss"
+		u.Fragment = "insecure"
+	}
+	return u.String(), true
+}
+
 func (f *wsInterface) Close() error {
 	if f.options.ServeMux != nil {
 		f.options.ServeMux.StopHandling(f.path)
@@ -0,0 +1,723 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package vpn
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"math/rand"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/MemeLabs/strims/internal/dao"
+	vpnv1 "github.com/MemeLabs/strims/pkg/apis/vpn/v1"
+	"github.com/MemeLabs/strims/pkg/kademlia"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+	"github.com/MemeLabs/strims/pkg/vnic"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	gossipMeshSize          = 6
+	gossipMeshSizeLow       = 4
+	gossipMeshSizeHigh      = 12
+	gossipLazySize          = 6
+	gossipHeartbeatInterval = time.Second
+	gossipHistoryLength     = 5
+	gossipHistoryGossip     = 3
+	gossipSeenSize          = 4096
+	gossipSeenTTL           = 2 * time.Minute
+	gossipMaxMessageAge     = time.Minute
+	gossipMaxDataSize       = 16 * 1024
+	gossipSubscriberBuffer  = 32
+)
+
+// peer scoring parameters. scores decay each heartbeat so peers recover from
+// transient failures. peers below the graylist threshold are ignored and peers
+// with negative scores are pruned from topic meshes.
+const (
+	gossipScoreDecay          = 0.9
+	gossipFirstDeliveryCap    = 20
+	gossipInvalidMessageScore = -10
+	gossipGraylistThreshold   = -100
+)
+
+// errors ...
+var (
+	ErrGossipDataTooLarge = errors.New("gossip message data too large")
+)
+
+// Gossip is a topic based pub/sub layer. Messages are signed by the publishing
+// host and relayed through a per-topic mesh of directly linked peers.
+type Gossip interface {
+	Publish(topic, data []byte) error
+	Subscribe(ctx context.Context, topic []byte) (<-chan *GossipMessage, error)
+}
+
+// GossipMessage ...
+type GossipMessage struct {
+	Topic []byte
+	Data  []byte
+	Key   []byte
+	Seq   uint64
+	Time  timeutil.Time
+}
+
+func newGossip(logger *zap.Logger, network *Network) *gossip {
+	g := &gossip{
+		logger:   logger,
+		network:  network,
+		seen:     newMessageIDLRU(gossipSeenSize, gossipSeenTTL),
+		topics:   map[string]*gossipTopic{},
+		peers:    map[kademlia.ID]*gossipPeer{},
+		messages: map[MessageID]*vpnv1.GossipMessage_Record{},
+		// seed the sequence from the clock so messages published after a restart
+		// aren't mistaken by peers for ones they've already seen
+		seq: uint64(timeutil.Now().UnixNano()),
+	}
+	g.stopHeartbeat = timeutil.DefaultTickEmitter.Subscribe(gossipHeartbeatInterval, g.heartbeat, nil)
+	return g
+}
+
+type gossip struct {
+	logger        *zap.Logger
+	network       *Network
+	stopHeartbeat timeutil.StopFunc
+	seq           uint64
+	seen          *messageIDLRU
+
+	lock     sync.Mutex
+	topics   map[string]*gossipTopic
+	peers    map[kademlia.ID]*gossipPeer
+	history  [gossipHistoryLength][]MessageID
+	messages map[MessageID]*vpnv1.GossipMessage_Record
+}
+
+type gossipTopic struct {
+	subscribers []chan *GossipMessage
+	mesh        map[kademlia.ID]*gossipPeer
+}
+
+func newGossipPeer(id kademlia.ID) *gossipPeer {
+	return &gossipPeer{
+		id:     id,
+		topics: map[string]struct{}{},
+		scores: map[string]*gossipScore{},
+	}
+}
+
+type gossipPeer struct {
+	id     kademlia.ID
+	topics map[string]struct{}
+	scores map[string]*gossipScore
+}
+
+func (p *gossipPeer) Subscribed(topic string) bool {
+	_, ok := p.topics[topic]
+	return ok
+}
+
+func (p *gossipPeer) TopicScore(topic string) *gossipScore {
+	s, ok := p.scores[topic]
+	if !ok {
+		s = &gossipScore{}
+		p.scores[topic] = s
+	}
+	return s
+}
+
+func (p *gossipPeer) Score() float64 {
+	var v float64
+	for _, s := range p.scores {
+		v += s.Value()
+	}
+	return v
+}
+
+type gossipScore struct {
+	firstDeliveries float64
+	invalidMessages float64
+}
+
+func (s *gossipScore) Value() float64 {
+	d := s.firstDeliveries
+	if d > gossipFirstDeliveryCap {
+		d = gossipFirstDeliveryCap
+	}
+	return d + gossipInvalidMessageScore*s.invalidMessages*s.invalidMessages
+}
+
+func (s *gossipScore) Decay() {
+	s.firstDeliveries *= gossipScoreDecay
+	s.invalidMessages *= gossipScoreDecay
+}
+
+// gossipMessageID identifies records by publisher and sequence number so
+// replays with modified payloads are treated as duplicates.
+func gossipMessageID(r *vpnv1.GossipMessage_Record) (id MessageID) {
+	h := sha256.New()
+	h.Write(r.Key)
+	binary.Write(h, binary.BigEndian, r.Seq)
+	h.Write(r.Topic)
+	copy(id[:], h.Sum(nil))
+	return
+}
+
+func newGossipMessage(r *vpnv1.GossipMessage_Record) *GossipMessage {
+	return &GossipMessage{
+		Topic: r.Topic,
+		Data:  r.Data,
+		Key:   r.Key,
+		Seq:   r.Seq,
+		Time:  timeutil.Unix(0, r.Timestamp),
+	}
+}
+
+type gossipSend struct {
+	id  kademlia.ID
+	msg *vpnv1.GossipMessage
+}
+
+func (g *gossip) send(sends []gossipSend) {
+	for _, s := range sends {
+		err := g.network.SendProtoWithFlags(s.id, vnic.GossipPort, vnic.GossipPort, s.msg, MstdFlags|Mnorelay)
+		if err != nil {
+			g.logger.Debug("sending gossip message failed", zap.Stringer("peer", s.id), zap.Error(err))
+		}
+	}
+}
+
+// Close ...
+func (g *gossip) Close() {
+	g.stopHeartbeat()
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	for k, t := range g.topics {
+		for _, ch := range t.subscribers {
+			close(ch)
+		}
+		delete(g.topics, k)
+	}
+}
+
+// HandleMessage ...
+func (g *gossip) HandleMessage(msg *Message) error {
+	// gossip is exchanged only between directly linked peers
+	if msg.Trailer.Hops != 1 || !g.network.HasPeer(msg.SrcHostID()) {
+		return nil
+	}
+
+	var m vpnv1.GossipMessage
+	if err := proto.Unmarshal(msg.Body, &m); err != nil {
+		return err
+	}
+
+	g.lock.Lock()
+	p := g.upsertPeer(msg.SrcHostID())
+	if p.Score() < gossipGraylistThreshold {
+		g.lock.Unlock()
+		return nil
+	}
+
+	var sends []gossipSend
+	var err error
+	switch b := m.Body.(type) {
+	case *vpnv1.GossipMessage_Subscribe_:
+		g.handleSubscribe(p, b.Subscribe)
+	case *vpnv1.GossipMessage_Unsubscribe_:
+		g.handleUnsubscribe(p, b.Unsubscribe)
+	case *vpnv1.GossipMessage_Publish_:
+		sends, err = g.handlePublish(p, b.Publish.Record)
+	case *vpnv1.GossipMessage_Ihave:
+		sends = g.handleIHave(p, b.Ihave)
+	case *vpnv1.GossipMessage_Iwant:
+		sends = g.handleIWant(p, b.Iwant)
+	case *vpnv1.GossipMessage_Graft_:
+		sends = g.handleGraft(p, b.Graft)
+	case *vpnv1.GossipMessage_Prune_:
+		g.handlePrune(p, b.Prune)
+	default:
+		err = errors.New("unexpected message type")
+	}
+	g.lock.Unlock()
+
+	g.send(sends)
+	return err
+}
+
+func (g *gossip) upsertPeer(id kademlia.ID) *gossipPeer {
+	p, ok := g.peers[id]
+	if !ok {
+		p = newGossipPeer(id)
+		g.peers[id] = p
+	}
+	return p
+}
+
+func (g *gossip) removePeer(p *gossipPeer) {
+	for _, t := range g.topics {
+		delete(t.mesh, p.id)
+	}
+	delete(g.peers, p.id)
+}
+
+func (g *gossip) handleSubscribe(p *gossipPeer, m *vpnv1.GossipMessage_Subscribe) {
+	for _, t := range m.Topics {
+		p.topics[string(t)] = struct{}{}
+	}
+}
+
+func (g *gossip) handleUnsubscribe(p *gossipPeer, m *vpnv1.GossipMessage_Unsubscribe) {
+	for _, t := range m.Topics {
+		delete(p.topics, string(t))
+		if t, ok := g.topics[string(t)]; ok {
+			delete(t.mesh, p.id)
+		}
+	}
+}
+
+func (g *gossip) handlePublish(p *gossipPeer, r *vpnv1.GossipMessage_Record) ([]gossipSend, error) {
+	score := p.TopicScore(string(r.GetTopic()))
+
+	if err := g.validateRecord(r); err != nil {
+		score.invalidMessages++
+		return nil, err
+	}
+
+	id := gossipMessageID(r)
+	if !g.seen.Insert(id) {
+		return nil, nil
+	}
+	score.firstDeliveries++
+
+	g.storeRecord(id, r)
+
+	t, ok := g.topics[string(r.Topic)]
+	if !ok {
+		return nil, nil
+	}
+
+	m := newGossipMessage(r)
+	for _, ch := range t.subscribers {
+		select {
+		case ch <- m:
+		default:
+		}
+	}
+
+	return g.forwardRecord(t.mesh, r, p.id), nil
+}
+
+func (g *gossip) validateRecord(r *vpnv1.GossipMessage_Record) error {
+	if r == nil {
+		return errors.New("empty record")
+	}
+	if len(r.Data) > gossipMaxDataSize {
+		return ErrGossipDataTooLarge
+	}
+	if age := timeutil.Since(timeutil.Unix(0, r.Timestamp)); age > gossipMaxMessageAge || age < -gossipMaxMessageAge {
+		return errors.New("record timestamp out of range")
+	}
+	return dao.VerifyMessage(r)
+}
+
+func (g *gossip) storeRecord(id MessageID, r *vpnv1.GossipMessage_Record) {
+	g.messages[id] = r
+	g.history[0] = append(g.history[0], id)
+}
+
+func (g *gossip) forwardRecord(peers map[kademlia.ID]*gossipPeer, r *vpnv1.GossipMessage_Record, src kademlia.ID) []gossipSend {
+	msg := &vpnv1.GossipMessage{
+		Body: &vpnv1.GossipMessage_Publish_{
+			Publish: &vpnv1.GossipMessage_Publish{
+				Record: r,
+			},
+		},
+	}
+
+	sends := make([]gossipSend, 0, len(peers))
+	for id := range peers {
+		if id != src {
+			sends = append(sends, gossipSend{id, msg})
+		}
+	}
+	return sends
+}
+
+func (g *gossip) handleIHave(p *gossipPeer, m *vpnv1.GossipMessage_IHave) []gossipSend {
+	if _, ok := g.topics[string(m.Topic)]; !ok {
+		return nil
+	}
+
+	var ids [][]byte
+	for _, b := range m.MessageIds {
+		var id MessageID
+		if len(b) != len(id) {
+			continue
+		}
+		copy(id[:], b)
+		if !g.seen.Contains(id) {
+			ids = append(ids, b)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	msg := &vpnv1.GossipMessage{
+		Body: &vpnv1.GossipMessage_Iwant{
+			Iwant: &vpnv1.GossipMessage_IWant{
+				MessageIds: ids,
+			},
+		},
+	}
+	return []gossipSend{{p.id, msg}}
+}
+
+func (g *gossip) handleIWant(p *gossipPeer, m *vpnv1.GossipMessage_IWant) []gossipSend {
+	var sends []gossipSend
+	for _, b := range m.MessageIds {
+		var id MessageID
+		if len(b) != len(id) {
+			continue
+		}
+		copy(id[:], b)
+		if r, ok := g.messages[id]; ok {
+			sends = append(sends, gossipSend{
+				id: p.id,
+				msg: &vpnv1.GossipMessage{
+					Body: &vpnv1.GossipMessage_Publish_{
+						Publish: &vpnv1.GossipMessage_Publish{
+							Record: r,
+						},
+					},
+				},
+			})
+		}
+	}
+	return sends
+}
+
+func (g *gossip) handleGraft(p *gossipPeer, m *vpnv1.GossipMessage_Graft) []gossipSend {
+	t, ok := g.topics[string(m.Topic)]
+	if ok && p.Score() >= 0 && len(t.mesh) < gossipMeshSizeHigh {
+		p.topics[string(m.Topic)] = struct{}{}
+		t.mesh[p.id] = p
+		return nil
+	}
+	return []gossipSend{{p.id, newGossipPruneMessage(m.Topic)}}
+}
+
+func (g *gossip) handlePrune(p *gossipPeer, m *vpnv1.GossipMessage_Prune) {
+	if t, ok := g.topics[string(m.Topic)]; ok {
+		delete(t.mesh, p.id)
+	}
+}
+
+func newGossipGraftMessage(topic []byte) *vpnv1.GossipMessage {
+	return &vpnv1.GossipMessage{
+		Body: &vpnv1.GossipMessage_Graft_{
+			Graft: &vpnv1.GossipMessage_Graft{
+				Topic: topic,
+			},
+		},
+	}
+}
+
+func newGossipPruneMessage(topic []byte) *vpnv1.GossipMessage {
+	return &vpnv1.GossipMessage{
+		Body: &vpnv1.GossipMessage_Prune_{
+			Prune: &vpnv1.GossipMessage_Prune{
+				Topic: topic,
+			},
+		},
+	}
+}
+
+func (g *gossip) localTopics() [][]byte {
+	topics := make([][]byte, 0, len(g.topics))
+	for t := range g.topics {
+		topics = append(topics, []byte(t))
+	}
+	return topics
+}
+
+// Publish ...
+func (g *gossip) Publish(topic, data []byte) error {
+	if len(data) > gossipMaxDataSize {
+		return ErrGossipDataTooLarge
+	}
+
+	r := &vpnv1.GossipMessage_Record{
+		Topic:     topic,
+		Data:      data,
+		Seq:       atomic.AddUint64(&g.seq, 1),
+		Timestamp: timeutil.Now().UnixNano(),
+	}
+	if err := dao.SignMessage(r, g.network.VNIC().Key()); err != nil {
+		return err
+	}
+
+	id := gossipMessageID(r)
+	g.seen.Insert(id)
+
+	g.lock.Lock()
+	g.storeRecord(id, r)
+
+	var peers map[kademlia.ID]*gossipPeer
+	if t, ok := g.topics[string(topic)]; ok {
+		peers = t.mesh
+	} else {
+		peers = g.fanoutPeers(string(topic))
+	}
+	sends := g.forwardRecord(peers, r, g.network.VNIC().ID())
+	g.lock.Unlock()
+
+	g.send(sends)
+	return nil
+}
+
+// fanoutPeers selects peers subscribed to a topic the local host is
+// publishing to without being subscribed.
+func (g *gossip) fanoutPeers(topic string) map[kademlia.ID]*gossipPeer {
+	peers := map[kademlia.ID]*gossipPeer{}
+	for _, p := range g.topicPeers(topic, nil) {
+		if len(peers) >= gossipMeshSize {
+			break
+		}
+		peers[p.id] = p
+	}
+	return peers
+}
+
+// topicPeers returns the peers subscribed to topic with non-negative scores
+// that are not in exclude in random order.
+func (g *gossip) topicPeers(topic string, exclude map[kademlia.ID]*gossipPeer) []*gossipPeer {
+	var peers []*gossipPeer
+	for id, p := range g.peers {
+		if _, ok := exclude[id]; ok {
+			continue
+		}
+		if p.Subscribed(topic) && p.Score() >= 0 {
+			peers = append(peers, p)
+		}
+	}
+	rand.Shuffle(len(peers), func(i, j int) { peers[i], peers[j] = peers[j], peers[i] })
+	return peers
+}
+
+// Subscribe ...
+func (g *gossip) Subscribe(ctx context.Context, topic []byte) (<-chan *GossipMessage, error) {
+	ch := make(chan *GossipMessage, gossipSubscriberBuffer)
+
+	g.lock.Lock()
+	var sends []gossipSend
+	t, ok := g.topics[string(topic)]
+	if !ok {
+		t = &gossipTopic{mesh: map[kademlia.ID]*gossipPeer{}}
+		g.topics[string(topic)] = t
+		sends = g.broadcastSubscription(&vpnv1.GossipMessage{
+			Body: &vpnv1.GossipMessage_Subscribe_{
+				Subscribe: &vpnv1.GossipMessage_Subscribe{
+					Topics: [][]byte{topic},
+				},
+			},
+		})
+	}
+	t.subscribers = append(t.subscribers, ch)
+	g.lock.Unlock()
+
+	g.send(sends)
+
+	go func() {
+		<-ctx.Done()
+		g.unsubscribe(topic, ch)
+	}()
+
+	return ch, nil
+}
+
+func (g *gossip) unsubscribe(topic []byte, ch chan *GossipMessage) {
+	g.lock.Lock()
+	t, ok := g.topics[string(topic)]
+	if !ok {
+		g.lock.Unlock()
+		return
+	}
+
+	for i, c := range t.subscribers {
+		if c == ch {
+			t.subscribers = append(t.subscribers[:i], t.subscribers[i+1:]...)
+			close(ch)
+			break
+		}
+	}
+
+	var sends []gossipSend
+	if len(t.subscribers) == 0 {
+		delete(g.topics, string(topic))
+
+		for id := range t.mesh {
+			sends = append(sends, gossipSend{id, newGossipPruneMessage(topic)})
+		}
+		sends = append(sends, g.broadcastSubscription(&vpnv1.GossipMessage{
+			Body: &vpnv1.GossipMessage_Unsubscribe_{
+				Unsubscribe: &vpnv1.GossipMessage_Unsubscribe{
+					Topics: [][]byte{topic},
+				},
+			},
+		})...)
+	}
+	g.lock.Unlock()
+
+	g.send(sends)
+}
+
+func (g *gossip) broadcastSubscription(msg *vpnv1.GossipMessage) []gossipSend {
+	sends := make([]gossipSend, 0, len(g.peers))
+	for id := range g.peers {
+		sends = append(sends, gossipSend{id, msg})
+	}
+	return sends
+}
+
+func (g *gossip) heartbeat(now timeutil.Time) {
+	g.lock.Lock()
+	sends := g.syncPeers()
+
+	for _, p := range g.peers {
+		for _, s := range p.scores {
+			s.Decay()
+		}
+	}
+
+	for topic, t := range g.topics {
+		sends = append(sends, g.maintainMesh([]byte(topic), t)...)
+		sends = append(sends, g.emitIHave([]byte(topic), t)...)
+	}
+
+	g.shiftHistory()
+	g.lock.Unlock()
+
+	g.send(sends)
+}
+
+// syncPeers tracks the network's direct links and announces local
+// subscriptions to newly linked peers.
+func (g *gossip) syncPeers() []gossipSend {
+	g.network.linksLock.Lock()
+	links := g.network.links.Slice()
+	g.network.linksLock.Unlock()
+
+	linked := map[kademlia.ID]struct{}{}
+	var sends []gossipSend
+	for _, l := range links {
+		id := l.ID()
+		linked[id] = struct{}{}
+
+		if _, ok := g.peers[id]; !ok {
+			g.upsertPeer(id)
+			if len(g.topics) != 0 {
+				sends = append(sends, gossipSend{id, &vpnv1.GossipMessage{
+					Body: &vpnv1.GossipMessage_Subscribe_{
+						Subscribe: &vpnv1.GossipMessage_Subscribe{
+							Topics: g.localTopics(),
+						},
+					},
+				}})
+			}
+		}
+	}
+
+	for id, p := range g.peers {
+		if _, ok := linked[id]; !ok {
+			g.removePeer(p)
+		}
+	}
+	return sends
+}
+
+func (g *gossip) maintainMesh(topic []byte, t *gossipTopic) []gossipSend {
+	var sends []gossipSend
+
+	for id, p := range t.mesh {
+		if !p.Subscribed(string(topic)) || p.Score() < 0 {
+			delete(t.mesh, id)
+			sends = append(sends, gossipSend{id, newGossipPruneMessage(topic)})
+		}
+	}
+
+	if len(t.mesh) < gossipMeshSizeLow {
+		for _, p := range g.topicPeers(string(topic), t.mesh) {
+			if len(t.mesh) >= gossipMeshSize {
+				break
+			}
+			t.mesh[p.id] = p
+			sends = append(sends, gossipSend{p.id, newGossipGraftMessage(topic)})
+		}
+	}
+
+	if len(t.mesh) > gossipMeshSizeHigh {
+		peers := make([]*gossipPeer, 0, len(t.mesh))
+		for _, p := range t.mesh {
+			peers = append(peers, p)
+		}
+		sort.Slice(peers, func(i, j int) bool {
+			return peers[i].Score() > peers[j].Score()
+		})
+		for _, p := range peers[gossipMeshSize:] {
+			delete(t.mesh, p.id)
+			sends = append(sends, gossipSend{p.id, newGossipPruneMessage(topic)})
+		}
+	}
+
+	return sends
+}
+
+func (g *gossip) emitIHave(topic []byte, t *gossipTopic) []gossipSend {
+	var ids [][]byte
+	for _, h := range g.history[:gossipHistoryGossip] {
+		for _, id := range h {
+			if r, ok := g.messages[id]; ok && string(r.Topic) == string(topic) {
+				ids = append(ids, append([]byte(nil), id[:]...))
+			}
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	msg := &vpnv1.GossipMessage{
+		Body: &vpnv1.GossipMessage_Ihave{
+			Ihave: &vpnv1.GossipMessage_IHave{
+				Topic:      topic,
+				MessageIds: ids,
+			},
+		},
+	}
+
+	var sends []gossipSend
+	for _, p := range g.topicPeers(string(topic), t.mesh) {
+		if len(sends) >= gossipLazySize {
+			break
+		}
+		sends = append(sends, gossipSend{p.id, msg})
+	}
+	return sends
+}
+
+func (g *gossip) shiftHistory() {
+	for _, id := range g.history[gossipHistoryLength-1] {
+		delete(g.messages, id)
+	}
+	copy(g.history[1:], g.history[:gossipHistoryLength-1])
+	g.history[0] = nil
+}
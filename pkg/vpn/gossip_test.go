@@ -0,0 +1,227 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package vpn
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MemeLabs/strims/internal/dao"
+	vpnv1 "github.com/MemeLabs/strims/pkg/apis/vpn/v1"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+	"github.com/MemeLabs/strims/pkg/vnic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGossipScore(t *testing.T) {
+	var s gossipScore
+	for i := 0; i < 30; i++ {
+		s.firstDeliveries++
+	}
+	assert.EqualValues(t, gossipFirstDeliveryCap, s.Value())
+
+	s.invalidMessages = 4
+	assert.Less(t, s.Value(), float64(gossipGraylistThreshold))
+
+	for i := 0; i < 100; i++ {
+		s.Decay()
+	}
+	assert.InDelta(t, 0, s.Value(), 0.1)
+}
+
+func TestGossipMessageID(t *testing.T) {
+	a := &vpnv1.GossipMessage_Record{Topic: []byte("a"), Key: []byte("k"), Seq: 1, Data: []byte("x")}
+	b := &vpnv1.GossipMessage_Record{Topic: []byte("a"), Key: []byte("k"), Seq: 1, Data: []byte("y")}
+	c := &vpnv1.GossipMessage_Record{Topic: []byte("a"), Key: []byte("k"), Seq: 2, Data: []byte("x")}
+
+	assert.Equal(t, gossipMessageID(a), gossipMessageID(b))
+	assert.NotEqual(t, gossipMessageID(a), gossipMessageID(c))
+}
+
+func testGossip(n *Node) *gossip {
+	return n.Gossip.(*gossip)
+}
+
+func testGossipMeshSize(g *gossip, topic []byte) int {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	if t, ok := g.topics[string(topic)]; ok {
+		return len(t.mesh)
+	}
+	return 0
+}
+
+// waitForGossipMesh runs heartbeats until every node has grafted each of its
+// linked peers into the topic mesh.
+func waitForGossipMesh(t *testing.T, nodes []*Node, topic []byte) {
+	require.Eventually(t, func() bool {
+		ok := true
+		for _, n := range nodes {
+			g := testGossip(n)
+			g.heartbeat(timeutil.Now())
+			n.Network.linksLock.Lock()
+			links := len(n.Network.links.Slice())
+			n.Network.linksLock.Unlock()
+			ok = ok && testGossipMeshSize(g, topic) == links
+		}
+		return ok
+	}, 5*time.Second, 50*time.Millisecond, "expected topic mesh to include every linked peer")
+}
+
+func readGossipMessage(t *testing.T, ch <-chan *GossipMessage) *GossipMessage {
+	select {
+	case m := <-ch:
+		return m
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for gossip message")
+		return nil
+	}
+}
+
+func TestGossipDelivery(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	start := timeutil.Now()
+	nodes := newTestNodes(t, 4)
+	linkTestNodeChain(t, nodes)
+
+	topic := []byte("topic")
+	chs := make([]<-chan *GossipMessage, len(nodes))
+	for i, n := range nodes {
+		var err error
+		chs[i], err = n.Gossip.Subscribe(ctx, topic)
+		require.NoError(t, err)
+	}
+	waitForGossipMesh(t, nodes, topic)
+
+	require.NoError(t, nodes[0].Gossip.Publish(topic, []byte("data")))
+
+	for _, ch := range chs[1:] {
+		m := readGossipMessage(t, ch)
+		assert.Equal(t, []byte("data"), m.Data)
+		assert.Equal(t, nodes[0].Host.VNIC().Key().Public, m.Key)
+		assert.Greater(t, m.Seq, uint64(start.UnixNano()), "expected sequence to be seeded from the clock")
+	}
+}
+
+func TestGossipMeshMaintenance(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nodes := newTestNodes(t, 3)
+	linkTestNodes(t, nodes[0], nodes[1])
+	linkTestNodes(t, nodes[1], nodes[2])
+
+	topic := []byte("topic")
+	_, err := nodes[0].Gossip.Subscribe(ctx, topic)
+	require.NoError(t, err)
+	_, err = nodes[1].Gossip.Subscribe(ctx, topic)
+	require.NoError(t, err)
+	leafCtx, leafCancel := context.WithCancel(ctx)
+	_, err = nodes[2].Gossip.Subscribe(leafCtx, topic)
+	require.NoError(t, err)
+	waitForGossipMesh(t, nodes, topic)
+
+	leafCancel()
+	hub := testGossip(nodes[1])
+	assert.Eventually(t, func() bool {
+		hub.heartbeat(timeutil.Now())
+		return testGossipMeshSize(hub, topic) == 1
+	}, 5*time.Second, 50*time.Millisecond, "expected unsubscribed peer to be pruned from the mesh")
+
+	hub.lock.Lock()
+	subscribed := hub.peers[nodes[2].Host.VNIC().ID()].Subscribed(string(topic))
+	hub.lock.Unlock()
+	assert.False(t, subscribed)
+}
+
+func TestGossipIHaveIWant(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nodes := newTestNodes(t, 2)
+	linkTestNodes(t, nodes[0], nodes[1])
+	for _, n := range nodes {
+		// heartbeats are run manually so the publisher never grafts the peer
+		// into its mesh and the message only reaches it through lazy gossip
+		testGossip(n).stopHeartbeat()
+	}
+
+	topic := []byte("topic")
+	ch, err := nodes[1].Gossip.Subscribe(ctx, topic)
+	require.NoError(t, err)
+	_, err = nodes[0].Gossip.Subscribe(ctx, topic)
+	require.NoError(t, err)
+
+	g := testGossip(nodes[0])
+	testGossip(nodes[1]).heartbeat(timeutil.Now())
+	require.Eventually(t, func() bool {
+		g.lock.Lock()
+		defer g.lock.Unlock()
+		p, ok := g.peers[nodes[1].Host.VNIC().ID()]
+		return ok && p.Subscribed(string(topic))
+	}, 5*time.Second, 10*time.Millisecond, "expected peer subscription to be announced")
+
+	require.NoError(t, g.Publish(topic, []byte("data")))
+	select {
+	case <-ch:
+		require.FailNow(t, "expected message not to be pushed outside the mesh")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	g.lock.Lock()
+	sends := g.emitIHave(topic, g.topics[string(topic)])
+	g.lock.Unlock()
+	require.Len(t, sends, 1)
+	g.send(sends)
+
+	m := readGossipMessage(t, ch)
+	assert.Equal(t, []byte("data"), m.Data)
+}
+
+func TestGossipInvalidSignature(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nodes := newTestNodes(t, 2)
+	linkTestNodes(t, nodes[0], nodes[1])
+
+	topic := []byte("topic")
+	ch, err := nodes[1].Gossip.Subscribe(ctx, topic)
+	require.NoError(t, err)
+
+	r := &vpnv1.GossipMessage_Record{
+		Topic:     topic,
+		Data:      []byte("data"),
+		Seq:       1,
+		Timestamp: timeutil.Now().UnixNano(),
+	}
+	require.NoError(t, dao.SignMessage(r, nodes[0].Host.VNIC().Key()))
+	r.Data = []byte("forged")
+
+	msg := &vpnv1.GossipMessage{
+		Body: &vpnv1.GossipMessage_Publish_{
+			Publish: &vpnv1.GossipMessage_Publish{Record: r},
+		},
+	}
+	err = nodes[0].Network.SendProtoWithFlags(nodes[1].Host.VNIC().ID(), vnic.GossipPort, vnic.GossipPort, msg, MstdFlags|Mnorelay)
+	require.NoError(t, err)
+
+	g := testGossip(nodes[1])
+	assert.Eventually(t, func() bool {
+		g.lock.Lock()
+		defer g.lock.Unlock()
+		p, ok := g.peers[nodes[0].Host.VNIC().ID()]
+		return ok && p.TopicScore(string(topic)).invalidMessages > 0
+	}, 5*time.Second, 10*time.Millisecond, "expected invalid message to be penalized")
+
+	select {
+	case <-ch:
+		assert.Fail(t, "expected message with invalid signature to be dropped")
+	default:
+	}
+}
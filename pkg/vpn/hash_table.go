@@ -212,7 +212,7 @@ func (s *hashTable) Get(ctx context.Context, key, salt []byte, options ...HashTa
 				},
 			},
 		}
-		if err := s.network.SendProtoWithFlags(target, vnic.HashTablePort, vnic.HashTablePort, msg, Mbroadcast); err != nil {
+		if err := s.network.SendProtoWithFlags(target, vnic.HashTablePort, vnic.HashTablePort, msg, Mbroadcast|Mdisjoint); err != nil {
 			cleanup()
 			return nil, err
 		}
@@ -22,6 +22,7 @@ const recentMessageIDHistoryDefaultSize = 1024
 const recentMessageIDHistoryTTL = 30 * time.Second
 const maxMessageHops = 5
 const maxMessageReplicas = 5
+const disjointMessagePaths = 3
 const qosClassWeight = 1
 
 // errors ...
@@ -100,6 +101,9 @@ func (h *Host) AddNetwork(key []byte) (*Node, error) {
 	hashTable := newHashTable(logger, network, h.hashTableStore)
 	peerIndex := newPeerIndex(logger, network)
 	peerExchange := newPeerExchange(logger, network)
+	gossip := newGossip(logger, network)
+	onion := newOnion(logger, network)
+	routeProber := newRouteProber(logger, network)
 
 	if err := network.SetHandler(vnic.HashTablePort, hashTable); err != nil {
 		return nil, err
@@ -110,6 +114,15 @@ func (h *Host) AddNetwork(key []byte) (*Node, error) {
 	if err := network.SetHandler(vnic.PeerExchangePort, peerExchange); err != nil {
 		return nil, err
 	}
+	if err := network.SetHandler(vnic.GossipPort, gossip); err != nil {
+		return nil, err
+	}
+	if err := network.SetHandler(vnic.OnionPort, onion); err != nil {
+		return nil, err
+	}
+	if err := network.SetHandler(vnic.RouteProbePort, routeProber); err != nil {
+		return nil, err
+	}
 
 	node := &Node{
 		Host:         h,
@@ -117,6 +130,8 @@ func (h *Host) AddNetwork(key []byte) (*Node, error) {
 		HashTable:    hashTable,
 		PeerIndex:    peerIndex,
 		PeerExchange: peerExchange,
+		Gossip:       gossip,
+		Onion:        onion,
 	}
 	h.nodes.Set(key, node)
 
@@ -136,6 +151,8 @@ func (h *Host) RemoveNetwork(key []byte) error {
 	}
 
 	node.PeerIndex.(*peerIndex).Close()
+	node.Gossip.(*gossip).Close()
+	node.Onion.(*onion).Close()
 	node.Network.Close()
 	return nil
 }
@@ -161,4 +178,6 @@ type Node struct {
 	HashTable    HashTable
 	PeerIndex    PeerIndex
 	PeerExchange PeerExchange
+	Gossip       Gossip
+	Onion        Onion
 }
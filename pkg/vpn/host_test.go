@@ -0,0 +1,100 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package vpn
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/MemeLabs/strims/internal/dao"
+	"github.com/MemeLabs/strims/pkg/ppspp/ppspptest"
+	"github.com/MemeLabs/strims/pkg/vnic"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const testNetworkPort = 10000
+
+// test hosts use cheap id puzzles to keep host creation fast
+var testHostOptions = []vnic.HostOption{vnic.WithIDPuzzleDifficulty(2, 2)}
+
+// newTestNodes creates n hosts joined to the same network. links between the
+// nodes are added with linkTestNodes.
+func newTestNodes(t *testing.T, n int) []*Node {
+	networkKey := make([]byte, 32)
+	_, err := rand.Read(networkKey)
+	require.NoError(t, err)
+
+	nodes := make([]*Node, n)
+	for i := range nodes {
+		profileKey, err := dao.GenerateKey()
+		require.NoError(t, err)
+		vnicHost, err := vnic.New(zap.NewNop(), profileKey, testHostOptions...)
+		require.NoError(t, err)
+		host, err := New(zap.NewNop(), vnicHost)
+		require.NoError(t, err)
+		nodes[i], err = host.AddNetwork(networkKey)
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			host.RemoveNetwork(networkKey)
+			vnicHost.Close()
+		})
+	}
+	return nodes
+}
+
+// testConn flushes writes immediately so handshakes written without an
+// explicit flush reach the peer.
+type testConn struct {
+	ppspptest.Conn
+}
+
+func (c testConn) Write(p []byte) (int, error) {
+	n, err := c.Conn.Write(p)
+	if err != nil {
+		return n, err
+	}
+	return n, c.Conn.Flush()
+}
+
+// linkTestNodes connects a and b with a buffered in memory link and adds the
+// peers to the network. buffering keeps hosts that write to each other from
+// their frame handlers from deadlocking.
+func linkTestNodes(t *testing.T, a, b *Node) {
+	pa, pb := linkTestHosts(t, a, b)
+	a.Network.AddPeer(pa, testNetworkPort, testNetworkPort)
+	b.Network.AddPeer(pb, testNetworkPort, testNetworkPort)
+}
+
+// linkTestHosts connects the vnic hosts of a and b without adding the peers
+// to any network.
+func linkTestHosts(t *testing.T, a, b *Node) (*vnic.Peer, *vnic.Peer) {
+	pipeA, pipeB := ppspptest.NewConnPair()
+	ca, cb := testConn{pipeA}, testConn{pipeB}
+
+	type result struct {
+		peer *vnic.Peer
+		err  error
+	}
+	ch := make(chan result, 1)
+	go func() {
+		p, err := b.Host.VNIC().AddLink(cb)
+		ch <- result{p, err}
+	}()
+	pa, err := a.Host.VNIC().AddLink(ca)
+	require.NoError(t, err)
+	rb := <-ch
+	require.NoError(t, rb.err)
+	require.NotNil(t, pa)
+	require.NotNil(t, rb.peer)
+	return pa, rb.peer
+}
+
+// linkTestNodeChain links each node to the next.
+func linkTestNodeChain(t *testing.T, nodes []*Node) {
+	for i := 1; i < len(nodes); i++ {
+		linkTestNodes(t, nodes[i-1], nodes[i])
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+//go:build !js
+
+package vpn
+
+import (
+	"context"
+	"encoding/hex"
+	"net"
+	"net/netip"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MemeLabs/strims/pkg/kademlia"
+	"github.com/MemeLabs/strims/pkg/mdns"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+	"github.com/MemeLabs/strims/pkg/vnic"
+	"go.uber.org/zap"
+)
+
+const (
+	lanDiscoveryServiceType      = "_strims._tcp"
+	lanDiscoveryInterval         = 30 * time.Second
+	lanDiscoveryDialBackoff      = time.Minute
+	lanDiscoveryNegotiateTimeout = 30 * time.Second
+	lanDiscoveryRejectBackoff    = time.Hour
+)
+
+// RunLANDiscovery advertises the host's local network listeners over mdns and
+// dials the hosts it discovers. Network membership is not advertised. The
+// network broker negotiates shared networks over the new links without
+// revealing the hosts' other networks, and links that do not join any network
+// before lanDiscoveryNegotiateTimeout are closed.
+func RunLANDiscovery(ctx context.Context, logger *zap.Logger, host *Host) error {
+	conn, err := mdns.Listen(logger)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	d := newLANDiscovery(logger, host)
+	stop := timeutil.DefaultTickEmitter.SubscribeCtx(ctx, lanDiscoveryInterval, d.prune, nil)
+	defer stop()
+
+	id := host.VNIC().ID().Bytes(nil)
+	s := mdns.Service{
+		Type:     lanDiscoveryServiceType,
+		Instance: hex.EncodeToString(id[:16]),
+		TXT:      d.txt,
+	}
+	return conn.Serve(ctx, s, lanDiscoveryInterval, d.handleEntry)
+}
+
+func newLANDiscovery(logger *zap.Logger, host *Host) *lanDiscovery {
+	return &lanDiscovery{
+		logger:   logger,
+		host:     host,
+		lastDial: map[kademlia.ID]timeutil.Time{},
+		pending:  map[kademlia.ID]lanPeer{},
+	}
+}
+
+type lanDiscovery struct {
+	logger   *zap.Logger
+	host     *Host
+	lock     sync.Mutex
+	lastDial map[kademlia.ID]timeutil.Time
+	pending  map[kademlia.ID]lanPeer
+}
+
+type lanPeer struct {
+	peer   *vnic.Peer
+	dialed timeutil.Time
+}
+
+func (d *lanDiscovery) txt() []string {
+	txt := []string{"id=" + d.host.VNIC().ID().String()}
+	for _, uri := range d.host.VNIC().LANURIs() {
+		txt = append(txt, "uri="+uri)
+	}
+	return txt
+}
+
+func (d *lanDiscovery) handleEntry(e mdns.Entry) {
+	var hostID kademlia.ID
+	var uris []string
+	for _, r := range e.TXT {
+		k, v, _ := strings.Cut(r, "=")
+		switch k {
+		case "id":
+			b, err := hex.DecodeString(v)
+			if err != nil {
+				return
+			}
+			if hostID, err = kademlia.UnmarshalID(b); err != nil {
+				return
+			}
+		case "uri":
+			uris = append(uris, v)
+		}
+	}
+
+	if hostID.Equals(d.host.VNIC().ID()) || d.host.VNIC().HasPeer(hostID) {
+		return
+	}
+
+	d.lock.Lock()
+	now := timeutil.Now()
+	if t, ok := d.lastDial[hostID]; ok && now.Before(t.Add(lanDiscoveryDialBackoff)) {
+		d.lock.Unlock()
+		return
+	}
+	d.lastDial[hostID] = now
+	d.lock.Unlock()
+
+	go d.dial(hostID, e.Addr, uris)
+}
+
+func (d *lanDiscovery) dial(hostID kademlia.ID, addr netip.Addr, uris []string) {
+	for _, uri := range uris {
+		uri, ok := lanDialURI(uri, addr)
+		if !ok {
+			continue
+		}
+
+		d.logger.Debug(
+			"dialing lan peer",
+			zap.Stringer("host", hostID),
+			zap.String("uri", uri),
+		)
+		peer, err := d.host.VNIC().Dial(uri)
+		if err != nil {
+			d.logger.Debug("lan peer dial failed", zap.Error(err))
+			continue
+		}
+
+		d.lock.Lock()
+		d.pending[peer.HostID()] = lanPeer{peer, timeutil.Now()}
+		d.lock.Unlock()
+		return
+	}
+}
+
+// prune closes links to lan peers that did not join any of the host's
+// networks and forgets expired dial backoffs. closed peers are not redialed
+// until lanDiscoveryRejectBackoff passes.
+func (d *lanDiscovery) prune(now timeutil.Time) {
+	var closed []*vnic.Peer
+
+	d.lock.Lock()
+	for id, t := range d.lastDial {
+		if now.After(t.Add(lanDiscoveryDialBackoff)) {
+			delete(d.lastDial, id)
+		}
+	}
+	for id, p := range d.pending {
+		if p.peer.Closed() || d.sharesNetwork(id) {
+			delete(d.pending, id)
+		} else if now.Sub(p.dialed) >= lanDiscoveryNegotiateTimeout {
+			delete(d.pending, id)
+			d.lastDial[id] = now.Add(lanDiscoveryRejectBackoff)
+			closed = append(closed, p.peer)
+		}
+	}
+	d.lock.Unlock()
+
+	for _, p := range closed {
+		d.logger.Debug("closing lan peer with no shared networks", zap.Stringer("host", p.HostID()))
+		p.Close()
+	}
+}
+
+func (d *lanDiscovery) sharesNetwork(hostID kademlia.ID) bool {
+	for _, node := range d.host.Nodes() {
+		if node.Network.HasPeer(hostID) {
+			return true
+		}
+	}
+	return false
+}
+
+// lanDialURI replaces the host in uri with the source address of the mdns
+// packet that advertised it so discovery can only be used to dial the
+// advertising host.
+func lanDialURI(uri string, addr netip.Addr) (string, bool) {
+	u, err := url.Parse(uri)
+	if err != nil || u.Port() == "" || !addr.IsValid() {
+		return "", false
+	}
+	u.Host = net.JoinHostPort(addr.String(), u.Port())
+	return u.String(), true
+}
@@ -0,0 +1,59 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+//go:build !js
+
+package vpn
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/MemeLabs/strims/pkg/timeutil"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestLANDialURI(t *testing.T) {
+	addr := netip.MustParseAddr("192.168.1.20")
+
+	cases := []struct {
+		label string
+		uri   string
+		dial  string
+		ok    bool
+	}{
+		{"unspecified host", "tcp://0.0.0.0:8083/abcd", "tcp://192.168.1.20:8083/abcd", true},
+		{"other host", "tcp://203.0.113.1:8083/abcd", "tcp://192.168.1.20:8083/abcd", true},
+		{"hostname", "ws://example.com:8080/api", "ws://192.168.1.20:8080/api", true},
+		{"missing port", "tcp://203.0.113.1/abcd", "", false},
+	}
+	for _, c := range cases {
+		t.Run(c.label, func(t *testing.T) {
+			dial, ok := lanDialURI(c.uri, addr)
+			assert.Equal(t, c.ok, ok)
+			assert.Equal(t, c.dial, dial)
+		})
+	}
+}
+
+func TestLANDiscoveryPrunesPeersWithoutSharedNetworks(t *testing.T) {
+	members := newTestNodes(t, 2)
+	linkTestNodes(t, members[0], members[1])
+	other := newTestNodes(t, 1)[0]
+	pa, _ := linkTestHosts(t, members[0], other)
+
+	d := newLANDiscovery(zap.NewNop(), members[0].Host)
+	now := timeutil.Now()
+	member, _ := members[0].Host.VNIC().GetPeer(members[1].Host.VNIC().ID())
+	d.pending[member.HostID()] = lanPeer{member, now}
+	d.pending[pa.HostID()] = lanPeer{pa, now}
+
+	d.prune(now.Add(lanDiscoveryNegotiateTimeout))
+
+	assert.Empty(t, d.pending)
+	assert.False(t, member.Closed(), "expected peers sharing a network to be kept")
+	assert.Eventually(t, pa.Closed, time.Second, 10*time.Millisecond, "expected peers without shared networks to be closed")
+	assert.True(t, d.lastDial[pa.HostID()].After(now.Add(lanDiscoveryDialBackoff)), "expected closed peers to back off")
+}
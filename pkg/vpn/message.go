@@ -35,9 +35,25 @@ const (
 	Mencrypt
 	Mbroadcast
 	Mnorelay
+	// Mdisjoint sends a copy of the message along each of several disjoint
+	// paths. hosts are assigned to paths by id and relays forward each copy
+	// only to hosts on its path when they can so a malicious host can only
+	// interfere with the copies on its own path.
+	Mdisjoint
 	MstdFlags uint16 = Mencrypt
 )
 
+// disjoint messages carry the index of their path in the high bits of the
+// flags.
+const (
+	mdisjointPathShift        = 14
+	mdisjointPathMask  uint16 = 3 << mdisjointPathShift
+)
+
+func disjointMessagePath(flags uint16) int {
+	return int(flags&mdisjointPathMask) >> mdisjointPathShift
+}
+
 // Marshal ...
 func (m MessageHeader) Marshal(b []byte) (n int, err error) {
 	if len(b) < messageHeaderLen {
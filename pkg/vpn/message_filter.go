@@ -104,7 +104,10 @@ func newMessageCipher(k *key.Key, hostID kademlia.ID) (*messageCipher, error) {
 	if err != nil {
 		return nil, err
 	}
+	return newMessageCipherWithSecret(secret)
+}
 
+func newMessageCipherWithSecret(secret []byte) (*messageCipher, error) {
 	block, err := aes.NewCipher(secret)
 	if err != nil {
 		return nil, err
@@ -18,7 +18,7 @@ func host(t *testing.T) *vnic.Host {
 	assert.Nil(t, err)
 	key, err := dao.GenerateKey()
 	assert.Nil(t, err)
-	host, err := vnic.New(logger, key)
+	host, err := vnic.New(logger, key, testHostOptions...)
 	assert.Nil(t, err)
 	return host
 }
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"net/netip"
 	"sync"
 	"sync/atomic"
 
@@ -15,7 +16,6 @@ import (
 	"github.com/MemeLabs/strims/pkg/randutil"
 	"github.com/MemeLabs/strims/pkg/vnic"
 	"github.com/MemeLabs/strims/pkg/vnic/qos"
-	"github.com/petar/GoLLRB/llrb"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 )
@@ -28,61 +28,13 @@ func newNetwork(logger *zap.Logger, host *vnic.Host, qosc *qos.Class, key []byte
 		qosc:             qosc,
 		key:              key,
 		recentMessageIDs: recentMessageIDs,
-		links:            kademlia.NewKBucket(host.ID(), 20),
+		links:            kademlia.NewKBucket(host.ID(), 20, kademlia.WithIPPrefixLimit(kademlia.DefaultIPPrefixLimit)),
 		handlers:         map[uint16]MessageHandler{},
 		reservations:     map[uint16]struct{}{},
 		nextHop:          newNextHopMap(),
 	}
 }
 
-func newNextHopMap() *nextHopMap {
-	return &nextHopMap{
-		v: llrb.New(),
-	}
-}
-
-type nextHopMap struct {
-	mu sync.Mutex
-	v  *llrb.LLRB
-}
-
-func (m *nextHopMap) Insert(target, nextHop kademlia.ID, distance int) {
-	m.mu.Lock()
-	defer m.mu.Unlock()
-
-	prev := m.v.Get(&nextHopMapItem{target: target})
-	if prev, ok := prev.(*nextHopMapItem); ok {
-		if prev.distance <= distance {
-			return
-		}
-	}
-	m.v.ReplaceOrInsert(&nextHopMapItem{target, nextHop, distance})
-}
-
-func (m *nextHopMap) Get(target kademlia.ID) (kademlia.ID, bool) {
-	m.mu.Lock()
-	defer m.mu.Unlock()
-
-	vi := m.v.Get(&nextHopMapItem{target: target})
-	if v, ok := vi.(*nextHopMapItem); ok {
-		return v.nextHop, true
-	}
-	return kademlia.ID{}, false
-}
-
-type nextHopMapItem struct {
-	target   kademlia.ID
-	nextHop  kademlia.ID
-	distance int
-}
-
-func (h *nextHopMapItem) Less(o llrb.Item) bool {
-	if o, ok := o.(*nextHopMapItem); ok {
-		return h.target.Less(o.target)
-	}
-	return !o.Less(h)
-}
-
 // Network ...
 type Network struct {
 	logger           *zap.Logger
@@ -99,6 +51,8 @@ type Network struct {
 	reservations     map[uint16]struct{}
 	connections      []*networkLink
 	nextHop          *nextHopMap
+	onion            *onion
+	routeProber      *routeProber
 }
 
 // VNIC ...
@@ -165,6 +119,10 @@ func (n *Network) ReleasePort(port uint16) {
 
 // Close ...
 func (n *Network) Close() {
+	if n.routeProber != nil {
+		n.routeProber.Close()
+	}
+
 	n.linksLock.Lock()
 	defer n.linksLock.Unlock()
 
@@ -174,6 +132,47 @@ func (n *Network) Close() {
 	n.links.Reset()
 }
 
+// sendVia sends an unencrypted message to id through the link to nextHop
+// instead of the route selected by the network.
+func (n *Network) sendVia(nextHop, id kademlia.ID, port, srcPort uint16, b []byte) error {
+	n.linksLock.Lock()
+	li, ok := n.links.Get(nextHop)
+	n.linksLock.Unlock()
+	if !ok {
+		return errNoRoute
+	}
+	l := li.(*networkLink)
+
+	m := &Message{
+		Header: MessageHeader{
+			DstID:   id,
+			DstPort: port,
+			SrcPort: srcPort,
+			Seq:     uint16(atomic.AddUint64(&n.seq, 1)),
+			Length:  uint16(len(b)),
+		},
+		Body: b,
+		Trailer: MessageTrailer{
+			Entries: []MessageTrailerEntry{
+				{
+					HostID: n.host.ID(),
+				},
+			},
+		},
+	}
+
+	f := pool.Get(m.Size())
+	defer pool.Put(f)
+	if _, err := m.Marshal(*f, n.host); err != nil {
+		return err
+	}
+
+	l.writeLock.Lock()
+	defer l.writeLock.Unlock()
+	_, err := l.WriteFrame(*f)
+	return err
+}
+
 // Key ...
 func (n *Network) Key() []byte {
 	return n.key
@@ -193,6 +192,14 @@ func (n *Network) AddPeer(peer *vnic.Peer, srcPort, dstPort uint16) {
 		port:        srcPort,
 		FrameWriter: vnic.NewFrameWriter(peer.Link, dstPort, n.qosc),
 	}
+	if !n.links.Admits(link) {
+		n.logger.Debug(
+			"peer rejected by routing table admission policy",
+			zap.Stringer("host", peer.HostID()),
+			zap.Stringer("addr", peer.RemoteAddr()),
+		)
+		return
+	}
 	peer.SetHandler(srcPort, n.handleFrame)
 
 	n.links.Insert(link)
@@ -210,6 +217,7 @@ func (n *Network) RemovePeer(id kademlia.ID) {
 	link.(*networkLink).peer.RemoveHandler(link.(*networkLink).port)
 
 	n.links.Remove(id)
+	n.nextHop.RemoveNextHop(id)
 }
 
 // HasPeer ...
@@ -239,7 +247,12 @@ func (n *Network) handleFrame(p *vnic.Peer, f vnic.Frame) error {
 	// if we are going to add it to the next hop index
 
 	for i := 0; i < lastHopIndex; i++ {
-		n.nextHop.Insert(m.Trailer.Entries[i].HostID, m.Trailer.Entries[lastHopIndex].HostID, lastHopIndex-i)
+		// ids learned indirectly are only routable if they solve the static id
+		// puzzle
+		e := &m.Trailer.Entries[i]
+		if n.host.VerifyHostID(e.HostID) {
+			n.nextHop.Insert(e.HostID, m.Trailer.Entries[lastHopIndex].HostID, lastHopIndex-i)
+		}
 	}
 
 	if ok := n.recentMessageIDs.Insert(m.ID()); !ok {
@@ -266,6 +279,10 @@ func (n *Network) Send(id kademlia.ID, port, srcPort uint16, b []byte) error {
 
 // SendWithFlags ...
 func (n *Network) SendWithFlags(id kademlia.ID, port, srcPort uint16, b []byte, f uint16) error {
+	if ok, err := n.onion.sendReply(id, port, srcPort, b); ok {
+		return err
+	}
+
 	var hs networkMessageHandler = (*Network).handleMessage
 	if f&Mencrypt != 0 {
 		hs = stackNetworkMessageHandler(hs, encryptMessage)
@@ -373,6 +390,30 @@ func (n *Network) callHandler(m *Message) error {
 
 // sendMessage ...
 func (n *Network) sendMessage(m *Message) error {
+	if m.Header.Flags&Mdisjoint != 0 && m.Trailer.Hops == 0 {
+		return n.sendDisjointMessage(m)
+	}
+	return n.writeMessage(m)
+}
+
+// sendDisjointMessage sends a copy of m along each disjoint path. the copies
+// get their own sequence numbers so relays where the paths cross don't drop
+// them as duplicates.
+func (n *Network) sendDisjointMessage(m *Message) error {
+	for i := 0; i < disjointMessagePaths; i++ {
+		c := m.ShallowClone()
+		if i != 0 {
+			c.Header.Seq = uint16(atomic.AddUint64(&n.seq, 1))
+		}
+		c.Header.Flags = c.Header.Flags&^mdisjointPathMask | uint16(i)<<mdisjointPathShift
+		if err := n.writeMessage(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n *Network) writeMessage(m *Message) error {
 	b := pool.Get(m.Size())
 	defer pool.Put(b)
 	if _, err := m.Marshal(*b, n.host); err != nil {
@@ -389,26 +430,24 @@ func (n *Network) sendMessage(m *Message) error {
 		if ln != 0 && conns[0].ID().Equals(m.Header.DstID) {
 			ln = 1
 			// log.Println("using direct route")
-		} else if id, ok := n.nextHop.Get(m.Header.DstID); ok {
-			if conn, ok := n.links.Get(id); ok {
-				k := ln
-				for i := 0; i < ln; i++ {
-					if conns[i] == conn {
-						k = i
-						break
-					}
-				}
-				if k == ln && ln < len(conns) {
-					ln++
+		} else {
+			var routes [maxNextHopRoutes]kademlia.ID
+			rn := n.nextHop.Routes(m.Header.DstID, routes[:])
+
+			n.linksLock.Lock()
+			for i := rn - 1; i >= 0; i-- {
+				if conn, ok := n.links.Get(routes[i]); ok {
+					ln = promoteLink(conns[:], ln, conn)
 				}
-				copy(conns[1:], conns[:k])
-				conns[0] = conn
-				// ln = 1
-				// log.Println("using known route", conn.ID().String())
 			}
+			n.linksLock.Unlock()
 		}
 	}
 
+	if m.Header.Flags&Mdisjoint != 0 {
+		ln = filterDisjointPathLinks(conns[:ln], disjointMessagePath(m.Header.Flags))
+	}
+
 	var k int
 	for _, li := range conns[:ln] {
 		l := li.(*networkLink)
@@ -419,10 +458,11 @@ func (n *Network) sendMessage(m *Message) error {
 
 		// n.logger.Debug("writing frame", zap.Stringer("id", l.ID()))
 		l.writeLock.Lock()
-		if _, err := l.WriteFrame(*b); err != nil {
+		_, err := l.WriteFrame(*b)
+		l.writeLock.Unlock()
+		if err != nil {
 			n.logger.Debug("failed to write frame", zap.Error(err))
 		}
-		l.writeLock.Unlock()
 
 		if unicast && m.Header.DstID.Equals(l.ID()) {
 			break
@@ -436,6 +476,51 @@ func (n *Network) sendMessage(m *Message) error {
 	return nil
 }
 
+// disjointPath returns the disjoint path id is assigned to.
+func disjointPath(id kademlia.ID) int {
+	return int(id[0] % disjointMessagePaths)
+}
+
+// filterDisjointPathLinks moves the links assigned to path to the front of
+// conns and returns their count. if no links are assigned to the path all of
+// them are kept so messages can make progress through sparse routing tables.
+func filterDisjointPathLinks(conns []kademlia.Interface, path int) int {
+	var k int
+	for i, c := range conns {
+		if disjointPath(c.ID()) == path {
+			conns[k], conns[i] = conns[i], conns[k]
+			k++
+		}
+	}
+	if k == 0 {
+		return len(conns)
+	}
+	return k
+}
+
+// promoteLink moves conn to the front of conns, inserting it if it is not
+// already present, and returns the new length.
+func promoteLink(conns []kademlia.Interface, ln int, conn kademlia.Interface) int {
+	k := ln
+	for i := 0; i < ln; i++ {
+		if conns[i] == conn {
+			k = i
+			break
+		}
+	}
+	if k == ln && ln < len(conns) {
+		ln++
+	}
+	copy(conns[1:], conns[:k])
+	conns[0] = conn
+	return ln
+}
+
+// Routes returns a snapshot of the network's route table.
+func (n *Network) Routes() []Route {
+	return n.nextHop.Slice()
+}
+
 type messageFilter func(n *Network, m *Message, next networkMessageHandler) error
 
 type networkMessageHandler func(n *Network, m *Message) error
@@ -477,6 +562,16 @@ func (c *networkLink) ID() kademlia.ID {
 	return c.peer.HostID()
 }
 
+// Addr ...
+func (c *networkLink) Addr() netip.Addr {
+	return c.peer.RemoteAddr()
+}
+
+// Verified ...
+func (c *networkLink) Verified() bool {
+	return c.peer.IDVerified()
+}
+
 // PeerNetwork ...
 type PeerNetwork struct {
 	Peer    *vnic.Peer
@@ -0,0 +1,62 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package vpn
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisjointMessagePaths(t *testing.T) {
+	nodes := newTestNodes(t, 5)
+	src, leaves := nodes[0], nodes[1:]
+	for _, n := range leaves {
+		linkTestNodes(t, src, n)
+	}
+
+	const port = 2000
+	received := make(chan [2]int, len(leaves)*disjointMessagePaths)
+	for i, n := range leaves {
+		i := i
+		require.NoError(t, n.Network.SetHandler(port, MessageHandlerFunc(func(m *Message) error {
+			received <- [2]int{i, disjointMessagePath(m.Header.Flags)}
+			return nil
+		})))
+	}
+
+	occupied := map[int]bool{}
+	for _, n := range leaves {
+		occupied[disjointPath(n.Host.VNIC().ID())] = true
+	}
+	expected := map[[2]int]bool{}
+	for p := 0; p < disjointMessagePaths; p++ {
+		for i, n := range leaves {
+			if !occupied[p] || disjointPath(n.Host.VNIC().ID()) == p {
+				expected[[2]int{i, p}] = true
+			}
+		}
+	}
+
+	require.NoError(t, src.Network.BroadcastWithFlags(port, port, []byte("test"), Mdisjoint))
+
+	actual := map[[2]int]bool{}
+	for range expected {
+		select {
+		case r := <-received:
+			actual[r] = true
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "timed out waiting for message")
+		}
+	}
+	assert.Equal(t, expected, actual, "expected each copy to be sent to the hosts on its path")
+
+	select {
+	case r := <-received:
+		assert.Fail(t, "unexpected message", "host %d received path %d", r[0], r[1])
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -0,0 +1,321 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package vpn
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/MemeLabs/strims/pkg/kademlia"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+	"github.com/petar/GoLLRB/llrb"
+)
+
+const (
+	maxNextHopRoutes      = 4
+	nextHopRouteTTL       = 2 * time.Minute
+	nextHopRTTWeight      = 0.2
+	nextHopLossWeight     = 0.2
+	nextHopLossPenalty    = 10
+	nextHopMaxHealthyLoss = 0.5
+
+	// nextHopUnmeasuredHopRTT is the per hop rtt assumed for routes that have
+	// not been probed yet
+	nextHopUnmeasuredHopRTT = 50 * time.Millisecond
+)
+
+// Route describes a candidate path to a host through one of the network's
+// links. RTT and Loss are measured with probes sent to the target through the
+// next hop. RTT is zero until a probe is acknowledged.
+type Route struct {
+	Target   kademlia.ID
+	NextHop  kademlia.ID
+	Distance int
+	RTT      time.Duration
+	Loss     float64
+	LastSeen timeutil.Time
+}
+
+func newNextHopMap() *nextHopMap {
+	return &nextHopMap{
+		v:      llrb.New(),
+		nextGC: timeutil.Now().Add(nextHopRouteTTL),
+	}
+}
+
+// nextHopMap tracks the routes to hosts observed in the trailers of received
+// messages.
+type nextHopMap struct {
+	mu     sync.Mutex
+	v      *llrb.LLRB
+	nextGC timeutil.Time
+}
+
+func (m *nextHopMap) Insert(target, nextHop kademlia.ID, distance int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := timeutil.Now()
+	m.gc(now)
+
+	var item *nextHopMapItem
+	if v, ok := m.v.Get(&nextHopMapItem{target: target}).(*nextHopMapItem); ok {
+		item = v
+	} else {
+		item = &nextHopMapItem{target: target}
+		m.v.ReplaceOrInsert(item)
+	}
+
+	for _, r := range item.routes {
+		if r.nextHop == nextHop {
+			r.distance = distance
+			r.lastSeen = now
+			return
+		}
+	}
+
+	r := &nextHopRoute{
+		nextHop:  nextHop,
+		distance: distance,
+		lastSeen: now,
+	}
+	// new routes replace the worst existing route so they get probed before
+	// competing with measured routes
+	if len(item.routes) == maxNextHopRoutes {
+		m.sortRoutes(item)
+		item.routes[maxNextHopRoutes-1] = r
+	} else {
+		item.routes = append(item.routes, r)
+	}
+}
+
+// Routes copies the next hops of healthy routes to target into ids ordered
+// from best to worst and returns the number of ids written.
+func (m *nextHopMap) Routes(target kademlia.ID, ids []kademlia.ID) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	item, ok := m.v.Get(&nextHopMapItem{target: target}).(*nextHopMapItem)
+	if !ok {
+		return 0
+	}
+
+	m.sortRoutes(item)
+
+	var n int
+	expired := timeutil.Now().Add(-nextHopRouteTTL)
+	for _, r := range item.routes {
+		if n == len(ids) {
+			break
+		}
+		if r.lastSeen.Before(expired) || item.loss(r) > nextHopMaxHealthyLoss {
+			continue
+		}
+		ids[n] = r.nextHop
+		n++
+	}
+	return n
+}
+
+// ProbeTargets returns up to n unexpired routes that have not been probed
+// since before and marks them as probed at now.
+func (m *nextHopMap) ProbeTargets(now, before timeutil.Time, n int) []Route {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var routes []Route
+	expired := now.Add(-nextHopRouteTTL)
+	m.v.AscendGreaterOrEqual(llrb.Inf(-1), func(i llrb.Item) bool {
+		item := i.(*nextHopMapItem)
+		for _, r := range item.routes {
+			if len(routes) == n {
+				return false
+			}
+			if r.lastSeen.Before(expired) || !r.lastProbe.Before(before) {
+				continue
+			}
+			r.lastProbe = now
+			routes = append(routes, Route{
+				Target:   item.target,
+				NextHop:  r.nextHop,
+				Distance: r.distance,
+			})
+		}
+		return true
+	})
+	return routes
+}
+
+// ReportProbe records the result of a probe sent to target via nextHop. lost
+// probes update the loss estimate, acknowledged probes update both the rtt
+// and the loss estimates.
+func (m *nextHopMap) ReportProbe(target, nextHop kademlia.ID, rtt time.Duration, lost bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	item, ok := m.v.Get(&nextHopMapItem{target: target}).(*nextHopMapItem)
+	if !ok {
+		return
+	}
+	for _, r := range item.routes {
+		if r.nextHop != nextHop {
+			continue
+		}
+
+		var v float64
+		if lost {
+			v = 1
+		} else {
+			item.acked = true
+			// timeutil.Now is truncated so acks from nearby hosts can measure 0
+			// which would be mistaken for an unmeasured route.
+			if rtt < timeutil.Precision {
+				rtt = timeutil.Precision
+			}
+			if r.rtt == 0 {
+				r.rtt = rtt
+			} else {
+				r.rtt = time.Duration(float64(r.rtt)*(1-nextHopRTTWeight) + float64(rtt)*nextHopRTTWeight)
+			}
+		}
+		r.loss = r.loss*(1-nextHopLossWeight) + v*nextHopLossWeight
+		return
+	}
+}
+
+// RemoveNextHop drops all routes via nextHop.
+func (m *nextHopMap) RemoveNextHop(nextHop kademlia.ID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.filter(func(r *nextHopRoute) bool { return r.nextHop != nextHop })
+}
+
+// Targets returns the hosts with unexpired routes.
+func (m *nextHopMap) Targets() []kademlia.ID {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var ids []kademlia.ID
+	expired := timeutil.Now().Add(-nextHopRouteTTL)
+	m.v.AscendGreaterOrEqual(llrb.Inf(-1), func(i llrb.Item) bool {
+		item := i.(*nextHopMapItem)
+		for _, r := range item.routes {
+			if !r.lastSeen.Before(expired) {
+				ids = append(ids, item.target)
+				break
+			}
+		}
+		return true
+	})
+	return ids
+}
+
+// Slice returns a snapshot of the route table.
+func (m *nextHopMap) Slice() []Route {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	routes := []Route{}
+	m.v.AscendGreaterOrEqual(llrb.Inf(-1), func(i llrb.Item) bool {
+		item := i.(*nextHopMapItem)
+		m.sortRoutes(item)
+		for _, r := range item.routes {
+			routes = append(routes, Route{
+				Target:   item.target,
+				NextHop:  r.nextHop,
+				Distance: r.distance,
+				RTT:      r.rtt,
+				Loss:     item.loss(r),
+				LastSeen: r.lastSeen,
+			})
+		}
+		return true
+	})
+	return routes
+}
+
+func (m *nextHopMap) gc(now timeutil.Time) {
+	if now.Before(m.nextGC) {
+		return
+	}
+	m.nextGC = now.Add(nextHopRouteTTL)
+
+	expired := now.Add(-nextHopRouteTTL)
+	m.filter(func(r *nextHopRoute) bool { return !r.lastSeen.Before(expired) })
+}
+
+func (m *nextHopMap) filter(fn func(r *nextHopRoute) bool) {
+	var empty []llrb.Item
+	m.v.AscendGreaterOrEqual(llrb.Inf(-1), func(i llrb.Item) bool {
+		item := i.(*nextHopMapItem)
+		routes := item.routes[:0]
+		for _, r := range item.routes {
+			if fn(r) {
+				routes = append(routes, r)
+			}
+		}
+		for j := len(routes); j < len(item.routes); j++ {
+			item.routes[j] = nil
+		}
+		item.routes = routes
+		if len(routes) == 0 {
+			empty = append(empty, item)
+		}
+		return true
+	})
+	for _, item := range empty {
+		m.v.Delete(item)
+	}
+}
+
+func (m *nextHopMap) sortRoutes(item *nextHopMapItem) {
+	sort.SliceStable(item.routes, func(i, j int) bool {
+		return item.score(item.routes[i]) < item.score(item.routes[j])
+	})
+}
+
+type nextHopRoute struct {
+	nextHop   kademlia.ID
+	distance  int
+	rtt       time.Duration
+	loss      float64
+	lastSeen  timeutil.Time
+	lastProbe timeutil.Time
+}
+
+type nextHopMapItem struct {
+	target kademlia.ID
+	routes []*nextHopRoute
+
+	// acked is set once the target acknowledges a probe. hosts that don't
+	// answer probes would otherwise look unreachable through every route.
+	acked bool
+}
+
+// loss returns the probe loss rate of r if the target answers probes.
+func (h *nextHopMapItem) loss(r *nextHopRoute) float64 {
+	if !h.acked {
+		return 0
+	}
+	return r.loss
+}
+
+// score ranks routes by their measured rtt, or an estimate based on their
+// length if they have not been probed, weighted by their loss rate.
+func (h *nextHopMapItem) score(r *nextHopRoute) float64 {
+	rtt := r.rtt
+	if rtt == 0 {
+		rtt = time.Duration(r.distance+1) * nextHopUnmeasuredHopRTT
+	}
+	return float64(rtt) * (1 + h.loss(r)*nextHopLossPenalty)
+}
+
+func (h *nextHopMapItem) Less(o llrb.Item) bool {
+	if o, ok := o.(*nextHopMapItem); ok {
+		return h.target.Less(o.target)
+	}
+	return !o.Less(h)
+}
@@ -0,0 +1,104 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package vpn
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MemeLabs/strims/pkg/kademlia"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextHopMapRoutes(t *testing.T) {
+	m := newNextHopMap()
+
+	target := kademlia.ID{1}
+	slow := kademlia.ID{2}
+	fast := kademlia.ID{3}
+	lossy := kademlia.ID{4}
+
+	m.Insert(target, slow, 2)
+	m.Insert(target, fast, 2)
+	m.Insert(target, lossy, 1)
+
+	var ids [maxNextHopRoutes]kademlia.ID
+	n := m.Routes(target, ids[:])
+	assert.Equal(t, []kademlia.ID{lossy, slow, fast}, ids[:n], "unprobed routes should be ordered by distance")
+
+	m.ReportProbe(target, slow, 50*time.Millisecond, false)
+	m.ReportProbe(target, fast, 10*time.Millisecond, false)
+	m.ReportProbe(target, lossy, 5*time.Millisecond, false)
+	n = m.Routes(target, ids[:])
+	assert.Equal(t, []kademlia.ID{lossy, fast, slow}, ids[:n], "probed routes should be ordered by rtt")
+
+	for i := 0; i < 10; i++ {
+		m.ReportProbe(target, lossy, 0, true)
+	}
+	n = m.Routes(target, ids[:])
+	assert.Equal(t, []kademlia.ID{fast, slow}, ids[:n], "routes with unacknowledged probes should be skipped")
+
+	m.RemoveNextHop(fast)
+	n = m.Routes(target, ids[:])
+	assert.Equal(t, []kademlia.ID{slow}, ids[:n])
+	assert.Len(t, m.Slice(), 2)
+}
+
+func TestNextHopMapMaxRoutes(t *testing.T) {
+	m := newNextHopMap()
+
+	target := kademlia.ID{1}
+	for i := 0; i < maxNextHopRoutes*2; i++ {
+		nextHop := kademlia.ID{uint64(i + 2)}
+		m.Insert(target, nextHop, 1)
+		m.ReportProbe(target, nextHop, time.Duration(maxNextHopRoutes*2-i)*time.Millisecond, false)
+	}
+
+	var ids [maxNextHopRoutes * 2]kademlia.ID
+	n := m.Routes(target, ids[:])
+	assert.Equal(t, maxNextHopRoutes, n)
+	assert.Equal(t, kademlia.ID{maxNextHopRoutes*2 + 1}, ids[0], "the fastest route should be retained")
+}
+
+func TestNextHopMapIgnoresLossUntilAcked(t *testing.T) {
+	m := newNextHopMap()
+
+	target := kademlia.ID{1}
+	nextHop := kademlia.ID{2}
+	m.Insert(target, nextHop, 1)
+	for i := 0; i < 10; i++ {
+		m.ReportProbe(target, nextHop, 0, true)
+	}
+
+	var ids [maxNextHopRoutes]kademlia.ID
+	n := m.Routes(target, ids[:])
+	assert.Equal(t, []kademlia.ID{nextHop}, ids[:n], "loss should be ignored for hosts that never answer probes")
+}
+
+func TestNextHopMapProbeTargets(t *testing.T) {
+	m := newNextHopMap()
+
+	m.Insert(kademlia.ID{1}, kademlia.ID{3}, 1)
+	m.Insert(kademlia.ID{2}, kademlia.ID{3}, 1)
+
+	now := timeutil.Now()
+	assert.Len(t, m.ProbeTargets(now, now, 1), 1)
+	assert.Len(t, m.ProbeTargets(now, now, 2), 1, "probed routes should be skipped")
+	assert.Len(t, m.ProbeTargets(now.Add(time.Second), now.Add(time.Second), 2), 2)
+}
+
+func TestNextHopMapClampsSubPrecisionRTT(t *testing.T) {
+	m := newNextHopMap()
+
+	target := kademlia.ID{1}
+	nextHop := kademlia.ID{2}
+	m.Insert(target, nextHop, 1)
+	m.ReportProbe(target, nextHop, 0, false)
+
+	routes := m.Slice()
+	require.Len(t, routes, 1)
+	assert.Equal(t, timeutil.Precision, routes[0].RTT, "acked probes should always count as measured")
+}
@@ -0,0 +1,583 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package vpn
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	mrand "math/rand"
+	"sync"
+	"time"
+
+	"github.com/MemeLabs/strims/internal/dao"
+	"github.com/MemeLabs/strims/pkg/apis/type/key"
+	vpnv1 "github.com/MemeLabs/strims/pkg/apis/vpn/v1"
+	"github.com/MemeLabs/strims/pkg/ed25519util"
+	"github.com/MemeLabs/strims/pkg/kademlia"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+	"github.com/MemeLabs/strims/pkg/vnic"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/curve25519"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	onionHopCount      = 3
+	onionCircuitTTL    = 10 * time.Minute
+	onionRouteTTL      = 2 * onionCircuitTTL
+	onionMaxClockSkew  = time.Minute
+	onionSeenSize      = 4096
+	onionSweepInterval = 30 * time.Second
+	onionKeySize       = 32
+)
+
+// errors ...
+var (
+	ErrInsufficientOnionHops = errors.New("not enough peers to build onion circuit")
+	errOnionHostMismatch     = errors.New("onion message host id mismatch")
+	errOnionClockSkew        = errors.New("onion message timestamp out of range")
+	errOnionReservedPort     = errors.New("onion message addressed to reserved port")
+)
+
+// Onion sends messages through circuits of randomly selected network hosts.
+// each hop learns only the previous and next hop so the receiver sees an
+// anonymous endpoint identified by key instead of the sender's host id.
+// replies sent to the endpoint id with Network.Send are routed back through
+// the reply block supplied with the most recent message.
+type Onion interface {
+	Send(key *key.Key, id kademlia.ID, port, srcPort uint16, b []byte) error
+	SendProto(key *key.Key, id kademlia.ID, port, srcPort uint16, msg proto.Message) error
+}
+
+func newOnion(logger *zap.Logger, network *Network) *onion {
+	o := &onion{
+		logger:   logger,
+		network:  network,
+		seen:     newMessageIDLRU(onionSeenSize, onionRouteTTL),
+		circuits: map[onionCircuitKey]*onionCircuit{},
+		replies:  map[onionReplyID]*onionCircuit{},
+	}
+	o.stopSweep = timeutil.DefaultTickEmitter.Subscribe(onionSweepInterval, o.sweep, nil)
+
+	// the network forwards messages addressed to anonymous endpoints to their
+	// reply routes
+	network.onion = o
+
+	return o
+}
+
+type onion struct {
+	logger    *zap.Logger
+	network   *Network
+	stopSweep timeutil.StopFunc
+	seen      *messageIDLRU
+
+	// routes is checked by the network for every outgoing message so it is
+	// kept out of lock
+	routes sync.Map // map[kademlia.ID]*onionReplyRoute
+
+	lock     sync.Mutex
+	circuits map[onionCircuitKey]*onionCircuit
+	replies  map[onionReplyID]*onionCircuit
+}
+
+type onionCircuitKey struct {
+	src, dst kademlia.ID
+}
+
+type onionReplyID [16]byte
+
+// onionCircuit is the sender's state for an anonymous endpoint and
+// destination pair. replyKeys[0] is shared with the destination and the
+// rest are used by the reply hops in order.
+type onionCircuit struct {
+	dst        kademlia.ID
+	hops       []kademlia.ID
+	replyID    onionReplyID
+	replyBlock *vpnv1.OnionReplyBlock
+	replyKeys  [onionHopCount + 1][]byte
+	expires    timeutil.Time
+}
+
+// onionReplyRoute is the receiver's state for an anonymous endpoint.
+type onionReplyRoute struct {
+	block   *vpnv1.OnionReplyBlock
+	key     []byte
+	expires timeutil.Time
+}
+
+// Close ...
+func (o *onion) Close() {
+	o.stopSweep()
+}
+
+func (o *onion) sweep(now timeutil.Time) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	for k, c := range o.circuits {
+		if now.After(c.expires) {
+			delete(o.circuits, k)
+		}
+	}
+	// replies to expired circuits may still be in flight
+	for k, c := range o.replies {
+		if now.After(c.expires.Add(onionCircuitTTL)) {
+			delete(o.replies, k)
+		}
+	}
+	o.routes.Range(func(k, r any) bool {
+		if now.After(r.(*onionReplyRoute).expires) {
+			o.routes.Delete(k)
+		}
+		return true
+	})
+}
+
+// SendProto ...
+func (o *onion) SendProto(key *key.Key, id kademlia.ID, port, srcPort uint16, msg proto.Message) error {
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return o.Send(key, id, port, srcPort, b)
+}
+
+// Send ...
+func (o *onion) Send(key *key.Key, id kademlia.ID, port, srcPort uint16, b []byte) error {
+	src, err := kademlia.UnmarshalID(key.Public)
+	if err != nil {
+		return err
+	}
+
+	c, err := o.circuit(src, id)
+	if err != nil {
+		return err
+	}
+
+	deliver := &vpnv1.OnionHeader_Deliver{
+		HostId:     id.Bytes(nil),
+		Port:       uint32(port),
+		SrcPort:    uint32(srcPort),
+		Body:       b,
+		ReplyBlock: c.replyBlock,
+		ReplyKey:   c.replyKeys[0],
+		Timestamp:  timeutil.Now().UnixNano(),
+	}
+	if err := dao.SignMessage(deliver, key); err != nil {
+		return err
+	}
+
+	header := &vpnv1.OnionHeader{
+		Body: &vpnv1.OnionHeader_Deliver_{
+			Deliver: deliver,
+		},
+	}
+	ephemeralKey, sealed, err := sealOnionPath(c.hops, id, header, nil)
+	if err != nil {
+		return err
+	}
+
+	msg := &vpnv1.OnionMessage{
+		EphemeralKey: ephemeralKey,
+		Header:       sealed,
+	}
+	return o.network.SendProtoWithFlags(c.hops[0], vnic.OnionPort, vnic.OnionPort, msg, MstdFlags)
+}
+
+func (o *onion) circuit(src, dst kademlia.ID) (*onionCircuit, error) {
+	k := onionCircuitKey{src, dst}
+
+	o.lock.Lock()
+	c, ok := o.circuits[k]
+	o.lock.Unlock()
+	if ok && timeutil.Now().Before(c.expires) {
+		return c, nil
+	}
+
+	c, err := o.newCircuit(dst)
+	if err != nil {
+		return nil, err
+	}
+
+	o.lock.Lock()
+	o.circuits[k] = c
+	o.replies[c.replyID] = c
+	o.lock.Unlock()
+
+	return c, nil
+}
+
+func (o *onion) newCircuit(dst kademlia.ID) (*onionCircuit, error) {
+	hops, err := o.selectHops(dst)
+	if err != nil {
+		return nil, err
+	}
+	replyHops, err := o.selectHops(dst)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &onionCircuit{
+		dst:     dst,
+		hops:    hops,
+		expires: timeutil.Now().Add(onionCircuitTTL),
+	}
+	if _, err := rand.Read(c.replyID[:]); err != nil {
+		return nil, err
+	}
+	for i := range c.replyKeys {
+		c.replyKeys[i] = make([]byte, onionKeySize)
+		if _, err := rand.Read(c.replyKeys[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	header := &vpnv1.OnionHeader{
+		Body: &vpnv1.OnionHeader_Receive_{
+			Receive: &vpnv1.OnionHeader_Receive{
+				ReplyId: c.replyID[:],
+			},
+		},
+	}
+	ephemeralKey, sealed, err := sealOnionPath(replyHops, o.network.VNIC().ID(), header, c.replyKeys[1:])
+	if err != nil {
+		return nil, err
+	}
+	c.replyBlock = &vpnv1.OnionReplyBlock{
+		HostId:       replyHops[0].Bytes(nil),
+		EphemeralKey: ephemeralKey,
+		Header:       sealed,
+	}
+
+	return c, nil
+}
+
+// selectHops picks random hosts from the network's routing table excluding
+// dst. at most the first hop is one of the sender's direct links so relays
+// can't infer the sender from their neighbours.
+func (o *onion) selectHops(dst kademlia.ID) ([]kademlia.ID, error) {
+	host := o.network.VNIC().ID()
+
+	o.network.linksLock.Lock()
+	links := o.network.links.Slice()
+	o.network.linksLock.Unlock()
+
+	linked := map[kademlia.ID]struct{}{}
+	direct := make([]kademlia.ID, 0, len(links))
+	for _, l := range links {
+		id := l.ID()
+		linked[id] = struct{}{}
+		if !id.Equals(dst) {
+			direct = append(direct, id)
+		}
+	}
+
+	var remote []kademlia.ID
+	for _, id := range o.network.nextHop.Targets() {
+		if _, ok := linked[id]; !ok && !id.Equals(dst) && !id.Equals(host) {
+			remote = append(remote, id)
+		}
+	}
+
+	mrand.Shuffle(len(remote), func(i, j int) { remote[i], remote[j] = remote[j], remote[i] })
+	if len(remote) >= onionHopCount {
+		return remote[:onionHopCount], nil
+	}
+	if len(remote) < onionHopCount-1 || len(direct) == 0 {
+		return nil, ErrInsufficientOnionHops
+	}
+
+	return append([]kademlia.ID{direct[mrand.Intn(len(direct))]}, remote...), nil
+}
+
+// sendReply sends b to the anonymous endpoint id if a reply route is known.
+func (o *onion) sendReply(id kademlia.ID, port, srcPort uint16, b []byte) (bool, error) {
+	if o == nil {
+		return false, nil
+	}
+
+	v, ok := o.routes.Load(id)
+	if !ok {
+		return false, nil
+	}
+	r := v.(*onionReplyRoute)
+
+	payload, err := proto.Marshal(&vpnv1.OnionPayload{
+		Port:    uint32(port),
+		SrcPort: uint32(srcPort),
+		Body:    b,
+	})
+	if err != nil {
+		return true, err
+	}
+	payload, err = sealOnionPayload(r.key, payload)
+	if err != nil {
+		return true, err
+	}
+
+	next, err := kademlia.UnmarshalID(r.block.HostId)
+	if err != nil {
+		return true, err
+	}
+	msg := &vpnv1.OnionMessage{
+		EphemeralKey: r.block.EphemeralKey,
+		Header:       r.block.Header,
+		Payload:      payload,
+	}
+	return true, o.network.SendProtoWithFlags(next, vnic.OnionPort, vnic.OnionPort, msg, MstdFlags)
+}
+
+// HandleMessage ...
+func (o *onion) HandleMessage(msg *Message) error {
+	var m vpnv1.OnionMessage
+	if err := proto.Unmarshal(msg.Body, &m); err != nil {
+		return err
+	}
+
+	h, err := openOnionLayer(o.network.VNIC().Key(), m.EphemeralKey, m.Header)
+	if err != nil {
+		return err
+	}
+
+	switch b := h.Body.(type) {
+	case *vpnv1.OnionHeader_Relay_:
+		return o.handleRelay(&m, b.Relay)
+	case *vpnv1.OnionHeader_Deliver_:
+		return o.handleDeliver(&m, b.Deliver)
+	case *vpnv1.OnionHeader_Receive_:
+		return o.handleReceive(&m, b.Receive)
+	default:
+		return errors.New("unexpected message type")
+	}
+}
+
+func (o *onion) handleRelay(m *vpnv1.OnionMessage, r *vpnv1.OnionHeader_Relay) error {
+	payload := m.Payload
+	if len(r.PayloadKey) != 0 {
+		// reply blocks are reused for the lifetime of the circuit so each hop
+		// re-encrypts the payload to keep it unlinkable between hops
+		var err error
+		payload, err = sealOnionPayload(r.PayloadKey, payload)
+		if err != nil {
+			return err
+		}
+	} else if !o.seen.Insert(onionMessageID(m.EphemeralKey)) {
+		return nil
+	}
+
+	next, err := kademlia.UnmarshalID(r.HostId)
+	if err != nil {
+		return err
+	}
+	msg := &vpnv1.OnionMessage{
+		EphemeralKey: r.EphemeralKey,
+		Header:       r.Header,
+		Payload:      payload,
+	}
+	return o.network.SendProtoWithFlags(next, vnic.OnionPort, vnic.OnionPort, msg, MstdFlags)
+}
+
+func (o *onion) handleDeliver(m *vpnv1.OnionMessage, d *vpnv1.OnionHeader_Deliver) error {
+	host := o.network.VNIC().ID()
+	if !bytes.Equal(d.HostId, host.Bytes(nil)) {
+		return errOnionHostMismatch
+	}
+	if d.Port < uint32(reservedPortCount) {
+		return errOnionReservedPort
+	}
+	if age := timeutil.Since(timeutil.Unix(0, d.Timestamp)); age > onionMaxClockSkew || age < -onionMaxClockSkew {
+		return errOnionClockSkew
+	}
+	if !o.seen.Insert(onionMessageID(m.EphemeralKey)) {
+		return nil
+	}
+	if err := dao.VerifyMessage(d); err != nil {
+		return err
+	}
+
+	src, err := kademlia.UnmarshalID(d.Key)
+	if err != nil {
+		return err
+	}
+
+	if d.ReplyBlock != nil && len(d.ReplyKey) == onionKeySize {
+		o.routes.Store(src, &onionReplyRoute{
+			block:   d.ReplyBlock,
+			key:     d.ReplyKey,
+			expires: timeutil.Now().Add(onionRouteTTL),
+		})
+	}
+
+	return o.network.callHandler(newOnionMessage(src, host, uint16(d.Port), uint16(d.SrcPort), d.Body))
+}
+
+func (o *onion) handleReceive(m *vpnv1.OnionMessage, r *vpnv1.OnionHeader_Receive) error {
+	var id onionReplyID
+	copy(id[:], r.ReplyId)
+
+	o.lock.Lock()
+	c, ok := o.replies[id]
+	o.lock.Unlock()
+	if !ok {
+		return nil
+	}
+
+	b := m.Payload
+	for i := len(c.replyKeys) - 1; i >= 0; i-- {
+		var err error
+		b, err = openOnionPayload(c.replyKeys[i], b)
+		if err != nil {
+			return err
+		}
+	}
+
+	var p vpnv1.OnionPayload
+	if err := proto.Unmarshal(b, &p); err != nil {
+		return err
+	}
+	if p.Port < uint32(reservedPortCount) {
+		return errOnionReservedPort
+	}
+
+	return o.network.callHandler(newOnionMessage(c.dst, o.network.VNIC().ID(), uint16(p.Port), uint16(p.SrcPort), p.Body))
+}
+
+// newOnionMessage creates a message for local delivery that appears to have
+// been sent directly by src.
+func newOnionMessage(src, dst kademlia.ID, port, srcPort uint16, b []byte) *Message {
+	return &Message{
+		Header: MessageHeader{
+			DstID:   dst,
+			DstPort: port,
+			SrcPort: srcPort,
+			Length:  uint16(len(b)),
+		},
+		Body: b,
+		Trailer: MessageTrailer{
+			Hops: 1,
+			Entries: []MessageTrailerEntry{
+				{
+					HostID: src,
+				},
+			},
+		},
+	}
+}
+
+func onionMessageID(ephemeralKey []byte) (id MessageID) {
+	h := sha256.Sum256(ephemeralKey)
+	copy(id[:], h[:])
+	return
+}
+
+// sealOnionPath wraps header in a layer for dst and relay layers for each of
+// hops in reverse order. if keys is set each relay hop encrypts the message
+// payload with its key before forwarding.
+func sealOnionPath(hops []kademlia.ID, dst kademlia.ID, header *vpnv1.OnionHeader, keys [][]byte) ([]byte, []byte, error) {
+	ephemeralKey, sealed, err := sealOnionLayer(dst, header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	next := dst
+	for i := len(hops) - 1; i >= 0; i-- {
+		relay := &vpnv1.OnionHeader_Relay{
+			HostId:       next.Bytes(nil),
+			EphemeralKey: ephemeralKey,
+			Header:       sealed,
+		}
+		if keys != nil {
+			relay.PayloadKey = keys[i]
+		}
+
+		header := &vpnv1.OnionHeader{
+			Body: &vpnv1.OnionHeader_Relay_{
+				Relay: relay,
+			},
+		}
+		ephemeralKey, sealed, err = sealOnionLayer(hops[i], header)
+		if err != nil {
+			return nil, nil, err
+		}
+		next = hops[i]
+	}
+
+	return ephemeralKey, sealed, nil
+}
+
+// sealOnionLayer encrypts header for the host id using a key derived from a
+// new ephemeral curve25519 key pair.
+func sealOnionLayer(id kademlia.ID, header *vpnv1.OnionHeader) ([]byte, []byte, error) {
+	b, err := proto.Marshal(header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	private := make([]byte, curve25519.ScalarSize)
+	if _, err := rand.Read(private); err != nil {
+		return nil, nil, err
+	}
+	public, err := curve25519.X25519(private, curve25519.Basepoint)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ed25519Public, curve25519Public [32]byte
+	id.Bytes(ed25519Public[:])
+	ed25519util.PublicKeyToCurve25519(&curve25519Public, &ed25519Public)
+
+	secret, err := curve25519.X25519(private, curve25519Public[:])
+	if err != nil {
+		return nil, nil, err
+	}
+	sealed, err := sealOnionPayload(secret, b)
+	if err != nil {
+		return nil, nil, err
+	}
+	return public, sealed, nil
+}
+
+func openOnionLayer(k *key.Key, ephemeralKey, b []byte) (*vpnv1.OnionHeader, error) {
+	var ed25519Private [64]byte
+	var curve25519Private [32]byte
+	copy(ed25519Private[:], k.Private)
+	ed25519util.PrivateKeyToCurve25519(&curve25519Private, &ed25519Private)
+
+	secret, err := curve25519.X25519(curve25519Private[:], ephemeralKey)
+	if err != nil {
+		return nil, err
+	}
+	b, err = openOnionPayload(secret, b)
+	if err != nil {
+		return nil, err
+	}
+
+	header := &vpnv1.OnionHeader{}
+	if err := proto.Unmarshal(b, header); err != nil {
+		return nil, err
+	}
+	return header, nil
+}
+
+func sealOnionPayload(secret, b []byte) ([]byte, error) {
+	c, err := newMessageCipherWithSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+	return c.Seal(make([]byte, 0, len(b)+c.Overhead()), b)
+}
+
+func openOnionPayload(secret, b []byte) ([]byte, error) {
+	c, err := newMessageCipherWithSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) < c.Overhead() {
+		return nil, errors.New("onion payload too short")
+	}
+	return c.Open(nil, b)
+}
@@ -0,0 +1,181 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package vpn
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MemeLabs/strims/internal/dao"
+	"github.com/MemeLabs/strims/pkg/apis/type/key"
+	vpnv1 "github.com/MemeLabs/strims/pkg/apis/vpn/v1"
+	"github.com/MemeLabs/strims/pkg/kademlia"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestOnionHost(t *testing.T) (*key.Key, kademlia.ID) {
+	k, err := dao.GenerateKey()
+	require.NoError(t, err)
+	id, err := kademlia.UnmarshalID(k.Public)
+	require.NoError(t, err)
+	return k, id
+}
+
+func TestOnionPath(t *testing.T) {
+	keys := map[kademlia.ID]*key.Key{}
+	var hops []kademlia.ID
+	for i := 0; i < onionHopCount; i++ {
+		k, id := newTestOnionHost(t)
+		keys[id] = k
+		hops = append(hops, id)
+	}
+	dstKey, dst := newTestOnionHost(t)
+	keys[dst] = dstKey
+
+	payloadKeys := [][]byte{make([]byte, onionKeySize), make([]byte, onionKeySize), make([]byte, onionKeySize)}
+	for i, k := range payloadKeys {
+		k[0] = byte(i + 1)
+	}
+
+	header := &vpnv1.OnionHeader{
+		Body: &vpnv1.OnionHeader_Receive_{
+			Receive: &vpnv1.OnionHeader_Receive{ReplyId: []byte("reply")},
+		},
+	}
+	ephemeralKey, sealed, err := sealOnionPath(hops, dst, header, payloadKeys)
+	require.NoError(t, err)
+
+	payload, err := sealOnionPayload(make([]byte, onionKeySize), []byte("body"))
+	require.NoError(t, err)
+
+	next := hops[0]
+	for i := 0; i < onionHopCount; i++ {
+		h, err := openOnionLayer(keys[next], ephemeralKey, sealed)
+		require.NoError(t, err)
+
+		relay := h.GetRelay()
+		require.NotNil(t, relay)
+		assert.Equal(t, payloadKeys[i], relay.PayloadKey)
+
+		payload, err = sealOnionPayload(relay.PayloadKey, payload)
+		require.NoError(t, err)
+
+		next, err = kademlia.UnmarshalID(relay.HostId)
+		require.NoError(t, err)
+		ephemeralKey, sealed = relay.EphemeralKey, relay.Header
+	}
+	assert.Equal(t, dst, next)
+
+	h, err := openOnionLayer(dstKey, ephemeralKey, sealed)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("reply"), h.GetReceive().GetReplyId())
+
+	_, err = openOnionLayer(keys[hops[0]], ephemeralKey, sealed)
+	assert.Error(t, err, "layers should only open for their own host")
+
+	for i := len(payloadKeys) - 1; i >= 0; i-- {
+		payload, err = openOnionPayload(payloadKeys[i], payload)
+		require.NoError(t, err)
+	}
+	payload, err = openOnionPayload(make([]byte, onionKeySize), payload)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("body"), payload)
+}
+
+// waitForTestRoutes broadcasts from each node in a chain until every node has
+// learned a route to each host it isn't linked to.
+func waitForTestRoutes(t *testing.T, nodes []*Node) {
+	for _, n := range nodes {
+		require.NoError(t, n.Network.Broadcast(testNetworkPort, testNetworkPort, []byte("ping")))
+	}
+	require.Eventually(t, func() bool {
+		for i, n := range nodes {
+			remote := len(nodes) - 1
+			if i > 0 {
+				remote--
+			}
+			if i < len(nodes)-1 {
+				remote--
+			}
+			if len(n.Network.nextHop.Targets()) != remote {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 10*time.Millisecond, "expected nodes to learn routes to every host")
+}
+
+func readTestMessage(t *testing.T, ch <-chan *Message) *Message {
+	select {
+	case m := <-ch:
+		return m
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for message")
+		return nil
+	}
+}
+
+func TestOnionSelectHops(t *testing.T) {
+	nodes := newTestNodes(t, 6)
+	linkTestNodeChain(t, nodes)
+	waitForTestRoutes(t, nodes)
+
+	dst := nodes[5].Host.VNIC().ID()
+	o := nodes[0].Onion.(*onion)
+	for i := 0; i < 10; i++ {
+		hops, err := o.selectHops(dst)
+		require.NoError(t, err)
+		require.Len(t, hops, onionHopCount)
+
+		for j, id := range hops {
+			assert.False(t, id.Equals(dst), "expected hops to exclude the destination")
+			if j > 0 {
+				assert.False(t, nodes[0].Network.HasPeer(id), "expected only the first hop to be a direct link")
+			}
+		}
+	}
+
+	small := newTestNodes(t, 3)
+	linkTestNodeChain(t, small)
+	waitForTestRoutes(t, small)
+
+	_, err := small[0].Onion.(*onion).selectHops(small[2].Host.VNIC().ID())
+	assert.ErrorIs(t, err, ErrInsufficientOnionHops, "expected hosts without enough known routes to fail")
+}
+
+func TestOnionDeliveryAndReply(t *testing.T) {
+	nodes := newTestNodes(t, 5)
+	linkTestNodeChain(t, nodes)
+	waitForTestRoutes(t, nodes)
+
+	const port, srcPort = 2000, 2001
+	sender, receiver := nodes[0], nodes[len(nodes)-1]
+
+	received := make(chan *Message, 1)
+	require.NoError(t, receiver.Network.SetHandler(port, MessageHandlerFunc(func(m *Message) error {
+		received <- m
+		return nil
+	})))
+	replies := make(chan *Message, 1)
+	require.NoError(t, sender.Network.SetHandler(srcPort, MessageHandlerFunc(func(m *Message) error {
+		replies <- m
+		return nil
+	})))
+
+	anonKey, anonID := newTestOnionHost(t)
+	require.NoError(t, sender.Onion.Send(anonKey, receiver.Host.VNIC().ID(), port, srcPort, []byte("hello")))
+
+	m := readTestMessage(t, received)
+	assert.Equal(t, []byte("hello"), m.Body)
+	assert.Equal(t, anonID, m.SrcHostID(), "expected message to appear to come from the anonymous key")
+	assert.EqualValues(t, srcPort, m.Header.SrcPort)
+
+	require.NoError(t, receiver.Network.Send(anonID, srcPort, port, []byte("world")))
+
+	m = readTestMessage(t, replies)
+	assert.Equal(t, []byte("world"), m.Body)
+	assert.Equal(t, receiver.Host.VNIC().ID(), m.SrcHostID(), "expected reply to come from the destination")
+	assert.EqualValues(t, port, m.Header.SrcPort)
+}
@@ -190,7 +190,7 @@ func (s *peerIndex) Search(ctx context.Context, key, salt []byte) (<-chan *PeerI
 			},
 		},
 	}
-	if err := s.network.SendProtoWithFlags(target, vnic.PeerIndexPort, vnic.PeerIndexPort, msg, Mbroadcast); err != nil {
+	if err := s.network.SendProtoWithFlags(target, vnic.PeerIndexPort, vnic.PeerIndexPort, msg, Mbroadcast|Mdisjoint); err != nil {
 		cleanup()
 		return nil, err
 	}
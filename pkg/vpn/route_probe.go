@@ -0,0 +1,176 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package vpn
+
+import (
+	"encoding/binary"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/MemeLabs/strims/pkg/kademlia"
+	"github.com/MemeLabs/strims/pkg/randutil"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+	"github.com/MemeLabs/strims/pkg/vnic"
+	"go.uber.org/zap"
+)
+
+const (
+	routeProbeTickInterval = time.Second
+	routeProbeInterval     = 10 * time.Second
+	routeProbeTimeout      = 5 * time.Second
+	maxRouteProbesPerTick  = 32
+	maxPendingRouteProbes  = 1024
+
+	routeProbeLen = 9
+)
+
+const (
+	routeProbeTypeProbe byte = iota
+	routeProbeTypeAck
+)
+
+var (
+	errNoRoute           = errors.New("no route to next hop")
+	errInvalidRouteProbe = errors.New("invalid route probe")
+)
+
+// newRouteProber measures the rtt and loss of the routes in the network's
+// next hop map. probes are sent to each route's target through its next hop
+// and the target acks them through whichever route it prefers. probes that
+// are not acked before routeProbeTimeout count as lost.
+func newRouteProber(logger *zap.Logger, network *Network) *routeProber {
+	p := &routeProber{
+		logger:  logger,
+		network: network,
+		pending: map[uint64]routeProbe{},
+	}
+	p.stopTick = timeutil.DefaultTickEmitter.Subscribe(routeProbeTickInterval, p.tick, nil)
+
+	network.routeProber = p
+
+	return p
+}
+
+type routeProber struct {
+	logger   *zap.Logger
+	network  *Network
+	stopTick timeutil.StopFunc
+
+	lock    sync.Mutex
+	pending map[uint64]routeProbe
+}
+
+type routeProbe struct {
+	target  kademlia.ID
+	nextHop kademlia.ID
+	sent    timeutil.Time
+}
+
+// Close ...
+func (p *routeProber) Close() {
+	p.stopTick()
+}
+
+func (p *routeProber) tick(now timeutil.Time) {
+	p.expire(now)
+	p.probe(now, now.Add(-routeProbeInterval))
+}
+
+// expire reports probes sent before routeProbeTimeout as lost.
+func (p *routeProber) expire(now timeutil.Time) {
+	var lost []routeProbe
+
+	p.lock.Lock()
+	for nonce, r := range p.pending {
+		if now.Sub(r.sent) >= routeProbeTimeout {
+			lost = append(lost, r)
+			delete(p.pending, nonce)
+		}
+	}
+	p.lock.Unlock()
+
+	for _, r := range lost {
+		p.network.nextHop.ReportProbe(r.target, r.nextHop, 0, true)
+	}
+}
+
+// probe sends probes along routes that have not been probed since before.
+func (p *routeProber) probe(now, before timeutil.Time) {
+	for _, r := range p.network.nextHop.ProbeTargets(now, before, maxRouteProbesPerTick) {
+		nonce, err := p.addPending(routeProbe{r.Target, r.NextHop, now})
+		if err != nil {
+			p.logger.Debug("failed to queue route probe", zap.Error(err))
+			return
+		}
+
+		b := appendRouteProbe(make([]byte, 0, routeProbeLen), routeProbeTypeProbe, nonce)
+		if err := p.network.sendVia(r.NextHop, r.Target, vnic.RouteProbePort, vnic.RouteProbePort, b); err != nil {
+			p.logger.Debug(
+				"failed to send route probe",
+				zap.Stringer("target", r.Target),
+				zap.Stringer("nextHop", r.NextHop),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+func (p *routeProber) addPending(r routeProbe) (uint64, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if len(p.pending) >= maxPendingRouteProbes {
+		return 0, errors.New("too many pending route probes")
+	}
+
+	for {
+		nonce, err := randutil.Uint64()
+		if err != nil {
+			return 0, err
+		}
+		if _, ok := p.pending[nonce]; !ok {
+			p.pending[nonce] = r
+			return nonce, nil
+		}
+	}
+}
+
+// HandleMessage ...
+func (p *routeProber) HandleMessage(msg *Message) error {
+	if len(msg.Body) != routeProbeLen {
+		return errInvalidRouteProbe
+	}
+	nonce := binary.BigEndian.Uint64(msg.Body[1:])
+
+	switch msg.Body[0] {
+	case routeProbeTypeProbe:
+		b := appendRouteProbe(make([]byte, 0, routeProbeLen), routeProbeTypeAck, nonce)
+		return p.network.SendWithFlags(msg.SrcHostID(), vnic.RouteProbePort, vnic.RouteProbePort, b, 0)
+	case routeProbeTypeAck:
+		p.handleAck(msg.SrcHostID(), nonce)
+		return nil
+	default:
+		return errInvalidRouteProbe
+	}
+}
+
+func (p *routeProber) handleAck(src kademlia.ID, nonce uint64) {
+	p.lock.Lock()
+	r, ok := p.pending[nonce]
+	ok = ok && r.target.Equals(src)
+	if ok {
+		delete(p.pending, nonce)
+	}
+	p.lock.Unlock()
+
+	if ok {
+		p.network.nextHop.ReportProbe(r.target, r.nextHop, timeutil.Now().Sub(r.sent), false)
+	}
+}
+
+func appendRouteProbe(b []byte, t byte, nonce uint64) []byte {
+	b = append(b, t)
+	return binary.BigEndian.AppendUint64(b, nonce)
+}
@@ -0,0 +1,59 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package vpn
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MemeLabs/strims/pkg/kademlia"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+	"github.com/MemeLabs/strims/pkg/vnic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func findTestRoute(n *Node, target kademlia.ID) (Route, bool) {
+	for _, r := range n.Network.Routes() {
+		if r.Target.Equals(target) {
+			return r, true
+		}
+	}
+	return Route{}, false
+}
+
+func TestRouteProberMeasuresRTTAndLoss(t *testing.T) {
+	nodes := newTestNodes(t, 3)
+	linkTestNodeChain(t, nodes)
+	waitForTestRoutes(t, nodes)
+
+	src, dst := nodes[0], nodes[2]
+	target := dst.Host.VNIC().ID()
+	prober := src.Network.routeProber
+
+	now := timeutil.Now()
+	prober.probe(now, now.Add(time.Hour))
+	require.Eventually(t, func() bool {
+		r, ok := findTestRoute(src, target)
+		return ok && r.RTT > 0
+	}, 5*time.Second, 10*time.Millisecond, "expected acknowledged probes to measure rtt")
+
+	r, _ := findTestRoute(src, target)
+	assert.Equal(t, nodes[1].Host.VNIC().ID(), r.NextHop)
+	assert.Equal(t, 0.0, r.Loss)
+
+	// stop acking so the next probes go unanswered
+	dst.Network.RemoveHandler(vnic.RouteProbePort)
+	for i := 0; i < 10; i++ {
+		now = timeutil.Now()
+		prober.probe(now, now.Add(time.Hour))
+		prober.expire(now.Add(routeProbeTimeout))
+	}
+
+	r, _ = findTestRoute(src, target)
+	assert.Greater(t, r.Loss, nextHopMaxHealthyLoss, "expected unacknowledged probes to count as loss")
+
+	var ids [maxNextHopRoutes]kademlia.ID
+	assert.Equal(t, 0, src.Network.nextHop.Routes(target, ids[:]), "expected lossy routes to be skipped")
+}
@@ -0,0 +1,814 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package vpn
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/MemeLabs/strims/pkg/kademlia"
+	"github.com/MemeLabs/strims/pkg/ledbat"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+)
+
+const (
+	streamMSS            = 1024
+	streamSendBufferSize = 256
+	streamRecvWindow     = 256
+	streamMaxSACKBlocks  = 4
+	streamDupThreshold   = 3
+	streamMaxRetransmits = 10
+	streamLingerTimeout  = 10 * time.Second
+	streamTimeWait       = 30 * time.Second
+	streamAcceptBacklog  = 16
+	streamHeaderLen      = 1 + 4 + 4 + 2 + 8 + 8 + 1
+	streamSACKBlockLen   = 8
+)
+
+const (
+	streamFlagSYN uint8 = 1 << iota
+	streamFlagFIN
+	streamFlagACK
+)
+
+// errors ...
+var (
+	ErrStreamTimeout = errors.New("stream retransmit limit exceeded")
+	errShortSegment  = errors.New("stream segment too short")
+)
+
+// StreamAddr ...
+type StreamAddr struct {
+	HostID kademlia.ID
+	Port   uint16
+}
+
+// Network ...
+func (a StreamAddr) Network() string {
+	return "vpn"
+}
+
+// String ...
+func (a StreamAddr) String() string {
+	return fmt.Sprintf("%s:%d", a.HostID, a.Port)
+}
+
+// seqLess compares sequence numbers allowing for wraparound.
+func seqLess(a, b uint32) bool {
+	return int32(a-b) < 0
+}
+
+type streamRange struct {
+	start, end uint32
+}
+
+type streamSegment struct {
+	flags  uint8
+	seq    uint32
+	ack    uint32
+	window uint16
+	time   int64
+	delay  int64
+	sack   []streamRange
+	data   []byte
+}
+
+// consumesSeq returns true for segments that are delivered reliably. pure
+// acks are not retransmitted and do not use sequence numbers.
+func (s *streamSegment) consumesSeq() bool {
+	return len(s.data) != 0 || s.flags&(streamFlagSYN|streamFlagFIN) != 0
+}
+
+func (s *streamSegment) Size() int {
+	return streamHeaderLen + len(s.sack)*streamSACKBlockLen + len(s.data)
+}
+
+func (s *streamSegment) Marshal(b []byte) int {
+	b[0] = s.flags
+	binary.BigEndian.PutUint32(b[1:], s.seq)
+	binary.BigEndian.PutUint32(b[5:], s.ack)
+	binary.BigEndian.PutUint16(b[9:], s.window)
+	binary.BigEndian.PutUint64(b[11:], uint64(s.time))
+	binary.BigEndian.PutUint64(b[19:], uint64(s.delay))
+	b[27] = uint8(len(s.sack))
+	n := streamHeaderLen
+	for _, r := range s.sack {
+		binary.BigEndian.PutUint32(b[n:], r.start)
+		binary.BigEndian.PutUint32(b[n+4:], r.end)
+		n += streamSACKBlockLen
+	}
+	n += copy(b[n:], s.data)
+	return n
+}
+
+func (s *streamSegment) Unmarshal(b []byte) error {
+	if len(b) < streamHeaderLen {
+		return errShortSegment
+	}
+	s.flags = b[0]
+	s.seq = binary.BigEndian.Uint32(b[1:])
+	s.ack = binary.BigEndian.Uint32(b[5:])
+	s.window = binary.BigEndian.Uint16(b[9:])
+	s.time = int64(binary.BigEndian.Uint64(b[11:]))
+	s.delay = int64(binary.BigEndian.Uint64(b[19:]))
+	n := streamHeaderLen
+	sackLen := int(b[27])
+	if sackLen > streamMaxSACKBlocks || len(b) < n+sackLen*streamSACKBlockLen {
+		return errShortSegment
+	}
+	s.sack = make([]streamRange, sackLen)
+	for i := range s.sack {
+		s.sack[i].start = binary.BigEndian.Uint32(b[n:])
+		s.sack[i].end = binary.BigEndian.Uint32(b[n+4:])
+		n += streamSACKBlockLen
+	}
+	s.data = b[n:]
+	return nil
+}
+
+type streamSendSegment struct {
+	*streamSegment
+	sentAt timeutil.Time
+	sends  int
+	sacked bool
+}
+
+// DialStream opens a reliable ordered stream to a StreamListener on the
+// host dstID.
+func DialStream(ctx context.Context, network *Network, dstID kademlia.ID, dstPort uint16) (*Stream, error) {
+	port, err := network.ReservePort()
+	if err != nil {
+		return nil, err
+	}
+
+	localAddr := StreamAddr{network.VNIC().ID(), port}
+	remoteAddr := StreamAddr{dstID, dstPort}
+	s := newStream(localAddr, remoteAddr, newStreamSendFunc(network, localAddr, remoteAddr), func() {
+		network.RemoveHandler(port)
+		network.ReleasePort(port)
+	})
+	if err := network.SetHandler(port, s); err != nil {
+		s.teardown(err)
+		return nil, err
+	}
+	s.flush()
+
+	if err := s.waitEstablished(ctx); err != nil {
+		s.teardown(err)
+		return nil, err
+	}
+	return s, nil
+}
+
+func newStreamSendFunc(network *Network, localAddr, remoteAddr StreamAddr) func(b []byte) error {
+	return func(b []byte) error {
+		return network.SendWithFlags(remoteAddr.HostID, remoteAddr.Port, localAddr.Port, b, MstdFlags)
+	}
+}
+
+func newStream(localAddr, remoteAddr StreamAddr, send func(b []byte) error, done func()) *Stream {
+	s := &Stream{
+		send:       send,
+		localAddr:  localAddr,
+		remoteAddr: remoteAddr,
+		done:       done,
+		changed:    make(chan struct{}),
+		ledbat:     ledbat.New(),
+		peerWindow: 1,
+		recvOOO:    map[uint32]*streamSegment{},
+	}
+
+	s.enqueue(&streamSegment{flags: streamFlagSYN})
+	s.stopTicker = timeutil.DefaultTickEmitter.DefaultSubscribe(s.tick)
+
+	return s
+}
+
+// Stream is a reliable ordered byte stream over vpn messages. segments are
+// acknowledged with cumulative acks and SACK blocks, lost segments are
+// retransmitted and the send rate is limited by the peer's receive window
+// and a LEDBAT congestion controller.
+type Stream struct {
+	send       func(b []byte) error
+	localAddr  StreamAddr
+	remoteAddr StreamAddr
+	done       func()
+	stopTicker timeutil.StopFunc
+
+	lock          sync.Mutex
+	changed       chan struct{}
+	err           error
+	established   bool
+	closed        bool
+	finSent       bool
+	finAcked      bool
+	finReceived   bool
+	closeTime     timeutil.Time
+	readDeadline  time.Time
+	writeDeadline time.Time
+
+	ledbat     *ledbat.Controller
+	sendQueue  []*streamSendSegment
+	sendNext   uint32
+	peerWindow uint16
+
+	recvNext  uint32
+	recvOOO   map[uint32]*streamSegment
+	readBuf   []byte
+	lastDelay int64
+	ackNeeded bool
+}
+
+// notify wakes goroutines blocked in Read, Write or Dial. the lock must be
+// held.
+func (s *Stream) notify() {
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// wait blocks until the stream state changes or the deadline passes. the lock
+// must be held and is released while waiting.
+func (s *Stream) wait(deadline time.Time) error {
+	ch := s.changed
+	s.lock.Unlock()
+	defer s.lock.Lock()
+
+	var timeout <-chan time.Time
+	if !deadline.IsZero() {
+		d := time.Until(deadline)
+		if d <= 0 {
+			return os.ErrDeadlineExceeded
+		}
+		t := time.NewTimer(d)
+		defer t.Stop()
+		timeout = t.C
+	}
+
+	select {
+	case <-ch:
+		return nil
+	case <-timeout:
+		return os.ErrDeadlineExceeded
+	}
+}
+
+func (s *Stream) waitEstablished(ctx context.Context) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for !s.established {
+		if s.err != nil {
+			return s.err
+		}
+
+		ch := s.changed
+		s.lock.Unlock()
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			s.lock.Lock()
+			return ctx.Err()
+		}
+		s.lock.Lock()
+	}
+	return nil
+}
+
+// Read ...
+func (s *Stream) Read(b []byte) (int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for len(s.readBuf) == 0 {
+		if s.closed {
+			return 0, net.ErrClosed
+		}
+		if s.finReceived {
+			return 0, io.EOF
+		}
+		if s.err != nil {
+			return 0, s.err
+		}
+		if err := s.wait(s.readDeadline); err != nil {
+			return 0, err
+		}
+	}
+
+	prevWindow := s.recvWindow()
+	n := copy(b, s.readBuf)
+	s.readBuf = s.readBuf[n:]
+
+	// reopening a closed window has to be announced to unblock the sender
+	if prevWindow == 0 && s.recvWindow() != 0 {
+		s.ackNeeded = true
+		go s.flush()
+	}
+
+	return n, nil
+}
+
+// Write ...
+func (s *Stream) Write(b []byte) (int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var n int
+	for n < len(b) {
+		if s.closed || s.finSent {
+			return n, net.ErrClosed
+		}
+		if s.err != nil {
+			return n, s.err
+		}
+
+		if len(s.sendQueue) >= streamSendBufferSize {
+			if err := s.wait(s.writeDeadline); err != nil {
+				return n, err
+			}
+			continue
+		}
+
+		size := len(b) - n
+		if size > streamMSS {
+			size = streamMSS
+		}
+		data := make([]byte, size)
+		copy(data, b[n:])
+		s.enqueue(&streamSegment{data: data})
+		n += size
+
+		s.lock.Unlock()
+		s.flush()
+		s.lock.Lock()
+	}
+	return n, nil
+}
+
+// Close ...
+func (s *Stream) Close() error {
+	s.lock.Lock()
+	if s.closed {
+		s.lock.Unlock()
+		return nil
+	}
+	s.closed = true
+	s.closeTime = timeutil.Now()
+	if !s.finSent && s.err == nil {
+		s.finSent = true
+		s.enqueue(&streamSegment{flags: streamFlagFIN})
+	}
+	s.notify()
+	s.lock.Unlock()
+
+	s.flush()
+	return nil
+}
+
+// LocalAddr ...
+func (s *Stream) LocalAddr() net.Addr {
+	return s.localAddr
+}
+
+// RemoteAddr ...
+func (s *Stream) RemoteAddr() net.Addr {
+	return s.remoteAddr
+}
+
+// SetDeadline ...
+func (s *Stream) SetDeadline(t time.Time) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.readDeadline = t
+	s.writeDeadline = t
+	s.notify()
+	return nil
+}
+
+// SetReadDeadline ...
+func (s *Stream) SetReadDeadline(t time.Time) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.readDeadline = t
+	s.notify()
+	return nil
+}
+
+// SetWriteDeadline ...
+func (s *Stream) SetWriteDeadline(t time.Time) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.writeDeadline = t
+	s.notify()
+	return nil
+}
+
+// enqueue assigns the next sequence number to seg and adds it to the send
+// queue. the lock must be held.
+func (s *Stream) enqueue(seg *streamSegment) {
+	seg.seq = s.sendNext
+	s.sendNext++
+	s.sendQueue = append(s.sendQueue, &streamSendSegment{streamSegment: seg})
+}
+
+func (s *Stream) recvWindow() uint16 {
+	used := len(s.recvOOO) + (len(s.readBuf)+streamMSS-1)/streamMSS
+	if used >= streamRecvWindow {
+		return 0
+	}
+	return uint16(streamRecvWindow - used)
+}
+
+// sackBlocks returns ranges of out of order segments received past recvNext.
+func (s *Stream) sackBlocks() []streamRange {
+	if len(s.recvOOO) == 0 {
+		return nil
+	}
+
+	seqs := make([]uint32, 0, len(s.recvOOO))
+	for seq := range s.recvOOO {
+		seqs = append(seqs, seq)
+	}
+	sort.Slice(seqs, func(i, j int) bool { return seqLess(seqs[i], seqs[j]) })
+
+	var blocks []streamRange
+	for _, seq := range seqs {
+		if n := len(blocks); n != 0 && blocks[n-1].end == seq {
+			blocks[n-1].end++
+			continue
+		}
+		if len(blocks) == streamMaxSACKBlocks {
+			break
+		}
+		blocks = append(blocks, streamRange{seq, seq + 1})
+	}
+	return blocks
+}
+
+// HandleMessage ...
+func (s *Stream) HandleMessage(msg *Message) error {
+	if msg.SrcHostID() != s.remoteAddr.HostID || msg.Header.SrcPort != s.remoteAddr.Port {
+		return nil
+	}
+
+	return s.handleSegment(msg.Body)
+}
+
+func (s *Stream) handleSegment(b []byte) error {
+	var seg streamSegment
+	if err := seg.Unmarshal(b); err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	if seg.flags&streamFlagACK != 0 {
+		s.handleAck(&seg)
+	}
+	if seg.consumesSeq() {
+		s.handleData(&seg)
+	}
+	s.notify()
+	s.lock.Unlock()
+
+	s.flush()
+	return nil
+}
+
+func (s *Stream) handleAck(seg *streamSegment) {
+	now := timeutil.Now()
+	s.peerWindow = seg.window
+
+	var acked int
+	var rtt time.Duration
+	for len(s.sendQueue) != 0 && seqLess(s.sendQueue[0].seq, seg.ack) {
+		ss := s.sendQueue[0]
+		s.sendQueue[0] = nil
+		s.sendQueue = s.sendQueue[1:]
+
+		if ss.sends == 0 {
+			continue
+		}
+		if !ss.sacked {
+			acked += ss.Size()
+		}
+		// karn's algorithm: only sample segments that were not retransmitted
+		if ss.sends == 1 {
+			rtt = now.Sub(ss.sentAt)
+		}
+		if ss.flags&streamFlagSYN != 0 {
+			s.established = true
+		}
+		if ss.flags&streamFlagFIN != 0 {
+			s.finAcked = true
+		}
+	}
+
+	for _, r := range seg.sack {
+		for _, ss := range s.sendQueue {
+			if !seqLess(ss.seq, r.start) && seqLess(ss.seq, r.end) && ss.sends != 0 && !ss.sacked {
+				ss.sacked = true
+				acked += ss.Size()
+			}
+		}
+	}
+
+	if acked != 0 {
+		s.ledbat.AddDelaySample(time.Duration(seg.delay), acked)
+		if rtt != 0 {
+			s.ledbat.AddRTTSample(rtt)
+		}
+		s.ledbat.DigestDelaySamples()
+	}
+
+	// segments followed by enough sacked segments are presumed lost
+	var sacked int
+	for i := len(s.sendQueue) - 1; i >= 0; i-- {
+		ss := s.sendQueue[i]
+		if ss.sacked {
+			sacked++
+			continue
+		}
+		if ss.sends > 0 && sacked >= streamDupThreshold && now.Sub(ss.sentAt) > s.ledbat.RTTMean() {
+			s.ledbat.AddDataLoss(ss.Size(), true)
+			ss.sends = -ss.sends
+		}
+	}
+}
+
+func (s *Stream) handleData(seg *streamSegment) {
+	s.ackNeeded = true
+	s.lastDelay = timeutil.Now().UnixNano() - seg.time
+
+	if seqLess(seg.seq, s.recvNext) {
+		return
+	}
+	if seg.seq != s.recvNext && int(seg.seq-s.recvNext) >= int(s.recvWindow()) {
+		return
+	}
+
+	if _, ok := s.recvOOO[seg.seq]; !ok {
+		data := make([]byte, len(seg.data))
+		copy(data, seg.data)
+		s.recvOOO[seg.seq] = &streamSegment{flags: seg.flags, seq: seg.seq, data: data}
+	}
+
+	for {
+		next, ok := s.recvOOO[s.recvNext]
+		if !ok {
+			break
+		}
+		delete(s.recvOOO, s.recvNext)
+		s.recvNext++
+
+		if s.finReceived {
+			continue
+		}
+		s.readBuf = append(s.readBuf, next.data...)
+		if next.flags&streamFlagFIN != 0 {
+			s.finReceived = true
+		}
+	}
+}
+
+func (s *Stream) tick(now timeutil.Time) {
+	s.lock.Lock()
+	s.ledbat.DigestDelaySamples()
+
+	// retransmit the oldest unacknowledged segment after the congestion timeout
+	for _, ss := range s.sendQueue {
+		if ss.sacked || ss.sends <= 0 {
+			continue
+		}
+		if now.Sub(ss.sentAt) > s.ledbat.CTO() {
+			// a closed receive window is probed indefinitely
+			if ss.sends > streamMaxRetransmits && s.peerWindow != 0 {
+				s.lock.Unlock()
+				s.teardown(ErrStreamTimeout)
+				return
+			}
+			s.ledbat.AddDataLoss(ss.Size(), true)
+			ss.sends = -ss.sends
+		}
+		break
+	}
+
+	complete := s.finAcked && (s.finReceived || s.err != nil)
+	lingering := s.closed && now.Sub(s.closeTime) > streamLingerTimeout
+	s.lock.Unlock()
+
+	if complete || lingering {
+		s.teardown(nil)
+		return
+	}
+	s.flush()
+}
+
+// flush transmits queued segments permitted by the congestion and receive
+// windows along with retransmissions and pending acks.
+func (s *Stream) flush() {
+	s.lock.Lock()
+	if s.err != nil {
+		s.lock.Unlock()
+		return
+	}
+
+	now := timeutil.Now()
+	var segs []*streamSegment
+	var una uint32
+	if len(s.sendQueue) != 0 {
+		una = s.sendQueue[0].seq
+	}
+	for i, ss := range s.sendQueue {
+		if ss.sacked || ss.sends > 0 {
+			continue
+		}
+
+		// retransmissions (negative send counts) bypass the congestion window.
+		// the first unacknowledged segment is always allowed so a closed
+		// receive window is probed
+		if ss.sends == 0 {
+			if i != 0 && (s.ledbat.FlightSize()+ss.Size() > s.ledbat.CWND() || int(ss.seq-una) >= int(s.peerWindow)) {
+				break
+			}
+			s.ledbat.AddSent(ss.Size())
+		}
+
+		ss.sends = -ss.sends + 1
+		ss.sentAt = now
+		segs = append(segs, ss.streamSegment)
+	}
+
+	var ack *streamSegment
+	if len(segs) == 0 && s.ackNeeded {
+		ack = &streamSegment{seq: s.sendNext}
+		segs = append(segs, ack)
+	}
+
+	bufs := make([][]byte, len(segs))
+	for i, seg := range segs {
+		// ack fields are refreshed each time a segment is sent
+		seg.flags |= streamFlagACK
+		seg.ack = s.recvNext
+		seg.window = s.recvWindow()
+		seg.time = now.UnixNano()
+		seg.delay = s.lastDelay
+		seg.sack = s.sackBlocks()
+
+		bufs[i] = make([]byte, seg.Size())
+		seg.Marshal(bufs[i])
+	}
+	if len(segs) != 0 {
+		s.ackNeeded = false
+	}
+	s.lock.Unlock()
+
+	for _, b := range bufs {
+		s.send(b)
+	}
+}
+
+// teardown releases the stream's resources. err is reported to blocked and
+// future reads and writes.
+func (s *Stream) teardown(err error) {
+	s.lock.Lock()
+	if s.done == nil {
+		s.lock.Unlock()
+		return
+	}
+	done := s.done
+	s.done = nil
+	if s.err == nil {
+		s.err = err
+		if s.err == nil {
+			s.err = net.ErrClosed
+		}
+	}
+	s.notify()
+	s.lock.Unlock()
+
+	if s.stopTicker != nil {
+		s.stopTicker()
+	}
+	done()
+}
+
+// ListenStream accepts streams opened with DialStream on port.
+func ListenStream(ctx context.Context, network *Network, port uint16) (*StreamListener, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	l := &StreamListener{
+		ctx:     ctx,
+		cancel:  cancel,
+		network: network,
+		addr:    StreamAddr{network.VNIC().ID(), port},
+		streams: map[StreamAddr]*Stream{},
+		closed:  map[StreamAddr]timeutil.Time{},
+		accept:  make(chan *Stream, streamAcceptBacklog),
+	}
+
+	if err := network.SetHandler(port, l); err != nil {
+		cancel()
+		return nil, err
+	}
+
+	go func() {
+		<-ctx.Done()
+		network.RemoveHandler(port)
+	}()
+
+	return l, nil
+}
+
+// StreamListener ...
+type StreamListener struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	network *Network
+	addr    StreamAddr
+	lock    sync.Mutex
+	streams map[StreamAddr]*Stream
+	// closed holds the close time of recently closed streams. delayed or
+	// retransmitted SYNs from their peers are dropped instead of reopening
+	// them.
+	closed map[StreamAddr]timeutil.Time
+	accept chan *Stream
+}
+
+// Accept ...
+func (l *StreamListener) Accept() (net.Conn, error) {
+	select {
+	case s := <-l.accept:
+		return s, nil
+	case <-l.ctx.Done():
+		return nil, net.ErrClosed
+	}
+}
+
+// Close ...
+func (l *StreamListener) Close() error {
+	l.cancel()
+	return nil
+}
+
+// Addr ...
+func (l *StreamListener) Addr() net.Addr {
+	return l.addr
+}
+
+// HandleMessage ...
+func (l *StreamListener) HandleMessage(msg *Message) error {
+	addr := StreamAddr{msg.SrcHostID(), msg.Header.SrcPort}
+
+	l.lock.Lock()
+	s, ok := l.streams[addr]
+	if !ok {
+		var seg streamSegment
+		if err := seg.Unmarshal(msg.Body); err != nil {
+			l.lock.Unlock()
+			return err
+		}
+		if seg.flags&streamFlagSYN == 0 || seg.seq != 0 || l.ctx.Err() != nil || l.recentlyClosed(addr) {
+			l.lock.Unlock()
+			return nil
+		}
+
+		s = newStream(l.addr, addr, newStreamSendFunc(l.network, l.addr, addr), func() {
+			l.lock.Lock()
+			delete(l.streams, addr)
+			l.closed[addr] = timeutil.Now()
+			l.lock.Unlock()
+		})
+
+		select {
+		case l.accept <- s:
+			l.streams[addr] = s
+		default:
+			l.lock.Unlock()
+			s.teardown(errors.New("stream accept backlog full"))
+			return nil
+		}
+	}
+	l.lock.Unlock()
+
+	return s.HandleMessage(msg)
+}
+
+// recentlyClosed returns true if a stream from addr closed within the time
+// wait period. expired entries are removed. the lock must be held.
+func (l *StreamListener) recentlyClosed(addr StreamAddr) bool {
+	now := timeutil.Now()
+	for a, t := range l.closed {
+		if now.Sub(t) > streamTimeWait {
+			delete(l.closed, a)
+		}
+	}
+	_, ok := l.closed[addr]
+	return ok
+}
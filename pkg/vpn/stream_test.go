@@ -0,0 +1,193 @@
+// Copyright 2022 Strims contributors
+// SPDX-License-Identifier: AGPL-3.0-only
+
+package vpn
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MemeLabs/protobuf/pkg/rpc"
+	vpnv1 "github.com/MemeLabs/strims/pkg/apis/vpn/v1"
+	"github.com/MemeLabs/strims/pkg/timeutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestStreamPair(loss float64) (a, b *Stream) {
+	var lock sync.Mutex
+	rng := rand.New(rand.NewSource(1))
+	pipe := func(dst **Stream) func(p []byte) error {
+		return func(p []byte) error {
+			lock.Lock()
+			drop := rng.Float64() < loss
+			lock.Unlock()
+			if !drop {
+				p = append([]byte(nil), p...)
+				go (*dst).handleSegment(p)
+			}
+			return nil
+		}
+	}
+
+	a = newStream(StreamAddr{Port: 1}, StreamAddr{Port: 2}, pipe(&b), func() {})
+	b = newStream(StreamAddr{Port: 2}, StreamAddr{Port: 1}, pipe(&a), func() {})
+	a.flush()
+	b.flush()
+	return
+}
+
+func TestStreamSegmentMarshal(t *testing.T) {
+	src := &streamSegment{
+		flags:  streamFlagACK | streamFlagFIN,
+		seq:    10,
+		ack:    20,
+		window: 30,
+		time:   40,
+		delay:  -50,
+		sack:   []streamRange{{22, 24}, {26, 27}},
+		data:   []byte("data"),
+	}
+	b := make([]byte, src.Size())
+	assert.Equal(t, len(b), src.Marshal(b))
+
+	var dst streamSegment
+	require.NoError(t, dst.Unmarshal(b))
+	assert.Equal(t, src, &dst)
+}
+
+func TestStreamSeqLess(t *testing.T) {
+	assert.True(t, seqLess(1, 2))
+	assert.False(t, seqLess(2, 1))
+	assert.True(t, seqLess(0xffffffff, 0))
+}
+
+func TestStreamLossyTransfer(t *testing.T) {
+	a, b := newTestStreamPair(0.05)
+
+	src := make([]byte, 64*1024)
+	rand.New(rand.NewSource(2)).Read(src)
+
+	go func() {
+		a.Write(src)
+		a.Close()
+	}()
+
+	b.SetReadDeadline(time.Now().Add(30 * time.Second))
+	dst, err := io.ReadAll(b)
+	require.NoError(t, err)
+	assert.True(t, bytes.Equal(src, dst))
+
+	b.Close()
+}
+
+func TestStreamReadDeadline(t *testing.T) {
+	a, b := newTestStreamPair(0)
+	defer a.Close()
+	defer b.Close()
+
+	b.SetReadDeadline(time.Now().Add(10 * time.Millisecond))
+	_, err := b.Read(make([]byte, 1))
+	assert.ErrorIs(t, err, os.ErrDeadlineExceeded)
+}
+
+func TestStreamRPC(t *testing.T) {
+	nodes := newTestNodes(t, 4)
+	linkTestNodeChain(t, nodes)
+	waitForTestRoutes(t, nodes)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const port = 2000
+	client, server := nodes[0], nodes[len(nodes)-1]
+
+	l, err := ListenStream(ctx, server.Network, port)
+	require.NoError(t, err)
+	defer l.Close()
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		s := rpc.NewServer(zap.NewNop(), &rpc.RWDialer{Logger: zap.NewNop(), ReadWriter: conn})
+		s.RegisterMethod("test.Echo", func(ctx context.Context, req *vpnv1.NetworkAddress) (*vpnv1.NetworkAddress, error) {
+			return req, nil
+		})
+		s.Listen(ctx)
+	}()
+
+	s, err := DialStream(ctx, client.Network, server.Host.VNIC().ID(), port)
+	require.NoError(t, err)
+	defer s.Close()
+
+	c, err := rpc.NewClient(zap.NewNop(), &rpc.RWDialer{Logger: zap.NewNop(), ReadWriter: s})
+	require.NoError(t, err)
+	defer c.Close()
+
+	for i := 0; i < 10; i++ {
+		req := &vpnv1.NetworkAddress{HostId: bytes.Repeat([]byte{byte(i)}, 4*1024), Port: uint32(i)}
+		res := &vpnv1.NetworkAddress{}
+		require.NoError(t, c.CallUnary(ctx, "test.Echo", req, res))
+		assert.True(t, proto.Equal(req, res), "expected echoed response to match request")
+	}
+}
+
+func TestStreamListenerDropsSYNAfterClose(t *testing.T) {
+	nodes := newTestNodes(t, 2)
+	linkTestNodes(t, nodes[0], nodes[1])
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const port = 2000
+	l, err := ListenStream(ctx, nodes[1].Network, port)
+	require.NoError(t, err)
+	defer l.Close()
+
+	s, err := DialStream(ctx, nodes[0].Network, nodes[1].Host.VNIC().ID(), port)
+	require.NoError(t, err)
+	defer s.Close()
+
+	conn, err := l.Accept()
+	require.NoError(t, err)
+	conn.(*Stream).teardown(nil)
+
+	addr := s.LocalAddr().(StreamAddr)
+	l.lock.Lock()
+	_, open := l.streams[addr]
+	l.lock.Unlock()
+	require.False(t, open, "expected stream to be removed on teardown")
+
+	syn := &streamSegment{flags: streamFlagSYN}
+	b := make([]byte, syn.Size())
+	syn.Marshal(b)
+	require.NoError(t, nodes[0].Network.Send(nodes[1].Host.VNIC().ID(), port, addr.Port, b))
+
+	select {
+	case <-l.accept:
+		assert.Fail(t, "expected SYN for recently closed stream to be dropped")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	l.lock.Lock()
+	l.closed[addr] = timeutil.Now().Add(-streamTimeWait - time.Second)
+	l.lock.Unlock()
+	require.NoError(t, nodes[0].Network.Send(nodes[1].Host.VNIC().ID(), port, addr.Port, b))
+
+	select {
+	case s := <-l.accept:
+		s.teardown(nil)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "expected SYN to be accepted after the time wait period")
+	}
+}
@@ -19,6 +19,7 @@ import { NetworkFrontendClient } from "./strims/network/v1/network_rpc";
 import { NotificationFrontendClient } from "./strims/notification/v1/notification_rpc";
 import { ProfileFrontendClient } from "./strims/profile/v1/profile_rpc";
 import { ReplicationFrontendClient } from "./strims/replication/v1/replication_rpc";
+import { TransferFrontendClient } from "./strims/transfer/v1/transfer_rpc";
 import { CaptureClient as VideoCaptureClient } from "./strims/video/v1/capture_rpc";
 import { VideoChannelFrontendClient } from "./strims/video/v1/channel_rpc";
 import { EgressClient as VideoEgressClient } from "./strims/video/v1/egress_rpc";
@@ -38,6 +39,7 @@ export class FrontendClient {
   public notification: NotificationFrontendClient;
   public profile: ProfileFrontendClient;
   public replication: ReplicationFrontendClient;
+  public transfer: TransferFrontendClient;
   public videoCapture: VideoCaptureClient;
   public videoChannel: VideoChannelFrontendClient;
   public videoEgress: VideoEgressClient;
@@ -58,6 +60,7 @@ export class FrontendClient {
     this.notification = new NotificationFrontendClient(host);
     this.profile = new ProfileFrontendClient(host);
     this.replication = new ReplicationFrontendClient(host);
+    this.transfer = new TransferFrontendClient(host);
     this.videoCapture = new VideoCaptureClient(host);
     this.videoChannel = new VideoChannelFrontendClient(host);
     this.videoEgress = new VideoEgressClient(host);
@@ -4,18 +4,26 @@ import Writer from "@memelabs/protobuf/lib/pb/writer";
 
 export type IConfig = {
   enable?: boolean;
+  diskBuffer?: boolean;
+  bufferDir?: string;
 }
 
 export class Config {
   enable: boolean;
+  diskBuffer: boolean;
+  bufferDir: string;
 
   constructor(v?: IConfig) {
     this.enable = v?.enable || false;
+    this.diskBuffer = v?.diskBuffer || false;
+    this.bufferDir = v?.bufferDir || "";
   }
 
   static encode(m: Config, w?: Writer): Writer {
     if (!w) w = new Writer();
     if (m.enable) w.uint32(8).bool(m.enable);
+    if (m.diskBuffer) w.uint32(16).bool(m.diskBuffer);
+    if (m.bufferDir.length) w.uint32(26).string(m.bufferDir);
     return w;
   }
 
@@ -29,6 +37,12 @@ export class Config {
         case 1:
         m.enable = r.bool();
         break;
+        case 2:
+        m.diskBuffer = r.bool();
+        break;
+        case 3:
+        m.bufferDir = r.string();
+        break;
         default:
         r.skipType(tag & 7);
         break;
@@ -4858,6 +4858,7 @@ export type IWhisperRequest = {
   alias?: string;
   peerKey?: Uint8Array;
   body?: string;
+  anonymous?: boolean;
 }
 
 export class WhisperRequest {
@@ -4866,6 +4867,7 @@ export class WhisperRequest {
   alias: string;
   peerKey: Uint8Array;
   body: string;
+  anonymous: boolean;
 
   constructor(v?: IWhisperRequest) {
     this.networkKey = v?.networkKey || new Uint8Array();
@@ -4873,6 +4875,7 @@ export class WhisperRequest {
     this.alias = v?.alias || "";
     this.peerKey = v?.peerKey || new Uint8Array();
     this.body = v?.body || "";
+    this.anonymous = v?.anonymous || false;
   }
 
   static encode(m: WhisperRequest, w?: Writer): Writer {
@@ -4882,6 +4885,7 @@ export class WhisperRequest {
     if (m.alias.length) w.uint32(26).string(m.alias);
     if (m.peerKey.length) w.uint32(34).bytes(m.peerKey);
     if (m.body.length) w.uint32(42).string(m.body);
+    if (m.anonymous) w.uint32(48).bool(m.anonymous);
     return w;
   }
 
@@ -4907,6 +4911,9 @@ export class WhisperRequest {
         case 5:
         m.body = r.string();
         break;
+        case 6:
+        m.anonymous = r.bool();
+        break;
         default:
         r.skipType(tag & 7);
         break;
@@ -6313,6 +6320,7 @@ export type IWhisperRecord = {
   state?: strims_chat_v1_MessageState;
   message?: strims_chat_v1_IMessage;
   peerMessageId?: bigint;
+  anonymous?: boolean;
 }
 
 export class WhisperRecord {
@@ -6325,6 +6333,7 @@ export class WhisperRecord {
   state: strims_chat_v1_MessageState;
   message: strims_chat_v1_Message | undefined;
   peerMessageId: bigint;
+  anonymous: boolean;
 
   constructor(v?: IWhisperRecord) {
     this.id = v?.id || BigInt(0);
@@ -6336,6 +6345,7 @@ export class WhisperRecord {
     this.state = v?.state || 0;
     this.message = v?.message && new strims_chat_v1_Message(v.message);
     this.peerMessageId = v?.peerMessageId || BigInt(0);
+    this.anonymous = v?.anonymous || false;
   }
 
   static encode(m: WhisperRecord, w?: Writer): Writer {
@@ -6349,6 +6359,7 @@ export class WhisperRecord {
     if (m.state) w.uint32(48).uint32(m.state);
     if (m.message) strims_chat_v1_Message.encode(m.message, w.uint32(58).fork()).ldelim();
     if (m.peerMessageId) w.uint32(72).uint64(m.peerMessageId);
+    if (m.anonymous) w.uint32(80).bool(m.anonymous);
     return w;
   }
 
@@ -6386,6 +6397,9 @@ export class WhisperRecord {
         case 9:
         m.peerMessageId = r.uint64();
         break;
+        case 10:
+        m.anonymous = r.bool();
+        break;
         default:
         r.skipType(tag & 7);
         break;
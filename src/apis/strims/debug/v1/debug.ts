@@ -614,6 +614,152 @@ export class StopMockStreamResponse {
   }
 }
 
+export type IReadRoutesRequest = {
+  networkKey?: Uint8Array;
+}
+
+export class ReadRoutesRequest {
+  networkKey: Uint8Array;
+
+  constructor(v?: IReadRoutesRequest) {
+    this.networkKey = v?.networkKey || new Uint8Array();
+  }
+
+  static encode(m: ReadRoutesRequest, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.networkKey.length) w.uint32(10).bytes(m.networkKey);
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): ReadRoutesRequest {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new ReadRoutesRequest();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.networkKey = r.bytes();
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export type IReadRoutesResponse = {
+  routes?: strims_debug_v1_ReadRoutesResponse_IRoute[];
+}
+
+export class ReadRoutesResponse {
+  routes: strims_debug_v1_ReadRoutesResponse_Route[];
+
+  constructor(v?: IReadRoutesResponse) {
+    this.routes = v?.routes ? v.routes.map(v => new strims_debug_v1_ReadRoutesResponse_Route(v)) : [];
+  }
+
+  static encode(m: ReadRoutesResponse, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    for (const v of m.routes) strims_debug_v1_ReadRoutesResponse_Route.encode(v, w.uint32(10).fork()).ldelim();
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): ReadRoutesResponse {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new ReadRoutesResponse();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.routes.push(strims_debug_v1_ReadRoutesResponse_Route.decode(r, r.uint32()));
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export namespace ReadRoutesResponse {
+  export type IRoute = {
+    target?: Uint8Array;
+    nextHop?: Uint8Array;
+    distance?: number;
+    rttMs?: bigint;
+    loss?: number;
+    lastSeen?: bigint;
+  }
+
+  export class Route {
+    target: Uint8Array;
+    nextHop: Uint8Array;
+    distance: number;
+    rttMs: bigint;
+    loss: number;
+    lastSeen: bigint;
+
+    constructor(v?: IRoute) {
+      this.target = v?.target || new Uint8Array();
+      this.nextHop = v?.nextHop || new Uint8Array();
+      this.distance = v?.distance || 0;
+      this.rttMs = v?.rttMs || BigInt(0);
+      this.loss = v?.loss || 0;
+      this.lastSeen = v?.lastSeen || BigInt(0);
+    }
+
+    static encode(m: Route, w?: Writer): Writer {
+      if (!w) w = new Writer();
+      if (m.target.length) w.uint32(10).bytes(m.target);
+      if (m.nextHop.length) w.uint32(18).bytes(m.nextHop);
+      if (m.distance) w.uint32(24).uint32(m.distance);
+      if (m.rttMs) w.uint32(32).int64(m.rttMs);
+      if (m.loss) w.uint32(41).double(m.loss);
+      if (m.lastSeen) w.uint32(48).int64(m.lastSeen);
+      return w;
+    }
+
+    static decode(r: Reader | Uint8Array, length?: number): Route {
+      r = r instanceof Reader ? r : new Reader(r);
+      const end = length === undefined ? r.len : r.pos + length;
+      const m = new Route();
+      while (r.pos < end) {
+        const tag = r.uint32();
+        switch (tag >> 3) {
+          case 1:
+          m.target = r.bytes();
+          break;
+          case 2:
+          m.nextHop = r.bytes();
+          break;
+          case 3:
+          m.distance = r.uint32();
+          break;
+          case 4:
+          m.rttMs = r.int64();
+          break;
+          case 5:
+          m.loss = r.double();
+          break;
+          case 6:
+          m.lastSeen = r.int64();
+          break;
+          default:
+          r.skipType(tag & 7);
+          break;
+        }
+      }
+      return m;
+    }
+  }
+
+}
+
 export enum MetricsFormat {
   METRICS_FORMAT_TEXT = 0,
   METRICS_FORMAT_PROTO_DELIM = 1,
@@ -718,6 +864,24 @@ export type strims_debug_v1_StopMockStreamResponse = StopMockStreamResponse;
 /* @internal */
 export type strims_debug_v1_IStopMockStreamResponse = IStopMockStreamResponse;
 /* @internal */
+export const strims_debug_v1_ReadRoutesRequest = ReadRoutesRequest;
+/* @internal */
+export type strims_debug_v1_ReadRoutesRequest = ReadRoutesRequest;
+/* @internal */
+export type strims_debug_v1_IReadRoutesRequest = IReadRoutesRequest;
+/* @internal */
+export const strims_debug_v1_ReadRoutesResponse = ReadRoutesResponse;
+/* @internal */
+export type strims_debug_v1_ReadRoutesResponse = ReadRoutesResponse;
+/* @internal */
+export type strims_debug_v1_IReadRoutesResponse = IReadRoutesResponse;
+/* @internal */
+export const strims_debug_v1_ReadRoutesResponse_Route = ReadRoutesResponse.Route;
+/* @internal */
+export type strims_debug_v1_ReadRoutesResponse_Route = ReadRoutesResponse.Route;
+/* @internal */
+export type strims_debug_v1_ReadRoutesResponse_IRoute = ReadRoutesResponse.IRoute;
+/* @internal */
 export const strims_debug_v1_MetricsFormat = MetricsFormat;
 /* @internal */
 export type strims_debug_v1_MetricsFormat = MetricsFormat;
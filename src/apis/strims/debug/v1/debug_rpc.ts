@@ -25,6 +25,9 @@ import {
   strims_debug_v1_IStopMockStreamRequest,
   strims_debug_v1_StopMockStreamRequest,
   strims_debug_v1_StopMockStreamResponse,
+  strims_debug_v1_IReadRoutesRequest,
+  strims_debug_v1_ReadRoutesRequest,
+  strims_debug_v1_ReadRoutesResponse,
 } from "./debug";
 
 export interface DebugService {
@@ -35,6 +38,7 @@ export interface DebugService {
   setConfig(req: strims_debug_v1_SetConfigRequest, call: strims_rpc_Call): Promise<strims_debug_v1_SetConfigResponse> | strims_debug_v1_SetConfigResponse;
   startMockStream(req: strims_debug_v1_StartMockStreamRequest, call: strims_rpc_Call): Promise<strims_debug_v1_StartMockStreamResponse> | strims_debug_v1_StartMockStreamResponse;
   stopMockStream(req: strims_debug_v1_StopMockStreamRequest, call: strims_rpc_Call): Promise<strims_debug_v1_StopMockStreamResponse> | strims_debug_v1_StopMockStreamResponse;
+  readRoutes(req: strims_debug_v1_ReadRoutesRequest, call: strims_rpc_Call): Promise<strims_debug_v1_ReadRoutesResponse> | strims_debug_v1_ReadRoutesResponse;
 }
 
 export class UnimplementedDebugService implements DebugService {
@@ -45,6 +49,7 @@ export class UnimplementedDebugService implements DebugService {
   setConfig(req: strims_debug_v1_SetConfigRequest, call: strims_rpc_Call): Promise<strims_debug_v1_SetConfigResponse> | strims_debug_v1_SetConfigResponse { throw new Error("not implemented"); }
   startMockStream(req: strims_debug_v1_StartMockStreamRequest, call: strims_rpc_Call): Promise<strims_debug_v1_StartMockStreamResponse> | strims_debug_v1_StartMockStreamResponse { throw new Error("not implemented"); }
   stopMockStream(req: strims_debug_v1_StopMockStreamRequest, call: strims_rpc_Call): Promise<strims_debug_v1_StopMockStreamResponse> | strims_debug_v1_StopMockStreamResponse { throw new Error("not implemented"); }
+  readRoutes(req: strims_debug_v1_ReadRoutesRequest, call: strims_rpc_Call): Promise<strims_debug_v1_ReadRoutesResponse> | strims_debug_v1_ReadRoutesResponse { throw new Error("not implemented"); }
 }
 
 export const registerDebugService = (host: strims_rpc_Service, service: DebugService): void => {
@@ -55,6 +60,7 @@ export const registerDebugService = (host: strims_rpc_Service, service: DebugSer
   host.registerMethod<strims_debug_v1_SetConfigRequest, strims_debug_v1_SetConfigResponse>("strims.debug.v1.Debug.SetConfig", service.setConfig.bind(service), strims_debug_v1_SetConfigRequest);
   host.registerMethod<strims_debug_v1_StartMockStreamRequest, strims_debug_v1_StartMockStreamResponse>("strims.debug.v1.Debug.StartMockStream", service.startMockStream.bind(service), strims_debug_v1_StartMockStreamRequest);
   host.registerMethod<strims_debug_v1_StopMockStreamRequest, strims_debug_v1_StopMockStreamResponse>("strims.debug.v1.Debug.StopMockStream", service.stopMockStream.bind(service), strims_debug_v1_StopMockStreamRequest);
+  host.registerMethod<strims_debug_v1_ReadRoutesRequest, strims_debug_v1_ReadRoutesResponse>("strims.debug.v1.Debug.ReadRoutes", service.readRoutes.bind(service), strims_debug_v1_ReadRoutesRequest);
 }
 
 export class DebugClient {
@@ -87,5 +93,9 @@ export class DebugClient {
   public stopMockStream(req?: strims_debug_v1_IStopMockStreamRequest, opts?: strims_rpc_UnaryCallOptions): Promise<strims_debug_v1_StopMockStreamResponse> {
     return this.host.expectOne(this.host.call("strims.debug.v1.Debug.StopMockStream", new strims_debug_v1_StopMockStreamRequest(req)), strims_debug_v1_StopMockStreamResponse, opts);
   }
+
+  public readRoutes(req?: strims_debug_v1_IReadRoutesRequest, opts?: strims_rpc_UnaryCallOptions): Promise<strims_debug_v1_ReadRoutesResponse> {
+    return this.host.expectOne(this.host.call("strims.debug.v1.Debug.ReadRoutes", new strims_debug_v1_ReadRoutesRequest(req)), strims_debug_v1_ReadRoutesResponse, opts);
+  }
 }
 
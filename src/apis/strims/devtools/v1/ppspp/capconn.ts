@@ -168,6 +168,8 @@ export namespace CapConnLog {
         MESSAGE_TYPE_STREAM_CANCEL = 14,
         MESSAGE_TYPE_STREAM_OPEN = 15,
         MESSAGE_TYPE_STREAM_CLOSE = 16,
+        MESSAGE_TYPE_PEX_REQUEST = 17,
+        MESSAGE_TYPE_PEX_RESPONSE = 18,
         MESSAGE_TYPE_END = 255,
       }
     }
@@ -198,6 +198,15 @@ export class BootstrapClient {
       case BootstrapClient.ClientOptionsCase.WEBSOCKET_OPTIONS:
       strims_network_v1_bootstrap_BootstrapClientWebSocketOptions.encode(m.clientOptions.websocketOptions, w.uint32(18).fork()).ldelim();
       break;
+      case BootstrapClient.ClientOptionsCase.TCP_OPTIONS:
+      strims_network_v1_bootstrap_BootstrapClientTCPOptions.encode(m.clientOptions.tcpOptions, w.uint32(34).fork()).ldelim();
+      break;
+      case BootstrapClient.ClientOptionsCase.DNS_OPTIONS:
+      strims_network_v1_bootstrap_BootstrapClientDNSOptions.encode(m.clientOptions.dnsOptions, w.uint32(42).fork()).ldelim();
+      break;
+      case BootstrapClient.ClientOptionsCase.UDP_OPTIONS:
+      strims_network_v1_bootstrap_BootstrapClientUDPOptions.encode(m.clientOptions.udpOptions, w.uint32(50).fork()).ldelim();
+      break;
     }
     return w;
   }
@@ -218,6 +227,15 @@ export class BootstrapClient {
         case 2:
         m.clientOptions = new BootstrapClient.ClientOptions({ websocketOptions: strims_network_v1_bootstrap_BootstrapClientWebSocketOptions.decode(r, r.uint32()) });
         break;
+        case 4:
+        m.clientOptions = new BootstrapClient.ClientOptions({ tcpOptions: strims_network_v1_bootstrap_BootstrapClientTCPOptions.decode(r, r.uint32()) });
+        break;
+        case 5:
+        m.clientOptions = new BootstrapClient.ClientOptions({ dnsOptions: strims_network_v1_bootstrap_BootstrapClientDNSOptions.decode(r, r.uint32()) });
+        break;
+        case 6:
+        m.clientOptions = new BootstrapClient.ClientOptions({ udpOptions: strims_network_v1_bootstrap_BootstrapClientUDPOptions.decode(r, r.uint32()) });
+        break;
         default:
         r.skipType(tag & 7);
         break;
@@ -231,26 +249,50 @@ export namespace BootstrapClient {
   export enum ClientOptionsCase {
     NOT_SET = 0,
     WEBSOCKET_OPTIONS = 2,
+    TCP_OPTIONS = 4,
+    DNS_OPTIONS = 5,
+    UDP_OPTIONS = 6,
   }
 
   export type IClientOptions =
   { case?: ClientOptionsCase.NOT_SET }
   |{ case?: ClientOptionsCase.WEBSOCKET_OPTIONS, websocketOptions: strims_network_v1_bootstrap_IBootstrapClientWebSocketOptions }
+  |{ case?: ClientOptionsCase.TCP_OPTIONS, tcpOptions: strims_network_v1_bootstrap_IBootstrapClientTCPOptions }
+  |{ case?: ClientOptionsCase.DNS_OPTIONS, dnsOptions: strims_network_v1_bootstrap_IBootstrapClientDNSOptions }
+  |{ case?: ClientOptionsCase.UDP_OPTIONS, udpOptions: strims_network_v1_bootstrap_IBootstrapClientUDPOptions }
   ;
 
   export type TClientOptions = Readonly<
   { case: ClientOptionsCase.NOT_SET }
   |{ case: ClientOptionsCase.WEBSOCKET_OPTIONS, websocketOptions: strims_network_v1_bootstrap_BootstrapClientWebSocketOptions }
+  |{ case: ClientOptionsCase.TCP_OPTIONS, tcpOptions: strims_network_v1_bootstrap_BootstrapClientTCPOptions }
+  |{ case: ClientOptionsCase.DNS_OPTIONS, dnsOptions: strims_network_v1_bootstrap_BootstrapClientDNSOptions }
+  |{ case: ClientOptionsCase.UDP_OPTIONS, udpOptions: strims_network_v1_bootstrap_BootstrapClientUDPOptions }
   >;
 
   class ClientOptionsImpl {
     websocketOptions: strims_network_v1_bootstrap_BootstrapClientWebSocketOptions;
+    tcpOptions: strims_network_v1_bootstrap_BootstrapClientTCPOptions;
+    dnsOptions: strims_network_v1_bootstrap_BootstrapClientDNSOptions;
+    udpOptions: strims_network_v1_bootstrap_BootstrapClientUDPOptions;
     case: ClientOptionsCase = ClientOptionsCase.NOT_SET;
 
     constructor(v?: IClientOptions) {
       if (v && "websocketOptions" in v) {
         this.case = ClientOptionsCase.WEBSOCKET_OPTIONS;
         this.websocketOptions = new strims_network_v1_bootstrap_BootstrapClientWebSocketOptions(v.websocketOptions);
+      } else
+      if (v && "tcpOptions" in v) {
+        this.case = ClientOptionsCase.TCP_OPTIONS;
+        this.tcpOptions = new strims_network_v1_bootstrap_BootstrapClientTCPOptions(v.tcpOptions);
+      } else
+      if (v && "dnsOptions" in v) {
+        this.case = ClientOptionsCase.DNS_OPTIONS;
+        this.dnsOptions = new strims_network_v1_bootstrap_BootstrapClientDNSOptions(v.dnsOptions);
+      } else
+      if (v && "udpOptions" in v) {
+        this.case = ClientOptionsCase.UDP_OPTIONS;
+        this.udpOptions = new strims_network_v1_bootstrap_BootstrapClientUDPOptions(v.udpOptions);
       }
     }
   }
@@ -259,6 +301,9 @@ export namespace BootstrapClient {
     new (): Readonly<{ case: ClientOptionsCase.NOT_SET }>;
     new <T extends IClientOptions>(v: T): Readonly<
     T extends { websocketOptions: strims_network_v1_bootstrap_IBootstrapClientWebSocketOptions } ? { case: ClientOptionsCase.WEBSOCKET_OPTIONS, websocketOptions: strims_network_v1_bootstrap_BootstrapClientWebSocketOptions } :
+    T extends { tcpOptions: strims_network_v1_bootstrap_IBootstrapClientTCPOptions } ? { case: ClientOptionsCase.TCP_OPTIONS, tcpOptions: strims_network_v1_bootstrap_BootstrapClientTCPOptions } :
+    T extends { dnsOptions: strims_network_v1_bootstrap_IBootstrapClientDNSOptions } ? { case: ClientOptionsCase.DNS_OPTIONS, dnsOptions: strims_network_v1_bootstrap_BootstrapClientDNSOptions } :
+    T extends { udpOptions: strims_network_v1_bootstrap_IBootstrapClientUDPOptions } ? { case: ClientOptionsCase.UDP_OPTIONS, udpOptions: strims_network_v1_bootstrap_BootstrapClientUDPOptions } :
     never
     >;
   };
@@ -308,6 +353,121 @@ export class BootstrapClientWebSocketOptions {
   }
 }
 
+export type IBootstrapClientTCPOptions = {
+  url?: string;
+}
+
+export class BootstrapClientTCPOptions {
+  url: string;
+
+  constructor(v?: IBootstrapClientTCPOptions) {
+    this.url = v?.url || "";
+  }
+
+  static encode(m: BootstrapClientTCPOptions, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.url.length) w.uint32(10).string(m.url);
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): BootstrapClientTCPOptions {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new BootstrapClientTCPOptions();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.url = r.string();
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export type IBootstrapClientUDPOptions = {
+  url?: string;
+}
+
+export class BootstrapClientUDPOptions {
+  url: string;
+
+  constructor(v?: IBootstrapClientUDPOptions) {
+    this.url = v?.url || "";
+  }
+
+  static encode(m: BootstrapClientUDPOptions, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.url.length) w.uint32(10).string(m.url);
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): BootstrapClientUDPOptions {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new BootstrapClientUDPOptions();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.url = r.string();
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export type IBootstrapClientDNSOptions = {
+  name?: string;
+  insecureSkipVerifyTls?: boolean;
+}
+
+export class BootstrapClientDNSOptions {
+  name: string;
+  insecureSkipVerifyTls: boolean;
+
+  constructor(v?: IBootstrapClientDNSOptions) {
+    this.name = v?.name || "";
+    this.insecureSkipVerifyTls = v?.insecureSkipVerifyTls || false;
+  }
+
+  static encode(m: BootstrapClientDNSOptions, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.name.length) w.uint32(10).string(m.name);
+    if (m.insecureSkipVerifyTls) w.uint32(16).bool(m.insecureSkipVerifyTls);
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): BootstrapClientDNSOptions {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new BootstrapClientDNSOptions();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.name = r.string();
+        break;
+        case 2:
+        m.insecureSkipVerifyTls = r.bool();
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
 export type ICreateBootstrapClientRequest = {
   clientOptions?: CreateBootstrapClientRequest.IClientOptions
 }
@@ -325,6 +485,15 @@ export class CreateBootstrapClientRequest {
       case CreateBootstrapClientRequest.ClientOptionsCase.WEBSOCKET_OPTIONS:
       strims_network_v1_bootstrap_BootstrapClientWebSocketOptions.encode(m.clientOptions.websocketOptions, w.uint32(10).fork()).ldelim();
       break;
+      case CreateBootstrapClientRequest.ClientOptionsCase.TCP_OPTIONS:
+      strims_network_v1_bootstrap_BootstrapClientTCPOptions.encode(m.clientOptions.tcpOptions, w.uint32(18).fork()).ldelim();
+      break;
+      case CreateBootstrapClientRequest.ClientOptionsCase.DNS_OPTIONS:
+      strims_network_v1_bootstrap_BootstrapClientDNSOptions.encode(m.clientOptions.dnsOptions, w.uint32(26).fork()).ldelim();
+      break;
+      case CreateBootstrapClientRequest.ClientOptionsCase.UDP_OPTIONS:
+      strims_network_v1_bootstrap_BootstrapClientUDPOptions.encode(m.clientOptions.udpOptions, w.uint32(34).fork()).ldelim();
+      break;
     }
     return w;
   }
@@ -339,6 +508,15 @@ export class CreateBootstrapClientRequest {
         case 1:
         m.clientOptions = new CreateBootstrapClientRequest.ClientOptions({ websocketOptions: strims_network_v1_bootstrap_BootstrapClientWebSocketOptions.decode(r, r.uint32()) });
         break;
+        case 2:
+        m.clientOptions = new CreateBootstrapClientRequest.ClientOptions({ tcpOptions: strims_network_v1_bootstrap_BootstrapClientTCPOptions.decode(r, r.uint32()) });
+        break;
+        case 3:
+        m.clientOptions = new CreateBootstrapClientRequest.ClientOptions({ dnsOptions: strims_network_v1_bootstrap_BootstrapClientDNSOptions.decode(r, r.uint32()) });
+        break;
+        case 4:
+        m.clientOptions = new CreateBootstrapClientRequest.ClientOptions({ udpOptions: strims_network_v1_bootstrap_BootstrapClientUDPOptions.decode(r, r.uint32()) });
+        break;
         default:
         r.skipType(tag & 7);
         break;
@@ -352,26 +530,50 @@ export namespace CreateBootstrapClientRequest {
   export enum ClientOptionsCase {
     NOT_SET = 0,
     WEBSOCKET_OPTIONS = 1,
+    TCP_OPTIONS = 2,
+    DNS_OPTIONS = 3,
+    UDP_OPTIONS = 4,
   }
 
   export type IClientOptions =
   { case?: ClientOptionsCase.NOT_SET }
   |{ case?: ClientOptionsCase.WEBSOCKET_OPTIONS, websocketOptions: strims_network_v1_bootstrap_IBootstrapClientWebSocketOptions }
+  |{ case?: ClientOptionsCase.TCP_OPTIONS, tcpOptions: strims_network_v1_bootstrap_IBootstrapClientTCPOptions }
+  |{ case?: ClientOptionsCase.DNS_OPTIONS, dnsOptions: strims_network_v1_bootstrap_IBootstrapClientDNSOptions }
+  |{ case?: ClientOptionsCase.UDP_OPTIONS, udpOptions: strims_network_v1_bootstrap_IBootstrapClientUDPOptions }
   ;
 
   export type TClientOptions = Readonly<
   { case: ClientOptionsCase.NOT_SET }
   |{ case: ClientOptionsCase.WEBSOCKET_OPTIONS, websocketOptions: strims_network_v1_bootstrap_BootstrapClientWebSocketOptions }
+  |{ case: ClientOptionsCase.TCP_OPTIONS, tcpOptions: strims_network_v1_bootstrap_BootstrapClientTCPOptions }
+  |{ case: ClientOptionsCase.DNS_OPTIONS, dnsOptions: strims_network_v1_bootstrap_BootstrapClientDNSOptions }
+  |{ case: ClientOptionsCase.UDP_OPTIONS, udpOptions: strims_network_v1_bootstrap_BootstrapClientUDPOptions }
   >;
 
   class ClientOptionsImpl {
     websocketOptions: strims_network_v1_bootstrap_BootstrapClientWebSocketOptions;
+    tcpOptions: strims_network_v1_bootstrap_BootstrapClientTCPOptions;
+    dnsOptions: strims_network_v1_bootstrap_BootstrapClientDNSOptions;
+    udpOptions: strims_network_v1_bootstrap_BootstrapClientUDPOptions;
     case: ClientOptionsCase = ClientOptionsCase.NOT_SET;
 
     constructor(v?: IClientOptions) {
       if (v && "websocketOptions" in v) {
         this.case = ClientOptionsCase.WEBSOCKET_OPTIONS;
         this.websocketOptions = new strims_network_v1_bootstrap_BootstrapClientWebSocketOptions(v.websocketOptions);
+      } else
+      if (v && "tcpOptions" in v) {
+        this.case = ClientOptionsCase.TCP_OPTIONS;
+        this.tcpOptions = new strims_network_v1_bootstrap_BootstrapClientTCPOptions(v.tcpOptions);
+      } else
+      if (v && "dnsOptions" in v) {
+        this.case = ClientOptionsCase.DNS_OPTIONS;
+        this.dnsOptions = new strims_network_v1_bootstrap_BootstrapClientDNSOptions(v.dnsOptions);
+      } else
+      if (v && "udpOptions" in v) {
+        this.case = ClientOptionsCase.UDP_OPTIONS;
+        this.udpOptions = new strims_network_v1_bootstrap_BootstrapClientUDPOptions(v.udpOptions);
       }
     }
   }
@@ -380,6 +582,9 @@ export namespace CreateBootstrapClientRequest {
     new (): Readonly<{ case: ClientOptionsCase.NOT_SET }>;
     new <T extends IClientOptions>(v: T): Readonly<
     T extends { websocketOptions: strims_network_v1_bootstrap_IBootstrapClientWebSocketOptions } ? { case: ClientOptionsCase.WEBSOCKET_OPTIONS, websocketOptions: strims_network_v1_bootstrap_BootstrapClientWebSocketOptions } :
+    T extends { tcpOptions: strims_network_v1_bootstrap_IBootstrapClientTCPOptions } ? { case: ClientOptionsCase.TCP_OPTIONS, tcpOptions: strims_network_v1_bootstrap_BootstrapClientTCPOptions } :
+    T extends { dnsOptions: strims_network_v1_bootstrap_IBootstrapClientDNSOptions } ? { case: ClientOptionsCase.DNS_OPTIONS, dnsOptions: strims_network_v1_bootstrap_BootstrapClientDNSOptions } :
+    T extends { udpOptions: strims_network_v1_bootstrap_IBootstrapClientUDPOptions } ? { case: ClientOptionsCase.UDP_OPTIONS, udpOptions: strims_network_v1_bootstrap_BootstrapClientUDPOptions } :
     never
     >;
   };
@@ -443,6 +648,15 @@ export class UpdateBootstrapClientRequest {
       case UpdateBootstrapClientRequest.ClientOptionsCase.WEBSOCKET_OPTIONS:
       strims_network_v1_bootstrap_BootstrapClientWebSocketOptions.encode(m.clientOptions.websocketOptions, w.uint32(18).fork()).ldelim();
       break;
+      case UpdateBootstrapClientRequest.ClientOptionsCase.TCP_OPTIONS:
+      strims_network_v1_bootstrap_BootstrapClientTCPOptions.encode(m.clientOptions.tcpOptions, w.uint32(26).fork()).ldelim();
+      break;
+      case UpdateBootstrapClientRequest.ClientOptionsCase.DNS_OPTIONS:
+      strims_network_v1_bootstrap_BootstrapClientDNSOptions.encode(m.clientOptions.dnsOptions, w.uint32(34).fork()).ldelim();
+      break;
+      case UpdateBootstrapClientRequest.ClientOptionsCase.UDP_OPTIONS:
+      strims_network_v1_bootstrap_BootstrapClientUDPOptions.encode(m.clientOptions.udpOptions, w.uint32(42).fork()).ldelim();
+      break;
     }
     return w;
   }
@@ -460,6 +674,15 @@ export class UpdateBootstrapClientRequest {
         case 2:
         m.clientOptions = new UpdateBootstrapClientRequest.ClientOptions({ websocketOptions: strims_network_v1_bootstrap_BootstrapClientWebSocketOptions.decode(r, r.uint32()) });
         break;
+        case 3:
+        m.clientOptions = new UpdateBootstrapClientRequest.ClientOptions({ tcpOptions: strims_network_v1_bootstrap_BootstrapClientTCPOptions.decode(r, r.uint32()) });
+        break;
+        case 4:
+        m.clientOptions = new UpdateBootstrapClientRequest.ClientOptions({ dnsOptions: strims_network_v1_bootstrap_BootstrapClientDNSOptions.decode(r, r.uint32()) });
+        break;
+        case 5:
+        m.clientOptions = new UpdateBootstrapClientRequest.ClientOptions({ udpOptions: strims_network_v1_bootstrap_BootstrapClientUDPOptions.decode(r, r.uint32()) });
+        break;
         default:
         r.skipType(tag & 7);
         break;
@@ -473,26 +696,50 @@ export namespace UpdateBootstrapClientRequest {
   export enum ClientOptionsCase {
     NOT_SET = 0,
     WEBSOCKET_OPTIONS = 2,
+    TCP_OPTIONS = 3,
+    DNS_OPTIONS = 4,
+    UDP_OPTIONS = 5,
   }
 
   export type IClientOptions =
   { case?: ClientOptionsCase.NOT_SET }
   |{ case?: ClientOptionsCase.WEBSOCKET_OPTIONS, websocketOptions: strims_network_v1_bootstrap_IBootstrapClientWebSocketOptions }
+  |{ case?: ClientOptionsCase.TCP_OPTIONS, tcpOptions: strims_network_v1_bootstrap_IBootstrapClientTCPOptions }
+  |{ case?: ClientOptionsCase.DNS_OPTIONS, dnsOptions: strims_network_v1_bootstrap_IBootstrapClientDNSOptions }
+  |{ case?: ClientOptionsCase.UDP_OPTIONS, udpOptions: strims_network_v1_bootstrap_IBootstrapClientUDPOptions }
   ;
 
   export type TClientOptions = Readonly<
   { case: ClientOptionsCase.NOT_SET }
   |{ case: ClientOptionsCase.WEBSOCKET_OPTIONS, websocketOptions: strims_network_v1_bootstrap_BootstrapClientWebSocketOptions }
+  |{ case: ClientOptionsCase.TCP_OPTIONS, tcpOptions: strims_network_v1_bootstrap_BootstrapClientTCPOptions }
+  |{ case: ClientOptionsCase.DNS_OPTIONS, dnsOptions: strims_network_v1_bootstrap_BootstrapClientDNSOptions }
+  |{ case: ClientOptionsCase.UDP_OPTIONS, udpOptions: strims_network_v1_bootstrap_BootstrapClientUDPOptions }
   >;
 
   class ClientOptionsImpl {
     websocketOptions: strims_network_v1_bootstrap_BootstrapClientWebSocketOptions;
+    tcpOptions: strims_network_v1_bootstrap_BootstrapClientTCPOptions;
+    dnsOptions: strims_network_v1_bootstrap_BootstrapClientDNSOptions;
+    udpOptions: strims_network_v1_bootstrap_BootstrapClientUDPOptions;
     case: ClientOptionsCase = ClientOptionsCase.NOT_SET;
 
     constructor(v?: IClientOptions) {
       if (v && "websocketOptions" in v) {
         this.case = ClientOptionsCase.WEBSOCKET_OPTIONS;
         this.websocketOptions = new strims_network_v1_bootstrap_BootstrapClientWebSocketOptions(v.websocketOptions);
+      } else
+      if (v && "tcpOptions" in v) {
+        this.case = ClientOptionsCase.TCP_OPTIONS;
+        this.tcpOptions = new strims_network_v1_bootstrap_BootstrapClientTCPOptions(v.tcpOptions);
+      } else
+      if (v && "dnsOptions" in v) {
+        this.case = ClientOptionsCase.DNS_OPTIONS;
+        this.dnsOptions = new strims_network_v1_bootstrap_BootstrapClientDNSOptions(v.dnsOptions);
+      } else
+      if (v && "udpOptions" in v) {
+        this.case = ClientOptionsCase.UDP_OPTIONS;
+        this.udpOptions = new strims_network_v1_bootstrap_BootstrapClientUDPOptions(v.udpOptions);
       }
     }
   }
@@ -501,6 +748,9 @@ export namespace UpdateBootstrapClientRequest {
     new (): Readonly<{ case: ClientOptionsCase.NOT_SET }>;
     new <T extends IClientOptions>(v: T): Readonly<
     T extends { websocketOptions: strims_network_v1_bootstrap_IBootstrapClientWebSocketOptions } ? { case: ClientOptionsCase.WEBSOCKET_OPTIONS, websocketOptions: strims_network_v1_bootstrap_BootstrapClientWebSocketOptions } :
+    T extends { tcpOptions: strims_network_v1_bootstrap_IBootstrapClientTCPOptions } ? { case: ClientOptionsCase.TCP_OPTIONS, tcpOptions: strims_network_v1_bootstrap_BootstrapClientTCPOptions } :
+    T extends { dnsOptions: strims_network_v1_bootstrap_IBootstrapClientDNSOptions } ? { case: ClientOptionsCase.DNS_OPTIONS, dnsOptions: strims_network_v1_bootstrap_BootstrapClientDNSOptions } :
+    T extends { udpOptions: strims_network_v1_bootstrap_IBootstrapClientUDPOptions } ? { case: ClientOptionsCase.UDP_OPTIONS, udpOptions: strims_network_v1_bootstrap_BootstrapClientUDPOptions } :
     never
     >;
   };
@@ -1176,6 +1426,24 @@ export type strims_network_v1_bootstrap_BootstrapClientWebSocketOptions = Bootst
 /* @internal */
 export type strims_network_v1_bootstrap_IBootstrapClientWebSocketOptions = IBootstrapClientWebSocketOptions;
 /* @internal */
+export const strims_network_v1_bootstrap_BootstrapClientTCPOptions = BootstrapClientTCPOptions;
+/* @internal */
+export type strims_network_v1_bootstrap_BootstrapClientTCPOptions = BootstrapClientTCPOptions;
+/* @internal */
+export type strims_network_v1_bootstrap_IBootstrapClientTCPOptions = IBootstrapClientTCPOptions;
+/* @internal */
+export const strims_network_v1_bootstrap_BootstrapClientUDPOptions = BootstrapClientUDPOptions;
+/* @internal */
+export type strims_network_v1_bootstrap_BootstrapClientUDPOptions = BootstrapClientUDPOptions;
+/* @internal */
+export type strims_network_v1_bootstrap_IBootstrapClientUDPOptions = IBootstrapClientUDPOptions;
+/* @internal */
+export const strims_network_v1_bootstrap_BootstrapClientDNSOptions = BootstrapClientDNSOptions;
+/* @internal */
+export type strims_network_v1_bootstrap_BootstrapClientDNSOptions = BootstrapClientDNSOptions;
+/* @internal */
+export type strims_network_v1_bootstrap_IBootstrapClientDNSOptions = IBootstrapClientDNSOptions;
+/* @internal */
 export const strims_network_v1_bootstrap_CreateBootstrapClientRequest = CreateBootstrapClientRequest;
 /* @internal */
 export type strims_network_v1_bootstrap_CreateBootstrapClientRequest = CreateBootstrapClientRequest;
@@ -31,6 +31,7 @@ export type IServerConfig = {
   maxPingInterval?: number;
   embedLoadInterval?: number;
   loadMediaEmbedTimeout?: number;
+  federations?: strims_network_v1_directory_ServerConfig_IFederation[];
 }
 
 export class ServerConfig {
@@ -44,6 +45,7 @@ export class ServerConfig {
   maxPingInterval: number;
   embedLoadInterval: number;
   loadMediaEmbedTimeout: number;
+  federations: strims_network_v1_directory_ServerConfig_Federation[];
 
   constructor(v?: IServerConfig) {
     this.integrations = v?.integrations && new strims_network_v1_directory_ServerConfig_Integrations(v.integrations);
@@ -56,6 +58,7 @@ export class ServerConfig {
     this.maxPingInterval = v?.maxPingInterval || 0;
     this.embedLoadInterval = v?.embedLoadInterval || 0;
     this.loadMediaEmbedTimeout = v?.loadMediaEmbedTimeout || 0;
+    this.federations = v?.federations ? v.federations.map(v => new strims_network_v1_directory_ServerConfig_Federation(v)) : [];
   }
 
   static encode(m: ServerConfig, w?: Writer): Writer {
@@ -70,6 +73,7 @@ export class ServerConfig {
     if (m.maxPingInterval) w.uint32(64).uint32(m.maxPingInterval);
     if (m.embedLoadInterval) w.uint32(72).uint32(m.embedLoadInterval);
     if (m.loadMediaEmbedTimeout) w.uint32(80).uint32(m.loadMediaEmbedTimeout);
+    for (const v of m.federations) strims_network_v1_directory_ServerConfig_Federation.encode(v, w.uint32(90).fork()).ldelim();
     return w;
   }
 
@@ -110,6 +114,9 @@ export class ServerConfig {
         case 10:
         m.loadMediaEmbedTimeout = r.uint32();
         break;
+        case 11:
+        m.federations.push(strims_network_v1_directory_ServerConfig_Federation.decode(r, r.uint32()));
+        break;
         default:
         r.skipType(tag & 7);
         break;
@@ -125,6 +132,9 @@ export namespace ServerConfig {
     twitch?: strims_network_v1_directory_ServerConfig_Integrations_ITwitch;
     youtube?: strims_network_v1_directory_ServerConfig_Integrations_IYouTube;
     swarm?: strims_network_v1_directory_ServerConfig_Integrations_ISwarm;
+    kick?: strims_network_v1_directory_ServerConfig_Integrations_IKick;
+    vimeo?: strims_network_v1_directory_ServerConfig_Integrations_IVimeo;
+    oembed?: strims_network_v1_directory_ServerConfig_Integrations_IOEmbed;
   }
 
   export class Integrations {
@@ -132,12 +142,18 @@ export namespace ServerConfig {
     twitch: strims_network_v1_directory_ServerConfig_Integrations_Twitch | undefined;
     youtube: strims_network_v1_directory_ServerConfig_Integrations_YouTube | undefined;
     swarm: strims_network_v1_directory_ServerConfig_Integrations_Swarm | undefined;
+    kick: strims_network_v1_directory_ServerConfig_Integrations_Kick | undefined;
+    vimeo: strims_network_v1_directory_ServerConfig_Integrations_Vimeo | undefined;
+    oembed: strims_network_v1_directory_ServerConfig_Integrations_OEmbed | undefined;
 
     constructor(v?: IIntegrations) {
       this.angelthump = v?.angelthump && new strims_network_v1_directory_ServerConfig_Integrations_AngelThump(v.angelthump);
       this.twitch = v?.twitch && new strims_network_v1_directory_ServerConfig_Integrations_Twitch(v.twitch);
       this.youtube = v?.youtube && new strims_network_v1_directory_ServerConfig_Integrations_YouTube(v.youtube);
       this.swarm = v?.swarm && new strims_network_v1_directory_ServerConfig_Integrations_Swarm(v.swarm);
+      this.kick = v?.kick && new strims_network_v1_directory_ServerConfig_Integrations_Kick(v.kick);
+      this.vimeo = v?.vimeo && new strims_network_v1_directory_ServerConfig_Integrations_Vimeo(v.vimeo);
+      this.oembed = v?.oembed && new strims_network_v1_directory_ServerConfig_Integrations_OEmbed(v.oembed);
     }
 
     static encode(m: Integrations, w?: Writer): Writer {
@@ -146,6 +162,9 @@ export namespace ServerConfig {
       if (m.twitch) strims_network_v1_directory_ServerConfig_Integrations_Twitch.encode(m.twitch, w.uint32(18).fork()).ldelim();
       if (m.youtube) strims_network_v1_directory_ServerConfig_Integrations_YouTube.encode(m.youtube, w.uint32(26).fork()).ldelim();
       if (m.swarm) strims_network_v1_directory_ServerConfig_Integrations_Swarm.encode(m.swarm, w.uint32(34).fork()).ldelim();
+      if (m.kick) strims_network_v1_directory_ServerConfig_Integrations_Kick.encode(m.kick, w.uint32(42).fork()).ldelim();
+      if (m.vimeo) strims_network_v1_directory_ServerConfig_Integrations_Vimeo.encode(m.vimeo, w.uint32(50).fork()).ldelim();
+      if (m.oembed) strims_network_v1_directory_ServerConfig_Integrations_OEmbed.encode(m.oembed, w.uint32(58).fork()).ldelim();
       return w;
     }
 
@@ -168,6 +187,15 @@ export namespace ServerConfig {
           case 4:
           m.swarm = strims_network_v1_directory_ServerConfig_Integrations_Swarm.decode(r, r.uint32());
           break;
+          case 5:
+          m.kick = strims_network_v1_directory_ServerConfig_Integrations_Kick.decode(r, r.uint32());
+          break;
+          case 6:
+          m.vimeo = strims_network_v1_directory_ServerConfig_Integrations_Vimeo.decode(r, r.uint32());
+          break;
+          case 7:
+          m.oembed = strims_network_v1_directory_ServerConfig_Integrations_OEmbed.decode(r, r.uint32());
+          break;
           default:
           r.skipType(tag & 7);
           break;
@@ -343,6 +371,171 @@ export namespace ServerConfig {
       }
     }
 
+    export type IKick = {
+      enable?: boolean;
+    }
+
+    export class Kick {
+      enable: boolean;
+
+      constructor(v?: IKick) {
+        this.enable = v?.enable || false;
+      }
+
+      static encode(m: Kick, w?: Writer): Writer {
+        if (!w) w = new Writer();
+        if (m.enable) w.uint32(8).bool(m.enable);
+        return w;
+      }
+
+      static decode(r: Reader | Uint8Array, length?: number): Kick {
+        r = r instanceof Reader ? r : new Reader(r);
+        const end = length === undefined ? r.len : r.pos + length;
+        const m = new Kick();
+        while (r.pos < end) {
+          const tag = r.uint32();
+          switch (tag >> 3) {
+            case 1:
+            m.enable = r.bool();
+            break;
+            default:
+            r.skipType(tag & 7);
+            break;
+          }
+        }
+        return m;
+      }
+    }
+
+    export type IVimeo = {
+      enable?: boolean;
+      accessToken?: string;
+    }
+
+    export class Vimeo {
+      enable: boolean;
+      accessToken: string;
+
+      constructor(v?: IVimeo) {
+        this.enable = v?.enable || false;
+        this.accessToken = v?.accessToken || "";
+      }
+
+      static encode(m: Vimeo, w?: Writer): Writer {
+        if (!w) w = new Writer();
+        if (m.enable) w.uint32(8).bool(m.enable);
+        if (m.accessToken.length) w.uint32(18).string(m.accessToken);
+        return w;
+      }
+
+      static decode(r: Reader | Uint8Array, length?: number): Vimeo {
+        r = r instanceof Reader ? r : new Reader(r);
+        const end = length === undefined ? r.len : r.pos + length;
+        const m = new Vimeo();
+        while (r.pos < end) {
+          const tag = r.uint32();
+          switch (tag >> 3) {
+            case 1:
+            m.enable = r.bool();
+            break;
+            case 2:
+            m.accessToken = r.string();
+            break;
+            default:
+            r.skipType(tag & 7);
+            break;
+          }
+        }
+        return m;
+      }
+    }
+
+    export type IOEmbed = {
+      enable?: boolean;
+      allowedHosts?: string[];
+    }
+
+    export class OEmbed {
+      enable: boolean;
+      allowedHosts: string[];
+
+      constructor(v?: IOEmbed) {
+        this.enable = v?.enable || false;
+        this.allowedHosts = v?.allowedHosts ? v.allowedHosts : [];
+      }
+
+      static encode(m: OEmbed, w?: Writer): Writer {
+        if (!w) w = new Writer();
+        if (m.enable) w.uint32(8).bool(m.enable);
+        for (const v of m.allowedHosts) w.uint32(18).string(v);
+        return w;
+      }
+
+      static decode(r: Reader | Uint8Array, length?: number): OEmbed {
+        r = r instanceof Reader ? r : new Reader(r);
+        const end = length === undefined ? r.len : r.pos + length;
+        const m = new OEmbed();
+        while (r.pos < end) {
+          const tag = r.uint32();
+          switch (tag >> 3) {
+            case 1:
+            m.enable = r.bool();
+            break;
+            case 2:
+            m.allowedHosts.push(r.string())
+            break;
+            default:
+            r.skipType(tag & 7);
+            break;
+          }
+        }
+        return m;
+      }
+    }
+
+  }
+
+  export type IFederation = {
+    networkKey?: Uint8Array;
+    filter?: strims_network_v1_directory_IListingFilter;
+  }
+
+  export class Federation {
+    networkKey: Uint8Array;
+    filter: strims_network_v1_directory_ListingFilter | undefined;
+
+    constructor(v?: IFederation) {
+      this.networkKey = v?.networkKey || new Uint8Array();
+      this.filter = v?.filter && new strims_network_v1_directory_ListingFilter(v.filter);
+    }
+
+    static encode(m: Federation, w?: Writer): Writer {
+      if (!w) w = new Writer();
+      if (m.networkKey.length) w.uint32(10).bytes(m.networkKey);
+      if (m.filter) strims_network_v1_directory_ListingFilter.encode(m.filter, w.uint32(18).fork()).ldelim();
+      return w;
+    }
+
+    static decode(r: Reader | Uint8Array, length?: number): Federation {
+      r = r instanceof Reader ? r : new Reader(r);
+      const end = length === undefined ? r.len : r.pos + length;
+      const m = new Federation();
+      while (r.pos < end) {
+        const tag = r.uint32();
+        switch (tag >> 3) {
+          case 1:
+          m.networkKey = r.bytes();
+          break;
+          case 2:
+          m.filter = strims_network_v1_directory_ListingFilter.decode(r, r.uint32());
+          break;
+          default:
+          r.skipType(tag & 7);
+          break;
+        }
+      }
+      return m;
+    }
   }
 
 }
@@ -417,6 +610,9 @@ export namespace ClientConfig {
     twitch?: boolean;
     youtube?: boolean;
     swarm?: boolean;
+    kick?: boolean;
+    vimeo?: boolean;
+    oembed?: boolean;
   }
 
   export class Integrations {
@@ -424,12 +620,18 @@ export namespace ClientConfig {
     twitch: boolean;
     youtube: boolean;
     swarm: boolean;
+    kick: boolean;
+    vimeo: boolean;
+    oembed: boolean;
 
     constructor(v?: IIntegrations) {
       this.angelthump = v?.angelthump || false;
       this.twitch = v?.twitch || false;
       this.youtube = v?.youtube || false;
       this.swarm = v?.swarm || false;
+      this.kick = v?.kick || false;
+      this.vimeo = v?.vimeo || false;
+      this.oembed = v?.oembed || false;
     }
 
     static encode(m: Integrations, w?: Writer): Writer {
@@ -438,6 +640,9 @@ export namespace ClientConfig {
       if (m.twitch) w.uint32(16).bool(m.twitch);
       if (m.youtube) w.uint32(24).bool(m.youtube);
       if (m.swarm) w.uint32(32).bool(m.swarm);
+      if (m.kick) w.uint32(40).bool(m.kick);
+      if (m.vimeo) w.uint32(48).bool(m.vimeo);
+      if (m.oembed) w.uint32(56).bool(m.oembed);
       return w;
     }
 
@@ -460,6 +665,15 @@ export namespace ClientConfig {
           case 4:
           m.swarm = r.bool();
           break;
+          case 5:
+          m.kick = r.bool();
+          break;
+          case 6:
+          m.vimeo = r.bool();
+          break;
+          case 7:
+          m.oembed = r.bool();
+          break;
           default:
           r.skipType(tag & 7);
           break;
@@ -844,6 +1058,9 @@ export namespace Listing {
       DIRECTORY_LISTING_EMBED_SERVICE_TWITCH_STREAM = 2,
       DIRECTORY_LISTING_EMBED_SERVICE_TWITCH_VOD = 3,
       DIRECTORY_LISTING_EMBED_SERVICE_YOUTUBE = 4,
+      DIRECTORY_LISTING_EMBED_SERVICE_KICK = 5,
+      DIRECTORY_LISTING_EMBED_SERVICE_VIMEO = 6,
+      DIRECTORY_LISTING_EMBED_SERVICE_OEMBED = 7,
     }
   }
 
@@ -1126,6 +1343,56 @@ export class ListingSnippet {
   }
 }
 
+export type IListingProvenance = {
+  networkKey?: Uint8Array;
+  networkName?: string;
+  listingId?: bigint;
+}
+
+export class ListingProvenance {
+  networkKey: Uint8Array;
+  networkName: string;
+  listingId: bigint;
+
+  constructor(v?: IListingProvenance) {
+    this.networkKey = v?.networkKey || new Uint8Array();
+    this.networkName = v?.networkName || "";
+    this.listingId = v?.listingId || BigInt(0);
+  }
+
+  static encode(m: ListingProvenance, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.networkKey.length) w.uint32(10).bytes(m.networkKey);
+    if (m.networkName.length) w.uint32(18).string(m.networkName);
+    if (m.listingId) w.uint32(24).uint64(m.listingId);
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): ListingProvenance {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new ListingProvenance();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.networkKey = r.bytes();
+        break;
+        case 2:
+        m.networkName = r.string();
+        break;
+        case 3:
+        m.listingId = r.uint64();
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
 export type IListingSnippetDelta = {
   title?: google_protobuf_IStringValue;
   description?: google_protobuf_IStringValue;
@@ -1564,6 +1831,8 @@ export namespace Event {
     listing?: strims_network_v1_directory_IListing;
     snippet?: strims_network_v1_directory_IListingSnippet;
     moderation?: strims_network_v1_directory_IListingModeration;
+    upcoming?: boolean;
+    provenance?: strims_network_v1_directory_IListingProvenance;
   }
 
   export class ListingChange {
@@ -1571,12 +1840,16 @@ export namespace Event {
     listing: strims_network_v1_directory_Listing | undefined;
     snippet: strims_network_v1_directory_ListingSnippet | undefined;
     moderation: strims_network_v1_directory_ListingModeration | undefined;
+    upcoming: boolean;
+    provenance: strims_network_v1_directory_ListingProvenance | undefined;
 
     constructor(v?: IListingChange) {
       this.id = v?.id || BigInt(0);
       this.listing = v?.listing && new strims_network_v1_directory_Listing(v.listing);
       this.snippet = v?.snippet && new strims_network_v1_directory_ListingSnippet(v.snippet);
       this.moderation = v?.moderation && new strims_network_v1_directory_ListingModeration(v.moderation);
+      this.upcoming = v?.upcoming || false;
+      this.provenance = v?.provenance && new strims_network_v1_directory_ListingProvenance(v.provenance);
     }
 
     static encode(m: ListingChange, w?: Writer): Writer {
@@ -1585,6 +1858,8 @@ export namespace Event {
       if (m.listing) strims_network_v1_directory_Listing.encode(m.listing, w.uint32(18).fork()).ldelim();
       if (m.snippet) strims_network_v1_directory_ListingSnippet.encode(m.snippet, w.uint32(26).fork()).ldelim();
       if (m.moderation) strims_network_v1_directory_ListingModeration.encode(m.moderation, w.uint32(34).fork()).ldelim();
+      if (m.upcoming) w.uint32(40).bool(m.upcoming);
+      if (m.provenance) strims_network_v1_directory_ListingProvenance.encode(m.provenance, w.uint32(50).fork()).ldelim();
       return w;
     }
 
@@ -1607,6 +1882,12 @@ export namespace Event {
           case 4:
           m.moderation = strims_network_v1_directory_ListingModeration.decode(r, r.uint32());
           break;
+          case 5:
+          m.upcoming = r.bool();
+          break;
+          case 6:
+          m.provenance = strims_network_v1_directory_ListingProvenance.decode(r, r.uint32());
+          break;
           default:
           r.skipType(tag & 7);
           break;
@@ -2011,53 +2292,67 @@ export class ListingRecord {
   }
 }
 
-export type IUserModeration = {
-  disableJoin?: google_protobuf_IBoolValue;
-  disablePublish?: google_protobuf_IBoolValue;
-  isModerator?: google_protobuf_IBoolValue;
-  isAdmin?: google_protobuf_IBoolValue;
+export type IScheduledListingRecord = {
+  id?: bigint;
+  networkId?: bigint;
+  listing?: strims_network_v1_directory_IListing;
+  snippet?: strims_network_v1_directory_IListingSnippet;
+  publisherKey?: Uint8Array;
+  startTime?: bigint;
 }
 
-export class UserModeration {
-  disableJoin: google_protobuf_BoolValue | undefined;
-  disablePublish: google_protobuf_BoolValue | undefined;
-  isModerator: google_protobuf_BoolValue | undefined;
-  isAdmin: google_protobuf_BoolValue | undefined;
+export class ScheduledListingRecord {
+  id: bigint;
+  networkId: bigint;
+  listing: strims_network_v1_directory_Listing | undefined;
+  snippet: strims_network_v1_directory_ListingSnippet | undefined;
+  publisherKey: Uint8Array;
+  startTime: bigint;
 
-  constructor(v?: IUserModeration) {
-    this.disableJoin = v?.disableJoin && new google_protobuf_BoolValue(v.disableJoin);
-    this.disablePublish = v?.disablePublish && new google_protobuf_BoolValue(v.disablePublish);
-    this.isModerator = v?.isModerator && new google_protobuf_BoolValue(v.isModerator);
-    this.isAdmin = v?.isAdmin && new google_protobuf_BoolValue(v.isAdmin);
+  constructor(v?: IScheduledListingRecord) {
+    this.id = v?.id || BigInt(0);
+    this.networkId = v?.networkId || BigInt(0);
+    this.listing = v?.listing && new strims_network_v1_directory_Listing(v.listing);
+    this.snippet = v?.snippet && new strims_network_v1_directory_ListingSnippet(v.snippet);
+    this.publisherKey = v?.publisherKey || new Uint8Array();
+    this.startTime = v?.startTime || BigInt(0);
   }
 
-  static encode(m: UserModeration, w?: Writer): Writer {
+  static encode(m: ScheduledListingRecord, w?: Writer): Writer {
     if (!w) w = new Writer();
-    if (m.disableJoin) google_protobuf_BoolValue.encode(m.disableJoin, w.uint32(10).fork()).ldelim();
-    if (m.disablePublish) google_protobuf_BoolValue.encode(m.disablePublish, w.uint32(18).fork()).ldelim();
-    if (m.isModerator) google_protobuf_BoolValue.encode(m.isModerator, w.uint32(26).fork()).ldelim();
-    if (m.isAdmin) google_protobuf_BoolValue.encode(m.isAdmin, w.uint32(34).fork()).ldelim();
+    if (m.id) w.uint32(8).uint64(m.id);
+    if (m.networkId) w.uint32(16).uint64(m.networkId);
+    if (m.listing) strims_network_v1_directory_Listing.encode(m.listing, w.uint32(26).fork()).ldelim();
+    if (m.snippet) strims_network_v1_directory_ListingSnippet.encode(m.snippet, w.uint32(34).fork()).ldelim();
+    if (m.publisherKey.length) w.uint32(42).bytes(m.publisherKey);
+    if (m.startTime) w.uint32(48).int64(m.startTime);
     return w;
   }
 
-  static decode(r: Reader | Uint8Array, length?: number): UserModeration {
+  static decode(r: Reader | Uint8Array, length?: number): ScheduledListingRecord {
     r = r instanceof Reader ? r : new Reader(r);
     const end = length === undefined ? r.len : r.pos + length;
-    const m = new UserModeration();
+    const m = new ScheduledListingRecord();
     while (r.pos < end) {
       const tag = r.uint32();
       switch (tag >> 3) {
         case 1:
-        m.disableJoin = google_protobuf_BoolValue.decode(r, r.uint32());
+        m.id = r.uint64();
         break;
         case 2:
-        m.disablePublish = google_protobuf_BoolValue.decode(r, r.uint32());
+        m.networkId = r.uint64();
         break;
         case 3:
-        m.isModerator = google_protobuf_BoolValue.decode(r, r.uint32());
+        m.listing = strims_network_v1_directory_Listing.decode(r, r.uint32());
         break;
         case 4:
-        m.isAdmin = google_protobuf_BoolValue.decode(r, r.uint32());
+        m.snippet = strims_network_v1_directory_ListingSnippet.decode(r, r.uint32());
+        break;
+        case 5:
+        m.publisherKey = r.bytes();
+        break;
+        case 6:
+        m.startTime = r.int64();
         break;
         default:
         r.skipType(tag & 7);
@@ -2068,39 +2363,39 @@ export class UserModeration {
   }
 }
 
-export type IUserRecord = {
+export type IListingSubscription = {
   id?: bigint;
   networkId?: bigint;
-  peerKey?: Uint8Array;
-  moderation?: strims_network_v1_directory_IUserModeration;
+  listing?: strims_network_v1_directory_IListing;
+  title?: string;
 }
 
-export class UserRecord {
+export class ListingSubscription {
   id: bigint;
   networkId: bigint;
-  peerKey: Uint8Array;
-  moderation: strims_network_v1_directory_UserModeration | undefined;
+  listing: strims_network_v1_directory_Listing | undefined;
+  title: string;
 
-  constructor(v?: IUserRecord) {
+  constructor(v?: IListingSubscription) {
     this.id = v?.id || BigInt(0);
     this.networkId = v?.networkId || BigInt(0);
-    this.peerKey = v?.peerKey || new Uint8Array();
-    this.moderation = v?.moderation && new strims_network_v1_directory_UserModeration(v.moderation);
+    this.listing = v?.listing && new strims_network_v1_directory_Listing(v.listing);
+    this.title = v?.title || "";
   }
 
-  static encode(m: UserRecord, w?: Writer): Writer {
+  static encode(m: ListingSubscription, w?: Writer): Writer {
     if (!w) w = new Writer();
     if (m.id) w.uint32(8).uint64(m.id);
     if (m.networkId) w.uint32(16).uint64(m.networkId);
-    if (m.peerKey.length) w.uint32(26).bytes(m.peerKey);
-    if (m.moderation) strims_network_v1_directory_UserModeration.encode(m.moderation, w.uint32(34).fork()).ldelim();
+    if (m.listing) strims_network_v1_directory_Listing.encode(m.listing, w.uint32(26).fork()).ldelim();
+    if (m.title.length) w.uint32(34).string(m.title);
     return w;
   }
 
-  static decode(r: Reader | Uint8Array, length?: number): UserRecord {
+  static decode(r: Reader | Uint8Array, length?: number): ListingSubscription {
     r = r instanceof Reader ? r : new Reader(r);
     const end = length === undefined ? r.len : r.pos + length;
-    const m = new UserRecord();
+    const m = new ListingSubscription();
     while (r.pos < end) {
       const tag = r.uint32();
       switch (tag >> 3) {
@@ -2111,10 +2406,10 @@ export class UserRecord {
         m.networkId = r.uint64();
         break;
         case 3:
-        m.peerKey = r.bytes();
+        m.listing = strims_network_v1_directory_Listing.decode(r, r.uint32());
         break;
         case 4:
-        m.moderation = strims_network_v1_directory_UserModeration.decode(r, r.uint32());
+        m.title = r.string();
         break;
         default:
         r.skipType(tag & 7);
@@ -2125,32 +2420,88 @@ export class UserRecord {
   }
 }
 
-export type IEventBroadcast = {
-  events?: strims_network_v1_directory_IEvent[];
-}
-
-export class EventBroadcast {
-  events: strims_network_v1_directory_Event[];
+export type IListingSessionRecord = {
+  id?: bigint;
+  networkId?: bigint;
+  listing?: strims_network_v1_directory_IListing;
+  publisherKey?: Uint8Array;
+  startTime?: bigint;
+  endTime?: bigint;
+  peakViewers?: number;
+  viewerSeconds?: bigint;
+  viewerSketch?: Uint8Array;
+}
 
-  constructor(v?: IEventBroadcast) {
-    this.events = v?.events ? v.events.map(v => new strims_network_v1_directory_Event(v)) : [];
+export class ListingSessionRecord {
+  id: bigint;
+  networkId: bigint;
+  listing: strims_network_v1_directory_Listing | undefined;
+  publisherKey: Uint8Array;
+  startTime: bigint;
+  endTime: bigint;
+  peakViewers: number;
+  viewerSeconds: bigint;
+  viewerSketch: Uint8Array;
+
+  constructor(v?: IListingSessionRecord) {
+    this.id = v?.id || BigInt(0);
+    this.networkId = v?.networkId || BigInt(0);
+    this.listing = v?.listing && new strims_network_v1_directory_Listing(v.listing);
+    this.publisherKey = v?.publisherKey || new Uint8Array();
+    this.startTime = v?.startTime || BigInt(0);
+    this.endTime = v?.endTime || BigInt(0);
+    this.peakViewers = v?.peakViewers || 0;
+    this.viewerSeconds = v?.viewerSeconds || BigInt(0);
+    this.viewerSketch = v?.viewerSketch || new Uint8Array();
   }
 
-  static encode(m: EventBroadcast, w?: Writer): Writer {
+  static encode(m: ListingSessionRecord, w?: Writer): Writer {
     if (!w) w = new Writer();
-    for (const v of m.events) strims_network_v1_directory_Event.encode(v, w.uint32(10).fork()).ldelim();
+    if (m.id) w.uint32(8).uint64(m.id);
+    if (m.networkId) w.uint32(16).uint64(m.networkId);
+    if (m.listing) strims_network_v1_directory_Listing.encode(m.listing, w.uint32(26).fork()).ldelim();
+    if (m.publisherKey.length) w.uint32(34).bytes(m.publisherKey);
+    if (m.startTime) w.uint32(40).int64(m.startTime);
+    if (m.endTime) w.uint32(48).int64(m.endTime);
+    if (m.peakViewers) w.uint32(56).uint32(m.peakViewers);
+    if (m.viewerSeconds) w.uint32(64).uint64(m.viewerSeconds);
+    if (m.viewerSketch.length) w.uint32(74).bytes(m.viewerSketch);
     return w;
   }
 
-  static decode(r: Reader | Uint8Array, length?: number): EventBroadcast {
+  static decode(r: Reader | Uint8Array, length?: number): ListingSessionRecord {
     r = r instanceof Reader ? r : new Reader(r);
     const end = length === undefined ? r.len : r.pos + length;
-    const m = new EventBroadcast();
+    const m = new ListingSessionRecord();
     while (r.pos < end) {
       const tag = r.uint32();
       switch (tag >> 3) {
         case 1:
-        m.events.push(strims_network_v1_directory_Event.decode(r, r.uint32()));
+        m.id = r.uint64();
+        break;
+        case 2:
+        m.networkId = r.uint64();
+        break;
+        case 3:
+        m.listing = strims_network_v1_directory_Listing.decode(r, r.uint32());
+        break;
+        case 4:
+        m.publisherKey = r.bytes();
+        break;
+        case 5:
+        m.startTime = r.int64();
+        break;
+        case 6:
+        m.endTime = r.int64();
+        break;
+        case 7:
+        m.peakViewers = r.uint32();
+        break;
+        case 8:
+        m.viewerSeconds = r.uint64();
+        break;
+        case 9:
+        m.viewerSketch = r.bytes();
         break;
         default:
         r.skipType(tag & 7);
@@ -2161,39 +2512,67 @@ export class EventBroadcast {
   }
 }
 
-export type IAssetBundle = {
-  icon?: strims_type_IImage;
-  directory?: strims_network_v1_directory_IClientConfig;
+export type IListingStats = {
+  sessionCount?: number;
+  duration?: bigint;
+  peakViewers?: number;
+  averageViewers?: number;
+  uniqueViewers?: number;
+  viewerSeconds?: bigint;
 }
 
-export class AssetBundle {
-  icon: strims_type_Image | undefined;
-  directory: strims_network_v1_directory_ClientConfig | undefined;
+export class ListingStats {
+  sessionCount: number;
+  duration: bigint;
+  peakViewers: number;
+  averageViewers: number;
+  uniqueViewers: number;
+  viewerSeconds: bigint;
 
-  constructor(v?: IAssetBundle) {
-    this.icon = v?.icon && new strims_type_Image(v.icon);
-    this.directory = v?.directory && new strims_network_v1_directory_ClientConfig(v.directory);
+  constructor(v?: IListingStats) {
+    this.sessionCount = v?.sessionCount || 0;
+    this.duration = v?.duration || BigInt(0);
+    this.peakViewers = v?.peakViewers || 0;
+    this.averageViewers = v?.averageViewers || 0;
+    this.uniqueViewers = v?.uniqueViewers || 0;
+    this.viewerSeconds = v?.viewerSeconds || BigInt(0);
   }
 
-  static encode(m: AssetBundle, w?: Writer): Writer {
+  static encode(m: ListingStats, w?: Writer): Writer {
     if (!w) w = new Writer();
-    if (m.icon) strims_type_Image.encode(m.icon, w.uint32(10).fork()).ldelim();
-    if (m.directory) strims_network_v1_directory_ClientConfig.encode(m.directory, w.uint32(18).fork()).ldelim();
+    if (m.sessionCount) w.uint32(8).uint32(m.sessionCount);
+    if (m.duration) w.uint32(16).int64(m.duration);
+    if (m.peakViewers) w.uint32(24).uint32(m.peakViewers);
+    if (m.averageViewers) w.uint32(33).double(m.averageViewers);
+    if (m.uniqueViewers) w.uint32(40).uint32(m.uniqueViewers);
+    if (m.viewerSeconds) w.uint32(48).uint64(m.viewerSeconds);
     return w;
   }
 
-  static decode(r: Reader | Uint8Array, length?: number): AssetBundle {
+  static decode(r: Reader | Uint8Array, length?: number): ListingStats {
     r = r instanceof Reader ? r : new Reader(r);
     const end = length === undefined ? r.len : r.pos + length;
-    const m = new AssetBundle();
+    const m = new ListingStats();
     while (r.pos < end) {
       const tag = r.uint32();
       switch (tag >> 3) {
         case 1:
-        m.icon = strims_type_Image.decode(r, r.uint32());
+        m.sessionCount = r.uint32();
         break;
         case 2:
-        m.directory = strims_network_v1_directory_ClientConfig.decode(r, r.uint32());
+        m.duration = r.int64();
+        break;
+        case 3:
+        m.peakViewers = r.uint32();
+        break;
+        case 4:
+        m.averageViewers = r.double();
+        break;
+        case 5:
+        m.uniqueViewers = r.uint32();
+        break;
+        case 6:
+        m.viewerSeconds = r.uint64();
         break;
         default:
         r.skipType(tag & 7);
@@ -2204,33 +2583,40 @@ export class AssetBundle {
   }
 }
 
-export type IPublishRequest = {
+export type IListingStatsItem = {
   listing?: strims_network_v1_directory_IListing;
+  stats?: strims_network_v1_directory_IListingStats;
 }
 
-export class PublishRequest {
+export class ListingStatsItem {
   listing: strims_network_v1_directory_Listing | undefined;
+  stats: strims_network_v1_directory_ListingStats | undefined;
 
-  constructor(v?: IPublishRequest) {
+  constructor(v?: IListingStatsItem) {
     this.listing = v?.listing && new strims_network_v1_directory_Listing(v.listing);
+    this.stats = v?.stats && new strims_network_v1_directory_ListingStats(v.stats);
   }
 
-  static encode(m: PublishRequest, w?: Writer): Writer {
+  static encode(m: ListingStatsItem, w?: Writer): Writer {
     if (!w) w = new Writer();
     if (m.listing) strims_network_v1_directory_Listing.encode(m.listing, w.uint32(10).fork()).ldelim();
+    if (m.stats) strims_network_v1_directory_ListingStats.encode(m.stats, w.uint32(18).fork()).ldelim();
     return w;
   }
 
-  static decode(r: Reader | Uint8Array, length?: number): PublishRequest {
+  static decode(r: Reader | Uint8Array, length?: number): ListingStatsItem {
     r = r instanceof Reader ? r : new Reader(r);
     const end = length === undefined ? r.len : r.pos + length;
-    const m = new PublishRequest();
+    const m = new ListingStatsItem();
     while (r.pos < end) {
       const tag = r.uint32();
       switch (tag >> 3) {
         case 1:
         m.listing = strims_network_v1_directory_Listing.decode(r, r.uint32());
         break;
+        case 2:
+        m.stats = strims_network_v1_directory_ListingStats.decode(r, r.uint32());
+        break;
         default:
         r.skipType(tag & 7);
         break;
@@ -2240,32 +2626,39 @@ export class PublishRequest {
   }
 }
 
-export type IPublishResponse = {
-  id?: bigint;
+export type IPublisherStatsItem = {
+  publisherKey?: Uint8Array;
+  stats?: strims_network_v1_directory_IListingStats;
 }
 
-export class PublishResponse {
-  id: bigint;
+export class PublisherStatsItem {
+  publisherKey: Uint8Array;
+  stats: strims_network_v1_directory_ListingStats | undefined;
 
-  constructor(v?: IPublishResponse) {
-    this.id = v?.id || BigInt(0);
+  constructor(v?: IPublisherStatsItem) {
+    this.publisherKey = v?.publisherKey || new Uint8Array();
+    this.stats = v?.stats && new strims_network_v1_directory_ListingStats(v.stats);
   }
 
-  static encode(m: PublishResponse, w?: Writer): Writer {
+  static encode(m: PublisherStatsItem, w?: Writer): Writer {
     if (!w) w = new Writer();
-    if (m.id) w.uint32(8).uint64(m.id);
+    if (m.publisherKey.length) w.uint32(10).bytes(m.publisherKey);
+    if (m.stats) strims_network_v1_directory_ListingStats.encode(m.stats, w.uint32(18).fork()).ldelim();
     return w;
   }
 
-  static decode(r: Reader | Uint8Array, length?: number): PublishResponse {
+  static decode(r: Reader | Uint8Array, length?: number): PublisherStatsItem {
     r = r instanceof Reader ? r : new Reader(r);
     const end = length === undefined ? r.len : r.pos + length;
-    const m = new PublishResponse();
+    const m = new PublisherStatsItem();
     while (r.pos < end) {
       const tag = r.uint32();
       switch (tag >> 3) {
         case 1:
-        m.id = r.uint64();
+        m.publisherKey = r.bytes();
+        break;
+        case 2:
+        m.stats = strims_network_v1_directory_ListingStats.decode(r, r.uint32());
         break;
         default:
         r.skipType(tag & 7);
@@ -2276,32 +2669,53 @@ export class PublishResponse {
   }
 }
 
-export type IUnpublishRequest = {
-  id?: bigint;
+export type IUserModeration = {
+  disableJoin?: google_protobuf_IBoolValue;
+  disablePublish?: google_protobuf_IBoolValue;
+  isModerator?: google_protobuf_IBoolValue;
+  isAdmin?: google_protobuf_IBoolValue;
 }
 
-export class UnpublishRequest {
-  id: bigint;
+export class UserModeration {
+  disableJoin: google_protobuf_BoolValue | undefined;
+  disablePublish: google_protobuf_BoolValue | undefined;
+  isModerator: google_protobuf_BoolValue | undefined;
+  isAdmin: google_protobuf_BoolValue | undefined;
 
-  constructor(v?: IUnpublishRequest) {
-    this.id = v?.id || BigInt(0);
+  constructor(v?: IUserModeration) {
+    this.disableJoin = v?.disableJoin && new google_protobuf_BoolValue(v.disableJoin);
+    this.disablePublish = v?.disablePublish && new google_protobuf_BoolValue(v.disablePublish);
+    this.isModerator = v?.isModerator && new google_protobuf_BoolValue(v.isModerator);
+    this.isAdmin = v?.isAdmin && new google_protobuf_BoolValue(v.isAdmin);
   }
 
-  static encode(m: UnpublishRequest, w?: Writer): Writer {
+  static encode(m: UserModeration, w?: Writer): Writer {
     if (!w) w = new Writer();
-    if (m.id) w.uint32(8).uint64(m.id);
+    if (m.disableJoin) google_protobuf_BoolValue.encode(m.disableJoin, w.uint32(10).fork()).ldelim();
+    if (m.disablePublish) google_protobuf_BoolValue.encode(m.disablePublish, w.uint32(18).fork()).ldelim();
+    if (m.isModerator) google_protobuf_BoolValue.encode(m.isModerator, w.uint32(26).fork()).ldelim();
+    if (m.isAdmin) google_protobuf_BoolValue.encode(m.isAdmin, w.uint32(34).fork()).ldelim();
     return w;
   }
 
-  static decode(r: Reader | Uint8Array, length?: number): UnpublishRequest {
+  static decode(r: Reader | Uint8Array, length?: number): UserModeration {
     r = r instanceof Reader ? r : new Reader(r);
     const end = length === undefined ? r.len : r.pos + length;
-    const m = new UnpublishRequest();
+    const m = new UserModeration();
     while (r.pos < end) {
       const tag = r.uint32();
       switch (tag >> 3) {
         case 1:
-        m.id = r.uint64();
+        m.disableJoin = google_protobuf_BoolValue.decode(r, r.uint32());
+        break;
+        case 2:
+        m.disablePublish = google_protobuf_BoolValue.decode(r, r.uint32());
+        break;
+        case 3:
+        m.isModerator = google_protobuf_BoolValue.decode(r, r.uint32());
+        break;
+        case 4:
+        m.isAdmin = google_protobuf_BoolValue.decode(r, r.uint32());
         break;
         default:
         r.skipType(tag & 7);
@@ -2312,51 +2726,53 @@ export class UnpublishRequest {
   }
 }
 
-export type IUnpublishResponse = Record<string, any>;
-
-export class UnpublishResponse {
-
-  // eslint-disable-next-line @typescript-eslint/no-unused-vars, @typescript-eslint/no-empty-function
-  constructor(v?: IUnpublishResponse) {
-  }
-
-  static encode(m: UnpublishResponse, w?: Writer): Writer {
-    if (!w) w = new Writer();
-    return w;
-  }
-
-  static decode(r: Reader | Uint8Array, length?: number): UnpublishResponse {
-    if (r instanceof Reader && length) r.skip(length);
-    return new UnpublishResponse();
-  }
-}
-
-export type IJoinRequest = {
-  query?: strims_network_v1_directory_IListingQuery;
+export type IUserRecord = {
+  id?: bigint;
+  networkId?: bigint;
+  peerKey?: Uint8Array;
+  moderation?: strims_network_v1_directory_IUserModeration;
 }
 
-export class JoinRequest {
-  query: strims_network_v1_directory_ListingQuery | undefined;
+export class UserRecord {
+  id: bigint;
+  networkId: bigint;
+  peerKey: Uint8Array;
+  moderation: strims_network_v1_directory_UserModeration | undefined;
 
-  constructor(v?: IJoinRequest) {
-    this.query = v?.query && new strims_network_v1_directory_ListingQuery(v.query);
+  constructor(v?: IUserRecord) {
+    this.id = v?.id || BigInt(0);
+    this.networkId = v?.networkId || BigInt(0);
+    this.peerKey = v?.peerKey || new Uint8Array();
+    this.moderation = v?.moderation && new strims_network_v1_directory_UserModeration(v.moderation);
   }
 
-  static encode(m: JoinRequest, w?: Writer): Writer {
+  static encode(m: UserRecord, w?: Writer): Writer {
     if (!w) w = new Writer();
-    if (m.query) strims_network_v1_directory_ListingQuery.encode(m.query, w.uint32(10).fork()).ldelim();
+    if (m.id) w.uint32(8).uint64(m.id);
+    if (m.networkId) w.uint32(16).uint64(m.networkId);
+    if (m.peerKey.length) w.uint32(26).bytes(m.peerKey);
+    if (m.moderation) strims_network_v1_directory_UserModeration.encode(m.moderation, w.uint32(34).fork()).ldelim();
     return w;
   }
 
-  static decode(r: Reader | Uint8Array, length?: number): JoinRequest {
+  static decode(r: Reader | Uint8Array, length?: number): UserRecord {
     r = r instanceof Reader ? r : new Reader(r);
     const end = length === undefined ? r.len : r.pos + length;
-    const m = new JoinRequest();
+    const m = new UserRecord();
     while (r.pos < end) {
       const tag = r.uint32();
       switch (tag >> 3) {
         case 1:
-        m.query = strims_network_v1_directory_ListingQuery.decode(r, r.uint32());
+        m.id = r.uint64();
+        break;
+        case 2:
+        m.networkId = r.uint64();
+        break;
+        case 3:
+        m.peerKey = r.bytes();
+        break;
+        case 4:
+        m.moderation = strims_network_v1_directory_UserModeration.decode(r, r.uint32());
         break;
         default:
         r.skipType(tag & 7);
@@ -2367,32 +2783,32 @@ export class JoinRequest {
   }
 }
 
-export type IJoinResponse = {
-  id?: bigint;
+export type IEventBroadcast = {
+  events?: strims_network_v1_directory_IEvent[];
 }
 
-export class JoinResponse {
-  id: bigint;
+export class EventBroadcast {
+  events: strims_network_v1_directory_Event[];
 
-  constructor(v?: IJoinResponse) {
-    this.id = v?.id || BigInt(0);
+  constructor(v?: IEventBroadcast) {
+    this.events = v?.events ? v.events.map(v => new strims_network_v1_directory_Event(v)) : [];
   }
 
-  static encode(m: JoinResponse, w?: Writer): Writer {
+  static encode(m: EventBroadcast, w?: Writer): Writer {
     if (!w) w = new Writer();
-    if (m.id) w.uint32(8).uint64(m.id);
+    for (const v of m.events) strims_network_v1_directory_Event.encode(v, w.uint32(10).fork()).ldelim();
     return w;
   }
 
-  static decode(r: Reader | Uint8Array, length?: number): JoinResponse {
+  static decode(r: Reader | Uint8Array, length?: number): EventBroadcast {
     r = r instanceof Reader ? r : new Reader(r);
     const end = length === undefined ? r.len : r.pos + length;
-    const m = new JoinResponse();
+    const m = new EventBroadcast();
     while (r.pos < end) {
       const tag = r.uint32();
       switch (tag >> 3) {
         case 1:
-        m.id = r.uint64();
+        m.events.push(strims_network_v1_directory_Event.decode(r, r.uint32()));
         break;
         default:
         r.skipType(tag & 7);
@@ -2403,32 +2819,39 @@ export class JoinResponse {
   }
 }
 
-export type IPartRequest = {
-  id?: bigint;
+export type IAssetBundle = {
+  icon?: strims_type_IImage;
+  directory?: strims_network_v1_directory_IClientConfig;
 }
 
-export class PartRequest {
-  id: bigint;
+export class AssetBundle {
+  icon: strims_type_Image | undefined;
+  directory: strims_network_v1_directory_ClientConfig | undefined;
 
-  constructor(v?: IPartRequest) {
-    this.id = v?.id || BigInt(0);
+  constructor(v?: IAssetBundle) {
+    this.icon = v?.icon && new strims_type_Image(v.icon);
+    this.directory = v?.directory && new strims_network_v1_directory_ClientConfig(v.directory);
   }
 
-  static encode(m: PartRequest, w?: Writer): Writer {
+  static encode(m: AssetBundle, w?: Writer): Writer {
     if (!w) w = new Writer();
-    if (m.id) w.uint32(8).uint64(m.id);
+    if (m.icon) strims_type_Image.encode(m.icon, w.uint32(10).fork()).ldelim();
+    if (m.directory) strims_network_v1_directory_ClientConfig.encode(m.directory, w.uint32(18).fork()).ldelim();
     return w;
   }
 
-  static decode(r: Reader | Uint8Array, length?: number): PartRequest {
+  static decode(r: Reader | Uint8Array, length?: number): AssetBundle {
     r = r instanceof Reader ? r : new Reader(r);
     const end = length === undefined ? r.len : r.pos + length;
-    const m = new PartRequest();
+    const m = new AssetBundle();
     while (r.pos < end) {
       const tag = r.uint32();
       switch (tag >> 3) {
         case 1:
-        m.id = r.uint64();
+        m.icon = strims_type_Image.decode(r, r.uint32());
+        break;
+        case 2:
+        m.directory = strims_network_v1_directory_ClientConfig.decode(r, r.uint32());
         break;
         default:
         r.skipType(tag & 7);
@@ -2439,96 +2862,1029 @@ export class PartRequest {
   }
 }
 
-export type IPartResponse = Record<string, any>;
+export type IPublishRequest = {
+  listing?: strims_network_v1_directory_IListing;
+}
 
-export class PartResponse {
+export class PublishRequest {
+  listing: strims_network_v1_directory_Listing | undefined;
 
-  // eslint-disable-next-line @typescript-eslint/no-unused-vars, @typescript-eslint/no-empty-function
-  constructor(v?: IPartResponse) {
-  }
+  constructor(v?: IPublishRequest) {
+    this.listing = v?.listing && new strims_network_v1_directory_Listing(v.listing);
+  }
 
-  static encode(m: PartResponse, w?: Writer): Writer {
+  static encode(m: PublishRequest, w?: Writer): Writer {
     if (!w) w = new Writer();
+    if (m.listing) strims_network_v1_directory_Listing.encode(m.listing, w.uint32(10).fork()).ldelim();
     return w;
   }
 
-  static decode(r: Reader | Uint8Array, length?: number): PartResponse {
-    if (r instanceof Reader && length) r.skip(length);
-    return new PartResponse();
+  static decode(r: Reader | Uint8Array, length?: number): PublishRequest {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new PublishRequest();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.listing = strims_network_v1_directory_Listing.decode(r, r.uint32());
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
   }
 }
 
-export type IPingRequest = Record<string, any>;
+export type IPublishResponse = {
+  id?: bigint;
+}
 
-export class PingRequest {
+export class PublishResponse {
+  id: bigint;
 
-  // eslint-disable-next-line @typescript-eslint/no-unused-vars, @typescript-eslint/no-empty-function
-  constructor(v?: IPingRequest) {
+  constructor(v?: IPublishResponse) {
+    this.id = v?.id || BigInt(0);
   }
 
-  static encode(m: PingRequest, w?: Writer): Writer {
+  static encode(m: PublishResponse, w?: Writer): Writer {
     if (!w) w = new Writer();
+    if (m.id) w.uint32(8).uint64(m.id);
     return w;
   }
 
-  static decode(r: Reader | Uint8Array, length?: number): PingRequest {
-    if (r instanceof Reader && length) r.skip(length);
-    return new PingRequest();
+  static decode(r: Reader | Uint8Array, length?: number): PublishResponse {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new PublishResponse();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.id = r.uint64();
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
   }
 }
 
-export type IPingResponse = Record<string, any>;
+export type IUnpublishRequest = {
+  id?: bigint;
+}
 
-export class PingResponse {
+export class UnpublishRequest {
+  id: bigint;
+
+  constructor(v?: IUnpublishRequest) {
+    this.id = v?.id || BigInt(0);
+  }
+
+  static encode(m: UnpublishRequest, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.id) w.uint32(8).uint64(m.id);
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): UnpublishRequest {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new UnpublishRequest();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.id = r.uint64();
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export type IUnpublishResponse = Record<string, any>;
+
+export class UnpublishResponse {
 
   // eslint-disable-next-line @typescript-eslint/no-unused-vars, @typescript-eslint/no-empty-function
-  constructor(v?: IPingResponse) {
+  constructor(v?: IUnpublishResponse) {
   }
 
-  static encode(m: PingResponse, w?: Writer): Writer {
+  static encode(m: UnpublishResponse, w?: Writer): Writer {
     if (!w) w = new Writer();
     return w;
   }
 
-  static decode(r: Reader | Uint8Array, length?: number): PingResponse {
+  static decode(r: Reader | Uint8Array, length?: number): UnpublishResponse {
     if (r instanceof Reader && length) r.skip(length);
-    return new PingResponse();
+    return new UnpublishResponse();
   }
 }
 
-export type IModerateListingRequest = {
+export type IJoinRequest = {
+  query?: strims_network_v1_directory_IListingQuery;
+}
+
+export class JoinRequest {
+  query: strims_network_v1_directory_ListingQuery | undefined;
+
+  constructor(v?: IJoinRequest) {
+    this.query = v?.query && new strims_network_v1_directory_ListingQuery(v.query);
+  }
+
+  static encode(m: JoinRequest, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.query) strims_network_v1_directory_ListingQuery.encode(m.query, w.uint32(10).fork()).ldelim();
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): JoinRequest {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new JoinRequest();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.query = strims_network_v1_directory_ListingQuery.decode(r, r.uint32());
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export type IJoinResponse = {
   id?: bigint;
-  moderation?: strims_network_v1_directory_IListingModeration;
 }
 
-export class ModerateListingRequest {
+export class JoinResponse {
   id: bigint;
-  moderation: strims_network_v1_directory_ListingModeration | undefined;
 
-  constructor(v?: IModerateListingRequest) {
-    this.id = v?.id || BigInt(0);
-    this.moderation = v?.moderation && new strims_network_v1_directory_ListingModeration(v.moderation);
+  constructor(v?: IJoinResponse) {
+    this.id = v?.id || BigInt(0);
+  }
+
+  static encode(m: JoinResponse, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.id) w.uint32(8).uint64(m.id);
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): JoinResponse {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new JoinResponse();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.id = r.uint64();
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export type IPartRequest = {
+  id?: bigint;
+}
+
+export class PartRequest {
+  id: bigint;
+
+  constructor(v?: IPartRequest) {
+    this.id = v?.id || BigInt(0);
+  }
+
+  static encode(m: PartRequest, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.id) w.uint32(8).uint64(m.id);
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): PartRequest {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new PartRequest();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.id = r.uint64();
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export type IPartResponse = Record<string, any>;
+
+export class PartResponse {
+
+  // eslint-disable-next-line @typescript-eslint/no-unused-vars, @typescript-eslint/no-empty-function
+  constructor(v?: IPartResponse) {
+  }
+
+  static encode(m: PartResponse, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): PartResponse {
+    if (r instanceof Reader && length) r.skip(length);
+    return new PartResponse();
+  }
+}
+
+export type IScheduleRequest = {
+  listing?: strims_network_v1_directory_IListing;
+  snippet?: strims_network_v1_directory_IListingSnippet;
+  startTime?: bigint;
+}
+
+export class ScheduleRequest {
+  listing: strims_network_v1_directory_Listing | undefined;
+  snippet: strims_network_v1_directory_ListingSnippet | undefined;
+  startTime: bigint;
+
+  constructor(v?: IScheduleRequest) {
+    this.listing = v?.listing && new strims_network_v1_directory_Listing(v.listing);
+    this.snippet = v?.snippet && new strims_network_v1_directory_ListingSnippet(v.snippet);
+    this.startTime = v?.startTime || BigInt(0);
+  }
+
+  static encode(m: ScheduleRequest, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.listing) strims_network_v1_directory_Listing.encode(m.listing, w.uint32(10).fork()).ldelim();
+    if (m.snippet) strims_network_v1_directory_ListingSnippet.encode(m.snippet, w.uint32(18).fork()).ldelim();
+    if (m.startTime) w.uint32(24).int64(m.startTime);
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): ScheduleRequest {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new ScheduleRequest();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.listing = strims_network_v1_directory_Listing.decode(r, r.uint32());
+        break;
+        case 2:
+        m.snippet = strims_network_v1_directory_ListingSnippet.decode(r, r.uint32());
+        break;
+        case 3:
+        m.startTime = r.int64();
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export type IScheduleResponse = {
+  id?: bigint;
+}
+
+export class ScheduleResponse {
+  id: bigint;
+
+  constructor(v?: IScheduleResponse) {
+    this.id = v?.id || BigInt(0);
+  }
+
+  static encode(m: ScheduleResponse, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.id) w.uint32(8).uint64(m.id);
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): ScheduleResponse {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new ScheduleResponse();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.id = r.uint64();
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export type IUnscheduleRequest = {
+  id?: bigint;
+}
+
+export class UnscheduleRequest {
+  id: bigint;
+
+  constructor(v?: IUnscheduleRequest) {
+    this.id = v?.id || BigInt(0);
+  }
+
+  static encode(m: UnscheduleRequest, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.id) w.uint32(8).uint64(m.id);
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): UnscheduleRequest {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new UnscheduleRequest();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.id = r.uint64();
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export type IUnscheduleResponse = Record<string, any>;
+
+export class UnscheduleResponse {
+
+  // eslint-disable-next-line @typescript-eslint/no-unused-vars, @typescript-eslint/no-empty-function
+  constructor(v?: IUnscheduleResponse) {
+  }
+
+  static encode(m: UnscheduleResponse, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): UnscheduleResponse {
+    if (r instanceof Reader && length) r.skip(length);
+    return new UnscheduleResponse();
+  }
+}
+
+export type IGetListingStatsRequest = {
+  startTime?: bigint;
+  endTime?: bigint;
+  publisherKey?: Uint8Array;
+  listing?: strims_network_v1_directory_IListing;
+}
+
+export class GetListingStatsRequest {
+  startTime: bigint;
+  endTime: bigint;
+  publisherKey: Uint8Array;
+  listing: strims_network_v1_directory_Listing | undefined;
+
+  constructor(v?: IGetListingStatsRequest) {
+    this.startTime = v?.startTime || BigInt(0);
+    this.endTime = v?.endTime || BigInt(0);
+    this.publisherKey = v?.publisherKey || new Uint8Array();
+    this.listing = v?.listing && new strims_network_v1_directory_Listing(v.listing);
+  }
+
+  static encode(m: GetListingStatsRequest, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.startTime) w.uint32(8).int64(m.startTime);
+    if (m.endTime) w.uint32(16).int64(m.endTime);
+    if (m.publisherKey.length) w.uint32(26).bytes(m.publisherKey);
+    if (m.listing) strims_network_v1_directory_Listing.encode(m.listing, w.uint32(34).fork()).ldelim();
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): GetListingStatsRequest {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new GetListingStatsRequest();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.startTime = r.int64();
+        break;
+        case 2:
+        m.endTime = r.int64();
+        break;
+        case 3:
+        m.publisherKey = r.bytes();
+        break;
+        case 4:
+        m.listing = strims_network_v1_directory_Listing.decode(r, r.uint32());
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export type IGetListingStatsResponse = {
+  listings?: strims_network_v1_directory_IListingStatsItem[];
+  publishers?: strims_network_v1_directory_IPublisherStatsItem[];
+}
+
+export class GetListingStatsResponse {
+  listings: strims_network_v1_directory_ListingStatsItem[];
+  publishers: strims_network_v1_directory_PublisherStatsItem[];
+
+  constructor(v?: IGetListingStatsResponse) {
+    this.listings = v?.listings ? v.listings.map(v => new strims_network_v1_directory_ListingStatsItem(v)) : [];
+    this.publishers = v?.publishers ? v.publishers.map(v => new strims_network_v1_directory_PublisherStatsItem(v)) : [];
+  }
+
+  static encode(m: GetListingStatsResponse, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    for (const v of m.listings) strims_network_v1_directory_ListingStatsItem.encode(v, w.uint32(10).fork()).ldelim();
+    for (const v of m.publishers) strims_network_v1_directory_PublisherStatsItem.encode(v, w.uint32(18).fork()).ldelim();
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): GetListingStatsResponse {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new GetListingStatsResponse();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.listings.push(strims_network_v1_directory_ListingStatsItem.decode(r, r.uint32()));
+        break;
+        case 2:
+        m.publishers.push(strims_network_v1_directory_PublisherStatsItem.decode(r, r.uint32()));
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export type IPingRequest = Record<string, any>;
+
+export class PingRequest {
+
+  // eslint-disable-next-line @typescript-eslint/no-unused-vars, @typescript-eslint/no-empty-function
+  constructor(v?: IPingRequest) {
+  }
+
+  static encode(m: PingRequest, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): PingRequest {
+    if (r instanceof Reader && length) r.skip(length);
+    return new PingRequest();
+  }
+}
+
+export type IPingResponse = Record<string, any>;
+
+export class PingResponse {
+
+  // eslint-disable-next-line @typescript-eslint/no-unused-vars, @typescript-eslint/no-empty-function
+  constructor(v?: IPingResponse) {
+  }
+
+  static encode(m: PingResponse, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): PingResponse {
+    if (r instanceof Reader && length) r.skip(length);
+    return new PingResponse();
+  }
+}
+
+export type IModerateListingRequest = {
+  id?: bigint;
+  moderation?: strims_network_v1_directory_IListingModeration;
+}
+
+export class ModerateListingRequest {
+  id: bigint;
+  moderation: strims_network_v1_directory_ListingModeration | undefined;
+
+  constructor(v?: IModerateListingRequest) {
+    this.id = v?.id || BigInt(0);
+    this.moderation = v?.moderation && new strims_network_v1_directory_ListingModeration(v.moderation);
+  }
+
+  static encode(m: ModerateListingRequest, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.id) w.uint32(8).uint64(m.id);
+    if (m.moderation) strims_network_v1_directory_ListingModeration.encode(m.moderation, w.uint32(18).fork()).ldelim();
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): ModerateListingRequest {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new ModerateListingRequest();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.id = r.uint64();
+        break;
+        case 2:
+        m.moderation = strims_network_v1_directory_ListingModeration.decode(r, r.uint32());
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export type IModerateListingResponse = Record<string, any>;
+
+export class ModerateListingResponse {
+
+  // eslint-disable-next-line @typescript-eslint/no-unused-vars, @typescript-eslint/no-empty-function
+  constructor(v?: IModerateListingResponse) {
+  }
+
+  static encode(m: ModerateListingResponse, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): ModerateListingResponse {
+    if (r instanceof Reader && length) r.skip(length);
+    return new ModerateListingResponse();
+  }
+}
+
+export type IModerateUserRequest = {
+  peerKey?: Uint8Array;
+  moderation?: strims_network_v1_directory_IUserModeration;
+}
+
+export class ModerateUserRequest {
+  peerKey: Uint8Array;
+  moderation: strims_network_v1_directory_UserModeration | undefined;
+
+  constructor(v?: IModerateUserRequest) {
+    this.peerKey = v?.peerKey || new Uint8Array();
+    this.moderation = v?.moderation && new strims_network_v1_directory_UserModeration(v.moderation);
+  }
+
+  static encode(m: ModerateUserRequest, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.peerKey.length) w.uint32(10).bytes(m.peerKey);
+    if (m.moderation) strims_network_v1_directory_UserModeration.encode(m.moderation, w.uint32(18).fork()).ldelim();
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): ModerateUserRequest {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new ModerateUserRequest();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.peerKey = r.bytes();
+        break;
+        case 2:
+        m.moderation = strims_network_v1_directory_UserModeration.decode(r, r.uint32());
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export type IModerateUserResponse = Record<string, any>;
+
+export class ModerateUserResponse {
+
+  // eslint-disable-next-line @typescript-eslint/no-unused-vars, @typescript-eslint/no-empty-function
+  constructor(v?: IModerateUserResponse) {
+  }
+
+  static encode(m: ModerateUserResponse, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): ModerateUserResponse {
+    if (r instanceof Reader && length) r.skip(length);
+    return new ModerateUserResponse();
+  }
+}
+
+export type INetwork = {
+  id?: bigint;
+  name?: string;
+  key?: Uint8Array;
+}
+
+export class Network {
+  id: bigint;
+  name: string;
+  key: Uint8Array;
+
+  constructor(v?: INetwork) {
+    this.id = v?.id || BigInt(0);
+    this.name = v?.name || "";
+    this.key = v?.key || new Uint8Array();
+  }
+
+  static encode(m: Network, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.id) w.uint32(8).uint64(m.id);
+    if (m.name.length) w.uint32(18).string(m.name);
+    if (m.key.length) w.uint32(26).bytes(m.key);
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): Network {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new Network();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.id = r.uint64();
+        break;
+        case 2:
+        m.name = r.string();
+        break;
+        case 3:
+        m.key = r.bytes();
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export type INetworkListingsItem = {
+  id?: bigint;
+  listing?: strims_network_v1_directory_IListing;
+  snippet?: strims_network_v1_directory_IListingSnippet;
+  moderation?: strims_network_v1_directory_IListingModeration;
+  userCount?: number;
+  recentUserCount?: number;
+  upcoming?: boolean;
+  provenance?: strims_network_v1_directory_IListingProvenance;
+}
+
+export class NetworkListingsItem {
+  id: bigint;
+  listing: strims_network_v1_directory_Listing | undefined;
+  snippet: strims_network_v1_directory_ListingSnippet | undefined;
+  moderation: strims_network_v1_directory_ListingModeration | undefined;
+  userCount: number;
+  recentUserCount: number;
+  upcoming: boolean;
+  provenance: strims_network_v1_directory_ListingProvenance | undefined;
+
+  constructor(v?: INetworkListingsItem) {
+    this.id = v?.id || BigInt(0);
+    this.listing = v?.listing && new strims_network_v1_directory_Listing(v.listing);
+    this.snippet = v?.snippet && new strims_network_v1_directory_ListingSnippet(v.snippet);
+    this.moderation = v?.moderation && new strims_network_v1_directory_ListingModeration(v.moderation);
+    this.userCount = v?.userCount || 0;
+    this.recentUserCount = v?.recentUserCount || 0;
+    this.upcoming = v?.upcoming || false;
+    this.provenance = v?.provenance && new strims_network_v1_directory_ListingProvenance(v.provenance);
+  }
+
+  static encode(m: NetworkListingsItem, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.id) w.uint32(8).uint64(m.id);
+    if (m.listing) strims_network_v1_directory_Listing.encode(m.listing, w.uint32(18).fork()).ldelim();
+    if (m.snippet) strims_network_v1_directory_ListingSnippet.encode(m.snippet, w.uint32(26).fork()).ldelim();
+    if (m.moderation) strims_network_v1_directory_ListingModeration.encode(m.moderation, w.uint32(34).fork()).ldelim();
+    if (m.userCount) w.uint32(40).uint32(m.userCount);
+    if (m.recentUserCount) w.uint32(48).uint32(m.recentUserCount);
+    if (m.upcoming) w.uint32(56).bool(m.upcoming);
+    if (m.provenance) strims_network_v1_directory_ListingProvenance.encode(m.provenance, w.uint32(66).fork()).ldelim();
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): NetworkListingsItem {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new NetworkListingsItem();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.id = r.uint64();
+        break;
+        case 2:
+        m.listing = strims_network_v1_directory_Listing.decode(r, r.uint32());
+        break;
+        case 3:
+        m.snippet = strims_network_v1_directory_ListingSnippet.decode(r, r.uint32());
+        break;
+        case 4:
+        m.moderation = strims_network_v1_directory_ListingModeration.decode(r, r.uint32());
+        break;
+        case 5:
+        m.userCount = r.uint32();
+        break;
+        case 6:
+        m.recentUserCount = r.uint32();
+        break;
+        case 7:
+        m.upcoming = r.bool();
+        break;
+        case 8:
+        m.provenance = strims_network_v1_directory_ListingProvenance.decode(r, r.uint32());
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export type INetworkListings = {
+  network?: strims_network_v1_directory_INetwork;
+  listings?: strims_network_v1_directory_INetworkListingsItem[];
+}
+
+export class NetworkListings {
+  network: strims_network_v1_directory_Network | undefined;
+  listings: strims_network_v1_directory_NetworkListingsItem[];
+
+  constructor(v?: INetworkListings) {
+    this.network = v?.network && new strims_network_v1_directory_Network(v.network);
+    this.listings = v?.listings ? v.listings.map(v => new strims_network_v1_directory_NetworkListingsItem(v)) : [];
+  }
+
+  static encode(m: NetworkListings, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.network) strims_network_v1_directory_Network.encode(m.network, w.uint32(10).fork()).ldelim();
+    for (const v of m.listings) strims_network_v1_directory_NetworkListingsItem.encode(v, w.uint32(18).fork()).ldelim();
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): NetworkListings {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new NetworkListings();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.network = strims_network_v1_directory_Network.decode(r, r.uint32());
+        break;
+        case 2:
+        m.listings.push(strims_network_v1_directory_NetworkListingsItem.decode(r, r.uint32()));
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export type IFrontendPublishRequest = {
+  networkKey?: Uint8Array;
+  listing?: strims_network_v1_directory_IListing;
+  anonymous?: boolean;
+}
+
+export class FrontendPublishRequest {
+  networkKey: Uint8Array;
+  listing: strims_network_v1_directory_Listing | undefined;
+  anonymous: boolean;
+
+  constructor(v?: IFrontendPublishRequest) {
+    this.networkKey = v?.networkKey || new Uint8Array();
+    this.listing = v?.listing && new strims_network_v1_directory_Listing(v.listing);
+    this.anonymous = v?.anonymous || false;
+  }
+
+  static encode(m: FrontendPublishRequest, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.networkKey.length) w.uint32(10).bytes(m.networkKey);
+    if (m.listing) strims_network_v1_directory_Listing.encode(m.listing, w.uint32(18).fork()).ldelim();
+    if (m.anonymous) w.uint32(24).bool(m.anonymous);
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): FrontendPublishRequest {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new FrontendPublishRequest();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.networkKey = r.bytes();
+        break;
+        case 2:
+        m.listing = strims_network_v1_directory_Listing.decode(r, r.uint32());
+        break;
+        case 3:
+        m.anonymous = r.bool();
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export type IFrontendPublishResponse = {
+  id?: bigint;
+}
+
+export class FrontendPublishResponse {
+  id: bigint;
+
+  constructor(v?: IFrontendPublishResponse) {
+    this.id = v?.id || BigInt(0);
+  }
+
+  static encode(m: FrontendPublishResponse, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.id) w.uint32(8).uint64(m.id);
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): FrontendPublishResponse {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new FrontendPublishResponse();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.id = r.uint64();
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export type IFrontendUnpublishRequest = {
+  networkKey?: Uint8Array;
+  id?: bigint;
+  anonymous?: boolean;
+}
+
+export class FrontendUnpublishRequest {
+  networkKey: Uint8Array;
+  id: bigint;
+  anonymous: boolean;
+
+  constructor(v?: IFrontendUnpublishRequest) {
+    this.networkKey = v?.networkKey || new Uint8Array();
+    this.id = v?.id || BigInt(0);
+    this.anonymous = v?.anonymous || false;
+  }
+
+  static encode(m: FrontendUnpublishRequest, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.networkKey.length) w.uint32(10).bytes(m.networkKey);
+    if (m.id) w.uint32(16).uint64(m.id);
+    if (m.anonymous) w.uint32(24).bool(m.anonymous);
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): FrontendUnpublishRequest {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new FrontendUnpublishRequest();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.networkKey = r.bytes();
+        break;
+        case 2:
+        m.id = r.uint64();
+        break;
+        case 3:
+        m.anonymous = r.bool();
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export type IFrontendUnpublishResponse = Record<string, any>;
+
+export class FrontendUnpublishResponse {
+
+  // eslint-disable-next-line @typescript-eslint/no-unused-vars, @typescript-eslint/no-empty-function
+  constructor(v?: IFrontendUnpublishResponse) {
+  }
+
+  static encode(m: FrontendUnpublishResponse, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): FrontendUnpublishResponse {
+    if (r instanceof Reader && length) r.skip(length);
+    return new FrontendUnpublishResponse();
+  }
+}
+
+export type IFrontendJoinRequest = {
+  networkKey?: Uint8Array;
+  query?: strims_network_v1_directory_IListingQuery;
+}
+
+export class FrontendJoinRequest {
+  networkKey: Uint8Array;
+  query: strims_network_v1_directory_ListingQuery | undefined;
+
+  constructor(v?: IFrontendJoinRequest) {
+    this.networkKey = v?.networkKey || new Uint8Array();
+    this.query = v?.query && new strims_network_v1_directory_ListingQuery(v.query);
   }
 
-  static encode(m: ModerateListingRequest, w?: Writer): Writer {
+  static encode(m: FrontendJoinRequest, w?: Writer): Writer {
     if (!w) w = new Writer();
-    if (m.id) w.uint32(8).uint64(m.id);
-    if (m.moderation) strims_network_v1_directory_ListingModeration.encode(m.moderation, w.uint32(18).fork()).ldelim();
+    if (m.networkKey.length) w.uint32(10).bytes(m.networkKey);
+    if (m.query) strims_network_v1_directory_ListingQuery.encode(m.query, w.uint32(18).fork()).ldelim();
     return w;
   }
 
-  static decode(r: Reader | Uint8Array, length?: number): ModerateListingRequest {
+  static decode(r: Reader | Uint8Array, length?: number): FrontendJoinRequest {
     r = r instanceof Reader ? r : new Reader(r);
     const end = length === undefined ? r.len : r.pos + length;
-    const m = new ModerateListingRequest();
+    const m = new FrontendJoinRequest();
     while (r.pos < end) {
       const tag = r.uint32();
       switch (tag >> 3) {
         case 1:
-        m.id = r.uint64();
+        m.networkKey = r.bytes();
         break;
         case 2:
-        m.moderation = strims_network_v1_directory_ListingModeration.decode(r, r.uint32());
+        m.query = strims_network_v1_directory_ListingQuery.decode(r, r.uint32());
         break;
         default:
         r.skipType(tag & 7);
@@ -2539,58 +3895,75 @@ export class ModerateListingRequest {
   }
 }
 
-export type IModerateListingResponse = Record<string, any>;
+export type IFrontendJoinResponse = {
+  id?: bigint;
+}
 
-export class ModerateListingResponse {
+export class FrontendJoinResponse {
+  id: bigint;
 
-  // eslint-disable-next-line @typescript-eslint/no-unused-vars, @typescript-eslint/no-empty-function
-  constructor(v?: IModerateListingResponse) {
+  constructor(v?: IFrontendJoinResponse) {
+    this.id = v?.id || BigInt(0);
   }
 
-  static encode(m: ModerateListingResponse, w?: Writer): Writer {
+  static encode(m: FrontendJoinResponse, w?: Writer): Writer {
     if (!w) w = new Writer();
+    if (m.id) w.uint32(8).uint64(m.id);
     return w;
   }
 
-  static decode(r: Reader | Uint8Array, length?: number): ModerateListingResponse {
-    if (r instanceof Reader && length) r.skip(length);
-    return new ModerateListingResponse();
+  static decode(r: Reader | Uint8Array, length?: number): FrontendJoinResponse {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new FrontendJoinResponse();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.id = r.uint64();
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
   }
 }
 
-export type IModerateUserRequest = {
-  peerKey?: Uint8Array;
-  moderation?: strims_network_v1_directory_IUserModeration;
+export type IFrontendPartRequest = {
+  networkKey?: Uint8Array;
+  id?: bigint;
 }
 
-export class ModerateUserRequest {
-  peerKey: Uint8Array;
-  moderation: strims_network_v1_directory_UserModeration | undefined;
+export class FrontendPartRequest {
+  networkKey: Uint8Array;
+  id: bigint;
 
-  constructor(v?: IModerateUserRequest) {
-    this.peerKey = v?.peerKey || new Uint8Array();
-    this.moderation = v?.moderation && new strims_network_v1_directory_UserModeration(v.moderation);
+  constructor(v?: IFrontendPartRequest) {
+    this.networkKey = v?.networkKey || new Uint8Array();
+    this.id = v?.id || BigInt(0);
   }
 
-  static encode(m: ModerateUserRequest, w?: Writer): Writer {
+  static encode(m: FrontendPartRequest, w?: Writer): Writer {
     if (!w) w = new Writer();
-    if (m.peerKey.length) w.uint32(10).bytes(m.peerKey);
-    if (m.moderation) strims_network_v1_directory_UserModeration.encode(m.moderation, w.uint32(18).fork()).ldelim();
+    if (m.networkKey.length) w.uint32(10).bytes(m.networkKey);
+    if (m.id) w.uint32(16).uint64(m.id);
     return w;
   }
 
-  static decode(r: Reader | Uint8Array, length?: number): ModerateUserRequest {
+  static decode(r: Reader | Uint8Array, length?: number): FrontendPartRequest {
     r = r instanceof Reader ? r : new Reader(r);
     const end = length === undefined ? r.len : r.pos + length;
-    const m = new ModerateUserRequest();
+    const m = new FrontendPartRequest();
     while (r.pos < end) {
       const tag = r.uint32();
       switch (tag >> 3) {
         case 1:
-        m.peerKey = r.bytes();
+        m.networkKey = r.bytes();
         break;
         case 2:
-        m.moderation = strims_network_v1_directory_UserModeration.decode(r, r.uint32());
+        m.id = r.uint64();
         break;
         default:
         r.skipType(tag & 7);
@@ -2601,65 +3974,72 @@ export class ModerateUserRequest {
   }
 }
 
-export type IModerateUserResponse = Record<string, any>;
+export type IFrontendPartResponse = Record<string, any>;
 
-export class ModerateUserResponse {
+export class FrontendPartResponse {
 
   // eslint-disable-next-line @typescript-eslint/no-unused-vars, @typescript-eslint/no-empty-function
-  constructor(v?: IModerateUserResponse) {
+  constructor(v?: IFrontendPartResponse) {
   }
 
-  static encode(m: ModerateUserResponse, w?: Writer): Writer {
+  static encode(m: FrontendPartResponse, w?: Writer): Writer {
     if (!w) w = new Writer();
     return w;
   }
 
-  static decode(r: Reader | Uint8Array, length?: number): ModerateUserResponse {
+  static decode(r: Reader | Uint8Array, length?: number): FrontendPartResponse {
     if (r instanceof Reader && length) r.skip(length);
-    return new ModerateUserResponse();
+    return new FrontendPartResponse();
   }
 }
 
-export type INetwork = {
-  id?: bigint;
-  name?: string;
-  key?: Uint8Array;
+export type IFrontendScheduleRequest = {
+  networkKey?: Uint8Array;
+  listing?: strims_network_v1_directory_IListing;
+  snippet?: strims_network_v1_directory_IListingSnippet;
+  startTime?: bigint;
 }
 
-export class Network {
-  id: bigint;
-  name: string;
-  key: Uint8Array;
+export class FrontendScheduleRequest {
+  networkKey: Uint8Array;
+  listing: strims_network_v1_directory_Listing | undefined;
+  snippet: strims_network_v1_directory_ListingSnippet | undefined;
+  startTime: bigint;
 
-  constructor(v?: INetwork) {
-    this.id = v?.id || BigInt(0);
-    this.name = v?.name || "";
-    this.key = v?.key || new Uint8Array();
+  constructor(v?: IFrontendScheduleRequest) {
+    this.networkKey = v?.networkKey || new Uint8Array();
+    this.listing = v?.listing && new strims_network_v1_directory_Listing(v.listing);
+    this.snippet = v?.snippet && new strims_network_v1_directory_ListingSnippet(v.snippet);
+    this.startTime = v?.startTime || BigInt(0);
   }
 
-  static encode(m: Network, w?: Writer): Writer {
+  static encode(m: FrontendScheduleRequest, w?: Writer): Writer {
     if (!w) w = new Writer();
-    if (m.id) w.uint32(8).uint64(m.id);
-    if (m.name.length) w.uint32(18).string(m.name);
-    if (m.key.length) w.uint32(26).bytes(m.key);
+    if (m.networkKey.length) w.uint32(10).bytes(m.networkKey);
+    if (m.listing) strims_network_v1_directory_Listing.encode(m.listing, w.uint32(18).fork()).ldelim();
+    if (m.snippet) strims_network_v1_directory_ListingSnippet.encode(m.snippet, w.uint32(26).fork()).ldelim();
+    if (m.startTime) w.uint32(32).int64(m.startTime);
     return w;
   }
 
-  static decode(r: Reader | Uint8Array, length?: number): Network {
+  static decode(r: Reader | Uint8Array, length?: number): FrontendScheduleRequest {
     r = r instanceof Reader ? r : new Reader(r);
     const end = length === undefined ? r.len : r.pos + length;
-    const m = new Network();
+    const m = new FrontendScheduleRequest();
     while (r.pos < end) {
       const tag = r.uint32();
       switch (tag >> 3) {
         case 1:
-        m.id = r.uint64();
+        m.networkKey = r.bytes();
         break;
         case 2:
-        m.name = r.string();
+        m.listing = strims_network_v1_directory_Listing.decode(r, r.uint32());
         break;
         case 3:
-        m.key = r.bytes();
+        m.snippet = strims_network_v1_directory_ListingSnippet.decode(r, r.uint32());
+        break;
+        case 4:
+        m.startTime = r.int64();
         break;
         default:
         r.skipType(tag & 7);
@@ -2670,68 +4050,33 @@ export class Network {
   }
 }
 
-export type INetworkListingsItem = {
+export type IFrontendScheduleResponse = {
   id?: bigint;
-  listing?: strims_network_v1_directory_IListing;
-  snippet?: strims_network_v1_directory_IListingSnippet;
-  moderation?: strims_network_v1_directory_IListingModeration;
-  userCount?: number;
-  recentUserCount?: number;
 }
 
-export class NetworkListingsItem {
+export class FrontendScheduleResponse {
   id: bigint;
-  listing: strims_network_v1_directory_Listing | undefined;
-  snippet: strims_network_v1_directory_ListingSnippet | undefined;
-  moderation: strims_network_v1_directory_ListingModeration | undefined;
-  userCount: number;
-  recentUserCount: number;
 
-  constructor(v?: INetworkListingsItem) {
+  constructor(v?: IFrontendScheduleResponse) {
     this.id = v?.id || BigInt(0);
-    this.listing = v?.listing && new strims_network_v1_directory_Listing(v.listing);
-    this.snippet = v?.snippet && new strims_network_v1_directory_ListingSnippet(v.snippet);
-    this.moderation = v?.moderation && new strims_network_v1_directory_ListingModeration(v.moderation);
-    this.userCount = v?.userCount || 0;
-    this.recentUserCount = v?.recentUserCount || 0;
   }
 
-  static encode(m: NetworkListingsItem, w?: Writer): Writer {
+  static encode(m: FrontendScheduleResponse, w?: Writer): Writer {
     if (!w) w = new Writer();
     if (m.id) w.uint32(8).uint64(m.id);
-    if (m.listing) strims_network_v1_directory_Listing.encode(m.listing, w.uint32(18).fork()).ldelim();
-    if (m.snippet) strims_network_v1_directory_ListingSnippet.encode(m.snippet, w.uint32(26).fork()).ldelim();
-    if (m.moderation) strims_network_v1_directory_ListingModeration.encode(m.moderation, w.uint32(34).fork()).ldelim();
-    if (m.userCount) w.uint32(40).uint32(m.userCount);
-    if (m.recentUserCount) w.uint32(48).uint32(m.recentUserCount);
     return w;
   }
 
-  static decode(r: Reader | Uint8Array, length?: number): NetworkListingsItem {
+  static decode(r: Reader | Uint8Array, length?: number): FrontendScheduleResponse {
     r = r instanceof Reader ? r : new Reader(r);
     const end = length === undefined ? r.len : r.pos + length;
-    const m = new NetworkListingsItem();
+    const m = new FrontendScheduleResponse();
     while (r.pos < end) {
       const tag = r.uint32();
       switch (tag >> 3) {
         case 1:
         m.id = r.uint64();
         break;
-        case 2:
-        m.listing = strims_network_v1_directory_Listing.decode(r, r.uint32());
-        break;
-        case 3:
-        m.snippet = strims_network_v1_directory_ListingSnippet.decode(r, r.uint32());
-        break;
-        case 4:
-        m.moderation = strims_network_v1_directory_ListingModeration.decode(r, r.uint32());
-        break;
-        case 5:
-        m.userCount = r.uint32();
-        break;
-        case 6:
-        m.recentUserCount = r.uint32();
-        break;
         default:
         r.skipType(tag & 7);
         break;
@@ -2741,39 +4086,39 @@ export class NetworkListingsItem {
   }
 }
 
-export type INetworkListings = {
-  network?: strims_network_v1_directory_INetwork;
-  listings?: strims_network_v1_directory_INetworkListingsItem[];
+export type IFrontendUnscheduleRequest = {
+  networkKey?: Uint8Array;
+  id?: bigint;
 }
 
-export class NetworkListings {
-  network: strims_network_v1_directory_Network | undefined;
-  listings: strims_network_v1_directory_NetworkListingsItem[];
+export class FrontendUnscheduleRequest {
+  networkKey: Uint8Array;
+  id: bigint;
 
-  constructor(v?: INetworkListings) {
-    this.network = v?.network && new strims_network_v1_directory_Network(v.network);
-    this.listings = v?.listings ? v.listings.map(v => new strims_network_v1_directory_NetworkListingsItem(v)) : [];
+  constructor(v?: IFrontendUnscheduleRequest) {
+    this.networkKey = v?.networkKey || new Uint8Array();
+    this.id = v?.id || BigInt(0);
   }
 
-  static encode(m: NetworkListings, w?: Writer): Writer {
+  static encode(m: FrontendUnscheduleRequest, w?: Writer): Writer {
     if (!w) w = new Writer();
-    if (m.network) strims_network_v1_directory_Network.encode(m.network, w.uint32(10).fork()).ldelim();
-    for (const v of m.listings) strims_network_v1_directory_NetworkListingsItem.encode(v, w.uint32(18).fork()).ldelim();
+    if (m.networkKey.length) w.uint32(10).bytes(m.networkKey);
+    if (m.id) w.uint32(16).uint64(m.id);
     return w;
   }
 
-  static decode(r: Reader | Uint8Array, length?: number): NetworkListings {
+  static decode(r: Reader | Uint8Array, length?: number): FrontendUnscheduleRequest {
     r = r instanceof Reader ? r : new Reader(r);
     const end = length === undefined ? r.len : r.pos + length;
-    const m = new NetworkListings();
+    const m = new FrontendUnscheduleRequest();
     while (r.pos < end) {
       const tag = r.uint32();
       switch (tag >> 3) {
         case 1:
-        m.network = strims_network_v1_directory_Network.decode(r, r.uint32());
+        m.networkKey = r.bytes();
         break;
         case 2:
-        m.listings.push(strims_network_v1_directory_NetworkListingsItem.decode(r, r.uint32()));
+        m.id = r.uint64();
         break;
         default:
         r.skipType(tag & 7);
@@ -2784,31 +4129,50 @@ export class NetworkListings {
   }
 }
 
-export type IFrontendPublishRequest = {
+export type IFrontendUnscheduleResponse = Record<string, any>;
+
+export class FrontendUnscheduleResponse {
+
+  // eslint-disable-next-line @typescript-eslint/no-unused-vars, @typescript-eslint/no-empty-function
+  constructor(v?: IFrontendUnscheduleResponse) {
+  }
+
+  static encode(m: FrontendUnscheduleResponse, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): FrontendUnscheduleResponse {
+    if (r instanceof Reader && length) r.skip(length);
+    return new FrontendUnscheduleResponse();
+  }
+}
+
+export type IFrontendSubscribeListingRequest = {
   networkKey?: Uint8Array;
-  listing?: strims_network_v1_directory_IListing;
+  query?: strims_network_v1_directory_IListingQuery;
 }
 
-export class FrontendPublishRequest {
+export class FrontendSubscribeListingRequest {
   networkKey: Uint8Array;
-  listing: strims_network_v1_directory_Listing | undefined;
+  query: strims_network_v1_directory_ListingQuery | undefined;
 
-  constructor(v?: IFrontendPublishRequest) {
+  constructor(v?: IFrontendSubscribeListingRequest) {
     this.networkKey = v?.networkKey || new Uint8Array();
-    this.listing = v?.listing && new strims_network_v1_directory_Listing(v.listing);
+    this.query = v?.query && new strims_network_v1_directory_ListingQuery(v.query);
   }
 
-  static encode(m: FrontendPublishRequest, w?: Writer): Writer {
+  static encode(m: FrontendSubscribeListingRequest, w?: Writer): Writer {
     if (!w) w = new Writer();
     if (m.networkKey.length) w.uint32(10).bytes(m.networkKey);
-    if (m.listing) strims_network_v1_directory_Listing.encode(m.listing, w.uint32(18).fork()).ldelim();
+    if (m.query) strims_network_v1_directory_ListingQuery.encode(m.query, w.uint32(18).fork()).ldelim();
     return w;
   }
 
-  static decode(r: Reader | Uint8Array, length?: number): FrontendPublishRequest {
+  static decode(r: Reader | Uint8Array, length?: number): FrontendSubscribeListingRequest {
     r = r instanceof Reader ? r : new Reader(r);
     const end = length === undefined ? r.len : r.pos + length;
-    const m = new FrontendPublishRequest();
+    const m = new FrontendSubscribeListingRequest();
     while (r.pos < end) {
       const tag = r.uint32();
       switch (tag >> 3) {
@@ -2816,7 +4180,7 @@ export class FrontendPublishRequest {
         m.networkKey = r.bytes();
         break;
         case 2:
-        m.listing = strims_network_v1_directory_Listing.decode(r, r.uint32());
+        m.query = strims_network_v1_directory_ListingQuery.decode(r, r.uint32());
         break;
         default:
         r.skipType(tag & 7);
@@ -2827,32 +4191,32 @@ export class FrontendPublishRequest {
   }
 }
 
-export type IFrontendPublishResponse = {
-  id?: bigint;
+export type IFrontendSubscribeListingResponse = {
+  subscription?: strims_network_v1_directory_IListingSubscription;
 }
 
-export class FrontendPublishResponse {
-  id: bigint;
+export class FrontendSubscribeListingResponse {
+  subscription: strims_network_v1_directory_ListingSubscription | undefined;
 
-  constructor(v?: IFrontendPublishResponse) {
-    this.id = v?.id || BigInt(0);
+  constructor(v?: IFrontendSubscribeListingResponse) {
+    this.subscription = v?.subscription && new strims_network_v1_directory_ListingSubscription(v.subscription);
   }
 
-  static encode(m: FrontendPublishResponse, w?: Writer): Writer {
+  static encode(m: FrontendSubscribeListingResponse, w?: Writer): Writer {
     if (!w) w = new Writer();
-    if (m.id) w.uint32(8).uint64(m.id);
+    if (m.subscription) strims_network_v1_directory_ListingSubscription.encode(m.subscription, w.uint32(10).fork()).ldelim();
     return w;
   }
 
-  static decode(r: Reader | Uint8Array, length?: number): FrontendPublishResponse {
+  static decode(r: Reader | Uint8Array, length?: number): FrontendSubscribeListingResponse {
     r = r instanceof Reader ? r : new Reader(r);
     const end = length === undefined ? r.len : r.pos + length;
-    const m = new FrontendPublishResponse();
+    const m = new FrontendSubscribeListingResponse();
     while (r.pos < end) {
       const tag = r.uint32();
       switch (tag >> 3) {
         case 1:
-        m.id = r.uint64();
+        m.subscription = strims_network_v1_directory_ListingSubscription.decode(r, r.uint32());
         break;
         default:
         r.skipType(tag & 7);
@@ -2863,38 +4227,31 @@ export class FrontendPublishResponse {
   }
 }
 
-export type IFrontendUnpublishRequest = {
-  networkKey?: Uint8Array;
+export type IFrontendUnsubscribeListingRequest = {
   id?: bigint;
 }
-
-export class FrontendUnpublishRequest {
-  networkKey: Uint8Array;
+
+export class FrontendUnsubscribeListingRequest {
   id: bigint;
 
-  constructor(v?: IFrontendUnpublishRequest) {
-    this.networkKey = v?.networkKey || new Uint8Array();
+  constructor(v?: IFrontendUnsubscribeListingRequest) {
     this.id = v?.id || BigInt(0);
   }
 
-  static encode(m: FrontendUnpublishRequest, w?: Writer): Writer {
+  static encode(m: FrontendUnsubscribeListingRequest, w?: Writer): Writer {
     if (!w) w = new Writer();
-    if (m.networkKey.length) w.uint32(10).bytes(m.networkKey);
-    if (m.id) w.uint32(16).uint64(m.id);
+    if (m.id) w.uint32(8).uint64(m.id);
     return w;
   }
 
-  static decode(r: Reader | Uint8Array, length?: number): FrontendUnpublishRequest {
+  static decode(r: Reader | Uint8Array, length?: number): FrontendUnsubscribeListingRequest {
     r = r instanceof Reader ? r : new Reader(r);
     const end = length === undefined ? r.len : r.pos + length;
-    const m = new FrontendUnpublishRequest();
+    const m = new FrontendUnsubscribeListingRequest();
     while (r.pos < end) {
       const tag = r.uint32();
       switch (tag >> 3) {
         case 1:
-        m.networkKey = r.bytes();
-        break;
-        case 2:
         m.id = r.uint64();
         break;
         default:
@@ -2906,94 +4263,70 @@ export class FrontendUnpublishRequest {
   }
 }
 
-export type IFrontendUnpublishResponse = Record<string, any>;
+export type IFrontendUnsubscribeListingResponse = Record<string, any>;
 
-export class FrontendUnpublishResponse {
+export class FrontendUnsubscribeListingResponse {
 
   // eslint-disable-next-line @typescript-eslint/no-unused-vars, @typescript-eslint/no-empty-function
-  constructor(v?: IFrontendUnpublishResponse) {
+  constructor(v?: IFrontendUnsubscribeListingResponse) {
   }
 
-  static encode(m: FrontendUnpublishResponse, w?: Writer): Writer {
+  static encode(m: FrontendUnsubscribeListingResponse, w?: Writer): Writer {
     if (!w) w = new Writer();
     return w;
   }
 
-  static decode(r: Reader | Uint8Array, length?: number): FrontendUnpublishResponse {
+  static decode(r: Reader | Uint8Array, length?: number): FrontendUnsubscribeListingResponse {
     if (r instanceof Reader && length) r.skip(length);
-    return new FrontendUnpublishResponse();
+    return new FrontendUnsubscribeListingResponse();
   }
 }
 
-export type IFrontendJoinRequest = {
-  networkKey?: Uint8Array;
-  query?: strims_network_v1_directory_IListingQuery;
-}
+export type IFrontendListListingSubscriptionsRequest = Record<string, any>;
 
-export class FrontendJoinRequest {
-  networkKey: Uint8Array;
-  query: strims_network_v1_directory_ListingQuery | undefined;
+export class FrontendListListingSubscriptionsRequest {
 
-  constructor(v?: IFrontendJoinRequest) {
-    this.networkKey = v?.networkKey || new Uint8Array();
-    this.query = v?.query && new strims_network_v1_directory_ListingQuery(v.query);
+  // eslint-disable-next-line @typescript-eslint/no-unused-vars, @typescript-eslint/no-empty-function
+  constructor(v?: IFrontendListListingSubscriptionsRequest) {
   }
 
-  static encode(m: FrontendJoinRequest, w?: Writer): Writer {
+  static encode(m: FrontendListListingSubscriptionsRequest, w?: Writer): Writer {
     if (!w) w = new Writer();
-    if (m.networkKey.length) w.uint32(10).bytes(m.networkKey);
-    if (m.query) strims_network_v1_directory_ListingQuery.encode(m.query, w.uint32(18).fork()).ldelim();
     return w;
   }
 
-  static decode(r: Reader | Uint8Array, length?: number): FrontendJoinRequest {
-    r = r instanceof Reader ? r : new Reader(r);
-    const end = length === undefined ? r.len : r.pos + length;
-    const m = new FrontendJoinRequest();
-    while (r.pos < end) {
-      const tag = r.uint32();
-      switch (tag >> 3) {
-        case 1:
-        m.networkKey = r.bytes();
-        break;
-        case 2:
-        m.query = strims_network_v1_directory_ListingQuery.decode(r, r.uint32());
-        break;
-        default:
-        r.skipType(tag & 7);
-        break;
-      }
-    }
-    return m;
+  static decode(r: Reader | Uint8Array, length?: number): FrontendListListingSubscriptionsRequest {
+    if (r instanceof Reader && length) r.skip(length);
+    return new FrontendListListingSubscriptionsRequest();
   }
 }
 
-export type IFrontendJoinResponse = {
-  id?: bigint;
+export type IFrontendListListingSubscriptionsResponse = {
+  subscriptions?: strims_network_v1_directory_IListingSubscription[];
 }
 
-export class FrontendJoinResponse {
-  id: bigint;
+export class FrontendListListingSubscriptionsResponse {
+  subscriptions: strims_network_v1_directory_ListingSubscription[];
 
-  constructor(v?: IFrontendJoinResponse) {
-    this.id = v?.id || BigInt(0);
+  constructor(v?: IFrontendListListingSubscriptionsResponse) {
+    this.subscriptions = v?.subscriptions ? v.subscriptions.map(v => new strims_network_v1_directory_ListingSubscription(v)) : [];
   }
 
-  static encode(m: FrontendJoinResponse, w?: Writer): Writer {
+  static encode(m: FrontendListListingSubscriptionsResponse, w?: Writer): Writer {
     if (!w) w = new Writer();
-    if (m.id) w.uint32(8).uint64(m.id);
+    for (const v of m.subscriptions) strims_network_v1_directory_ListingSubscription.encode(v, w.uint32(10).fork()).ldelim();
     return w;
   }
 
-  static decode(r: Reader | Uint8Array, length?: number): FrontendJoinResponse {
+  static decode(r: Reader | Uint8Array, length?: number): FrontendListListingSubscriptionsResponse {
     r = r instanceof Reader ? r : new Reader(r);
     const end = length === undefined ? r.len : r.pos + length;
-    const m = new FrontendJoinResponse();
+    const m = new FrontendListListingSubscriptionsResponse();
     while (r.pos < end) {
       const tag = r.uint32();
       switch (tag >> 3) {
         case 1:
-        m.id = r.uint64();
+        m.subscriptions.push(strims_network_v1_directory_ListingSubscription.decode(r, r.uint32()));
         break;
         default:
         r.skipType(tag & 7);
@@ -3004,31 +4337,43 @@ export class FrontendJoinResponse {
   }
 }
 
-export type IFrontendPartRequest = {
+export type IFrontendGetListingStatsRequest = {
   networkKey?: Uint8Array;
-  id?: bigint;
+  startTime?: bigint;
+  endTime?: bigint;
+  publisherKey?: Uint8Array;
+  listing?: strims_network_v1_directory_IListing;
 }
 
-export class FrontendPartRequest {
+export class FrontendGetListingStatsRequest {
   networkKey: Uint8Array;
-  id: bigint;
+  startTime: bigint;
+  endTime: bigint;
+  publisherKey: Uint8Array;
+  listing: strims_network_v1_directory_Listing | undefined;
 
-  constructor(v?: IFrontendPartRequest) {
+  constructor(v?: IFrontendGetListingStatsRequest) {
     this.networkKey = v?.networkKey || new Uint8Array();
-    this.id = v?.id || BigInt(0);
+    this.startTime = v?.startTime || BigInt(0);
+    this.endTime = v?.endTime || BigInt(0);
+    this.publisherKey = v?.publisherKey || new Uint8Array();
+    this.listing = v?.listing && new strims_network_v1_directory_Listing(v.listing);
   }
 
-  static encode(m: FrontendPartRequest, w?: Writer): Writer {
+  static encode(m: FrontendGetListingStatsRequest, w?: Writer): Writer {
     if (!w) w = new Writer();
     if (m.networkKey.length) w.uint32(10).bytes(m.networkKey);
-    if (m.id) w.uint32(16).uint64(m.id);
+    if (m.startTime) w.uint32(16).int64(m.startTime);
+    if (m.endTime) w.uint32(24).int64(m.endTime);
+    if (m.publisherKey.length) w.uint32(34).bytes(m.publisherKey);
+    if (m.listing) strims_network_v1_directory_Listing.encode(m.listing, w.uint32(42).fork()).ldelim();
     return w;
   }
 
-  static decode(r: Reader | Uint8Array, length?: number): FrontendPartRequest {
+  static decode(r: Reader | Uint8Array, length?: number): FrontendGetListingStatsRequest {
     r = r instanceof Reader ? r : new Reader(r);
     const end = length === undefined ? r.len : r.pos + length;
-    const m = new FrontendPartRequest();
+    const m = new FrontendGetListingStatsRequest();
     while (r.pos < end) {
       const tag = r.uint32();
       switch (tag >> 3) {
@@ -3036,7 +4381,16 @@ export class FrontendPartRequest {
         m.networkKey = r.bytes();
         break;
         case 2:
-        m.id = r.uint64();
+        m.startTime = r.int64();
+        break;
+        case 3:
+        m.endTime = r.int64();
+        break;
+        case 4:
+        m.publisherKey = r.bytes();
+        break;
+        case 5:
+        m.listing = strims_network_v1_directory_Listing.decode(r, r.uint32());
         break;
         default:
         r.skipType(tag & 7);
@@ -3047,22 +4401,46 @@ export class FrontendPartRequest {
   }
 }
 
-export type IFrontendPartResponse = Record<string, any>;
+export type IFrontendGetListingStatsResponse = {
+  listings?: strims_network_v1_directory_IListingStatsItem[];
+  publishers?: strims_network_v1_directory_IPublisherStatsItem[];
+}
 
-export class FrontendPartResponse {
+export class FrontendGetListingStatsResponse {
+  listings: strims_network_v1_directory_ListingStatsItem[];
+  publishers: strims_network_v1_directory_PublisherStatsItem[];
 
-  // eslint-disable-next-line @typescript-eslint/no-unused-vars, @typescript-eslint/no-empty-function
-  constructor(v?: IFrontendPartResponse) {
+  constructor(v?: IFrontendGetListingStatsResponse) {
+    this.listings = v?.listings ? v.listings.map(v => new strims_network_v1_directory_ListingStatsItem(v)) : [];
+    this.publishers = v?.publishers ? v.publishers.map(v => new strims_network_v1_directory_PublisherStatsItem(v)) : [];
   }
 
-  static encode(m: FrontendPartResponse, w?: Writer): Writer {
+  static encode(m: FrontendGetListingStatsResponse, w?: Writer): Writer {
     if (!w) w = new Writer();
+    for (const v of m.listings) strims_network_v1_directory_ListingStatsItem.encode(v, w.uint32(10).fork()).ldelim();
+    for (const v of m.publishers) strims_network_v1_directory_PublisherStatsItem.encode(v, w.uint32(18).fork()).ldelim();
     return w;
   }
 
-  static decode(r: Reader | Uint8Array, length?: number): FrontendPartResponse {
-    if (r instanceof Reader && length) r.skip(length);
-    return new FrontendPartResponse();
+  static decode(r: Reader | Uint8Array, length?: number): FrontendGetListingStatsResponse {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new FrontendGetListingStatsResponse();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.listings.push(strims_network_v1_directory_ListingStatsItem.decode(r, r.uint32()));
+        break;
+        case 2:
+        m.publishers.push(strims_network_v1_directory_PublisherStatsItem.decode(r, r.uint32()));
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
   }
 }
 
@@ -3478,6 +4856,8 @@ export type IFrontendGetListingResponse = {
   moderation?: strims_network_v1_directory_IListingModeration;
   userCount?: number;
   recentUserCount?: number;
+  upcoming?: boolean;
+  provenance?: strims_network_v1_directory_IListingProvenance;
 }
 
 export class FrontendGetListingResponse {
@@ -3487,6 +4867,8 @@ export class FrontendGetListingResponse {
   moderation: strims_network_v1_directory_ListingModeration | undefined;
   userCount: number;
   recentUserCount: number;
+  upcoming: boolean;
+  provenance: strims_network_v1_directory_ListingProvenance | undefined;
 
   constructor(v?: IFrontendGetListingResponse) {
     this.id = v?.id || BigInt(0);
@@ -3495,6 +4877,8 @@ export class FrontendGetListingResponse {
     this.moderation = v?.moderation && new strims_network_v1_directory_ListingModeration(v.moderation);
     this.userCount = v?.userCount || 0;
     this.recentUserCount = v?.recentUserCount || 0;
+    this.upcoming = v?.upcoming || false;
+    this.provenance = v?.provenance && new strims_network_v1_directory_ListingProvenance(v.provenance);
   }
 
   static encode(m: FrontendGetListingResponse, w?: Writer): Writer {
@@ -3505,6 +4889,8 @@ export class FrontendGetListingResponse {
     if (m.moderation) strims_network_v1_directory_ListingModeration.encode(m.moderation, w.uint32(34).fork()).ldelim();
     if (m.userCount) w.uint32(40).uint32(m.userCount);
     if (m.recentUserCount) w.uint32(48).uint32(m.recentUserCount);
+    if (m.upcoming) w.uint32(56).bool(m.upcoming);
+    if (m.provenance) strims_network_v1_directory_ListingProvenance.encode(m.provenance, w.uint32(66).fork()).ldelim();
     return w;
   }
 
@@ -3533,6 +4919,69 @@ export class FrontendGetListingResponse {
         case 6:
         m.recentUserCount = r.uint32();
         break;
+        case 7:
+        m.upcoming = r.bool();
+        break;
+        case 8:
+        m.provenance = strims_network_v1_directory_ListingProvenance.decode(r, r.uint32());
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export type IListingFilter = {
+  search?: string;
+  tags?: string[];
+  categories?: string[];
+  contentTypes?: strims_network_v1_directory_ListingContentType[];
+}
+
+export class ListingFilter {
+  search: string;
+  tags: string[];
+  categories: string[];
+  contentTypes: strims_network_v1_directory_ListingContentType[];
+
+  constructor(v?: IListingFilter) {
+    this.search = v?.search || "";
+    this.tags = v?.tags ? v.tags : [];
+    this.categories = v?.categories ? v.categories : [];
+    this.contentTypes = v?.contentTypes ? v.contentTypes : [];
+  }
+
+  static encode(m: ListingFilter, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.search.length) w.uint32(10).string(m.search);
+    for (const v of m.tags) w.uint32(18).string(v);
+    for (const v of m.categories) w.uint32(26).string(v);
+    m.contentTypes.reduce((w, v) => w.uint32(v), w.uint32(34).fork()).ldelim();
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): ListingFilter {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new ListingFilter();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.search = r.string();
+        break;
+        case 2:
+        m.tags.push(r.string())
+        break;
+        case 3:
+        m.categories.push(r.string())
+        break;
+        case 4:
+        for (const flen = r.uint32(), fend = r.pos + flen; r.pos < fend;) m.contentTypes.push(r.uint32());
+        break;
         default:
         r.skipType(tag & 7);
         break;
@@ -3545,21 +4994,29 @@ export class FrontendGetListingResponse {
 export type IFrontendGetListingsRequest = {
   contentTypes?: strims_network_v1_directory_ListingContentType[];
   networkKeys?: Uint8Array[];
+  filter?: strims_network_v1_directory_IListingFilter;
+  sortOrder?: strims_network_v1_directory_ListingSortOrder;
 }
 
 export class FrontendGetListingsRequest {
   contentTypes: strims_network_v1_directory_ListingContentType[];
   networkKeys: Uint8Array[];
+  filter: strims_network_v1_directory_ListingFilter | undefined;
+  sortOrder: strims_network_v1_directory_ListingSortOrder;
 
   constructor(v?: IFrontendGetListingsRequest) {
     this.contentTypes = v?.contentTypes ? v.contentTypes : [];
     this.networkKeys = v?.networkKeys ? v.networkKeys : [];
+    this.filter = v?.filter && new strims_network_v1_directory_ListingFilter(v.filter);
+    this.sortOrder = v?.sortOrder || 0;
   }
 
   static encode(m: FrontendGetListingsRequest, w?: Writer): Writer {
     if (!w) w = new Writer();
     m.contentTypes.reduce((w, v) => w.uint32(v), w.uint32(10).fork()).ldelim();
     for (const v of m.networkKeys) w.uint32(18).bytes(v);
+    if (m.filter) strims_network_v1_directory_ListingFilter.encode(m.filter, w.uint32(26).fork()).ldelim();
+    if (m.sortOrder) w.uint32(32).uint32(m.sortOrder);
     return w;
   }
 
@@ -3576,6 +5033,12 @@ export class FrontendGetListingsRequest {
         case 2:
         m.networkKeys.push(r.bytes())
         break;
+        case 3:
+        m.filter = strims_network_v1_directory_ListingFilter.decode(r, r.uint32());
+        break;
+        case 4:
+        m.sortOrder = r.uint32();
+        break;
         default:
         r.skipType(tag & 7);
         break;
@@ -3625,17 +5088,23 @@ export type IFrontendWatchListingsRequest = {
   contentTypes?: strims_network_v1_directory_ListingContentType[];
   networkKeys?: Uint8Array[];
   listingId?: bigint;
+  filter?: strims_network_v1_directory_IListingFilter;
+  sortOrder?: strims_network_v1_directory_ListingSortOrder;
 }
 
 export class FrontendWatchListingsRequest {
   contentTypes: strims_network_v1_directory_ListingContentType[];
   networkKeys: Uint8Array[];
   listingId: bigint;
+  filter: strims_network_v1_directory_ListingFilter | undefined;
+  sortOrder: strims_network_v1_directory_ListingSortOrder;
 
   constructor(v?: IFrontendWatchListingsRequest) {
     this.contentTypes = v?.contentTypes ? v.contentTypes : [];
     this.networkKeys = v?.networkKeys ? v.networkKeys : [];
     this.listingId = v?.listingId || BigInt(0);
+    this.filter = v?.filter && new strims_network_v1_directory_ListingFilter(v.filter);
+    this.sortOrder = v?.sortOrder || 0;
   }
 
   static encode(m: FrontendWatchListingsRequest, w?: Writer): Writer {
@@ -3643,6 +5112,8 @@ export class FrontendWatchListingsRequest {
     m.contentTypes.reduce((w, v) => w.uint32(v), w.uint32(10).fork()).ldelim();
     for (const v of m.networkKeys) w.uint32(18).bytes(v);
     if (m.listingId) w.uint32(24).uint64(m.listingId);
+    if (m.filter) strims_network_v1_directory_ListingFilter.encode(m.filter, w.uint32(34).fork()).ldelim();
+    if (m.sortOrder) w.uint32(40).uint32(m.sortOrder);
     return w;
   }
 
@@ -3662,6 +5133,12 @@ export class FrontendWatchListingsRequest {
         case 3:
         m.listingId = r.uint64();
         break;
+        case 4:
+        m.filter = strims_network_v1_directory_ListingFilter.decode(r, r.uint32());
+        break;
+        case 5:
+        m.sortOrder = r.uint32();
+        break;
         default:
         r.skipType(tag & 7);
         break;
@@ -4246,6 +5723,11 @@ export enum ListingContentType {
   LISTING_CONTENT_TYPE_EMBED = 3,
   LISTING_CONTENT_TYPE_CHAT = 4,
 }
+export enum ListingSortOrder {
+  LISTING_SORT_ORDER_UNDEFINED = 0,
+  LISTING_SORT_ORDER_VIEWERS = 1,
+  LISTING_SORT_ORDER_RECENT = 2,
+}
 /* @internal */
 export const strims_network_v1_directory_ServerConfig = ServerConfig;
 /* @internal */
@@ -4295,6 +5777,12 @@ export type strims_network_v1_directory_ListingSnippet = ListingSnippet;
 /* @internal */
 export type strims_network_v1_directory_IListingSnippet = IListingSnippet;
 /* @internal */
+export const strims_network_v1_directory_ListingProvenance = ListingProvenance;
+/* @internal */
+export type strims_network_v1_directory_ListingProvenance = ListingProvenance;
+/* @internal */
+export type strims_network_v1_directory_IListingProvenance = IListingProvenance;
+/* @internal */
 export const strims_network_v1_directory_ListingSnippetDelta = ListingSnippetDelta;
 /* @internal */
 export type strims_network_v1_directory_ListingSnippetDelta = ListingSnippetDelta;
@@ -4325,6 +5813,42 @@ export type strims_network_v1_directory_ListingRecord = ListingRecord;
 /* @internal */
 export type strims_network_v1_directory_IListingRecord = IListingRecord;
 /* @internal */
+export const strims_network_v1_directory_ScheduledListingRecord = ScheduledListingRecord;
+/* @internal */
+export type strims_network_v1_directory_ScheduledListingRecord = ScheduledListingRecord;
+/* @internal */
+export type strims_network_v1_directory_IScheduledListingRecord = IScheduledListingRecord;
+/* @internal */
+export const strims_network_v1_directory_ListingSubscription = ListingSubscription;
+/* @internal */
+export type strims_network_v1_directory_ListingSubscription = ListingSubscription;
+/* @internal */
+export type strims_network_v1_directory_IListingSubscription = IListingSubscription;
+/* @internal */
+export const strims_network_v1_directory_ListingSessionRecord = ListingSessionRecord;
+/* @internal */
+export type strims_network_v1_directory_ListingSessionRecord = ListingSessionRecord;
+/* @internal */
+export type strims_network_v1_directory_IListingSessionRecord = IListingSessionRecord;
+/* @internal */
+export const strims_network_v1_directory_ListingStats = ListingStats;
+/* @internal */
+export type strims_network_v1_directory_ListingStats = ListingStats;
+/* @internal */
+export type strims_network_v1_directory_IListingStats = IListingStats;
+/* @internal */
+export const strims_network_v1_directory_ListingStatsItem = ListingStatsItem;
+/* @internal */
+export type strims_network_v1_directory_ListingStatsItem = ListingStatsItem;
+/* @internal */
+export type strims_network_v1_directory_IListingStatsItem = IListingStatsItem;
+/* @internal */
+export const strims_network_v1_directory_PublisherStatsItem = PublisherStatsItem;
+/* @internal */
+export type strims_network_v1_directory_PublisherStatsItem = PublisherStatsItem;
+/* @internal */
+export type strims_network_v1_directory_IPublisherStatsItem = IPublisherStatsItem;
+/* @internal */
 export const strims_network_v1_directory_UserModeration = UserModeration;
 /* @internal */
 export type strims_network_v1_directory_UserModeration = UserModeration;
@@ -4397,6 +5921,42 @@ export type strims_network_v1_directory_PartResponse = PartResponse;
 /* @internal */
 export type strims_network_v1_directory_IPartResponse = IPartResponse;
 /* @internal */
+export const strims_network_v1_directory_ScheduleRequest = ScheduleRequest;
+/* @internal */
+export type strims_network_v1_directory_ScheduleRequest = ScheduleRequest;
+/* @internal */
+export type strims_network_v1_directory_IScheduleRequest = IScheduleRequest;
+/* @internal */
+export const strims_network_v1_directory_ScheduleResponse = ScheduleResponse;
+/* @internal */
+export type strims_network_v1_directory_ScheduleResponse = ScheduleResponse;
+/* @internal */
+export type strims_network_v1_directory_IScheduleResponse = IScheduleResponse;
+/* @internal */
+export const strims_network_v1_directory_UnscheduleRequest = UnscheduleRequest;
+/* @internal */
+export type strims_network_v1_directory_UnscheduleRequest = UnscheduleRequest;
+/* @internal */
+export type strims_network_v1_directory_IUnscheduleRequest = IUnscheduleRequest;
+/* @internal */
+export const strims_network_v1_directory_UnscheduleResponse = UnscheduleResponse;
+/* @internal */
+export type strims_network_v1_directory_UnscheduleResponse = UnscheduleResponse;
+/* @internal */
+export type strims_network_v1_directory_IUnscheduleResponse = IUnscheduleResponse;
+/* @internal */
+export const strims_network_v1_directory_GetListingStatsRequest = GetListingStatsRequest;
+/* @internal */
+export type strims_network_v1_directory_GetListingStatsRequest = GetListingStatsRequest;
+/* @internal */
+export type strims_network_v1_directory_IGetListingStatsRequest = IGetListingStatsRequest;
+/* @internal */
+export const strims_network_v1_directory_GetListingStatsResponse = GetListingStatsResponse;
+/* @internal */
+export type strims_network_v1_directory_GetListingStatsResponse = GetListingStatsResponse;
+/* @internal */
+export type strims_network_v1_directory_IGetListingStatsResponse = IGetListingStatsResponse;
+/* @internal */
 export const strims_network_v1_directory_PingRequest = PingRequest;
 /* @internal */
 export type strims_network_v1_directory_PingRequest = PingRequest;
@@ -4499,6 +6059,78 @@ export type strims_network_v1_directory_FrontendPartResponse = FrontendPartRespo
 /* @internal */
 export type strims_network_v1_directory_IFrontendPartResponse = IFrontendPartResponse;
 /* @internal */
+export const strims_network_v1_directory_FrontendScheduleRequest = FrontendScheduleRequest;
+/* @internal */
+export type strims_network_v1_directory_FrontendScheduleRequest = FrontendScheduleRequest;
+/* @internal */
+export type strims_network_v1_directory_IFrontendScheduleRequest = IFrontendScheduleRequest;
+/* @internal */
+export const strims_network_v1_directory_FrontendScheduleResponse = FrontendScheduleResponse;
+/* @internal */
+export type strims_network_v1_directory_FrontendScheduleResponse = FrontendScheduleResponse;
+/* @internal */
+export type strims_network_v1_directory_IFrontendScheduleResponse = IFrontendScheduleResponse;
+/* @internal */
+export const strims_network_v1_directory_FrontendUnscheduleRequest = FrontendUnscheduleRequest;
+/* @internal */
+export type strims_network_v1_directory_FrontendUnscheduleRequest = FrontendUnscheduleRequest;
+/* @internal */
+export type strims_network_v1_directory_IFrontendUnscheduleRequest = IFrontendUnscheduleRequest;
+/* @internal */
+export const strims_network_v1_directory_FrontendUnscheduleResponse = FrontendUnscheduleResponse;
+/* @internal */
+export type strims_network_v1_directory_FrontendUnscheduleResponse = FrontendUnscheduleResponse;
+/* @internal */
+export type strims_network_v1_directory_IFrontendUnscheduleResponse = IFrontendUnscheduleResponse;
+/* @internal */
+export const strims_network_v1_directory_FrontendSubscribeListingRequest = FrontendSubscribeListingRequest;
+/* @internal */
+export type strims_network_v1_directory_FrontendSubscribeListingRequest = FrontendSubscribeListingRequest;
+/* @internal */
+export type strims_network_v1_directory_IFrontendSubscribeListingRequest = IFrontendSubscribeListingRequest;
+/* @internal */
+export const strims_network_v1_directory_FrontendSubscribeListingResponse = FrontendSubscribeListingResponse;
+/* @internal */
+export type strims_network_v1_directory_FrontendSubscribeListingResponse = FrontendSubscribeListingResponse;
+/* @internal */
+export type strims_network_v1_directory_IFrontendSubscribeListingResponse = IFrontendSubscribeListingResponse;
+/* @internal */
+export const strims_network_v1_directory_FrontendUnsubscribeListingRequest = FrontendUnsubscribeListingRequest;
+/* @internal */
+export type strims_network_v1_directory_FrontendUnsubscribeListingRequest = FrontendUnsubscribeListingRequest;
+/* @internal */
+export type strims_network_v1_directory_IFrontendUnsubscribeListingRequest = IFrontendUnsubscribeListingRequest;
+/* @internal */
+export const strims_network_v1_directory_FrontendUnsubscribeListingResponse = FrontendUnsubscribeListingResponse;
+/* @internal */
+export type strims_network_v1_directory_FrontendUnsubscribeListingResponse = FrontendUnsubscribeListingResponse;
+/* @internal */
+export type strims_network_v1_directory_IFrontendUnsubscribeListingResponse = IFrontendUnsubscribeListingResponse;
+/* @internal */
+export const strims_network_v1_directory_FrontendListListingSubscriptionsRequest = FrontendListListingSubscriptionsRequest;
+/* @internal */
+export type strims_network_v1_directory_FrontendListListingSubscriptionsRequest = FrontendListListingSubscriptionsRequest;
+/* @internal */
+export type strims_network_v1_directory_IFrontendListListingSubscriptionsRequest = IFrontendListListingSubscriptionsRequest;
+/* @internal */
+export const strims_network_v1_directory_FrontendListListingSubscriptionsResponse = FrontendListListingSubscriptionsResponse;
+/* @internal */
+export type strims_network_v1_directory_FrontendListListingSubscriptionsResponse = FrontendListListingSubscriptionsResponse;
+/* @internal */
+export type strims_network_v1_directory_IFrontendListListingSubscriptionsResponse = IFrontendListListingSubscriptionsResponse;
+/* @internal */
+export const strims_network_v1_directory_FrontendGetListingStatsRequest = FrontendGetListingStatsRequest;
+/* @internal */
+export type strims_network_v1_directory_FrontendGetListingStatsRequest = FrontendGetListingStatsRequest;
+/* @internal */
+export type strims_network_v1_directory_IFrontendGetListingStatsRequest = IFrontendGetListingStatsRequest;
+/* @internal */
+export const strims_network_v1_directory_FrontendGetListingStatsResponse = FrontendGetListingStatsResponse;
+/* @internal */
+export type strims_network_v1_directory_FrontendGetListingStatsResponse = FrontendGetListingStatsResponse;
+/* @internal */
+export type strims_network_v1_directory_IFrontendGetListingStatsResponse = IFrontendGetListingStatsResponse;
+/* @internal */
 export const strims_network_v1_directory_FrontendTestRequest = FrontendTestRequest;
 /* @internal */
 export type strims_network_v1_directory_FrontendTestRequest = FrontendTestRequest;
@@ -4559,6 +6191,12 @@ export type strims_network_v1_directory_FrontendGetListingResponse = FrontendGet
 /* @internal */
 export type strims_network_v1_directory_IFrontendGetListingResponse = IFrontendGetListingResponse;
 /* @internal */
+export const strims_network_v1_directory_ListingFilter = ListingFilter;
+/* @internal */
+export type strims_network_v1_directory_ListingFilter = ListingFilter;
+/* @internal */
+export type strims_network_v1_directory_IListingFilter = IListingFilter;
+/* @internal */
 export const strims_network_v1_directory_FrontendGetListingsRequest = FrontendGetListingsRequest;
 /* @internal */
 export type strims_network_v1_directory_FrontendGetListingsRequest = FrontendGetListingsRequest;
@@ -4625,6 +6263,12 @@ export type strims_network_v1_directory_ServerConfig_Integrations = ServerConfig
 /* @internal */
 export type strims_network_v1_directory_ServerConfig_IIntegrations = ServerConfig.IIntegrations;
 /* @internal */
+export const strims_network_v1_directory_ServerConfig_Federation = ServerConfig.Federation;
+/* @internal */
+export type strims_network_v1_directory_ServerConfig_Federation = ServerConfig.Federation;
+/* @internal */
+export type strims_network_v1_directory_ServerConfig_IFederation = ServerConfig.IFederation;
+/* @internal */
 export const strims_network_v1_directory_ServerConfig_Integrations_AngelThump = ServerConfig.Integrations.AngelThump;
 /* @internal */
 export type strims_network_v1_directory_ServerConfig_Integrations_AngelThump = ServerConfig.Integrations.AngelThump;
@@ -4649,6 +6293,24 @@ export type strims_network_v1_directory_ServerConfig_Integrations_Swarm = Server
 /* @internal */
 export type strims_network_v1_directory_ServerConfig_Integrations_ISwarm = ServerConfig.Integrations.ISwarm;
 /* @internal */
+export const strims_network_v1_directory_ServerConfig_Integrations_Kick = ServerConfig.Integrations.Kick;
+/* @internal */
+export type strims_network_v1_directory_ServerConfig_Integrations_Kick = ServerConfig.Integrations.Kick;
+/* @internal */
+export type strims_network_v1_directory_ServerConfig_Integrations_IKick = ServerConfig.Integrations.IKick;
+/* @internal */
+export const strims_network_v1_directory_ServerConfig_Integrations_Vimeo = ServerConfig.Integrations.Vimeo;
+/* @internal */
+export type strims_network_v1_directory_ServerConfig_Integrations_Vimeo = ServerConfig.Integrations.Vimeo;
+/* @internal */
+export type strims_network_v1_directory_ServerConfig_Integrations_IVimeo = ServerConfig.Integrations.IVimeo;
+/* @internal */
+export const strims_network_v1_directory_ServerConfig_Integrations_OEmbed = ServerConfig.Integrations.OEmbed;
+/* @internal */
+export type strims_network_v1_directory_ServerConfig_Integrations_OEmbed = ServerConfig.Integrations.OEmbed;
+/* @internal */
+export type strims_network_v1_directory_ServerConfig_Integrations_IOEmbed = ServerConfig.Integrations.IOEmbed;
+/* @internal */
 export const strims_network_v1_directory_ClientConfig_Integrations = ClientConfig.Integrations;
 /* @internal */
 export type strims_network_v1_directory_ClientConfig_Integrations = ClientConfig.Integrations;
@@ -4761,6 +6423,10 @@ export const strims_network_v1_directory_ListingContentType = ListingContentType
 /* @internal */
 export type strims_network_v1_directory_ListingContentType = ListingContentType;
 /* @internal */
+export const strims_network_v1_directory_ListingSortOrder = ListingSortOrder;
+/* @internal */
+export type strims_network_v1_directory_ListingSortOrder = ListingSortOrder;
+/* @internal */
 export const strims_network_v1_directory_Listing_Embed_Service = Listing.Embed.Service;
 /* @internal */
 export type strims_network_v1_directory_Listing_Embed_Service = Listing.Embed.Service;
@@ -19,12 +19,21 @@ import {
   strims_network_v1_directory_IPingRequest,
   strims_network_v1_directory_PingRequest,
   strims_network_v1_directory_PingResponse,
+  strims_network_v1_directory_IScheduleRequest,
+  strims_network_v1_directory_ScheduleRequest,
+  strims_network_v1_directory_ScheduleResponse,
+  strims_network_v1_directory_IUnscheduleRequest,
+  strims_network_v1_directory_UnscheduleRequest,
+  strims_network_v1_directory_UnscheduleResponse,
   strims_network_v1_directory_IModerateListingRequest,
   strims_network_v1_directory_ModerateListingRequest,
   strims_network_v1_directory_ModerateListingResponse,
   strims_network_v1_directory_IModerateUserRequest,
   strims_network_v1_directory_ModerateUserRequest,
   strims_network_v1_directory_ModerateUserResponse,
+  strims_network_v1_directory_IGetListingStatsRequest,
+  strims_network_v1_directory_GetListingStatsRequest,
+  strims_network_v1_directory_GetListingStatsResponse,
   strims_network_v1_directory_IFrontendPublishRequest,
   strims_network_v1_directory_FrontendPublishRequest,
   strims_network_v1_directory_FrontendPublishResponse,
@@ -40,12 +49,30 @@ import {
   strims_network_v1_directory_IFrontendTestRequest,
   strims_network_v1_directory_FrontendTestRequest,
   strims_network_v1_directory_FrontendTestResponse,
+  strims_network_v1_directory_IFrontendScheduleRequest,
+  strims_network_v1_directory_FrontendScheduleRequest,
+  strims_network_v1_directory_FrontendScheduleResponse,
+  strims_network_v1_directory_IFrontendUnscheduleRequest,
+  strims_network_v1_directory_FrontendUnscheduleRequest,
+  strims_network_v1_directory_FrontendUnscheduleResponse,
+  strims_network_v1_directory_IFrontendSubscribeListingRequest,
+  strims_network_v1_directory_FrontendSubscribeListingRequest,
+  strims_network_v1_directory_FrontendSubscribeListingResponse,
+  strims_network_v1_directory_IFrontendUnsubscribeListingRequest,
+  strims_network_v1_directory_FrontendUnsubscribeListingRequest,
+  strims_network_v1_directory_FrontendUnsubscribeListingResponse,
+  strims_network_v1_directory_IFrontendListListingSubscriptionsRequest,
+  strims_network_v1_directory_FrontendListListingSubscriptionsRequest,
+  strims_network_v1_directory_FrontendListListingSubscriptionsResponse,
   strims_network_v1_directory_IFrontendModerateListingRequest,
   strims_network_v1_directory_FrontendModerateListingRequest,
   strims_network_v1_directory_FrontendModerateListingResponse,
   strims_network_v1_directory_IFrontendModerateUserRequest,
   strims_network_v1_directory_FrontendModerateUserRequest,
   strims_network_v1_directory_FrontendModerateUserResponse,
+  strims_network_v1_directory_IFrontendGetListingStatsRequest,
+  strims_network_v1_directory_FrontendGetListingStatsRequest,
+  strims_network_v1_directory_FrontendGetListingStatsResponse,
   strims_network_v1_directory_IFrontendGetUsersRequest,
   strims_network_v1_directory_FrontendGetUsersRequest,
   strims_network_v1_directory_FrontendGetUsersResponse,
@@ -75,8 +102,11 @@ export interface DirectoryService {
   join(req: strims_network_v1_directory_JoinRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_JoinResponse> | strims_network_v1_directory_JoinResponse;
   part(req: strims_network_v1_directory_PartRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_PartResponse> | strims_network_v1_directory_PartResponse;
   ping(req: strims_network_v1_directory_PingRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_PingResponse> | strims_network_v1_directory_PingResponse;
+  schedule(req: strims_network_v1_directory_ScheduleRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_ScheduleResponse> | strims_network_v1_directory_ScheduleResponse;
+  unschedule(req: strims_network_v1_directory_UnscheduleRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_UnscheduleResponse> | strims_network_v1_directory_UnscheduleResponse;
   moderateListing(req: strims_network_v1_directory_ModerateListingRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_ModerateListingResponse> | strims_network_v1_directory_ModerateListingResponse;
   moderateUser(req: strims_network_v1_directory_ModerateUserRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_ModerateUserResponse> | strims_network_v1_directory_ModerateUserResponse;
+  getListingStats(req: strims_network_v1_directory_GetListingStatsRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_GetListingStatsResponse> | strims_network_v1_directory_GetListingStatsResponse;
 }
 
 export class UnimplementedDirectoryService implements DirectoryService {
@@ -85,8 +115,11 @@ export class UnimplementedDirectoryService implements DirectoryService {
   join(req: strims_network_v1_directory_JoinRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_JoinResponse> | strims_network_v1_directory_JoinResponse { throw new Error("not implemented"); }
   part(req: strims_network_v1_directory_PartRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_PartResponse> | strims_network_v1_directory_PartResponse { throw new Error("not implemented"); }
   ping(req: strims_network_v1_directory_PingRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_PingResponse> | strims_network_v1_directory_PingResponse { throw new Error("not implemented"); }
+  schedule(req: strims_network_v1_directory_ScheduleRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_ScheduleResponse> | strims_network_v1_directory_ScheduleResponse { throw new Error("not implemented"); }
+  unschedule(req: strims_network_v1_directory_UnscheduleRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_UnscheduleResponse> | strims_network_v1_directory_UnscheduleResponse { throw new Error("not implemented"); }
   moderateListing(req: strims_network_v1_directory_ModerateListingRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_ModerateListingResponse> | strims_network_v1_directory_ModerateListingResponse { throw new Error("not implemented"); }
   moderateUser(req: strims_network_v1_directory_ModerateUserRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_ModerateUserResponse> | strims_network_v1_directory_ModerateUserResponse { throw new Error("not implemented"); }
+  getListingStats(req: strims_network_v1_directory_GetListingStatsRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_GetListingStatsResponse> | strims_network_v1_directory_GetListingStatsResponse { throw new Error("not implemented"); }
 }
 
 export const registerDirectoryService = (host: strims_rpc_Service, service: DirectoryService): void => {
@@ -95,8 +128,11 @@ export const registerDirectoryService = (host: strims_rpc_Service, service: Dire
   host.registerMethod<strims_network_v1_directory_JoinRequest, strims_network_v1_directory_JoinResponse>("strims.network.v1.directory.Directory.Join", service.join.bind(service), strims_network_v1_directory_JoinRequest);
   host.registerMethod<strims_network_v1_directory_PartRequest, strims_network_v1_directory_PartResponse>("strims.network.v1.directory.Directory.Part", service.part.bind(service), strims_network_v1_directory_PartRequest);
   host.registerMethod<strims_network_v1_directory_PingRequest, strims_network_v1_directory_PingResponse>("strims.network.v1.directory.Directory.Ping", service.ping.bind(service), strims_network_v1_directory_PingRequest);
+  host.registerMethod<strims_network_v1_directory_ScheduleRequest, strims_network_v1_directory_ScheduleResponse>("strims.network.v1.directory.Directory.Schedule", service.schedule.bind(service), strims_network_v1_directory_ScheduleRequest);
+  host.registerMethod<strims_network_v1_directory_UnscheduleRequest, strims_network_v1_directory_UnscheduleResponse>("strims.network.v1.directory.Directory.Unschedule", service.unschedule.bind(service), strims_network_v1_directory_UnscheduleRequest);
   host.registerMethod<strims_network_v1_directory_ModerateListingRequest, strims_network_v1_directory_ModerateListingResponse>("strims.network.v1.directory.Directory.ModerateListing", service.moderateListing.bind(service), strims_network_v1_directory_ModerateListingRequest);
   host.registerMethod<strims_network_v1_directory_ModerateUserRequest, strims_network_v1_directory_ModerateUserResponse>("strims.network.v1.directory.Directory.ModerateUser", service.moderateUser.bind(service), strims_network_v1_directory_ModerateUserRequest);
+  host.registerMethod<strims_network_v1_directory_GetListingStatsRequest, strims_network_v1_directory_GetListingStatsResponse>("strims.network.v1.directory.Directory.GetListingStats", service.getListingStats.bind(service), strims_network_v1_directory_GetListingStatsRequest);
 }
 
 export class DirectoryClient {
@@ -122,6 +158,14 @@ export class DirectoryClient {
     return this.host.expectOne(this.host.call("strims.network.v1.directory.Directory.Ping", new strims_network_v1_directory_PingRequest(req)), strims_network_v1_directory_PingResponse, opts);
   }
 
+  public schedule(req?: strims_network_v1_directory_IScheduleRequest, opts?: strims_rpc_UnaryCallOptions): Promise<strims_network_v1_directory_ScheduleResponse> {
+    return this.host.expectOne(this.host.call("strims.network.v1.directory.Directory.Schedule", new strims_network_v1_directory_ScheduleRequest(req)), strims_network_v1_directory_ScheduleResponse, opts);
+  }
+
+  public unschedule(req?: strims_network_v1_directory_IUnscheduleRequest, opts?: strims_rpc_UnaryCallOptions): Promise<strims_network_v1_directory_UnscheduleResponse> {
+    return this.host.expectOne(this.host.call("strims.network.v1.directory.Directory.Unschedule", new strims_network_v1_directory_UnscheduleRequest(req)), strims_network_v1_directory_UnscheduleResponse, opts);
+  }
+
   public moderateListing(req?: strims_network_v1_directory_IModerateListingRequest, opts?: strims_rpc_UnaryCallOptions): Promise<strims_network_v1_directory_ModerateListingResponse> {
     return this.host.expectOne(this.host.call("strims.network.v1.directory.Directory.ModerateListing", new strims_network_v1_directory_ModerateListingRequest(req)), strims_network_v1_directory_ModerateListingResponse, opts);
   }
@@ -129,6 +173,10 @@ export class DirectoryClient {
   public moderateUser(req?: strims_network_v1_directory_IModerateUserRequest, opts?: strims_rpc_UnaryCallOptions): Promise<strims_network_v1_directory_ModerateUserResponse> {
     return this.host.expectOne(this.host.call("strims.network.v1.directory.Directory.ModerateUser", new strims_network_v1_directory_ModerateUserRequest(req)), strims_network_v1_directory_ModerateUserResponse, opts);
   }
+
+  public getListingStats(req?: strims_network_v1_directory_IGetListingStatsRequest, opts?: strims_rpc_UnaryCallOptions): Promise<strims_network_v1_directory_GetListingStatsResponse> {
+    return this.host.expectOne(this.host.call("strims.network.v1.directory.Directory.GetListingStats", new strims_network_v1_directory_GetListingStatsRequest(req)), strims_network_v1_directory_GetListingStatsResponse, opts);
+  }
 }
 
 export interface DirectoryFrontendService {
@@ -137,8 +185,14 @@ export interface DirectoryFrontendService {
   join(req: strims_network_v1_directory_FrontendJoinRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_FrontendJoinResponse> | strims_network_v1_directory_FrontendJoinResponse;
   part(req: strims_network_v1_directory_FrontendPartRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_FrontendPartResponse> | strims_network_v1_directory_FrontendPartResponse;
   test(req: strims_network_v1_directory_FrontendTestRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_FrontendTestResponse> | strims_network_v1_directory_FrontendTestResponse;
+  schedule(req: strims_network_v1_directory_FrontendScheduleRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_FrontendScheduleResponse> | strims_network_v1_directory_FrontendScheduleResponse;
+  unschedule(req: strims_network_v1_directory_FrontendUnscheduleRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_FrontendUnscheduleResponse> | strims_network_v1_directory_FrontendUnscheduleResponse;
+  subscribeListing(req: strims_network_v1_directory_FrontendSubscribeListingRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_FrontendSubscribeListingResponse> | strims_network_v1_directory_FrontendSubscribeListingResponse;
+  unsubscribeListing(req: strims_network_v1_directory_FrontendUnsubscribeListingRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_FrontendUnsubscribeListingResponse> | strims_network_v1_directory_FrontendUnsubscribeListingResponse;
+  listListingSubscriptions(req: strims_network_v1_directory_FrontendListListingSubscriptionsRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_FrontendListListingSubscriptionsResponse> | strims_network_v1_directory_FrontendListListingSubscriptionsResponse;
   moderateListing(req: strims_network_v1_directory_FrontendModerateListingRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_FrontendModerateListingResponse> | strims_network_v1_directory_FrontendModerateListingResponse;
   moderateUser(req: strims_network_v1_directory_FrontendModerateUserRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_FrontendModerateUserResponse> | strims_network_v1_directory_FrontendModerateUserResponse;
+  getListingStats(req: strims_network_v1_directory_FrontendGetListingStatsRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_FrontendGetListingStatsResponse> | strims_network_v1_directory_FrontendGetListingStatsResponse;
   getUsers(req: strims_network_v1_directory_FrontendGetUsersRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_FrontendGetUsersResponse> | strims_network_v1_directory_FrontendGetUsersResponse;
   getListing(req: strims_network_v1_directory_FrontendGetListingRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_FrontendGetListingResponse> | strims_network_v1_directory_FrontendGetListingResponse;
   getListings(req: strims_network_v1_directory_FrontendGetListingsRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_FrontendGetListingsResponse> | strims_network_v1_directory_FrontendGetListingsResponse;
@@ -153,8 +207,14 @@ export class UnimplementedDirectoryFrontendService implements DirectoryFrontendS
   join(req: strims_network_v1_directory_FrontendJoinRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_FrontendJoinResponse> | strims_network_v1_directory_FrontendJoinResponse { throw new Error("not implemented"); }
   part(req: strims_network_v1_directory_FrontendPartRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_FrontendPartResponse> | strims_network_v1_directory_FrontendPartResponse { throw new Error("not implemented"); }
   test(req: strims_network_v1_directory_FrontendTestRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_FrontendTestResponse> | strims_network_v1_directory_FrontendTestResponse { throw new Error("not implemented"); }
+  schedule(req: strims_network_v1_directory_FrontendScheduleRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_FrontendScheduleResponse> | strims_network_v1_directory_FrontendScheduleResponse { throw new Error("not implemented"); }
+  unschedule(req: strims_network_v1_directory_FrontendUnscheduleRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_FrontendUnscheduleResponse> | strims_network_v1_directory_FrontendUnscheduleResponse { throw new Error("not implemented"); }
+  subscribeListing(req: strims_network_v1_directory_FrontendSubscribeListingRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_FrontendSubscribeListingResponse> | strims_network_v1_directory_FrontendSubscribeListingResponse { throw new Error("not implemented"); }
+  unsubscribeListing(req: strims_network_v1_directory_FrontendUnsubscribeListingRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_FrontendUnsubscribeListingResponse> | strims_network_v1_directory_FrontendUnsubscribeListingResponse { throw new Error("not implemented"); }
+  listListingSubscriptions(req: strims_network_v1_directory_FrontendListListingSubscriptionsRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_FrontendListListingSubscriptionsResponse> | strims_network_v1_directory_FrontendListListingSubscriptionsResponse { throw new Error("not implemented"); }
   moderateListing(req: strims_network_v1_directory_FrontendModerateListingRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_FrontendModerateListingResponse> | strims_network_v1_directory_FrontendModerateListingResponse { throw new Error("not implemented"); }
   moderateUser(req: strims_network_v1_directory_FrontendModerateUserRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_FrontendModerateUserResponse> | strims_network_v1_directory_FrontendModerateUserResponse { throw new Error("not implemented"); }
+  getListingStats(req: strims_network_v1_directory_FrontendGetListingStatsRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_FrontendGetListingStatsResponse> | strims_network_v1_directory_FrontendGetListingStatsResponse { throw new Error("not implemented"); }
   getUsers(req: strims_network_v1_directory_FrontendGetUsersRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_FrontendGetUsersResponse> | strims_network_v1_directory_FrontendGetUsersResponse { throw new Error("not implemented"); }
   getListing(req: strims_network_v1_directory_FrontendGetListingRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_FrontendGetListingResponse> | strims_network_v1_directory_FrontendGetListingResponse { throw new Error("not implemented"); }
   getListings(req: strims_network_v1_directory_FrontendGetListingsRequest, call: strims_rpc_Call): Promise<strims_network_v1_directory_FrontendGetListingsResponse> | strims_network_v1_directory_FrontendGetListingsResponse { throw new Error("not implemented"); }
@@ -169,8 +229,14 @@ export const registerDirectoryFrontendService = (host: strims_rpc_Service, servi
   host.registerMethod<strims_network_v1_directory_FrontendJoinRequest, strims_network_v1_directory_FrontendJoinResponse>("strims.network.v1.directory.DirectoryFrontend.Join", service.join.bind(service), strims_network_v1_directory_FrontendJoinRequest);
   host.registerMethod<strims_network_v1_directory_FrontendPartRequest, strims_network_v1_directory_FrontendPartResponse>("strims.network.v1.directory.DirectoryFrontend.Part", service.part.bind(service), strims_network_v1_directory_FrontendPartRequest);
   host.registerMethod<strims_network_v1_directory_FrontendTestRequest, strims_network_v1_directory_FrontendTestResponse>("strims.network.v1.directory.DirectoryFrontend.Test", service.test.bind(service), strims_network_v1_directory_FrontendTestRequest);
+  host.registerMethod<strims_network_v1_directory_FrontendScheduleRequest, strims_network_v1_directory_FrontendScheduleResponse>("strims.network.v1.directory.DirectoryFrontend.Schedule", service.schedule.bind(service), strims_network_v1_directory_FrontendScheduleRequest);
+  host.registerMethod<strims_network_v1_directory_FrontendUnscheduleRequest, strims_network_v1_directory_FrontendUnscheduleResponse>("strims.network.v1.directory.DirectoryFrontend.Unschedule", service.unschedule.bind(service), strims_network_v1_directory_FrontendUnscheduleRequest);
+  host.registerMethod<strims_network_v1_directory_FrontendSubscribeListingRequest, strims_network_v1_directory_FrontendSubscribeListingResponse>("strims.network.v1.directory.DirectoryFrontend.SubscribeListing", service.subscribeListing.bind(service), strims_network_v1_directory_FrontendSubscribeListingRequest);
+  host.registerMethod<strims_network_v1_directory_FrontendUnsubscribeListingRequest, strims_network_v1_directory_FrontendUnsubscribeListingResponse>("strims.network.v1.directory.DirectoryFrontend.UnsubscribeListing", service.unsubscribeListing.bind(service), strims_network_v1_directory_FrontendUnsubscribeListingRequest);
+  host.registerMethod<strims_network_v1_directory_FrontendListListingSubscriptionsRequest, strims_network_v1_directory_FrontendListListingSubscriptionsResponse>("strims.network.v1.directory.DirectoryFrontend.ListListingSubscriptions", service.listListingSubscriptions.bind(service), strims_network_v1_directory_FrontendListListingSubscriptionsRequest);
   host.registerMethod<strims_network_v1_directory_FrontendModerateListingRequest, strims_network_v1_directory_FrontendModerateListingResponse>("strims.network.v1.directory.DirectoryFrontend.ModerateListing", service.moderateListing.bind(service), strims_network_v1_directory_FrontendModerateListingRequest);
   host.registerMethod<strims_network_v1_directory_FrontendModerateUserRequest, strims_network_v1_directory_FrontendModerateUserResponse>("strims.network.v1.directory.DirectoryFrontend.ModerateUser", service.moderateUser.bind(service), strims_network_v1_directory_FrontendModerateUserRequest);
+  host.registerMethod<strims_network_v1_directory_FrontendGetListingStatsRequest, strims_network_v1_directory_FrontendGetListingStatsResponse>("strims.network.v1.directory.DirectoryFrontend.GetListingStats", service.getListingStats.bind(service), strims_network_v1_directory_FrontendGetListingStatsRequest);
   host.registerMethod<strims_network_v1_directory_FrontendGetUsersRequest, strims_network_v1_directory_FrontendGetUsersResponse>("strims.network.v1.directory.DirectoryFrontend.GetUsers", service.getUsers.bind(service), strims_network_v1_directory_FrontendGetUsersRequest);
   host.registerMethod<strims_network_v1_directory_FrontendGetListingRequest, strims_network_v1_directory_FrontendGetListingResponse>("strims.network.v1.directory.DirectoryFrontend.GetListing", service.getListing.bind(service), strims_network_v1_directory_FrontendGetListingRequest);
   host.registerMethod<strims_network_v1_directory_FrontendGetListingsRequest, strims_network_v1_directory_FrontendGetListingsResponse>("strims.network.v1.directory.DirectoryFrontend.GetListings", service.getListings.bind(service), strims_network_v1_directory_FrontendGetListingsRequest);
@@ -202,6 +268,26 @@ export class DirectoryFrontendClient {
     return this.host.expectOne(this.host.call("strims.network.v1.directory.DirectoryFrontend.Test", new strims_network_v1_directory_FrontendTestRequest(req)), strims_network_v1_directory_FrontendTestResponse, opts);
   }
 
+  public schedule(req?: strims_network_v1_directory_IFrontendScheduleRequest, opts?: strims_rpc_UnaryCallOptions): Promise<strims_network_v1_directory_FrontendScheduleResponse> {
+    return this.host.expectOne(this.host.call("strims.network.v1.directory.DirectoryFrontend.Schedule", new strims_network_v1_directory_FrontendScheduleRequest(req)), strims_network_v1_directory_FrontendScheduleResponse, opts);
+  }
+
+  public unschedule(req?: strims_network_v1_directory_IFrontendUnscheduleRequest, opts?: strims_rpc_UnaryCallOptions): Promise<strims_network_v1_directory_FrontendUnscheduleResponse> {
+    return this.host.expectOne(this.host.call("strims.network.v1.directory.DirectoryFrontend.Unschedule", new strims_network_v1_directory_FrontendUnscheduleRequest(req)), strims_network_v1_directory_FrontendUnscheduleResponse, opts);
+  }
+
+  public subscribeListing(req?: strims_network_v1_directory_IFrontendSubscribeListingRequest, opts?: strims_rpc_UnaryCallOptions): Promise<strims_network_v1_directory_FrontendSubscribeListingResponse> {
+    return this.host.expectOne(this.host.call("strims.network.v1.directory.DirectoryFrontend.SubscribeListing", new strims_network_v1_directory_FrontendSubscribeListingRequest(req)), strims_network_v1_directory_FrontendSubscribeListingResponse, opts);
+  }
+
+  public unsubscribeListing(req?: strims_network_v1_directory_IFrontendUnsubscribeListingRequest, opts?: strims_rpc_UnaryCallOptions): Promise<strims_network_v1_directory_FrontendUnsubscribeListingResponse> {
+    return this.host.expectOne(this.host.call("strims.network.v1.directory.DirectoryFrontend.UnsubscribeListing", new strims_network_v1_directory_FrontendUnsubscribeListingRequest(req)), strims_network_v1_directory_FrontendUnsubscribeListingResponse, opts);
+  }
+
+  public listListingSubscriptions(req?: strims_network_v1_directory_IFrontendListListingSubscriptionsRequest, opts?: strims_rpc_UnaryCallOptions): Promise<strims_network_v1_directory_FrontendListListingSubscriptionsResponse> {
+    return this.host.expectOne(this.host.call("strims.network.v1.directory.DirectoryFrontend.ListListingSubscriptions", new strims_network_v1_directory_FrontendListListingSubscriptionsRequest(req)), strims_network_v1_directory_FrontendListListingSubscriptionsResponse, opts);
+  }
+
   public moderateListing(req?: strims_network_v1_directory_IFrontendModerateListingRequest, opts?: strims_rpc_UnaryCallOptions): Promise<strims_network_v1_directory_FrontendModerateListingResponse> {
     return this.host.expectOne(this.host.call("strims.network.v1.directory.DirectoryFrontend.ModerateListing", new strims_network_v1_directory_FrontendModerateListingRequest(req)), strims_network_v1_directory_FrontendModerateListingResponse, opts);
   }
@@ -210,6 +296,10 @@ export class DirectoryFrontendClient {
     return this.host.expectOne(this.host.call("strims.network.v1.directory.DirectoryFrontend.ModerateUser", new strims_network_v1_directory_FrontendModerateUserRequest(req)), strims_network_v1_directory_FrontendModerateUserResponse, opts);
   }
 
+  public getListingStats(req?: strims_network_v1_directory_IFrontendGetListingStatsRequest, opts?: strims_rpc_UnaryCallOptions): Promise<strims_network_v1_directory_FrontendGetListingStatsResponse> {
+    return this.host.expectOne(this.host.call("strims.network.v1.directory.DirectoryFrontend.GetListingStats", new strims_network_v1_directory_FrontendGetListingStatsRequest(req)), strims_network_v1_directory_FrontendGetListingStatsResponse, opts);
+  }
+
   public getUsers(req?: strims_network_v1_directory_IFrontendGetUsersRequest, opts?: strims_rpc_UnaryCallOptions): Promise<strims_network_v1_directory_FrontendGetUsersResponse> {
     return this.host.expectOne(this.host.call("strims.network.v1.directory.DirectoryFrontend.GetUsers", new strims_network_v1_directory_FrontendGetUsersRequest(req)), strims_network_v1_directory_FrontendGetUsersResponse, opts);
   }
@@ -127,6 +127,7 @@ export namespace Notification {
   export namespace Subject {
     export enum Model {
       NOTIFICATION_SUBJECT_MODEL_NETWORK = 0,
+      NOTIFICATION_SUBJECT_MODEL_DIRECTORY_LISTING = 1,
     }
   }
 
@@ -178,6 +178,142 @@ export class TransferPeerCloseResponse {
   }
 }
 
+export type IContentKeyPolicy = {
+  networkKeys?: Uint8Array[];
+  keyUsage?: number;
+  key?: Uint8Array;
+  signature?: Uint8Array;
+}
+
+export class ContentKeyPolicy {
+  networkKeys: Uint8Array[];
+  keyUsage: number;
+  key: Uint8Array;
+  signature: Uint8Array;
+
+  constructor(v?: IContentKeyPolicy) {
+    this.networkKeys = v?.networkKeys ? v.networkKeys : [];
+    this.keyUsage = v?.keyUsage || 0;
+    this.key = v?.key || new Uint8Array();
+    this.signature = v?.signature || new Uint8Array();
+  }
+
+  static encode(m: ContentKeyPolicy, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    for (const v of m.networkKeys) w.uint32(10).bytes(v);
+    if (m.keyUsage) w.uint32(16).uint32(m.keyUsage);
+    if (m.key.length) w.uint32(80010).bytes(m.key);
+    if (m.signature.length) w.uint32(80018).bytes(m.signature);
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): ContentKeyPolicy {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new ContentKeyPolicy();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.networkKeys.push(r.bytes())
+        break;
+        case 2:
+        m.keyUsage = r.uint32();
+        break;
+        case 10001:
+        m.key = r.bytes();
+        break;
+        case 10002:
+        m.signature = r.bytes();
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export type ITransferPeerRequestContentKeyRequest = {
+  id?: Uint8Array;
+}
+
+export class TransferPeerRequestContentKeyRequest {
+  id: Uint8Array;
+
+  constructor(v?: ITransferPeerRequestContentKeyRequest) {
+    this.id = v?.id || new Uint8Array();
+  }
+
+  static encode(m: TransferPeerRequestContentKeyRequest, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.id.length) w.uint32(10).bytes(m.id);
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): TransferPeerRequestContentKeyRequest {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new TransferPeerRequestContentKeyRequest();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.id = r.bytes();
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export type ITransferPeerRequestContentKeyResponse = {
+  key?: Uint8Array;
+  policy?: strims_transfer_v1_IContentKeyPolicy;
+}
+
+export class TransferPeerRequestContentKeyResponse {
+  key: Uint8Array;
+  policy: strims_transfer_v1_ContentKeyPolicy | undefined;
+
+  constructor(v?: ITransferPeerRequestContentKeyResponse) {
+    this.key = v?.key || new Uint8Array();
+    this.policy = v?.policy && new strims_transfer_v1_ContentKeyPolicy(v.policy);
+  }
+
+  static encode(m: TransferPeerRequestContentKeyResponse, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.key.length) w.uint32(10).bytes(m.key);
+    if (m.policy) strims_transfer_v1_ContentKeyPolicy.encode(m.policy, w.uint32(18).fork()).ldelim();
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): TransferPeerRequestContentKeyResponse {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new TransferPeerRequestContentKeyResponse();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.key = r.bytes();
+        break;
+        case 2:
+        m.policy = strims_transfer_v1_ContentKeyPolicy.decode(r, r.uint32());
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
 /* @internal */
 export const strims_transfer_v1_TransferPeerAnnounceRequest = TransferPeerAnnounceRequest;
 /* @internal */
@@ -202,3 +338,21 @@ export const strims_transfer_v1_TransferPeerCloseResponse = TransferPeerCloseRes
 export type strims_transfer_v1_TransferPeerCloseResponse = TransferPeerCloseResponse;
 /* @internal */
 export type strims_transfer_v1_ITransferPeerCloseResponse = ITransferPeerCloseResponse;
+/* @internal */
+export const strims_transfer_v1_ContentKeyPolicy = ContentKeyPolicy;
+/* @internal */
+export type strims_transfer_v1_ContentKeyPolicy = ContentKeyPolicy;
+/* @internal */
+export type strims_transfer_v1_IContentKeyPolicy = IContentKeyPolicy;
+/* @internal */
+export const strims_transfer_v1_TransferPeerRequestContentKeyRequest = TransferPeerRequestContentKeyRequest;
+/* @internal */
+export type strims_transfer_v1_TransferPeerRequestContentKeyRequest = TransferPeerRequestContentKeyRequest;
+/* @internal */
+export type strims_transfer_v1_ITransferPeerRequestContentKeyRequest = ITransferPeerRequestContentKeyRequest;
+/* @internal */
+export const strims_transfer_v1_TransferPeerRequestContentKeyResponse = TransferPeerRequestContentKeyResponse;
+/* @internal */
+export type strims_transfer_v1_TransferPeerRequestContentKeyResponse = TransferPeerRequestContentKeyResponse;
+/* @internal */
+export type strims_transfer_v1_ITransferPeerRequestContentKeyResponse = ITransferPeerRequestContentKeyResponse;
@@ -9,21 +9,27 @@ import {
   strims_transfer_v1_ITransferPeerCloseRequest,
   strims_transfer_v1_TransferPeerCloseRequest,
   strims_transfer_v1_TransferPeerCloseResponse,
+  strims_transfer_v1_ITransferPeerRequestContentKeyRequest,
+  strims_transfer_v1_TransferPeerRequestContentKeyRequest,
+  strims_transfer_v1_TransferPeerRequestContentKeyResponse,
 } from "./peer";
 
 export interface TransferPeerService {
   announce(req: strims_transfer_v1_TransferPeerAnnounceRequest, call: strims_rpc_Call): Promise<strims_transfer_v1_TransferPeerAnnounceResponse> | strims_transfer_v1_TransferPeerAnnounceResponse;
   close(req: strims_transfer_v1_TransferPeerCloseRequest, call: strims_rpc_Call): Promise<strims_transfer_v1_TransferPeerCloseResponse> | strims_transfer_v1_TransferPeerCloseResponse;
+  requestContentKey(req: strims_transfer_v1_TransferPeerRequestContentKeyRequest, call: strims_rpc_Call): Promise<strims_transfer_v1_TransferPeerRequestContentKeyResponse> | strims_transfer_v1_TransferPeerRequestContentKeyResponse;
 }
 
 export class UnimplementedTransferPeerService implements TransferPeerService {
   announce(req: strims_transfer_v1_TransferPeerAnnounceRequest, call: strims_rpc_Call): Promise<strims_transfer_v1_TransferPeerAnnounceResponse> | strims_transfer_v1_TransferPeerAnnounceResponse { throw new Error("not implemented"); }
   close(req: strims_transfer_v1_TransferPeerCloseRequest, call: strims_rpc_Call): Promise<strims_transfer_v1_TransferPeerCloseResponse> | strims_transfer_v1_TransferPeerCloseResponse { throw new Error("not implemented"); }
+  requestContentKey(req: strims_transfer_v1_TransferPeerRequestContentKeyRequest, call: strims_rpc_Call): Promise<strims_transfer_v1_TransferPeerRequestContentKeyResponse> | strims_transfer_v1_TransferPeerRequestContentKeyResponse { throw new Error("not implemented"); }
 }
 
 export const registerTransferPeerService = (host: strims_rpc_Service, service: TransferPeerService): void => {
   host.registerMethod<strims_transfer_v1_TransferPeerAnnounceRequest, strims_transfer_v1_TransferPeerAnnounceResponse>("strims.transfer.v1.TransferPeer.Announce", service.announce.bind(service), strims_transfer_v1_TransferPeerAnnounceRequest);
   host.registerMethod<strims_transfer_v1_TransferPeerCloseRequest, strims_transfer_v1_TransferPeerCloseResponse>("strims.transfer.v1.TransferPeer.Close", service.close.bind(service), strims_transfer_v1_TransferPeerCloseRequest);
+  host.registerMethod<strims_transfer_v1_TransferPeerRequestContentKeyRequest, strims_transfer_v1_TransferPeerRequestContentKeyResponse>("strims.transfer.v1.TransferPeer.RequestContentKey", service.requestContentKey.bind(service), strims_transfer_v1_TransferPeerRequestContentKeyRequest);
 }
 
 export class TransferPeerClient {
@@ -36,5 +42,9 @@ export class TransferPeerClient {
   public close(req?: strims_transfer_v1_ITransferPeerCloseRequest, opts?: strims_rpc_UnaryCallOptions): Promise<strims_transfer_v1_TransferPeerCloseResponse> {
     return this.host.expectOne(this.host.call("strims.transfer.v1.TransferPeer.Close", new strims_transfer_v1_TransferPeerCloseRequest(req)), strims_transfer_v1_TransferPeerCloseResponse, opts);
   }
+
+  public requestContentKey(req?: strims_transfer_v1_ITransferPeerRequestContentKeyRequest, opts?: strims_rpc_UnaryCallOptions): Promise<strims_transfer_v1_TransferPeerRequestContentKeyResponse> {
+    return this.host.expectOne(this.host.call("strims.transfer.v1.TransferPeer.RequestContentKey", new strims_transfer_v1_TransferPeerRequestContentKeyRequest(req)), strims_transfer_v1_TransferPeerRequestContentKeyResponse, opts);
+  }
 }
 
@@ -38,9 +38,338 @@ export class Transfer {
   }
 }
 
+export type ITransferMetrics = {
+  bytes?: bigint;
+  bytesPerSecond?: bigint;
+}
+
+export class TransferMetrics {
+  bytes: bigint;
+  bytesPerSecond: bigint;
+
+  constructor(v?: ITransferMetrics) {
+    this.bytes = v?.bytes || BigInt(0);
+    this.bytesPerSecond = v?.bytesPerSecond || BigInt(0);
+  }
+
+  static encode(m: TransferMetrics, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.bytes) w.uint32(8).uint64(m.bytes);
+    if (m.bytesPerSecond) w.uint32(16).uint64(m.bytesPerSecond);
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): TransferMetrics {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new TransferMetrics();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.bytes = r.uint64();
+        break;
+        case 2:
+        m.bytesPerSecond = r.uint64();
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export type IPeerSwarmMetrics = {
+  hostId?: Uint8Array;
+  read?: strims_transfer_v1_ITransferMetrics;
+  write?: strims_transfer_v1_ITransferMetrics;
+  rttMs?: bigint;
+  rttVarMs?: bigint;
+  cwndBytes?: bigint;
+  flightSizeBytes?: bigint;
+  requestedChunks?: bigint;
+  receivedChunks?: bigint;
+  invalidChunks?: bigint;
+  choked?: boolean;
+  peerChoked?: boolean;
+}
+
+export class PeerSwarmMetrics {
+  hostId: Uint8Array;
+  read: strims_transfer_v1_TransferMetrics | undefined;
+  write: strims_transfer_v1_TransferMetrics | undefined;
+  rttMs: bigint;
+  rttVarMs: bigint;
+  cwndBytes: bigint;
+  flightSizeBytes: bigint;
+  requestedChunks: bigint;
+  receivedChunks: bigint;
+  invalidChunks: bigint;
+  choked: boolean;
+  peerChoked: boolean;
+
+  constructor(v?: IPeerSwarmMetrics) {
+    this.hostId = v?.hostId || new Uint8Array();
+    this.read = v?.read && new strims_transfer_v1_TransferMetrics(v.read);
+    this.write = v?.write && new strims_transfer_v1_TransferMetrics(v.write);
+    this.rttMs = v?.rttMs || BigInt(0);
+    this.rttVarMs = v?.rttVarMs || BigInt(0);
+    this.cwndBytes = v?.cwndBytes || BigInt(0);
+    this.flightSizeBytes = v?.flightSizeBytes || BigInt(0);
+    this.requestedChunks = v?.requestedChunks || BigInt(0);
+    this.receivedChunks = v?.receivedChunks || BigInt(0);
+    this.invalidChunks = v?.invalidChunks || BigInt(0);
+    this.choked = v?.choked || false;
+    this.peerChoked = v?.peerChoked || false;
+  }
+
+  static encode(m: PeerSwarmMetrics, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.hostId.length) w.uint32(10).bytes(m.hostId);
+    if (m.read) strims_transfer_v1_TransferMetrics.encode(m.read, w.uint32(18).fork()).ldelim();
+    if (m.write) strims_transfer_v1_TransferMetrics.encode(m.write, w.uint32(26).fork()).ldelim();
+    if (m.rttMs) w.uint32(32).int64(m.rttMs);
+    if (m.rttVarMs) w.uint32(40).int64(m.rttVarMs);
+    if (m.cwndBytes) w.uint32(48).uint64(m.cwndBytes);
+    if (m.flightSizeBytes) w.uint32(56).uint64(m.flightSizeBytes);
+    if (m.requestedChunks) w.uint32(64).uint64(m.requestedChunks);
+    if (m.receivedChunks) w.uint32(72).uint64(m.receivedChunks);
+    if (m.invalidChunks) w.uint32(80).uint64(m.invalidChunks);
+    if (m.choked) w.uint32(88).bool(m.choked);
+    if (m.peerChoked) w.uint32(96).bool(m.peerChoked);
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): PeerSwarmMetrics {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new PeerSwarmMetrics();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.hostId = r.bytes();
+        break;
+        case 2:
+        m.read = strims_transfer_v1_TransferMetrics.decode(r, r.uint32());
+        break;
+        case 3:
+        m.write = strims_transfer_v1_TransferMetrics.decode(r, r.uint32());
+        break;
+        case 4:
+        m.rttMs = r.int64();
+        break;
+        case 5:
+        m.rttVarMs = r.int64();
+        break;
+        case 6:
+        m.cwndBytes = r.uint64();
+        break;
+        case 7:
+        m.flightSizeBytes = r.uint64();
+        break;
+        case 8:
+        m.requestedChunks = r.uint64();
+        break;
+        case 9:
+        m.receivedChunks = r.uint64();
+        break;
+        case 10:
+        m.invalidChunks = r.uint64();
+        break;
+        case 11:
+        m.choked = r.bool();
+        break;
+        case 12:
+        m.peerChoked = r.bool();
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export type ISwarmMetrics = {
+  transferId?: Uint8Array;
+  swarmId?: Uint8Array;
+  label?: string;
+  read?: strims_transfer_v1_ITransferMetrics;
+  write?: strims_transfer_v1_ITransferMetrics;
+  peers?: strims_transfer_v1_IPeerSwarmMetrics[];
+}
+
+export class SwarmMetrics {
+  transferId: Uint8Array;
+  swarmId: Uint8Array;
+  label: string;
+  read: strims_transfer_v1_TransferMetrics | undefined;
+  write: strims_transfer_v1_TransferMetrics | undefined;
+  peers: strims_transfer_v1_PeerSwarmMetrics[];
+
+  constructor(v?: ISwarmMetrics) {
+    this.transferId = v?.transferId || new Uint8Array();
+    this.swarmId = v?.swarmId || new Uint8Array();
+    this.label = v?.label || "";
+    this.read = v?.read && new strims_transfer_v1_TransferMetrics(v.read);
+    this.write = v?.write && new strims_transfer_v1_TransferMetrics(v.write);
+    this.peers = v?.peers ? v.peers.map(v => new strims_transfer_v1_PeerSwarmMetrics(v)) : [];
+  }
+
+  static encode(m: SwarmMetrics, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.transferId.length) w.uint32(10).bytes(m.transferId);
+    if (m.swarmId.length) w.uint32(18).bytes(m.swarmId);
+    if (m.label.length) w.uint32(26).string(m.label);
+    if (m.read) strims_transfer_v1_TransferMetrics.encode(m.read, w.uint32(34).fork()).ldelim();
+    if (m.write) strims_transfer_v1_TransferMetrics.encode(m.write, w.uint32(42).fork()).ldelim();
+    for (const v of m.peers) strims_transfer_v1_PeerSwarmMetrics.encode(v, w.uint32(50).fork()).ldelim();
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): SwarmMetrics {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new SwarmMetrics();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.transferId = r.bytes();
+        break;
+        case 2:
+        m.swarmId = r.bytes();
+        break;
+        case 3:
+        m.label = r.string();
+        break;
+        case 4:
+        m.read = strims_transfer_v1_TransferMetrics.decode(r, r.uint32());
+        break;
+        case 5:
+        m.write = strims_transfer_v1_TransferMetrics.decode(r, r.uint32());
+        break;
+        case 6:
+        m.peers.push(strims_transfer_v1_PeerSwarmMetrics.decode(r, r.uint32()));
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export type IWatchSwarmMetricsRequest = {
+  intervalMs?: number;
+}
+
+export class WatchSwarmMetricsRequest {
+  intervalMs: number;
+
+  constructor(v?: IWatchSwarmMetricsRequest) {
+    this.intervalMs = v?.intervalMs || 0;
+  }
+
+  static encode(m: WatchSwarmMetricsRequest, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.intervalMs) w.uint32(8).int32(m.intervalMs);
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): WatchSwarmMetricsRequest {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new WatchSwarmMetricsRequest();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.intervalMs = r.int32();
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export type IWatchSwarmMetricsResponse = {
+  swarms?: strims_transfer_v1_ISwarmMetrics[];
+}
+
+export class WatchSwarmMetricsResponse {
+  swarms: strims_transfer_v1_SwarmMetrics[];
+
+  constructor(v?: IWatchSwarmMetricsResponse) {
+    this.swarms = v?.swarms ? v.swarms.map(v => new strims_transfer_v1_SwarmMetrics(v)) : [];
+  }
+
+  static encode(m: WatchSwarmMetricsResponse, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    for (const v of m.swarms) strims_transfer_v1_SwarmMetrics.encode(v, w.uint32(10).fork()).ldelim();
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): WatchSwarmMetricsResponse {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new WatchSwarmMetricsResponse();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.swarms.push(strims_transfer_v1_SwarmMetrics.decode(r, r.uint32()));
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
 /* @internal */
 export const strims_transfer_v1_Transfer = Transfer;
 /* @internal */
 export type strims_transfer_v1_Transfer = Transfer;
 /* @internal */
 export type strims_transfer_v1_ITransfer = ITransfer;
+/* @internal */
+export const strims_transfer_v1_TransferMetrics = TransferMetrics;
+/* @internal */
+export type strims_transfer_v1_TransferMetrics = TransferMetrics;
+/* @internal */
+export type strims_transfer_v1_ITransferMetrics = ITransferMetrics;
+/* @internal */
+export const strims_transfer_v1_PeerSwarmMetrics = PeerSwarmMetrics;
+/* @internal */
+export type strims_transfer_v1_PeerSwarmMetrics = PeerSwarmMetrics;
+/* @internal */
+export type strims_transfer_v1_IPeerSwarmMetrics = IPeerSwarmMetrics;
+/* @internal */
+export const strims_transfer_v1_SwarmMetrics = SwarmMetrics;
+/* @internal */
+export type strims_transfer_v1_SwarmMetrics = SwarmMetrics;
+/* @internal */
+export type strims_transfer_v1_ISwarmMetrics = ISwarmMetrics;
+/* @internal */
+export const strims_transfer_v1_WatchSwarmMetricsRequest = WatchSwarmMetricsRequest;
+/* @internal */
+export type strims_transfer_v1_WatchSwarmMetricsRequest = WatchSwarmMetricsRequest;
+/* @internal */
+export type strims_transfer_v1_IWatchSwarmMetricsRequest = IWatchSwarmMetricsRequest;
+/* @internal */
+export const strims_transfer_v1_WatchSwarmMetricsResponse = WatchSwarmMetricsResponse;
+/* @internal */
+export type strims_transfer_v1_WatchSwarmMetricsResponse = WatchSwarmMetricsResponse;
+/* @internal */
+export type strims_transfer_v1_IWatchSwarmMetricsResponse = IWatchSwarmMetricsResponse;
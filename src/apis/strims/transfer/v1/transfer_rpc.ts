@@ -0,0 +1,31 @@
+import strims_rpc_Host from "@memelabs/protobuf/lib/rpc/host";
+import strims_rpc_Service from "@memelabs/protobuf/lib/rpc/service";
+import { Call as strims_rpc_Call } from "@memelabs/protobuf/lib/apis/strims/rpc/rpc";
+import { Readable as GenericReadable } from "@memelabs/protobuf/lib/rpc/stream";
+
+import {
+  strims_transfer_v1_IWatchSwarmMetricsRequest,
+  strims_transfer_v1_WatchSwarmMetricsRequest,
+  strims_transfer_v1_WatchSwarmMetricsResponse,
+} from "./transfer";
+
+export interface TransferFrontendService {
+  watchSwarmMetrics(req: strims_transfer_v1_WatchSwarmMetricsRequest, call: strims_rpc_Call): GenericReadable<strims_transfer_v1_WatchSwarmMetricsResponse>;
+}
+
+export class UnimplementedTransferFrontendService implements TransferFrontendService {
+  watchSwarmMetrics(req: strims_transfer_v1_WatchSwarmMetricsRequest, call: strims_rpc_Call): GenericReadable<strims_transfer_v1_WatchSwarmMetricsResponse> { throw new Error("not implemented"); }
+}
+
+export const registerTransferFrontendService = (host: strims_rpc_Service, service: TransferFrontendService): void => {
+  host.registerMethod<strims_transfer_v1_WatchSwarmMetricsRequest, strims_transfer_v1_WatchSwarmMetricsResponse>("strims.transfer.v1.TransferFrontend.WatchSwarmMetrics", service.watchSwarmMetrics.bind(service), strims_transfer_v1_WatchSwarmMetricsRequest);
+}
+
+export class TransferFrontendClient {
+  constructor(private readonly host: strims_rpc_Host) {}
+
+  public watchSwarmMetrics(req?: strims_transfer_v1_IWatchSwarmMetricsRequest): GenericReadable<strims_transfer_v1_WatchSwarmMetricsResponse> {
+    return this.host.expectMany(this.host.call("strims.transfer.v1.TransferFrontend.WatchSwarmMetrics", new strims_transfer_v1_WatchSwarmMetricsRequest(req)), strims_transfer_v1_WatchSwarmMetricsResponse);
+  }
+}
+
@@ -19,6 +19,7 @@ export type IVideoChannel = {
   key?: strims_type_IKey;
   token?: Uint8Array;
   directoryListingSnippet?: strims_network_v1_directory_IListingSnippet;
+  membersOnly?: boolean;
   owner?: VideoChannel.IOwner
 }
 
@@ -27,6 +28,7 @@ export class VideoChannel {
   key: strims_type_Key | undefined;
   token: Uint8Array;
   directoryListingSnippet: strims_network_v1_directory_ListingSnippet | undefined;
+  membersOnly: boolean;
   owner: VideoChannel.TOwner;
 
   constructor(v?: IVideoChannel) {
@@ -34,6 +36,7 @@ export class VideoChannel {
     this.key = v?.key && new strims_type_Key(v.key);
     this.token = v?.token || new Uint8Array();
     this.directoryListingSnippet = v?.directoryListingSnippet && new strims_network_v1_directory_ListingSnippet(v.directoryListingSnippet);
+    this.membersOnly = v?.membersOnly || false;
     this.owner = new VideoChannel.Owner(v?.owner);
   }
 
@@ -43,6 +46,7 @@ export class VideoChannel {
     if (m.key) strims_type_Key.encode(m.key, w.uint32(18).fork()).ldelim();
     if (m.token.length) w.uint32(26).bytes(m.token);
     if (m.directoryListingSnippet) strims_network_v1_directory_ListingSnippet.encode(m.directoryListingSnippet, w.uint32(34).fork()).ldelim();
+    if (m.membersOnly) w.uint32(40).bool(m.membersOnly);
     switch (m.owner.case) {
       case VideoChannel.OwnerCase.LOCAL:
       strims_video_v1_VideoChannel_Local.encode(m.owner.local, w.uint32(8010).fork()).ldelim();
@@ -85,6 +89,9 @@ export class VideoChannel {
         case 4:
         m.directoryListingSnippet = strims_network_v1_directory_ListingSnippet.decode(r, r.uint32());
         break;
+        case 5:
+        m.membersOnly = r.bool();
+        break;
         default:
         r.skipType(tag & 7);
         break;
@@ -423,21 +430,25 @@ export class VideoChannelGetResponse {
 export type IVideoChannelCreateRequest = {
   directoryListingSnippet?: strims_network_v1_directory_IListingSnippet;
   networkKey?: Uint8Array;
+  membersOnly?: boolean;
 }
 
 export class VideoChannelCreateRequest {
   directoryListingSnippet: strims_network_v1_directory_ListingSnippet | undefined;
   networkKey: Uint8Array;
+  membersOnly: boolean;
 
   constructor(v?: IVideoChannelCreateRequest) {
     this.directoryListingSnippet = v?.directoryListingSnippet && new strims_network_v1_directory_ListingSnippet(v.directoryListingSnippet);
     this.networkKey = v?.networkKey || new Uint8Array();
+    this.membersOnly = v?.membersOnly || false;
   }
 
   static encode(m: VideoChannelCreateRequest, w?: Writer): Writer {
     if (!w) w = new Writer();
     if (m.directoryListingSnippet) strims_network_v1_directory_ListingSnippet.encode(m.directoryListingSnippet, w.uint32(10).fork()).ldelim();
     if (m.networkKey.length) w.uint32(18).bytes(m.networkKey);
+    if (m.membersOnly) w.uint32(24).bool(m.membersOnly);
     return w;
   }
 
@@ -454,6 +465,9 @@ export class VideoChannelCreateRequest {
         case 2:
         m.networkKey = r.bytes();
         break;
+        case 3:
+        m.membersOnly = r.bool();
+        break;
         default:
         r.skipType(tag & 7);
         break;
@@ -503,17 +517,20 @@ export type IVideoChannelUpdateRequest = {
   id?: bigint;
   directoryListingSnippet?: strims_network_v1_directory_IListingSnippet;
   networkKey?: Uint8Array;
+  membersOnly?: boolean;
 }
 
 export class VideoChannelUpdateRequest {
   id: bigint;
   directoryListingSnippet: strims_network_v1_directory_ListingSnippet | undefined;
   networkKey: Uint8Array;
+  membersOnly: boolean;
 
   constructor(v?: IVideoChannelUpdateRequest) {
     this.id = v?.id || BigInt(0);
     this.directoryListingSnippet = v?.directoryListingSnippet && new strims_network_v1_directory_ListingSnippet(v.directoryListingSnippet);
     this.networkKey = v?.networkKey || new Uint8Array();
+    this.membersOnly = v?.membersOnly || false;
   }
 
   static encode(m: VideoChannelUpdateRequest, w?: Writer): Writer {
@@ -521,6 +538,7 @@ export class VideoChannelUpdateRequest {
     if (m.id) w.uint32(8).uint64(m.id);
     if (m.directoryListingSnippet) strims_network_v1_directory_ListingSnippet.encode(m.directoryListingSnippet, w.uint32(18).fork()).ldelim();
     if (m.networkKey.length) w.uint32(26).bytes(m.networkKey);
+    if (m.membersOnly) w.uint32(32).bool(m.membersOnly);
     return w;
   }
 
@@ -540,6 +558,9 @@ export class VideoChannelUpdateRequest {
         case 3:
         m.networkKey = r.bytes();
         break;
+        case 4:
+        m.membersOnly = r.bool();
+        break;
         default:
         r.skipType(tag & 7);
         break;
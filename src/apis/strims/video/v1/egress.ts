@@ -5,21 +5,25 @@ import Writer from "@memelabs/protobuf/lib/pb/writer";
 export type IEgressOpenStreamRequest = {
   swarmUri?: string;
   networkKeys?: Uint8Array[];
+  rewindMs?: number;
 }
 
 export class EgressOpenStreamRequest {
   swarmUri: string;
   networkKeys: Uint8Array[];
+  rewindMs: number;
 
   constructor(v?: IEgressOpenStreamRequest) {
     this.swarmUri = v?.swarmUri || "";
     this.networkKeys = v?.networkKeys ? v.networkKeys : [];
+    this.rewindMs = v?.rewindMs || 0;
   }
 
   static encode(m: EgressOpenStreamRequest, w?: Writer): Writer {
     if (!w) w = new Writer();
     if (m.swarmUri.length) w.uint32(10).string(m.swarmUri);
     for (const v of m.networkKeys) w.uint32(18).bytes(v);
+    if (m.rewindMs) w.uint32(24).uint32(m.rewindMs);
     return w;
   }
 
@@ -36,6 +40,9 @@ export class EgressOpenStreamRequest {
         case 2:
         m.networkKeys.push(r.bytes())
         break;
+        case 3:
+        m.rewindMs = r.uint32();
+        break;
         default:
         r.skipType(tag & 7);
         break;
@@ -92,6 +92,49 @@ export class TCPMuxInit {
   }
 }
 
+export type IUDPMuxInit = {
+  protocolVersion?: number;
+  peerKey?: Uint8Array;
+}
+
+export class UDPMuxInit {
+  protocolVersion: number;
+  peerKey: Uint8Array;
+
+  constructor(v?: IUDPMuxInit) {
+    this.protocolVersion = v?.protocolVersion || 0;
+    this.peerKey = v?.peerKey || new Uint8Array();
+  }
+
+  static encode(m: UDPMuxInit, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.protocolVersion) w.uint32(8).uint32(m.protocolVersion);
+    if (m.peerKey.length) w.uint32(18).bytes(m.peerKey);
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): UDPMuxInit {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new UDPMuxInit();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.protocolVersion = r.uint32();
+        break;
+        case 2:
+        m.peerKey = r.bytes();
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
 export type IAESLinkInit = {
   protocolVersion?: number;
   key?: Uint8Array;
@@ -147,6 +190,7 @@ export type IPeerInit = {
   certificate?: strims_type_ICertificate;
   nodePlatform?: string;
   nodeVersion?: string;
+  idNonce?: bigint;
 }
 
 export class PeerInit {
@@ -154,12 +198,14 @@ export class PeerInit {
   certificate: strims_type_Certificate | undefined;
   nodePlatform: string;
   nodeVersion: string;
+  idNonce: bigint;
 
   constructor(v?: IPeerInit) {
     this.protocolVersion = v?.protocolVersion || 0;
     this.certificate = v?.certificate && new strims_type_Certificate(v.certificate);
     this.nodePlatform = v?.nodePlatform || "";
     this.nodeVersion = v?.nodeVersion || "";
+    this.idNonce = v?.idNonce || BigInt(0);
   }
 
   static encode(m: PeerInit, w?: Writer): Writer {
@@ -168,6 +214,7 @@ export class PeerInit {
     if (m.certificate) strims_type_Certificate.encode(m.certificate, w.uint32(18).fork()).ldelim();
     if (m.nodePlatform.length) w.uint32(26).string(m.nodePlatform);
     if (m.nodeVersion.length) w.uint32(34).string(m.nodeVersion);
+    if (m.idNonce) w.uint32(40).uint64(m.idNonce);
     return w;
   }
 
@@ -190,6 +237,9 @@ export class PeerInit {
         case 4:
         m.nodeVersion = r.string();
         break;
+        case 5:
+        m.idNonce = r.uint64();
+        break;
         default:
         r.skipType(tag & 7);
         break;
@@ -382,6 +432,12 @@ export type strims_vnic_v1_TCPMuxInit = TCPMuxInit;
 /* @internal */
 export type strims_vnic_v1_ITCPMuxInit = ITCPMuxInit;
 /* @internal */
+export const strims_vnic_v1_UDPMuxInit = UDPMuxInit;
+/* @internal */
+export type strims_vnic_v1_UDPMuxInit = UDPMuxInit;
+/* @internal */
+export type strims_vnic_v1_IUDPMuxInit = IUDPMuxInit;
+/* @internal */
 export const strims_vnic_v1_AESLinkInit = AESLinkInit;
 /* @internal */
 export type strims_vnic_v1_AESLinkInit = AESLinkInit;
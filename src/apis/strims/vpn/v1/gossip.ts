@@ -0,0 +1,556 @@
+import Reader from "@memelabs/protobuf/lib/pb/reader";
+import Writer from "@memelabs/protobuf/lib/pb/writer";
+
+
+export type IGossipMessage = {
+  body?: GossipMessage.IBody
+}
+
+export class GossipMessage {
+  body: GossipMessage.TBody;
+
+  constructor(v?: IGossipMessage) {
+    this.body = new GossipMessage.Body(v?.body);
+  }
+
+  static encode(m: GossipMessage, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    switch (m.body.case) {
+      case GossipMessage.BodyCase.SUBSCRIBE:
+      strims_vpn_v1_GossipMessage_Subscribe.encode(m.body.subscribe, w.uint32(10).fork()).ldelim();
+      break;
+      case GossipMessage.BodyCase.UNSUBSCRIBE:
+      strims_vpn_v1_GossipMessage_Unsubscribe.encode(m.body.unsubscribe, w.uint32(18).fork()).ldelim();
+      break;
+      case GossipMessage.BodyCase.PUBLISH:
+      strims_vpn_v1_GossipMessage_Publish.encode(m.body.publish, w.uint32(26).fork()).ldelim();
+      break;
+      case GossipMessage.BodyCase.IHAVE:
+      strims_vpn_v1_GossipMessage_IHave.encode(m.body.ihave, w.uint32(34).fork()).ldelim();
+      break;
+      case GossipMessage.BodyCase.IWANT:
+      strims_vpn_v1_GossipMessage_IWant.encode(m.body.iwant, w.uint32(42).fork()).ldelim();
+      break;
+      case GossipMessage.BodyCase.GRAFT:
+      strims_vpn_v1_GossipMessage_Graft.encode(m.body.graft, w.uint32(50).fork()).ldelim();
+      break;
+      case GossipMessage.BodyCase.PRUNE:
+      strims_vpn_v1_GossipMessage_Prune.encode(m.body.prune, w.uint32(58).fork()).ldelim();
+      break;
+    }
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): GossipMessage {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new GossipMessage();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.body = new GossipMessage.Body({ subscribe: strims_vpn_v1_GossipMessage_Subscribe.decode(r, r.uint32()) });
+        break;
+        case 2:
+        m.body = new GossipMessage.Body({ unsubscribe: strims_vpn_v1_GossipMessage_Unsubscribe.decode(r, r.uint32()) });
+        break;
+        case 3:
+        m.body = new GossipMessage.Body({ publish: strims_vpn_v1_GossipMessage_Publish.decode(r, r.uint32()) });
+        break;
+        case 4:
+        m.body = new GossipMessage.Body({ ihave: strims_vpn_v1_GossipMessage_IHave.decode(r, r.uint32()) });
+        break;
+        case 5:
+        m.body = new GossipMessage.Body({ iwant: strims_vpn_v1_GossipMessage_IWant.decode(r, r.uint32()) });
+        break;
+        case 6:
+        m.body = new GossipMessage.Body({ graft: strims_vpn_v1_GossipMessage_Graft.decode(r, r.uint32()) });
+        break;
+        case 7:
+        m.body = new GossipMessage.Body({ prune: strims_vpn_v1_GossipMessage_Prune.decode(r, r.uint32()) });
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export namespace GossipMessage {
+  export enum BodyCase {
+    NOT_SET = 0,
+    SUBSCRIBE = 1,
+    UNSUBSCRIBE = 2,
+    PUBLISH = 3,
+    IHAVE = 4,
+    IWANT = 5,
+    GRAFT = 6,
+    PRUNE = 7,
+  }
+
+  export type IBody =
+  { case?: BodyCase.NOT_SET }
+  |{ case?: BodyCase.SUBSCRIBE, subscribe: strims_vpn_v1_GossipMessage_ISubscribe }
+  |{ case?: BodyCase.UNSUBSCRIBE, unsubscribe: strims_vpn_v1_GossipMessage_IUnsubscribe }
+  |{ case?: BodyCase.PUBLISH, publish: strims_vpn_v1_GossipMessage_IPublish }
+  |{ case?: BodyCase.IHAVE, ihave: strims_vpn_v1_GossipMessage_IIHave }
+  |{ case?: BodyCase.IWANT, iwant: strims_vpn_v1_GossipMessage_IIWant }
+  |{ case?: BodyCase.GRAFT, graft: strims_vpn_v1_GossipMessage_IGraft }
+  |{ case?: BodyCase.PRUNE, prune: strims_vpn_v1_GossipMessage_IPrune }
+  ;
+
+  export type TBody = Readonly<
+  { case: BodyCase.NOT_SET }
+  |{ case: BodyCase.SUBSCRIBE, subscribe: strims_vpn_v1_GossipMessage_Subscribe }
+  |{ case: BodyCase.UNSUBSCRIBE, unsubscribe: strims_vpn_v1_GossipMessage_Unsubscribe }
+  |{ case: BodyCase.PUBLISH, publish: strims_vpn_v1_GossipMessage_Publish }
+  |{ case: BodyCase.IHAVE, ihave: strims_vpn_v1_GossipMessage_IHave }
+  |{ case: BodyCase.IWANT, iwant: strims_vpn_v1_GossipMessage_IWant }
+  |{ case: BodyCase.GRAFT, graft: strims_vpn_v1_GossipMessage_Graft }
+  |{ case: BodyCase.PRUNE, prune: strims_vpn_v1_GossipMessage_Prune }
+  >;
+
+  class BodyImpl {
+    subscribe: strims_vpn_v1_GossipMessage_Subscribe;
+    unsubscribe: strims_vpn_v1_GossipMessage_Unsubscribe;
+    publish: strims_vpn_v1_GossipMessage_Publish;
+    ihave: strims_vpn_v1_GossipMessage_IHave;
+    iwant: strims_vpn_v1_GossipMessage_IWant;
+    graft: strims_vpn_v1_GossipMessage_Graft;
+    prune: strims_vpn_v1_GossipMessage_Prune;
+    case: BodyCase = BodyCase.NOT_SET;
+
+    constructor(v?: IBody) {
+      if (v && "subscribe" in v) {
+        this.case = BodyCase.SUBSCRIBE;
+        this.subscribe = new strims_vpn_v1_GossipMessage_Subscribe(v.subscribe);
+      } else
+      if (v && "unsubscribe" in v) {
+        this.case = BodyCase.UNSUBSCRIBE;
+        this.unsubscribe = new strims_vpn_v1_GossipMessage_Unsubscribe(v.unsubscribe);
+      } else
+      if (v && "publish" in v) {
+        this.case = BodyCase.PUBLISH;
+        this.publish = new strims_vpn_v1_GossipMessage_Publish(v.publish);
+      } else
+      if (v && "ihave" in v) {
+        this.case = BodyCase.IHAVE;
+        this.ihave = new strims_vpn_v1_GossipMessage_IHave(v.ihave);
+      } else
+      if (v && "iwant" in v) {
+        this.case = BodyCase.IWANT;
+        this.iwant = new strims_vpn_v1_GossipMessage_IWant(v.iwant);
+      } else
+      if (v && "graft" in v) {
+        this.case = BodyCase.GRAFT;
+        this.graft = new strims_vpn_v1_GossipMessage_Graft(v.graft);
+      } else
+      if (v && "prune" in v) {
+        this.case = BodyCase.PRUNE;
+        this.prune = new strims_vpn_v1_GossipMessage_Prune(v.prune);
+      }
+    }
+  }
+
+  export const Body = BodyImpl as {
+    new (): Readonly<{ case: BodyCase.NOT_SET }>;
+    new <T extends IBody>(v: T): Readonly<
+    T extends { subscribe: strims_vpn_v1_GossipMessage_ISubscribe } ? { case: BodyCase.SUBSCRIBE, subscribe: strims_vpn_v1_GossipMessage_Subscribe } :
+    T extends { unsubscribe: strims_vpn_v1_GossipMessage_IUnsubscribe } ? { case: BodyCase.UNSUBSCRIBE, unsubscribe: strims_vpn_v1_GossipMessage_Unsubscribe } :
+    T extends { publish: strims_vpn_v1_GossipMessage_IPublish } ? { case: BodyCase.PUBLISH, publish: strims_vpn_v1_GossipMessage_Publish } :
+    T extends { ihave: strims_vpn_v1_GossipMessage_IIHave } ? { case: BodyCase.IHAVE, ihave: strims_vpn_v1_GossipMessage_IHave } :
+    T extends { iwant: strims_vpn_v1_GossipMessage_IIWant } ? { case: BodyCase.IWANT, iwant: strims_vpn_v1_GossipMessage_IWant } :
+    T extends { graft: strims_vpn_v1_GossipMessage_IGraft } ? { case: BodyCase.GRAFT, graft: strims_vpn_v1_GossipMessage_Graft } :
+    T extends { prune: strims_vpn_v1_GossipMessage_IPrune } ? { case: BodyCase.PRUNE, prune: strims_vpn_v1_GossipMessage_Prune } :
+    never
+    >;
+  };
+
+  export type IRecord = {
+    topic?: Uint8Array;
+    data?: Uint8Array;
+    seq?: bigint;
+    timestamp?: bigint;
+    key?: Uint8Array;
+    signature?: Uint8Array;
+  }
+
+  export class Record {
+    topic: Uint8Array;
+    data: Uint8Array;
+    seq: bigint;
+    timestamp: bigint;
+    key: Uint8Array;
+    signature: Uint8Array;
+
+    constructor(v?: IRecord) {
+      this.topic = v?.topic || new Uint8Array();
+      this.data = v?.data || new Uint8Array();
+      this.seq = v?.seq || BigInt(0);
+      this.timestamp = v?.timestamp || BigInt(0);
+      this.key = v?.key || new Uint8Array();
+      this.signature = v?.signature || new Uint8Array();
+    }
+
+    static encode(m: Record, w?: Writer): Writer {
+      if (!w) w = new Writer();
+      if (m.topic.length) w.uint32(10).bytes(m.topic);
+      if (m.data.length) w.uint32(18).bytes(m.data);
+      if (m.seq) w.uint32(24).uint64(m.seq);
+      if (m.timestamp) w.uint32(32).int64(m.timestamp);
+      if (m.key.length) w.uint32(80010).bytes(m.key);
+      if (m.signature.length) w.uint32(80018).bytes(m.signature);
+      return w;
+    }
+
+    static decode(r: Reader | Uint8Array, length?: number): Record {
+      r = r instanceof Reader ? r : new Reader(r);
+      const end = length === undefined ? r.len : r.pos + length;
+      const m = new Record();
+      while (r.pos < end) {
+        const tag = r.uint32();
+        switch (tag >> 3) {
+          case 1:
+          m.topic = r.bytes();
+          break;
+          case 2:
+          m.data = r.bytes();
+          break;
+          case 3:
+          m.seq = r.uint64();
+          break;
+          case 4:
+          m.timestamp = r.int64();
+          break;
+          case 10001:
+          m.key = r.bytes();
+          break;
+          case 10002:
+          m.signature = r.bytes();
+          break;
+          default:
+          r.skipType(tag & 7);
+          break;
+        }
+      }
+      return m;
+    }
+  }
+
+  export type ISubscribe = {
+    topics?: Uint8Array[];
+  }
+
+  export class Subscribe {
+    topics: Uint8Array[];
+
+    constructor(v?: ISubscribe) {
+      this.topics = v?.topics ? v.topics : [];
+    }
+
+    static encode(m: Subscribe, w?: Writer): Writer {
+      if (!w) w = new Writer();
+      for (const v of m.topics) w.uint32(10).bytes(v);
+      return w;
+    }
+
+    static decode(r: Reader | Uint8Array, length?: number): Subscribe {
+      r = r instanceof Reader ? r : new Reader(r);
+      const end = length === undefined ? r.len : r.pos + length;
+      const m = new Subscribe();
+      while (r.pos < end) {
+        const tag = r.uint32();
+        switch (tag >> 3) {
+          case 1:
+          m.topics.push(r.bytes())
+          break;
+          default:
+          r.skipType(tag & 7);
+          break;
+        }
+      }
+      return m;
+    }
+  }
+
+  export type IUnsubscribe = {
+    topics?: Uint8Array[];
+  }
+
+  export class Unsubscribe {
+    topics: Uint8Array[];
+
+    constructor(v?: IUnsubscribe) {
+      this.topics = v?.topics ? v.topics : [];
+    }
+
+    static encode(m: Unsubscribe, w?: Writer): Writer {
+      if (!w) w = new Writer();
+      for (const v of m.topics) w.uint32(10).bytes(v);
+      return w;
+    }
+
+    static decode(r: Reader | Uint8Array, length?: number): Unsubscribe {
+      r = r instanceof Reader ? r : new Reader(r);
+      const end = length === undefined ? r.len : r.pos + length;
+      const m = new Unsubscribe();
+      while (r.pos < end) {
+        const tag = r.uint32();
+        switch (tag >> 3) {
+          case 1:
+          m.topics.push(r.bytes())
+          break;
+          default:
+          r.skipType(tag & 7);
+          break;
+        }
+      }
+      return m;
+    }
+  }
+
+  export type IPublish = {
+    record?: strims_vpn_v1_GossipMessage_IRecord;
+  }
+
+  export class Publish {
+    record: strims_vpn_v1_GossipMessage_Record | undefined;
+
+    constructor(v?: IPublish) {
+      this.record = v?.record && new strims_vpn_v1_GossipMessage_Record(v.record);
+    }
+
+    static encode(m: Publish, w?: Writer): Writer {
+      if (!w) w = new Writer();
+      if (m.record) strims_vpn_v1_GossipMessage_Record.encode(m.record, w.uint32(10).fork()).ldelim();
+      return w;
+    }
+
+    static decode(r: Reader | Uint8Array, length?: number): Publish {
+      r = r instanceof Reader ? r : new Reader(r);
+      const end = length === undefined ? r.len : r.pos + length;
+      const m = new Publish();
+      while (r.pos < end) {
+        const tag = r.uint32();
+        switch (tag >> 3) {
+          case 1:
+          m.record = strims_vpn_v1_GossipMessage_Record.decode(r, r.uint32());
+          break;
+          default:
+          r.skipType(tag & 7);
+          break;
+        }
+      }
+      return m;
+    }
+  }
+
+  export type IIHave = {
+    topic?: Uint8Array;
+    messageIds?: Uint8Array[];
+  }
+
+  export class IHave {
+    topic: Uint8Array;
+    messageIds: Uint8Array[];
+
+    constructor(v?: IIHave) {
+      this.topic = v?.topic || new Uint8Array();
+      this.messageIds = v?.messageIds ? v.messageIds : [];
+    }
+
+    static encode(m: IHave, w?: Writer): Writer {
+      if (!w) w = new Writer();
+      if (m.topic.length) w.uint32(10).bytes(m.topic);
+      for (const v of m.messageIds) w.uint32(18).bytes(v);
+      return w;
+    }
+
+    static decode(r: Reader | Uint8Array, length?: number): IHave {
+      r = r instanceof Reader ? r : new Reader(r);
+      const end = length === undefined ? r.len : r.pos + length;
+      const m = new IHave();
+      while (r.pos < end) {
+        const tag = r.uint32();
+        switch (tag >> 3) {
+          case 1:
+          m.topic = r.bytes();
+          break;
+          case 2:
+          m.messageIds.push(r.bytes())
+          break;
+          default:
+          r.skipType(tag & 7);
+          break;
+        }
+      }
+      return m;
+    }
+  }
+
+  export type IIWant = {
+    messageIds?: Uint8Array[];
+  }
+
+  export class IWant {
+    messageIds: Uint8Array[];
+
+    constructor(v?: IIWant) {
+      this.messageIds = v?.messageIds ? v.messageIds : [];
+    }
+
+    static encode(m: IWant, w?: Writer): Writer {
+      if (!w) w = new Writer();
+      for (const v of m.messageIds) w.uint32(10).bytes(v);
+      return w;
+    }
+
+    static decode(r: Reader | Uint8Array, length?: number): IWant {
+      r = r instanceof Reader ? r : new Reader(r);
+      const end = length === undefined ? r.len : r.pos + length;
+      const m = new IWant();
+      while (r.pos < end) {
+        const tag = r.uint32();
+        switch (tag >> 3) {
+          case 1:
+          m.messageIds.push(r.bytes())
+          break;
+          default:
+          r.skipType(tag & 7);
+          break;
+        }
+      }
+      return m;
+    }
+  }
+
+  export type IGraft = {
+    topic?: Uint8Array;
+  }
+
+  export class Graft {
+    topic: Uint8Array;
+
+    constructor(v?: IGraft) {
+      this.topic = v?.topic || new Uint8Array();
+    }
+
+    static encode(m: Graft, w?: Writer): Writer {
+      if (!w) w = new Writer();
+      if (m.topic.length) w.uint32(10).bytes(m.topic);
+      return w;
+    }
+
+    static decode(r: Reader | Uint8Array, length?: number): Graft {
+      r = r instanceof Reader ? r : new Reader(r);
+      const end = length === undefined ? r.len : r.pos + length;
+      const m = new Graft();
+      while (r.pos < end) {
+        const tag = r.uint32();
+        switch (tag >> 3) {
+          case 1:
+          m.topic = r.bytes();
+          break;
+          default:
+          r.skipType(tag & 7);
+          break;
+        }
+      }
+      return m;
+    }
+  }
+
+  export type IPrune = {
+    topic?: Uint8Array;
+  }
+
+  export class Prune {
+    topic: Uint8Array;
+
+    constructor(v?: IPrune) {
+      this.topic = v?.topic || new Uint8Array();
+    }
+
+    static encode(m: Prune, w?: Writer): Writer {
+      if (!w) w = new Writer();
+      if (m.topic.length) w.uint32(10).bytes(m.topic);
+      return w;
+    }
+
+    static decode(r: Reader | Uint8Array, length?: number): Prune {
+      r = r instanceof Reader ? r : new Reader(r);
+      const end = length === undefined ? r.len : r.pos + length;
+      const m = new Prune();
+      while (r.pos < end) {
+        const tag = r.uint32();
+        switch (tag >> 3) {
+          case 1:
+          m.topic = r.bytes();
+          break;
+          default:
+          r.skipType(tag & 7);
+          break;
+        }
+      }
+      return m;
+    }
+  }
+
+}
+
+/* @internal */
+export const strims_vpn_v1_GossipMessage = GossipMessage;
+/* @internal */
+export type strims_vpn_v1_GossipMessage = GossipMessage;
+/* @internal */
+export type strims_vpn_v1_IGossipMessage = IGossipMessage;
+/* @internal */
+export const strims_vpn_v1_GossipMessage_Record = GossipMessage.Record;
+/* @internal */
+export type strims_vpn_v1_GossipMessage_Record = GossipMessage.Record;
+/* @internal */
+export type strims_vpn_v1_GossipMessage_IRecord = GossipMessage.IRecord;
+/* @internal */
+export const strims_vpn_v1_GossipMessage_Subscribe = GossipMessage.Subscribe;
+/* @internal */
+export type strims_vpn_v1_GossipMessage_Subscribe = GossipMessage.Subscribe;
+/* @internal */
+export type strims_vpn_v1_GossipMessage_ISubscribe = GossipMessage.ISubscribe;
+/* @internal */
+export const strims_vpn_v1_GossipMessage_Unsubscribe = GossipMessage.Unsubscribe;
+/* @internal */
+export type strims_vpn_v1_GossipMessage_Unsubscribe = GossipMessage.Unsubscribe;
+/* @internal */
+export type strims_vpn_v1_GossipMessage_IUnsubscribe = GossipMessage.IUnsubscribe;
+/* @internal */
+export const strims_vpn_v1_GossipMessage_Publish = GossipMessage.Publish;
+/* @internal */
+export type strims_vpn_v1_GossipMessage_Publish = GossipMessage.Publish;
+/* @internal */
+export type strims_vpn_v1_GossipMessage_IPublish = GossipMessage.IPublish;
+/* @internal */
+export const strims_vpn_v1_GossipMessage_IHave = GossipMessage.IHave;
+/* @internal */
+export type strims_vpn_v1_GossipMessage_IHave = GossipMessage.IHave;
+/* @internal */
+export type strims_vpn_v1_GossipMessage_IIHave = GossipMessage.IIHave;
+/* @internal */
+export const strims_vpn_v1_GossipMessage_IWant = GossipMessage.IWant;
+/* @internal */
+export type strims_vpn_v1_GossipMessage_IWant = GossipMessage.IWant;
+/* @internal */
+export type strims_vpn_v1_GossipMessage_IIWant = GossipMessage.IIWant;
+/* @internal */
+export const strims_vpn_v1_GossipMessage_Graft = GossipMessage.Graft;
+/* @internal */
+export type strims_vpn_v1_GossipMessage_Graft = GossipMessage.Graft;
+/* @internal */
+export type strims_vpn_v1_GossipMessage_IGraft = GossipMessage.IGraft;
+/* @internal */
+export const strims_vpn_v1_GossipMessage_Prune = GossipMessage.Prune;
+/* @internal */
+export type strims_vpn_v1_GossipMessage_Prune = GossipMessage.Prune;
+/* @internal */
+export type strims_vpn_v1_GossipMessage_IPrune = GossipMessage.IPrune;
@@ -0,0 +1,489 @@
+import Reader from "@memelabs/protobuf/lib/pb/reader";
+import Writer from "@memelabs/protobuf/lib/pb/writer";
+
+
+export type IOnionMessage = {
+  ephemeralKey?: Uint8Array;
+  header?: Uint8Array;
+  payload?: Uint8Array;
+}
+
+export class OnionMessage {
+  ephemeralKey: Uint8Array;
+  header: Uint8Array;
+  payload: Uint8Array;
+
+  constructor(v?: IOnionMessage) {
+    this.ephemeralKey = v?.ephemeralKey || new Uint8Array();
+    this.header = v?.header || new Uint8Array();
+    this.payload = v?.payload || new Uint8Array();
+  }
+
+  static encode(m: OnionMessage, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.ephemeralKey.length) w.uint32(10).bytes(m.ephemeralKey);
+    if (m.header.length) w.uint32(18).bytes(m.header);
+    if (m.payload.length) w.uint32(26).bytes(m.payload);
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): OnionMessage {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new OnionMessage();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.ephemeralKey = r.bytes();
+        break;
+        case 2:
+        m.header = r.bytes();
+        break;
+        case 3:
+        m.payload = r.bytes();
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export type IOnionHeader = {
+  body?: OnionHeader.IBody
+}
+
+export class OnionHeader {
+  body: OnionHeader.TBody;
+
+  constructor(v?: IOnionHeader) {
+    this.body = new OnionHeader.Body(v?.body);
+  }
+
+  static encode(m: OnionHeader, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    switch (m.body.case) {
+      case OnionHeader.BodyCase.RELAY:
+      strims_vpn_v1_OnionHeader_Relay.encode(m.body.relay, w.uint32(10).fork()).ldelim();
+      break;
+      case OnionHeader.BodyCase.DELIVER:
+      strims_vpn_v1_OnionHeader_Deliver.encode(m.body.deliver, w.uint32(18).fork()).ldelim();
+      break;
+      case OnionHeader.BodyCase.RECEIVE:
+      strims_vpn_v1_OnionHeader_Receive.encode(m.body.receive, w.uint32(26).fork()).ldelim();
+      break;
+    }
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): OnionHeader {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new OnionHeader();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.body = new OnionHeader.Body({ relay: strims_vpn_v1_OnionHeader_Relay.decode(r, r.uint32()) });
+        break;
+        case 2:
+        m.body = new OnionHeader.Body({ deliver: strims_vpn_v1_OnionHeader_Deliver.decode(r, r.uint32()) });
+        break;
+        case 3:
+        m.body = new OnionHeader.Body({ receive: strims_vpn_v1_OnionHeader_Receive.decode(r, r.uint32()) });
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export namespace OnionHeader {
+  export enum BodyCase {
+    NOT_SET = 0,
+    RELAY = 1,
+    DELIVER = 2,
+    RECEIVE = 3,
+  }
+
+  export type IBody =
+  { case?: BodyCase.NOT_SET }
+  |{ case?: BodyCase.RELAY, relay: strims_vpn_v1_OnionHeader_IRelay }
+  |{ case?: BodyCase.DELIVER, deliver: strims_vpn_v1_OnionHeader_IDeliver }
+  |{ case?: BodyCase.RECEIVE, receive: strims_vpn_v1_OnionHeader_IReceive }
+  ;
+
+  export type TBody = Readonly<
+  { case: BodyCase.NOT_SET }
+  |{ case: BodyCase.RELAY, relay: strims_vpn_v1_OnionHeader_Relay }
+  |{ case: BodyCase.DELIVER, deliver: strims_vpn_v1_OnionHeader_Deliver }
+  |{ case: BodyCase.RECEIVE, receive: strims_vpn_v1_OnionHeader_Receive }
+  >;
+
+  class BodyImpl {
+    relay: strims_vpn_v1_OnionHeader_Relay;
+    deliver: strims_vpn_v1_OnionHeader_Deliver;
+    receive: strims_vpn_v1_OnionHeader_Receive;
+    case: BodyCase = BodyCase.NOT_SET;
+
+    constructor(v?: IBody) {
+      if (v && "relay" in v) {
+        this.case = BodyCase.RELAY;
+        this.relay = new strims_vpn_v1_OnionHeader_Relay(v.relay);
+      } else
+      if (v && "deliver" in v) {
+        this.case = BodyCase.DELIVER;
+        this.deliver = new strims_vpn_v1_OnionHeader_Deliver(v.deliver);
+      } else
+      if (v && "receive" in v) {
+        this.case = BodyCase.RECEIVE;
+        this.receive = new strims_vpn_v1_OnionHeader_Receive(v.receive);
+      }
+    }
+  }
+
+  export const Body = BodyImpl as {
+    new (): Readonly<{ case: BodyCase.NOT_SET }>;
+    new <T extends IBody>(v: T): Readonly<
+    T extends { relay: strims_vpn_v1_OnionHeader_IRelay } ? { case: BodyCase.RELAY, relay: strims_vpn_v1_OnionHeader_Relay } :
+    T extends { deliver: strims_vpn_v1_OnionHeader_IDeliver } ? { case: BodyCase.DELIVER, deliver: strims_vpn_v1_OnionHeader_Deliver } :
+    T extends { receive: strims_vpn_v1_OnionHeader_IReceive } ? { case: BodyCase.RECEIVE, receive: strims_vpn_v1_OnionHeader_Receive } :
+    never
+    >;
+  };
+
+  export type IRelay = {
+    hostId?: Uint8Array;
+    payloadKey?: Uint8Array;
+    ephemeralKey?: Uint8Array;
+    header?: Uint8Array;
+  }
+
+  export class Relay {
+    hostId: Uint8Array;
+    payloadKey: Uint8Array;
+    ephemeralKey: Uint8Array;
+    header: Uint8Array;
+
+    constructor(v?: IRelay) {
+      this.hostId = v?.hostId || new Uint8Array();
+      this.payloadKey = v?.payloadKey || new Uint8Array();
+      this.ephemeralKey = v?.ephemeralKey || new Uint8Array();
+      this.header = v?.header || new Uint8Array();
+    }
+
+    static encode(m: Relay, w?: Writer): Writer {
+      if (!w) w = new Writer();
+      if (m.hostId.length) w.uint32(10).bytes(m.hostId);
+      if (m.payloadKey.length) w.uint32(18).bytes(m.payloadKey);
+      if (m.ephemeralKey.length) w.uint32(26).bytes(m.ephemeralKey);
+      if (m.header.length) w.uint32(34).bytes(m.header);
+      return w;
+    }
+
+    static decode(r: Reader | Uint8Array, length?: number): Relay {
+      r = r instanceof Reader ? r : new Reader(r);
+      const end = length === undefined ? r.len : r.pos + length;
+      const m = new Relay();
+      while (r.pos < end) {
+        const tag = r.uint32();
+        switch (tag >> 3) {
+          case 1:
+          m.hostId = r.bytes();
+          break;
+          case 2:
+          m.payloadKey = r.bytes();
+          break;
+          case 3:
+          m.ephemeralKey = r.bytes();
+          break;
+          case 4:
+          m.header = r.bytes();
+          break;
+          default:
+          r.skipType(tag & 7);
+          break;
+        }
+      }
+      return m;
+    }
+  }
+
+  export type IDeliver = {
+    hostId?: Uint8Array;
+    port?: number;
+    srcPort?: number;
+    body?: Uint8Array;
+    replyBlock?: strims_vpn_v1_IOnionReplyBlock;
+    replyKey?: Uint8Array;
+    timestamp?: bigint;
+    key?: Uint8Array;
+    signature?: Uint8Array;
+  }
+
+  export class Deliver {
+    hostId: Uint8Array;
+    port: number;
+    srcPort: number;
+    body: Uint8Array;
+    replyBlock: strims_vpn_v1_OnionReplyBlock | undefined;
+    replyKey: Uint8Array;
+    timestamp: bigint;
+    key: Uint8Array;
+    signature: Uint8Array;
+
+    constructor(v?: IDeliver) {
+      this.hostId = v?.hostId || new Uint8Array();
+      this.port = v?.port || 0;
+      this.srcPort = v?.srcPort || 0;
+      this.body = v?.body || new Uint8Array();
+      this.replyBlock = v?.replyBlock && new strims_vpn_v1_OnionReplyBlock(v.replyBlock);
+      this.replyKey = v?.replyKey || new Uint8Array();
+      this.timestamp = v?.timestamp || BigInt(0);
+      this.key = v?.key || new Uint8Array();
+      this.signature = v?.signature || new Uint8Array();
+    }
+
+    static encode(m: Deliver, w?: Writer): Writer {
+      if (!w) w = new Writer();
+      if (m.hostId.length) w.uint32(10).bytes(m.hostId);
+      if (m.port) w.uint32(16).uint32(m.port);
+      if (m.srcPort) w.uint32(24).uint32(m.srcPort);
+      if (m.body.length) w.uint32(34).bytes(m.body);
+      if (m.replyBlock) strims_vpn_v1_OnionReplyBlock.encode(m.replyBlock, w.uint32(42).fork()).ldelim();
+      if (m.replyKey.length) w.uint32(50).bytes(m.replyKey);
+      if (m.timestamp) w.uint32(56).int64(m.timestamp);
+      if (m.key.length) w.uint32(80010).bytes(m.key);
+      if (m.signature.length) w.uint32(80018).bytes(m.signature);
+      return w;
+    }
+
+    static decode(r: Reader | Uint8Array, length?: number): Deliver {
+      r = r instanceof Reader ? r : new Reader(r);
+      const end = length === undefined ? r.len : r.pos + length;
+      const m = new Deliver();
+      while (r.pos < end) {
+        const tag = r.uint32();
+        switch (tag >> 3) {
+          case 1:
+          m.hostId = r.bytes();
+          break;
+          case 2:
+          m.port = r.uint32();
+          break;
+          case 3:
+          m.srcPort = r.uint32();
+          break;
+          case 4:
+          m.body = r.bytes();
+          break;
+          case 5:
+          m.replyBlock = strims_vpn_v1_OnionReplyBlock.decode(r, r.uint32());
+          break;
+          case 6:
+          m.replyKey = r.bytes();
+          break;
+          case 7:
+          m.timestamp = r.int64();
+          break;
+          case 10001:
+          m.key = r.bytes();
+          break;
+          case 10002:
+          m.signature = r.bytes();
+          break;
+          default:
+          r.skipType(tag & 7);
+          break;
+        }
+      }
+      return m;
+    }
+  }
+
+  export type IReceive = {
+    replyId?: Uint8Array;
+  }
+
+  export class Receive {
+    replyId: Uint8Array;
+
+    constructor(v?: IReceive) {
+      this.replyId = v?.replyId || new Uint8Array();
+    }
+
+    static encode(m: Receive, w?: Writer): Writer {
+      if (!w) w = new Writer();
+      if (m.replyId.length) w.uint32(10).bytes(m.replyId);
+      return w;
+    }
+
+    static decode(r: Reader | Uint8Array, length?: number): Receive {
+      r = r instanceof Reader ? r : new Reader(r);
+      const end = length === undefined ? r.len : r.pos + length;
+      const m = new Receive();
+      while (r.pos < end) {
+        const tag = r.uint32();
+        switch (tag >> 3) {
+          case 1:
+          m.replyId = r.bytes();
+          break;
+          default:
+          r.skipType(tag & 7);
+          break;
+        }
+      }
+      return m;
+    }
+  }
+
+}
+
+export type IOnionReplyBlock = {
+  hostId?: Uint8Array;
+  ephemeralKey?: Uint8Array;
+  header?: Uint8Array;
+}
+
+export class OnionReplyBlock {
+  hostId: Uint8Array;
+  ephemeralKey: Uint8Array;
+  header: Uint8Array;
+
+  constructor(v?: IOnionReplyBlock) {
+    this.hostId = v?.hostId || new Uint8Array();
+    this.ephemeralKey = v?.ephemeralKey || new Uint8Array();
+    this.header = v?.header || new Uint8Array();
+  }
+
+  static encode(m: OnionReplyBlock, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.hostId.length) w.uint32(10).bytes(m.hostId);
+    if (m.ephemeralKey.length) w.uint32(18).bytes(m.ephemeralKey);
+    if (m.header.length) w.uint32(26).bytes(m.header);
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): OnionReplyBlock {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new OnionReplyBlock();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.hostId = r.bytes();
+        break;
+        case 2:
+        m.ephemeralKey = r.bytes();
+        break;
+        case 3:
+        m.header = r.bytes();
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+export type IOnionPayload = {
+  port?: number;
+  srcPort?: number;
+  body?: Uint8Array;
+}
+
+export class OnionPayload {
+  port: number;
+  srcPort: number;
+  body: Uint8Array;
+
+  constructor(v?: IOnionPayload) {
+    this.port = v?.port || 0;
+    this.srcPort = v?.srcPort || 0;
+    this.body = v?.body || new Uint8Array();
+  }
+
+  static encode(m: OnionPayload, w?: Writer): Writer {
+    if (!w) w = new Writer();
+    if (m.port) w.uint32(8).uint32(m.port);
+    if (m.srcPort) w.uint32(16).uint32(m.srcPort);
+    if (m.body.length) w.uint32(26).bytes(m.body);
+    return w;
+  }
+
+  static decode(r: Reader | Uint8Array, length?: number): OnionPayload {
+    r = r instanceof Reader ? r : new Reader(r);
+    const end = length === undefined ? r.len : r.pos + length;
+    const m = new OnionPayload();
+    while (r.pos < end) {
+      const tag = r.uint32();
+      switch (tag >> 3) {
+        case 1:
+        m.port = r.uint32();
+        break;
+        case 2:
+        m.srcPort = r.uint32();
+        break;
+        case 3:
+        m.body = r.bytes();
+        break;
+        default:
+        r.skipType(tag & 7);
+        break;
+      }
+    }
+    return m;
+  }
+}
+
+/* @internal */
+export const strims_vpn_v1_OnionMessage = OnionMessage;
+/* @internal */
+export type strims_vpn_v1_OnionMessage = OnionMessage;
+/* @internal */
+export type strims_vpn_v1_IOnionMessage = IOnionMessage;
+/* @internal */
+export const strims_vpn_v1_OnionHeader = OnionHeader;
+/* @internal */
+export type strims_vpn_v1_OnionHeader = OnionHeader;
+/* @internal */
+export type strims_vpn_v1_IOnionHeader = IOnionHeader;
+/* @internal */
+export const strims_vpn_v1_OnionReplyBlock = OnionReplyBlock;
+/* @internal */
+export type strims_vpn_v1_OnionReplyBlock = OnionReplyBlock;
+/* @internal */
+export type strims_vpn_v1_IOnionReplyBlock = IOnionReplyBlock;
+/* @internal */
+export const strims_vpn_v1_OnionPayload = OnionPayload;
+/* @internal */
+export type strims_vpn_v1_OnionPayload = OnionPayload;
+/* @internal */
+export type strims_vpn_v1_IOnionPayload = IOnionPayload;
+/* @internal */
+export const strims_vpn_v1_OnionHeader_Relay = OnionHeader.Relay;
+/* @internal */
+export type strims_vpn_v1_OnionHeader_Relay = OnionHeader.Relay;
+/* @internal */
+export type strims_vpn_v1_OnionHeader_IRelay = OnionHeader.IRelay;
+/* @internal */
+export const strims_vpn_v1_OnionHeader_Deliver = OnionHeader.Deliver;
+/* @internal */
+export type strims_vpn_v1_OnionHeader_Deliver = OnionHeader.Deliver;
+/* @internal */
+export type strims_vpn_v1_OnionHeader_IDeliver = OnionHeader.IDeliver;
+/* @internal */
+export const strims_vpn_v1_OnionHeader_Receive = OnionHeader.Receive;
+/* @internal */
+export type strims_vpn_v1_OnionHeader_Receive = OnionHeader.Receive;
+/* @internal */
+export type strims_vpn_v1_OnionHeader_IReceive = OnionHeader.IReceive;
@@ -9,7 +9,12 @@ import React, { useCallback, useEffect, useState } from "react";
 import { HiOutlineUser } from "react-icons/hi";
 
 import monkey from "../../../assets/directory/monkey.png";
-import { Listing, ListingSnippet, Network } from "../../apis/strims/network/v1/directory/directory";
+import {
+  Listing,
+  ListingProvenance,
+  ListingSnippet,
+  Network,
+} from "../../apis/strims/network/v1/directory/directory";
 import SnippetImage from "../../components/Directory/SnippetImage";
 import { useLayout } from "../../contexts/Layout";
 import { useOpenListing } from "../../hooks/directory";
@@ -24,6 +29,8 @@ const DirectoryGridItem: React.FC<DirectoryGridItemProps> = ({
   snippet,
   userCount,
   recentUserCount,
+  upcoming,
+  provenance,
   network,
 }) => {
   const layout = useLayout();
@@ -63,7 +70,12 @@ const DirectoryGridItem: React.FC<DirectoryGridItemProps> = ({
           fallback={monkey}
           source={snippet.thumbnail}
         />
-        {snippet.userCount > 0 && (
+        {upcoming && snippet.startTime > 0 && (
+          <span className="directory_grid__item__upcoming">
+            {new Date(Number(snippet.startTime) * 1000).toLocaleString()}
+          </span>
+        )}
+        {!upcoming && snippet.userCount > 0 && (
           <span className="directory_grid__item__viewer_count">
             {formatNumber(Number(snippet.userCount))}{" "}
             {snippet.userCount === BigInt(1) ? "viewer" : "viewers"}
@@ -85,6 +97,11 @@ const DirectoryGridItem: React.FC<DirectoryGridItemProps> = ({
           {snippet.channelName && (
             <span className="directory_grid__item__channel__name">{snippet.channelName}</span>
           )}
+          {provenance && (
+            <span className="directory_grid__item__channel__provenance">
+              via {provenance.networkName}
+            </span>
+          )}
         </div>
         <span className="directory_grid__item__channel__user_count">
           {formatNumber(Number(userCount))}
@@ -101,6 +118,8 @@ export interface DirectoryListing {
   snippet: ListingSnippet;
   userCount: number;
   recentUserCount: number;
+  upcoming: boolean;
+  provenance?: ListingProvenance;
   network: Network;
 }
 
@@ -65,6 +65,16 @@ const EmbedMenuItem: React.FC<EmbedMenuItemProps> = ({
           logo: imgYouTube,
           name: t("directory.embedType.YouTube"),
         };
+      case Listing.Embed.Service.DIRECTORY_LISTING_EMBED_SERVICE_KICK:
+        return {
+          logo: undefined,
+          name: t("directory.embedType.Kick"),
+        };
+      case Listing.Embed.Service.DIRECTORY_LISTING_EMBED_SERVICE_VIMEO:
+        return {
+          logo: undefined,
+          name: t("directory.embedType.Vimeo"),
+        };
     }
   })();
 
@@ -77,7 +87,7 @@ const EmbedMenuItem: React.FC<EmbedMenuItemProps> = ({
       onMouseEnter={onMouseEnter}
       onClick={onSelect}
     >
-      <img className="search__menu__embed__logo" src={label.logo} />
+      {label.logo && <img className="search__menu__embed__logo" src={label.logo} />}
       {t("directory.Embed")} {label.name}
     </div>
   );
@@ -33,6 +33,10 @@ const getEmbedUrl = (
       return `https://player.twitch.tv/?channel=${id}&parent=${location.hostname}`;
     case "youtube":
       return `https://www.youtube-nocookie.com/embed/${id}?autoplay=1${queryString}`;
+    case "kick":
+      return `https://player.kick.com/${id}`;
+    case "vimeo":
+      return `https://player.vimeo.com/video/${id}?autoplay=1`;
   }
 };
 
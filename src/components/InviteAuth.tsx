@@ -200,17 +200,23 @@ const BootstrapClientCheckbox: React.FC<BootstrapClientCheckboxProps> = ({
   index,
   control,
 }) => {
+  let label: string;
   switch (client.clientOptions.case) {
-    case BootstrapClient.ClientOptionsCase.WEBSOCKET_OPTIONS: {
-      return (
-        <ToggleInput
-          control={control}
-          label={new URL(client.clientOptions.websocketOptions.url).host}
-          name={`enabledBootstrapClients.${index}`}
-        />
-      );
-    }
+    case BootstrapClient.ClientOptionsCase.WEBSOCKET_OPTIONS:
+      label = new URL(client.clientOptions.websocketOptions.url).host;
+      break;
+    case BootstrapClient.ClientOptionsCase.TCP_OPTIONS:
+      label = new URL(client.clientOptions.tcpOptions.url).host;
+      break;
+    case BootstrapClient.ClientOptionsCase.UDP_OPTIONS:
+      label = new URL(client.clientOptions.udpOptions.url).host;
+      break;
+    case BootstrapClient.ClientOptionsCase.DNS_OPTIONS:
+      label = client.clientOptions.dnsOptions.name;
+      break;
     default:
       return null;
   }
+
+  return <ToggleInput control={control} label={label} name={`enabledBootstrapClients.${index}`} />;
 };
@@ -961,6 +961,9 @@ const createRoomActions = (
       whisper: (alias: string, body: string) => {
         void client.chat.whisper({ networkKey, alias, body });
       },
+      anonwhisper: (alias: string, body: string) => {
+        void client.chat.whisper({ networkKey, alias, body, anonymous: true });
+      },
       exit: () => {
         state = closeTopic(state, { type: "ROOM", topicKey: serverKey });
       },
@@ -998,6 +1001,7 @@ const createRoomActions = (
       "tell": "whisper",
       "t": "whisper",
       "notify": "whisper",
+      "aw": "anonwhisper",
     };
 
     if (body.startsWith("/")) {
@@ -47,6 +47,7 @@ const GridStory: React.FC = () => {
       snippet: new ListingSnippet(e.snippet),
       userCount: 0,
       recentUserCount: 0,
+      upcoming: false,
       network: new Network({
         id: BigInt(0),
         name: "test-network",
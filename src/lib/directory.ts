@@ -6,13 +6,23 @@ import qs from "qs";
 import { Listing } from "../apis/strims/network/v1/directory/directory";
 import { PlayerSource } from "../contexts/Player";
 
-export type ServiceSlug = "angelthump" | "twitch" | "twitch-vod" | "youtube";
+export type ServiceSlug =
+  | "angelthump"
+  | "twitch"
+  | "twitch-vod"
+  | "youtube"
+  | "kick"
+  | "vimeo"
+  | "oembed";
 
 const serviceSlugs: [ServiceSlug, Listing.Embed.Service][] = [
   ["angelthump", Listing.Embed.Service.DIRECTORY_LISTING_EMBED_SERVICE_ANGELTHUMP],
   ["twitch", Listing.Embed.Service.DIRECTORY_LISTING_EMBED_SERVICE_TWITCH_STREAM],
   ["twitch-vod", Listing.Embed.Service.DIRECTORY_LISTING_EMBED_SERVICE_TWITCH_VOD],
   ["youtube", Listing.Embed.Service.DIRECTORY_LISTING_EMBED_SERVICE_YOUTUBE],
+  ["kick", Listing.Embed.Service.DIRECTORY_LISTING_EMBED_SERVICE_KICK],
+  ["vimeo", Listing.Embed.Service.DIRECTORY_LISTING_EMBED_SERVICE_VIMEO],
+  ["oembed", Listing.Embed.Service.DIRECTORY_LISTING_EMBED_SERVICE_OEMBED],
 ];
 
 export const serviceToSlug = (t: Listing.Embed.Service): ServiceSlug =>
@@ -116,6 +126,20 @@ const EMBED_URLS = [
       queryParams: v[2] ? { t: v[2] } : {},
     }),
   },
+  {
+    pattern: new RegExp(`kick\\.com/${EMBED_ID}/?$`),
+    embed: (v: RegExpExecArray) => ({
+      service: Listing.Embed.Service.DIRECTORY_LISTING_EMBED_SERVICE_KICK,
+      id: v[1],
+    }),
+  },
+  {
+    pattern: new RegExp(`vimeo\\.com/(?:video/)?(\\d{1,20})`),
+    embed: (v: RegExpExecArray) => ({
+      service: Listing.Embed.Service.DIRECTORY_LISTING_EMBED_SERVICE_VIMEO,
+      id: v[1],
+    }),
+  },
 ];
 
 export const createEmbedFromURL = (url: string): Listing.Embed => {
@@ -68,6 +68,8 @@ const Directory: React.FC = () => {
           snippet: l.snippet,
           userCount: l.userCount,
           recentUserCount: l.recentUserCount,
+          upcoming: l.upcoming,
+          provenance: l.provenance,
           network: n.network,
         });
       }
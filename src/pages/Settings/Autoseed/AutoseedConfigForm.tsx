@@ -7,13 +7,21 @@ import { useTranslation } from "react-i18next";
 import { useTitle } from "react-use";
 
 import { Config } from "../../../apis/strims/autoseed/v1/autoseed";
-import { Button, ButtonSet, InputError, ToggleInput } from "../../../components/Form";
+import {
+  Button,
+  ButtonSet,
+  InputError,
+  TextInput,
+  ToggleInput,
+} from "../../../components/Form";
 import { TableTitleBar } from "../../../components/Settings/Table";
 import { useCall, useLazyCall } from "../../../contexts/FrontendApi";
 import ForwardLink from "../ForwardLink";
 
 interface AutoseedConfigFormData {
   enable: boolean;
+  diskBuffer: boolean;
+  bufferDir: string;
 }
 
 const AutoseedConfigForm = () => {
@@ -26,6 +34,8 @@ const AutoseedConfigForm = () => {
     mode: "onBlur",
     defaultValues: {
       enable: false,
+      diskBuffer: false,
+      bufferDir: "",
     },
   });
 
@@ -33,6 +43,8 @@ const AutoseedConfigForm = () => {
     reset(
       {
         enable: config.enable,
+        diskBuffer: config.diskBuffer,
+        bufferDir: config.bufferDir,
       },
       {
         keepDirty: false,
@@ -46,6 +58,8 @@ const AutoseedConfigForm = () => {
     const res = await setConfig({
       config: {
         enable: data.enable,
+        diskBuffer: data.diskBuffer,
+        bufferDir: data.bufferDir,
       },
     });
     setValues(res);
@@ -59,6 +73,19 @@ const AutoseedConfigForm = () => {
           <InputError error={setConfigRes.error.message || "Error saving autoseed settings"} />
         )}
         <ToggleInput control={control} label="Enable" name="enable" />
+        <ToggleInput
+          control={control}
+          label="Disk buffer"
+          description="Store autoseeded streams on disk instead of in memory."
+          name="diskBuffer"
+        />
+        <TextInput
+          control={control}
+          label="Buffer directory"
+          description="Directory for buffer files. The system temp directory is used if empty."
+          name="bufferDir"
+          placeholder="eg. /var/cache/strims"
+        />
         <ButtonSet>
           <Button disabled={formState.isSubmitting || !formState.isDirty}>Save Changes</Button>
         </ButtonSet>
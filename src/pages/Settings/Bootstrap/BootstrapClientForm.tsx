@@ -6,13 +6,111 @@ import React from "react";
 import { SubmitHandler, useForm } from "react-hook-form";
 import createUrlRegExp from "url-regex-safe";
 
-import { Button, ButtonSet, InputError, TextInput, ToggleInput } from "../../../components/Form";
+import {
+  BootstrapClient,
+  CreateBootstrapClientRequest,
+} from "../../../apis/strims/network/v1/bootstrap/bootstrap";
+import {
+  Button,
+  ButtonSet,
+  InputError,
+  SelectInput,
+  SelectOption,
+  TextInput,
+  ToggleInput,
+} from "../../../components/Form";
+
+export type BootstrapClientType = "websocket" | "tcp" | "udp" | "dns";
+
+const clientTypeOptions: SelectOption<BootstrapClientType>[] = [
+  { label: "WebSocket", value: "websocket" },
+  { label: "TCP", value: "tcp" },
+  { label: "UDP", value: "udp" },
+  { label: "DNS seeds", value: "dns" },
+];
 
 export interface BootstrapFormData {
+  type: SelectOption<BootstrapClientType>;
   url: string;
+  name: string;
   insecureSkipVerifyTls: boolean;
 }
 
+export const toClientOptions = (
+  data: BootstrapFormData
+): CreateBootstrapClientRequest.IClientOptions => {
+  switch (data.type.value) {
+    case "websocket":
+      return {
+        websocketOptions: {
+          url: data.url,
+          insecureSkipVerifyTls: data.insecureSkipVerifyTls,
+        },
+      };
+    case "tcp":
+      return { tcpOptions: { url: data.url } };
+    case "udp":
+      return { udpOptions: { url: data.url } };
+    case "dns":
+      return {
+        dnsOptions: {
+          name: data.name,
+          insecureSkipVerifyTls: data.insecureSkipVerifyTls,
+        },
+      };
+  }
+};
+
+export const fromBootstrapClient = (client: BootstrapClient): BootstrapFormData => {
+  switch (client.clientOptions.case) {
+    case BootstrapClient.ClientOptionsCase.WEBSOCKET_OPTIONS:
+      return {
+        type: clientTypeOptions[0],
+        url: client.clientOptions.websocketOptions.url,
+        name: "",
+        insecureSkipVerifyTls: client.clientOptions.websocketOptions.insecureSkipVerifyTls,
+      };
+    case BootstrapClient.ClientOptionsCase.TCP_OPTIONS:
+      return {
+        type: clientTypeOptions[1],
+        url: client.clientOptions.tcpOptions.url,
+        name: "",
+        insecureSkipVerifyTls: false,
+      };
+    case BootstrapClient.ClientOptionsCase.UDP_OPTIONS:
+      return {
+        type: clientTypeOptions[2],
+        url: client.clientOptions.udpOptions.url,
+        name: "",
+        insecureSkipVerifyTls: false,
+      };
+    case BootstrapClient.ClientOptionsCase.DNS_OPTIONS:
+      return {
+        type: clientTypeOptions[3],
+        url: "",
+        name: client.clientOptions.dnsOptions.name,
+        insecureSkipVerifyTls: client.clientOptions.dnsOptions.insecureSkipVerifyTls,
+      };
+    default:
+      return null;
+  }
+};
+
+export const formatBootstrapClientLabel = (client: BootstrapClient): string => {
+  switch (client.clientOptions.case) {
+    case BootstrapClient.ClientOptionsCase.WEBSOCKET_OPTIONS:
+      return client.clientOptions.websocketOptions.url;
+    case BootstrapClient.ClientOptionsCase.TCP_OPTIONS:
+      return client.clientOptions.tcpOptions.url;
+    case BootstrapClient.ClientOptionsCase.UDP_OPTIONS:
+      return client.clientOptions.udpOptions.url;
+    case BootstrapClient.ClientOptionsCase.DNS_OPTIONS:
+      return `dns:${client.clientOptions.dnsOptions.name}`;
+    default:
+      return null;
+  }
+};
+
 export interface BootstrapFormProps {
   values?: BootstrapFormData;
   onSubmit: SubmitHandler<BootstrapFormData>;
@@ -28,41 +126,71 @@ const BootstrapForm: React.FC<BootstrapFormProps> = ({
   loading,
   submitLabel,
 }) => {
-  const { handleSubmit, control } = useForm<BootstrapFormData>({
+  const { handleSubmit, control, watch } = useForm<BootstrapFormData>({
     mode: "onBlur",
     defaultValues: {
+      type: clientTypeOptions[0],
       url: "",
+      name: "",
       ...values,
     },
   });
 
+  const type = watch("type").value;
+
   return (
     <form className="thing_form" onSubmit={handleSubmit(onSubmit)}>
       {error && <InputError error={error.message || "Error creating channel"} />}
-      <TextInput
-        control={control}
-        rules={{
-          required: {
-            value: true,
-            message: "URL is required",
-          },
-          pattern: {
-            value: createUrlRegExp(),
-            message: "Invalid format",
-          },
-        }}
-        autoCapitalize="off"
-        autoCorrect="off"
-        label="URL"
-        name="url"
-        placeholder="Enter a bootstrap url"
-      />
-      <ToggleInput
-        control={control}
-        name="insecureSkipVerifyTls"
-        label="Skip TLS verification"
-        description="Ignore invalid TLS certificates (native clients only)"
-      />
+      <SelectInput control={control} name="type" label="Type" options={clientTypeOptions} />
+      {type === "dns" ? (
+        <TextInput
+          control={control}
+          rules={{
+            required: {
+              value: true,
+              message: "Name is required",
+            },
+          }}
+          autoCapitalize="off"
+          autoCorrect="off"
+          label="Name"
+          name="name"
+          placeholder="Enter a domain name with bootstrap records"
+        />
+      ) : (
+        <TextInput
+          control={control}
+          rules={{
+            required: {
+              value: true,
+              message: "URL is required",
+            },
+            pattern:
+              type === "websocket"
+                ? {
+                    value: createUrlRegExp(),
+                    message: "Invalid format",
+                  }
+                : {
+                    value: new RegExp(`^${type}://[^/]+:\\d+/[0-9a-fA-F]{64}$`),
+                    message: `Expected ${type}://<host>:<port>/<peer key>`,
+                  },
+          }}
+          autoCapitalize="off"
+          autoCorrect="off"
+          label="URL"
+          name="url"
+          placeholder="Enter a bootstrap url"
+        />
+      )}
+      {(type === "websocket" || type === "dns") && (
+        <ToggleInput
+          control={control}
+          name="insecureSkipVerifyTls"
+          label="Skip TLS verification"
+          description="Ignore invalid TLS certificates (native clients only)"
+        />
+      )}
       <ButtonSet>
         <Button disabled={loading}>{submitLabel}</Button>
       </ButtonSet>
@@ -17,6 +17,7 @@ import {
   TableTitleBar,
 } from "../../../components/Settings/Table";
 import { useCall, useLazyCall } from "../../../contexts/FrontendApi";
+import { formatBootstrapClientLabel } from "./BootstrapClientForm";
 
 interface BootstrapTableItemProps {
   client: BootstrapClient;
@@ -30,13 +31,9 @@ const BootstrapTableItem = ({ client, onDelete }: BootstrapTableItemProps) => {
 
   const handleDelete = useCallback(() => deleteClient({ id: client.id }), [client]);
 
-  let url: string;
-  switch (client.clientOptions.case) {
-    case BootstrapClient.ClientOptionsCase.WEBSOCKET_OPTIONS:
-      url = client.clientOptions.websocketOptions.url;
-      break;
-    default:
-      return null;
+  const url = formatBootstrapClientLabel(client);
+  if (!url) {
+    return null;
   }
 
   return (
@@ -8,7 +8,7 @@ import { useTitle } from "react-use";
 
 import { TableTitleBar } from "../../../components/Settings/Table";
 import { useCall, useLazyCall } from "../../../contexts/FrontendApi";
-import BootstrapForm, { BootstrapFormData } from "./BootstrapClientForm";
+import BootstrapForm, { BootstrapFormData, toClientOptions } from "./BootstrapClientForm";
 
 const ChatModifierCreateFormPage: React.FC = () => {
   const { t } = useTranslation();
@@ -22,9 +22,7 @@ const ChatModifierCreateFormPage: React.FC = () => {
 
   const onSubmit = React.useCallback(async (data: BootstrapFormData) => {
     await createClient({
-      clientOptions: {
-        websocketOptions: data,
-      },
+      clientOptions: toClientOptions(data),
     });
   }, []);
 
@@ -6,10 +6,13 @@ import { useTranslation } from "react-i18next";
 import { useNavigate, useParams } from "react-router-dom";
 import { useTitle } from "react-use";
 
-import { BootstrapClient } from "../../../apis/strims/network/v1/bootstrap/bootstrap";
 import { TableTitleBar } from "../../../components/Settings/Table";
 import { useCall, useLazyCall } from "../../../contexts/FrontendApi";
-import BootstrapForm, { BootstrapFormData } from "./BootstrapClientForm";
+import BootstrapForm, {
+  BootstrapFormData,
+  fromBootstrapClient,
+  toClientOptions,
+} from "./BootstrapClientForm";
 
 const BootstrapEditForm: React.FC = () => {
   const { t } = useTranslation();
@@ -28,9 +31,7 @@ const BootstrapEditForm: React.FC = () => {
   const onSubmit = React.useCallback(async (data: BootstrapFormData) => {
     await updateBootstrap({
       id: BigInt(clientId),
-      clientOptions: {
-        websocketOptions: data,
-      },
+      clientOptions: toClientOptions(data),
     });
   }, []);
 
@@ -38,14 +39,9 @@ const BootstrapEditForm: React.FC = () => {
     return null;
   }
 
-  let data: BootstrapFormData;
-  switch (value.bootstrapClient.clientOptions.case) {
-    case BootstrapClient.ClientOptionsCase.WEBSOCKET_OPTIONS: {
-      data = value.bootstrapClient.clientOptions.websocketOptions;
-      break;
-    }
-    default:
-      return null;
+  const data = fromBootstrapClient(value.bootstrapClient);
+  if (!data) {
+    return null;
   }
 
   return (
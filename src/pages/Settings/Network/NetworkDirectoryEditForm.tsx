@@ -1,6 +1,7 @@
 // Copyright 2022 Strims contributors
 // SPDX-License-Identifier: AGPL-3.0-only
 
+import { Base64 } from "js-base64";
 import React from "react";
 import { useTranslation } from "react-i18next";
 import { useNavigate, useParams } from "react-router-dom";
@@ -29,6 +30,7 @@ const NetworkDirectoryEditForm: React.FC = () => {
   }
 
   const { serverConfig } = network;
+  const federations = serverConfig.directory?.federations ?? [];
 
   const onSubmit = (data: NetworkDirectoryFormData) =>
     updateServerConfig({
@@ -36,6 +38,7 @@ const NetworkDirectoryEditForm: React.FC = () => {
       serverConfig: {
         ...serverConfig,
         directory: {
+          ...serverConfig.directory,
           integrations: {
             angelthump: {
               enable: data.angelthumpEnable,
@@ -52,7 +55,31 @@ const NetworkDirectoryEditForm: React.FC = () => {
             swarm: {
               enable: data.swarmEnable,
             },
+            kick: {
+              enable: data.kickEnable,
+            },
+            vimeo: {
+              enable: data.vimeoEnable,
+              accessToken: data.vimeoAccessToken,
+            },
+            oembed: {
+              enable: data.oembedEnable,
+              allowedHosts: data.oembedAllowedHosts
+                .split(",")
+                .map((h) => h.trim())
+                .filter((h) => h !== ""),
+            },
           },
+          federations: data.federationNetworkKeys
+            .split(",")
+            .map((k) => k.trim())
+            .filter((k) => k !== "")
+            .map((k) => ({
+              networkKey: Base64.toUint8Array(k),
+              filter: federations.find(
+                (f) => Base64.fromUint8Array(f.networkKey, true) === k
+              )?.filter,
+            })),
         },
       },
     });
@@ -65,6 +92,14 @@ const NetworkDirectoryEditForm: React.FC = () => {
     youtubeEnable: serverConfig.directory?.integrations?.youtube?.enable,
     youtubePublicApiKey: serverConfig.directory?.integrations?.youtube?.publicApiKey,
     swarmEnable: serverConfig.directory?.integrations?.swarm?.enable,
+    kickEnable: serverConfig.directory?.integrations?.kick?.enable,
+    vimeoEnable: serverConfig.directory?.integrations?.vimeo?.enable,
+    vimeoAccessToken: serverConfig.directory?.integrations?.vimeo?.accessToken,
+    oembedEnable: serverConfig.directory?.integrations?.oembed?.enable,
+    oembedAllowedHosts: serverConfig.directory?.integrations?.oembed?.allowedHosts?.join(", ") ?? "",
+    federationNetworkKeys: federations
+      .map(({ networkKey }) => Base64.fromUint8Array(networkKey, true))
+      .join(", "),
   };
 
   return (
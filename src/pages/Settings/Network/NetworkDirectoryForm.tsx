@@ -15,6 +15,12 @@ export interface NetworkDirectoryFormData {
   youtubeEnable: boolean;
   youtubePublicApiKey: string;
   swarmEnable: boolean;
+  kickEnable: boolean;
+  vimeoEnable: boolean;
+  vimeoAccessToken: string;
+  oembedEnable: boolean;
+  oembedAllowedHosts: string;
+  federationNetworkKeys: string;
 }
 
 export interface NetworkDirectoryFormProps {
@@ -35,9 +41,10 @@ const NetworkDirectoryForm: React.FC<NetworkDirectoryFormProps> = ({
     defaultValues: values,
   });
 
-  const { twitchEnable, youtubeEnable } = watch();
+  const { twitchEnable, youtubeEnable, vimeoEnable } = watch();
   useEffect(() => clearErrors(["twitchClientId", "twitchClientSecret"]), [twitchEnable]);
   useEffect(() => clearErrors(["youtubePublicApiKey"]), [youtubeEnable]);
+  useEffect(() => clearErrors(["vimeoAccessToken"]), [vimeoEnable]);
 
   return (
     <form className="thing_form" onSubmit={handleSubmit(onSubmit)}>
@@ -87,6 +94,37 @@ const NetworkDirectoryForm: React.FC<NetworkDirectoryFormProps> = ({
         placeholder="Enter a YouTube public API key"
       />
       <ToggleInput control={control} name="swarmEnable" label="Allow swarm embed" />
+      <ToggleInput control={control} name="kickEnable" label="Allow Kick embed" />
+      <ToggleInput control={control} name="vimeoEnable" label="Allow Vimeo embed" />
+      <TextInput
+        control={control}
+        rules={{
+          required: {
+            value: vimeoEnable,
+            message: "Vimeo access token required",
+          },
+        }}
+        name="vimeoAccessToken"
+        autoComplete="off"
+        type="password"
+        label="Vimeo access token"
+        placeholder="Enter a Vimeo access token"
+      />
+      <ToggleInput control={control} name="oembedEnable" label="Allow oEmbed embed" />
+      <TextInput
+        control={control}
+        name="oembedAllowedHosts"
+        autoComplete="off"
+        label="oEmbed allowed hosts"
+        placeholder="Comma separated host names to load embeds from"
+      />
+      <TextInput
+        control={control}
+        name="federationNetworkKeys"
+        autoComplete="off"
+        label="Federated networks"
+        placeholder="Comma separated keys of networks to import listings from"
+      />
       <ButtonSet>
         <Button disabled={loading}>{values ? "Update Network" : "Create Network"}</Button>
       </ButtonSet>
@@ -8,13 +8,13 @@ import { useTranslation } from "react-i18next";
 import { useNavigate, useParams } from "react-router";
 import { useTitle } from "react-use";
 
-import { BootstrapClient } from "../../../apis/strims/network/v1/bootstrap/bootstrap";
 import { Invitation } from "../../../apis/strims/network/v1/network";
 import { Notification } from "../../../apis/strims/notification/v1/notification";
 import { Button, ButtonSet, SelectInput, SelectOption } from "../../../components/Form";
 import { TableTitleBar } from "../../../components/Settings/Table";
 import { useCall, useClient } from "../../../contexts/FrontendApi";
 import { useNotification } from "../../../contexts/Notification";
+import { formatBootstrapClientLabel } from "../Bootstrap/BootstrapClientForm";
 
 const NetworkInviteCreateForm: React.FC = () => {
   const { t } = useTranslation();
@@ -36,13 +36,9 @@ const NetworkInviteCreateForm: React.FC = () => {
     onComplete: (res) => {
       const options: SelectOption<bigint>[] = [];
       for (const client of res.bootstrapClients) {
-        switch (client.clientOptions.case) {
-          case BootstrapClient.ClientOptionsCase.WEBSOCKET_OPTIONS:
-            options.push({
-              label: client.clientOptions.websocketOptions.url,
-              value: client.id,
-            });
-            break;
+        const label = formatBootstrapClientLabel(client);
+        if (label) {
+          options.push({ label, value: client.id });
         }
         setOptions(options);
       }